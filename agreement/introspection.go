@@ -0,0 +1,287 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package agreement
+
+import (
+	"bytes"
+	"context"
+	"sort"
+	"time"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+)
+
+// A StateSnapshot is a point-in-time view of the agreement state machine,
+// meant for diagnosing a node which has stopped making progress.
+type StateSnapshot struct {
+	Round  basics.Round
+	Period uint64
+	Step   uint64
+
+	// Napping is set if the player has already voted in its current next
+	// step and is waiting to enter the following one.
+	Napping bool
+
+	// StepTime and RoundTime are the time spent so far in the current step
+	// and in the current round.
+	StepTime  time.Duration
+	RoundTime time.Duration
+
+	// Deadline and FastRecoveryDeadline are the times of the next timeouts
+	// expected by the player, measured from the start of the round.
+	Deadline             time.Duration
+	FastRecoveryDeadline time.Duration
+
+	// Proposals lists the proposal-values seen in the current round.
+	Proposals []ProposalSnapshot
+
+	// Tallies lists the votes counted in each step of each period of the
+	// current round, ordered by period and step.
+	Tallies []VoteTally
+}
+
+// A ProposalValue identifies a proposed block. The zero ProposalValue is
+// the value voted for when no block is to be agreed upon.
+type ProposalValue struct {
+	OriginalPeriod   uint64
+	OriginalProposer basics.Address
+	BlockDigest      crypto.Digest
+}
+
+// A ProposalSnapshot describes a proposal-value seen in the current round.
+type ProposalSnapshot struct {
+	ProposalValue
+
+	// Lowest is set if this value carries the lowest proposal credential
+	// seen in the current period.
+	Lowest bool
+	// Staging is set if a soft threshold was observed for this value in
+	// the current period.
+	Staging bool
+	// Pinned is set if a certificate may have formed for this value in an
+	// earlier period.
+	Pinned bool
+
+	// PayloadReceived is set once the block for this value has arrived,
+	// and PayloadValidated once it has also been validated.
+	PayloadReceived  bool
+	PayloadValidated bool
+}
+
+// A VoteTally holds the votes counted for a single step of a period.
+type VoteTally struct {
+	Period uint64
+	Step   uint64
+
+	// Threshold is the weight a value needs to reach in this step. It is
+	// zero if the consensus version of the round is unknown.
+	Threshold uint64
+
+	// Voters is the number of distinct voters seen in this step.
+	Voters uint64
+
+	// EquivocatorWeight is the weight of the voters which equivocated.
+	// It counts towards every value.
+	EquivocatorWeight uint64
+
+	// Weights lists the weight counted towards each value, highest first.
+	Weights []ProposalWeight
+}
+
+// A ProposalWeight is the vote weight counted towards a proposal-value.
+type ProposalWeight struct {
+	ProposalValue
+	Weight uint64
+}
+
+func exportProposalValue(v proposalValue) ProposalValue {
+	return ProposalValue{
+		OriginalPeriod:   uint64(v.OriginalPeriod),
+		OriginalProposer: v.OriginalProposer,
+		BlockDigest:      v.BlockDigest,
+	}
+}
+
+// stepTimer tracks when the player entered its current round and step.
+type stepTimer struct {
+	round  round
+	period period
+	step   step
+
+	roundStart time.Time
+	stepStart  time.Time
+}
+
+func (t *stepTimer) observe(p player, now time.Time) {
+	if p.Round != t.round || t.roundStart.IsZero() {
+		t.roundStart = now
+	}
+	if p.Round != t.round || p.Period != t.period || p.Step != t.step || t.stepStart.IsZero() {
+		t.stepStart = now
+	}
+	t.round, t.period, t.step = p.Round, p.Period, p.Step
+}
+
+// StateSnapshot returns a snapshot of the agreement state machine.
+//
+// The snapshot is taken once the state machine has finished handling its
+// current event. StateSnapshot fails if ctx is done before then, which is
+// also the case if the service is not running.
+func (s *Service) StateSnapshot(ctx context.Context) (StateSnapshot, error) {
+	req := make(chan StateSnapshot, 1)
+	select {
+	case s.snapshotRequests <- req:
+	case <-ctx.Done():
+		return StateSnapshot{}, ctx.Err()
+	}
+	return <-req, nil
+}
+
+// awaitEvent waits for the next input event of the main loop, answering any
+// snapshot requests which arrive in the meantime.
+func (s *Service) awaitEvent(input <-chan externalEvent, router *rootRouter, status player, timer stepTimer) (externalEvent, bool) {
+	for {
+		select {
+		case e, ok := <-input:
+			return e, ok
+		case req := <-s.snapshotRequests:
+			var proto *config.ConsensusParams
+			cv, err := s.Ledger.ConsensusVersion(ParamsRound(status.Round))
+			if err != nil {
+				s.log.Warnf("agreement: unable to retrieve consensus version for round %d: %v", status.Round, err)
+			} else {
+				params := config.Consensus[cv]
+				proto = &params
+			}
+			req <- snapshotState(router, status, timer, proto, time.Now())
+		}
+	}
+}
+
+// snapshotState copies the parts of the state machine which describe the
+// progress of the current round into a StateSnapshot. Thresholds are left
+// unset if proto is nil.
+func snapshotState(router *rootRouter, p player, timer stepTimer, proto *config.ConsensusParams, now time.Time) StateSnapshot {
+	res := StateSnapshot{
+		Round:                p.Round,
+		Period:               uint64(p.Period),
+		Step:                 uint64(p.Step),
+		Napping:              p.Napping,
+		StepTime:             now.Sub(timer.stepStart),
+		RoundTime:            now.Sub(timer.roundStart),
+		Deadline:             p.Deadline,
+		FastRecoveryDeadline: p.FastRecoveryDeadline,
+	}
+
+	rr := router.Children[p.Round]
+	if rr == nil {
+		return res
+	}
+
+	proposals := make(map[proposalValue]*ProposalSnapshot)
+	see := func(v proposalValue) *ProposalSnapshot {
+		ps := proposals[v]
+		if ps == nil {
+			ps = &ProposalSnapshot{ProposalValue: exportProposalValue(v)}
+			proposals[v] = ps
+		}
+		return ps
+	}
+
+	store := rr.ProposalStore
+	for v, a := range store.Assemblers {
+		ps := see(v)
+		ps.PayloadReceived = a.Filled || a.Assembled
+		ps.PayloadValidated = a.Assembled
+	}
+	for _, v := range store.Relevant {
+		if v != bottom {
+			see(v)
+		}
+	}
+	if store.Pinned != bottom {
+		see(store.Pinned).Pinned = true
+	}
+
+	for per, pr := range rr.Children {
+		if per == p.Period {
+			tracker := pr.ProposalTracker
+			if tracker.Freezer.Filled {
+				see(tracker.Freezer.Lowest.R.Proposal).Lowest = true
+			}
+			if tracker.Staging != bottom {
+				see(tracker.Staging).Staging = true
+			}
+		}
+
+		for st, sr := range pr.Children {
+			vt := sr.VoteTracker
+			if len(vt.Voters) == 0 {
+				continue
+			}
+
+			tally := VoteTally{
+				Period:            uint64(per),
+				Step:              uint64(st),
+				Voters:            uint64(len(vt.Voters)),
+				EquivocatorWeight: vt.EquivocatorsCount,
+			}
+			if st != propose && proto != nil {
+				tally.Threshold = st.threshold(*proto)
+			}
+			for v := range vt.Counts {
+				if v != bottom {
+					see(v)
+				}
+				tally.Weights = append(tally.Weights, ProposalWeight{ProposalValue: exportProposalValue(v), Weight: vt.count(v)})
+			}
+			sort.Slice(tally.Weights, func(i, j int) bool {
+				if tally.Weights[i].Weight != tally.Weights[j].Weight {
+					return tally.Weights[i].Weight > tally.Weights[j].Weight
+				}
+				return lessProposalValue(tally.Weights[i].ProposalValue, tally.Weights[j].ProposalValue)
+			})
+			res.Tallies = append(res.Tallies, tally)
+		}
+	}
+	sort.Slice(res.Tallies, func(i, j int) bool {
+		if res.Tallies[i].Period != res.Tallies[j].Period {
+			return res.Tallies[i].Period < res.Tallies[j].Period
+		}
+		return res.Tallies[i].Step < res.Tallies[j].Step
+	})
+
+	for _, ps := range proposals {
+		res.Proposals = append(res.Proposals, *ps)
+	}
+	sort.Slice(res.Proposals, func(i, j int) bool {
+		return lessProposalValue(res.Proposals[i].ProposalValue, res.Proposals[j].ProposalValue)
+	})
+	return res
+}
+
+func lessProposalValue(a, b ProposalValue) bool {
+	if a.OriginalPeriod != b.OriginalPeriod {
+		return a.OriginalPeriod < b.OriginalPeriod
+	}
+	if c := bytes.Compare(a.OriginalProposer[:], b.OriginalProposer[:]); c != 0 {
+		return c < 0
+	}
+	return bytes.Compare(a.BlockDigest[:], b.BlockDigest[:]) < 0
+}
@@ -0,0 +1,127 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package agreement
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestSnapshotState(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	player, router, accs, f, ledger := testPlayerSetup()
+	proto := config.Consensus[protocol.ConsensusCurrentVersion]
+
+	voteBatch, payloadBatch, lowest := generateProposalEvents(t, player, accs, f, ledger)
+	softBatch := generateVoteEvents(t, player, soft, accs, lowest, ledger)
+	simulateProposals(t, &router, &player, voteBatch, payloadBatch)
+	simulateTimeoutExpectSoft(t, &router, &player, lowest)
+	simulateSoftExpectAttest(t, &router, &player, lowest, softBatch)
+
+	start := time.Now()
+	var timer stepTimer
+	timer.observe(player, start)
+	snap := snapshotState(&router, player, timer, &proto, start.Add(time.Second))
+
+	require.Equal(t, player.Round, snap.Round)
+	require.Equal(t, uint64(0), snap.Period)
+	require.Equal(t, uint64(cert), snap.Step)
+	require.Equal(t, time.Second, snap.StepTime)
+	require.Equal(t, time.Second, snap.RoundTime)
+	require.Equal(t, player.Deadline, snap.Deadline)
+
+	var found bool
+	for _, ps := range snap.Proposals {
+		if ps.ProposalValue != exportProposalValue(lowest) {
+			require.False(t, ps.Lowest)
+			require.False(t, ps.Staging)
+			continue
+		}
+		found = true
+		require.True(t, ps.Lowest)
+		require.True(t, ps.Staging)
+		require.False(t, ps.Pinned)
+		require.True(t, ps.PayloadReceived)
+		require.True(t, ps.PayloadValidated)
+	}
+	require.True(t, found)
+
+	require.Len(t, snap.Tallies, 1)
+	tally := snap.Tallies[0]
+	require.Equal(t, uint64(0), tally.Period)
+	require.Equal(t, uint64(soft), tally.Step)
+	require.Equal(t, proto.SoftCommitteeThreshold, tally.Threshold)
+	require.NotZero(t, tally.Voters)
+	require.Len(t, tally.Weights, 1)
+	require.Equal(t, exportProposalValue(lowest), tally.Weights[0].ProposalValue)
+	require.GreaterOrEqual(t, tally.Weights[0].Weight, tally.Threshold)
+
+	// without consensus parameters, thresholds are left unset
+	snap = snapshotState(&router, player, timer, nil, start)
+	require.Zero(t, snap.Tallies[0].Threshold)
+
+	// a round the router has not seen yet has no proposals or votes
+	player.Round++
+	snap = snapshotState(&router, player, timer, &proto, start)
+	require.Empty(t, snap.Proposals)
+	require.Empty(t, snap.Tallies)
+}
+
+func TestStepTimer(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	var timer stepTimer
+	t0 := time.Now()
+	p := player{Round: 10, Step: soft}
+	timer.observe(p, t0)
+	require.Equal(t, t0, timer.roundStart)
+	require.Equal(t, t0, timer.stepStart)
+
+	t1 := t0.Add(time.Second)
+	timer.observe(p, t1)
+	require.Equal(t, t0, timer.stepStart)
+
+	p.Step = cert
+	timer.observe(p, t1)
+	require.Equal(t, t0, timer.roundStart)
+	require.Equal(t, t1, timer.stepStart)
+
+	t2 := t1.Add(time.Second)
+	p.Round++
+	p.Step = soft
+	timer.observe(p, t2)
+	require.Equal(t, t2, timer.roundStart)
+	require.Equal(t, t2, timer.stepStart)
+}
+
+func TestStateSnapshotNotRunning(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	s := &Service{snapshotRequests: make(chan chan StateSnapshot)}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err := s.StateSnapshot(ctx)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+}
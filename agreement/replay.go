@@ -0,0 +1,266 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package agreement
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+// A ReplayDivergence describes the first point at which a replayed cadaver
+// trace stopped matching what the traced node recorded.
+type ReplayDivergence struct {
+	// Sequence is the index of the cadaver sequence (one per process run)
+	// in which the divergence occurred.
+	Sequence int
+	// Event is the index of the offending event within its sequence.
+	Event int
+
+	// Round, Period, and Step give the state of the player before the
+	// offending event was delivered.
+	Round  uint64
+	Period uint64
+	Step   uint64
+
+	// Elapsed is the replay clock's reading when the event was delivered,
+	// measured from the start of the round.
+	Elapsed time.Duration
+
+	Reason   string
+	Input    string
+	Expected string
+	Actual   string
+}
+
+func (d ReplayDivergence) String() string {
+	return fmt.Sprintf("seq %d event %d (%d, %d, %d) at %v: %s\n\tinput: %s\n\texpected: %s\n\tactual: %s",
+		d.Sequence, d.Event, d.Round, d.Period, d.Step, d.Elapsed, d.Reason, d.Input, d.Expected, d.Actual)
+}
+
+// A ReplayResult summarizes the replay of an Autopsy.
+type ReplayResult struct {
+	// Sequences and Events count what was replayed, including the event
+	// which diverged, if any.
+	Sequences int
+	Events    int
+
+	// Divergence is nil if every recorded action was reproduced.
+	Divergence *ReplayDivergence
+}
+
+// replayClock stands in for the Service clock during a replay. Time only
+// advances when a timeout is delivered, since that is the only point at
+// which the traced node is known to have observed the passage of time.
+type replayClock struct {
+	now time.Duration
+}
+
+func (c *replayClock) observe(x player, e event) event {
+	switch e.t() {
+	case timeout:
+		if c.now < x.Deadline {
+			c.now = x.Deadline
+		}
+	case fastTimeout:
+		if c.now < x.FastRecoveryDeadline {
+			c.now = x.FastRecoveryDeadline
+		}
+	case payloadVerified:
+		e = e.(messageEvent).AttachValidatedAt(c.now)
+	case payloadPresent, votePresent:
+		e = e.(messageEvent).AttachReceivedAt(c.now)
+	}
+	return e
+}
+
+func (c *replayClock) apply(as []action) {
+	for _, a := range as {
+		if a.t() == rezero {
+			c.now = 0
+		}
+	}
+}
+
+// Replay feeds the recorded input events of the Autopsy back through a fresh
+// player and rootRouter, and checks that the same actions are emitted in
+// response to each event. It also checks that each player snapshot in the
+// trace matches the replayed player state. Replay stops at the first
+// divergence.
+//
+// Each cadaver sequence is replayed from its first player snapshot with an
+// empty router. A node which restored its state from disk after a crash
+// starts its sequence with router state that is not part of the trace, so
+// such a sequence may diverge shortly after its start.
+//
+// Replay consumes the Autopsy.
+func (a *Autopsy) Replay() (res ReplayResult) {
+	var t tracer
+	t.log = serviceLogger{logging.Base()}
+	t.w = io.Discard
+
+	seq := 0
+	for cdv := range a.cdvs {
+		if res.Divergence != nil {
+			drainCdv(cdv)
+		} else {
+			res.Divergence = replayCdv(&t, seq, cdv, &res)
+		}
+		seq++
+	}
+	return
+}
+
+func replayCdv(t *tracer, seq int, cdv cdvInstance, res *ReplayResult) *ReplayDivergence {
+	var pairs <-chan autopsyPair
+	defer func() {
+		if pairs != nil {
+			for range pairs {
+			}
+		}
+		drainCdv(cdv)
+	}()
+
+	var router rootRouter
+	var clock replayClock
+	var current player
+	first := true
+	n := 0
+
+	for tr := range cdv {
+		pairs = tr.p
+		if first {
+			first = false
+			res.Sequences++
+			current = tr.x
+		} else if !bytes.Equal(canonicalPlayer(tr.x), canonicalPlayer(current)) {
+			return &ReplayDivergence{
+				Sequence: seq,
+				Event:    n,
+				Round:    uint64(tr.x.Round),
+				Period:   uint64(tr.x.Period),
+				Step:     uint64(tr.x.Step),
+				Elapsed:  clock.now,
+				Reason:   "player state mismatch",
+				Expected: replayPlayerStr(tr.x),
+				Actual:   replayPlayerStr(current),
+			}
+		}
+
+		for pair := range tr.p {
+			if !pair.aok {
+				// the node stopped before it finished handling this event
+				continue
+			}
+			res.Events++
+
+			e := clock.observe(current, pair.e)
+			next, out, err := replayEvent(t, &router, current, e)
+			if err == nil {
+				err = compareActions(pair.a, out)
+			}
+			if err != nil {
+				return &ReplayDivergence{
+					Sequence: seq,
+					Event:    n,
+					Round:    uint64(current.Round),
+					Period:   uint64(current.Period),
+					Step:     uint64(current.Step),
+					Elapsed:  clock.now,
+					Reason:   err.Error(),
+					Input:    e.String(),
+					Expected: fmt.Sprintf("%v", pair.a),
+					Actual:   fmt.Sprintf("%v", out),
+				}
+			}
+			clock.apply(out)
+			current = next
+			n++
+		}
+	}
+	return nil
+}
+
+// replayEvent delivers e to the state machine, converting a panic into an
+// error so that it can be reported as a divergence.
+func replayEvent(t *tracer, router *rootRouter, x player, e event) (next player, out []action, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("state machine panicked: %v", r)
+		}
+	}()
+
+	router.root = checkedActor{actor: &x, actorContract: playerContract{}}
+	next, out = router.submitTop(t, x, e)
+	return
+}
+
+func compareActions(expected, actual []action) error {
+	if len(expected) != len(actual) {
+		return fmt.Errorf("expected %d actions but got %d", len(expected), len(actual))
+	}
+	for i := range expected {
+		if expected[i].t() != actual[i].t() {
+			return fmt.Errorf("action %d: expected %v but got %v", i, expected[i].t(), actual[i].t())
+		}
+		if !bytes.Equal(canonicalAction(expected[i]), canonicalAction(actual[i])) {
+			return fmt.Errorf("action %d (%v) differs", i, expected[i].t())
+		}
+	}
+	return nil
+}
+
+// replayPlayerStr formats a player without its pending proposal table, as
+// dumpPlayerStr does.
+func replayPlayerStr(x player) string {
+	pending := len(x.Pending.Pending)
+	x.Pending = proposalTable{}
+	return fmt.Sprintf("%+v (len(player.Pending) = %d)", x, pending)
+}
+
+// canonicalAction and canonicalPlayer encode values the way they appear after
+// a round-trip through a cadaver file, which drops unexported fields.
+func canonicalAction(a action) []byte {
+	z := zeroAction(a.t())
+	err := protocol.DecodeReflect(protocol.EncodeReflect(a), &z)
+	if err != nil {
+		return protocol.EncodeReflect(a)
+	}
+	return protocol.EncodeReflect(z)
+}
+
+func canonicalPlayer(x player) []byte {
+	var z player
+	err := protocol.DecodeReflect(protocol.EncodeReflect(x), &z)
+	if err != nil {
+		return protocol.EncodeReflect(x)
+	}
+	return protocol.EncodeReflect(z)
+}
+
+// drainCdv consumes the rest of a cadaver sequence so that the goroutine
+// extracting it can move on.
+func drainCdv(cdv cdvInstance) {
+	for tr := range cdv {
+		for range tr.p {
+		}
+	}
+}
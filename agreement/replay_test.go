@@ -0,0 +1,137 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package agreement
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+type cadaverBuffer struct {
+	bytes.Buffer
+}
+
+func (b *cadaverBuffer) Close() error {
+	return nil
+}
+
+// recordCadaver points playerTracer at an in-memory cadaver for the duration
+// of the test, and restores the whole tracer once the test is done.
+func recordCadaver(t *testing.T) *cadaverBuffer {
+	buf := new(cadaverBuffer)
+	saved := playerTracer
+	t.Cleanup(func() {
+		playerTracer = saved
+	})
+
+	playerTracer.cadaver = cadaver{overrideSetup: true, out: &cadaverHandle{WriteCloser: buf}}
+	protocol.EncodeStream(playerTracer.cadaver.out, cadaverMetaEntry)
+	protocol.EncodeStream(playerTracer.cadaver.out, CadaverMetadata{})
+	return buf
+}
+
+func replayCadaver(t *testing.T, buf *cadaverBuffer) ReplayResult {
+	var runs int
+	var doneErr error
+	nextBounds := func(int, AutopsyBounds) {}
+	done := func(n int, err error) {
+		runs = n
+		doneErr = err
+	}
+
+	autopsy, err := PrepareAutopsyFromStream(io.NopCloser(&buf.Buffer), nextBounds, done)
+	require.NoError(t, err)
+	res := autopsy.Replay()
+	require.NoError(t, doneErr)
+	require.Equal(t, res.Sequences, runs)
+	return res
+}
+
+func TestReplayCadaver(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	buf := recordCadaver(t)
+	player, router, accs, f, ledger := testPlayerSetup()
+	for i := 0; i < 3; i++ {
+		simulateSingleSynchronousRound(t, &router, &player, accs, f, ledger)
+	}
+
+	res := replayCadaver(t, buf)
+	require.Nil(t, res.Divergence)
+	require.Equal(t, 1, res.Sequences)
+	require.NotZero(t, res.Events)
+}
+
+func TestReplayDivergence(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	buf := recordCadaver(t)
+	player, router, accs, f, ledger := testPlayerSetup()
+
+	// record an event whose actions do not match what the state machine
+	// emits, followed by a trace which the replay should skip
+	var quiet tracer
+	quiet.log = playerTracer.log
+	var scratch rootRouter
+	e := makeTimeoutEvent()
+	_, actual, err := replayEvent(&quiet, &scratch, player, e)
+	require.NoError(t, err)
+	tampered := append(append([]action{}, actual...), noopAction{})
+	playerTracer.traceInput(player.Round, player.Period, player, e)
+	playerTracer.traceOutput(player.Round, player.Period, player, tampered)
+
+	for i := 0; i < 2; i++ {
+		simulateSingleSynchronousRound(t, &router, &player, accs, f, ledger)
+	}
+
+	res := replayCadaver(t, buf)
+	require.NotNil(t, res.Divergence)
+	require.Equal(t, 1, res.Sequences)
+	require.Equal(t, 1, res.Events)
+
+	div := res.Divergence
+	require.Equal(t, 0, div.Sequence)
+	require.Equal(t, 0, div.Event)
+	require.Equal(t, uint64(player.Round-2), div.Round)
+	require.Equal(t, e.String(), div.Input)
+	require.Contains(t, div.Reason, "actions")
+}
+
+func TestReplayPlayerMismatch(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	buf := recordCadaver(t)
+	player, router, accs, f, ledger := testPlayerSetup()
+	simulateSingleSynchronousRound(t, &router, &player, accs, f, ledger)
+
+	// a snapshot which disagrees with the replayed state
+	forged := player
+	forged.Step = cert
+	protocol.EncodeStream(playerTracer.cadaver.out, cadaverPlayerEntry)
+	protocol.EncodeStream(playerTracer.cadaver.out, forged)
+
+	res := replayCadaver(t, buf)
+	require.NotNil(t, res.Divergence)
+	require.Equal(t, "player state mismatch", res.Divergence.Reason)
+	require.Equal(t, uint64(cert), res.Divergence.Step)
+}
@@ -57,6 +57,9 @@ type Service struct {
 	persistRouter  rootRouter
 	persistStatus  player
 	persistActions []action
+
+	// snapshotRequests is served by the main loop between events
+	snapshotRequests chan chan StateSnapshot
 }
 
 // Parameters holds the parameters necessary to run the agreement protocol.
@@ -105,6 +108,7 @@ func MakeService(p Parameters) (*Service, error) {
 	}
 
 	s.persistenceLoop = makeAsyncPersistenceLoop(s.log, s.Accessor, s.Ledger)
+	s.snapshotRequests = make(chan chan StateSnapshot)
 
 	return s, nil
 }
@@ -224,10 +228,12 @@ func (s *Service) mainLoop(input <-chan externalEvent, output chan<- []action, r
 		s.Clock = clock
 	}
 
+	var timer stepTimer
 	for {
 		output <- a
 		ready <- externalDemuxSignals{Deadline: status.Deadline, FastRecoveryDeadline: status.FastRecoveryDeadline, CurrentRound: status.Round}
-		e, ok := <-input
+		timer.observe(status, time.Now())
+		e, ok := s.awaitEvent(input, &router, status, timer)
 		if !ok {
 			break
 		}
@@ -18,6 +18,7 @@ package catchup
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"sync"
 	"time"
@@ -98,6 +99,11 @@ type CatchpointCatchupService struct {
 	abortCtxFunc context.CancelFunc
 	// blocksDownloadPeerSelector is the peer selector used for downloading blocks.
 	blocksDownloadPeerSelector *peerSelector
+	// localFiles, when set, provides the catchpoint file and the blocks from the local file system instead of the network.
+	localFiles *localCatchpointFiles
+	// localFilesErr is set when a resumed catchpoint catchup was installing from local files which are no longer available.
+	// The catchup is aborted as soon as the service starts running.
+	localFilesErr error
 }
 
 // MakeResumedCatchpointCatchupService creates a catchpoint catchup service for a node that is already in catchpoint catchup mode
@@ -155,6 +161,26 @@ func MakeNewCatchpointCatchupService(catchpoint string, node CatchpointCatchupNo
 	return service, nil
 }
 
+// MakeNewCatchpointCatchupServiceFromFiles creates a new catchpoint catchup service that installs the given catchpoint
+// out of a local catchpoint file and a local blocks file, without downloading anything from the network. The blocks file
+// is a sequence of msgpack encoded rpcs.EncodedBlockCert entries, which needs to contain the catchpoint round block
+// as well as the lookback blocks preceding it. The catchpoint label is verified against the catchpoint round block,
+// exactly as it is for a network catchup. The paths of both files are persisted along with the catchup state, so that if
+// the node is restarted before the catchup completes, the resumed catchup keeps using them. If they are no longer
+// available by then, the resumed catchup is aborted.
+func MakeNewCatchpointCatchupServiceFromFiles(catchpoint, catchpointFile, blocksFile string, node CatchpointCatchupNodeServices, log logging.Logger, net network.GossipNode, accessor ledger.CatchpointCatchupAccessor, cfg config.Local) (service *CatchpointCatchupService, err error) {
+	localFiles, err := makeLocalCatchpointFiles(catchpointFile, blocksFile)
+	if err != nil {
+		return nil, fmt.Errorf("MakeNewCatchpointCatchupServiceFromFiles: %w", err)
+	}
+	service, err = MakeNewCatchpointCatchupService(catchpoint, node, log, net, accessor, cfg)
+	if err != nil {
+		return nil, err
+	}
+	service.localFiles = localFiles
+	return service, nil
+}
+
 // Start starts the catchpoint catchup service ( continue in the process )
 func (cs *CatchpointCatchupService) Start(ctx context.Context) {
 	cs.ctx, cs.cancelCtxFunc = context.WithCancel(ctx)
@@ -192,6 +218,11 @@ func (cs *CatchpointCatchupService) GetLatestBlockHeader() bookkeeping.BlockHead
 func (cs *CatchpointCatchupService) run() {
 	defer cs.running.Done()
 	var err error
+	if cs.localFilesErr != nil {
+		err = cs.abort(cs.localFilesErr)
+		cs.log.Warnf("catchpoint catchup stage error : %v", err)
+		return
+	}
 	for {
 		// check if we need to abort.
 		select {
@@ -245,6 +276,18 @@ func (cs *CatchpointCatchupService) loadStateVariables(ctx context.Context) (err
 	if err != nil {
 		return err
 	}
+
+	catchpointFile, blocksFile, err := cs.ledgerAccessor.GetLocalFiles(ctx)
+	if err != nil {
+		return err
+	}
+	if catchpointFile != "" || blocksFile != "" {
+		cs.localFiles, err = makeLocalCatchpointFiles(catchpointFile, blocksFile)
+		if err != nil {
+			// don't fail the node startup; the catchup is aborted once the service starts running.
+			cs.localFilesErr = fmt.Errorf("unable to resume catchpoint catchup from local files : %w", err)
+		}
+	}
 	return nil
 }
 
@@ -258,6 +301,12 @@ func (cs *CatchpointCatchupService) processStageInactive() (err error) {
 	if err != nil {
 		return cs.abort(fmt.Errorf("processStageInactive failed to set a catchpoint label : %v", err))
 	}
+	if cs.localFiles != nil {
+		err = cs.ledgerAccessor.SetLocalFiles(cs.ctx, cs.localFiles.catchpointFile, cs.localFiles.blocksFile)
+		if err != nil {
+			return cs.abort(fmt.Errorf("processStageInactive failed to set the catchpoint catchup local files : %v", err))
+		}
+	}
 
 	err = cs.updateStage(ledger.CatchpointCatchupStateLedgerDownload)
 	if err != nil {
@@ -281,29 +330,57 @@ func (cs *CatchpointCatchupService) processStageLedgerDownload() (err error) {
 		return cs.abort(fmt.Errorf("processStageLedgerDownload failed to parse label : %v", err0))
 	}
 
+	if cs.localFiles != nil {
+		return cs.processStageLedgerLoad()
+	}
+
 	// download balances file.
 	peerSelector := makePeerSelector(cs.net, []peerClass{{initialRank: peerRankInitialFirstPriority, peerClass: network.PeersPhonebookRelays}})
 	ledgerFetcher := makeLedgerFetcher(cs.net, cs.ledgerAccessor, cs.log, cs, cs.config)
 	attemptsCount := 0
+	// a resumed catchup picks up the download from the last processed catchpoint file section, and so do retries
+	// following download errors. Anything else starts over with empty staging tables.
+	resume := !cs.newService
+	var progress ledger.CatchpointCatchupAccessorProgress
 
 	for {
 		attemptsCount++
 
-		err = cs.ledgerAccessor.ResetStagingBalances(cs.ctx, true)
-		if err != nil {
-			if cs.ctx.Err() != nil {
-				return cs.stopOrAbort()
+		if resume {
+			err = cs.ledgerAccessor.RestoreStagingBalancesProgress(cs.ctx, &progress)
+			if err != nil {
+				cs.log.Warnf("processStageLedgerDownload failed to restore the catchpoint download progress : %v", err)
+				progress = ledger.CatchpointCatchupAccessorProgress{}
 			}
-			return cs.abort(fmt.Errorf("processStageLedgerDownload failed to reset staging balances : %v", err))
 		}
-		psp, err := peerSelector.getNextPeer()
-		if err != nil {
+		if progress.NextChunk == 0 {
+			err = cs.ledgerAccessor.ResetStagingBalances(cs.ctx, true)
+			if err != nil {
+				if cs.ctx.Err() != nil {
+					return cs.stopOrAbort()
+				}
+				return cs.abort(fmt.Errorf("processStageLedgerDownload failed to reset staging balances : %v", err))
+			}
+		} else {
+			cs.log.Infof("resuming the catchpoint file download at chunk %d", progress.NextChunk)
+			cs.updateLedgerFetcherProgress(&progress)
+		}
+		resume = true
+
+		var psp *peerSelectorPeer
+		start := time.Now()
+		if cs.config.CatchpointDownloadParallelism > 1 {
+			err = ledgerFetcher.downloadLedgerParallel(cs.ctx, peerSelector, round, &progress)
+		} else {
+			psp, err = peerSelector.getNextPeer()
+			if err == nil {
+				err = ledgerFetcher.downloadLedger(cs.ctx, psp.Peer, round, &progress)
+			}
+		}
+		if err == errPeerSelectorNoPeerPoolsAvailable {
 			err = fmt.Errorf("processStageLedgerDownload: catchpoint catchup was unable to obtain a list of peers to retrieve the catchpoint file from")
 			return cs.abort(err)
 		}
-		peer := psp.Peer
-		start := time.Now()
-		err = ledgerFetcher.downloadLedger(cs.ctx, peer, round)
 		if err == nil {
 			cs.log.Infof("ledger downloaded in %d seconds", time.Since(start)/time.Second)
 			start = time.Now()
@@ -314,8 +391,15 @@ func (cs *CatchpointCatchupService) processStageLedgerDownload() (err error) {
 			}
 			// failed to build the merkle trie for the above catchpoint file.
 			peerSelector.rankPeer(psp, peerRankInvalidDownload)
+			resume = false
 		} else {
 			peerSelector.rankPeer(psp, peerRankDownloadFailed)
+			if errors.Is(err, errCatchpointSectionProcessing) {
+				resume = false
+			}
+		}
+		if !resume {
+			progress = ledger.CatchpointCatchupAccessorProgress{}
 		}
 
 		// instead of testing for err == cs.ctx.Err() , we'll check on the context itself.
@@ -339,6 +423,46 @@ func (cs *CatchpointCatchupService) processStageLedgerDownload() (err error) {
 	return nil
 }
 
+// processStageLedgerLoad is the offline alternative of processStageLedgerDownload. It loads the ledger from the local catchpoint file.
+// Since retrying would read the very same file, any failure aborts the catchup.
+func (cs *CatchpointCatchupService) processStageLedgerLoad() (err error) {
+	err = cs.ledgerAccessor.ResetStagingBalances(cs.ctx, true)
+	if err != nil {
+		if cs.ctx.Err() != nil {
+			return cs.stopOrAbort()
+		}
+		return cs.abort(fmt.Errorf("processStageLedgerLoad failed to reset staging balances : %v", err))
+	}
+
+	ledgerFetcher := makeLedgerFetcher(cs.net, cs.ledgerAccessor, cs.log, cs, cs.config)
+	var progress ledger.CatchpointCatchupAccessorProgress
+	start := time.Now()
+	err = ledgerFetcher.loadLedgerFile(cs.ctx, cs.localFiles.catchpointFile, &progress)
+	if err != nil {
+		if cs.ctx.Err() != nil {
+			return cs.stopOrAbort()
+		}
+		return cs.abort(fmt.Errorf("processStageLedgerLoad failed to load catchpoint file %s : %v", cs.localFiles.catchpointFile, err))
+	}
+	cs.log.Infof("ledger loaded from %s in %d seconds", cs.localFiles.catchpointFile, time.Since(start)/time.Second)
+
+	start = time.Now()
+	err = cs.ledgerAccessor.BuildMerkleTrie(cs.ctx, cs.updateVerifiedCounts)
+	if err != nil {
+		if cs.ctx.Err() != nil {
+			return cs.stopOrAbort()
+		}
+		return cs.abort(fmt.Errorf("processStageLedgerLoad failed to build the merkle trie : %v", err))
+	}
+	cs.log.Infof("built merkle trie in %d seconds", time.Since(start)/time.Second)
+
+	err = cs.updateStage(ledger.CatchpointCatchupStateLatestBlockDownload)
+	if err != nil {
+		return cs.abort(fmt.Errorf("processStageLedgerLoad failed to update stage to CatchpointCatchupStateLatestBlockDownload : %v", err))
+	}
+	return nil
+}
+
 // updateVerifiedCounts update the user's statistics for the given verified hashes
 func (cs *CatchpointCatchupService) updateVerifiedCounts(accountCount, kvCount uint64) {
 	cs.statsMu.Lock()
@@ -639,9 +763,18 @@ func (cs *CatchpointCatchupService) processStageBlocksDownload() (err error) {
 }
 
 // fetchBlock uses the internal peer selector blocksDownloadPeerSelector to pick a peer and then attempt to fetch the block requested from that peer.
+// When installing a catchpoint from local files, the block is taken from the blocks file instead.
 // The method return stop=true if the caller should exit the current operation
 // If the method return a nil block, the caller is expected to retry the operation, increasing the retry counter as needed.
 func (cs *CatchpointCatchupService) fetchBlock(round basics.Round, retryCount uint64) (blk *bookkeeping.Block, downloadDuration time.Duration, psp *peerSelectorPeer, stop bool, err error) {
+	if cs.localFiles != nil {
+		blk, err = cs.localFiles.block(round)
+		if err != nil {
+			return nil, time.Duration(0), nil, true, cs.abort(fmt.Errorf("fetchBlock: %w", err))
+		}
+		return blk, time.Duration(0), nil, false, nil
+	}
+
 	psp, err = cs.blocksDownloadPeerSelector.getNextPeer()
 	if err != nil {
 		if err == errPeerSelectorNoPeerPoolsAvailable {
@@ -18,11 +18,14 @@ package catchup
 
 import (
 	"context"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 
 	"github.com/algorand/go-algorand/components/mocks"
+	"github.com/algorand/go-algorand/config"
 	"github.com/algorand/go-algorand/crypto"
 	"github.com/algorand/go-algorand/data/basics"
 	"github.com/algorand/go-algorand/data/bookkeeping"
@@ -89,3 +92,57 @@ func TestCatchpointServicePeerRank(t *testing.T) {
 	err := cs.processStageLatestBlockDownload()
 	require.NoError(t, err)
 }
+
+type catchpointCatchupLocalFilesAccessorMock struct {
+	catchpointCatchupAccessorMock
+	catchpointFile string
+	blocksFile     string
+}
+
+func (m *catchpointCatchupLocalFilesAccessorMock) GetLocalFiles(ctx context.Context) (catchpointFile string, blocksFile string, err error) {
+	return m.catchpointFile, m.blocksFile, nil
+}
+
+func (m *catchpointCatchupLocalFilesAccessorMock) SetLocalFiles(ctx context.Context, catchpointFile string, blocksFile string) (err error) {
+	m.catchpointFile, m.blocksFile = catchpointFile, blocksFile
+	return nil
+}
+
+// TestCatchpointServiceResumeLocalFiles ensures that a catchup installed from local files keeps using them once resumed,
+// and is aborted if they are no longer available.
+func TestCatchpointServiceResumeLocalFiles(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	dir := t.TempDir()
+	catchpointFile := filepath.Join(dir, "ledger.catchpoint")
+	blocksFile := filepath.Join(dir, "ledger.blocks")
+	require.NoError(t, os.WriteFile(catchpointFile, nil, 0600))
+	require.NoError(t, os.WriteFile(blocksFile, nil, 0600))
+
+	l := catchpointCatchupLedger{}
+	a := catchpointCatchupLocalFilesAccessorMock{catchpointCatchupAccessorMock: catchpointCatchupAccessorMock{l: &l}}
+	localFiles, err := makeLocalCatchpointFiles(catchpointFile, blocksFile)
+	require.NoError(t, err)
+	cs := CatchpointCatchupService{ledgerAccessor: &a, ledger: &l, localFiles: localFiles, ctx: context.Background()}
+	require.NoError(t, cs.processStageInactive())
+	require.Equal(t, catchpointFile, a.catchpointFile)
+	require.Equal(t, blocksFile, a.blocksFile)
+
+	resumed, err := MakeResumedCatchpointCatchupService(context.Background(), nil, nil, nil, &a, config.GetDefaultLocal())
+	require.NoError(t, err)
+	require.NoError(t, resumed.localFilesErr)
+	require.Equal(t, localFiles, resumed.localFiles)
+
+	// the node starts up even if the files are gone, but the catchup does not fall back to the network.
+	require.NoError(t, os.Remove(blocksFile))
+	resumed, err = MakeResumedCatchpointCatchupService(context.Background(), nil, nil, nil, &a, config.GetDefaultLocal())
+	require.NoError(t, err)
+	require.ErrorIs(t, resumed.localFilesErr, os.ErrNotExist)
+
+	// and neither does a catchup that was started from the network.
+	a.catchpointFile, a.blocksFile = "", ""
+	resumed, err = MakeResumedCatchpointCatchupService(context.Background(), nil, nil, nil, &a, config.GetDefaultLocal())
+	require.NoError(t, err)
+	require.NoError(t, resumed.localFilesErr)
+	require.Nil(t, resumed.localFiles)
+}
@@ -18,13 +18,18 @@ package catchup
 
 import (
 	"archive/tar"
+	"bufio"
+	"compress/gzip"
 	"context"
 	"errors"
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
+	"os"
 	"path"
 	"strconv"
+	"sync"
 	"time"
 
 	"github.com/algorand/go-algorand/config"
@@ -46,10 +51,20 @@ const (
 	defaultMinCatchpointFileDownloadBytesPerSecond = 20 * 1024
 	// catchpointFileStreamReadSize defines the number of bytes we would attempt to read at each iteration from the incoming http data stream
 	catchpointFileStreamReadSize = 4096
+	// catchpointChunksPerRequest is the number of catchpoint file chunks requested from a single peer when downloading the file by ranges of chunks
+	catchpointChunksPerRequest = 16
 )
 
 var errNonHTTPPeer = fmt.Errorf("downloadLedger : non-HTTPPeer encountered")
 
+// errNoChunkRangeSupport is returned when a peer responds to a chunk range request with the entire catchpoint file, or
+// rejects it since its catchpoint file can only be streamed.
+var errNoChunkRangeSupport = errors.New("getPeerLedgerChunks : peer does not support catchpoint chunk ranges")
+
+// errCatchpointSectionProcessing is returned when a downloaded catchpoint file section could not be processed. Unlike
+// download errors, it could leave a partially written section in the staging tables, so the download can't be resumed.
+var errCatchpointSectionProcessing = errors.New("failed to process catchpoint file section")
+
 type ledgerFetcherReporter interface {
 	updateLedgerFetcherProgress(*ledger.CatchpointCatchupAccessorProgress)
 }
@@ -73,75 +88,278 @@ func makeLedgerFetcher(net network.GossipNode, accessor ledger.CatchpointCatchup
 	}
 }
 
-func (lf *ledgerFetcher) downloadLedger(ctx context.Context, peer network.Peer, round basics.Round) error {
+// downloadLedger downloads the catchpoint file from the given peer, streaming the sections that weren't processed yet
+// ( i.e. starting at progress.NextChunk ) into the catchup accessor.
+func (lf *ledgerFetcher) downloadLedger(ctx context.Context, peer network.Peer, round basics.Round, progress *ledger.CatchpointCatchupAccessorProgress) error {
 	httpPeer, ok := peer.(network.HTTPPeer)
 	if !ok {
 		return errNonHTTPPeer
 	}
-	return lf.getPeerLedger(ctx, httpPeer, round)
+	return lf.getPeerLedger(ctx, httpPeer, round, progress)
+}
+
+// downloadLedgerParallel downloads the catchpoint file by ranges of chunks, asking several peers for consecutive ranges
+// concurrently. The ranges are processed in order, so that the progress persisted by the catchup accessor always
+// describes a prefix of the catchpoint file.
+func (lf *ledgerFetcher) downloadLedgerParallel(ctx context.Context, peerSelector *peerSelector, round basics.Round, progress *ledger.CatchpointCatchupAccessorProgress) error {
+	for {
+		var pending sync.WaitGroup
+		windowCtx, windowCancel := context.WithCancel(ctx)
+		results := lf.requestChunkRanges(windowCtx, &pending, peerSelector, round, progress)
+		if len(results) == 0 {
+			windowCancel()
+			return errPeerSelectorNoPeerPoolsAvailable
+		}
+		done, fallbackPeer, err := lf.processChunkRanges(ctx, results, peerSelector, progress)
+		// cancel the outstanding requests, if any, wait for them to complete and discard the ranges that weren't processed.
+		windowCancel()
+		pending.Wait()
+		for _, resultCh := range results {
+			select {
+			case result := <-resultCh:
+				if result.file != nil {
+					discardChunkRange(result.file)
+				}
+			default:
+			}
+		}
+		if fallbackPeer != nil {
+			// the peer doesn't support chunk ranges; stream the rest of the file from it instead.
+			err = lf.downloadLedger(ctx, fallbackPeer.Peer, round, progress)
+			if err != nil {
+				peerSelector.rankPeer(fallbackPeer, peerRankDownloadFailed)
+			}
+			return err
+		}
+		if err != nil || done {
+			return err
+		}
+	}
+}
+
+// chunkRangeResult is the outcome of a single chunk range request made by downloadLedgerParallel.
+type chunkRangeResult struct {
+	psp *peerSelectorPeer
+	// first is the index of the first chunk of the range
+	first uint64
+	// file holds the downloaded sections until they are processed, so that the ranges waiting for the preceding
+	// ones don't have to be kept in memory.
+	file *os.File
+	err  error
+}
+
+// requestChunkRanges starts up to CatchpointDownloadParallelism concurrent requests for the consecutive chunk ranges
+// starting at progress.NextChunk. The returned channels are in file order, and each would receive a single result.
+// pending is marked done once the corresponding request completes.
+func (lf *ledgerFetcher) requestChunkRanges(ctx context.Context, pending *sync.WaitGroup, peerSelector *peerSelector, round basics.Round, progress *ledger.CatchpointCatchupAccessorProgress) []chan chunkRangeResult {
+	ranges := lf.config.CatchpointDownloadParallelism
+	if !progress.SeenHeader {
+		// until the content header is processed, we don't know how many chunks to expect.
+		ranges = 1
+	} else {
+		// don't ask for ranges that are known to be past the end of the file. The extra range accounts for
+		// sections other than the balances chunks.
+		remainingChunks := uint64(0)
+		if progress.TotalChunks > progress.ProcessedChunks {
+			remainingChunks = progress.TotalChunks - progress.ProcessedChunks
+		}
+		if remainingRanges := remainingChunks/catchpointChunksPerRequest + 1; remainingRanges < uint64(ranges) {
+			ranges = int(remainingRanges)
+		}
+	}
+	if ranges < 1 {
+		ranges = 1
+	}
+
+	results := make([]chan chunkRangeResult, 0, ranges)
+	for i := 0; i < ranges; i++ {
+		psp, err := peerSelector.getNextPeer()
+		if err != nil {
+			break
+		}
+		resultCh := make(chan chunkRangeResult, 1)
+		results = append(results, resultCh)
+		pending.Add(1)
+		go func(first uint64) {
+			defer pending.Done()
+			httpPeer, ok := psp.Peer.(network.HTTPPeer)
+			if !ok {
+				resultCh <- chunkRangeResult{psp: psp, first: first, err: errNonHTTPPeer}
+				return
+			}
+			file, err := lf.getPeerLedgerChunks(ctx, httpPeer, round, first, catchpointChunksPerRequest)
+			resultCh <- chunkRangeResult{psp: psp, first: first, file: file, err: err}
+		}(progress.NextChunk + uint64(i)*catchpointChunksPerRequest)
+	}
+	return results
 }
 
-func (lf *ledgerFetcher) getPeerLedger(ctx context.Context, peer network.HTTPPeer, round basics.Round) error {
+// processChunkRanges processes the results of the given chunk range requests in order. It returns done once the end
+// of the catchpoint file was reached, or a fallback peer if the peer asked for the next range doesn't support chunk ranges.
+func (lf *ledgerFetcher) processChunkRanges(ctx context.Context, results []chan chunkRangeResult, peerSelector *peerSelector, progress *ledger.CatchpointCatchupAccessorProgress) (done bool, fallbackPeer *peerSelectorPeer, err error) {
+	for _, resultCh := range results {
+		result := <-resultCh
+		if result.err == errNoChunkRangeSupport {
+			return false, result.psp, nil
+		}
+		if result.err != nil {
+			peerSelector.rankPeer(result.psp, peerRankDownloadFailed)
+			return false, nil, result.err
+		}
+		sectionsCount, err := lf.processChunkRange(ctx, result, progress)
+		if err != nil {
+			peerSelector.rankPeer(result.psp, peerRankInvalidDownload)
+			return false, nil, err
+		}
+		if sectionsCount < catchpointChunksPerRequest {
+			// the peer reached the end of the catchpoint file. Make sure that it didn't just cut it short.
+			if progress.SeenHeader && progress.ProcessedChunks >= progress.TotalChunks {
+				return true, nil, nil
+			}
+			peerSelector.rankPeer(result.psp, peerRankInvalidDownload)
+			return false, nil, fmt.Errorf("downloadLedgerParallel : catchpoint file ended after %d sections, with %d out of %d chunks", progress.NextChunk, progress.ProcessedChunks, progress.TotalChunks)
+		}
+	}
+	return false, nil, nil
+}
+
+// processChunkRange processes the sections of a downloaded chunk range, and discards its file. It returns the number
+// of sections in the range.
+func (lf *ledgerFetcher) processChunkRange(ctx context.Context, result chunkRangeResult, progress *ledger.CatchpointCatchupAccessorProgress) (sectionsCount uint64, err error) {
+	defer discardChunkRange(result.file)
+	err = lf.readCatchpointSections(result.file, func(sectionName string, sectionBytes []byte) error {
+		err := lf.processSection(ctx, result.first+sectionsCount, sectionName, sectionBytes, progress)
+		if err != nil {
+			return err
+		}
+		sectionsCount++
+		return nil
+	})
+	return sectionsCount, err
+}
+
+// processSection processes the catchpoint file section found at the given chunk index, and advances the download
+// cursor past it. The cursor is persisted along with the section's writes.
+func (lf *ledgerFetcher) processSection(ctx context.Context, chunk uint64, sectionName string, sectionBytes []byte, progress *ledger.CatchpointCatchupAccessorProgress) error {
+	cursor := progress.NextChunk
+	progress.NextChunk = chunk + 1
+	err := lf.processBalancesBlock(ctx, sectionName, sectionBytes, progress)
+	if err != nil {
+		progress.NextChunk = cursor
+		return fmt.Errorf("%w %s : %v", errCatchpointSectionProcessing, sectionName, err)
+	}
+	if lf.reporter != nil {
+		lf.reporter.updateLedgerFetcherProgress(progress)
+	}
+	return nil
+}
+
+// requestPeerLedger sends a catchpoint file request to the given peer, and validates the response headers.
+// On success, the caller is responsible for closing the response body.
+func (lf *ledgerFetcher) requestPeerLedger(ctx context.Context, peer network.HTTPPeer, round basics.Round, query url.Values) (*http.Response, error) {
 	parsedURL, err := network.ParseHostOrURL(peer.GetAddress())
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	parsedURL.Path = lf.net.SubstituteGenesisID(path.Join(parsedURL.Path, "/v1/{genesisID}/ledger/"+strconv.FormatUint(uint64(round), 36)))
+	if len(query) > 0 {
+		parsedURL.RawQuery = query.Encode()
+	}
 	ledgerURL := parsedURL.String()
 	lf.log.Debugf("ledger GET %#v peer %#v %T", ledgerURL, peer, peer)
 	request, err := http.NewRequest(http.MethodGet, ledgerURL, nil)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	timeoutContext, timeoutContextCancel := context.WithTimeout(ctx, lf.config.MaxCatchpointDownloadDuration)
-	defer timeoutContextCancel()
-	request = request.WithContext(timeoutContext)
+	request = request.WithContext(ctx)
 	network.SetUserAgentHeader(request.Header)
 	response, err := peer.GetHTTPClient().Do(request)
 	if err != nil {
 		lf.log.Debugf("getPeerLedger GET %v : %s", ledgerURL, err)
-		return err
+		return nil, err
 	}
-	defer response.Body.Close()
 
 	// check to see that we had no errors.
 	switch response.StatusCode {
 	case http.StatusOK:
 	case http.StatusNotFound: // server could not find a block with that round numbers.
-		return errNoLedgerForRound
+		response.Body.Close()
+		return nil, errNoLedgerForRound
+	case http.StatusRequestedRangeNotSatisfiable: // server has no chunk index for its catchpoint file.
+		response.Body.Close()
+		return nil, errNoChunkRangeSupport
 	default:
-		return fmt.Errorf("getPeerLedger error response status code %d", response.StatusCode)
+		response.Body.Close()
+		return nil, fmt.Errorf("getPeerLedger error response status code %d", response.StatusCode)
 	}
 
 	// at this point, we've already received the response headers. ensure that the
 	// response content type is what we'd like it to be.
 	contentTypes := response.Header["Content-Type"]
 	if len(contentTypes) != 1 {
-		err = fmt.Errorf("getPeerLedger : http ledger fetcher invalid content type count %d", len(contentTypes))
-		return err
+		response.Body.Close()
+		return nil, fmt.Errorf("getPeerLedger : http ledger fetcher invalid content type count %d", len(contentTypes))
 	}
 
 	if contentTypes[0] != rpcs.LedgerResponseContentType {
-		err = fmt.Errorf("getPeerLedger : http ledger fetcher response has an invalid content type : %s", contentTypes[0])
+		response.Body.Close()
+		return nil, fmt.Errorf("getPeerLedger : http ledger fetcher response has an invalid content type : %s", contentTypes[0])
+	}
+	return response, nil
+}
+
+// getPeerLedger streams the catchpoint file from the given peer, processing every section starting at progress.NextChunk.
+// Peers that support chunk ranges are asked to start the stream at that section, while the sections sent by older peers are skipped.
+func (lf *ledgerFetcher) getPeerLedger(ctx context.Context, peer network.HTTPPeer, round basics.Round, progress *ledger.CatchpointCatchupAccessorProgress) error {
+	query := url.Values{}
+	if progress.NextChunk > 0 {
+		query.Set(rpcs.LedgerServiceFirstChunkParam, strconv.FormatUint(progress.NextChunk, 10))
+	}
+
+	timeoutContext, timeoutContextCancel := context.WithTimeout(ctx, lf.config.MaxCatchpointDownloadDuration)
+	defer timeoutContextCancel()
+	response, err := lf.requestPeerLedger(timeoutContext, peer, round, query)
+	if err != nil {
 		return err
 	}
+	defer response.Body.Close()
 
-	// maxCatchpointFileChunkDownloadDuration is the maximum amount of time we would wait to download a single chunk off a catchpoint file
-	maxCatchpointFileChunkDownloadDuration := 2 * time.Minute
-	if lf.config.MinCatchpointFileDownloadBytesPerSecond > 0 {
-		maxCatchpointFileChunkDownloadDuration += maxCatchpointFileChunkSize * time.Second / time.Duration(lf.config.MinCatchpointFileDownloadBytesPerSecond)
-	} else {
-		maxCatchpointFileChunkDownloadDuration += maxCatchpointFileChunkSize * time.Second / defaultMinCatchpointFileDownloadBytesPerSecond
+	firstChunk := uint64(0)
+	if response.Header.Get(rpcs.LedgerResponseFirstChunkHeader) != "" {
+		firstChunk = progress.NextChunk
 	}
 
-	watchdogReader := util.MakeWatchdogStreamReader(response.Body, catchpointFileStreamReadSize, 2*maxCatchpointFileChunkSize, maxCatchpointFileChunkDownloadDuration)
-	defer watchdogReader.Close()
-	tarReader := tar.NewReader(watchdogReader)
-	var downloadProgress ledger.CatchpointCatchupAccessorProgress
-	var writeDuration time.Duration
+	return lf.processCatchpointStream(ctx, response.Body, firstChunk, progress)
+}
+
+// loadLedgerFile processes the sections of a catchpoint file stored on the local file system, starting at
+// progress.NextChunk. Both compressed and uncompressed catchpoint files are supported.
+func (lf *ledgerFetcher) loadLedgerFile(ctx context.Context, catchpointFile string, progress *ledger.CatchpointCatchupAccessorProgress) error {
+	file, err := os.Open(catchpointFile)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	reader := bufio.NewReader(file)
+	var body io.Reader = reader
+	if prefix, err := reader.Peek(2); err == nil && prefix[0] == 0x1F && prefix[1] == 0x8B {
+		gzipReader, err := gzip.NewReader(reader)
+		if err != nil {
+			return err
+		}
+		defer gzipReader.Close()
+		body = gzipReader
+	}
+	return lf.processCatchpointStream(ctx, body, 0, progress)
+}
 
+// processCatchpointStream processes the sections of the given catchpoint file stream, which starts at the chunk index
+// firstChunk. The sections preceding progress.NextChunk are skipped.
+func (lf *ledgerFetcher) processCatchpointStream(ctx context.Context, body io.Reader, firstChunk uint64, progress *ledger.CatchpointCatchupAccessorProgress) error {
+	var writeDuration time.Duration
 	printLogsFunc := func() {
 		lf.log.Infof(
 			"writing balances to disk took %d seconds, "+
@@ -149,18 +367,110 @@ func (lf *ledgerFetcher) getPeerLedger(ctx context.Context, peer network.HTTPPee
 				"writing hashes to disk took %d seconds, "+
 				"writing kv pairs to disk took %d seconds, "+
 				"total duration is %d seconds",
-			downloadProgress.BalancesWriteDuration/time.Second,
-			downloadProgress.CreatablesWriteDuration/time.Second,
-			downloadProgress.HashesWriteDuration/time.Second,
-			downloadProgress.KVWriteDuration/time.Second,
+			progress.BalancesWriteDuration/time.Second,
+			progress.CreatablesWriteDuration/time.Second,
+			progress.HashesWriteDuration/time.Second,
+			progress.KVWriteDuration/time.Second,
 			writeDuration/time.Second)
 	}
 
+	chunk := firstChunk
+	err := lf.readCatchpointSections(body, func(sectionName string, sectionBytes []byte) error {
+		defer func() { chunk++ }()
+		if chunk < progress.NextChunk {
+			return nil
+		}
+		start := time.Now()
+		err := lf.processSection(ctx, chunk, sectionName, sectionBytes, progress)
+		if err != nil {
+			return err
+		}
+		writeDuration += time.Since(start)
+		return nil
+	})
+	if err == nil {
+		printLogsFunc()
+	}
+	return err
+}
+
+// getPeerLedgerChunks asks the given peer for a range of catchpoint file chunks, and stores the received sections in a
+// temporary file, which the caller is responsible for discarding. Less sections than requested are stored when the range
+// reaches the end of the file. If the peer doesn't support chunk ranges, errNoChunkRangeSupport is returned.
+func (lf *ledgerFetcher) getPeerLedgerChunks(ctx context.Context, peer network.HTTPPeer, round basics.Round, first, count uint64) (*os.File, error) {
+	query := url.Values{}
+	query.Set(rpcs.LedgerServiceFirstChunkParam, strconv.FormatUint(first, 10))
+	query.Set(rpcs.LedgerServiceChunkCountParam, strconv.FormatUint(count, 10))
+
+	timeoutContext, timeoutContextCancel := context.WithTimeout(ctx, lf.config.MaxCatchpointDownloadDuration)
+	defer timeoutContextCancel()
+	response, err := lf.requestPeerLedger(timeoutContext, peer, round, query)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	if response.Header.Get(rpcs.LedgerResponseFirstChunkHeader) != strconv.FormatUint(first, 10) {
+		return nil, errNoChunkRangeSupport
+	}
+
+	file, err := os.CreateTemp("", "catchpointchunks-*.tar")
+	if err != nil {
+		return nil, err
+	}
+	// the sections are validated while being read, and written one at a time.
+	tarWriter := tar.NewWriter(file)
+	received := uint64(0)
+	err = lf.readCatchpointSections(response.Body, func(sectionName string, sectionBytes []byte) error {
+		if received >= count {
+			return fmt.Errorf("getPeerLedgerChunks received more than the %d requested chunks", count)
+		}
+		received++
+		err := tarWriter.WriteHeader(&tar.Header{Name: sectionName, Mode: 0600, Size: int64(len(sectionBytes))})
+		if err != nil {
+			return err
+		}
+		_, err = tarWriter.Write(sectionBytes)
+		return err
+	})
+	if err == nil {
+		err = tarWriter.Close()
+	}
+	if err == nil {
+		_, err = file.Seek(0, io.SeekStart)
+	}
+	if err != nil {
+		discardChunkRange(file)
+		return nil, err
+	}
+	return file, nil
+}
+
+// discardChunkRange closes and removes the temporary file of a downloaded chunk range.
+func discardChunkRange(file *os.File) {
+	file.Close()
+	os.Remove(file.Name())
+}
+
+// readCatchpointSections reads the tar entries of the given catchpoint file stream, calling handler for each one of them.
+// The stream is guarded by a watchdog, so that a stalled peer would not hold the download indefinitely.
+func (lf *ledgerFetcher) readCatchpointSections(body io.Reader, handler func(sectionName string, sectionBytes []byte) error) error {
+	// maxCatchpointFileChunkDownloadDuration is the maximum amount of time we would wait to download a single chunk off a catchpoint file
+	maxCatchpointFileChunkDownloadDuration := 2 * time.Minute
+	if lf.config.MinCatchpointFileDownloadBytesPerSecond > 0 {
+		maxCatchpointFileChunkDownloadDuration += maxCatchpointFileChunkSize * time.Second / time.Duration(lf.config.MinCatchpointFileDownloadBytesPerSecond)
+	} else {
+		maxCatchpointFileChunkDownloadDuration += maxCatchpointFileChunkSize * time.Second / defaultMinCatchpointFileDownloadBytesPerSecond
+	}
+
+	watchdogReader := util.MakeWatchdogStreamReader(body, catchpointFileStreamReadSize, 2*maxCatchpointFileChunkSize, maxCatchpointFileChunkDownloadDuration)
+	defer watchdogReader.Close()
+	tarReader := tar.NewReader(watchdogReader)
+
 	for {
 		header, err := tarReader.Next()
 		if err != nil {
 			if err == io.EOF {
-				printLogsFunc()
 				return nil
 			}
 			return err
@@ -173,22 +483,15 @@ func (lf *ledgerFetcher) getPeerLedger(ctx context.Context, peer network.HTTPPee
 		if err != nil {
 			return err
 		}
-		start := time.Now()
-		err = lf.processBalancesBlock(ctx, header.Name, balancesBlockBytes, &downloadProgress)
+		err = handler(header.Name, balancesBlockBytes)
 		if err != nil {
 			return err
 		}
-		writeDuration += time.Since(start)
-		if lf.reporter != nil {
-			lf.reporter.updateLedgerFetcherProgress(&downloadProgress)
-		}
 		if err = watchdogReader.Reset(); err != nil {
 			if err == io.EOF {
-				printLogsFunc()
 				return nil
 			}
-			err = fmt.Errorf("getPeerLedger received the following error while reading the catchpoint file : %v", err)
-			return err
+			return fmt.Errorf("getPeerLedger received the following error while reading the catchpoint file : %v", err)
 		}
 	}
 }
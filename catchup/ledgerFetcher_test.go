@@ -17,10 +17,16 @@
 package catchup
 
 import (
+	"archive/tar"
+	"compress/gzip"
 	"context"
 	"fmt"
+	"io"
 	"net"
 	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -30,6 +36,8 @@ import (
 	"github.com/algorand/go-algorand/data/basics"
 	"github.com/algorand/go-algorand/ledger"
 	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/network"
+	"github.com/algorand/go-algorand/rpcs"
 	"github.com/algorand/go-algorand/test/partitiontest"
 )
 
@@ -44,7 +52,7 @@ func TestNoPeersAvailable(t *testing.T) {
 
 	lf := makeLedgerFetcher(&mocks.MockNetwork{}, &mocks.MockCatchpointCatchupAccessor{}, logging.TestingLog(t), &dummyLedgerFetcherReporter{}, config.GetDefaultLocal())
 	peer := &lf // The peer is an opaque interface.. we can add anything as a Peer.
-	err := lf.downloadLedger(context.Background(), peer, basics.Round(0))
+	err := lf.downloadLedger(context.Background(), peer, basics.Round(0), &ledger.CatchpointCatchupAccessorProgress{})
 	require.Equal(t, errNonHTTPPeer, err)
 }
 
@@ -53,7 +61,7 @@ func TestNonParsableAddress(t *testing.T) {
 
 	lf := makeLedgerFetcher(&mocks.MockNetwork{}, &mocks.MockCatchpointCatchupAccessor{}, logging.TestingLog(t), &dummyLedgerFetcherReporter{}, config.GetDefaultLocal())
 	peer := testHTTPPeer(":def")
-	err := lf.getPeerLedger(context.Background(), &peer, basics.Round(0))
+	err := lf.getPeerLedger(context.Background(), &peer, basics.Round(0), &ledger.CatchpointCatchupAccessorProgress{})
 	require.Error(t, err)
 }
 
@@ -83,22 +91,233 @@ func TestLedgerFetcherErrorResponseHandling(t *testing.T) {
 
 	lf := makeLedgerFetcher(&mocks.MockNetwork{}, &mocks.MockCatchpointCatchupAccessor{}, logging.TestingLog(t), &dummyLedgerFetcherReporter{}, config.GetDefaultLocal())
 	peer := testHTTPPeer(listener.Addr().String())
-	err = lf.getPeerLedger(context.Background(), &peer, basics.Round(0))
+	err = lf.getPeerLedger(context.Background(), &peer, basics.Round(0), &ledger.CatchpointCatchupAccessorProgress{})
 	require.Equal(t, errNoLedgerForRound, err)
 
 	httpServerResponse = http.StatusInternalServerError
-	err = lf.getPeerLedger(context.Background(), &peer, basics.Round(0))
+	err = lf.getPeerLedger(context.Background(), &peer, basics.Round(0), &ledger.CatchpointCatchupAccessorProgress{})
 	require.Equal(t, fmt.Errorf("getPeerLedger error response status code %d", httpServerResponse), err)
 
+	httpServerResponse = http.StatusRequestedRangeNotSatisfiable
+	_, err = lf.getPeerLedgerChunks(context.Background(), &peer, basics.Round(0), 1, catchpointChunksPerRequest)
+	require.Equal(t, errNoChunkRangeSupport, err)
+
 	httpServerResponse = http.StatusOK
-	err = lf.getPeerLedger(context.Background(), &peer, basics.Round(0))
+	err = lf.getPeerLedger(context.Background(), &peer, basics.Round(0), &ledger.CatchpointCatchupAccessorProgress{})
 	require.Equal(t, fmt.Errorf("getPeerLedger : http ledger fetcher invalid content type count %d", 0), err)
 
 	contentTypes = []string{"applications/one", "applications/two"}
-	err = lf.getPeerLedger(context.Background(), &peer, basics.Round(0))
+	err = lf.getPeerLedger(context.Background(), &peer, basics.Round(0), &ledger.CatchpointCatchupAccessorProgress{})
 	require.Equal(t, fmt.Errorf("getPeerLedger : http ledger fetcher invalid content type count %d", len(contentTypes)), err)
 
 	contentTypes = []string{"applications/one"}
-	err = lf.getPeerLedger(context.Background(), &peer, basics.Round(0))
+	err = lf.getPeerLedger(context.Background(), &peer, basics.Round(0), &ledger.CatchpointCatchupAccessorProgress{})
 	require.Equal(t, fmt.Errorf("getPeerLedger : http ledger fetcher response has an invalid content type : %s", contentTypes[0]), err)
 }
+
+// sectionsRecordingAccessor records the processed catchpoint file sections, updating the progress the same way the ledger's accessor does.
+type sectionsRecordingAccessor struct {
+	mocks.MockCatchpointCatchupAccessor
+	totalChunks uint64
+	sections    []string
+}
+
+func (a *sectionsRecordingAccessor) ProcessStagingBalances(ctx context.Context, sectionName string, bytes []byte, progress *ledger.CatchpointCatchupAccessorProgress) error {
+	if sectionName == ledger.CatchpointContentFileName {
+		progress.SeenHeader = true
+		progress.TotalChunks = a.totalChunks
+	} else {
+		progress.ProcessedChunks++
+	}
+	a.sections = append(a.sections, sectionName)
+	return nil
+}
+
+// chunkRangeSupport describes how the test catchpoint file server handles chunk range requests.
+type chunkRangeSupport int
+
+const (
+	// chunkRangesUnsupported makes the server return the entire file, as older servers do.
+	chunkRangesUnsupported chunkRangeSupport = iota
+	// chunkRangesSupported makes the server honor the chunk range query arguments, as rpcs.LedgerService does for
+	// catchpoint files with a chunk index.
+	chunkRangesSupported
+	// chunkRangesOpenEnded makes the server reject ranges with a chunk count, as rpcs.LedgerService does for catchpoint
+	// files without a chunk index.
+	chunkRangesOpenEnded
+)
+
+func (r chunkRangeSupport) String() string {
+	switch r {
+	case chunkRangesSupported:
+		return "supported"
+	case chunkRangesOpenEnded:
+		return "open ended"
+	default:
+		return "unsupported"
+	}
+}
+
+// startCatchpointFileServer serves a catchpoint file made of the given sections, handling chunk range requests as
+// described by rangeSupport.
+func startCatchpointFileServer(t *testing.T, sections []string, rangeSupport chunkRangeSupport) string {
+	mux := http.NewServeMux()
+	s := &http.Server{
+		Handler: mux,
+	}
+	listener, err := net.Listen("tcp", "localhost:")
+	require.NoError(t, err)
+	go s.Serve(listener)
+	t.Cleanup(func() {
+		s.Close()
+		listener.Close()
+	})
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, req *http.Request) {
+		first, count := uint64(0), uint64(len(sections))
+		if rangeSupport != chunkRangesUnsupported {
+			query := req.URL.Query()
+			if rangeSupport == chunkRangesOpenEnded && query.Get(rpcs.LedgerServiceChunkCountParam) != "" {
+				w.WriteHeader(http.StatusRequestedRangeNotSatisfiable)
+				return
+			}
+			if firstStr := query.Get(rpcs.LedgerServiceFirstChunkParam); firstStr != "" {
+				first, _ = strconv.ParseUint(firstStr, 10, 64)
+				w.Header().Set(rpcs.LedgerResponseFirstChunkHeader, firstStr)
+			}
+			if countStr := query.Get(rpcs.LedgerServiceChunkCountParam); countStr != "" {
+				count, _ = strconv.ParseUint(countStr, 10, 64)
+			}
+		}
+		w.Header().Set("Content-Type", rpcs.LedgerResponseContentType)
+		tarWriter := tar.NewWriter(w)
+		defer tarWriter.Close()
+		for i := first; i < first+count && i < uint64(len(sections)); i++ {
+			data := []byte(sections[i])
+			tarWriter.WriteHeader(&tar.Header{Name: sections[i], Mode: 0600, Size: int64(len(data))})
+			tarWriter.Write(data)
+		}
+	})
+	return listener.Addr().String()
+}
+
+func TestLedgerFetcherParallelDownload(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	const totalChunks = 5*catchpointChunksPerRequest + 3
+	sections := []string{ledger.CatchpointContentFileName}
+	for i := 1; i <= totalChunks; i++ {
+		sections = append(sections, fmt.Sprintf("balances.%d.msgpack", i))
+	}
+
+	cfg := config.GetDefaultLocal()
+	cfg.CatchpointDownloadParallelism = 3
+	// the downloaded ranges are stored in temporary files until they are processed.
+	tempDir := t.TempDir()
+	t.Setenv("TMPDIR", tempDir)
+
+	for _, rangeSupport := range []chunkRangeSupport{chunkRangesSupported, chunkRangesUnsupported, chunkRangesOpenEnded} {
+		rangeSupport := rangeSupport
+		t.Run(fmt.Sprintf("ranges %v", rangeSupport), func(t *testing.T) {
+			addr := startCatchpointFileServer(t, sections, rangeSupport)
+			peerA, peerB := testHTTPPeer(addr), testHTTPPeer(addr)
+			peers := []network.Peer{&peerA, &peerB}
+			peerSelector := makePeerSelector(
+				makePeersRetrieverStub(func(options ...network.PeerOption) []network.Peer { return peers }),
+				[]peerClass{{initialRank: peerRankInitialFirstPriority, peerClass: network.PeersPhonebookRelays}},
+			)
+
+			// download the entire file.
+			accessor := &sectionsRecordingAccessor{totalChunks: totalChunks}
+			lf := makeLedgerFetcher(&mocks.MockNetwork{}, accessor, logging.TestingLog(t), &dummyLedgerFetcherReporter{}, cfg)
+			var progress ledger.CatchpointCatchupAccessorProgress
+			err := lf.downloadLedgerParallel(context.Background(), peerSelector, basics.Round(0), &progress)
+			require.NoError(t, err)
+			require.Equal(t, sections, accessor.sections)
+			require.Equal(t, uint64(len(sections)), progress.NextChunk)
+			tempFiles, err := os.ReadDir(tempDir)
+			require.NoError(t, err)
+			require.Empty(t, tempFiles)
+
+			// resume a download that was interrupted after a few sections.
+			const processedSections = catchpointChunksPerRequest + 2
+			accessor = &sectionsRecordingAccessor{totalChunks: totalChunks}
+			lf = makeLedgerFetcher(&mocks.MockNetwork{}, accessor, logging.TestingLog(t), &dummyLedgerFetcherReporter{}, cfg)
+			progress = ledger.CatchpointCatchupAccessorProgress{
+				SeenHeader:      true,
+				TotalChunks:     totalChunks,
+				NextChunk:       processedSections,
+				ProcessedChunks: processedSections - 1,
+			}
+			err = lf.downloadLedgerParallel(context.Background(), peerSelector, basics.Round(0), &progress)
+			require.NoError(t, err)
+			require.Equal(t, sections[processedSections:], accessor.sections)
+		})
+	}
+}
+
+func TestLedgerFetcherParallelDownloadTruncated(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	// the header claims more chunks than the file holds.
+	sections := []string{ledger.CatchpointContentFileName, "balances.1.msgpack", "balances.2.msgpack"}
+	addr := startCatchpointFileServer(t, sections, chunkRangesSupported)
+	peer := testHTTPPeer(addr)
+	peerSelector := makePeerSelector(
+		makePeersRetrieverStub(func(options ...network.PeerOption) []network.Peer { return []network.Peer{&peer} }),
+		[]peerClass{{initialRank: peerRankInitialFirstPriority, peerClass: network.PeersPhonebookRelays}},
+	)
+
+	accessor := &sectionsRecordingAccessor{totalChunks: 5}
+	lf := makeLedgerFetcher(&mocks.MockNetwork{}, accessor, logging.TestingLog(t), &dummyLedgerFetcherReporter{}, config.GetDefaultLocal())
+	var progress ledger.CatchpointCatchupAccessorProgress
+	err := lf.downloadLedgerParallel(context.Background(), peerSelector, basics.Round(0), &progress)
+	require.Error(t, err)
+	require.Equal(t, sections, accessor.sections)
+	require.Equal(t, uint64(len(sections)), progress.NextChunk)
+}
+
+func TestLedgerFetcherLoadLedgerFile(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	sections := []string{ledger.CatchpointContentFileName, "balances.1.msgpack", "balances.2.msgpack", "balances.3.msgpack"}
+	for _, compressed := range []bool{true, false} {
+		compressed := compressed
+		t.Run(fmt.Sprintf("compressed=%v", compressed), func(t *testing.T) {
+			catchpointFile := filepath.Join(t.TempDir(), "ledger.catchpoint")
+			file, err := os.Create(catchpointFile)
+			require.NoError(t, err)
+			var writer io.WriteCloser = file
+			if compressed {
+				writer = gzip.NewWriter(file)
+			}
+			tarWriter := tar.NewWriter(writer)
+			for _, section := range sections {
+				data := []byte(section)
+				require.NoError(t, tarWriter.WriteHeader(&tar.Header{Name: section, Mode: 0600, Size: int64(len(data))}))
+				_, err = tarWriter.Write(data)
+				require.NoError(t, err)
+			}
+			require.NoError(t, tarWriter.Close())
+			require.NoError(t, writer.Close())
+			if compressed {
+				require.NoError(t, file.Close())
+			}
+
+			accessor := &sectionsRecordingAccessor{totalChunks: uint64(len(sections) - 1)}
+			lf := makeLedgerFetcher(&mocks.MockNetwork{}, accessor, logging.TestingLog(t), &dummyLedgerFetcherReporter{}, config.GetDefaultLocal())
+			var progress ledger.CatchpointCatchupAccessorProgress
+			err = lf.loadLedgerFile(context.Background(), catchpointFile, &progress)
+			require.NoError(t, err)
+			require.Equal(t, sections, accessor.sections)
+
+			// sections that were already processed are skipped.
+			accessor = &sectionsRecordingAccessor{totalChunks: uint64(len(sections) - 1)}
+			lf = makeLedgerFetcher(&mocks.MockNetwork{}, accessor, logging.TestingLog(t), &dummyLedgerFetcherReporter{}, config.GetDefaultLocal())
+			progress = ledger.CatchpointCatchupAccessorProgress{SeenHeader: true, NextChunk: 2, ProcessedChunks: 1}
+			err = lf.loadLedgerFile(context.Background(), catchpointFile, &progress)
+			require.NoError(t, err)
+			require.Equal(t, sections[2:], accessor.sections)
+		})
+	}
+}
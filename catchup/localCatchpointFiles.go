@@ -0,0 +1,153 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package catchup
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/rpcs"
+)
+
+// localCatchpointFiles is the source of an offline catchpoint catchup. Instead of downloading the catchpoint file
+// and the blocks preceding the catchpoint round from the network, they are read off the local file system.
+type localCatchpointFiles struct {
+	// catchpointFile is the absolute path of the catchpoint file, either compressed or not.
+	catchpointFile string
+	// blocksFile is the absolute path of a file containing a sequence of msgpack encoded rpcs.EncodedBlockCert entries,
+	// as served by the block service.
+	blocksFile string
+	// offsets holds the offset of each of the blocks within blocksFile, indexed by round.
+	offsets map[basics.Round]int64
+}
+
+// makeLocalCatchpointFiles creates a local catchpoint files source, after checking that both files exist.
+func makeLocalCatchpointFiles(catchpointFile, blocksFile string) (*localCatchpointFiles, error) {
+	paths := []string{catchpointFile, blocksFile}
+	for i, path := range paths {
+		absPath, err := filepath.Abs(path)
+		if err != nil {
+			return nil, err
+		}
+		info, err := os.Stat(absPath)
+		if err != nil {
+			return nil, err
+		}
+		if info.IsDir() {
+			return nil, fmt.Errorf("%s is a directory", absPath)
+		}
+		paths[i] = absPath
+	}
+	return &localCatchpointFiles{
+		catchpointFile: paths[0],
+		blocksFile:     paths[1],
+	}, nil
+}
+
+// countingReader is a buffered reader keeping track of the number of bytes consumed from it.
+type countingReader struct {
+	*bufio.Reader
+	offset int64
+}
+
+func (cr *countingReader) Read(p []byte) (n int, err error) {
+	n, err = cr.Reader.Read(p)
+	cr.offset += int64(n)
+	return
+}
+
+func (cr *countingReader) ReadByte() (b byte, err error) {
+	b, err = cr.Reader.ReadByte()
+	if err == nil {
+		cr.offset++
+	}
+	return
+}
+
+func (cr *countingReader) UnreadByte() (err error) {
+	err = cr.Reader.UnreadByte()
+	if err == nil {
+		cr.offset--
+	}
+	return
+}
+
+// indexBlocks scans the blocks file once, recording the offset of each of the blocks it contains. The blocks themselves
+// are decoded one at a time and discarded, so that the blocks file never has to fit in memory.
+func (lcf *localCatchpointFiles) indexBlocks() error {
+	file, err := os.Open(lcf.blocksFile)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	offsets := make(map[basics.Round]int64)
+	reader := &countingReader{Reader: bufio.NewReader(file)}
+	decoder := protocol.NewDecoder(reader)
+	for {
+		offset := reader.offset
+		var entry rpcs.EncodedBlockCert
+		err = decoder.Decode(&entry)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("unable to decode block #%d of %s : %w", len(offsets), lcf.blocksFile, err)
+		}
+		offsets[entry.Block.Round()] = offset
+	}
+	lcf.offsets = offsets
+	return nil
+}
+
+// block returns the block of the given round, indexing the blocks file on first use.
+func (lcf *localCatchpointFiles) block(round basics.Round) (*bookkeeping.Block, error) {
+	if lcf.offsets == nil {
+		if err := lcf.indexBlocks(); err != nil {
+			return nil, err
+		}
+	}
+	offset, ok := lcf.offsets[round]
+	if !ok {
+		return nil, fmt.Errorf("block %d is missing from %s", round, lcf.blocksFile)
+	}
+
+	file, err := os.Open(lcf.blocksFile)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	_, err = file.Seek(offset, io.SeekStart)
+	if err != nil {
+		return nil, err
+	}
+	var entry rpcs.EncodedBlockCert
+	err = protocol.NewDecoder(bufio.NewReader(file)).Decode(&entry)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode block %d of %s : %w", round, lcf.blocksFile, err)
+	}
+	if entry.Block.Round() != round {
+		return nil, fmt.Errorf("%s has changed : found block %d instead of block %d", lcf.blocksFile, entry.Block.Round(), round)
+	}
+	return &entry.Block, nil
+}
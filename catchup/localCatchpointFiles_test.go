@@ -0,0 +1,69 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package catchup
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/rpcs"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestLocalCatchpointFilesBlocks(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	dir := t.TempDir()
+	catchpointFile := filepath.Join(dir, "ledger.catchpoint")
+	blocksFile := filepath.Join(dir, "ledger.blocks")
+	require.NoError(t, os.WriteFile(catchpointFile, nil, 0600))
+
+	// the blocks file is missing.
+	_, err := makeLocalCatchpointFiles(catchpointFile, blocksFile)
+	require.ErrorIs(t, err, os.ErrNotExist)
+
+	// the blocks are not required to be ordered by round, and vary in size.
+	var blocksBytes []byte
+	for _, rnd := range []basics.Round{15, 16, 17, 18, 19, 20, 10, 11, 12, 13, 14} {
+		var entry rpcs.EncodedBlockCert
+		entry.Block.BlockHeader.Round = rnd
+		entry.Block.BlockHeader.GenesisID = strings.Repeat("x", int(rnd)*100)
+		blocksBytes = append(blocksBytes, protocol.EncodeReflect(&entry)...)
+	}
+	require.NoError(t, os.WriteFile(blocksFile, blocksBytes, 0600))
+
+	lcf, err := makeLocalCatchpointFiles(catchpointFile, blocksFile)
+	require.NoError(t, err)
+	for rnd := basics.Round(10); rnd <= 20; rnd++ {
+		var blk *bookkeeping.Block
+		blk, err = lcf.block(rnd)
+		require.NoError(t, err)
+		require.Equal(t, rnd, blk.Round())
+		require.Equal(t, strings.Repeat("x", int(rnd)*100), blk.GenesisID())
+	}
+	_, err = lcf.block(9)
+	require.Error(t, err)
+	_, err = lcf.block(21)
+	require.Error(t, err)
+}
@@ -0,0 +1,674 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/crypto/merkletrie"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/ledger"
+	"github.com/algorand/go-algorand/ledger/store/trackerdb"
+	"github.com/algorand/go-algorand/ledger/store/trackerdb/pebbledbdriver"
+	"github.com/algorand/go-algorand/ledger/store/trackerdb/pgdriver"
+	"github.com/algorand/go-algorand/ledger/store/trackerdb/sqlitedriver"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+// sqliteFileHeader is the header every sqlite database file starts with
+const sqliteFileHeader = "SQLite format 3\x00"
+
+var diffStagingTables bool
+
+func init() {
+	diffCmd.Flags().StringVarP(&outFileName, "output", "o", "", "Specify an outfile for the diff report ( i.e. catchpoint.diff.txt )")
+	diffCmd.Flags().BoolVarP(&diffStagingTables, "staging", "s", false, "Specify whether to look in the catchpoint staging or regular tables of the given tracker stores. (default false)")
+}
+
+var diffCmd = &cobra.Command{
+	Use:   "diff [catchpoint file or tracker store] [catchpoint file or tracker store]",
+	Short: "Compare two catchpoint files or ledger tracker stores",
+	Long:  "Compare two catchpoint files or ledger tracker stores, reporting the accounts, resources and key-value pairs that differ between the two along with the first diverging merkle trie subtree. A tracker store is either a sqlite database file, a pebble store directory or a postgres connection URL",
+	Args:  cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		outFile := os.Stdout
+		var err error
+		if outFileName != "" {
+			outFile, err = os.OpenFile(outFileName, os.O_RDWR|os.O_TRUNC|os.O_CREATE, 0755)
+			if err != nil {
+				reportErrorf("Unable to create file '%s' : %v", outFileName, err)
+			}
+			defer outFile.Close()
+		}
+
+		tempDir, err := os.MkdirTemp("", "catchpointdiff")
+		if err != nil {
+			reportErrorf("Unable to create temporary directory : %v", err)
+		}
+		defer os.RemoveAll(tempDir)
+
+		var sources [2]diffSource
+		for i, arg := range args {
+			sources[i], err = openDiffSource(arg, filepath.Join(tempDir, fmt.Sprintf("ledger%d", i)))
+			if err != nil {
+				reportErrorf("Unable to open '%s' : %v", arg, err)
+			}
+			defer sources[i].store.Close()
+		}
+		err = diffDatabases(context.Background(), sources[0], sources[1], outFile)
+		if err != nil {
+			reportErrorf("Unable to compare '%s' and '%s' : %v", args[0], args[1], err)
+		}
+	},
+}
+
+// diffSource is a ledger tracker store compared by the diff command. Catchpoint files are loaded into the staging
+// tables of a temporary ledger before being compared.
+type diffSource struct {
+	name    string
+	store   trackerdb.TrackerStore
+	staging bool
+}
+
+// openDiffSource opens the given catchpoint file, sqlite tracker database, pebble tracker store directory or postgres
+// connection URL for comparison. Catchpoint files are loaded into a ledger created at ledgerPath.
+func openDiffSource(path string, ledgerPath string) (diffSource, error) {
+	if strings.HasPrefix(path, "postgres://") || strings.HasPrefix(path, "postgresql://") {
+		name := path
+		if u, err := url.Parse(path); err == nil {
+			name = u.Redacted()
+		}
+		store, err := pgdriver.OpenTrackerPGStore(path)
+		if err != nil {
+			return diffSource{}, err
+		}
+		return makeDiffSource(name, store, diffStagingTables)
+	}
+
+	stats, err := os.Stat(path)
+	if err != nil {
+		return diffSource{}, err
+	}
+	if stats.IsDir() {
+		// pebble creates missing stores on open, so make sure there is one first
+		_, err = os.Stat(filepath.Join(path, "CURRENT"))
+		if err != nil {
+			return diffSource{}, fmt.Errorf("not a pebble tracker store: %w", err)
+		}
+		store, err := pebbledbdriver.Open(path, false)
+		if err != nil {
+			return diffSource{}, err
+		}
+		return makeDiffSource(path, store, diffStagingTables)
+	}
+
+	isDatabase, err := isSQLiteDatabase(path)
+	if err != nil {
+		return diffSource{}, err
+	}
+	if isDatabase {
+		version, err := getVersion(path, diffStagingTables)
+		if err != nil {
+			return diffSource{}, err
+		}
+		// the merkle trie hashes are calculated the same way starting tracker db version 6 and catchpoint file version 6.
+		// staging tables of catchpoints older than version 7 don't record their version.
+		if !diffStagingTables && version < 6 || diffStagingTables && version != 0 && version < ledger.CatchpointFileVersionV6 {
+			return diffSource{}, fmt.Errorf("unsupported version %d", version)
+		}
+		store, err := sqlitedriver.OpenTrackerSQLStore(path, false)
+		if err != nil {
+			return diffSource{}, err
+		}
+		return diffSource{name: path, store: store, staging: diffStagingTables}, nil
+	}
+
+	err = loadCatchpointFileForDiff(path, ledgerPath)
+	if err != nil {
+		return diffSource{}, err
+	}
+	store, err := sqlitedriver.OpenTrackerSQLStore(ledgerPath+".tracker.sqlite", false)
+	if err != nil {
+		return diffSource{}, err
+	}
+	return diffSource{name: path, store: store, staging: true}, nil
+}
+
+// makeDiffSource compares the given pebble or postgres store. Such stores are always created with the latest tracker db
+// version, so only the version of their staging tables is checked.
+func makeDiffSource(name string, store trackerdb.TrackerStore, staging bool) (diffSource, error) {
+	if staging {
+		crw, err := store.MakeCatchpointReaderWriter()
+		if err != nil {
+			store.Close()
+			return diffSource{}, err
+		}
+		version, err := crw.ReadCatchpointStateUint64(context.Background(), trackerdb.CatchpointStateCatchupVersion)
+		if err != nil {
+			store.Close()
+			return diffSource{}, err
+		}
+		if version != 0 && version < ledger.CatchpointFileVersionV6 {
+			store.Close()
+			return diffSource{}, fmt.Errorf("unsupported version %d", version)
+		}
+	}
+	return diffSource{name: name, store: store, staging: staging}, nil
+}
+
+func isSQLiteDatabase(path string) (bool, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return false, err
+	}
+	defer file.Close()
+
+	header := make([]byte, len(sqliteFileHeader))
+	_, err = io.ReadFull(file, header)
+	if err == io.EOF || err == io.ErrUnexpectedEOF {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return string(header) == sqliteFileHeader, nil
+}
+
+// loadCatchpointFileForDiff loads the given catchpoint file into the staging tables of a new ledger, and builds its merkle trie.
+func loadCatchpointFileForDiff(catchpointFileName string, ledgerPath string) error {
+	stats, err := os.Stat(catchpointFileName)
+	if err != nil {
+		return err
+	}
+	if stats.Size() == 0 {
+		return fmt.Errorf("empty file")
+	}
+
+	l, err := openCatchpointLedger(ledgerPath)
+	if err != nil {
+		return err
+	}
+	defer l.Close()
+
+	ctx := context.Background()
+	catchupAccessor := ledger.MakeCatchpointCatchupAccessor(l, logging.Base())
+	err = catchupAccessor.ResetStagingBalances(ctx, true)
+	if err != nil {
+		return err
+	}
+
+	reader, err := os.Open(catchpointFileName)
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+
+	_, err = loadCatchpointIntoDatabase(ctx, catchupAccessor, reader, stats.Size())
+	if err != nil {
+		return err
+	}
+	return catchupAccessor.BuildMerkleTrie(ctx, func(uint64, uint64) {})
+}
+
+// diffBatchSize is the number of accounts read at once out of the compared stores.
+const diffBatchSize = 4096
+
+// errDiffRollback rolls back the transactions the compared stores are read in.
+var errDiffRollback = errors.New("diff completed")
+
+// diffRecordID identifies an account, an account's resource or a key-value pair, regardless of its content.
+type diffRecordID struct {
+	kind trackerdb.HashKind
+	addr basics.Address
+	aidx basics.CreatableIndex
+	key  string
+}
+
+func (id diffRecordID) String() string {
+	switch id.kind {
+	case trackerdb.KvHK:
+		return "kv " + prettyKey([]byte(id.key))
+	case trackerdb.AccountHK:
+		return "account " + id.addr.String()
+	case trackerdb.AssetHK:
+		return fmt.Sprintf("asset %d of %s", id.aidx, id.addr)
+	default:
+		return fmt.Sprintf("app %d of %s", id.aidx, id.addr)
+	}
+}
+
+func (id diffRecordID) less(other diffRecordID) bool {
+	if id.kind != other.kind {
+		return id.kind < other.kind
+	}
+	if c := bytes.Compare(id.addr[:], other.addr[:]); c != 0 {
+		return c < 0
+	}
+	if id.aidx != other.aidx {
+		return id.aidx < other.aidx
+	}
+	return id.key < other.key
+}
+
+// diffHashesIter streams the merkle trie hashes of a tracker store in increasing order, which is the order of the
+// leaves of the trie. The hashes of the accounts and resources come out of a trackerdb.OrderedAccountsIter, while the
+// hashes of the key-value pairs, which the store keeps ordered by key, are sorted in memory.
+type diffHashesIter struct {
+	accounts      trackerdb.OrderedAccountsIter
+	accountsDone  bool
+	accountHashes [][]byte
+	kvHashes      [][]byte
+	hash          []byte
+	err           error
+}
+
+func makeDiffHashesIter(ctx context.Context, tx trackerdb.TransactionScope) (*diffHashesIter, error) {
+	kvs, err := tx.MakeKVsIter(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer kvs.Close()
+	var kvHashes [][]byte
+	for kvs.Next() {
+		k, v, err := kvs.KeyValue()
+		if err != nil {
+			return nil, err
+		}
+		kvHashes = append(kvHashes, trackerdb.KvHashBuilderV6(string(k), v))
+	}
+	sort.Slice(kvHashes, func(i, j int) bool { return bytes.Compare(kvHashes[i], kvHashes[j]) < 0 })
+
+	return &diffHashesIter{
+		accounts: tx.MakeOrderedAccountsIter(diffBatchSize),
+		kvHashes: kvHashes,
+	}, nil
+}
+
+// Next advances the iterator, returning false once all the hashes were returned or an error occurred.
+func (iter *diffHashesIter) Next(ctx context.Context) bool {
+	for len(iter.accountHashes) == 0 && !iter.accountsDone {
+		accts, _, err := iter.accounts.Next(ctx)
+		if err == sql.ErrNoRows {
+			// the ordered accounts iterator returns sql.ErrNoRows once all the accounts were returned
+			iter.accountsDone = true
+			break
+		} else if err != nil {
+			iter.err = err
+			return false
+		}
+		for _, acct := range accts {
+			iter.accountHashes = append(iter.accountHashes, acct.Digest)
+		}
+	}
+
+	switch {
+	case len(iter.accountHashes) == 0 && len(iter.kvHashes) == 0:
+		return false
+	case len(iter.kvHashes) == 0 || len(iter.accountHashes) != 0 && bytes.Compare(iter.accountHashes[0], iter.kvHashes[0]) < 0:
+		iter.hash, iter.accountHashes = iter.accountHashes[0], iter.accountHashes[1:]
+	default:
+		iter.hash, iter.kvHashes = iter.kvHashes[0], iter.kvHashes[1:]
+	}
+	return true
+}
+
+// Hash returns the current hash.
+func (iter *diffHashesIter) Hash() []byte {
+	return iter.hash
+}
+
+// Err returns the error, if any, that stopped the iteration.
+func (iter *diffHashesIter) Err() error {
+	return iter.err
+}
+
+// Close releases the resources held by the iterator.
+func (iter *diffHashesIter) Close(ctx context.Context) error {
+	return iter.accounts.Close(ctx)
+}
+
+// recordsDiff is the outcome of comparing the records of two sources.
+type recordsDiff struct {
+	// hashes holds the merkle trie hashes found only in the left and right sources respectively
+	hashes [2]map[string]bool
+	// records holds the records these hashes were calculated from, along with a printable form of their content
+	records [2]map[diffRecordID]string
+	// first is the lowest differing hash, which is the first diverging leaf of the merkle tries
+	first     []byte
+	firstSide int
+	firstID   diffRecordID
+	// divergencePath is the path of the deepest merkle trie node shared by both tries, on the way to the first diverging record
+	divergencePath []byte
+	diverged       bool
+}
+
+func makeRecordsDiff() *recordsDiff {
+	d := &recordsDiff{}
+	for side := range d.hashes {
+		d.hashes[side] = make(map[string]bool)
+		d.records[side] = make(map[diffRecordID]string)
+	}
+	return d
+}
+
+// merge walks over the two hash streams side by side, adding the hashes found only on one side. The deepest trie node
+// the first of them shares with the other side is its longest common prefix with its neighbors on the other side: the
+// last hash found on both sides and the next hash of the other side.
+func (d *recordsDiff) merge(ctx context.Context, iters [2]*diffHashesIter) error {
+	var last []byte
+	more := [2]bool{iters[0].Next(ctx), iters[1].Next(ctx)}
+	for more[0] || more[1] {
+		var c int
+		switch {
+		case !more[1]:
+			c = -1
+		case !more[0]:
+			c = 1
+		default:
+			c = bytes.Compare(iters[0].Hash(), iters[1].Hash())
+		}
+		if c == 0 {
+			last = iters[0].Hash()
+			more[0] = iters[0].Next(ctx)
+			more[1] = iters[1].Next(ctx)
+			continue
+		}
+
+		side := 0
+		if c > 0 {
+			side = 1
+		}
+		hash := iters[side].Hash()
+		if !d.diverged {
+			depth := commonPrefixLength(hash, last)
+			if more[1-side] {
+				if l := commonPrefixLength(hash, iters[1-side].Hash()); l > depth {
+					depth = l
+				}
+			}
+			d.first = hash
+			d.firstSide = side
+			d.divergencePath = hash[:depth]
+			d.diverged = true
+		}
+		d.hashes[side][string(hash)] = true
+		more[side] = iters[side].Next(ctx)
+	}
+	for _, iter := range iters {
+		if err := iter.Err(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func commonPrefixLength(a, b []byte) int {
+	i := 0
+	for i < len(a) && i < len(b) && a[i] == b[i] {
+		i++
+	}
+	return i
+}
+
+// resolve finds the records the differing hashes of one side were calculated from, with a pass over its accounts,
+// resources and key-value pairs.
+func (d *recordsDiff) resolve(ctx context.Context, tx trackerdb.TransactionScope, side int) error {
+	hashes := d.hashes[side]
+	if len(hashes) == 0 {
+		return nil
+	}
+	add := func(hash []byte, id diffRecordID, value interface{}) error {
+		if !hashes[string(hash)] {
+			return nil
+		}
+		if d.diverged && side == d.firstSide && bytes.Equal(hash, d.first) {
+			d.firstID = id
+		}
+		if buf, ok := value.([]byte); ok {
+			d.records[side][id] = base64.StdEncoding.EncodeToString(buf)
+			return nil
+		}
+		jsonData, err := json.Marshal(value)
+		d.records[side][id] = string(jsonData)
+		return err
+	}
+
+	accounts := tx.MakeEncodedAccoutsBatchIter()
+	defer accounts.Close()
+	for {
+		bals, _, err := accounts.Next(ctx, diffBatchSize, ledger.ResourcesPerCatchpointFileChunk)
+		if err != nil {
+			return err
+		}
+		if len(bals) == 0 {
+			break
+		}
+		for _, bal := range bals {
+			var data trackerdb.BaseAccountData
+			err = protocol.Decode(bal.AccountData, &data)
+			if err != nil {
+				return err
+			}
+			hash := trackerdb.AccountHashBuilderV6(bal.Address, &data, bal.AccountData)
+			err = add(hash, diffRecordID{kind: trackerdb.AccountHK, addr: bal.Address}, data.GetAccountData())
+			if err != nil {
+				return err
+			}
+
+			for aidx, buf := range bal.Resources {
+				var rd trackerdb.ResourcesData
+				err = protocol.Decode(buf, &rd)
+				if err != nil {
+					return err
+				}
+				cidx := basics.CreatableIndex(aidx)
+				hash, err = trackerdb.ResourcesHashBuilderV6(&rd, bal.Address, cidx, rd.UpdateRound, buf)
+				if err != nil {
+					return err
+				}
+				err = add(hash, diffRecordID{kind: trackerdb.HashKind(hash[trackerdb.HashKindEncodingIndex]), addr: bal.Address, aidx: cidx}, rd)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	kvs, err := tx.MakeKVsIter(ctx)
+	if err != nil {
+		return err
+	}
+	defer kvs.Close()
+	for kvs.Next() {
+		k, v, err := kvs.KeyValue()
+		if err != nil {
+			return err
+		}
+		err = add(trackerdb.KvHashBuilderV6(string(k), v), diffRecordID{kind: trackerdb.KvHK, key: string(k)}, v)
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(d.records[side]) != len(hashes) {
+		return fmt.Errorf("found the records of %d out of %d differing hashes", len(d.records[side]), len(hashes))
+	}
+	return nil
+}
+
+// diffRecords compares the records of the two stores in the order of their merkle trie hashes, and then finds the
+// records of the differing hashes.
+func diffRecords(ctx context.Context, txs [2]trackerdb.TransactionScope) (*recordsDiff, error) {
+	var iters [2]*diffHashesIter
+	for side, tx := range txs {
+		iter, err := makeDiffHashesIter(ctx, tx)
+		if err != nil {
+			return nil, err
+		}
+		defer iter.Close(ctx)
+		iters[side] = iter
+	}
+
+	d := makeRecordsDiff()
+	err := d.merge(ctx, iters)
+	if err != nil {
+		return nil, err
+	}
+	for side, iter := range iters {
+		err = iter.Close(ctx)
+		if err != nil {
+			return nil, err
+		}
+		err = d.resolve(ctx, txs[side], side)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return d, nil
+}
+
+// trieRoot returns the root hash of the merkle trie stored in the given store.
+func trieRoot(tx trackerdb.TransactionScope) (crypto.Digest, error) {
+	committer, err := tx.MakeMerkleCommitter(false)
+	if err != nil {
+		return crypto.Digest{}, err
+	}
+	trie, err := merkletrie.MakeTrie(committer, trackerdb.TrieMemoryConfig)
+	if err != nil {
+		return crypto.Digest{}, err
+	}
+	return trie.RootHash()
+}
+
+// withDiffTransactions runs fn in a transaction of each of the sources, which is always rolled back: the ordered
+// accounts iterator keeps its ordering hashes in the store, and the staging tables are compared by applying them over
+// the regular ones.
+func withDiffTransactions(ctx context.Context, sources [2]diffSource, fn func(ctx context.Context, txs [2]trackerdb.TransactionScope) error) error {
+	var txs [2]trackerdb.TransactionScope
+	var run func(ctx context.Context, side int) error
+	run = func(ctx context.Context, side int) error {
+		if side == len(sources) {
+			err := fn(ctx, txs)
+			if err != nil {
+				return err
+			}
+			return errDiffRollback
+		}
+		return sources[side].store.TransactionContext(ctx, func(ctx context.Context, tx trackerdb.TransactionScope) error {
+			if sources[side].staging {
+				crw, err := tx.MakeCatchpointReaderWriter()
+				if err != nil {
+					return err
+				}
+				err = crw.ApplyCatchpointStagingBalances(ctx, 0, 0)
+				if err != nil {
+					return err
+				}
+			}
+			txs[side] = tx
+			return run(ctx, side+1)
+		})
+	}
+	err := run(ctx, 0)
+	if err == errDiffRollback {
+		return nil
+	}
+	return err
+}
+
+// diffDatabases compares the two given stores and writes the differences out.
+func diffDatabases(ctx context.Context, left, right diffSource, outFile *os.File) error {
+	fileWriter := bufio.NewWriterSize(outFile, 1024*1024)
+	defer fileWriter.Flush()
+
+	fmt.Fprintf(fileWriter, "Left: %s\nRight: %s\n", left.name, right.name)
+	return withDiffTransactions(ctx, [2]diffSource{left, right}, func(ctx context.Context, txs [2]trackerdb.TransactionScope) error {
+		leftRoot, err := trieRoot(txs[0])
+		if err != nil {
+			return err
+		}
+		rightRoot, err := trieRoot(txs[1])
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(fileWriter, "Left merkle trie root: %s\nRight merkle trie root: %s\n", leftRoot, rightRoot)
+
+		d, err := diffRecords(ctx, txs)
+		if err != nil {
+			return err
+		}
+		if !d.diverged {
+			fmt.Fprintf(fileWriter, "No differences found\n")
+			return nil
+		}
+		fmt.Fprintf(fileWriter, "First diverging merkle trie subtree: path %s (depth %d)\n", hex.EncodeToString(d.divergencePath), len(d.divergencePath))
+		fmt.Fprintf(fileWriter, "First diverging record: %s\n", d.firstID)
+
+		ids := make([]diffRecordID, 0, len(d.records[0])+len(d.records[1]))
+		for id := range d.records[0] {
+			ids = append(ids, id)
+		}
+		for id := range d.records[1] {
+			if _, ok := d.records[0][id]; !ok {
+				ids = append(ids, id)
+			}
+		}
+		sort.Slice(ids, func(i, j int) bool { return ids[i].less(ids[j]) })
+
+		counts := make(map[trackerdb.HashKind]int)
+		for _, id := range ids {
+			counts[id.kind]++
+			leftValue, inLeft := d.records[0][id]
+			rightValue, inRight := d.records[1][id]
+			switch {
+			case inLeft && inRight:
+				fmt.Fprintf(fileWriter, "%s : differs\n", id)
+			case inLeft:
+				fmt.Fprintf(fileWriter, "%s : only in left\n", id)
+			default:
+				fmt.Fprintf(fileWriter, "%s : only in right\n", id)
+			}
+			if inLeft {
+				fmt.Fprintf(fileWriter, "  left  : %s\n", leftValue)
+			}
+			if inRight {
+				fmt.Fprintf(fileWriter, "  right : %s\n", rightValue)
+			}
+		}
+		fmt.Fprintf(fileWriter, "Differing accounts: %d\nDiffering resources: %d\nDiffering key-value pairs: %d\n",
+			counts[trackerdb.AccountHK], counts[trackerdb.AssetHK]+counts[trackerdb.AppHK], counts[trackerdb.KvHK])
+		return nil
+	})
+}
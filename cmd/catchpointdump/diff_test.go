@@ -0,0 +1,272 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/ledger/store/trackerdb"
+	"github.com/algorand/go-algorand/ledger/store/trackerdb/pebbledbdriver"
+	"github.com/algorand/go-algorand/ledger/store/trackerdb/sqlitedriver"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+// diffTestAccount is an account of a generated catchpoint, holding the given amounts of assets
+type diffTestAccount struct {
+	addr   basics.Address
+	algos  uint64
+	assets map[basics.AssetIndex]uint64
+}
+
+// diffTestEngines open the tracker stores the diff tests compare
+var diffTestEngines = map[string]func(t *testing.T) trackerdb.TrackerStore{
+	"sqlite": func(t *testing.T) trackerdb.TrackerStore {
+		store, err := sqlitedriver.OpenTrackerSQLStore(filepath.Join(t.TempDir(), "tracker.sqlite"), false)
+		require.NoError(t, err)
+		return store
+	},
+	"pebble": func(t *testing.T) trackerdb.TrackerStore {
+		store, err := pebbledbdriver.Open(filepath.Join(t.TempDir(), "tracker.pebble"), false)
+		require.NoError(t, err)
+		return store
+	},
+}
+
+// makeDiffTestCatchpoint loads the given accounts and key-value pairs into the catchpoint staging tables of a new
+// tracker store, the same way catchpoint file chunks are loaded during catchup. It returns the merkle trie hashes
+// of the loaded records along with the store.
+func makeDiffTestCatchpoint(t *testing.T, engine string, accounts []diffTestAccount, kvs map[string]string) (diffSource, map[string]bool) {
+	store := diffTestEngines[engine](t)
+	t.Cleanup(store.Close)
+
+	hashes := make(map[string]bool)
+	err := store.Transaction(func(ctx context.Context, tx trackerdb.TransactionScope) error {
+		_, err := tx.Testing().AccountsInitLightTest(t, nil, config.Consensus[protocol.ConsensusCurrentVersion])
+		if err != nil {
+			return err
+		}
+		cw, err := tx.MakeCatchpointReaderWriter()
+		if err != nil {
+			return err
+		}
+		err = cw.ResetCatchpointStagingBalances(ctx, true)
+		if err != nil {
+			return err
+		}
+
+		var bals []trackerdb.NormalizedAccountBalance
+		for _, acct := range accounts {
+			bal := trackerdb.NormalizedAccountBalance{
+				Address:          acct.addr,
+				Resources:        make(map[basics.CreatableIndex]trackerdb.ResourcesData),
+				EncodedResources: make(map[basics.CreatableIndex][]byte),
+			}
+			bal.AccountData.SetAccountData(&basics.AccountData{
+				MicroAlgos: basics.MicroAlgos{Raw: acct.algos},
+			})
+			bal.AccountData.TotalAssets = uint64(len(acct.assets))
+			bal.EncodedAccountData = protocol.Encode(&bal.AccountData)
+			hashes[string(trackerdb.AccountHashBuilderV6(acct.addr, &bal.AccountData, bal.EncodedAccountData))] = true
+
+			for aidx, amount := range acct.assets {
+				cidx := basics.CreatableIndex(aidx)
+				rd := trackerdb.MakeResourcesData(0)
+				rd.SetAssetHolding(basics.AssetHolding{Amount: amount})
+				bal.Resources[cidx] = rd
+				bal.EncodedResources[cidx] = protocol.Encode(&rd)
+				hash, err := trackerdb.ResourcesHashBuilderV6(&rd, acct.addr, cidx, rd.UpdateRound, bal.EncodedResources[cidx])
+				if err != nil {
+					return err
+				}
+				hashes[string(hash)] = true
+			}
+			bals = append(bals, bal)
+		}
+		err = cw.WriteCatchpointStagingBalances(ctx, bals)
+		if err != nil {
+			return err
+		}
+
+		var keys, values, kvHashes [][]byte
+		for key, value := range kvs {
+			hash := trackerdb.KvHashBuilderV6(key, []byte(value))
+			keys = append(keys, []byte(key))
+			values = append(values, []byte(value))
+			kvHashes = append(kvHashes, hash)
+			hashes[string(hash)] = true
+		}
+		return cw.WriteCatchpointStagingKVs(ctx, keys, values, kvHashes)
+	})
+	require.NoError(t, err)
+
+	return diffSource{name: engine, store: store, staging: true}, hashes
+}
+
+// makeDiffTestCatchpoints generates two catchpoints, which have some records in common, some records found only in
+// one of them, and some records that differ between the two.
+func makeDiffTestCatchpoints(t *testing.T, engines [2]string) (sources [2]diffSource, hashes [2]map[string]bool) {
+	common := diffTestAccount{addr: basics.Address{1}, algos: 100, assets: map[basics.AssetIndex]uint64{10: 5}}
+	sources[0], hashes[0] = makeDiffTestCatchpoint(t, engines[0],
+		[]diffTestAccount{
+			common,
+			{addr: basics.Address{2}, algos: 200},
+			{addr: basics.Address{4}, algos: 300, assets: map[basics.AssetIndex]uint64{20: 1}},
+			{addr: basics.Address{5}, algos: 500},
+		},
+		map[string]string{"common": "v", "left": "l", "changed": "1"})
+	sources[1], hashes[1] = makeDiffTestCatchpoint(t, engines[1],
+		[]diffTestAccount{
+			common,
+			{addr: basics.Address{3}, algos: 400},
+			{addr: basics.Address{4}, algos: 300, assets: map[basics.AssetIndex]uint64{20: 2}},
+			{addr: basics.Address{5}, algos: 501},
+		},
+		map[string]string{"common": "v", "right": "r", "changed": "2"})
+	return sources, hashes
+}
+
+func diffTestRecords(t *testing.T, sources [2]diffSource) (d *recordsDiff) {
+	err := withDiffTransactions(context.Background(), sources, func(ctx context.Context, txs [2]trackerdb.TransactionScope) (err error) {
+		d, err = diffRecords(ctx, txs)
+		return err
+	})
+	require.NoError(t, err)
+	return d
+}
+
+func recordIDs(records map[diffRecordID]string) map[diffRecordID]bool {
+	ids := make(map[diffRecordID]bool)
+	for id := range records {
+		ids[id] = true
+	}
+	return ids
+}
+
+func TestDiffRecords(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	for _, engines := range [][2]string{{"sqlite", "sqlite"}, {"pebble", "pebble"}, {"sqlite", "pebble"}} {
+		engines := engines
+		t.Run(engines[0]+"-"+engines[1], func(t *testing.T) {
+			t.Parallel()
+
+			sources, hashes := makeDiffTestCatchpoints(t, engines)
+			d := diffTestRecords(t, sources)
+			require.True(t, d.diverged)
+
+			// only in left, only in right, and differing records
+			require.Equal(t, map[diffRecordID]bool{
+				{kind: trackerdb.AccountHK, addr: basics.Address{2}}:         true,
+				{kind: trackerdb.AccountHK, addr: basics.Address{5}}:         true,
+				{kind: trackerdb.AssetHK, addr: basics.Address{4}, aidx: 20}: true,
+				{kind: trackerdb.KvHK, key: "left"}:                          true,
+				{kind: trackerdb.KvHK, key: "changed"}:                       true,
+			}, recordIDs(d.records[0]))
+			require.Equal(t, map[diffRecordID]bool{
+				{kind: trackerdb.AccountHK, addr: basics.Address{3}}:         true,
+				{kind: trackerdb.AccountHK, addr: basics.Address{5}}:         true,
+				{kind: trackerdb.AssetHK, addr: basics.Address{4}, aidx: 20}: true,
+				{kind: trackerdb.KvHK, key: "right"}:                         true,
+				{kind: trackerdb.KvHK, key: "changed"}:                       true,
+			}, recordIDs(d.records[1]))
+			require.Equal(t, base64.StdEncoding.EncodeToString([]byte("l")), d.records[0][diffRecordID{kind: trackerdb.KvHK, key: "left"}])
+
+			// the first diverging record is the lowest hash found in only one of the two tries, and the deepest shared
+			// trie node on its way is its longest common prefix with the hashes of the other trie.
+			var differing []string
+			for i := range hashes {
+				for hash := range hashes[i] {
+					if !hashes[1-i][hash] {
+						differing = append(differing, hash)
+					}
+				}
+			}
+			sort.Strings(differing)
+			first := []byte(differing[0])
+			require.Equal(t, first, d.first)
+			other := hashes[0]
+			if hashes[0][differing[0]] {
+				other = hashes[1]
+			}
+			depth := 0
+			for hash := range other {
+				if l := commonPrefixLength(first, []byte(hash)); l > depth {
+					depth = l
+				}
+			}
+			require.Equal(t, first[:depth], d.divergencePath)
+			require.Contains(t, d.records[d.firstSide], d.firstID)
+		})
+	}
+}
+
+func TestDiffRecordsIdentical(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	left, _ := makeDiffTestCatchpoints(t, [2]string{"sqlite", "sqlite"})
+	right, _ := makeDiffTestCatchpoints(t, [2]string{"pebble", "pebble"})
+	d := diffTestRecords(t, [2]diffSource{left[0], right[0]})
+	require.False(t, d.diverged)
+	require.Empty(t, d.records[0])
+	require.Empty(t, d.records[1])
+}
+
+func TestDiffDatabases(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	sources, _ := makeDiffTestCatchpoints(t, [2]string{"sqlite", "pebble"})
+	diff := func() []byte {
+		outFile, err := os.CreateTemp(t.TempDir(), "diff.txt")
+		require.NoError(t, err)
+		defer outFile.Close()
+		err = diffDatabases(context.Background(), sources[0], sources[1], outFile)
+		require.NoError(t, err)
+		report, err := os.ReadFile(outFile.Name())
+		require.NoError(t, err)
+		return report
+	}
+
+	report := diff()
+	for _, line := range []string{
+		"account " + basics.Address{2}.String() + " : only in left\n",
+		"account " + basics.Address{3}.String() + " : only in right\n",
+		"account " + basics.Address{5}.String() + " : differs\n",
+		"asset 20 of " + basics.Address{4}.String() + " : differs\n",
+		"First diverging merkle trie subtree: path ",
+		"First diverging record: ",
+		"Differing accounts: 3\nDiffering resources: 1\nDiffering key-value pairs: 3\n",
+	} {
+		require.True(t, bytes.Contains(report, []byte(line)), line)
+	}
+
+	// the compared stores are left untouched, with their records still in the staging tables
+	require.Equal(t, report, diff())
+}
@@ -75,17 +75,7 @@ var fileCmd = &cobra.Command{
 		if catchpointSize == 0 {
 			reportErrorf("Empty file '%s' : %v", catchpointFile, err)
 		}
-		// TODO: store CurrentProtocol in catchpoint file header.
-		// As a temporary workaround use a current protocol version.
-		genesisInitState := ledgercore.InitState{
-			Block: bookkeeping.Block{BlockHeader: bookkeeping.BlockHeader{
-				UpgradeState: bookkeeping.UpgradeState{
-					CurrentProtocol: protocol.ConsensusCurrentVersion,
-				},
-			}},
-		}
-		cfg := config.GetDefaultLocal()
-		l, err := ledger.OpenLedger(logging.Base(), "./ledger", false, genesisInitState, cfg)
+		l, err := openCatchpointLedger("./ledger")
 		if err != nil {
 			reportErrorf("Unable to open ledger : %v", err)
 		}
@@ -143,6 +133,21 @@ var fileCmd = &cobra.Command{
 	},
 }
 
+// openCatchpointLedger opens a ledger at the given path, to be used for loading a catchpoint file into its staging tables.
+func openCatchpointLedger(ledgerPath string) (*ledger.Ledger, error) {
+	// TODO: store CurrentProtocol in catchpoint file header.
+	// As a temporary workaround use a current protocol version.
+	genesisInitState := ledgercore.InitState{
+		Block: bookkeeping.Block{BlockHeader: bookkeeping.BlockHeader{
+			UpgradeState: bookkeeping.UpgradeState{
+				CurrentProtocol: protocol.ConsensusCurrentVersion,
+			},
+		}},
+	}
+	cfg := config.GetDefaultLocal()
+	return ledger.OpenLedger(logging.Base(), ledgerPath, false, genesisInitState, cfg)
+}
+
 func printLoadCatchpointProgressLine(progress int, barLength int, dld int64) {
 	if barLength == 0 {
 		fmt.Printf(escapeCursorUp + escapeDeleteLine + "[ Done ] Loaded\n")
@@ -482,16 +487,17 @@ func printStateProofVerificationContext(databaseName string, stagingTables bool,
 	return nil
 }
 
-func printKeyValue(writer *bufio.Writer, key, value []byte) {
-	var pretty string
+// prettyKey formats a key-value store key, decoding box keys.
+func prettyKey(key []byte) string {
 	ai, rest, err := apps.SplitBoxKey(string(key))
 	if err == nil {
-		pretty = fmt.Sprintf("box(%d, %s)", ai, base64.StdEncoding.EncodeToString([]byte(rest)))
-	} else {
-		pretty = base64.StdEncoding.EncodeToString(key)
+		return fmt.Sprintf("box(%d, %s)", ai, base64.StdEncoding.EncodeToString([]byte(rest)))
 	}
+	return base64.StdEncoding.EncodeToString(key)
+}
 
-	fmt.Fprintf(writer, "%s : %v\n", pretty, base64.StdEncoding.EncodeToString(value))
+func printKeyValue(writer *bufio.Writer, key, value []byte) {
+	fmt.Fprintf(writer, "%s : %v\n", prettyKey(key), base64.StdEncoding.EncodeToString(value))
 }
 
 func printKeyValueStore(databaseName string, stagingTables bool, outFile *os.File) error {
@@ -66,6 +66,9 @@ var (
 	listAccountInfo    bool
 	onlyShowAssetIds   bool
 	partKeyIDToDelete  string
+	rekeyBack          bool
+	rekeyForce         bool
+	rekeyTxFile        string
 )
 
 func init() {
@@ -86,6 +89,7 @@ func init() {
 	accountCmd.AddCommand(accountMultisigCmd)
 	accountCmd.AddCommand(markNonparticipatingCmd)
 	accountCmd.AddCommand(deletePartKeyCmd)
+	accountCmd.AddCommand(rekeyCmd)
 
 	accountMultisigCmd.AddCommand(newMultisigCmd)
 	accountMultisigCmd.AddCommand(deleteMultisigCmd)
@@ -211,6 +215,19 @@ func init() {
 	markNonparticipatingCmd.Flags().MarkDeprecated("firstRound", "use --firstvalid instead")
 	markNonparticipatingCmd.Flags().MarkDeprecated("validRounds", "use --validrounds instead")
 
+	// rekey flags
+	rekeyCmd.Flags().StringVarP(&accountAddress, "address", "a", "", "Account address to rekey (required)")
+	rekeyCmd.MarkFlagRequired("address")
+	rekeyCmd.Flags().StringVarP(&rekeyToAddress, "to", "t", "", "Address of the key or multisig that will authorize transactions from the account")
+	rekeyCmd.Flags().BoolVar(&rekeyBack, "back", false, "Rekey the account back to its own key")
+	rekeyCmd.Flags().BoolVar(&rekeyForce, "force", false, "Rekey even if the wallet can't sign for the new authorizer")
+	rekeyCmd.Flags().Uint64VarP(&transactionFee, "fee", "f", 0, "The Fee to set on the rekey transaction (defaults to suggested fee)")
+	rekeyCmd.Flags().Uint64VarP(&firstValid, "firstvalid", "", 0, "FirstValid for the rekey transaction (0 for current)")
+	rekeyCmd.Flags().Uint64VarP(&numValidRounds, "validrounds", "v", 0, "The validity period for the rekey transaction")
+	rekeyCmd.Flags().Uint64Var(&lastValid, "lastvalid", 0, "The last round where the transaction may be committed to the ledger")
+	rekeyCmd.Flags().StringVarP(&rekeyTxFile, "txfile", "o", "", "Write the signed rekey transaction to this file, rather than posting to network")
+	rekeyCmd.Flags().BoolVarP(&noWaitAfterSend, "no-wait", "N", false, "Don't wait for transaction to commit")
+
 	dumpCmd.Flags().StringVarP(&dumpOutFile, "outfile", "o", "", "Save balance record to specified output file")
 	dumpCmd.Flags().StringVarP(&accountAddress, "address", "a", "", "Account address to retrieve balance (required)")
 	balanceCmd.MarkFlagRequired("address")
@@ -1472,3 +1489,104 @@ var markNonparticipatingCmd = &cobra.Command{
 		}
 	},
 }
+
+var rekeyCmd = &cobra.Command{
+	Use:   "rekey",
+	Short: "Change the key that authorizes transactions from an account",
+	Long: `Change the key or multisig that authorizes transactions from an account, by issuing a zero amount payment from the account to itself with its rekey-to field set. Use --to to rekey the account to another address, or --back to restore its own key.
+The transaction is signed by the account's current authorizer. To avoid locking the account, the wallet must hold the key or multisig of the new authorizer, unless --force is given.
+If the current authorizer is a multisig needing more signatures than the wallet can provide, write the transaction out with --txfile and add the remaining signatures with 'goal clerk multisig sign'.`,
+	Args: validateNoPosArgsFn,
+	Run: func(cmd *cobra.Command, args []string) {
+		checkTxValidityPeriodCmdFlags(cmd)
+
+		if rekeyBack == (rekeyToAddress != "") {
+			reportErrorln(errorRekeyTargetFlags)
+		}
+
+		dataDir := datadir.EnsureSingleDataDir()
+		accountList := makeAccountsList(dataDir)
+		account := accountList.getAddressByName(accountAddress)
+		accountAddr, err := basics.UnmarshalChecksumAddress(account)
+		if err != nil {
+			reportErrorf(errorParseAddr, err)
+		}
+
+		target := accountAddr
+		if !rekeyBack {
+			target, err = basics.UnmarshalChecksumAddress(rekeyToAddress)
+			if err != nil {
+				reportErrorf(errorParseAddr, err)
+			}
+		}
+
+		client := ensureFullClient(dataDir)
+		current, err := client.AccountAuthAddr(account)
+		if err != nil {
+			reportErrorf(errorRekeyAuthAddrLookup, account, err)
+		}
+		if current.IsZero() {
+			current = accountAddr
+		}
+		if current == target {
+			reportErrorf(errorRekeyAlreadyAuthorized, account, target)
+		}
+
+		wh, pw := ensureWalletHandleMaybePassword(dataDir, walletName, true)
+		addrs, err := client.ListAddresses(wh)
+		if err != nil {
+			reportErrorf(errorRequestFail, err)
+		}
+		walletAddrs := make(map[string]bool, len(addrs))
+		for _, addr := range addrs {
+			walletAddrs[addr] = true
+		}
+		if !rekeyForce && !walletAddrs[target.String()] {
+			reportErrorf(errorRekeyTargetNotInWallet, target)
+		}
+		if !walletAddrs[current.String()] {
+			reportErrorf(errorRekeyCurrentNotInWallet, account, current)
+		}
+
+		firstTxRound, lastTxRound, _, err := client.ComputeValidityRounds(firstValid, lastValid, numValidRounds)
+		if err != nil {
+			reportErrorf(errorConstructingTX, err)
+		}
+		utx, err := client.ConstructPayment(account, account, transactionFee, 0, nil, "", [32]byte{}, basics.Round(firstTxRound), basics.Round(lastTxRound))
+		if err != nil {
+			reportErrorf(errorConstructingTX, err)
+		}
+		utx.RekeyTo = target
+
+		// SignTransactionWithWallet signs with the current authorizer of the account
+		signedTxn, err := client.SignTransactionWithWallet(wh, pw, utx)
+		if err != nil {
+			reportErrorf(errorSigningTX, err)
+		}
+
+		if rekeyTxFile != "" {
+			err = writeSignedTxnsToFile([]transactions.SignedTxn{signedTxn}, rekeyTxFile)
+			if err != nil {
+				reportErrorf(fileWriteError, rekeyTxFile, err)
+			}
+			return
+		}
+
+		txid, err := client.BroadcastTransaction(signedTxn)
+		if err != nil {
+			reportErrorf(errorBroadcastingTX, err)
+		}
+		fmt.Printf("Transaction id for rekey transaction: %s\n", txid)
+
+		if noWaitAfterSend {
+			fmt.Println("Note: the authorizer will not change until transaction is finalized")
+			return
+		}
+
+		_, err = waitForCommit(client, txid, lastTxRound)
+		if err != nil {
+			reportErrorf("error waiting for transaction to be committed: %v", err)
+		}
+		reportInfof(infoRekeyedAccount, account, target)
+	},
+}
@@ -65,15 +65,22 @@ var (
 	protoVersion       string
 	rekeyToAddress     string
 	signerAddress      string
+	noAuthAddrLookup   bool
 	rawOutput          bool
 	requestFilename    string
 	requestOutFilename string
 
 	simulateAllowEmptySignatures  bool
 	simulateAllowMoreLogging      bool
+	simulateAllowUnnamedResources bool
 	simulateAllowMoreOpcodeBudget bool
 	simulateExtraOpcodeBudget     uint64
 	simulateEnableRequestTrace    bool
+	simulateStackChange           bool
+	simulateScratchChange         bool
+	simulateStateChange           bool
+	simulateFullTrace             bool
+	simulateRound                 uint64
 )
 
 func init() {
@@ -102,6 +109,7 @@ func init() {
 	sendCmd.Flags().StringSliceVar(&argB64Strings, "argb64", nil, "Base64 encoded args to pass to transaction logic")
 	sendCmd.Flags().StringVarP(&logicSigFile, "logic-sig", "L", "", "LogicSig to apply to transaction")
 	sendCmd.Flags().StringVar(&msigParams, "msig-params", "", "Multisig preimage parameters - [threshold] [Address 1] [Address 2] ...\nUsed to add the necessary fields in case the account was rekeyed to a multisig account")
+	sendCmd.Flags().BoolVar(&noAuthAddrLookup, "no-auth-lookup", false, "Sign with the sender key, without asking algod whether the account was rekeyed")
 	sendCmd.MarkFlagRequired("to")
 	sendCmd.MarkFlagRequired("amount")
 
@@ -117,6 +125,7 @@ func init() {
 	signCmd.Flags().StringVarP(&txFilename, "infile", "i", "", "Partially-signed transaction file to add signature to")
 	signCmd.Flags().StringVarP(&outFilename, "outfile", "o", "", "Filename for writing the signed transaction")
 	signCmd.Flags().StringVarP(&signerAddress, "signer", "S", "", "Address of key to sign with, if different from transaction \"from\" address due to rekeying")
+	signCmd.Flags().BoolVar(&noAuthAddrLookup, "no-auth-lookup", false, "Sign with the sender key, without asking algod whether the account was rekeyed, e.g. when signing offline")
 	signCmd.Flags().StringVarP(&programSource, "program", "p", "", "Program source to use as account logic")
 	signCmd.Flags().StringVarP(&logicSigFile, "logic-sig", "L", "", "LogicSig to apply to transaction")
 	signCmd.Flags().StringSliceVar(&argB64Strings, "argb64", nil, "Base64 encoded args to pass to transaction logic")
@@ -160,9 +169,15 @@ func init() {
 	simulateCmd.Flags().StringVarP(&outFilename, "result-out", "o", "", "Filename for writing simulation result")
 	simulateCmd.Flags().BoolVar(&simulateAllowEmptySignatures, "allow-empty-signatures", false, "Allow transactions without signatures to be simulated as if they had correct signatures")
 	simulateCmd.Flags().BoolVar(&simulateAllowMoreLogging, "allow-more-logging", false, "Lift the limits on log opcode during simulation")
+	simulateCmd.Flags().BoolVar(&simulateAllowUnnamedResources, "allow-unnamed-resources", false, "Allow access to unnamed resources during simulation, and report the ones accessed")
 	simulateCmd.Flags().BoolVar(&simulateAllowMoreOpcodeBudget, "allow-more-opcode-budget", false, "Apply max extra opcode budget for apps per transaction group (default 320000) during simulation")
 	simulateCmd.Flags().Uint64Var(&simulateExtraOpcodeBudget, "extra-opcode-budget", 0, "Apply extra opcode budget for apps per transaction group during simulation")
 	simulateCmd.Flags().BoolVar(&simulateEnableRequestTrace, "trace", false, "Enable simulation time execution trace of app calls")
+	simulateCmd.Flags().BoolVar(&simulateStackChange, "stack", false, "Report stack changes in the execution trace (implies --trace)")
+	simulateCmd.Flags().BoolVar(&simulateScratchChange, "scratch", false, "Report scratch slot changes in the execution trace (implies --trace)")
+	simulateCmd.Flags().BoolVar(&simulateStateChange, "state", false, "Report application state changes in the execution trace (implies --trace)")
+	simulateCmd.Flags().BoolVar(&simulateFullTrace, "full-trace", false, "Enable the execution trace with stack, scratch slot and application state changes")
+	simulateCmd.Flags().Uint64Var(&simulateRound, "round", 0, "Simulate against the ledger state as of this round, which must be one of the most recent rounds kept in memory by the node (default is the latest round)")
 }
 
 var clerkCmd = &cobra.Command{
@@ -403,6 +418,7 @@ var sendCmd = &cobra.Command{
 			}
 		}
 		client := ensureFullClient(dataDir)
+		client.SetAuthAddrLookup(!noAuthAddrLookup)
 		firstValid, lastValid, _, err = client.ComputeValidityRounds(firstValid, lastValid, numValidRounds)
 		if err != nil {
 			reportErrorf(err.Error())
@@ -477,8 +493,9 @@ var sendCmd = &cobra.Command{
 			}
 		}
 
-		// Handle the case where the user wants to send to an account that was rekeyed to a multisig account
-		if msigParams != "" {
+		// Handle the case where the user wants to send to an account that was rekeyed to a multisig account,
+		// unless the wallet already signed on behalf of that multisig
+		if msigParams != "" && stx.Msig.Blank() {
 			// Decode params
 			params := strings.Split(msigParams, " ")
 			if len(params) < 3 {
@@ -783,6 +800,7 @@ var signCmd = &cobra.Command{
 			// sign the usual way
 			dataDir := datadir.EnsureSingleDataDir()
 			client = ensureKmdClient(dataDir)
+			client.SetAuthAddrLookup(!noAuthAddrLookup)
 			wh, pw = ensureWalletHandleMaybePassword(dataDir, walletName, true)
 		} else if signerAddress != "" {
 			authAddr, err = basics.UnmarshalChecksumAddress(signerAddress)
@@ -1275,10 +1293,12 @@ var simulateCmd = &cobra.Command{
 						Txns: txgroup,
 					},
 				},
-				AllowEmptySignatures: simulateAllowEmptySignatures,
-				AllowMoreLogging:     simulateAllowMoreLogging,
-				ExtraOpcodeBudget:    simulateExtraOpcodeBudget,
-				ExecTraceConfig:      traceCmdOptionToSimulateTraceConfigModel(),
+				Round:                 basics.Round(simulateRound),
+				AllowEmptySignatures:  simulateAllowEmptySignatures,
+				AllowMoreLogging:      simulateAllowMoreLogging,
+				AllowUnnamedResources: simulateAllowUnnamedResources,
+				ExtraOpcodeBudget:     simulateExtraOpcodeBudget,
+				ExecTraceConfig:       traceCmdOptionToSimulateTraceConfigModel(),
 			}
 			err := writeFile(requestOutFilename, protocol.EncodeJSON(simulateRequest), 0600)
 			if err != nil {
@@ -1299,10 +1319,12 @@ var simulateCmd = &cobra.Command{
 						Txns: txgroup,
 					},
 				},
-				AllowEmptySignatures: simulateAllowEmptySignatures,
-				AllowMoreLogging:     simulateAllowMoreLogging,
-				ExtraOpcodeBudget:    simulateExtraOpcodeBudget,
-				ExecTraceConfig:      traceCmdOptionToSimulateTraceConfigModel(),
+				Round:                 basics.Round(simulateRound),
+				AllowEmptySignatures:  simulateAllowEmptySignatures,
+				AllowMoreLogging:      simulateAllowMoreLogging,
+				AllowUnnamedResources: simulateAllowUnnamedResources,
+				ExtraOpcodeBudget:     simulateExtraOpcodeBudget,
+				ExecTraceConfig:       traceCmdOptionToSimulateTraceConfigModel(),
 			}
 			simulateResponse, responseErr = client.SimulateTransactions(simulateRequest)
 		} else {
@@ -1364,7 +1386,13 @@ func decodeTxnsFromFile(file string) []transactions.SignedTxn {
 }
 
 func traceCmdOptionToSimulateTraceConfigModel() simulation.ExecTraceConfig {
+	stack := simulateStackChange || simulateFullTrace
+	scratch := simulateScratchChange || simulateFullTrace
+	state := simulateStateChange || simulateFullTrace
 	return simulation.ExecTraceConfig{
-		Enable: simulateEnableRequestTrace,
+		Enable:  simulateEnableRequestTrace || stack || scratch || state,
+		Stack:   stack,
+		Scratch: scratch,
+		State:   state,
 	}
 }
@@ -45,6 +45,12 @@ const (
 	errExistingPartKey             = "Account already has a participation key valid at least until roundLastValid (%d) - current is %d"
 	errorSeedConversion            = "Got private key for account %s, but was unable to convert to seed: %s"
 	errorMnemonicConversion        = "Got seed for account %s, but was unable to convert to mnemonic: %s"
+	errorRekeyTargetFlags          = "Exactly one of --to or --back is required"
+	errorRekeyAuthAddrLookup       = "Couldn't look up the authorizer of account %s: %s"
+	errorRekeyAlreadyAuthorized    = "Account %s is already authorized by %s"
+	errorRekeyTargetNotInWallet    = "The wallet holds neither the key nor the multisig of %s; rekeying to it could lock the account. Use --force to rekey anyway"
+	errorRekeyCurrentNotInWallet   = "Account %s is authorized by %s, whose key or multisig is not in the wallet"
+	infoRekeyedAccount             = "Account %s is now authorized by %s"
 
 	// KMD
 	infoKMDStopped        = "Stopped kmd"
@@ -92,6 +98,10 @@ const (
 	errorCatchpointLabelMissing             = "A catchpoint argument is needed: %s: %s"
 	errorUnableToLookupCatchpointLabel      = "Unable to fetch catchpoint label"
 	errorTooManyCatchpointLabels            = "The catchup command expect a single catchpoint"
+	errorCatchupFilesMissing                = "Both --file and --blocks are needed to catch up from local files"
+	errorCatchupFilesLabelMissing           = "A catchpoint argument is needed to catch up from local files"
+	infoNodeConsensusState                  = "Round: %d\nPeriod: %d\nStep: %s\nTime in step: %.1fs\nTime in round: %.1fs\nNext timeout: %.1fs"
+	errorNodeConsensusState                 = "Cannot retrieve the agreement state: %s"
 
 	// Asset
 	malformedMetadataHash = "Cannot base64-decode metadata hash %s: %s"
@@ -188,6 +198,13 @@ const (
 	errorBadRecoveredKey         = "Recovered invalid key"
 	errorFailedToReadResponse    = "Couldn't read response: %s"
 	errorFailedToReadPassword    = "Couldn't read password: %s"
+	infoBackedUpWallet           = "Wrote backup of wallet '%s' to %s"
+	infoBackupPasswordPrompt     = "Please enter the password the backup was encrypted with: "
+	infoRestoredWallet           = "Restored wallet '%s'"
+	errorCouldntBackupWallet     = "Couldn't back up wallet: %s"
+	errorCouldntRestoreWallet    = "Couldn't restore wallet: %s"
+	infoUpgradedWalletKDF        = "Re-encrypted wallet '%s' with the configured key derivation function"
+	errorCouldntUpgradeWalletKDF = "Couldn't re-encrypt wallet: %s"
 
 	// Commands
 	infoPasswordPrompt       = "Please enter the password for wallet '%s': "
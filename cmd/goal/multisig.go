@@ -107,9 +107,18 @@ var addSigCmd = &cobra.Command{
 				reportErrorf(txDecodeError, txFilename, err)
 			}
 
+			// Sign on behalf of the multisig the sender was rekeyed to, if any
+			if stxn.AuthAddr.IsZero() && stxn.Msig.Blank() {
+				stxn.AuthAddr = lookupAuthAddr(dataDir, stxn.Txn.Sender)
+			}
+
 			var msig crypto.MultisigSig
 			if noSig {
-				multisigInfo, err := client.LookupMultisigAccount(wh, stxn.Txn.Sender.String())
+				msigAccount := stxn.Txn.Sender
+				if !stxn.AuthAddr.IsZero() {
+					msigAccount = stxn.AuthAddr
+				}
+				multisigInfo, err := client.LookupMultisigAccount(wh, msigAccount.String())
 				if err != nil {
 					reportErrorf(msigLookupError, err)
 				}
@@ -323,11 +332,36 @@ func populateBlankMultisig(client libgoal.Client, dataDir string, walletName str
 		return stxn
 	}
 
-	multisigInfo, err := client.LookupMultisigAccount(wh, stxn.Txn.Sender.String())
+	// If the sender was rekeyed, the multisig to populate is the one it was
+	// rekeyed to
+	msigAccount := stxn.Txn.Sender
+	authAddr, err := client.AccountAuthAddr(stxn.Txn.Sender.String())
+	if err == nil && !authAddr.IsZero() {
+		msigAccount = authAddr
+	}
+
+	multisigInfo, err := client.LookupMultisigAccount(wh, msigAccount.String())
 	if err != nil {
 		return stxn
 	}
 
 	stxn.Msig, _ = msigInfoToMsig(multisigInfo)
+	if msigAccount != stxn.Txn.Sender {
+		stxn.AuthAddr = msigAccount
+	}
 	return stxn
 }
+
+// lookupAuthAddr returns the address the account was rekeyed to, or the zero
+// address if it wasn't rekeyed or algod can't be reached
+func lookupAuthAddr(dataDir string, account basics.Address) basics.Address {
+	client, err := getGoalClient(dataDir, libgoal.AlgodClient)
+	if err != nil {
+		return basics.Address{}
+	}
+	authAddr, err := client.AccountAuthAddr(account.String())
+	if err != nil {
+		return basics.Address{}
+	}
+	return authAddr
+}
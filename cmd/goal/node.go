@@ -62,6 +62,9 @@ var newNodeFullConfig bool
 var watchMillisecond uint64
 var abortCatchup bool
 var fastCatchupForce bool
+var catchupCatchpointFile string
+var catchupBlocksFile string
+var consensusJSON bool
 
 const catchpointURL = "https://algorand-catchpoints.s3.us-east-2.amazonaws.com/channel/%s/latest.catchpoint"
 
@@ -77,6 +80,7 @@ func init() {
 	nodeCmd.AddCommand(waitCmd)
 	nodeCmd.AddCommand(createCmd)
 	nodeCmd.AddCommand(catchupCmd)
+	nodeCmd.AddCommand(consensusCmd)
 	// Once the server-side implementation of the shutdown command is ready, we should enable this one.
 	//nodeCmd.AddCommand(shutdownCmd)
 
@@ -108,9 +112,12 @@ func init() {
 	pendingTxnsCmd.Flags().Uint64VarP(&maxPendingTransactions, "maxPendingTxn", "m", 0, "Cap the number of txns to fetch")
 	waitCmd.Flags().Uint32VarP(&waitSec, "waittime", "w", 5, "Time (in seconds) to wait for node to make progress")
 	statusCmd.Flags().Uint64VarP(&watchMillisecond, "watch", "w", 0, "Time (in milliseconds) between two successive status updates")
+	consensusCmd.Flags().BoolVar(&consensusJSON, "json", false, "Print the agreement state as JSON")
 
 	catchupCmd.Flags().BoolVarP(&abortCatchup, "abort", "x", false, "Aborts the current catchup process")
 	catchupCmd.Flags().BoolVar(&fastCatchupForce, "force", false, "Forces fast catchup with implicit catchpoint to start without a consent prompt")
+	catchupCmd.Flags().StringVarP(&catchupCatchpointFile, "file", "f", "", "Catchpoint file to catch up from, instead of downloading it from the network (requires --blocks)")
+	catchupCmd.Flags().StringVar(&catchupBlocksFile, "blocks", "", "Blocks file containing the catchpoint round block and its lookback, to be used along with --file")
 
 }
 
@@ -153,9 +160,17 @@ var catchupCmd = &cobra.Command{
 	Use:     "catchup",
 	Short:   "Catchup the Algorand node to a specific catchpoint",
 	Long:    "Catchup allows making large jumps over round ranges without the need to incrementally validate each individual round. Using external catchpoints is not a secure practice and should not be done for consensus participating nodes.\nIf no catchpoint is provided, this command attempts to lookup the latest catchpoint from algorand-catchpoints.s3.us-east-2.amazonaws.com.",
-	Example: "goal node catchup 6500000#1234567890ABCDEF01234567890ABCDEF0\tStart catching up to round 6500000 with the provided catchpoint\ngoal node catchup --abort\t\t\t\t\tAbort the current catchup",
+	Example: "goal node catchup 6500000#1234567890ABCDEF01234567890ABCDEF0\tStart catching up to round 6500000 with the provided catchpoint\ngoal node catchup 6500000#1234567890ABCDEF01234567890ABCDEF0 --file ledger.catchpoint --blocks ledger.blocks\tCatch up from local files\ngoal node catchup --abort\t\t\t\t\tAbort the current catchup",
 	Args:    catchpointCmdArgument,
 	Run: func(cmd *cobra.Command, args []string) {
+		if !abortCatchup && (catchupCatchpointFile != "" || catchupBlocksFile != "") {
+			if catchupCatchpointFile == "" || catchupBlocksFile == "" {
+				reportErrorf(errorCatchupFilesMissing)
+			}
+			if len(args) == 0 {
+				reportErrorf(errorCatchupFilesLabelMissing)
+			}
+		}
 		datadir.OnDataDirs(func(dataDir string) {
 			if !abortCatchup && len(args) == 0 {
 				client := ensureAlgodClient(dataDir)
@@ -528,6 +543,109 @@ func makeStatusString(stat model.NodeStatusResponse) string {
 	return statusString
 }
 
+var consensusCmd = &cobra.Command{
+	Use:   "consensus",
+	Short: "Show the agreement state of the node",
+	Long:  "Show a snapshot of the agreement state machine of the running node: the round, period and step it is in, the proposals it has seen in the round, and the votes counted for them in each step. Requires the admin API token.",
+	Args:  validateNoPosArgsFn,
+	Run: func(cmd *cobra.Command, _ []string) {
+		datadir.OnDataDirs(func(dataDir string) {
+			client := ensureAlgodClient(dataDir)
+			state, err := client.GetConsensusState()
+			if err != nil {
+				reportErrorf(errorNodeConsensusState, err)
+			}
+			if consensusJSON {
+				out, err := json.MarshalIndent(state, "", "  ")
+				if err != nil {
+					reportErrorf(errorNodeConsensusState, err)
+				}
+				fmt.Println(string(out))
+				return
+			}
+			fmt.Println(makeConsensusStateString(state))
+		})
+	},
+}
+
+// consensusStepName names agreement steps the way the protocol specification does.
+func consensusStepName(step uint64) string {
+	switch step {
+	case 0:
+		return "propose"
+	case 1:
+		return "soft"
+	case 2:
+		return "cert"
+	case 253:
+		return "late"
+	case 254:
+		return "redo"
+	case 255:
+		return "down"
+	default:
+		return fmt.Sprintf("next %d", step-3)
+	}
+}
+
+func consensusValueString(proposer, digest string, period uint64) string {
+	if proposer == "" && digest == "" {
+		return "bottom"
+	}
+	return fmt.Sprintf("%s by %s (period %d)", digest, proposer, period)
+}
+
+func makeConsensusStateString(state model.ConsensusStateResponse) string {
+	step := consensusStepName(state.Step)
+	if state.Napping {
+		step += " (napping)"
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, infoNodeConsensusState, state.Round, state.Period, step,
+		time.Duration(state.StepTime).Seconds(), time.Duration(state.RoundTime).Seconds(), time.Duration(state.Deadline).Seconds())
+
+	b.WriteString("\nProposals:")
+	if len(state.Proposals) == 0 {
+		b.WriteString(" none")
+	}
+	for _, p := range state.Proposals {
+		var flags []string
+		if p.Lowest {
+			flags = append(flags, "lowest credential")
+		}
+		if p.Staging {
+			flags = append(flags, "staged")
+		}
+		if p.Pinned {
+			flags = append(flags, "pinned")
+		}
+		if p.PayloadValidated {
+			flags = append(flags, "block validated")
+		} else if p.PayloadReceived {
+			flags = append(flags, "block received")
+		} else {
+			flags = append(flags, "block missing")
+		}
+		fmt.Fprintf(&b, "\n  %s: %s", consensusValueString(p.OriginalProposer, p.BlockDigest, p.OriginalPeriod), strings.Join(flags, ", "))
+	}
+
+	b.WriteString("\nVotes:")
+	if len(state.Tallies) == 0 {
+		b.WriteString(" none")
+	}
+	for _, t := range state.Tallies {
+		fmt.Fprintf(&b, "\n  Period %d, step %s: %d voters, threshold %d", t.Period, consensusStepName(t.Step), t.Voters, t.Threshold)
+		if t.EquivocatorWeight > 0 {
+			fmt.Fprintf(&b, ", equivocating weight %d", t.EquivocatorWeight)
+		}
+		for _, w := range t.Weights {
+			fmt.Fprintf(&b, "\n    %s: %d", consensusValueString(w.OriginalProposer, w.BlockDigest, w.OriginalPeriod), w.Weight)
+		}
+	}
+	return b.String()
+}
+
 var lastroundCmd = &cobra.Command{
 	Use:   "lastround",
 	Short: "Print the last round number",
@@ -723,6 +841,22 @@ func catchup(dataDir string, args []string) {
 		}
 		return
 	}
+	if catchupCatchpointFile != "" {
+		// the node resolves the paths against its own working directory, so make them absolute.
+		catchpointFile, err := filepath.Abs(catchupCatchpointFile)
+		if err != nil {
+			reportErrorf(errorNodeStatus, err)
+		}
+		blocksFile, err := filepath.Abs(catchupBlocksFile)
+		if err != nil {
+			reportErrorf(errorNodeStatus, err)
+		}
+		err = client.CatchupFromFiles(args[0], catchpointFile, blocksFile)
+		if err != nil {
+			reportErrorf(errorNodeStatus, err)
+		}
+		return
+	}
 	err := client.Catchup(args[0])
 	if err != nil {
 		reportErrorf(errorNodeStatus, err)
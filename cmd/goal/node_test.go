@@ -21,7 +21,9 @@ import (
 	"regexp"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated/model"
 	"github.com/algorand/go-algorand/ledger/ledgercore"
 	"github.com/algorand/go-algorand/test/partitiontest"
 	"github.com/stretchr/testify/assert"
@@ -113,3 +115,44 @@ func TestGetMissingCatchpointLabel(t *testing.T) {
 		})
 	}
 }
+
+func TestMakeConsensusStateString(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	state := model.ConsensusStateResponse{
+		Round:     10,
+		Period:    1,
+		Step:      4,
+		Napping:   true,
+		StepTime:  uint64(1500 * time.Millisecond),
+		RoundTime: uint64(20 * time.Second),
+		Deadline:  uint64(19 * time.Second),
+		Proposals: []model.ConsensusProposal{
+			{OriginalPeriod: 0, OriginalProposer: "PROPOSER", BlockDigest: "DIGEST", Lowest: true, Pinned: true, PayloadReceived: true},
+		},
+		Tallies: []model.ConsensusVoteTally{
+			{Period: 1, Step: 3, Threshold: 3838, Voters: 2, EquivocatorWeight: 7, Weights: []model.ConsensusProposalWeight{
+				{OriginalPeriod: 0, OriginalProposer: "PROPOSER", BlockDigest: "DIGEST", Weight: 2000},
+				{Weight: 1000},
+			}},
+		},
+	}
+
+	expected := `Round: 10
+Period: 1
+Step: next 1 (napping)
+Time in step: 1.5s
+Time in round: 20.0s
+Next timeout: 19.0s
+Proposals:
+  DIGEST by PROPOSER (period 0): lowest credential, pinned, block received
+Votes:
+  Period 1, step next 0: 2 voters, threshold 3838, equivocating weight 7
+    DIGEST by PROPOSER (period 0): 2000
+    bottom: 1000`
+	require.Equal(t, expected, makeConsensusStateString(state))
+
+	empty := makeConsensusStateString(model.ConsensusStateResponse{Round: 3, Step: 1})
+	require.True(t, strings.HasSuffix(empty, "Proposals: none\nVotes: none"), empty)
+}
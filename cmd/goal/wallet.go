@@ -34,17 +34,27 @@ import (
 var (
 	recoverWallet     bool
 	defaultWalletName string
+	backupOutFile     string
+	restoreWalletName string
 )
 
 func init() {
 	walletCmd.AddCommand(newWalletCmd)
 	walletCmd.AddCommand(listWalletsCmd)
+	walletCmd.AddCommand(backupWalletCmd)
+	walletCmd.AddCommand(restoreWalletCmd)
+	walletCmd.AddCommand(upgradeKDFWalletCmd)
 
 	// Default wallet to use when -w not specified
 	walletCmd.Flags().StringVarP(&defaultWalletName, "default", "f", "", "Set the wallet with this name to be the default wallet")
 
 	// Should we recover the wallet?
 	newWalletCmd.Flags().BoolVarP(&recoverWallet, "recover", "r", false, "Recover the wallet from the backup mnemonic provided at wallet creation (NOT the mnemonic provided by goal account export or by algokey). Regenerate accounts in the wallet with `goal account new`")
+
+	backupWalletCmd.Flags().StringVarP(&backupOutFile, "outfile", "o", "", "Filename for writing the encrypted wallet backup")
+	backupWalletCmd.MarkFlagRequired("outfile")
+
+	restoreWalletCmd.Flags().StringVarP(&restoreWalletName, "name", "n", "", "Name of the restored wallet, instead of the name of the backed up wallet")
 }
 
 var walletCmd = &cobra.Command{
@@ -200,6 +210,68 @@ var listWalletsCmd = &cobra.Command{
 	},
 }
 
+var backupWalletCmd = &cobra.Command{
+	Use:   "backup [wallet name]",
+	Short: "Write an encrypted backup of a wallet to a file",
+	Long:  "Write a backup of the wallet, encrypted with the wallet password, to a file. Unlike the backup phrase, the backup also holds the accounts imported into the wallet and its multisig addresses. Restore it with `goal wallet restore`.",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		dataDir := datadir.EnsureSingleDataDir()
+		client := ensureKmdClient(dataDir)
+		wh, pw := ensureWalletHandleMaybePassword(dataDir, args[0], true)
+
+		backup, err := client.BackupWallet(wh, pw)
+		if err != nil {
+			reportErrorf(errorCouldntBackupWallet, err)
+		}
+
+		err = writeFile(backupOutFile, backup, 0600)
+		if err != nil {
+			reportErrorf(fileWriteError, backupOutFile, err)
+		}
+		reportInfof(infoBackedUpWallet, args[0], backupOutFile)
+	},
+}
+
+var restoreWalletCmd = &cobra.Command{
+	Use:   "restore [backup file]",
+	Short: "Create a wallet from an encrypted backup",
+	Long:  "Create a new wallet holding the accounts and multisig addresses of a backup written by `goal wallet backup`. The new wallet has the password the backup was encrypted with.",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		dataDir := datadir.EnsureSingleDataDir()
+		accountList := makeAccountsList(dataDir)
+		client := ensureKmdClient(dataDir)
+
+		backup, err := readFile(args[0])
+		if err != nil {
+			reportErrorf(fileReadError, args[0], err)
+		}
+
+		fmt.Printf(infoBackupPasswordPrompt)
+		walletPassword := ensurePassword()
+
+		walletID, err := client.RestoreWallet([]byte(restoreWalletName), walletPassword, backup)
+		if err != nil {
+			reportErrorf(errorCouldntRestoreWallet, err)
+		}
+		walletName, _, err := client.FindWalletNameByID(walletID)
+		if err != nil {
+			reportErrorf(errGettingWalletName, walletID, err)
+		}
+		reportInfof(infoRestoredWallet, walletName)
+
+		// We are the only wallet -- make us the default
+		wallets, err := client.ListWallets()
+		if err != nil {
+			reportErrorf(errorCouldntListWallets, err)
+		}
+		if len(wallets) == 1 {
+			accountList.setDefaultWalletID(walletID)
+		}
+	},
+}
+
 func printWallets(dataDir string, wallets []kmdapi.APIV1Wallet) {
 	accountList := makeAccountsList(dataDir)
 	defaultWalletID := string(accountList.getDefaultWalletID())
@@ -221,3 +293,21 @@ func printWallets(dataDir string, wallets []kmdapi.APIV1Wallet) {
 	}
 	fmt.Println(strings.Repeat("#", 50))
 }
+
+var upgradeKDFWalletCmd = &cobra.Command{
+	Use:   "upgrade-kdf [wallet name]",
+	Short: "Re-encrypt a wallet with the key derivation function configured in kmd",
+	Long:  "Re-encrypt a wallet under its password, using the key derivation function (scrypt or argon2id) and parameters currently set in the kmd configuration. Wallets keep opening with the function they were encrypted with until they are upgraded. The wallet password is unchanged.",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		dataDir := datadir.EnsureSingleDataDir()
+		client := ensureKmdClient(dataDir)
+		wh, pw := ensureWalletHandleMaybePassword(dataDir, args[0], true)
+
+		err := client.UpgradeWalletKDF(wh, pw)
+		if err != nil {
+			reportErrorf(errorCouldntUpgradeWalletKDF, err)
+		}
+		reportInfof(infoUpgradedWalletKDF, args[0])
+	},
+}
@@ -26,10 +26,12 @@ import (
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/cobra/doc"
+	"golang.org/x/crypto/ssh/terminal"
 
 	"github.com/algorand/go-algorand/cmd/kmd/codes"
 	"github.com/algorand/go-algorand/daemon/kmd"
 	"github.com/algorand/go-algorand/daemon/kmd/server"
+	"github.com/algorand/go-algorand/daemon/kmd/wallet/driver"
 	"github.com/algorand/go-algorand/logging"
 )
 
@@ -47,6 +49,7 @@ func init() {
 	kmdCmd.Flags().StringVarP(&dataDir, "data-dir", "d", "", "kmd data directory.")
 	kmdCmd.Flags().Uint64VarP(&timeoutSecs, "timout-secs", "t", 0, "Number of seconds that kmd will run for before termination.")
 	kmdCmd.MarkFlagRequired("data-dir")
+	kmdCmd.AddCommand(remotePasswordHashCmd)
 }
 
 var kmdCmd = &cobra.Command{
@@ -62,6 +65,30 @@ a timely manner. This is a blocking command.`,
 	},
 }
 
+var remotePasswordHashCmd = &cobra.Command{
+	Use:   "remote-password-hash",
+	Short: "Hash a password for a remote signer wallet",
+	Long: `Reads a password from the terminal and prints its hash, to be set as the
+password_hash of a remote signer in kmd_config.json. The remote signer wallet
+is then unlocked with this password, like any other wallet.`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		fmt.Print("Password: ")
+		pw, err := terminal.ReadPassword(int(os.Stdin.Fd()))
+		fmt.Println()
+		if err != nil {
+			fmt.Printf("couldn't read password: %s\n", err)
+			os.Exit(1)
+		}
+		hash, err := driver.HashRemoteWalletPassword(pw)
+		if err != nil {
+			fmt.Printf("couldn't hash password: %s\n", err)
+			os.Exit(1)
+		}
+		fmt.Println(hash)
+	},
+}
+
 func runKmd(dataDir string, timeoutSecs uint64) {
 	// Use logging package instead of stdin/stdout
 	log := logging.NewLogger()
@@ -0,0 +1,242 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/cobra/doc"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/daemon/algod/api/client"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/rpcs"
+	"github.com/algorand/go-algorand/stateproof/lightclient"
+)
+
+var versionCheck bool
+
+var checkpointFile string
+var algodURL string
+var algodToken string
+
+var initRound uint64
+var initVoters string
+var initProvenWeight uint64
+var initStrengthTarget uint64
+var initFromNode bool
+var initForce bool
+
+var proveTxid string
+var proveRound uint64
+
+var rootCmd = &cobra.Command{
+	Use:   "lightclient",
+	Short: "State proof light client",
+	Long:  "Follows the state proofs of an Algorand network from a trusted checkpoint and proves transactions against it",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		if versionCheck {
+			fmt.Println(config.FormatVersionAndLicense())
+			return
+		}
+		// If no arguments passed, we should fallback to help
+		cmd.HelpFunc()(cmd, args)
+	},
+}
+
+var initCmd = &cobra.Command{
+	Use:   "init",
+	Short: "Create a checkpoint from a trusted voters commitment",
+	Long:  "Create a checkpoint from the voters commitment and proven weight of a state proof interval boundary. With --from-node these are taken from the node without verification, so only use it with a node you trust.",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		if !initForce {
+			if _, err := os.Stat(checkpointFile); err == nil {
+				reportErrorf("Checkpoint %s already exists, use --force to overwrite it", checkpointFile)
+			}
+		}
+
+		var cp lightclient.Checkpoint
+		var err error
+		if initFromNode {
+			cp, err = checkpointFromNode(makeNode(), initRound)
+		} else {
+			var voters []byte
+			voters, err = base64.StdEncoding.DecodeString(initVoters)
+			if err != nil {
+				reportErrorf("Invalid voters commitment: %v", err)
+			}
+			cp, err = lightclient.NewCheckpoint(initRound, voters, initProvenWeight, initStrengthTarget)
+		}
+		if err != nil {
+			reportErrorf("Cannot create checkpoint: %v", err)
+		}
+
+		err = cp.Save(checkpointFile)
+		if err != nil {
+			reportErrorf("Cannot save checkpoint: %v", err)
+		}
+		fmt.Printf("Created checkpoint %s at round %d\n", checkpointFile, cp.Round)
+	},
+}
+
+var advanceCmd = &cobra.Command{
+	Use:   "advance",
+	Short: "Verify the state proofs that follow the checkpoint",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		lc := openClient()
+		advance(lc)
+	},
+}
+
+var proveCmd = &cobra.Command{
+	Use:   "prove",
+	Short: "Prove that a transaction is included in a round",
+	Long:  "Prove that a transaction is included in a round. The checkpoint is advanced first if it does not attest to the round yet.",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		lc := openClient()
+		if proveRound > lc.Checkpoint().Round {
+			advance(lc)
+		}
+
+		inc, err := lc.ProveTransaction(proveTxid, proveRound)
+		if err != nil {
+			reportErrorf("Cannot prove transaction %s in round %d: %v", proveTxid, proveRound, err)
+		}
+		fmt.Printf("Transaction %s is included in round %d at index %d\n", proveTxid, inc.Round, inc.Index)
+		fmt.Printf("Genesis hash: %s\n", inc.Header.GenesisHash)
+		fmt.Printf("Transaction commitment: %s\n", base64.StdEncoding.EncodeToString(inc.Header.Sha256TxnCommitment))
+		fmt.Println(string(protocol.EncodeJSONStrict(inc.Transaction)))
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(initCmd)
+	rootCmd.AddCommand(advanceCmd)
+	rootCmd.AddCommand(proveCmd)
+	rootCmd.Flags().BoolVarP(&versionCheck, "version", "v", false, "Display and write current build version and exit")
+
+	rootCmd.PersistentFlags().StringVarP(&checkpointFile, "checkpoint", "c", "checkpoint.json", "File holding the trusted checkpoint")
+	rootCmd.PersistentFlags().StringVarP(&algodURL, "algod", "a", "http://127.0.0.1:8080", "URL of the algod REST API")
+	rootCmd.PersistentFlags().StringVarP(&algodToken, "token", "t", "", "algod API token")
+
+	initCmd.Flags().Uint64VarP(&initRound, "round", "r", 0, "State proof interval boundary holding the voters commitment")
+	initCmd.Flags().StringVar(&initVoters, "voters", "", "Base64 encoded voters commitment of the round")
+	initCmd.Flags().Uint64Var(&initProvenWeight, "proven-weight", 0, "Weight the next state proof has to prove")
+	initCmd.Flags().Uint64Var(&initStrengthTarget, "strength-target", config.Consensus[protocol.ConsensusCurrentVersion].StateProofStrengthTarget, "State proof strength target")
+	initCmd.Flags().BoolVar(&initFromNode, "from-node", false, "Take the voters commitment and proven weight from the node instead of the command line")
+	initCmd.Flags().BoolVarP(&initForce, "force", "f", false, "Overwrite an existing checkpoint")
+	initCmd.MarkFlagRequired("round")
+
+	proveCmd.Flags().StringVar(&proveTxid, "txid", "", "ID of the transaction to prove")
+	proveCmd.Flags().Uint64VarP(&proveRound, "round", "r", 0, "Round the transaction was included in")
+	proveCmd.MarkFlagRequired("txid")
+	proveCmd.MarkFlagRequired("round")
+}
+
+// restNode adapts a RestClient to the lightclient.Node interface.
+type restNode struct {
+	client.RestClient
+}
+
+func (n restNode) BookkeepingBlock(round uint64) (bookkeeping.Block, error) {
+	raw, err := n.RawBlock(round)
+	if err != nil {
+		return bookkeeping.Block{}, err
+	}
+	var blockCert rpcs.EncodedBlockCert
+	err = protocol.Decode(raw, &blockCert)
+	if err != nil {
+		return bookkeeping.Block{}, err
+	}
+	return blockCert.Block, nil
+}
+
+func makeNode() restNode {
+	u, err := url.Parse(algodURL)
+	if err != nil {
+		reportErrorf("Invalid algod URL %s: %v", algodURL, err)
+	}
+	return restNode{client.MakeRestClient(*u, algodToken)}
+}
+
+func checkpointFromNode(node restNode, round uint64) (lightclient.Checkpoint, error) {
+	blk, err := node.BookkeepingBlock(round)
+	if err != nil {
+		return lightclient.Checkpoint{}, err
+	}
+	if blk.Round() != basics.Round(round) {
+		return lightclient.Checkpoint{}, fmt.Errorf("node returned block %d instead of %d", blk.Round(), round)
+	}
+	proto, ok := config.Consensus[blk.CurrentProtocol]
+	if !ok {
+		return lightclient.Checkpoint{}, fmt.Errorf("unknown protocol %s", blk.CurrentProtocol)
+	}
+	return lightclient.CheckpointFromHeader(blk.BlockHeader, proto)
+}
+
+func openClient() *lightclient.Client {
+	lc, err := lightclient.OpenClient(makeNode(), checkpointFile)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			reportErrorf("Checkpoint %s does not exist, create it with the init command", checkpointFile)
+		}
+		reportErrorf("Cannot open checkpoint: %v", err)
+	}
+	return lc
+}
+
+func advance(lc *lightclient.Client) {
+	n, err := lc.Advance()
+	fmt.Printf("Verified %d state proofs, checkpoint is at round %d\n", n, lc.Checkpoint().Round)
+	if err != nil {
+		reportErrorf("Cannot advance checkpoint: %v", err)
+	}
+}
+
+func reportErrorf(format string, args ...interface{}) {
+	fmt.Fprintf(os.Stderr, format+"\n", args...)
+	os.Exit(1)
+}
+
+func main() {
+	// Hidden command to generate docs in a given directory
+	// lightclient generate-docs [path]
+	if len(os.Args) == 3 && os.Args[1] == "generate-docs" {
+		err := doc.GenMarkdownTree(rootCmd, os.Args[2])
+		if err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+		os.Exit(0)
+	}
+
+	if err := rootCmd.Execute(); err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+}
@@ -29,7 +29,7 @@ import (
 	"github.com/algorand/go-algorand/protocol"
 )
 
-var docVersion = 9
+var docVersion = 10
 
 // OpImmediateNote returns a short string about immediate data which follows the op byte
 func opImmediateNoteSyntaxMarkdown(name string, oids []logic.OpImmediateDetails) string {
@@ -415,6 +415,8 @@ func argEnums(name string) ([]string, []string) {
 		return fieldsAndTypes(logic.VrfStandards)
 	case "ecdsa_pk_recover", "ecdsa_verify", "ecdsa_pk_decompress":
 		return fieldsAndTypes(logic.EcdsaCurves)
+	case "ec_add", "ec_scalar_mul", "ec_pairing_check", "ec_multi_scalar_mul", "ec_subgroup_check", "ec_map_to":
+		return fieldsAndTypes(logic.EcGroups)
 	default:
 		return nil, nil
 	}
@@ -47,6 +47,16 @@ func (m *MockCatchpointCatchupAccessor) SetLabel(ctx context.Context, label stri
 	return nil
 }
 
+// GetLocalFiles returns the catchpoint file and the blocks file the catchpoint catchup is installed from
+func (m *MockCatchpointCatchupAccessor) GetLocalFiles(ctx context.Context) (catchpointFile string, blocksFile string, err error) {
+	return "", "", nil
+}
+
+// SetLocalFiles sets the catchpoint file and the blocks file the catchpoint catchup is installed from
+func (m *MockCatchpointCatchupAccessor) SetLocalFiles(ctx context.Context, catchpointFile string, blocksFile string) (err error) {
+	return nil
+}
+
 // ResetStagingBalances resets the current staging balances, preparing for a new set of balances to be added
 func (m *MockCatchpointCatchupAccessor) ResetStagingBalances(ctx context.Context, newCatchup bool) (err error) {
 	return nil
@@ -57,6 +67,11 @@ func (m *MockCatchpointCatchupAccessor) ProcessStagingBalances(ctx context.Conte
 	return nil
 }
 
+// RestoreStagingBalancesProgress loads the progress of a previously interrupted catchpoint file download
+func (m *MockCatchpointCatchupAccessor) RestoreStagingBalancesProgress(ctx context.Context, progress *ledger.CatchpointCatchupAccessorProgress) (err error) {
+	return nil
+}
+
 // BuildMerkleTrie inserts the account hashes into the merkle trie
 func (m *MockCatchpointCatchupAccessor) BuildMerkleTrie(ctx context.Context, progressUpdates func(uint64, uint64)) (err error) {
 	return nil
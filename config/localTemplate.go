@@ -178,6 +178,10 @@ type Local struct {
 	// if enabled, the over-all TXBacklog Size will be larger by MAX_PEERS*TxBacklogReservedCapacityPerPeer
 	EnableTxBacklogRateLimiting bool `version[27]:"false"`
 
+	// EnableTxBacklogSenderLimiting controls if the tx backlog limits the share of each transaction sender, as measured by the
+	// groups of the sender that passed signature verification over the last TxBacklogServiceRateWindowSeconds
+	EnableTxBacklogSenderLimiting bool `version[27]:"false"`
+
 	// TxBacklogSize is the queue size used for receiving transactions. default of 26000 to approximate 1 block of transactions
 	// if EnableTxBacklogRateLimiting enabled, the over-all size will be larger by MAX_PEERS*TxBacklogReservedCapacityPerPeer
 	TxBacklogSize int `version[27]:"26000"`
@@ -372,6 +376,11 @@ type Local struct {
 	// the default of 20480 would be used.
 	MinCatchpointFileDownloadBytesPerSecond uint64 `version[13]:"20480"`
 
+	// CatchpointDownloadParallelism defines the number of peers from which the catchpoint file chunks are downloaded concurrently during fast catchup.
+	// Each peer is asked for a different range of chunks, and peers that don't support chunk ranges are streamed from sequentially. A value of 1 (or less)
+	// downloads one range of chunks at a time.
+	CatchpointDownloadParallelism int `version[27]:"4"`
+
 	// TraceServer is a host:port to report graph propagation trace info to.
 	NetworkMessageTraceServer string `version[13]:""`
 
@@ -510,6 +519,35 @@ type Local struct {
 	// EnableTxnEvalTracer turns on features in the BlockEvaluator which collect data on transactions, exposing them via algod APIs.
 	// It will store txn deltas created during block evaluation, potentially consuming much larger amounts of memory,
 	EnableTxnEvalTracer bool `version[27]:"false"`
+
+	// EnableP2P turns on the libp2p based gossip network instead of the websocket one.
+	// TX and AV messages are disseminated through gossipsub, and peers are discovered through a DHT.
+	EnableP2P bool `version[27]:"false"`
+
+	// EnableP2PHybridMode turns on both the websocket and the libp2p gossip networks,
+	// allowing a relay to serve the peers of either transport. It takes precedence over EnableP2P.
+	EnableP2PHybridMode bool `version[27]:"false"`
+
+	// P2PNetAddress is the host:port the libp2p network listens on. When empty, an ephemeral port is used.
+	// In hybrid mode, it must differ from NetAddress which is used by the websocket network.
+	P2PNetAddress string `version[27]:""`
+
+	// P2PPersistPeerID stores the libp2p private key in the data directory, so that the node keeps the same peer ID across restarts.
+	P2PPersistPeerID bool `version[27]:"false"`
+
+	// P2PPrivateKeyLocation is an optional path to the libp2p private key. It overrides the default location in the data directory.
+	P2PPrivateKeyLocation string `version[27]:""`
+
+	// StorageEngine selects the database used for the ledger tracker state. Available options are:
+	// - sqlite (default)
+	// - postgres, which requires TrackerDBPostgresDSN to be set. This engine is experimental, and is only tested
+	//   against a live server by a dedicated CI job.
+	// - pebbledb, an embedded key-value store kept in the ledger.tracker.pebble directory.
+	StorageEngine string `version[27]:"sqlite"`
+
+	// TrackerDBPostgresDSN is the connection string of the PostgreSQL database used when StorageEngine is "postgres".
+	// Both the key/value ("host=... dbname=...") and the URL ("postgres://...") forms are accepted.
+	TrackerDBPostgresDSN string `version[27]:""`
 }
 
 // DNSBootstrapArray returns an array of one or more DNS Bootstrap identifiers
@@ -33,6 +33,7 @@ var defaultLocal = Local{
 	BroadcastConnectionsLimit:                  -1,
 	CadaverDirectory:                           "",
 	CadaverSizeTarget:                          0,
+	CatchpointDownloadParallelism:              4,
 	CatchpointFileHistoryLength:                365,
 	CatchpointInterval:                         10000,
 	CatchpointTracking:                         0,
@@ -68,6 +69,8 @@ var defaultLocal = Local{
 	EnableLedgerService:                        false,
 	EnableMetricReporting:                      false,
 	EnableOutgoingNetworkMessageFiltering:      true,
+	EnableP2P:                                  false,
+	EnableP2PHybridMode:                        false,
 	EnablePingHandler:                          true,
 	EnableProcessBlockStats:                    false,
 	EnableProfiler:                             false,
@@ -75,6 +78,7 @@ var defaultLocal = Local{
 	EnableRuntimeMetrics:                       false,
 	EnableTopAccountsReporting:                 false,
 	EnableTxBacklogRateLimiting:                false,
+	EnableTxBacklogSenderLimiting:              false,
 	EnableTxnEvalTracer:                        false,
 	EnableUsageLog:                             false,
 	EnableVerbosedTransactionSyncLogging:       false,
@@ -106,6 +110,9 @@ var defaultLocal = Local{
 	OptimizeAccountsDatabaseOnStartup:          false,
 	OutgoingMessageFilterBucketCount:           3,
 	OutgoingMessageFilterBucketSize:            128,
+	P2PNetAddress:                              "",
+	P2PPersistPeerID:                           false,
+	P2PPrivateKeyLocation:                      "",
 	ParticipationKeysRefreshInterval:           60000000000,
 	PeerConnectionsUpdateInterval:              3600,
 	PeerPingPeriodSeconds:                      0,
@@ -119,11 +126,13 @@ var defaultLocal = Local{
 	RestReadTimeoutSeconds:                     15,
 	RestWriteTimeoutSeconds:                    120,
 	RunHosted:                                  false,
+	StorageEngine:                              "sqlite",
 	SuggestedFeeBlockHistory:                   3,
 	SuggestedFeeSlidingWindowSize:              50,
 	TLSCertFile:                                "",
 	TLSKeyFile:                                 "",
 	TelemetryToLog:                             true,
+	TrackerDBPostgresDSN:                       "",
 	TransactionSyncDataExchangeRate:            0,
 	TransactionSyncSignificantMessageThreshold: 0,
 	TxBacklogReservedCapacityPerPeer:           20,
@@ -573,6 +573,18 @@ func (client RestClient) Catchup(catchpointLabel string) (response model.Catchpo
 	return
 }
 
+type catchupFromFilesParams struct {
+	CatchpointFile string `url:"catchpoint-file"`
+	BlocksFile     string `url:"blocks-file"`
+}
+
+// CatchupFromFiles start catching up to the give catchpoint label, using a catchpoint file and a blocks file
+// found on the node's file system
+func (client RestClient) CatchupFromFiles(catchpointLabel, catchpointFile, blocksFile string) (response model.CatchpointStartResponse, err error) {
+	err = client.submitForm(&response, fmt.Sprintf("/v2/catchup/%s/file", catchpointLabel), catchupFromFilesParams{CatchpointFile: catchpointFile, BlocksFile: blocksFile}, nil, "POST", false, true, false)
+	return
+}
+
 // GetGoRoutines gets a dump of the goroutines from pprof
 // Not supported
 func (client RestClient) GetGoRoutines(ctx context.Context) (goRoutines string, err error) {
@@ -728,6 +740,12 @@ func (client RestClient) RemoveParticipationKeyByID(participationID string) (err
 	return
 }
 
+// GetConsensusState gets a snapshot of the agreement state machine of the node
+func (client RestClient) GetConsensusState() (response model.ConsensusStateResponse, err error) {
+	err = client.get(&response, "/v2/consensus/state", nil)
+	return
+}
+
 /* Endpoint registered for follower nodes */
 
 // SetSyncRound sets the sync round for the catchup service
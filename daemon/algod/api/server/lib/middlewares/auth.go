@@ -42,6 +42,12 @@ type AuthMiddleware struct {
 
 	// Tokens is the set of tokens which can be set to allow access.
 	tokens [][]byte
+
+	// Scoped holds the scoped tokens, which are also accepted if they allow the route.
+	scoped *ScopedTokens
+
+	// Group is the name of the route group the middleware is used for.
+	group string
 }
 
 // MakeAuth constructs the auth middleware function
@@ -59,6 +65,24 @@ func MakeAuth(header string, tokens []string) echo.MiddlewareFunc {
 	return auth.handler
 }
 
+// MakeScopedAuth constructs the auth middleware function for a group of routes.
+// Besides the given tokens, it accepts the scoped tokens that allow the route.
+func MakeScopedAuth(header string, tokens []string, scoped *ScopedTokens, group string) echo.MiddlewareFunc {
+	apiTokenBytes := make([][]byte, 0)
+	for _, token := range tokens {
+		apiTokenBytes = append(apiTokenBytes, []byte(token))
+	}
+
+	auth := AuthMiddleware{
+		header: header,
+		tokens: apiTokenBytes,
+		scoped: scoped,
+		group:  group,
+	}
+
+	return auth.handler
+}
+
 // Auth takes a logger and an array of api token and return a middleware function
 // that ensures one of the api tokens was provided.
 func (auth *AuthMiddleware) handler(next echo.HandlerFunc) echo.HandlerFunc {
@@ -101,6 +125,13 @@ func (auth *AuthMiddleware) handler(next echo.HandlerFunc) echo.HandlerFunc {
 			}
 		}
 
+		if auth.scoped != nil {
+			if err := auth.scoped.authorize(providedToken, auth.group, ctx.Request().Method, ctx.Path()); err != nil {
+				return err
+			}
+			return next(ctx)
+		}
+
 		return echo.NewHTTPError(http.StatusUnauthorized, InvalidTokenMessage)
 	}
 }
@@ -0,0 +1,298 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package middlewares
+
+import (
+	"bytes"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/algorand/go-deadlock"
+	"github.com/labstack/echo/v4"
+
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/util/tokens"
+)
+
+// ExpiredTokenMessage is the message set when a scoped token has expired.
+const ExpiredTokenMessage = "API token has expired"
+
+// ForbiddenRouteMessage is the message set when a scoped token does not allow the requested route.
+const ForbiddenRouteMessage = "API token is not allowed to access this route"
+
+// QuotaExceededMessage is the message set when a scoped token has used up its request quota.
+const QuotaExceededMessage = "API token request quota exceeded"
+
+// scopedTokensReloadInterval is how often the scoped tokens file is checked for changes.
+const scopedTokensReloadInterval = time.Second
+
+// ScopedToken is an API token restricted to some routes.
+type ScopedToken struct {
+	// Name identifies the token in logs.
+	Name string `json:"name"`
+
+	// Token is the secret, which has the same length requirements as the API token.
+	Token string `json:"token"`
+
+	// Groups are the route groups the token can access, e.g. "nonparticipating/public".
+	Groups []string `json:"groups,omitempty"`
+
+	// Routes are the individual routes the token can access, as the method and
+	// the path template of the route, e.g. "GET /v2/accounts/:address".
+	Routes []string `json:"routes,omitempty"`
+
+	// Expires is when the token stops being accepted. It never expires if not set.
+	Expires *time.Time `json:"expires,omitempty"`
+
+	// Quota is the number of requests allowed in each quota period. There is no
+	// limit if it is 0.
+	Quota uint64 `json:"quota,omitempty"`
+
+	// QuotaPeriodSeconds is the length of the quota period.
+	QuotaPeriodSeconds uint64 `json:"quota-period-seconds,omitempty"`
+}
+
+// scopedTokensFile is the format of the scoped tokens file.
+type scopedTokensFile struct {
+	Tokens []ScopedToken `json:"tokens"`
+}
+
+// scopedToken is a validated ScopedToken.
+type scopedToken struct {
+	ScopedToken
+	groups map[string]bool
+	routes map[string]bool
+}
+
+func (st *scopedToken) allows(group, method, path string) bool {
+	return st.groups[group] || st.routes[routeKey(method, path)]
+}
+
+// quotaUsage counts the requests of a token in the current quota period.
+type quotaUsage struct {
+	periodStart time.Time
+	requests    uint64
+}
+
+// ScopedTokens holds the scoped tokens defined in a file. The file is reloaded
+// when it changes, and removing it revokes all the scoped tokens.
+type ScopedTokens struct {
+	path string
+	log  logging.Logger
+	now  func() time.Time
+
+	mu          deadlock.Mutex
+	groups      map[string]bool
+	routes      map[string]string
+	tokens      []*scopedToken
+	usage       map[string]*quotaUsage
+	lastCheck   time.Time
+	lastModTime time.Time
+	lastSize    int64
+}
+
+// MakeScopedTokens creates a ScopedTokens for the given file. Nothing is loaded
+// until the routes are set.
+func MakeScopedTokens(path string, log logging.Logger) *ScopedTokens {
+	return &ScopedTokens{
+		path:  path,
+		log:   log,
+		now:   time.Now,
+		usage: make(map[string]*quotaUsage),
+	}
+}
+
+func routeKey(method, path string) string {
+	return method + " " + path
+}
+
+// SetRoutes sets the route groups that tokens can refer to, and loads the
+// tokens file.
+func (s *ScopedTokens) SetRoutes(groups map[string][]*echo.Route) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.groups = make(map[string]bool)
+	s.routes = make(map[string]string)
+	for group, routes := range groups {
+		s.groups[group] = true
+		for _, route := range routes {
+			s.routes[routeKey(route.Method, route.Path)] = group
+		}
+	}
+	s.reload()
+}
+
+// parse reads and validates the tokens file.
+func (s *ScopedTokens) parse(data []byte) ([]*scopedToken, error) {
+	var file scopedTokensFile
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&file); err != nil {
+		return nil, err
+	}
+
+	names := make(map[string]bool)
+	secrets := make(map[string]bool)
+	parsed := make([]*scopedToken, 0, len(file.Tokens))
+	for _, t := range file.Tokens {
+		if t.Name == "" {
+			return nil, errors.New("token without a name")
+		}
+		if names[t.Name] {
+			return nil, fmt.Errorf("token %s: duplicate name", t.Name)
+		}
+		names[t.Name] = true
+		if err := tokens.ValidateAPIToken(t.Token); err != nil {
+			return nil, fmt.Errorf("token %s: %w", t.Name, err)
+		}
+		if secrets[t.Token] {
+			return nil, fmt.Errorf("token %s: duplicate token", t.Name)
+		}
+		secrets[t.Token] = true
+		if len(t.Groups) == 0 && len(t.Routes) == 0 {
+			return nil, fmt.Errorf("token %s: no groups or routes", t.Name)
+		}
+		if t.Quota != 0 && t.QuotaPeriodSeconds == 0 {
+			return nil, fmt.Errorf("token %s: quota without a quota period", t.Name)
+		}
+
+		st := &scopedToken{
+			ScopedToken: t,
+			groups:      make(map[string]bool),
+			routes:      make(map[string]bool),
+		}
+		for _, group := range t.Groups {
+			if !s.groups[group] {
+				return nil, fmt.Errorf("token %s: unknown route group %s", t.Name, group)
+			}
+			st.groups[group] = true
+		}
+		for _, route := range t.Routes {
+			if _, ok := s.routes[route]; !ok {
+				return nil, fmt.Errorf("token %s: unknown route %s", t.Name, route)
+			}
+			st.routes[route] = true
+		}
+		parsed = append(parsed, st)
+	}
+	return parsed, nil
+}
+
+// reload loads the tokens file if it changed since it was last loaded. If the
+// new file is invalid, the previous tokens are kept.
+func (s *ScopedTokens) reload() {
+	s.lastCheck = s.now()
+
+	info, err := os.Stat(s.path)
+	if errors.Is(err, os.ErrNotExist) {
+		if len(s.tokens) > 0 {
+			s.log.Infof("scoped API tokens file %s was removed, revoking scoped tokens", s.path)
+		}
+		s.tokens = nil
+		s.lastModTime = time.Time{}
+		s.lastSize = 0
+		return
+	}
+	if err != nil {
+		s.log.Warnf("unable to check scoped API tokens file %s: %v", s.path, err)
+		return
+	}
+	if info.ModTime().Equal(s.lastModTime) && info.Size() == s.lastSize {
+		return
+	}
+
+	data, err := os.ReadFile(s.path)
+	if err != nil {
+		s.log.Warnf("unable to read scoped API tokens file %s: %v", s.path, err)
+		return
+	}
+	// Remember the file even if it is invalid, so that the error is only logged once.
+	s.lastModTime = info.ModTime()
+	s.lastSize = info.Size()
+	parsed, err := s.parse(data)
+	if err != nil {
+		s.log.Errorf("invalid scoped API tokens file %s, keeping %d previous tokens: %v", s.path, len(s.tokens), err)
+		return
+	}
+
+	s.tokens = parsed
+	for secret := range s.usage {
+		if !s.has(secret) {
+			delete(s.usage, secret)
+		}
+	}
+	s.log.Infof("loaded %d scoped API tokens from %s", len(parsed), s.path)
+}
+
+func (s *ScopedTokens) has(secret string) bool {
+	for _, st := range s.tokens {
+		if st.Token == secret {
+			return true
+		}
+	}
+	return false
+}
+
+// authorize checks a scoped token against the requested route, and counts the
+// request against its quota. It returns nil if the request is allowed.
+func (s *ScopedTokens) authorize(provided []byte, group, method, path string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	if s.routes != nil && now.Sub(s.lastCheck) >= scopedTokensReloadInterval {
+		s.reload()
+	}
+
+	var token *scopedToken
+	// Check all the tokens in constant time
+	for _, st := range s.tokens {
+		if subtle.ConstantTimeCompare(provided, []byte(st.Token)) == 1 {
+			token = st
+		}
+	}
+	if token == nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, InvalidTokenMessage)
+	}
+	if token.Expires != nil && !now.Before(*token.Expires) {
+		return echo.NewHTTPError(http.StatusUnauthorized, ExpiredTokenMessage)
+	}
+	if !token.allows(group, method, path) {
+		return echo.NewHTTPError(http.StatusForbidden, ForbiddenRouteMessage)
+	}
+
+	if token.Quota != 0 {
+		// Usage is tracked by token rather than by name, so that it survives reloads.
+		usage := s.usage[token.Token]
+		period := time.Duration(token.QuotaPeriodSeconds) * time.Second
+		if usage == nil || now.Sub(usage.periodStart) >= period {
+			usage = &quotaUsage{periodStart: now}
+			s.usage[token.Token] = usage
+		}
+		if usage.requests >= token.Quota {
+			return echo.NewHTTPError(http.StatusTooManyRequests, QuotaExceededMessage)
+		}
+		usage.requests++
+	}
+	return nil
+}
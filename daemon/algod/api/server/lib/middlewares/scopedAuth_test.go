@@ -0,0 +1,215 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package middlewares
+
+import (
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+var adminToken = strings.Repeat("a", 64)
+var readToken = strings.Repeat("r", 64)
+var simulateToken = strings.Repeat("s", 64)
+
+var testRouteGroups = map[string][]*echo.Route{
+	"nonparticipating/public": {
+		{Method: "GET", Path: "/v2/status"},
+		{Method: "GET", Path: "/v2/accounts/:address"},
+		{Method: "POST", Path: "/v2/transactions/simulate"},
+	},
+	"nonparticipating/private": {
+		{Method: "POST", Path: "/v2/shutdown"},
+	},
+	"participating/private": {
+		{Method: "GET", Path: "/v2/participation"},
+	},
+}
+
+type scopedAuthFixture struct {
+	t      *testing.T
+	path   string
+	now    time.Time
+	mtime  time.Time
+	scoped *ScopedTokens
+}
+
+func makeScopedAuthFixture(t *testing.T, tokens ...ScopedToken) *scopedAuthFixture {
+	f := &scopedAuthFixture{
+		t:     t,
+		path:  filepath.Join(t.TempDir(), "scoped.json"),
+		now:   time.Unix(1_000_000, 0),
+		mtime: time.Unix(1_000_000, 0),
+	}
+	f.write(tokens...)
+	f.scoped = MakeScopedTokens(f.path, logging.TestingLog(t))
+	f.scoped.now = func() time.Time { return f.now }
+	f.scoped.SetRoutes(testRouteGroups)
+	return f
+}
+
+// write replaces the tokens file, and makes sure its modification time changes.
+func (f *scopedAuthFixture) write(tokens ...ScopedToken) {
+	data, err := json.Marshal(scopedTokensFile{Tokens: tokens})
+	require.NoError(f.t, err)
+	f.writeRaw(string(data))
+}
+
+func (f *scopedAuthFixture) writeRaw(data string) {
+	require.NoError(f.t, os.WriteFile(f.path, []byte(data), 0600))
+	f.mtime = f.mtime.Add(time.Second)
+	require.NoError(f.t, os.Chtimes(f.path, f.mtime, f.mtime))
+}
+
+// request runs a request with the token through the auth middleware of the group.
+func (f *scopedAuthFixture) request(group, method, path, token string) error {
+	handler := MakeScopedAuth(testAPIHeader, []string{adminToken}, f.scoped, group)(success)
+	req, _ := http.NewRequest(method, "N/A", nil)
+	req.Header.Set(testAPIHeader, token)
+	ctx := e.NewContext(req, nil)
+	ctx.SetPath(path)
+	return handler(ctx)
+}
+
+func httpError(code int, message string) error {
+	return echo.NewHTTPError(code, message)
+}
+
+func TestScopedAuthRoutes(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	f := makeScopedAuthFixture(t,
+		ScopedToken{Name: "read", Token: readToken, Groups: []string{"nonparticipating/public"}},
+		ScopedToken{Name: "simulate", Token: simulateToken, Routes: []string{"POST /v2/transactions/simulate"}},
+	)
+	forbidden := httpError(http.StatusForbidden, ForbiddenRouteMessage)
+
+	// The tokens given to the middleware still have access to everything.
+	require.Equal(t, errSuccess, f.request("nonparticipating/private", "POST", "/v2/shutdown", adminToken))
+
+	require.Equal(t, errSuccess, f.request("nonparticipating/public", "GET", "/v2/status", readToken))
+	require.Equal(t, errSuccess, f.request("nonparticipating/public", "GET", "/v2/accounts/:address", readToken))
+	require.Equal(t, forbidden, f.request("nonparticipating/private", "POST", "/v2/shutdown", readToken))
+	require.Equal(t, forbidden, f.request("participating/private", "GET", "/v2/participation", readToken))
+
+	require.Equal(t, errSuccess, f.request("nonparticipating/public", "POST", "/v2/transactions/simulate", simulateToken))
+	require.Equal(t, forbidden, f.request("nonparticipating/public", "GET", "/v2/status", simulateToken))
+
+	require.Equal(t, invalidTokenError, f.request("nonparticipating/public", "GET", "/v2/status", strings.Repeat("x", 64)))
+	require.Equal(t, invalidTokenError, f.request("nonparticipating/public", "GET", "/v2/status", ""))
+}
+
+func TestScopedAuthExpiry(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	f := makeScopedAuthFixture(t)
+	expires := f.now.Add(time.Hour)
+	f.write(ScopedToken{Name: "read", Token: readToken, Groups: []string{"nonparticipating/public"}, Expires: &expires})
+	f.now = f.now.Add(scopedTokensReloadInterval)
+
+	require.Equal(t, errSuccess, f.request("nonparticipating/public", "GET", "/v2/status", readToken))
+	f.now = expires.Add(-time.Second)
+	require.Equal(t, errSuccess, f.request("nonparticipating/public", "GET", "/v2/status", readToken))
+	f.now = expires
+	require.Equal(t, httpError(http.StatusUnauthorized, ExpiredTokenMessage), f.request("nonparticipating/public", "GET", "/v2/status", readToken))
+}
+
+func TestScopedAuthQuota(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	token := ScopedToken{Name: "read", Token: readToken, Groups: []string{"nonparticipating/public"}, Quota: 2, QuotaPeriodSeconds: 60}
+	f := makeScopedAuthFixture(t, token)
+	exceeded := httpError(http.StatusTooManyRequests, QuotaExceededMessage)
+
+	require.Equal(t, errSuccess, f.request("nonparticipating/public", "GET", "/v2/status", readToken))
+	require.Equal(t, errSuccess, f.request("nonparticipating/public", "GET", "/v2/status", readToken))
+	require.Equal(t, exceeded, f.request("nonparticipating/public", "GET", "/v2/status", readToken))
+
+	// Forbidden requests do not count against the quota.
+	f.now = f.now.Add(time.Minute)
+	require.Error(t, f.request("nonparticipating/private", "POST", "/v2/shutdown", readToken))
+	require.Equal(t, errSuccess, f.request("nonparticipating/public", "GET", "/v2/status", readToken))
+
+	// Usage survives reloading the file.
+	token.Name = "renamed"
+	f.write(token)
+	f.now = f.now.Add(scopedTokensReloadInterval)
+	require.Equal(t, errSuccess, f.request("nonparticipating/public", "GET", "/v2/status", readToken))
+	require.Equal(t, exceeded, f.request("nonparticipating/public", "GET", "/v2/status", readToken))
+}
+
+func TestScopedAuthReload(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	f := makeScopedAuthFixture(t, ScopedToken{Name: "read", Token: readToken, Groups: []string{"nonparticipating/public"}})
+	require.Equal(t, errSuccess, f.request("nonparticipating/public", "GET", "/v2/status", readToken))
+
+	f.write(ScopedToken{Name: "simulate", Token: simulateToken, Groups: []string{"nonparticipating/public"}})
+	// The file is not checked again until the reload interval passed.
+	require.Equal(t, errSuccess, f.request("nonparticipating/public", "GET", "/v2/status", readToken))
+	f.now = f.now.Add(scopedTokensReloadInterval)
+	require.Equal(t, invalidTokenError, f.request("nonparticipating/public", "GET", "/v2/status", readToken))
+	require.Equal(t, errSuccess, f.request("nonparticipating/public", "GET", "/v2/status", simulateToken))
+
+	// An invalid file keeps the previous tokens.
+	f.writeRaw("{not json")
+	f.now = f.now.Add(scopedTokensReloadInterval)
+	require.Equal(t, errSuccess, f.request("nonparticipating/public", "GET", "/v2/status", simulateToken))
+
+	// Removing the file revokes the tokens.
+	require.NoError(t, os.Remove(f.path))
+	f.now = f.now.Add(scopedTokensReloadInterval)
+	require.Equal(t, invalidTokenError, f.request("nonparticipating/public", "GET", "/v2/status", simulateToken))
+	require.Equal(t, errSuccess, f.request("nonparticipating/public", "GET", "/v2/status", adminToken))
+}
+
+func TestScopedAuthInvalidFile(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	f := makeScopedAuthFixture(t)
+	tests := []struct {
+		name   string
+		data   string
+		errMsg string
+	}{
+		{"unknown field", `{"tokens":[{"name":"read","token":"` + readToken + `","groups":["nonparticipating/public"],"scope":"all"}]}`, "unknown field"},
+		{"no name", `{"tokens":[{"token":"` + readToken + `","groups":["nonparticipating/public"]}]}`, "without a name"},
+		{"short token", `{"tokens":[{"name":"read","token":"short","groups":["nonparticipating/public"]}]}`, "too short"},
+		{"no scope", `{"tokens":[{"name":"read","token":"` + readToken + `"}]}`, "no groups or routes"},
+		{"unknown group", `{"tokens":[{"name":"read","token":"` + readToken + `","groups":["admin"]}]}`, "unknown route group admin"},
+		{"unknown route", `{"tokens":[{"name":"read","token":"` + readToken + `","routes":["GET /v2/accounts/{address}"]}]}`, "unknown route GET /v2/accounts/{address}"},
+		{"quota period", `{"tokens":[{"name":"read","token":"` + readToken + `","groups":["nonparticipating/public"],"quota":10}]}`, "quota without a quota period"},
+		{"duplicate name", `{"tokens":[{"name":"read","token":"` + readToken + `","groups":["nonparticipating/public"]},{"name":"read","token":"` + simulateToken + `","groups":["nonparticipating/public"]}]}`, "duplicate name"},
+		{"duplicate token", `{"tokens":[{"name":"a","token":"` + readToken + `","groups":["nonparticipating/public"]},{"name":"b","token":"` + readToken + `","groups":["nonparticipating/public"]}]}`, "duplicate token"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := f.scoped.parse([]byte(test.data))
+			require.ErrorContains(t, err, test.errMsg)
+		})
+	}
+}
@@ -72,7 +72,8 @@ func registerHandlers(router *echo.Echo, prefix string, routes lib.Routes, ctx l
 }
 
 // NewRouter builds and returns a new router with our REST handlers registered.
-func NewRouter(logger logging.Logger, node APINodeInterface, shutdown <-chan struct{}, apiToken string, adminAPIToken string, listener net.Listener, numConnectionsLimit uint64) *echo.Echo {
+// Scoped tokens are read from scopedTokensFile, unless it is empty.
+func NewRouter(logger logging.Logger, node APINodeInterface, shutdown <-chan struct{}, apiToken string, adminAPIToken string, scopedTokensFile string, listener net.Listener, numConnectionsLimit uint64) *echo.Echo {
 	if err := tokens.ValidateAPIToken(apiToken); err != nil {
 		logger.Errorf("Invalid apiToken was passed to NewRouter ('%s'): %v", apiToken, err)
 	}
@@ -119,17 +120,41 @@ func NewRouter(logger logging.Logger, node APINodeInterface, shutdown <-chan str
 		Log:      logger,
 		Shutdown: shutdown,
 	}
-	nppublic.RegisterHandlers(e, &v2Handler, apiAuthenticator)
-	npprivate.RegisterHandlers(e, &v2Handler, adminAuthenticator)
-	ppublic.RegisterHandlers(e, &v2Handler, apiAuthenticator)
-	pprivate.RegisterHandlers(e, &v2Handler, adminAuthenticator)
+	var scopedTokens *middlewares.ScopedTokens
+	if scopedTokensFile != "" {
+		scopedTokens = middlewares.MakeScopedTokens(scopedTokensFile, logger)
+	}
+	routeGroups := make(map[string][]*echo.Route)
+	// registerGroup registers a group of v2 routes, which scoped tokens can refer to by name.
+	registerGroup := func(group string, register func(m echo.MiddlewareFunc), accepted ...string) {
+		before := make(map[string]bool)
+		for _, r := range e.Routes() {
+			before[r.Method+" "+r.Path] = true
+		}
+		register(middlewares.MakeScopedAuth(TokenHeader, accepted, scopedTokens, group))
+		for _, r := range e.Routes() {
+			if !before[r.Method+" "+r.Path] {
+				routeGroups[group] = append(routeGroups[group], r)
+			}
+		}
+	}
+
+	registerGroup("nonparticipating/public", func(m echo.MiddlewareFunc) { nppublic.RegisterHandlers(e, &v2Handler, m) }, adminAPIToken, apiToken)
+	registerGroup("nonparticipating/private", func(m echo.MiddlewareFunc) { npprivate.RegisterHandlers(e, &v2Handler, m) }, adminAPIToken)
+	registerGroup("participating/public", func(m echo.MiddlewareFunc) { ppublic.RegisterHandlers(e, &v2Handler, m) }, adminAPIToken, apiToken)
+	registerGroup("participating/private", func(m echo.MiddlewareFunc) { pprivate.RegisterHandlers(e, &v2Handler, m) }, adminAPIToken)
 
 	if node.Config().EnableFollowMode {
-		data.RegisterHandlers(e, &v2Handler, apiAuthenticator)
+		registerGroup("data", func(m echo.MiddlewareFunc) { data.RegisterHandlers(e, &v2Handler, m) }, adminAPIToken, apiToken)
 	}
 
 	if node.Config().EnableExperimentalAPI {
-		experimental.RegisterHandlers(e, &v2Handler, apiAuthenticator)
+		v2Handler.BlockStream = v2.MakeBlockStream(node.LedgerForAPI())
+		registerGroup("experimental", func(m echo.MiddlewareFunc) { experimental.RegisterHandlers(e, &v2Handler, m) }, adminAPIToken, apiToken)
+	}
+
+	if scopedTokens != nil {
+		scopedTokens.SetRoutes(routeGroups)
 	}
 
 	return e
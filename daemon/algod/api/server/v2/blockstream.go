@@ -0,0 +1,273 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package v2
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/algorand/go-codec/codec"
+	"github.com/algorand/go-deadlock"
+	"github.com/labstack/echo/v4"
+
+	"github.com/algorand/go-algorand/agreement"
+	"github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated/model"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+// blockStreamBufferSize is the number of blocks queued for each block stream
+// subscriber. When a subscriber falls further behind, new blocks are dropped
+// from its queue and it catches up from the ledger instead.
+const blockStreamBufferSize = 64
+
+// blockStreamKeepAlive is how often an idle block stream sends a comment to
+// keep intermediate proxies from closing the connection.
+const blockStreamKeepAlive = 15 * time.Second
+
+// lastEventIDHeader is the header used by server-sent event clients to resume
+// a stream after the last event they received.
+const lastEventIDHeader = "Last-Event-ID"
+
+// errBlockStreamClosed is returned once the block stream has ended.
+var errBlockStreamClosed = errors.New("block stream closed")
+
+type streamedBlock struct {
+	block bookkeeping.Block
+	delta ledgercore.StateDelta
+}
+
+type blockSubscriber struct {
+	blocks chan streamedBlock
+	// lagged is signaled when a block could not be queued for the subscriber.
+	lagged chan struct{}
+}
+
+// BlockStream fans the blocks added to the ledger out to the block stream
+// subscribers. It is registered with the ledger as a block listener, and
+// never blocks the ledger: blocks are dropped for subscribers that fall behind.
+type BlockStream struct {
+	mu     deadlock.Mutex
+	latest basics.Round
+	subs   map[*blockSubscriber]struct{}
+}
+
+// MakeBlockStream creates a BlockStream and registers it with the ledger.
+func MakeBlockStream(ledger LedgerForAPI) *BlockStream {
+	s := &BlockStream{
+		latest: ledger.Latest(),
+		subs:   make(map[*blockSubscriber]struct{}),
+	}
+	ledger.RegisterBlockListeners([]ledgercore.BlockListener{s})
+	return s
+}
+
+// OnNewBlock implements the ledgercore.BlockListener interface.
+func (s *BlockStream) OnNewBlock(block bookkeeping.Block, delta ledgercore.StateDelta) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if block.Round() > s.latest {
+		s.latest = block.Round()
+	}
+	for sub := range s.subs {
+		select {
+		case sub.blocks <- streamedBlock{block: block, delta: delta}:
+		default:
+			select {
+			case sub.lagged <- struct{}{}:
+			default:
+			}
+		}
+	}
+}
+
+// Latest returns the latest round passed to the block listeners. The ledger
+// can serve the blocks and the in-memory state deltas up to this round.
+func (s *BlockStream) Latest() basics.Round {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.latest
+}
+
+// subscribe adds a subscriber. Every block after the returned round is queued
+// for the subscriber, unless it falls behind.
+func (s *BlockStream) subscribe() (*blockSubscriber, basics.Round) {
+	sub := &blockSubscriber{
+		blocks: make(chan streamedBlock, blockStreamBufferSize),
+		lagged: make(chan struct{}, 1),
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.subs[sub] = struct{}{}
+	return sub, s.latest
+}
+
+func (s *BlockStream) unsubscribe(sub *blockSubscriber) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.subs, sub)
+}
+
+// blockStreamEvent is the payload of a block event.
+type blockStreamEvent struct {
+	Block       bookkeeping.Block      `codec:"block"`
+	Certificate agreement.Certificate  `codec:"cert"`
+	Delta       *ledgercore.StateDelta `codec:"delta,omitempty"`
+}
+
+// blockStreamWriter writes server-sent events to a block stream response.
+type blockStreamWriter struct {
+	ctx          echo.Context
+	ledger       LedgerForAPI
+	handle       codec.Handle
+	deltas       bool
+	writeTimeout time.Duration
+	rc           *http.ResponseController
+	// next is the round of the next block event.
+	next basics.Round
+}
+
+// start writes the response header.
+func (w *blockStreamWriter) start() error {
+	header := w.ctx.Response().Header()
+	header.Set(echo.HeaderContentType, "text/event-stream")
+	header.Set(echo.HeaderCacheControl, "no-cache")
+	w.ctx.Response().WriteHeader(http.StatusOK)
+	return w.flush()
+}
+
+func (w *blockStreamWriter) write(event string) error {
+	// The server write timeout applies to the whole response, so it is replaced
+	// by a deadline for each event. Writers that do not support deadlines, e.g.
+	// in tests, are left alone.
+	err := w.rc.SetWriteDeadline(time.Now().Add(w.writeTimeout))
+	if err != nil && !errors.Is(err, http.ErrNotSupported) {
+		return err
+	}
+	_, err = w.ctx.Response().Write([]byte(event))
+	if err != nil {
+		return err
+	}
+	return w.flush()
+}
+
+func (w *blockStreamWriter) flush() error {
+	err := w.rc.Flush()
+	if errors.Is(err, http.ErrNotSupported) {
+		return nil
+	}
+	return err
+}
+
+// eventData formats the data field of an event, which ends the event. Every
+// line of the payload needs its own field, as the JSON encoding is indented.
+func eventData(payload string) string {
+	return "data: " + strings.ReplaceAll(payload, "\n", "\ndata: ") + "\n\n"
+}
+
+// keepAlive writes a comment, which clients ignore.
+func (w *blockStreamWriter) keepAlive() error {
+	return w.write(": keep-alive\n\n")
+}
+
+// fail writes an error event and ends the stream.
+func (w *blockStreamWriter) fail(err error, message string) error {
+	data, encErr := encode(protocol.JSONStrictHandle, model.ErrorResponse{Message: message})
+	if encErr != nil {
+		return encErr
+	}
+	if writeErr := w.write("event: error\n" + eventData(string(data))); writeErr != nil {
+		return writeErr
+	}
+	return fmt.Errorf("%w: %v", errBlockStreamClosed, err)
+}
+
+// writeBlock writes the block event for w.next. The delta is only used if
+// state deltas were requested.
+func (w *blockStreamWriter) writeBlock(block bookkeeping.Block, delta *ledgercore.StateDelta) error {
+	_, cert, err := w.ledger.BlockCert(block.Round())
+	if err != nil {
+		return w.fail(err, errFailedLookingUpLedger)
+	}
+	event := blockStreamEvent{
+		Block:       block,
+		Certificate: cert,
+	}
+	if w.deltas {
+		event.Delta = delta
+	}
+
+	data, err := encode(w.handle, event)
+	if err != nil {
+		return w.fail(err, errFailedToEncodeResponse)
+	}
+	var payload string
+	if w.handle == protocol.CodecHandle {
+		payload = base64.StdEncoding.EncodeToString(data)
+	} else {
+		payload = string(data)
+	}
+
+	err = w.write(fmt.Sprintf("id: %d\nevent: block\n", block.Round()) + eventData(payload))
+	if err != nil {
+		return err
+	}
+	w.next = block.Round() + 1
+	return nil
+}
+
+// catchUp writes the block events up to and including round upTo from the
+// ledger.
+func (w *blockStreamWriter) catchUp(upTo basics.Round) error {
+	for w.next <= upTo {
+		block, err := w.ledger.Block(w.next)
+		if err != nil {
+			return w.fail(err, errFailedLookingUpLedger)
+		}
+		var delta *ledgercore.StateDelta
+		if w.deltas {
+			sDelta, err := w.ledger.GetStateDeltaForRound(w.next)
+			if err != nil {
+				return w.fail(err, fmt.Sprintf(errFailedRetrievingStateDelta, err))
+			}
+			delta = &sDelta
+		}
+		if err := w.writeBlock(block, delta); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// parseLastEventID returns the round to resume a block stream from, given the
+// Last-Event-ID header sent by a reconnecting client.
+func parseLastEventID(id string) (basics.Round, error) {
+	rnd, err := strconv.ParseUint(id, 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	return basics.Round(rnd) + 1, nil
+}
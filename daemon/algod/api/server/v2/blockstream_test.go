@@ -0,0 +1,85 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package v2
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestBlockStreamBackPressure(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	s := &BlockStream{latest: 5, subs: make(map[*blockSubscriber]struct{})}
+	slow, latest := s.subscribe()
+	require.Equal(t, basics.Round(5), latest)
+
+	newBlock := func(rnd basics.Round) {
+		var blk bookkeeping.Block
+		blk.BlockHeader.Round = rnd
+		s.OnNewBlock(blk, ledgercore.StateDelta{})
+	}
+
+	// Fill the queue of the slow subscriber, then overflow it.
+	for i := 0; i < blockStreamBufferSize+2; i++ {
+		newBlock(latest + basics.Round(i+1))
+	}
+	require.Equal(t, latest+blockStreamBufferSize+2, s.Latest())
+	require.Len(t, slow.blocks, blockStreamBufferSize)
+	require.Len(t, slow.lagged, 1)
+
+	// A new subscriber is not affected by the slow one.
+	fast, latest := s.subscribe()
+	newBlock(latest + 1)
+	require.Len(t, fast.blocks, 1)
+	require.Len(t, fast.lagged, 0)
+	require.Equal(t, latest+1, (<-fast.blocks).block.Round())
+
+	// Blocks are queued in order, and the overflow did not replace queued blocks.
+	require.Equal(t, basics.Round(6), (<-slow.blocks).block.Round())
+
+	s.unsubscribe(slow)
+	s.unsubscribe(fast)
+	newBlock(s.Latest() + 1)
+	require.Len(t, fast.blocks, 0)
+	require.Empty(t, s.subs)
+
+	// An old block does not move the latest round back.
+	newBlock(1)
+	require.Equal(t, latest+2, s.Latest())
+}
+
+func TestParseLastEventID(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	rnd, err := parseLastEventID("41")
+	require.NoError(t, err)
+	require.Equal(t, basics.Round(42), rnd)
+
+	_, err = parseLastEventID("-1")
+	require.Error(t, err)
+	_, err = parseLastEventID("round")
+	require.Error(t, err)
+}
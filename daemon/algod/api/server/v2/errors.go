@@ -32,6 +32,7 @@ var (
 	errFailedRetrievingSyncRound               = "failed retrieving sync round from ledger"
 	errFailedSettingSyncRound                  = "failed to set sync round on the ledger"
 	errFailedParsingFormatOption               = "failed to parse the format option"
+	errFailedParsingLastEventID                = "failed to parse the Last-Event-ID header"
 	errFailedToParseAddress                    = "failed to parse the address"
 	errFailedToParseExclude                    = "failed to parse exclude"
 	errFailedToEncodeResponse                  = "failed to encode response"
@@ -48,4 +49,6 @@ var (
 	errRESTPayloadZeroLength                   = "payload was of zero length"
 	errRoundGreaterThanTheLatest               = "given round is greater than the latest round"
 	errFailedRetrievingTracer                  = "failed retrieving the expected tracer from ledger"
+	errBlockStreamNotAvailable                 = "block stream is not available"
+	errFailedRetrievingConsensusState          = "failed retrieving agreement state: %v"
 )
@@ -114,185 +114,228 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+x9a5PcNpLgX0HUboQsXbFbL3tHipjYa0u2p8+yrVC3Pbcr6WwUmVWFaRbAAcDuKuv0",
-	"3y+QCZAgCVaxH5ZmLuaT1EU8EolEIl/I/DDL1aZSEqQ1s+cfZhXXfAMWNP7F81zV0maicH8VYHItKiuU",
-	"nD0P35ixWsjVbD4T7teK2/VsPpN8A20b138+0/D3WmgoZs+trmE+M/kaNtwNbHeVa92MtM1WKvNDnNAQ",
-	"py9nH/d84EWhwZghlD/JcseEzMu6AGY1l4bn7pNhV8KumV0Lw3xnJiRTEphaMrvuNGZLAWVhjsIi/16D",
-	"3kWr9JOPL+ljC2KmVQlDOF+ozUJICFBBA1SzIcwqVsASG625ZW4GB2toaBUzwHW+ZkulD4BKQMTwgqw3",
-	"s+dvZwZkARp3Kwdxif9daoDfIbNcr8DO3s9Ti1ta0JkVm8TSTj32NZi6tIZhW1zjSlyCZK7XEfuhNpYt",
-	"gHHJ3nz7gj158uSZW8iGWwuFJ7LRVbWzx2ui7rPns4JbCJ+HtMbLldJcFlnT/s23L3D+M7/Aqa24MZA+",
-	"LCfuCzt9ObaA0DFBQkJaWOE+dKjf9UgcivbnBSyVhol7Qo3vdFPi+T/rruTc5utKCWkT+8LwK6PPSR4W",
-	"dd/HwxoAOu0rhyntBn37MHv2/sOj+aOHH//t7Un23/7PL598nLj8F824BzCQbJjXWoPMd9lKA8fTsuZy",
-	"iI83nh7MWtVlwdb8Ejefb5DV+77M9SXWecnL2tGJyLU6KVfKMO7JqIAlr0vLwsSslqVjU240T+1MGFZp",
-	"dSkKKOaO+16tRb5mOTc0BLZjV6IsHQ3WBooxWkuvbs9h+hijxMF1I3zggv5xkdGu6wAmYIvcIMtLZSCz",
-	"6sD1FG4cLgsWXyjtXWWud1mx8zUwnNx9oMsWcScdTZfljlnc14JxwzgLV9OciSXbqZpd4eaU4gL7+9U4",
-	"rG2YQxpuTucedYd3DH0DZCSQt1CqBC4ReeHcDVEml2JVazDsag127e88DaZS0gBTi79Bbt22/6+zn35k",
-	"SrMfwBi+gtc8v2Agc1VAccROl0wqG5GGpyXEoes5tg4PV+qS/5tRjiY2ZlXx/CJ9o5diIxKr+oFvxabe",
-	"MFlvFqDdloYrxCqmwdZajgFEIx4gxQ3fDic917XMcf/baTuynKM2YaqS7xBhG77988O5B8cwXpasAlkI",
-	"uWJ2K0flODf3YfAyrWpZTBBzrNvT6GI1FeRiKaBgzSh7IPHTHIJHyOvB0wpfEThhkFFwmlkOgCNhm6AZ",
-	"d7rdF1bxFUQkc8R+9swNv1p1AbIhdLbY4adKw6VQtWk6jcCIU++XwKWykFUaliJBY2ceHY7BUBvPgTde",
-	"BsqVtFxIKBxzRqCVBWJWozBFE+7Xd4a3+IIb+Orp2B3ffp24+0vV3/W9Oz5pt7FRRkcycXW6r/7ApiWr",
-	"Tv8J+mE8txGrjH4ebKRYnbvbZilKvIn+5vYvoKE2yAQ6iAh3kxEryW2t4fk7+cD9xTJ2ZrksuC7cLxv6",
-	"6Ye6tOJMrNxPJf30Sq1EfiZWI8hsYE0qXNhtQ/+48dLs2G6TesUrpS7qKl5Q3lFcFzt2+nJsk2nM6xLm",
-	"SaPtxorH+TYoI9ftYbfNRo4AOYq7iruGF7DT4KDl+RL/2S6RnvhS/+7+qarS9bbVMoVaR8f+SkbzgTcr",
-	"nFRVKXLukPjGf3ZfHRMAUiR42+IYL9TnHyIQK60q0FbQoLyqslLlvMyM5RZH+ncNy9nz2b8dt/aXY+pu",
-	"jqPJX7leZ9jJiawkBmW8qq4xxmsn+pg9zMIxaPyEbILYHgpNQtImOlISjgWXcMmlPWpVlg4/aA7wWz9T",
-	"i2+SdgjfPRVsFOGMGi7AkARMDe8ZFqGeIVoZohUF0lWpFs0PX5xUVYtB/H5SVYQPlB5BoGAGW2GsuY/L",
-	"5+1Jiuc5fXnEvovHRlFcyXLnLgcSNdzdsPS3lr/FGtuSX0M74j3DcDuVPnJbE9DgxPy7oDhUK9aqdFLP",
-	"QVpxjf/i28Zk5n6f1Pmfg8Ri3I4TFypaHnOk4+AvkXLzRY9yhoTjzT1H7KTf92Zk40ZJE8yNaGXvftK4",
-	"e/DYoPBK84oA9F/oLhUSlTRqRLDekptOZHRJmKMzHNEaQnXjs3bwPCQhQVLowfB1qfKLv3CzvoMzvwhj",
-	"DY8fTsPWwAvQbM3N+miWkjLi49WONuWIuYao4LNFNNVRs8S7Wt6BpRXc8mhpHt60WEKox37I9EAndJef",
-	"8D+8ZO6zO9uO9dOwR+wcGZih4+ydDIXT9klBoJlcA7RCKLYhBZ85rftaUL5oJ0/v06Q9+oZsCn6H/CJw",
-	"h9T2zo/B12qbguFrtR0cAbUFcxf04cZBMdLCxkyA76WHTOH+e/RxrfluiGQcewqS3QKd6GrwNMj4xnez",
-	"tMbZk4XSN+M+PbYiWWtyZtyNGjHfeQ9J2LSuMk+KCbMVNegN1Hr59jON/vApjHWwcGb5H4AF40a9Cyx0",
-	"B7prLKhNJUq4A9JfJ5n+ght48pid/eXky0ePf3385VeOJCutVppv2GJnwbAvvG7GjN2VcH+4MtSO6tKm",
-	"R//qaTBUdsdNjWNUrXPY8Go4FBlASQSiZsy1G2Kti2ZcdQPglMN5Do6TE9oZ2fYdaC+FcRLWZnEnmzGG",
-	"sKKdpWAekgIOEtN1l9dOs4uXqHe6vgtVFrRWOmFfwyNmVa7K7BK0ESrhTXntWzDfIoi3Vf93gpZdccPc",
-	"3Gj6rSUKFAnKsls5ne/T0Odb2eJmL+en9SZW5+edsi9d5AdLomEV6MxuJStgUa86mtBSqw3jrMCOeEd/",
-	"BxZFgXOxgTPLN9VPy+XdqIoKB0qobGIDxs3EqIWT6w3kSlIkxAHtzI86BT19xAQTnR0HwGPkbCdztDPe",
-	"xbEdV1w3QqLTw+xkHmmxDsYSilWHLG+vrY6hg6a6ZxLgOHS8ws9o6HgJpeXfKn3eWgK/06qu7lzI6885",
-	"dTncL8abUgrXN+jQQq7KbvTNysF+lFrjZ1nQi3B8/RoQeqTIV2K1tpFa8Vortbx7GFOzpADFD6SUla7P",
-	"UDX7URWOmdja3IEI1g7WcjhHtzFf4wtVW8aZVAXg5tcmLZyNxGugoxj92zaW9+ya9KwFOOrKee1WW1cM",
-	"vbeD+6LtmPGcTmiGqDEjvqvG6UitaDqKBSg18GLHFgCSqYV3EHnXFS6So+vZBvHGi4YJftGBq9IqB2Og",
-	"yLxh6iBooR1dHXYPnhBwBLiZhRnFllzfGtiLy4NwXsAuw0AJw774/hdz/zPAa5Xl5QHEYpsUehs133sB",
-	"h1BPm34fwfUnj8mOa2DhXmFWoTRbgoUxFF4LJ6P714dosIu3R8slaPTH/aEUHya5HQE1oP7B9H5baOtq",
-	"JPzPq7dOwnMbJrlUQbBKDVZyY7NDbNk16ujgbgURJ0xxYhx4RPB6xY0lH7KQBZq+6DrBeUgIc1OMAzyq",
-	"hriRfwkayHDs3N2D0tSmUUdMXVVKWyhSa5Cw3TPXj7Bt5lLLaOxG57GK1QYOjTyGpWh8jyxaCSGI28bV",
-	"4oMshotDh4S753dJVHaAaBGxD5Cz0CrCbhwCNQKIMC2iiXCE6VFOE3c1nxmrqspxC5vVsuk3hqYzan1i",
-	"f27bDomL2/beLhQYjLzy7T3kV4RZCn5bc8M8HGzDL5zsgWYQcnYPYXaHMTNC5pDto3xU8Vyr+AgcPKR1",
-	"tdK8gKyAku+Gg/5Mnxl93jcA7nir7ioLGUUxpTe9peQQNLJnaIXjmZTwyPALy90RdKpASyC+94GRC8Cx",
-	"U8zJ09G9ZiicK7lFYTxcNm11YkS8DS+VdTvu6QFB9hx9CsAjeGiGvjkqsHPW6p79Kf4LjJ+gkSOuP8kO",
-	"zNgS2vGvtYARG6oPEI/OS4+99zhwkm2OsrEDfGTsyI4YdF9zbUUuKtR1vofdnat+/QmSbkZWgOWihIJF",
-	"H0gNrOL+jOJv+mPeTBWcZHsbgj8wviWWUwqDIk8X+AvYoc79mgI7I1PHXeiyiVHd/cQlQ0BDuJgTweMm",
-	"sOW5LXdOULNr2LEr0MBMvdgIaylgu6vqWlVl8QBJv8aeGb0Tj4Iiww5M8Sqe4VDR8oZbMZ+RTrAfvvOe",
-	"YtBBh9cFKqXKCRayATKSEEyK92CVcrsufOx4iB4OlNQB0jNt9OA21/8900EzroD9l6pZziWqXLWFRqZR",
-	"GgUFFCDdDE4Ea+b0kR0thqCEDZAmiV8ePOgv/MEDv+fCsCVchQcXrmEfHQ8eoB3ntTK2c7juwB7qjttp",
-	"4vpAh4+7+LwW0ucphyML/MhTdvJ1b/DGS+TOlDGecN3yb80AeidzO2XtMY1Mi6rAcSf5cqKhU+vGfT8T",
-	"m7rk9i68VnDJy0xdgtaigIOc3E8slPzmkpc/Nd3wMQnkjkZzyHJ8AjFxLDh3fejVxCHdsI0mE5sNFIJb",
-	"KHes0pADRfk7kc80MB4xiv/L11yuUNLXql75ADQaBzl1bcimoms5GCIpDdmtzNA6neLcPug4PPRwchBw",
-	"p4v1TdukeVzxZj7/tmfKlRohr2/qT3q35rNRVdUh9bJVVQk53dcqE7h4R1CL8NNOPNEHgqhzQssQX/G2",
-	"uFPgNvePsbW3Q6egHE4chcS1H8ei4pyeXO7uQFqhgZiGSoPBuyW2Lxn6qpbxyzR/+ZidsbAZmuCp668j",
-	"x+/NqKKnZCkkZBslYZd8jC0k/IAfk8cJ77eRzihpjPXtKw8d+HtgdeeZQo23xS/udv+E9l1N5lul78qX",
-	"SQNOlssnuA4P+sn9lDd1cPKyTPgE/buVPgMw8+advNCMG6NygcLWaWHmdNC8G9E/cumi/3UTjXsHZ68/",
-	"bs/5FT+JROMulBXjLC8Fmn6VNFbXuX0nORqXoqUmopaCFj1ubnwRmqTtmwnzox/qneQYsdaYnJKRFktI",
-	"2Fe+BQhWR1OvVmBsT0lZAryTvpWQrJbC4lwbd1wyOi8VaAwdOqKWG75jS0cTVrHfQSu2qG1XbMdnWcaK",
-	"svSeODcNU8t3kltWAjeW/SDk+RaHC976cGQl2CulLxospG/3FUgwwmTp6Krv6CsGvvrlr30QLD6jp8/k",
-	"u3Hjt2+3dmh7ap+G/58v/vP525Psv3n2+8Ps2f84fv/h6cf7DwY/Pv745z//3+5PTz7++f5//ntqpwLs",
-	"qUdDHvLTl16lPX2JekvrvBnA/skM9xshsySRxWEYPdpiX+ADWU9A97tWLbuGd9JupSOkS16KwvGWm5BD",
-	"/4YZnEU6HT2q6WxEz4oV1npNbeAWXIYlmEyPNd5YihoGJKaf56E30b+4w/OyrCVtZZC+6fVJCAxTy3nz",
-	"BJOyszxn+D5vzUNUo//z8Zdfzebtu7rm+2w+81/fJyhZFNvU68kCtiklzx8QPBj3DKv4zoBNcw+EPRkD",
-	"R0EZ8bAb2CxAm7WoPj2nMFYs0hwuxPR7Y9FWnkoKtnfnB32TO+/yUMtPD7fVAAVUdp3K2tAR1LBVu5sA",
-	"vXiRSqtLkHMmjuCob6wpnL7oo/FK4EvMHoDap5qiDTXngAgtUEWE9XghkywiKfpBkcdz64/zmb/8zZ2r",
-	"Q37gFFz9ORtHZPjbKnbvu2/O2bFnmOYePeSloaOnlwlV2r8u6kQSOW5GuWpIyHsn38mXsBRSuO/P38mC",
-	"W3684Ebk5rg2oL/mJZc5HK0Uex4eLL3klr+TA0lrNJ1U9FSMVfWiFDm7iBWSljwpRchwhHfv3vJypd69",
-	"ez8IqhiqD36qJH+hCTInCKvaZj7BQabhiuuU08o0D9xxZMpgsm9WErJVTZbNkEDBj5/mebyqTP+h63D5",
-	"VVW65UdkaPwzTrdlzFilgyziBBSCBvf3R+UvBs2vgl2lNmDYbxtevRXSvmfZu/rhwyfAOi8/f/NXvqPJ",
-	"XQWTrSujD3H7RhVcOKmVsLWaZxVfpXxj7969tcAr3H2Ulzdo4yhLht06L05DRD0O1S4g4GN8AwiOa7+e",
-	"w8WdUa+QzCq9BPyEW4htnLjReuxvul/RG9Qbb1fvHetgl2q7ztzZTq7KOBIPO9PkuFk5ISuEURixQm3V",
-	"pwNaAMvXkF/4PC2wqexu3ukeInW8oBlYhzCUwYdekGEOCfQsLIDVVcG9KM7lrv+Y34C1IR74DVzA7ly1",
-	"KSiu83q/+5jcjB1UpNRIunTEGh9bP0Z/8304GCr2VRXeZOPjvEAWzxu6CH3GDzKJvHdwiFNE0XnsPIYI",
-	"rhOIIOIfQcENFurGuxXpp5bntIwF3XyJbD6B9zPfpFWefORWvBq0utP3DWA6MHVl2II7uV35TFb0YDri",
-	"YrXhKxiRkGPnzsRnyR2HEA5y6N5L3nRq2b/QBvdNEmRqnLk1JykF3BdHKqjM9OL1wkzkP/SeCUxQ6RG2",
-	"KFFMagIbielw3XGyUca9MdDSBAxatgJHAKOLkViyWXMTkmxhLrJwlifJAH9gAoB9aV9Oo1CzKOFYk9Ql",
-	"8Nz+OR1olz75S8j4EtK8xKrlhJQtTsLH6PbUdiiJAlABJaxo4dQ4EEqbjKDdIAfHT8tlKSSwLBW1FplB",
-	"o2vGzwFOPn7AGFng2eQRUmQcgY1+cRyY/ajisylX1wFS+mQKPIyNHvXob0i/+6I4bifyqMqxcDHi1coD",
-	"B+A+1LG5v3oBtzgME3LOHJu75KVjc17jawcZZB9BsbWXa8RHZtwfE2f3OEDoYrnWmugquslqYpkpAJ0W",
-	"6PZAvFDbjB5+JiXexXbh6D0Z2o7PUFMHk/K83DNsobYY7YNXC4VSH4BlHI4ARqThb4VBesV+Y7c5AbNv",
-	"2v3SVIoKDZKMN+c15DImTkyZekSCGSOXL6LULTcCoGfsaPMge+X3oJLaFU+Gl3l7q83blGTh1VDq+I8d",
-	"oeQujeBvaIVpkq287kssSTtFN2ilm2cmEiFTRO/YxNBJM3QFGSgBlYKsI0RlFynPqdNtAG+cs9AtMl5g",
-	"Nhsud/ejSCgNK2EstEb0ECfxOcyTHJPoKbUcX52t9NKt741SzTVFbkTs2FnmJ18BhhIvhTY2Qw9Ecgmu",
-	"0bcGlepvXdO0rNSNtaKUs6JI8wac9gJ2WSHKOk2vft7vX7ppf2xYoqkXyG+FpICVBaZITkZg7pmagnT3",
-	"LvgVLfgVv7P1TjsNrqmbWDty6c7xT3Iuepx3HztIEGCKOIa7NorSPQwyejk75I6R3BT5+I/2WV8Hh6kI",
-	"Yx+M2gnvd8fuKBopuZbIYLB3FQLdRE4sETbKMDx80jpyBnhViWLbs4XSqKMaM7+WwSPkZethAXfXD3YA",
-	"A5HdM/WqRoPppuBrBXzKFd3JgHM0CTPn3UR5MUOIpxImVDoYIqp5dXcIV+fAy+9h94tri8uZfZzPbmc6",
-	"TeHaj3gA16+b7U3iGV3zZErreEKuiXJeVVpd8jLzBuYx0tTq0pMmNg/26E/M6tJmzPNvTl699uB/nM/y",
-	"ErjOGlFhdFXYrvqnWRVl+xs5ICGTutP5gsxOomS0+U2KstgofbUGn5I6kkYHuTNbh0N0FL2RepmOEDpo",
-	"cva+EVriHh8JVI2LpDXfkYek6xXhl1yUwW4WoB2J5sHFTUvAmuQK8QC39q5ETrLsTtnN4HSnT0dLXQd4",
-	"UjzXnqTZG8oLb5iSfRc6xjzvKu9133DMfElWkSFzkvUGLQmZKUWetrHKhXHEIcl35hozbDwijLoRazHi",
-	"ipW1iMZyzabktukBGc2RRKZJptdpcbdQvuZPLcXfa2CiAGndJ42nsndQMU2Kt7YPr1MnOwzn8gOThb4d",
-	"/jYyRpz1tX/jIRD7BYzYUzcA92WjMoeFNhYp90PkkriGwz+ecXAl7nHWe/rw1EzBi+uuxy0u0TPkf44w",
-	"KFf74fpAQXn16WdH5kjW+xEmW2r1O6T1PFSPEw+WQp5bgVEuv0P80CGuctFhMY11py1b1M4+ut1j0k1s",
-	"heoGKYxQPe585JbDhJvBQs0lbTU9JOnEuqUJJo4qPabxW4LxMA8icUt+teCpbKROyHAwnbQO4I4t3SoW",
-	"Ogfcm+a1Bc3OIl9y01bQY/QKdPuWcJjY5oYCA007WVRoJQOk2lgmmJP/rzQqMUwtr7ikKi6uHx0l39sA",
-	"Gb9cryulMZWESZv9C8jFhpdpyaHIhybeQqwEFSipDUQVMPxAVPyJqMhXEWneEHnUnC7Zw3lUhsfvRiEu",
-	"hRGLErDFI2qx4AY5eWOIarq45YG0a4PNH09ovq5loaGwa0OINYo1Qh2qN43zagH2CkCyh9ju0TP2Bbrt",
-	"jLiE+w6L/n6ePX/0DI2u9MfD1AXgC8zs4yYFspO/enaSpmP0W9IYjnH7UY+Sr+6pwtw449pzmqjrlLOE",
-	"LT2vO3yWNlzyFaQjRTYHYKK+uJtoSOvhRRZUHslYrXZM2PT8YLnjTyPR5479ERgsV5uNsBvv3DFq4+ip",
-	"LW9Bk4bhqNaSz0wc4Aof0UdaBRdRT4n8tEZTut9Sq0ZP9o98A120zhmn/CGlaKMXQr50dhrSE2Gq5iZD",
-	"M+HGzeWWjmIOBjMsWaWFtKhY1HaZ/Ynla6557tjf0Ri42eKrp4n01N00qfJ6gH9yvGswoC/TqNcjZB9k",
-	"CN+XfSGVzDaOoxT329ce0akcdeam3XZjvsP9Q08Vytwo2Si51R1y4xGnvhXhyT0D3pIUm/Vcix6vvbJP",
-	"Tpm1TpMHr90O/fzmlZcyNkqncg62x91LHBqsFnCJsXvpTXJj3nIvdDlpF24D/ef1PASRMxLLwllOKQJf",
-	"q4R2GlKmN5Z0H6uesA6MHVP3wZHBwg81Z9301J+ej95NFFTa0xUM20PHlvsS8IB/9BHxmckFN7D15dNK",
-	"RgglSs+fJJmi+R752Dn7Wm2nEk7vFAbi+QdAURIltSiLX9qXn73qB5rLfJ30mS1cx1/bOm3N4ugOTKYP",
-	"XHMpoUwOR/Lmr0EuTUjOf1NT59kIObFtvyADLbe3uBbwLpgBqDChQ6+wpZsgxmr3UV0TtF2uVMFwnjZX",
-	"XXtch4U8onTrf6/B2NQDJfxAgWNoG3XsgLJ9M5AFaqRH7DsqxbwG1klEhJpgyBTRfTVdV6XixRwzWJx/",
-	"c/KK0azUh6oNUbbxFSpC3VX0bGJRGs5pIcihcFD6ecT0cfbHa7tVG5s1ycFTD1BdizZ9uej5CVBFirFz",
-	"xF5GRVXpraobgmECE71xWl0zGslHSBPuP9byfI1qX4e1jpP89DT5gSpNVJqyKTHV5KbEc+fg9pnyKVH+",
-	"nCmnm18JQxV44RK6b16bB+De7BDewHaXp2spiVKOrnHLNZkor4v2ABxdkcGVkISsh/hrCv1UZeK6VQPO",
-	"sFcyVVa/BMGgJiW9oGxKB4XK6jmXSoocE1WlrmhfqneKn21CTq++ITcccX9CE4crWfigCcXzWBwthRAY",
-	"oUfc0NAffXWbStRBf1qsCbvmlq3AGs/ZoJiH+h3e1iikAZ9rFAs7R3xS6Y7vEjlk0h2eNW6Ta5IRPr0Z",
-	"UR6/dd9+9KYFjEm/EBKVCI82L/iRNRAriVqneQjLVgqMX0/3/bF56/oc4VPcArbvj0LlURyDXH9u2eTn",
-	"Hg51Erze3svs2r5wbX2CpObnTpQzTXpSVX7S8eouSXnAbuUoghPeyyy4jyLkNuPHo+0ht73hKnifOkKD",
-	"S3R2Q4X38IAwmkonvSpaTmglisIWjMLEklkShEyA8UpIaOviJi6IPHkl4MbgeR3pZ3LNLYmAk3jaOfAS",
-	"Pdwphmasd2/cdqh+eiiHElxjmGN8G9siLSOMo2nQCm5c7ppyvI66I2HiBdYB94gcllxBqcoLUQW+WugV",
-	"YUkxDse4Q5mn7gUwPAZDmYi6Y660695EYw9RF3WxApvxokilfv0avzL8yooaJQfYQl43KUKriuWYd6Wb",
-	"iGZIbX6iXElTb/bMFRrccrqoqlGCGuLKSmGH8aHLYof/pvJjju+MD/S4dqhhiOoorpd9aRg6mZJ6HU1n",
-	"Rqyy6ZjAO+X26Ginvhmht/3vlNJLteoC8onTT+zjcvEepfjbN+7iiLMzDJK+0tXSJE/AwD4ValGi2tg8",
-	"++1yJbzKBllg0aHU1Lrbb4AYr1o3x8tvJLw3SrrB6X4lD+VYkG8+GpPOrX8dZznby4JGXxxRhBC9LUIo",
-	"0tbZsaggCgpynwe9p0mGAznbphMfRggN4WZDgL4Psays4sK731tmMcSsj3ofvkOYEg/bbnB/ET6WfNRi",
-	"9/3lWNx3SMaG3/tVrS7AP5mvNFwKVQfHdoh8Cioh/dqpEdVE3ifXPzS84lSf1xw6arw999UFaJleJ//+",
-	"F4qTYyCt3v0DmHIHmz6olzWUdsk81TZhTWLqSYmqO7filESFqZx4XjbsVOw6UG9sQFYvp4gDw/ph89lp",
-	"ca0LM5VXcUajpI5duhrYeNqpNtUUHrFKGdHmh0+VCZsYYniOlb6itFnDsUJ8zyXkFosCtHELGuA6SbTc",
-	"ZFHh0X+lnxpRp5tITJ91al+qqWElgAN3/OA1WPSikbKoH01PrHTSRKchn8ZsyCuQvvZn953H5Gjz5RJy",
-	"Ky4PvL776xpk9LJrHuwyVMM7eownmuhlTN5yfatjC9C+x3F74YmSKN4anLG3Nxewu2dYhxqSad3n4aq9",
-	"Sd4OxAByh8yRiDKp6A8yJHuHvDANZSAWQrQVdYc2A9poRajoLekN5wok6S6O9n3pninTJWkmzeW6XuvV",
-	"NQbijj3QG1a0GNc/XmIBEdNUawx5P2ItnZ0OsyNe+bwh+Fay8Z2EDCJgwm/hYTTNUooLiGtWoafqiusi",
-	"tEiaXoJVJ9tzHw1e1YVqDH2gl83Moo2NHb6jSuTbwgjovFROjMjGwsi74ahNLMc9Q0E3lP4dA20dXEvQ",
-	"vrYfyr+lMpBZFWJp98GxDxUUWXQjJJjRHJcE3GjmmTdtah3M9csx0wz3AUXxApmGDXfQ6SgBzvic+5D9",
-	"gr6Hh0Mh1+tBC1NDr4eLDoSoaGEGSIypfsn8bXn4QdJNjE1CSqofbVLZcCTorjek0qqoc7qg44PRGOQm",
-	"55raw0qSdpp8uMqejhC96ryA3TEpQaFaQ9jBGGiSnAj0KItCb5Pv1PxmUnCv7gS8z2m5ms8qpcpsxNlx",
-	"Okzh06f4C5FfQMHcTRGiB0cq6LAv0MbeeLOv1ruQsqaqQEJx/4ixE0nx2sGx3c0h3Ztc3rP75t/irEVN",
-	"WbW8Ue3onUwHvmK+K31LbhaG2c/DDDhWd8upaJADCWK2I+mDNL9K1JM6mqqVD13N/Ro/LVERFCmZpC1f",
-	"cyBOpgmRaSt/tGEyQ+mgLNVVhlSUNfm/UjqHa9dlkiHjadvNYXsBUbwNN/4C3bE1L1iutIY87pF+4kBA",
-	"bZSGrFQYfpPyDC6tk4c2GNcsWalWTFVOzaU0esGHkixLE811VyV46LkuQZCRw2ckIQIY/zzXg0uNh/Du",
-	"qYJz/Qo75+uE3QY3LOzWtcvoeIK7dvWLCMwJhH7YZnWSqhLUXVe/XtVY9TirNiJPo/ufK1plNMYkRb0p",
-	"VPgEtPQADpvhAY95SuOcxNMzRDNIvihTHhvmj5930iCdu//iDdYfly3BM5cRfpZ4gLlv1anKT4ldbaby",
-	"hanCm8oRCkk6vPf7l6ka4GKql7nJOD2RGUQAjPudOzBM8j5fF4wlVtfMeALJp43MP+8UPxY9jheyAdLJ",
-	"zjnp/Gtgbuxag3/jR2UAe3WHKm7XQQZwzYeaudPywOADPCqewg3ZkYI9y9cg7AtXqspKuISOO94/PKzz",
-	"HIwRlxDXL6TOrACo0Lrb1zlSfuaYt/cEUb/2LPJUTsFuUjIlxNJOsQNiZ1JI3sqMjomZepQcRJeiqHkH",
-	"f+YWldzGirglLp8A6/tpnOLaTCK9uH0s4mBkCNJ88lzKdGBI/O61MSnhbEVjeiYibE+2qfiVHFfBhkTZ",
-	"yk7TayBGiP1mCzneQ93Ih9vjhOFgzPTetI8KTbrZ4Zuq8qNUto/IBhUhk1KbgVDRN04/EwRf3zch7ZLR",
-	"UZjEAMK0vAHjKKGN04uabfiOFWK5BE1uFWO5LLgu4uZCshy05cLpmDtzcwXDQatrmB/UMRynxkEDs0pp",
-	"G2ghJEDKnVfexuT/CXI7+tASMjtd21aNFasc7Er6YQffOj0HI9xGiMA/SUcthw6rkihisg2/gGvOY8Tv",
-	"sH8aTBTjrbBW4axTpvi4l9Z/QtThgf9ZCruX2kn064cckk+IiDHQoFy1jmnanCENpqJEz6lkUhwp2q9A",
-	"EPaaDFQ0H4xkVPS8M0Oeava4fMFEtZJyb7IbigMDZkzAzH0E7bWkhb65IT/AlJIseuRMdGV1tUTqxE2h",
-	"iwnjBhp2PO9HtHSvoGbbsfpnXmsUoq747nBitvYaSgcD08hBnQkxDg3UfquJwAwVlEjmPbuOeJKg+VRN",
-	"hWHGqbtfDEW5t364P2453tKeXkBcoX0/vbWCfCCVBK1xuUsdnWBLvsECx6STCXGad7ZVzWn5IzYoyaJv",
-	"loh0EmjDmL0ENqPKwfvDKOI8xe0DaE2hn+h2DfpQn1/80OpJ02oYhw4HwIuja6IqxsHR4cH5zC+Jf2iQ",
-	"Ei3l/RgldJZ/KGDHL7BVLKMt8rKatUBZ4+n1WXdfomgs86IJchoruN2PhcKkxE44KMtEDBWJj1TiNiIc",
-	"d0/qS15++jgozFZ9gviA4s245zQOpImRTKg0N3vG94pPmjsKmrm7qeVrjNv6K7g9Sl4LfiivsQ6YPwr/",
-	"vCQr/zLUu7wEya5wTAr6fvQVW/g0J5WGXJi+JnwVSlE1cSNYmdE/ndzaA4Eqh9b5i7K3IONlMCyxH9uy",
-	"NmjIXskWwvaIfmamMnJyk1Seor4BWSTwl+JRcb7RA9fFRScavJXqohtNabjjqPDofdc1o8KHmVSnLo8i",
-	"n92lUxsYrnPybd3BbeKibtc29UnDELn7ap9MeYmQLmnkuuNTCEII1gNjCCr77dFvTMMSC/4q9uABTvDg",
-	"wdw3/e1x97M7zg8eJJW8T/YIgnDkx/Dzpijml7Fn8fT0eyQDQ28/alEWhwijk0+jLZmNGSN+9Vl7PkvR",
-	"7l8pMHN4VH3h1FtEkxNiEmvtTB5NFWXKmJAkw3dLpMTAoIe81sLuMJlw0HjFr8nnGt81ob8+dLwx4fm7",
-	"z6oLaNJRt4HCtQm363eKl3gfkWVRultIlUfsmy3fVCX4g/Lne4v/gCd/elo8fPLoPxZ/evjlwxyefvns",
-	"4UP+7Cl/9OzJI3j8py+fPoRHy6+eLR4Xj58+Xjx9/PSrL5/lT54+Wjz96tl/3HN8yIFMgM5C6rrZ/8bK",
-	"9tnJ69Ps3AHb4oRX4nvYURFdR8ahPC/P8STChoty9jz89D/DCTvK1aYdPvw685mxZmtrK/P8+Pjq6uoo",
-	"7nK8wsjAzKo6Xx+HeQb1e09enzYuSDL6445SUongzAmkcILf3nxzds5OXp8etQQzez57ePTw6JEbX1Ug",
-	"eSVmz2dP8Cc8PWvc92NPbLPnHz7OZ8dr4CUG0rs/NmC1yMMnDbzY+f+bK75agT7yNYvdT5ePj4NYcfzB",
-	"R0h+3PftOC7/dfyhE0haHOiJ5YGOP4Sst/tbd9LK+gDaqMNEKPY1O15gMq2pTcFEjceXgsqGOf6A4vLo",
-	"78c++0/6I6otdB6OQ7R1umUHSx/s1sHa65Fzm6/r6vgD/gfpMwKL3toe2608RvP08YfOavznwWq6v7fd",
-	"4xaXG1VAAFgtl5TFe9/n4w/0bzQRbCvQwgl+GN/uf6V3SMeYW283/HknvXG3hFT0+M/SACmmIffPTubt",
-	"a7jmyJ4WofHZTuZBQg1vSvEgPn74kKZ/iv+5myrh3detiVrhZw28mIgVw4sRhkefDoZTic8vHP9ixJ8/",
-	"zmdffkosnDqdXfKSYUua/skn3ATQlyIHdg6bSmmuRbljP8smY0+UCThFgRdSXckAubvc682G6x0KzRt1",
-	"CYb5JMMRcTINTkwhZxI6PFoaxtuFrwwa87EG02xOb5nfo2BkUzJCsNcMZwq2qnbw7qn47uCZmL4LXdFz",
-	"T/D4JDgPvPag4Ydy83B/m0L8PfcETXUvtUGzfzGCfzGCO2QEttZy9IhG9xe+gILKB8vlPF/DPn4wvC2j",
-	"C35WqVQk8dkeZuHzjI3xirMur4jKfD1/Oy2/p3cwkO24ACN86RPUG5xQ3Ir1uuFI4cxjBEG01/uSt398",
-	"/w9xv7/gMpznzo5TED7XpQDdUAGXw9Rv/+IC/99wAcphyWlf58xCWZr47FuFZ5+cLf5hqyQn2EQ+0C8j",
-	"n/r5+EO3jGFHSTDr2hbqKuqLJnPy9wx1h6awd+fv4ysubLZU2j9qxTITw84WeHnsM9j1fm2Txgy+YCac",
-	"6Mc4vDD563FTxSf5sa+Opr56dWykUYhQCp9b01Rs6kEO2Rh53r53/AlzxHvm2Vounh8f40OxtTL2ePZx",
-	"/qFn1Yg/vm9IIiT2nVVaXGKeoPcf/18AAAD//3zWrY3S0gAA",
+	"H4sIAAAAAAAC/+y9e3PcNrIo/lVQc06VY/9mJL+Ss9HW1vkpcZL1jZO4LCV7z4l9NxiyZwYrDsAFQGkm",
+	"vv7ut7oBkCAJcjiS4uye2r9sDfFoNBqN7kY/3s8ytS2VBGnN7Oz9rOSab8GCpr94lqlK2oXI8a8cTKZF",
+	"aYWSs7PwjRmrhVzP5jOBv5bcbmbzmeRbmJ3F/eczDX+vhIZ8dmZ1BfOZyTaw5Tiw3ZfYuh5pt1irhR/i",
+	"3A3x8sXsw8gHnucajOlD+YMs9kzIrKhyYFZzaXiGnwy7EXbD7EYY5jszIZmSwNSK2U2rMVsJKHJzEhb5",
+	"9wr0Plqln3x4SR8aEBdaFdCH80u1XQoJASqogao3hFnFclhRow23DGdAWENDq5gBrrMNWyl9AFQHRAwv",
+	"yGo7O/t5ZkDmoGm3MhDX9N+VBvgVFpbrNdjZu3lqcSsLemHFNrG0lx77GkxVWMOoLa1xLa5BMux1wr6r",
+	"jGVLYFyyN19/yZ49e/Y5LmTLrYXcE9ngqprZ4zW57rOzWc4thM99WuPFWmku80Xd/s3XX9L8F36BU1tx",
+	"YyB9WM7xC3v5YmgBoWOChIS0sKZ9aFE/9kgciubnJayUhol74hrf66bE8/+uu5Jxm21KJaRN7Aujr8x9",
+	"TvKwqPsYD6sBaLUvEVMaB/358eLzd++fzJ88/vBvP58v/tv/+emzDxOX/2U97gEMJBtmldYgs/1irYHT",
+	"adlw2cfHG08PZqOqImcbfk2bz7fE6n1fhn0d67zmRYV0IjKtzou1Mox7MsphxavCsjAxq2QBxtBontqZ",
+	"MKzU6lrkkM+ZkOxmI7INy7hxQ1A7diOKAmmwMpAP0Vp6dSOH6UOMEoTrVvigBf3jIqNZ1wFMwI64wSIr",
+	"lIGFVQeup3DjcJmz+EJp7ipz3GXFLjfAaHL84C5bwp1Emi6KPbO0rznjhnEWrqY5Eyu2VxW7oc0pxBX1",
+	"96tBrG0ZIo02p3WP4uEdQl8PGQnkLZUqgEtCXjh3fZTJlVhXGgy72YDd+DtPgymVNMDU8m+QWdz2/3Xx",
+	"w/dMafYdGMPX8JpnVwxkpnLIT9jLFZPKRqThaYlwiD2H1uHhSl3yfzMKaWJr1iXPrtI3eiG2IrGq7/hO",
+	"bKstk9V2CRq3NFwhVjENttJyCCA34gFS3PJdf9JLXcmM9r+ZtiXLIbUJUxZ8Twjb8t2fHs89OIbxomAl",
+	"yFzINbM7OSjH4dyHwVtoVcl8gphjcU+ji9WUkImVgJzVo4xA4qc5BI+Qx8HTCF8ROEIeAEfIaeBI2CVo",
+	"Bk83fmElX0NEMifsR8/c6KtVVyBrQmfLPX0qNVwLVZm60wCMNPW4BC6VhUWpYSUSNHbh0WEYZ66N58Bb",
+	"LwNlSlouJORMSAe0suCY1SBM0YTj+k7/Fl9yA589n3049HXi7q9Ud9dHd3zSblOjhTuSiasTv/oDm5as",
+	"Wv0n6Ifx3EasF+7n3kaK9SXeNitR0E30N9y/gIbKEBNoISLcTUasJbeVhrO38hH+xRbswnKZc53jL1v3",
+	"03dVYcWFWONPhfvplVqL7EKsB5BZw5pUuKjb1v2D46XZsd0l9YpXSl1VZbygrKW4Lvfs5YuhTXZjHkuY",
+	"57W2Gysel7ugjBzbw+7qjRwAchB3JceGV7DXgNDybEX/7FZET3ylf8V/yrLA3rZcpVCLdOyvZDIfeLPC",
+	"eVkWIuOIxDf+M35FJgBOkeBNi1O6UM/eRyCWWpWgrXCD8rJcFCrjxcJYbmmkf9ewmp3N/u20sb+cuu7m",
+	"NJr8Ffa6oE4osjoxaMHL8ogxXqPoY0aYBTJo+kRswrE9EpqEdJuIpCSQBRdwzaU9mc1TZ7I5wD/7mRp8",
+	"O2nH4bujgg0inLmGSzBOAnYNHxgWoZ4RWhmhlQTSdaGW9Q+fnJdlg0H6fl6WDh8kPYIgwQx2wljzkJbP",
+	"m5MUz/PyxQn7Jh6bRHGF5qUleFED74aVv7X8LVbblvwamhEfGEbbicaaD/MaDcaAvQ+KI7ViowqUeg7S",
+	"Cjb+s28bkxn+PqnzPweJxbgdJi5sxTzmnI5Dv0TKzScdyukTjjf3nLDzbt/bkQ2OkiaYW9HK6H66cUfw",
+	"WKPwRvPSAei/uLtUSFLSXCMH6x256URGl4S5+RzTGkF167N28DwkIcEPXRi+KFR29WduNvdw5pdhrP7x",
+	"o2nYBngOmm242ZzMUlJGfLya0aYcMWxICj5bRlOd1Eu8r+UdWFrOLT+ZdeFNiyUO9dSPmB7ohO7yA/2H",
+	"Fww/49nmNqjuaLYQdERV9MiQo7bvFAQ3EzbAjbeKbZ2Cz1DrPgrKL5vJ0/s0aY++cjYFv0N+EbRDanfv",
+	"x+ALtUvB8IXa9Y6A2oG5D/pQO/cfYWFrJsD3wkOmaP89+rjWfN9HMo09Bcm4QBRdDZ0GGd/4OEtjnD1f",
+	"Kn077tNhK5I1JmfGcdSI+c47SKKmVbnwpJgwW7kGnYGaV75xptEdPoWxFhYuLP8NsGAsj4C/AxbaA903",
+	"FtS2FAXcA+lvkkwfjQTPnrKLP59/+uTpX59++hmSZKnVWvMtW+4tGPaJ182YsfsCHvZXNp851Tk9+mfP",
+	"g6GyPW5qHKMqncGWl/2hnAHUiUCuGcN2fay10UyrrgGccjgvATm5Qztztn06lIh+aSpDasK9s8L28EnR",
+	"gBnJS7NRNqCBrzXAFhwtW8RHthHN47RUuZOsXgjDjYHt8l7oaGiv82aWnHkk5nDwHBy7M800+2h3Xui9",
+	"ru5DCwetlU6YBok7WJWpYnEN2giVeAh67Vsw3yJI5mX3dwctu+GG4dxkta4kyUKJQ4Hm6MlXlhv6cicb",
+	"3IxeWm69idX5eafsSxv5wQhqWImPbDvJclhW65YSt9JqyzjLqSPR6DdgSYq5FFu4sHxb/rBa3Y+Wq2ig",
+	"hLYptmBwJuZaMCGZgUxJ58RxQLH0o05BTxcxwbpohwHwGLnYy4xMpPdxbId17q2Q9F5j9jKLFHCEsYB8",
+	"DXoCPqYr2kPocFM9MAlwEB2v6DNxxxdQWP610peNEfMbrary3plyd86py+F+MZ4v59g3qP9Crou249Aa",
+	"YT9JrfF3WdCX4fj6NRD0RJGvxHpjI43otVZqdf8wpmZJAUofnD5ZYJ++Vvm9ypGZ2Mrcg/TYDNZwOKTb",
+	"mK/xpaos43T10uZXJi1XDria0Bs3Pc3bWFS1G6ciLgGpK+MVrhZN+ip1XzQdFzxzJ3RBqDHpCZv3UtfK",
+	"TefcGAoNPEczFEimlv5ty7+60SI5vZrXIomXahP8ogVXqVUGxqD50BmFDoIW2rmrw47giQAngOtZmFFs",
+	"xfWdgb26PgjnFewX5ONh2Cff/mQe/g7wWmV5cQCx1CaF3tpCIeQA1NOmHyO47uQx2XENLNwrzCoSxAuw",
+	"MITCo3AyuH9diHq7eHe0XIOmp8TflOLDJHcjoBrU35je7wptVQ54LnrNHCU83DDJpQqCVWqwghu7OMSW",
+	"sVG8FoMriDhhihPTwAOC1yturHv+FjInq527Tmge6kNTDAM8qIbgyD8FDaQ/dhY0zVodMVVZKm0hT60B",
+	"fSaG5/oedvVcahWNXes8VrHKwKGRh7AUje+R5VbiEMRt/Urk/UP6i6O3FLzn90lUtoBoEDEGyEVoFWE3",
+	"9t4aAESYBtGOcITpUE7tMjafGavKErmFXVSy7jeEpgvX+tz+2LTtExe3zb2dKzDkNObbe8hvHGad396G",
+	"G+bhYFt+hbIHWXDcO30fZjyMCyNkBosxyicVD1vFR+DgIa3KteY5LHIo+L4/6I/uM3OfxwagHW/UXWVh",
+	"4Ryw0pveUHLwdxkZWtF4Cab5vWL0hWV4BFEVaAjE9z4wcg40doo5eTp6UA9FcyW3KIxHy3ZbnRiRbsNr",
+	"ZXHHXSMHsufoUwAewEM99O1RQZ0Xje7ZneK/wPgJQptbTLIHM7SEZvyjFjBg/vW+7dF56bD3DgdOss1B",
+	"NnaAjwwd2QFb9GuurchESbrOt7C/d9WvO0HaDJqD5QKNjNEHpwaWcX/mXIe6Y95OFZxke+uD3zO+JZZT",
+	"CEMiTxv4K9iTzv3a+aRGpo770GUTozLhXM0R0ODphiJ43AR2PLPFnnG6hPfsBjQwUy23wlrna95Wda0q",
+	"F/EAySeZkRn9+6Pz5ww7MOVB9IKGipbX34r5zOkE4/BddhSDFjq8LlAqVUywkPWQkYRgkqsKKxXuuvBu",
+	"78HxOVBSC0jPtIt9ANdfFTGaaQXsv1TFMi5J5aos1DKN0iQoYF+aQZhoTu+U0mAICnqSqLHz6FF34Y8e",
+	"+T0Xhq3gJsSKPHrUR8ejR2THea2MbR2ue7CH4nF7mbg+6K0KLz6vhXR5ymGnCD/ylJ183Rk8TEpnyhhP",
+	"uLj8OzOAzsncTVl7TCPTHELsbuLKo/Uk1037fiG2VXHb17b2guGaFwt1DVqLHA5ycj+xUPKra178UHej",
+	"OBjIkEYzWGQUvTFxLLjEPi7g45Bu2DjCie0WcsEtFHtWasggd+ZyYZipYTxhznUx23C5Jklfq2rtfefc",
+	"OMSpMSCIQjAq2RsiKQ3ZnVyQdTrFub2/dIhRQTkIOOpiXdO20zxueD0f5C2GPhF5XVN/8nVrPhtUVRGp",
+	"142q6pDTDrSZwMVbglqEn2biiW8ghDoUWvr4ircFTwFu7m9ja2+GTkHZnzjy5ms+Djn0oZ5c7O9BWnED",
+	"MQ2lBkN3S2xfMu6rWsVBdf7yMXtjYds3wbuufx04fm8GFT0lCyFhsVUS9sk4ciHhO/qY6u3ut4HOJGkM",
+	"9e0qDy34O2C155lCjXfFL+1294R2n5rM10rf11umG3CyXD7h6fDgO7mf8rYPnBhe1n8T9CE3XQZg5nWI",
+	"v9CMG6MyQcLWy9zM3UHzz4g+PqeN/te1I/E9nL3uuJ3Hrziak4y7UJSMs6wQZPpV0lhdZfat5GRcipaa",
+	"cLgKWvSwubH2kknbNxPmRz/UW8nJ2a42OSU9LVaQsK98DRCsjqZar8HYjpKyAngrfSshWSWFpbm2eFwW",
+	"7ryUoMnr6cS13PI9WyFNWMV+Ba3YsrJtsZ0iyoxF46V7icNpmFq9ldyyArix7DuBfh44XHitD0dWgr1R",
+	"+qrGQvp2X4MEI8wi7Rj2jftKPrt++Rvvv4v/953d2w2O34Sd7S20otr/zyf/eYbR7Hzx6+PF5//f6bv3",
+	"zz88fNT78emHP/3p/7Z/evbhTw//899TOxVgF/kg5C9feJX25QvSW5rHmx7sH81wj0GSSSKL3TA6tMU+",
+	"odheT0AP21Ytu4G3En1srMLQcpFzezty6N4wvbPoTkeHalob0bFihbUeqQ3cgcuwBJPpsMZbS1F9X8p0",
+	"ZCFuZAgWxFZsVUm3lUH6doEzwTFMreZ19KhLLHPGKLRww4NDpv/z6aefzeZNSGD9fTaf+a/vEpQs8l0q",
+	"8DOHXUrJ8weEDsYDw0q+N2DT3INgT/rAOaeMeNgtoHXAbET58TmFsWKZ5nAhHMEbi3bypXRxAnh+6G1y",
+	"75881Orjw201QA6l3aQSTrQENWrV7CZAx18EA4ZAzpk4gZOusSZHfdF74xXAV0ig7n1NTdGG6nPgCC1Q",
+	"RYT1eCGTLCIp+iGRx3PrD/OZv/zNvatDfuAUXN0564fI8LdV7ME3X12yU88wzQPClh86ihpNqNLuQ9uT",
+	"yDLu0+w4Ie+tfCtfwEpIgd/P3sqcW3665EZk5rQyoL/gBZcZnKwVOwuxVi+45W9lT9IazIQVRbmxsloW",
+	"IkNDdIo8XXaT/ghv3/6M5ti3b9/1nCr66oOfKslf3AQLFIRVZRc+N8NCww3XqUcrU8fm08jUe3RWJ2Sr",
+	"ylk2/fjMj5/mebwsTTdGt7/8sixw+REZGh+BilvGjFU6yCLCBGhof79X/mLQ/CbYVSoDhv2y5eXPQtp3",
+	"bPG2evz4GbBW0Oov/spHmtyXMNm6MhhD3DWq0MKdWgk7q/mi5OvU29jbtz9b4CXtPsnLW9wCFHSpW4yT",
+	"OhiAhmoWEPAxvAEOjqMD/2hxF65XyMOVXgJ9oi2kNihuNC/2t92vKHz21tvVCcHt7VJlNws828lVGSTx",
+	"sDN1ep41F9IENwp8gcFD4DMZLdGkCNmVTzED29Lu563uatUSNAPrEMYlH3LBb5T+gl4WMClRmXMvinO5",
+	"7+YhMGBt8Ad+A1ewv1RN9oxjEg+04+DN0EElSo2kSyTW+Nj6Mbqb793BEFJeliGcnOIKA1mc1XQR+gwf",
+	"ZCfy3sMhThFFK057CBFcJxBBHYZQcIuF4nh3Iv3U8lDLWLqbL5GIKPB+5ps0ypP33IpXc7mpv1NQzVqr",
+	"G8OWHOV25ZNwuVjviItVhq9hQEKOH3cmRlS3HoRokEP3XvKmw+fk9oXWu2+SILvGC1xzklIAvyCpkDLT",
+	"8dcLM7n3Q/8yQbk1PcKWBYlJtWOjYzpctx7Z5HoMtDQBg5aNwBHAaGMklmw23IT8YPk8OsuTZIDfMHfB",
+	"WMaal5GrWZQrrc5HE3hu95z2tEuftyYkqwkZamLVckK2mfnMe7entkNJEoByKGDtFu4aB0Jp8ig0G4Rw",
+	"/LBaFUICW6S81iIzaHTN+DkA5eNHjDkLPJs8QoqMI7DpXZwGZt+r+GzK9TFASp8Hgoex6UU9+hvScV/O",
+	"jxtFHlUiCxcDr1pZ4ADcuzrW91fH4ZaGYULOGbK5a16AtEHjawbpJU4hsbWTJsV7ZjwcEmdHHkDcxXLU",
+	"mqjHrVYTy0wB6LRANwLxUu0WLmY1KfEud0uk96RrO/ZKHkyXouaBYUu1I28fulqcK/UBWIbhCGA0AFDu",
+	"EVw79Ru6zR0wY9OOS1MpKjTsk1q2achlSJyYMvWABDNELp9EWWduBUDH2NGkcPbK70EltS2e9C/z5lab",
+	"N9nUQtRQ6vgPHaHkLg3gr2+FqfPEvO5KLEk7RatVJ0VOJEKmiJ4JmXik6T8FGSiAlIJFS4haXME+rdsA",
+	"3TgXoVtkvKBEPFzuH0aeUBrWwlhojOjBT+L3ME9yyv+n1Gp4dbbUK1zfG9UEf1NHZ5xsLfOjr4BciVdC",
+	"o88qvkAkl4CNvjakVH+NTdOyUmuzmcuWK/I0b6BpMfokF0WVplc/77cvcNrva5ZoqiXxWyGdw8qSsjsn",
+	"PTBHpnZOuqMLfuUW/Irf23qnnQZsihNrJJf2HP8k56LDecfYQYIAU8TR37VBlI4wyChyts8dI7kpeuM/",
+	"GbO+9g5THsY+6LUT4neH7ig3UnItDaDjqxD0TIRiibBRcuR+SOvAGeBlKfJdxxbqRh3UmPlRBo+QUq6D",
+	"BdpdP9gBDJBI+wZWoCFpQqg/Oe/oWlyKUwriWWln8Uls+qDxv21K8+2aGg/RRLcwgvkkkMN73Phexivq",
+	"LCVRZaA/ayWk/ex5by8aGz/CMmU3LtKm9QurNLQRH6lbhK9DmyAGFPeoU8ye46mECSUz+mRbx0AeolxM",
+	"YPIt7H/CtrSc2Yf57G6G7BTl+xEP4Pp1fdiSeCZHCWfYbL1LHYlyXuLzIy8W3tw/xCi0uvaMgpqH14GP",
+	"fPGkKfvyq/NXrz34aFEtgOtFLbgNroralf80q3JpIwcOiGdSpIEHDcoJ9tHm17nu4ieCmw343OaRbtBL",
+	"wto8/zTjhSeDVdpf6yDv8y9VbokjL1ZQ1g9WjTGVOnfeqPg1F0WwYgZoB3yraHHTMvkmuUI8wJ3fuqIn",
+	"y8W9spve6U6fjoa6DvAkmusHSomUlk6kT5hErMi/XbVZ0APjKeuUVn2K5pX69px4J3+tdIv5e8f65NuX",
+	"H6THGA/e3e529pgacCYKFTG6ouUJI2phv6x/wfP26FF8mB49mrNfCv8hAoF+X/rfyRz06FESrKRegWyA",
+	"1AbJt/CwdgMcRHWXv9l++PfNtFvz/HpLq8VOapg2arJxL0sBQzd+wTdaeBTk/hc0vuJPh6Namll7e+aw",
+	"NYWsL4a822vHha2rm2GYkl0/HQqsQGogDozuo0vwptc+XctqS+bKhSlEln7IkUuDPE+6B3pszKjxgMaL",
+	"I1ZiwN9DViIaC5tNSaDVATKaI4lMk8zh1eBuqfyZq6T4ewVM5CAtftJ02XTunyCx06g9KREVlP5cfmDq",
+	"Ew1/F0UmzordFeQIiHEtJnYH6IH7orbLhYXWZm8uW++eR3gVxTP2uOmIR5CnD0/NzkN6037Wn6ZcTKmf",
+	"FniTT889MEeyHpowi5VWv0LamEQ2uERUpJ+IdATqfZKIve/enLUJuSnr1sx+aLunK6xDG39nBTUsuk49",
+	"fhvtNH2qj9vI22iiJp27bz6Lj2QaLveRtd3NBlgLHa/IwYKyPoe3Ri7deXIhgS2v5fSpjFqYUzd+cyo9",
+	"zN1dzQp+s+TZVVpBQZii7W29ilrFQuewAaaOm3Ozs8grqG4rXFqREnQTFd5PUXZLZcNNO1nNaLQK7NjS",
+	"J+bOk6MwKjFMJW+4tBCy+jt+5XsbcM8Y2OtGaUoKZNLiXQ6Z2PIirXXkWf+xLhdr4apkVQaiMkx+IFeB",
+	"0FGRL2VVR4N61Lxcscfz5kyG3cjFtTBiWQC1eOJaLLmh67LJJxu64PJA2o2h5k8nNN9UMteQ241xiDWK",
+	"1QohCXm1G8IS7A2AZI+p3ZPP2SfkgGHENTxELHohaHb25HN6PnN/PE7dsr7K2RjLzoln/8Xz7DQdkweK",
+	"GwOZpB/1JJk/xZU5Hb4dRk6T6zrlLFFLf6EcPktbLvka0j5/2wMwub60m/Qk0sGLzF2NPmO12jNh0/OD",
+	"5cifBuKIkP05MFimtltht/6Z3qgt0lNTY8lNGoZzBf8cT6/hCh/J26UMj/0dA9THff5yQkRq1eST9D3f",
+	"Qhutc8ZdJqhCNH5ooWgHexkSzVG9gLpMgMMNzoVLJ1kSt5BydQtpyShR2dXiD6irap4h+zsZAnex/Ox5",
+	"okZCO1e3PA7wj453DQb0dRr1eoDsg8zi+2JklVxsBbL6h03cXnQqB91yktPaIS+Q8aGnSr44ymKQ3KoW",
+	"ufGIU9+J8OTIgHckxXo9R9Hj0Sv76JRZ6TR58Ap36Mc3r7yUsVU6lT22Oe5e4tBgtYBryAc3Cce8417o",
+	"YtIu3AX63/cNOYickVgWznJSEQhGp7HoKxThf/rO1/Ttyd4DHmP0c9PnoJ0sbRqk/m1L15NfmEbljwTI",
+	"R49oHjR4uaa/PG1/dnzl0aN0GrSkrQd/bQC/iypGfVNox4owZ+8HyqXUT9E+2KuP8kHuiB/w9C39UHPW",
+	"Lk3x8a+v+3EjTruKpAkXPUPwS8AD/dFFxO98SmkDG2c4t5IBQolK8yRJJq+/R05qnH2hdlMJp8P8AvH8",
+	"A6BoACUT7UK0kl7poeTj7UHvgYhGcdQlFAq1G6uSpHlXPI+jBuGdjyCoEkX+U5NbosOuNZfZJumVs8SO",
+	"f22K2NZQOe6WWig+GUkoksM5PeivQV9KaHR/U1Pn2Qo5sW23WpVbbmdxDeBtMANQYUJEr7AFThBjtR22",
+	"X4eFFWuVM5qnyYbb8LN+lbO43M5rrUplUhL3OSv9N3/FGQDZTdBdu68l6sQtcrEGM5Dv2X2rs8DRTKEu",
+	"WjpnhLpJDvaXOjVwbQvJuNbBdOi6NUvJNOQut19yPSVoofK0cUJpsRYSX2OpUXpd7hsO26ROdlBRORo/",
+	"RLH3EMGAO2Izl2t2wJbn0Rh6hcH1CfsKbR51ygoHCTrmhRsQJJPKoZ0J42oe5awqlUzuQsn3heL5IsT3",
+	"jO2HT3TQmAnd7BtuXIBBGCON7TBTSLtxp6nqQQbmElKOTcBbpQfxeZDCJPCIQd6dlEIvGXBdCNCjBGUs",
+	"Xycfl5p5jVpZ2i9mNxoMatiOkJakYqcmn0bO3SfYDm2nKHDePtb1kWwWUmMyQSipHX03hTP9BbD6yEDC",
+	"OcTMDTVwMQpkd3MBarzDvz4Kl/oXj5jPbm65YfXkcdAgxR6pjFulJ9WNug0de4BHqXHAa/KWVeO6lJgD",
+	"zwshIZ3Nve4LO0tlrVRlGexKyGy7XMycbYGbihzMQ50IVz7Rj6Dd20w7H3yaulaUbAgyTOq5XxwB4Ir8",
+	"8X3Hjwau5GU5ykvDpHQphMI7jsqFdGpvnc9ph5sHpVOIDbvhgmK8wglZqaJQN/gLthq4UkbOf5s7txY7",
+	"mAyIGNlAdFabz5lxQW1SPHhfPEzEhh/UsWuUU0pj95im3AFhQzlRqedA3REiNVNGL4St1U0jFNyy8W2h",
+	"rQ8sv3uBdkY6GlLsNA1Qy4tCgBnmo6bmoCHUJkBOf7Sp64508JOycIl30sFMjsG2UDNgQnhzQGO8tbZ7",
+	"3nDBQe4TH4UGQ6OMu4F8GiLr5I8BmTwigjbXju6mxdRLT63oTx3C8+sxMFbe/eTD98O1CMRHh+yCB1hN",
+	"mwg67GrSqRjuU0ukh1fNHfxMAuBFr5gmEg1RzTQH+2/Qigl8zrmS6kYOx2npg2WTcorKzWzAdcMRw2zJ",
+	"wR205njRhVbjZZeNWG/AWEYhU7fnuF7sPXTcuses2ZQaV/MUoTaLTZ2eUHL071VSNPYfXOQ6dqar0ZUb",
+	"ZSBzcqQ4Yd9Qfi0kvFYlBHJgCKmq22lbqxI1hDml0EYHeuZmdX002Er7cqdrer9vGzmSDlfT09iG/GED",
+	"+ZmmjzOeMAZXbeyirk6ayoCJLZr6qaLjGk8v+zF2TtgL51RhwpO9m4RRBnXSUevp/LMemYzwP9bybIMN",
+	"VMs0PWwRm16nNxitGl8uHv6fNcWxSG1AuH2pXlepd84USm03ApNib7hFJtjWbP0kjejpknC2l6crKR2l",
+	"nBzxSlCXwjoW7a1LtnYzTkLWQfyRb9WuQvexZYsvqFeKKHs1kDt+wCGFY0jkzr7z7kYZl0qKjLTV1BMH",
+	"JQic5rg4oahI2uPQzPwJTRyuZOXlOheAx+JgLeb5rIW4vhNw9BU31VGH+9OSsrbhlq3BGs/Z8JL3tc+9",
+	"i5yQBnyxMySimE8qnYhMSD0iLGqX6iPJiHJ/Dfg8fI3fvvceMXgE2ZWQJEl4tPmHM+fEhnlskNolE5at",
+	"FRi/nnYCVPMz9jmhXKA57N6dvFJrkV2INY3hol1w2S60qz/UeQj08oFV2PZLbOsrNNQ/t2I63KTnZekn",
+	"Ha6Mn3wuwCoEQwhOvJAsgmt5hNx6/Hi0EXIbjdCk+xQJDWtuOCkV7+G+dBpKrbdHwYoblaMoasFcnHrS",
+	"5J5U+F8JGUSt9AWRJa8E2hgnN6X7mUxzm21abOhQXFcduNJlaMZ6r9y7DtXZ4KCFZLMwx/A2NlXiBxhH",
+	"3aB51+Fyz8KhQOqOhIkveVFHOCZqvpNU5YWonNsm72yoAp9iHMi4F1swJkTvdctAdf0kWjKR607FWo69",
+	"iYYyYS6rfA0Wsyym7PFf0FdGX1leaTIQ7iCr6hplZckQqG4m/D61+YkyJU21HZkrNLjjdLkw3BjYLlN6",
+	"6Iv6I+T1DiOlobEM/00V6BreGR/beHSugxDImB9X/qGfuyEl9SJNLzD/2nRM0J1yd3Q0U9+O0Jv+90rp",
+	"hVq3AfnI+a/HuFy8Ryn+9hVeHHF66F4Yqbta6uzNFLKp6HtIeFbnHe1Ywrkj2t6cfvMSW9YBPjRMAn7N",
+	"i4H8IrHfmbtfncliKMtINpgUh1ufns9yNsqCBlOeuejBjidb36lwKGLQBQzenzuZX+soQkOEdR+gb0P6",
+	"BlZy4aNGGmbRx6wPj+0nQpoSzNpscHcRPpnNoMfTt9dDiWeCQZC+x1VnvF//3D8OwrVQld+wOioyqITu",
+	"1xWlJWxXlxlYfzI8+Pd2Jxt0frv05Y3dMr1O/u1PLoaWgbR6/w/gCtfb9G7pooS0Sy0igvUqcM+pZkCp",
+	"bd2KUyolpYryeNkw2Moca2nRUq/IUY+sXkwRB3r4+DCfvcyPujBThZ1mbpTUsXuFRkiqC/Fn4Dno1wfq",
+	"XjS1LuiIlcqIpkBtgYP5h+oNDXcyNfwYCVjEdTv6Y4WnnGvILFUlbsJtNMAxVTxwMn8K/lX/YkSdrqO0",
+	"fdmLsVoX/VLEB+74Xjq6KKXi0Dv9YGWH8zqokvg0OXKsQZJFM++kNpqcYGW1gsyK6wPp//6yARmllpsH",
+	"u4x7qo6yAYo6s0GV9ik5ZC5qACr4LeEp+P2BM5Ru6gr2DwxrUUOyrmydieM2icMJA86PpBx0oXSGZB9H",
+	"IkxNGYSFECToukNTgiXFSGi6KJnlLecKJMl4nOByZMp0TfxJc2HXo9K+UpD+ULqPfkntYf3jBVUwNz5k",
+	"hteJx2MtHQ2O3fJMNz5xOSVrrN9OgjcSmPBbyMzqZinEFTQOHP6lCp8FQ4uk6SVYdRYj91EvrR8TaaBX",
+	"9cyiCenue5/399hlR8gKhWLEYijFRPtttQ5BemBcrJirPwvaw7UCrR0FYEscGxZWBTe0MTjGUGEoIO5W",
+	"SDCDRbYccIOp7980uf2p2CCnVPfcx8HFC2Qathyh01EG/uE5x5D9pfsecmUFn6ODFqaaXg9XPQ7B/ML0",
+	"kBhT/Yr52/JwDq7bGJuElKAX4eWpm45fgm6/hpRa5VXmLuj4YNQGuclP7SOsJGmnyfqr7OgIUS6rK9if",
+	"ei90Xy467GAMtJOcHOhRGufOJt+r+c2k4F7fC3i/p+VqPiuVKhYDjx0v+zUEuhR/JbACD8ObIgS9DpTw",
+	"Z5+Qjb1+zb7Z7EPO/LIECfnDE8bQ9kXutA7gThHLzuTygR2bf0ez5pUr6+GNaidvZTpem/ys9R25WRhm",
+	"nIcZkPmdp3KDjE9kdwP1C7AgjqEH4wHOOK6V95+au241DVE5KFIyyYV7sfqSDnrKcERJ0aKUevSQyZl/",
+	"6WKmUKkgy9skbsOh0piKJyOALMgJYhkNGGeRSyLAe/F4HhQK+ScVr4Jn4FJymxAbV2f79fmc3QtLu2r+",
+	"RP3rMkqdZBVTHpLbpfSNa9CZg+yevD984hyI65KEAhp0eOnSYqJOGRM8kJtGRxftb1UMqXGfeqoj6Spk",
+	"RhlKCBW+T10OdRKW5QraS8KBjl9MlH5rbC2DxahersgSIcjHQgd6a9fD8Z3bfKm9UApZN5Szhud/q0yo",
+	"NaMRsGL/R8brUZzcS6XckN5WhbrB+bYugPhv5O5+Z9O7J8zR05ekg777AFjDQmb+Ccm2J51H9HcYzvrM",
+	"bjbKQDfRsz+cOcjDuePK8uWLXg7o8feFQL7f0tHEPcLNRAL1ZaqvAEpf/L1lnTdHE22cWvawt5LD1YGd",
+	"DDLYBF7qQ5LWlDCNRBPc4Vbe35B1iMumUs09bW2ayx7YxsPpqkeOMZXzmZLr+bdKOn0INhqkMazcI3jd",
+	"fMf/c09A6hoYPgItNuYvsCBd4EiTCH3E6tEkrG4Sr9x7Ys3GvuHYZZTv8hhWOSnNZhwNdau8mk06TY+3",
+	"sd38Qu0O3EeumJi0ZJPHjYtyJ4xzrLmz8YR3WOwmVhRgkMrZe5Cb4SlRNz6yfql203la2n3y0sOUyoYy",
+	"KS/NyEMrjhuQdoux06dyHpKDHBb3D8YF1CEBzXY1YQH9vcFougVpzYu64GJKTC1IwoqNQqHEdNONWUVp",
+	"JOv4Am68wRCDtnOWKa0hi3uk4/ccUFulYVGodTpM+5VYWcMKsRVIvJIVas1Uidvg6pamuc/QXJXEDcCI",
+	"6ci7O4EBqvZFb02K+T6s7jN1SrTtOH+mBZn81pMZPvZxOVWbIgBu0QvnUzeQ0gSMT/rvMeQa9+ElunEJ",
+	"ubtv2kdVDG1d1u10stSDlRoyqHPsRgjzzsTBfmc3WlXrTVSuid2IoggPV7gNugqhRdEoVJqV2hvP2GsJ",
+	"2Fgc4ApKCg/cwlbpvVM5QsDBnFWmIq96BO452yoXVAvSMiXBsE+QAWhVFO0nS2fAXXs/jO/47jzL7Cul",
+	"rjB57MM/MlWgzcVDhTMGRcWXG2HPHz92dqY5Bp5JJGuusw3Wf6QJnLu3VLbGaT4POT274Sf1asZiW53M",
+	"FUTJyYJHS9kxwU+byGTAPNAjJwI3MIqjJR/P63r+G4eEoAjMCTz2sHvIeX9h3XW12W3a4n4uGbdqK7L0",
+	"sfvnCgwZDOcYoJ7+I0w4+56e4xi1YMYMhvO88ta9EGQZiShKMqvKdk3BONzbW9qj28oV3RgPZ0uWjQyj",
+	"h0Udb4HpGPHSPt918dspFdruAExCE05Zg9LVWr9oBKQ7wBBLrymKG6SullKUOLD4XB78Ax2jdhobE2S8",
+	"9OaZFjaVTkkTPjUhAjKcnzCVmfDRoxOGVVgiF1OD1UvwTyrf0Rcq79dJ8XbenVGcxpHenSnxJcUDXQ+f",
+	"G52akVAZy7F1AACJT33UgMQrL7XvXv7yjtDEQPC/9ErUHZetgNve3JEM3ZfpvKV/kQ2+R3QAIEhdwl5b",
+	"aXJpbb0W1BKQWjutkWSELqATJU6KlrkbbDjCvQNl4U5A9SL0agA/cUabuTN/umg/0tzc94dNHaNbAf9h",
+	"nMpbUsNQGNJFQ1qamtTm3wFRIKlXj8fsXFKy5uXUyB0TvCwnSv8RAMOxPC0YJkX0HAvGimNI54LbAUWE",
+	"/Cjm0Wuwz/wUjR7SE9AsLONOuUAfPi6KSoNP90/3D9NtH82S200QVrB539sJPWfAify/glbEovN55CMI",
+	"hcta1HmwVuWigGtohTg5WjYVqaHiGkJfU3dmOUBJHrNdP45U7E6Ex+4F79e+iKI/pmA3+drvEOt2ih14",
+	"yk86Huzkwh0TM/UoIUTXIq94C3/maCtry1UFj3ICVT37wcLZCSCfOs2PboQ3YYDz0D+lwwRMvJvGh45m",
+	"QWnUjTGgg7F8lRk69TIdyhcX2KidAGm2vHYWdiTe8A1T8hs57DTTJ/nGFDNxn4SSEWK/2kFGUk07Vu3u",
+	"OGE0GDNifXgNDUHczfnqd6HhURIeHC8l/RrwNpUmRD64RoZ11HThNfXmDVmiiFwUPrek5/+e/83ZsgoD",
+	"oQ3QpSqKNYEXELxcqchu7eDnVhSqzpCHj0O34/19A6KIopHRP1tp+kcqy/5e8UKsXKZAB37oxsyGIwl5",
+	"t1rn7+1j/HDiccFkHgALNkwVpnLrFlPHjIbb4ygR0HgFMqW9h+aWX0G8DeTK7jhPZpHlmGq5FcbQZdfZ",
+	"zj4W/OJDSv4tzyNLnisMtm/dRKG6I/b+Y5PpJJ4q1POhh648bJ7h244TGYkRNXGh+8ExtoPLiARCq4ho",
+	"dUhqnTsnCYe/ujYESSL0n6Wwmuv9SGDuQb+bVHw5Sc6HwI4E8MjR4N6WMTHVT6fQ+UgSoUlLue9dGBGx",
+	"DnkHtSDseAp9BBQny/INLWMK+B8RtQPmqRgkavIxENlKX3+MOQtljGtejFhLL8mbk57JO0Wow9uY75sy",
+	"YYULoz+AMI1oT6lloEldEjXD2ykXqxVoF2lmLJc513ncXEjK4Mwx5S7fm9u/QSK0uoJ5zISTz5A8uqrb",
+	"Cc+iB0nacQdIsff+rHd8IqwB5Pf4Vjjhje9yA8n3PafxWzXwpNeHIZ2Gn+/wGZYSjgwQoC9sR4+w1Iwp",
+	"Sc8Q7rI/bh4jfoXxaaimrw+KsYpmnTLF+Dn7gVBH0vyPUtjRk+ZMRd0MMC5Ezx2EQP9y3cQJu83p03+Z",
+	"pScr24l7utlEw167eAE331C66rZ5cmAXyWPaZ3yKbZFHWO9bTtkJpuwVtAUpbmYkEhhME/VK3kZOo+9F",
+	"pnQ1PoeUuU+sdOSV4cykPM/FwCvLZXg0MP5staetvetxnOm3bORKnoaoVOUimxIe5sp+5w6AAGkbxrGH",
+	"4FHqqD3pTV2dPqbGdpl6Gs/cRpbrlMk/mIIzG9Mgh7T1AQ7atgSrFfEyOsLORqF0rJnPu+ko2taImkkw",
+	"zjRklSZr3Q3fJx2kWp6ZC5uGMmTyciOHd5KQoKCG2hOjY0emedLq+W4eYwdLcMgEvSZcOe9/MUO+nve/",
+	"HB8ml14AvtpjQ4RynN4ai3EglQStoZ6aYHAhEOwWCxwyVE1IsnRvW1Wflt9ig5IX+kg+kf4jeZ1gaBJo",
+	"/YQ7CWwSAAOZNFo5EKIg8Kj6m3Y2IrImBcN7l1981xjkD4Z8EiShwwHw4tQYTbva2cKD8zuXUfuuRkq0",
+	"lHdDlNBa/qFsG36BzQtGtEVeq7AWnF+sixlq70uUSsV8WWcoGRAjeolMtFKWXL6KIpEAxSk6dKZiwhHS",
+	"gr7mxcdPYvK10MaeEz4gfzMc9hxnwYiR7FBpbpeD9xWfNHfBf4Op5WtKujJUGudcMj+Uf7zoMX9SU3nh",
+	"XFbr6jbo8OcznONOsyefsaUvrVtqyITpPoo4y3XkCXkNGm2jdTGQ8SwTh9b5k7J3IONVeMFk30fGTUV6",
+	"dgNhc0R/Z6YycHKTVJ6ivh5ZJPCX4lHj3kqt6+KqFWgy5Kh0zyndbu/0E6+MkudOXh6tgy6dykB/nZNv",
+	"6/HwGAfhFMQ3+Qgn18HFgtnLKWkE0wVwsTvlMbyXSrhH1cH9DTIYOhz5Mfy8KYr5aSinvcvbPlBdsbMf",
+	"WIjxoDU2rpWJAV4gwQhD1SD/6itFf9y7NEDgomT6R9XBepdUcA4xibW2Jo+miqpgTiiA6bslyl1SxoKs",
+	"0sLuLxD/QeMVf026MX5T5+3yed9qY7O/+6y6Ahle+5osX5UJt+s3ihd0HzkbuARmlSpO2Fc7vi2L4PT5",
+	"pwfL/4Bnf3ieP3725D+Wf3j86eMMnn/6+ePH/PPn/Mnnz57A0z98+vwxPFl99vnyaf70+dPl86fPP/v0",
+	"8+zZ8yfL5599/h8PZvOZQJAdoCGG52z2vxfnxVotzl+/XFwisA1OeCkwNdqHD6RarhQun5Ca0UmELRfF",
+	"7Cz89P+HE3aSqW0zfPh15quxzzbWlubs9PTm5uYk7nK6prQ+C6uqbHMa5vkw72D8/PXL2rfRvcLSjjbm",
+	"npNZQwrn9O3NVxeX7Pz1y5OGYGZns8cnj0+e4PiqBMlLMTubPaOf6PRsaN9PPbHNzt5/mM9ON8ALu/F/",
+	"bMFqkYVPFM7u/29u+HoN+oT81t1P109Pg1hx+t4Han8Y+3YaP/Cdvo/+Woj8QE96uTp9H+LvxlvH2vup",
+	"9wuIOkyEYqzZ6VLtjmgKJmo8vBRSNszpexKXB38/9ZV90x9JbXHn4TSkSku3bGHpvd0hrJ0eGZqSq/L0",
+	"Pf2H6PPD+NfTlSggahLK+pyG2F3/wSXZPrU7eUoPIafvW5jwn3uYaP/edI9bXG9VDmGxarUyYA98Pn3v",
+	"/o0mwnp9WqDQyIvmVxcIcWqqsiz2/Z/30j8jFJCKU/5RGrCtgIq9zJrYoPq4v8xD44u9zIJ0GxxX6BA/",
+	"ffzYTf+c/jPz3v6d5Gqn/rTO3LV70LbSSmtNLLLjrFbD6yKgwJ7MCIYnHw+Gl9I5qyDPdLz9w3z26cfE",
+	"wktpQUteMGrppn/2ETcB9LXIgF3CtlSaa1Hs2Y+y9rdxtwvFo6UokEqLBchRMKi2W673JHBv1TUYthWS",
+	"Xvga4mQaDN4Lzms/VKp0NEw3E18begioloXIZnOXxPwdCVU2JV8EW09/pmDnagZvn4pvDp6J6bvQFltH",
+	"ssZNgvNAPiE3fF/m7u9v2Pvu04ab6kFqg2b/YgT/YgT3yAhspeXgEY3uL0p9CqWP4Ml4toExftC/LaML",
+	"flYqY0eyJCQg8QXGhnjFRZtXNC4zs7Ofh5NDupLPoSy1kzfI7pyDwcN8EnQOFKgblUDXHCmceXJDifba",
+	"L2B29jjBLN79Q9zvX3IZznNrx1Vc0T1QAU8W2P0XF/gfwgVc8UoeSgtbQJei6OxbFdLo8Dqjta8jPZEP",
+	"tBKQN8J06+fT960/2+qS2VQ2VzdRX1Iy3FtRX3fAj5Xp/n2K1a3RgOazWfOVBZ3qrIFvvdrQ/GyBF6e+",
+	"ol3n16aITO8LVcaJfoxDY5K/nhLzGvzY1XBTX72GN9AouOeFz421K7YeEeOs7UY/v0O2ZUBfB57aGEPO",
+	"Tk/JGXmjjD2dfZi/7xhK4o/vakp5H7hpqcU1QvPh3Yf/NwBTUYCbHgwBAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
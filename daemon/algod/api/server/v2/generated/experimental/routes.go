@@ -8,11 +8,13 @@ import (
 	"compress/gzip"
 	"encoding/base64"
 	"fmt"
+	"net/http"
 	"net/url"
 	"path"
 	"strings"
 
 	. "github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated/model"
+	"github.com/algorand/oapi-codegen/pkg/runtime"
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/labstack/echo/v4"
 )
@@ -22,6 +24,9 @@ type ServerInterface interface {
 	// Returns OK if experimental API is enabled.
 	// (GET /v2/experimental)
 	ExperimentalCheck(ctx echo.Context) error
+	// Stream blocks, certificates and state deltas as they are added to the ledger.
+	// (GET /v2/stream/blocks)
+	StreamBlocks(ctx echo.Context, params StreamBlocksParams) error
 }
 
 // ServerInterfaceWrapper converts echo contexts to parameters.
@@ -40,6 +45,40 @@ func (w *ServerInterfaceWrapper) ExperimentalCheck(ctx echo.Context) error {
 	return err
 }
 
+// StreamBlocks converts echo context to params.
+func (w *ServerInterfaceWrapper) StreamBlocks(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params StreamBlocksParams
+	// ------------- Optional query parameter "round" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "round", ctx.QueryParams(), &params.Round)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter round: %s", err))
+	}
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", ctx.QueryParams(), &params.Format)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter format: %s", err))
+	}
+
+	// ------------- Optional query parameter "deltas" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "deltas", ctx.QueryParams(), &params.Deltas)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter deltas: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.StreamBlocks(ctx, params)
+	return err
+}
+
 // This is a simple interface which specifies echo.Route addition functions which
 // are present on both echo.Echo and echo.Group, since we want to allow using
 // either of them for path registration
@@ -69,187 +108,240 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	}
 
 	router.GET(baseURL+"/v2/experimental", wrapper.ExperimentalCheck, m...)
+	router.GET(baseURL+"/v2/stream/blocks", wrapper.StreamBlocks, m...)
 
 }
 
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+x9/ZPbNrLgv4LSe1X+OHE0/kjeeqpS7ya2k52Lk7jsSfbes30JRLYk7JAAFwA1Unz+",
-	"36/QAEiQBClqZmLvVt1P9oj4aDQajf5C98dZKopScOBazc4+zkoqaQEaJP5F01RUXCcsM39loFLJSs0E",
-	"n535b0Rpyfh6Np8x82tJ9WY2n3FaQNPG9J/PJPyjYhKy2ZmWFcxnKt1AQc3Ael+a1vVIu2QtEjfEuR3i",
-	"4sXs08gHmmUSlOpD+TPP94TxNK8yIFpSrmhqPilyzfSG6A1TxHUmjBPBgYgV0ZtWY7JikGfqxC/yHxXI",
-	"fbBKN/nwkj41ICZS5NCH87koloyDhwpqoOoNIVqQDFbYaEM1MTMYWH1DLYgCKtMNWQl5AFQLRAgv8KqY",
-	"nb2bKeAZSNytFNgW/7uSAH9Aoqlcg559mMcWt9IgE82KyNIuHPYlqCrXimBbXOOabYET0+uE/FgpTZZA",
-	"KCdvvntOnjx58swspKBaQ+aIbHBVzezhmmz32dksoxr85z6t0XwtJOVZUrd/891znP+tW+DUVlQpiB+W",
-	"c/OFXLwYWoDvGCEhxjWscR9a1G96RA5F8/MSVkLCxD2xje90U8L5v+iupFSnm1IwriP7QvArsZ+jPCzo",
-	"PsbDagBa7UuDKWkGfXeaPPvw8dH80emnf3t3nvy3+/OrJ58mLv95Pe4BDEQbppWUwNN9spZA8bRsKO/j",
-	"442jB7URVZ6RDd3i5tMCWb3rS0xfyzq3NK8MnbBUivN8LRShjowyWNEq18RPTCqeGzZlRnPUTpgipRRb",
-	"lkE2N9z3esPSDUmpskNgO3LN8tzQYKUgG6K1+OpGDtOnECUGrhvhAxf0z4uMZl0HMAE75AZJmgsFiRYH",
-	"rid/41CekfBCae4qddxlRS43QHBy88Fetog7bmg6z/dE475mhCpCib+a5oStyF5U5Bo3J2dX2N+txmCt",
-	"IAZpuDmte9Qc3iH09ZARQd5SiBwoR+T5c9dHGV+xdSVBkesN6I278ySoUnAFRCz/Dqk22/6/3v78ExGS",
-	"/AhK0TW8pukVAZ6KDLITcrEiXOiANBwtIQ5Nz6F1OLhil/zflTA0Uah1SdOr+I2es4JFVvUj3bGiKgiv",
-	"iiVIs6X+CtGCSNCV5EMA2REPkGJBd/1JL2XFU9z/ZtqWLGeojakyp3tEWEF335zOHTiK0DwnJfCM8TXR",
-	"Oz4ox5m5D4OXSFHxbIKYo82eBherKiFlKwYZqUcZgcRNcwgexo+DpxG+AnD8IIPg1LMcAIfDLkIz5nSb",
-	"L6SkawhI5oT84pgbftXiCnhN6GS5x0+lhC0Tlao7DcCIU49L4FxoSEoJKxahsbcOHYbB2DaOAxdOBkoF",
-	"15RxyAxzRqCFBsusBmEKJhzXd/q3+JIq+Prp0B3ffJ24+yvR3fXRHZ+029gosUcycnWar+7AxiWrVv8J",
-	"+mE4t2LrxP7c20i2vjS3zYrleBP93eyfR0OlkAm0EOHvJsXWnOpKwtl7/tD8RRLyVlOeUZmZXwr7049V",
-	"rtlbtjY/5fanV2LN0rdsPYDMGtaowoXdCvuPGS/OjvUuqle8EuKqKsMFpS3FdbknFy+GNtmOeSxhntfa",
-	"bqh4XO68MnJsD72rN3IAyEHcldQ0vIK9BAMtTVf4z26F9ERX8g/zT1nmprcuVzHUGjp2VzKaD5xZ4bws",
-	"c5ZSg8Q37rP5apgAWEWCNi0WeKGefQxALKUoQWpmB6VlmeQipXmiNNU40r9LWM3OZv+2aOwvC9tdLYLJ",
-	"X5leb7GTEVmtGJTQsjxijNdG9FEjzMIwaPyEbMKyPRSaGLebaEiJGRacw5ZyfdKoLC1+UB/gd26mBt9W",
-	"2rH47qhggwgntuESlJWAbcN7igSoJ4hWgmhFgXSdi2X9w/3zsmwwiN/Py9LiA6VHYCiYwY4prR7g8mlz",
-	"ksJ5Ll6ckO/DsVEUFzzfm8vBihrmbli5W8vdYrVtya2hGfGeIridQp6YrfFoMGL+XVAcqhUbkRup5yCt",
-	"mMZ/dW1DMjO/T+r8r0FiIW6HiQsVLYc5q+PgL4Fyc79DOX3CceaeE3Le7XszsjGjxAnmRrQyup923BE8",
-	"1ii8lrS0ALov9i5lHJU028jCektuOpHRRWEOznBAawjVjc/awfMQhQRJoQPDt7lIr/5K1eYOzvzSj9U/",
-	"fjgN2QDNQJINVZuTWUzKCI9XM9qUI2YaooJPlsFUJ/US72p5B5aWUU2DpTl442KJRT32Q6YHMqK7/Iz/",
-	"oTkxn83ZNqzfDntCLpGBKXucnZMhM9q+VRDsTKYBWiEEKayCT4zWfRSUz5vJ4/s0aY9eWpuC2yG3CNwh",
-	"sbvzY/Ct2MVg+FbsekdA7EDdBX2YcVCM1FCoCfC9cJAJ3H+HPiol3feRjGNPQbJZoBFdFZ4GHt74ZpbG",
-	"OHu+FPJm3KfDVjhpTM6EmlED5jvvIAmbVmXiSDFitrINOgM1Xr5xptEdPoaxFhbeavonYEGZUe8CC+2B",
-	"7hoLoihZDndA+pso019SBU8ek7d/Pf/q0ePfHn/1tSHJUoq1pAVZ7jUoct/pZkTpfQ4P+itD7ajKdXz0",
-	"r596Q2V73Ng4SlQyhYKW/aGsAdSKQLYZMe36WGujGVddAzjlcF6C4eQW7cTa9g1oL5gyElaxvJPNGEJY",
-	"1sySEQdJBgeJ6djlNdPswyXKvazuQpUFKYWM2NfwiGmRijzZglRMRLwpr10L4lp48bbs/m6hJddUETM3",
-	"mn4rjgJFhLL0jk/n+3boyx1vcDPK+e16I6tz807ZlzbyvSVRkRJkonecZLCs1i1NaCVFQSjJsCPe0d+D",
-	"RlHgkhXwVtOi/Hm1uhtVUeBAEZWNFaDMTMS2MHK9glRwGwlxQDtzo05BTxcx3kSnhwFwGHm75ynaGe/i",
-	"2A4rrgXj6PRQe54GWqyBMYds3SLL22urQ+iwU91TEXAMOl7hZzR0vIBc0++EvGwsgd9LUZV3LuR155y6",
-	"HOoW40wpmenrdWjG13k7+mZtYD+JrfGLLOi5P75uDQg9UuQrtt7oQK14LYVY3T2MsVligOIHq5Tlpk9f",
-	"NftJZIaZ6ErdgQjWDNZwOEO3IV+jS1FpQgkXGeDmVyounA3Ea6CjGP3bOpT39MbqWUsw1JXSyqy2Kgl6",
-	"b3v3RdMxoak9oQmiRg34rmqno21lp7OxALkEmu3JEoATsXQOIue6wkVSdD1rL9440TDCL1pwlVKkoBRk",
-	"iTNMHQTNt7NXhx7BEwKOANezECXIispbA3u1PQjnFewTDJRQ5P4Pv6oHXwBeLTTNDyAW28TQW6v5zgvY",
-	"h3ra9GME1508JDsqgfh7hWiB0mwOGoZQeBROBvevC1FvF2+Pli1I9Mf9qRTvJ7kdAdWg/sn0fltoq3Ig",
-	"/M+pt0bCMxvGKRdesIoNllOlk0Ns2TRq6eBmBQEnjHFiHHhA8HpFlbY+ZMYzNH3Z6wTnsUKYmWIY4EE1",
-	"xIz8q9dA+mOn5h7kqlK1OqKqshRSQxZbA4fdyFw/wa6eS6yCsWudRwtSKTg08hCWgvEdsuxKLIKorl0t",
-	"Lsiivzh0SJh7fh9FZQuIBhFjgLz1rQLshiFQA4Aw1SDaEg5THcqp467mM6VFWRpuoZOK1/2G0PTWtj7X",
-	"vzRt+8RFdXNvZwIURl659g7ya4tZG/y2oYo4OEhBr4zsgWYQ6+zuw2wOY6IYTyEZo3xU8Uyr8AgcPKRV",
-	"uZY0gySDnO77g/5iPxP7eWwA3PFG3RUaEhvFFN/0hpJ90MjI0ALHUzHhkeAXkpojaFSBhkBc7wMjZ4Bj",
-	"x5iTo6N79VA4V3SL/Hi4bLvVkRHxNtwKbXbc0QOC7Dj6FIAH8FAPfXNUYOek0T27U/wXKDdBLUccP8ke",
-	"1NASmvGPWsCADdUFiAfnpcPeOxw4yjYH2dgBPjJ0ZAcMuq+p1CxlJeo6P8D+zlW/7gRRNyPJQFOWQ0aC",
-	"D1YNLMP+xMbfdMe8mSo4yfbWB79nfIssJ2cKRZ428FewR537tQ3sDEwdd6HLRkY19xPlBAH14WJGBA+b",
-	"wI6mOt8bQU1vYE+uQQJR1bJgWtuA7baqq0WZhANE/RojMzonng2K9Dswxav4FocKltffivnM6gTj8F12",
-	"FIMWOpwuUAqRT7CQ9ZARhWBSvAcphdl15mLHffSwp6QWkI5powe3vv7vqRaacQXkv0RFUspR5ao01DKN",
-	"kCgooABpZjAiWD2ni+xoMAQ5FGA1Sfzy8GF34Q8fuj1niqzg2j+4MA276Hj4EO04r4XSrcN1B/ZQc9wu",
-	"ItcHOnzMxee0kC5PORxZ4EaespOvO4PXXiJzppRyhGuWf2sG0DmZuylrD2lkWlQFjjvJlxMMHVs37vtb",
-	"VlQ51XfhtYItzROxBSlZBgc5uZuYCf5yS/Of6274mARSQ6MpJCk+gZg4FlyaPvbVxCHdsIkmY0UBGaMa",
-	"8j0pJaRgo/yNyKdqGE+Ijf9LN5SvUdKXolq7ADQ7DnLqSlmbiqx4b4ioNKR3PEHrdIxzu6Bj/9DDyEFA",
-	"jS7WNW1bzeOa1vO5tz1TrtQAeV1Tf9S7NZ8NqqoGqdtGVbXIab9WmcDFW4JagJ9m4ok+EESdEVr6+Aq3",
-	"xZwCs7l/jq29GToGZX/iICSu+TgUFWf05Hx/B9KKHYhIKCUovFtC+5KyX8UqfJnmLh+1VxqKvgnedv1t",
-	"4Pi9GVT0BM8Zh6QQHPbRx9iMw4/4MXqc8H4b6IySxlDfrvLQgr8DVnueKdR4W/zibndPaNfVpL4T8q58",
-	"mXbAyXL5BNfhQT+5m/KmDk6a5xGfoHu30mUAal6/k2eSUKVEylDYusjU3B4050Z0j1za6H9dR+Pewdnr",
-	"jttxfoVPItG4C3lJKElzhqZfwZWWVarfc4rGpWCpkaglr0UPmxuf+yZx+2bE/OiGes8pRqzVJqdopMUK",
-	"IvaV7wC81VFV6zUo3VFSVgDvuWvFOKk40zhXYY5LYs9LCRJDh05sy4LuycrQhBbkD5CCLCvdFtvxWZbS",
-	"LM+dJ85MQ8TqPaea5ECVJj8yfrnD4by33h9ZDvpayKsaC/HbfQ0cFFNJPLrqe/sVA1/d8jcuCBaf0dvP",
-	"1ndjxm/ebu3R9tQ8Df8/9//z7N158t80+eM0efY/Fh8+Pv304GHvx8efvvnm/7Z/evLpmwf/+e+xnfKw",
-	"xx4NOcgvXjiV9uIF6i2N86YH+2cz3BeMJ1EiC8MwOrRF7uMDWUdAD9pWLb2B91zvuCGkLc1ZZnjLTcih",
-	"e8P0zqI9HR2qaW1Ex4rl13qkNnALLkMiTKbDGm8sRfUDEuPP89Cb6F7c4XlZVdxupZe+7esTHxgmVvP6",
-	"CabNznJG8H3ehvqoRvfn46++ns2bd3X199l85r5+iFAyy3ax15MZ7GJKnjsgeDDuKVLSvQId5x4IezQG",
-	"zgZlhMMWUCxBqg0rPz+nUJot4xzOx/Q7Y9GOX3AbbG/OD/om987lIVafH24tATIo9SaWtaElqGGrZjcB",
-	"OvEipRRb4HPCTuCka6zJjL7oovFyoCvMHoDap5iiDdXnwBKap4oA6+FCJllEYvSDIo/j1p/mM3f5qztX",
-	"h9zAMbi6c9aOSP+3FuTe9y8vycIxTHXPPuS1QwdPLyOqtHtd1IokMtzM5qqxQt57/p6/gBXjzHw/e88z",
-	"quliSRVL1aJSIL+lOeUpnKwFOfMPll5QTd/znqQ1mE4qeCpGymqZs5RchQpJQ542RUh/hPfv39F8Ld6/",
-	"/9ALquirD26qKH+xEyRGEBaVTlyCg0TCNZUxp5WqH7jjyDaDydisVsgWlbVs+gQKbvw4z6NlqboPXfvL",
-	"L8vcLD8gQ+WecZotI0oL6WURI6BYaHB/fxLuYpD02ttVKgWK/F7Q8h3j+gNJ3lenp0+AtF5+/u6ufEOT",
-	"+xImW1cGH+J2jSq4cKtWwk5LmpR0HfONvX//TgMtcfdRXi7QxpHnBLu1Xpz6iHocqlmAx8fwBlg4jn49",
-	"h4t7a3v5ZFbxJeAn3EJsY8SNxmN/0/0K3qDeeLs671h7u1TpTWLOdnRVypC435k6x83aCFk+jEKxNWqr",
-	"Lh3QEki6gfTK5WmBotT7eau7j9RxgqZnHUzZDD72BRnmkEDPwhJIVWbUieKU77uP+RVo7eOB38AV7C9F",
-	"k4LimNf77cfkauigIqUG0qUh1vDYujG6m+/CwVCxL0v/Jhsf53myOKvpwvcZPshW5L2DQxwjitZj5yFE",
-	"UBlBhCX+ARTcYKFmvFuRfmx5RstY2psvks3H837imjTKk4vcCleDVnf7vQBMByauFVlSI7cLl8nKPpgO",
-	"uFil6BoGJOTQuTPxWXLLIYSDHLr3ojedWHUvtN59EwXZNk7MmqOUAuaLIRVUZjrxen4m6z90nglMUOkQ",
-	"tsxRTKoDGy3TobLlZLMZ94ZAixMwSN4IHB6MNkZCyWZDlU+yhbnI/FmeJAP8iQkAxtK+XAShZkHCsTqp",
-	"i+e53XPa0y5d8hef8cWneQlVywkpW4yEj9Htse0QHAWgDHJY24Xbxp5QmmQEzQYZOH5erXLGgSSxqLXA",
-	"DBpcM24OMPLxQ0KsBZ5MHiFGxgHY6BfHgclPIjybfH0MkNwlU6B+bPSoB39D/N2XjeM2Io8oDQtnA16t",
-	"1HMA6kId6/urE3CLwxDG58SwuS3NDZtzGl8zSC/7CIqtnVwjLjLjwZA4O+IAsRfLUWuyV9FNVhPKTB7o",
-	"uEA3AvFS7BL78DMq8S53S0Pv0dB2fIYaO5g2z8s9RZZih9E+eLXYUOoDsAzD4cEINPwdU0iv2G/oNrfA",
-	"jE07Lk3FqFAhyThzXk0uQ+LElKkHJJghcrkfpG65EQAdY0eTB9kpvweV1LZ40r/Mm1tt3qQk86+GYsd/",
-	"6AhFd2kAf30rTJ1s5XVXYonaKdpBK+08M4EIGSN6wyb6Tpq+K0hBDqgUJC0hKrmKeU6NbgN447z13QLj",
-	"BWazoXz/IIiEkrBmSkNjRPdxEl/CPEkxiZ4Qq+HV6VKuzPreCFFfU9aNiB1by/zsK8BQ4hWTSifogYgu",
-	"wTT6TqFS/Z1pGpeV2rFWNuUsy+K8Aae9gn2SsbyK06ub94cXZtqfapaoqiXyW8ZtwMoSUyRHIzBHprZB",
-	"uqMLfmUX/Ire2XqnnQbT1EwsDbm05/gXORcdzjvGDiIEGCOO/q4NonSEQQYvZ/vcMZCbAh//yZj1tXeY",
-	"Mj/2wagd/3536I6yI0XXEhgMRlfB0E1kxBKmgwzD/SetA2eAliXLdh1bqB11UGOmRxk8fF62DhZwd91g",
-	"BzAQ2D1jr2okqHYKvkbAt7miWxlwTiZh5rKdKC9kCOFUTPlKB31E1a/uDuHqEmj+A+x/NW1xObNP89nt",
-	"TKcxXLsRD+D6db29UTyja96a0lqekCNRTstSii3NE2dgHiJNKbaONLG5t0d/ZlYXN2Nevjx/9dqB/2k+",
-	"S3OgMqlFhcFVYbvyX2ZVNtvfwAHxmdSNzudlditKBptfpygLjdLXG3ApqQNptJc7s3E4BEfRGalX8Qih",
-	"gyZn5xuxSxzxkUBZu0ga8531kLS9InRLWe7tZh7agWgeXNy0BKxRrhAOcGvvSuAkS+6U3fROd/x0NNR1",
-	"gCeFc40kzS5sXnhFBO+60DHmeV86r3tBMfOltYr0mROvCrQkJCpnadzGypfKEAe3vjPTmGDjAWHUjFix",
-	"AVcsr1gwlmk2JbdNB8hgjigyVTS9ToO7pXA1fyrO/lEBYRlwbT5JPJWdg4ppUpy1vX+dGtmhP5cb2Fro",
-	"m+FvI2OEWV+7Nx4CMS5ghJ66HrgvapXZL7S2SJkfApfEEQ7/cMbelTjirHf04ajZBi9u2h63sERPn/8Z",
-	"wrC52g/XB/LKq0s/OzBHtN4PU8lKij8gruehehx5sOTz3DKMcvkDwocOYZWLFouprTtN2aJm9sHtHpJu",
-	"QitUO0hhgOpx5wO3HCbc9BZqyu1W24ckrVi3OMGEUaULO35DMA7mXiRuTq+XNJaN1AgZBqbzxgHcsqVr",
-	"QXxnj3tVv7aws5PAl1y3ZfYxegmyeUvYT2xzQ4HBTjtZVGgkA6TaUCaYW/9frkRkmIpfU26ruJh+9ii5",
-	"3gqs8cv0uhYSU0mouNk/g5QVNI9LDlnaN/FmbM1sgZJKQVABww1kiz9ZKnJVROo3RA41FytyOg/K8Ljd",
-	"yNiWKbbMAVs8si2WVCEnrw1RdRezPOB6o7D54wnNNxXPJGR6oyxilSC1UIfqTe28WoK+BuDkFNs9ekbu",
-	"o9tOsS08MFh09/Ps7NEzNLraP05jF4ArMDPGTTJkJ39z7CROx+i3tGMYxu1GPYm+urcV5oYZ18hpsl2n",
-	"nCVs6Xjd4bNUUE7XEI8UKQ7AZPvibqIhrYMXntnySEpLsSdMx+cHTQ1/Gog+N+zPgkFSURRMF865o0Rh",
-	"6Kkpb2En9cPZWksuM7GHy39EH2npXUQdJfLzGk3t/RZbNXqyf6IFtNE6J9TmD8lZE73g86WTC5+eCFM1",
-	"1xmaLW7MXGbpKOZgMMOKlJJxjYpFpVfJX0i6oZKmhv2dDIGbLL9+GklP3U6Tyo8D/LPjXYICuY2jXg6Q",
-	"vZchXF9ynwueFIajZA+a1x7BqRx05sbddkO+w/GhpwplZpRkkNyqFrnRgFPfivD4yIC3JMV6PUfR49Er",
-	"++yUWck4edDK7NAvb145KaMQMpZzsDnuTuKQoCWDLcbuxTfJjHnLvZD5pF24DfRf1vPgRc5ALPNnOaYI",
-	"fCsi2qlPmV5b0l2sesQ6MHRMzQdDBks31Jy001N/fj56N1FQcU+XN2z3HVvmi8cD/tFFxBcmF9zAxpdv",
-	"VzJAKEF6/ijJZPX3wMdOybdiN5VwOqfQE88/AYqiKKlYnv3avPzsVD+QlKebqM9saTr+1tRpqxdn78Bo",
-	"+sAN5Rzy6HBW3vzNy6URyfnvYuo8BeMT23YLMtjldhbXAN4G0wPlJzToZTo3E4RYbT+qq4O287XICM7T",
-	"5Kprjmu/kEeQbv0fFSgde6CEH2zgGNpGDTuw2b4J8Aw10hPyvS3FvAHSSkSEmqDPFNF+NV2VuaDZHDNY",
-	"XL48f0XsrLaPrTZks42vURFqr6JjEwvScE4LQfaFg+LPI6aPMx6vbVatdFInB489QDUtmvTlrOMnQBUp",
-	"xM4JeREUVbVvVc0QBBOYyMJodfVoVj5CmjD/0ZqmG1T7Wqx1mOSnp8n3VKmC0pR1iak6NyWeOwO3y5Rv",
-	"E+XPiTC6+TVTtgIvbKH95rV+AO7MDv4NbHt5suLcUsrJEbdcnYnyWLR74OwV6V0JUcg6iD9S6LdVJo6t",
-	"GvAWe0VTZXVLEPRqUtoXlHXpIF9ZPaVccJZioqrYFe1K9U7xs03I6dU15Poj7k5o5HBFCx/UoXgOi4Ol",
-	"EDwjdIjrG/qDr2ZTLXXYPzXWhN1QTdagleNskM19/Q5na2Rcgcs1ioWdAz4pZMt3iRwy6g5ParfJkWSE",
-	"T28GlMfvzLefnGkBY9KvGEclwqHNCX7WGoiVRLXRPJgmawHKraf9/li9M31O8CluBrsPJ77yKI5hXX9m",
-	"2dbP3R/q3Hu9nZfZtH1u2roESfXPrShnO+l5WbpJh6u7ROUBveODCI54LxPvPgqQW48fjjZCbqPhKnif",
-	"GkKDLTq7ocR7uEcYdaWTThUtI7RaisIWxIaJRbMkMB4B4xXj0NTFjVwQafRKwI3B8zrQT6WSaisCTuJp",
-	"l0Bz9HDHGJrSzr1x26G66aEMSnCNfo7hbWyKtAwwjrpBI7hRvq/L8RrqDoSJ51gH3CGyX3IFpSonRGX4",
-	"aqFThCXGOAzj9mWe2hdA/xj0ZSLbHXOlHXsTDT1EXVbZGnRCsyyW+vVb/ErwK8kqlBxgB2lVpwgtS5Ji",
-	"3pV2Ipo+tbmJUsFVVYzM5RvccrqgqlGEGsLKSn6H8aHLco//xvJjDu+MC/Q4OtTQR3Vkx2Vf6odOxqRe",
-	"Q9OJYutkOibwTrk9Opqpb0boTf87pfRcrNuAfOb0E2NcLtyjGH97aS6OMDtDL+mrvVrq5AkY2Cd8LUpU",
-	"G+tnv22uhFdZLwssOpTqWnfjBojhqnVzvPwGwnuDpBvU3q/WQzkU5JsOxqRT7V7HaUpGWdDgiyMbIWTf",
-	"FiEUcevsUFSQDQoyn3u9p0mGPTlbxxMfBgj14WZ9gH7wsaykpMy53xtm0cesi3rvv0OYEg/bbHB3ES6W",
-	"fNBi98N2KO7bJ2PD792qVlfgnsyXErZMVN6x7SOfvEpof23ViKoj76Pr7xtecaovaw4dNN5euuoCdplO",
-	"J//hVxsnR4Bruf8nMOX2Nr1XL6sv7VrzVNOE1ImpJyWqbt2KUxIVxnLiOdmwVbHrQL2xHlm9mCIO9OuH",
-	"zWcX2VEXZiyv4syOEjt28Wpgw2mnmlRTeMRKoViTHz5WJmxiiOElVvoK0mb1x/LxPVtINRYFaOIWJMAx",
-	"SbTMZEHh0f+ffmpAna4jMV3WqbFUU/1KAAfu+N5rsOBFo82ifjI9sdJ5HZ2GfBqzIa+Bu9qf7Xcek6PN",
-	"VytINdseeH33tw3w4GXX3NtlbA3v4DEeq6OXMXnL8VbHBqCxx3Gj8ARJFG8NztDbmyvY31OkRQ3RtO5z",
-	"f9XeJG8HYgC5Q2JIRKhY9Ic1JDuHPFM1ZSAWfLSV7Q5NBrTBilDBW9IbzuVJ0lwczfvSkSnjJWkmzWW6",
-	"HvXqGgNxhx7o9StaDOsfL7CAiKqrNfq8H6GWTi762RGvXd4QfCtZ+058BhFQ/jf/MNrOkrMrCGtWoafq",
-	"msrMt4iaXrxVJxm5j3qv6nw1hi7Qq3pm1sTG9t9RRfJtYQR0mgsjRiRDYeTtcNQ6luOeskE3Nv07Btoa",
-	"uFYgXW0/lH9zoSDRwsfSjsExhgobWXQjJKjBHJcWuMHMM2+a1DqY65diphnqAorCBRIJBTXQySABzvCc",
-	"Y8h+br/7h0M+1+tBC1NNr4eLDvioaKZ6SAypfkXcbXn4QdJNjE2Mc1s/WsWy4XCQbW9IKUVWpfaCDg9G",
-	"bZCbnGtqhJVE7TRpf5UdHSF41XkF+4VVgny1Br+DIdBWcrKgB1kUOpt8p+Y3FYN7fSfgfUnL1XxWCpEn",
-	"A86Oi34Kny7FX7H0CjJibgofPThQQYfcRxt77c2+3ux9ypqyBA7ZgxNCzrmN1/aO7XYO6c7k/J4em3+H",
-	"s2aVzarljGon73k88BXzXclbcjM/zDgPU2BY3S2nsoMcSBCzG0gfJOl1pJ7UyVStvO9q7tb4aYjKQhGT",
-	"SZryNQfiZOoQmabyRxMm05cO8lxcJ0hFSZ3/K6ZzmHZtJukznjbdDLaXEMTbUOUu0D3Z0IykQkpIwx7x",
-	"Jw4WqEJISHKB4Tcxz+BKG3mowLhmTnKxJqI0aq5No+d9KNGyNMFcd1WCxz7XtRAk1uEzkBABlHue68C1",
-	"jfvwjlTBOb7CzuUmYrfBDfO7dXQZHUdwR1e/CMCcQOiHbVbnsSpB7XV161UNVY/TomBpHN3/WtEqgzEm",
-	"MeqNocIloLUP4LAZHvCQp9TOSTw9fTQDp8s85rEh7vg5Jw3Sufkv3mDdcckKHHMZ4GeRB5hjq45Vfors",
-	"aj2VK0zl31QOUEjU4T3uX7bVAJdTvcx1xumJzCAAYNjv3IJhkvf5WDBWWF0zoREkX9Qy/7xV/Jh1OJ7P",
-	"BmhPdkqtzr8BYsauJLg3frYMYKfuUEn1xssApnlfMzdaHih8gGeLp1Bl7UjenuVqEHaFK1EmOWyh5Y53",
-	"Dw+rNAWl2BbC+oW2M8kASrTudnWOmJ855O0dQdStPQk8lVOwG5VMLWLtTpEDYmdUSN7xxB4TNfUoGYi2",
-	"LKtoC3/qFpXchoq4RS4fD+uHaZziaCYRX9wYizgYGYI0Hz2XPB4YEr57rU1KOFtWm54tETYnW5X0mg+r",
-	"YH2ibGSn6TUQA8S+3EGK91A78uH2OCE4GFGdN+2DQpOsd/imqvwglY0RWa8iZFRqU+Ar+obpZ7zg6/pG",
-	"pF1rdGQqMgBTDW/AOEpo4vSCZgXdk4ytViCtW0VpyjMqs7A54yQFqSkzOuZe3VzBMNDKCuYHdQzDqXFQ",
-	"z6xi2gZaCC0g+d4pb0Py/wS5HX1oEZndXttaDBWr7O1K/GEH3Rk9ByPcBojAPUlHLcceVsFRxCQFvYIj",
-	"51HsDxifBhPFOCusFjjrlCk+jdL6z4g6PPC/cKZHqd2Kft2QQ+sTssToaZCvG8e03Zw+DcaiRC9tyaQw",
-	"UrRbgcDvtTVQ2flgIKOi450J8lQ14vIFFdRKSp3Jri8O9JixBWbuImiPkha65ob0AFOKsuiBM9GW1cUK",
-	"qRM3xV5MGDdQs+N5N6KlfQXV247VP9NKohB1TfeHE7M111A8GNiO7NUZH+NQQ+222hKYsgUlonnPjhFP",
-	"IjQfq6nQzzh194uxUe6NH+7PW46ztMcXEFZoH6e3RpD3pBKhNcr3saPjbck3WOCQdDIhTvPOtqo+LX/G",
-	"BkVZ9M0SkU4CrR+zF8FmUDl4PIwizFPcPICWNvQT3a5eH+ryix8bPWlaDWPf4QB4YXRNUMXYOzocOF/4",
-	"JfGPNVKCpXwYooTW8g8F7LgFNoplsEVOVtMabNZ4+/qsvS9BNJZ6Xgc5DRXc7sZCYVJiIxzkeSSGyoqP",
-	"tsRtQDjmnpRbmn/+OCjMVn2O+IDszbDnNAykCZFsUalu9ozvFZ00dxA0c3dT89cYt/U3MHsUvRbcUE5j",
-	"7TF/FP5pbq38K1/vcgucXOOYNuj70ddk6dKclBJSprqa8LUvRVXHjWBlRvd0cqcPBKocWuevQt+CjFfe",
-	"sER+asraoCF7zRsImyP6hZnKwMmNUnmM+npkEcFfjEeF+UYPXBdXrWjwRqoLbjQh4Y6jwoP3XUdGhfcz",
-	"qU5dno18NpdOpaC/zsm3dQu3kYu6WdvUJw195I7VPpnyEiFe0sh0x6cQFiFYD4wgqOT3R78TCSss+CvI",
-	"w4c4wcOHc9f098ftz+Y4P3wYVfI+2yMIiyM3hps3RjG/Dj2Lt0+/BzIwdPajYnl2iDBa+TSaktmYMeI3",
-	"l7XnixTt/s0GZvaPqiuceotocouYyFpbkwdTBZkyJiTJcN0iKTEw6CGtJNN7TCbsNV72W/S5xvd16K8L",
-	"Ha9NeO7u0+IK6nTUTaBwpfzt+r2gOd5H1rLIzS0k8hPyckeLMgd3UL65t/wPePKXp9npk0f/sfzL6Ven",
-	"KTz96tnpKX32lD569uQRPP7LV09P4dHq62fLx9njp4+XTx8//fqrZ+mTp4+WT79+9h/3DB8yIFtAZz51",
-	"3ex/Y2X75Pz1RXJpgG1wQkv2A+xtEV1Dxr48L03xJEJBWT478z/9T3/CTlJRNMP7X2cuM9Zso3WpzhaL",
-	"6+vrk7DLYo2RgYkWVbpZ+Hl69XvPX1/ULkhr9McdtUklvDPHk8I5fnvz8u0lOX99cdIQzOxsdnpyevLI",
-	"jC9K4LRks7PZE/wJT88G933hiG129vHTfLbYAM0xkN78UYCWLPWfJNBs7/6vrul6DfLE1Sw2P20fL7xY",
-	"sfjoIiQ/jX1bhOW/Fh9bgaTZgZ5YHmjx0We9HW/dSivrAmiDDhOhGGu2WGIyralNQQWNh5eCyoZafERx",
-	"efD3hcv+E/+Iaos9DwsfbR1v2cLSR70zsHZ6pFSnm6pcfMT/IH0GYNm3tgu94ws0Ty8+tlbjPvdW0/69",
-	"6R622BYiAw+wWK1sFu+xz4uP9t9gItiVIJkR/Gx8uzPF18fqIpudzV4GjZ5vIL3CwlfWD4Pn5fHpaSQR",
-	"QdCL2ONLlzlk5uw9PX06oQMXOuzkUrT2O/7Cr7i45gSfrVpeXhUFlXuUkXQluSI//0DYikB3Cqb8DMg/",
-	"6FqhuRar7MzmsxZ6PnxySLPPtBaYenDf4NL/vOdp9Mf+NncrjMZ+XnxsV7hp0Y/aVDoT10Ff1KasKaA/",
-	"X13zsfX34poybeQj994BMxD3O2ug+cIlN+n82rwn7n3BR9LBj6HnOfrrok7wHv3Y5VSxr+6kDjTyziv/",
-	"uZFaQilgdvYuuP/fffj0wXyTW/Q0vPsYXGpniwXGEG+E0ovZp/nHzoUXfvxQ05jP+TYrJdviE/IPn/5f",
-	"AAAA///KktDr7cgAAA==",
+	"H4sIAAAAAAAC/+y9e5PcNpI4+FUQtRshS1fVrZe9Y21M7LUl29Nr2Vao257btXRjFJlVhWkWwAHA7irr",
+	"9N0vMgGQIAmyWN1teecX+5fURTwSiUQiM5GPD7NMbUslQVoze/FhVnLNt2BB0188y1Ql7ULk+FcOJtOi",
+	"tELJ2YvwjRmrhVzP5jOBv5bcbmbzmeRbmL2I+89nGv5RCQ357IXVFcxnJtvAluPAdl9i63qk3WKtFn6I",
+	"MzfE+avZx5EPPM81GNOH8kdZ7JmQWVHlwKzm0vAMPxl2I+yG2Y0wzHdmQjIlgakVs5tWY7YSUOTmJCzy",
+	"HxXofbRKP/nwkj42IC60KqAP50u1XQoJASqogao3hFnFclhRow23DGdAWENDq5gBrrMNWyl9AFQHRAwv",
+	"yGo7e/HLzIDMQdNuZSCu6b8rDfAbLCzXa7Cz9/PU4lYW9MKKbWJp5x77GkxVWMOoLa1xLa5BMux1wr6v",
+	"jGVLYFyyt9+8ZM+ePfsSF7Ll1kLuiWxwVc3s8Zpc99mLWc4thM99WuPFWmku80Xd/u03L2n+C7/Aqa24",
+	"MZA+LGf4hZ2/GlpA6JggISEtrGkfWtSPPRKHovl5CSulYeKeuMb3uinx/H/ormTcZptSCWkT+8LoK3Of",
+	"kzws6j7Gw2oAWu1LxJTGQX95vPjy/Ycn8yePP/7LL2eL//Z/fv7s48Tlv6zHPYCBZMOs0hpktl+sNXA6",
+	"LRsu+/h46+nBbFRV5GzDr2nz+ZZYve/LsK9jnde8qJBORKbVWbFWhnFPRjmseFVYFiZmlSzAGBrNUzsT",
+	"hpVaXYsc8jkTkt1sRLZhGTduCGrHbkRRIA1WBvIhWkuvbuQwfYxRgnDdCh+0oP+5yGjWdQATsCNusMgK",
+	"ZWBh1YHrKdw4XOYsvlCau8ocd1mxyw0wmhw/uMuWcCeRpotizyzta864YZyFq2nOxIrtVcVuaHMKcUX9",
+	"/WoQa1uGSKPNad2jeHiH0NdDRgJ5S6UK4JKQF85dH2VyJdaVBsNuNmA3/s7TYEolDTC1/DtkFrf9Py9+",
+	"/IEpzb4HY/ga3vDsioHMVA75CTtfMalsRBqelgiH2HNoHR6u1CX/d6OQJrZmXfLsKn2jF2IrEqv6nu/E",
+	"ttoyWW2XoHFLwxViFdNgKy2HAHIjHiDFLd/1J73Ulcxo/5tpW7IcUpswZcH3hLAt3/358dyDYxgvClaC",
+	"zIVcM7uTg3Iczn0YvIVWlcwniDkW9zS6WE0JmVgJyFk9yggkfppD8Ah5HDyN8BWBI+QBcIScBo6EXYJm",
+	"8HTjF1byNUQkc8J+8syNvlp1BbImdLbc06dSw7VQlak7DcBIU49L4FJZWJQaViJBYxceHYZx5tp4Drz1",
+	"MlCmpOVCQs6EdEArC45ZDcIUTTiu7/Rv8SU38MXz2cdDXyfu/kp1d310xyftNjVauCOZuDrxqz+wacmq",
+	"1X+CfhjPbcR64X7ubaRYX+JtsxIF3UR/x/0LaKgMMYEWIsLdZMRacltpePFOPsK/2IJdWC5zrnP8Zet+",
+	"+r4qrLgQa/ypcD+9VmuRXYj1ADJrWJMKF3Xbun9wvDQ7trukXvFaqauqjBeUtRTX5Z6dvxraZDfmsYR5",
+	"Vmu7seJxuQvKyLE97K7eyAEgB3FXcmx4BXsNCC3PVvTPbkX0xFf6N/ynLAvsbctVCrVIx/5KJvOBNyuc",
+	"lWUhMo5IfOs/41dkAuAUCd60OKUL9cWHCMRSqxK0FW5QXpaLQmW8WBjLLY30rxpWsxezfzlt7C+nrrs5",
+	"jSZ/jb0uqBOKrE4MWvCyPGKMNyj6mBFmgQyaPhGbcGyPhCYh3SYiKQlkwQVcc2lPZvPUmWwO8C9+pgbf",
+	"Ttpx+O6oYIMIZ67hEoyTgF3DB4ZFqGeEVkZoJYF0Xahl/cNnZ2XZYJC+n5WlwwdJjyBIMIOdMNY8pOXz",
+	"5iTF85y/OmHfxmOTKK7QvLQEL2rg3bDyt5a/xWrbkl9DM+IDw2g70VjzcV6jwRiw90FxpFZsVIFSz0Fa",
+	"wcZ/8W1jMsPfJ3X+5yCxGLfDxIWtmMec03Hol0i5+axDOX3C8eaeE3bW7Xs7ssFR0gRzK1oZ3U837gge",
+	"axTeaF46AP0Xd5cKSUqaa+RgvSM3ncjokjA3n2NaI6hufdYOnockJPihC8NXhcqu/sLN5h7O/DKM1T9+",
+	"NA3bAM9Bsw03m5NZSsqIj1cz2pQjhg1JwWfLaKqTeon3tbwDS8u55SezLrxpscShnvoR0wOd0F1+pP/w",
+	"guFnPNvcBtUdzRaCjqiKHhly1PadguBmwga48VaxrVPwGWrdR0H5spk8vU+T9uhrZ1PwO+QXQTukdvd+",
+	"DL5SuxQMX6ld7wioHZj7oA+1c/8RFrZmAnyvPGSK9t+jj2vN930k09hTkIwLRNHV0GmQ8Y2PszTG2bOl",
+	"0rfjPh22IlljcmYcR42Y77yDJGpalQtPigmzlWvQGah55RtnGt3hUxhrYeHC8t8BC8byCPg7YKE90H1j",
+	"QW1LUcA9kP4myfTRSPDsKbv4y9nnT57+7ennXyBJllqtNd+y5d6CYZ953YwZuy/gYX9l85lTndOjf/E8",
+	"GCrb46bGMarSGWx52R/KGUCdCOSaMWzXx1obzbTqGsAph/MSkJM7tDNn26dDieiXpjKkJtw7K2wPnxQN",
+	"mJG8NBtlAxr4WgNswdGyRXxkG9E8TkuVO8nqlTDcGNgu74WOhvY6b2bJmUdiDgfPwbE700yzj3bnld7r",
+	"6j60cNBa6YRpkLiDVZkqFtegjVCJh6A3vgXzLYJkXnZ/d9CyG24Yzk1W60qSLJQ4FGiOnnxluaEvd7LB",
+	"zeil5dabWJ2fd8q+tJEfjKCGlfjItpMsh2W1bilxK622jLOcOhKNfguWpJhLsYULy7flj6vV/Wi5igZK",
+	"aJtiCwZnYq4FE5IZyJR0ThwHFEs/6hT0dBETrIt2GACPkYu9zMhEeh/Hdljn3gpJ7zVmL7NIAUcYC8jX",
+	"oCfgY7qiPYQON9UDkwAH0fGaPhN3fAWF5d8ofdkYMb/VqirvnSl355y6HO4X4/lyjn2D+i/kumg7Dq0R",
+	"9pPUGv+QBb0Mx9evgaAninwt1hsbaURvtFKr+4cxNUsKUPrg9MkC+/S1yh9UjszEVuYepMdmsIbDId3G",
+	"fI0vVWUZp6uXNr8yablywNWE3rjpad7GoqrdOBVxCUhdGa9wtWjSV6n7oum44Jk7oQtCjUlP2LyXulZu",
+	"OufGUGjgOZqhQDK19G9b/tWNFsnp1bwWSbxUm+AXLbhKrTIwBs2Hzih0ELTQzl0ddgRPBDgBXM/CjGIr",
+	"ru8M7NX1QTivYL8gHw/DPvvuZ/PwD4DXKsuLA4ilNin01hYKIQegnjb9GMF1J4/Jjmtg4V5hVpEgXoCF",
+	"IRQehZPB/etC1NvFu6PlGjQ9Jf6uFB8muRsB1aD+zvR+V2ircsBz0WvmKOHhhkkuVRCsUoMV3NjFIbaM",
+	"jeK1GFxBxAlTnJgGHhC8XnNj3fO3kDlZ7dx1QvNQH5piGOBBNQRH/jloIP2xs6Bp1uqIqcpSaQt5ag3o",
+	"MzE81w+wq+dSq2jsWuexilUGDo08hKVofI8stxKHIG7rVyLvH9JfHL2l4D2/T6KyBUSDiDFALkKrCLux",
+	"99YAIMI0iHaEI0yHcmqXsfnMWFWWyC3sopJ1vyE0XbjWZ/anpm2fuLht7u1cgSGnMd/eQ37jMOv89jbc",
+	"MA8H2/IrlD3IguPe6fsw42FcGCEzWIxRPql42Co+AgcPaVWuNc9hkUPB9/1Bf3Kfmfs8NgDteKPuKgsL",
+	"54CV3vSGkoO/y8jQisZLMM0fFKMvLMMjiKpAQyC+94GRc6CxU8zJ09GDeiiaK7lFYTxattvqxIh0G14r",
+	"izvuGjmQPUefAvAAHuqhb48K6rxodM/uFP8Fxk8Q2txikj2YoSU04x+1gAHzr/dtj85Lh713OHCSbQ6y",
+	"sQN8ZOjIDtii33BtRSZK0nW+g/29q37dCdJm0BwsF2hkjD44NbCM+zPnOtQd83aq4CTbWx/8nvEtsZxC",
+	"GBJ52sBfwZ507jfOJzUyddyHLpsYlQnnao6ABk83FMHjJrDjmS32jNMlvGc3oIGZarkV1jpf87aqa1W5",
+	"iAdIPsmMzOjfH50/Z9iBKQ+iFzRUtLz+VsxnTicYh++yoxi00OF1gVKpYoKFrIeMJASTXFVYqXDXhXd7",
+	"D47PgZJaQHqmXewDuP6qiNFMK2D/pSqWcUkqV2WhlmmUJkEB+9IMwkRzeqeUBkNQ0JNEjZ1Hj7oLf/TI",
+	"77kwbAU3IVbk0aM+Oh49IjvOG2Vs63Ddgz0Uj9t54vqgtyq8+LwW0uUph50i/MhTdvJNZ/AwKZ0pYzzh",
+	"4vLvzAA6J3M3Ze0xjUxzCLG7iSuP1pNcN+37hdhWxW1f29oLhmteLNQ1aC1yOMjJ/cRCya+vefFj3Y3i",
+	"YCBDGs1gkVH0xsSx4BL7uICPQ7ph4wgntlvIBbdQ7FmpIYPcmcuFYaaG8YQ518Vsw+WaJH2tqrX3nXPj",
+	"EKfGgCAKwahkb4ikNGR3ckHW6RTn9v7SIUYF5SDgqIt1TdtO87jh9XyQtxj6ROR1Tf3J1635bFBVRaRe",
+	"N6qqQ0470GYCF28JahF+moknvoEQ6lBo6eMr3hY8Bbi5v4+tvRk6BWV/4sibr/k45NCHenKxvwdpxQ3E",
+	"NJQaDN0tsX3JuK9qFQfV+cvH7I2Fbd8E77r+beD4vR1U9JQshITFVknYJ+PIhYTv6WOqt7vfBjqTpDHU",
+	"t6s8tODvgNWeZwo13hW/tNvdE9p9ajLfKH1fb5luwMly+YSnw4Pv5H7K2z5wYnhZ/03Qh9x0GYCZ1yH+",
+	"QjNujMoECVvnuZm7g+afEX18Thv9b2pH4ns4e91xO49fcTQnGXehKBlnWSHI9KuksbrK7DvJybgULTXh",
+	"cBW06GFzY+0lk7ZvJsyPfqh3kpOzXW1ySnparCBhX/kGIFgdTbVeg7EdJWUF8E76VkKySgpLc23xuCzc",
+	"eSlBk9fTiWu55Xu2Qpqwiv0GWrFlZdtiO0WUGYvGS/cSh9MwtXonuWUFcGPZ9wL9PHC48FofjqwEe6P0",
+	"VY2F9O2+BglGmEXaMexb95V8dv3yN95/F//vO7u3Gxy/CTvbW2hFtf+/n/3HC4xm54vfHi++/L9O3394",
+	"/vHho96PTz/++c//X/unZx///PA//jW1UwF2kQ9Cfv7Kq7Tnr0hvaR5verB/MsM9BkkmiSx2w+jQFvuM",
+	"Yns9AT1sW7XsBt5J9LGxCkPLRc7t7cihe8P0zqI7HR2qaW1Ex4oV1nqkNnAHLsMSTKbDGm8tRfV9KdOR",
+	"hbiRIVgQW7FVJd1WBunbBc4ExzC1mtfRoy6xzAtGoYUbHhwy/Z9PP/9iNm9CAuvvs/nMf32foGSR71KB",
+	"nznsUkqePyB0MB4YVvK9AZvmHgR70gfOOWXEw24BrQNmI8pPzymMFcs0hwvhCN5YtJPn0sUJ4Pmht8m9",
+	"f/JQq08Pt9UAOZR2k0o40RLUqFWzmwAdfxEMGAI5Z+IETrrGmhz1Re+NVwBfIYG69zU1RRuqz4EjtEAV",
+	"EdbjhUyyiKToh0Qez60/zmf+8jf3rg75gVNwdeesHyLD31axB99+fclOPcM0DwhbfugoajShSrsPbU8i",
+	"y7hPs+OEvHfynXwFKyEFfn/xTubc8tMlNyIzp5UB/RUvuMzgZK3YixBr9Ypb/k72JK3BTFhRlBsrq2Uh",
+	"MjREp8jTZTfpj/Du3S9ojn337n3PqaKvPvipkvzFTbBAQVhVduFzMyw03HCderQydWw+jUy9R2d1Qraq",
+	"nGXTj8/8+Gmex8vSdGN0+8svywKXH5Gh8RGouGXMWKWDLCJMgIb29wflLwbNb4JdpTJg2K9bXv4ipH3P",
+	"Fu+qx4+fAWsFrf7qr3ykyX0Jk60rgzHEXaMKLdyplbCzmi9Kvk69jb1794sFXtLuk7y8xS1AQZe6xTip",
+	"gwFoqGYBAR/DG+DgODrwjxZ34XqFPFzpJdAn2kJqg+JG82J/2/2KwmdvvV2dENzeLlV2s8CznVyVQRIP",
+	"O1On51lzIU1wo8AXGDwEPpPREk2KkF35FDOwLe1+3uquVi1BM7AOYVzyIRf8Rukv6GUBkxKVOfeiOJf7",
+	"bh4CA9YGf+C3cAX7S9Vkzzgm8UA7Dt4MHVSi1Ei6RGKNj60fo7v53h0MIeVlGcLJKa4wkMWLmi5Cn+GD",
+	"7ETeezjEKaJoxWkPIYLrBCKowxAKbrFQHO9OpJ9aHmoZS3fzJRIRBd7PfJNGefKeW/FqLjf1dwqqWWt1",
+	"Y9iSo9yufBIuF+sdcbHK8DUMSMjx487EiOrWgxANcujeS950+JzcvtB6900SZNd4gWtOUgrgFyQVUmY6",
+	"/nphJvd+6F8mKLemR9iyIDGpdmx0TIfr1iObXI+BliZg0LIROAIYbYzEks2Gm5AfLJ9HZ3mSDPA75i4Y",
+	"y1hzHrmaRbnS6nw0ged2z2lPu/R5a0KympChJlYtJ2Sbmc+8d3tqO5QkASiHAtZu4a5xIJQmj0KzQQjH",
+	"j6tVISSwRcprLTKDRteMnwNQPn7EmLPAs8kjpMg4ApvexWlg9oOKz6ZcHwOk9HkgeBibXtSjvyEd9+X8",
+	"uFHkUSWycDHwqpUFDsC9q2N9f3UcbmkYJuScIZu75gVIGzS+ZpBe4hQSWztpUrxnxsMhcXbkAcRdLEet",
+	"iXrcajWxzBSATgt0IxAv1W7hYlaTEu9yt0R6T7q2Y6/kwXQpah4YtlQ78vahq8W5Uh+AZRiOAEYDAOUe",
+	"wbVTv6Hb3AEzNu24NJWiQsM+q2WbhlyGxIkpUw9IMEPk8lmUdeZWAHSMHU0KZ6/8HlRS2+JJ/zJvbrV5",
+	"k00tRA2ljv/QEUru0gD++laYOk/Mm67EkrRTtFp1UuREImSK6JmQiUea/lOQgQJIKVi0hKjFFezTug3Q",
+	"jXMRukXGC0rEw+X+YeQJpWEtjIXGiB78JP4I8ySn/H9KrYZXZ0u9wvW9VU3wN3V0xsnWMj/5CsiVeCU0",
+	"+qziC0RyCdjoG0NK9TfYNC0rtTabuWy5Ik/zBpoWo09yUVRpevXzfvcKp/2hZommWhK/FdI5rCwpu3PS",
+	"A3NkauekO7rg127Br/m9rXfaacCmOLFGcmnP8U9yLjqcd4wdJAgwRRz9XRtE6QiDjCJn+9wxkpuiN/6T",
+	"Metr7zDlYeyDXjshfnfojnIjJdfSADq+CkHPRCiWCBslR+6HtA6cAV6WIt91bKFu1EGNmR9l8Agp5TpY",
+	"oN31gx3AAIm0b2EFGpImhPqT846uxaU4pSCelXYWn8SmDxr/26Y0366p8RBNdAsjmE8CObzHje9lvKLO",
+	"UhJVBvqzVkLaL5739qKx8SMsU3bjIm1av7BKQxvxkbpF+Dq0CWJAcY86xew5nkqYUDKjT7Z1DOQhysUE",
+	"Jt/B/mdsS8uZfZzP7mbITlG+H/EArt/Uhy2JZ3KUcIbN1rvUkSjnJT4/8mLhzf1DjEKra88oqHl4HfjE",
+	"F0+asi+/Pnv9xoOPFtUCuF7Ugtvgqqhd+U+zKpc2cuCAeCZFGnjQoJxgH21+nesufiK42YDPbR7pBr0k",
+	"rM3zTzNeeDJYpf21DvI+/1LlljjyYgVl/WDVGFOpc+eNil9zUQQrZoB2wLeKFjctk2+SK8QD3PmtK3qy",
+	"XNwru+md7vTpaKjrAE+iuX6klEhp6UT6hEnEivzbVZsFPTCesk5p1adoXqlvz4l38jdKt5i/d6xPvn35",
+	"QXqM8eDd7W5nj6kBZ6JQEaMrWp4wohb26/pXPG+PHsWH6dGjOfu18B8iEOj3pf+dzEGPHiXBSuoVyAZI",
+	"bZB8Cw9rN8BBVHf5m+2Hf99MuzXPrre0WuykhmmjJhv3shQwdOMXfKOFR0Huf0HjK/50OKqlmbW3Zw5b",
+	"U8j6Ysi7vXZc2Lq6GYYp2fXTocAKpAbiwOg+ugRveu3Ttay2ZK5cmEJk6YccuTTI86R7oMfGjBoPaLw4",
+	"YiUG/D1kJaKxsNmUBFodIKM5ksg0yRxeDe6Wyp+5Sop/VMBEDtLiJ02XTef+CRI7jdqTElFB6c/lB6Y+",
+	"0fB3UWTirNhdQY6AGNdiYneAHrivartcWGht9uay9e55hFdRPGOPm454BHn68NTsPKQ37Wf9acrFlPpp",
+	"gTf59NwDcyTroQmzWGn1G6SNSWSDS0RF+olIR6DeJ4nY++7NWZuQm7JuzeyHtnu6wjq08XdWUMOi69Tj",
+	"t9FO06f6uI28jSZq0rn75rP4SKbhch9Z291sgLXQ8YocLCjrc3hr5NKdJxcS2PJaTp/KqIU5deM3p9LD",
+	"3N3VrOA3S55dpRUUhCna3tarqFUsdA4bYOq4OTc7i7yC6rbCpRUpQTdR4f0UZbdUNty0k9WMRqvAji19",
+	"Yu48OQqjEsNU8oZLCyGrv+NXvrcB94yBvW6UpqRAJi3e5ZCJLS/SWkee9R/rcrEWrkpWZSAqw+QHchUI",
+	"HRX5UlZ1NKhHzfmKPZ43ZzLsRi6uhRHLAqjFE9diyQ1dl00+2dAFlwfSbgw1fzqh+aaSuYbcboxDrFGs",
+	"VghJyKvdEJZgbwAke0ztnnzJPiMHDCOu4SFi0QtBsxdPvqTnM/fH49Qt66ucjbHsnHj2Xz3PTtMxeaC4",
+	"MZBJ+lFPkvlTXJnT4dth5DS5rlPOErX0F8rhs7Tlkq8h7fO3PQCT60u7SU8iHbzI3NXoM1arPRM2PT9Y",
+	"jvxpII4I2Z8Dg2VquxV265/pjdoiPTU1ltykYThX8M/x9Bqu8JG8Xcrw2N8xQH3a5y8nRKRWTT5JP/At",
+	"tNE6Z9xlgipE44cWinaw85BojuoF1GUCHG5wLlw6yZK4hZSrW0hLRonKrhZ/Ql1V8wzZ38kQuIvlF88T",
+	"NRLaubrlcYB/crxrMKCv06jXA2QfZBbfFyOr5GIrkNU/bOL2olM56JaTnNYOeYGMDz1V8sVRFoPkVrXI",
+	"jUec+k6EJ0cGvCMp1us5ih6PXtknp8xKp8mDV7hDP7197aWMrdKp7LHNcfcShwarBVxDPrhJOOYd90IX",
+	"k3bhLtD/sW/IQeSMxLJwlpOKQDA6jUVfoQj/8/e+pm9P9h7wGKOfmz4H7WRp0yD1b1u6nvzKNCp/JEA+",
+	"ekTzoMHLNf31afuz4yuPHqXToCVtPfhrA/hdVDHqm0I7VoR58WGgXEr9FO2DvfooH+SO+AFP39IPNWft",
+	"0hSf/vq6HzfitKtImnDRMwS/BDzQH11E/MGnlDawcYZzKxkglKg0T5Jk8vp75KTG2VdqN5VwOswvEM//",
+	"ABQNoGSiXYhW0is9lHy8Peg9ENEojrqEQqF2Y1WSNO+K53HUILzzEQRVosh/bnJLdNi15jLbJL1yltjx",
+	"b00R2xoqx91SC8UnIwlFcjinB/0t6EsJje7vauo8WyEntu1Wq3LL7SyuAbwNZgAqTIjoFbbACWKstsP2",
+	"67CwYq1yRvM02XAbftavchaX23mjValMSuI+Y6X/5q84AyC7Cbpr97VEnbhFLtZgBvI9u291FjiaKdRF",
+	"S+eMUDfJwf5apwaubSEZ1zqYDl23ZimZhtzl9kuupwQtVJ42Tigt1kLiayw1Sq/LfcNhm9TJDioqR+OH",
+	"KPYeIhhwR2zmcs0O2PI8GkOvMLg+YV+jzaNOWeEgQce8cAOCZFI5tDNhXM2jnFWlksldKPm+UDxfhPie",
+	"sf3wiQ4aM6GbfcONCzAIY6SxHWYKaTfuNFU9yMBcQsqxCXir9CA+D1KYBB4xyLuTUuglA64LAXqUoIzl",
+	"6+TjUjOvUStL+8XsRoNBDdsR0pJU7NTk08i5+wTboe0UBc7bx7o+ks1CakwmCCW1o++ncKa/AlYfGUg4",
+	"h5i5oQYuRoHsbi5AjXf41yfhUv/LI+azm1tuWD15HDRIsUcq41bpSXWjbkPHHuBRahzwmrxl1bguJebA",
+	"80JISGdzr/vCzlJZK1VZBrsSMtsuFzNnW+CmIgfzUCfClU/0I2j3NtPOB5+mrhUlG4IMk3ruF0cAuCJ/",
+	"fN/xk4EreVmO8tIwKV0KofCOo3Ihndpb53Pa4eZB6RRiw264oBivcEJWqijUDf6CrQaulJHz3+bOrcUO",
+	"JgMiRjYQndXmc2ZcUJsUD94XDxOx4Qd17BrllNLYPaYpd0DYUE5U6jlQd4RIzZTRC2FrddMIBbdsfFto",
+	"6wPL716gnZGOhhQ7TQPU8qIQYIb5qKk5aAi1CZDTH23quiMd/KwsXOKddDCTY7At1AyYEN4c0Bhvre2e",
+	"N1xwkPvER6HB0CjjbiCfhsg6+WNAJo+IoM21o7tpMfXSUyv6U4fw/HoMjJV3P/nw/XAtAvHRIbvgAVbT",
+	"JoIOu5p0Kob71BLp4VVzBz+TAHjRK6aJRENUM83B/hu0YgKfc66kupHDcVr6YNmknKJyMxtw3XDEMFty",
+	"cAetOV50odV42WUj1hswllHI1O05rhd7Dx237jFrNqXG1TxFqM1iU6cnlBz9R5UUjf0HF7mOnelqdOVG",
+	"GcicHClO2LeUXwsJr1UJgRwYQqrqdtrWqkQNYU4ptNGBnrlZXR8NttK+3Oma3u/bRo6kw9X0NLYhf9hA",
+	"fqbp44wnjMFVG7uoq5OmMmBii6Z+qui4xtPLfoydE/bKOVWY8GTvJmGUQZ101Ho6/6xHJiP8j7U822AD",
+	"1TJND1vEptfpDUarxpeLh/9nTXEsUhsQbl+q11XqnTOFUtuNwKTYG26RCbY1Wz9JI3q6JJzt5elKSkcp",
+	"J0e8EtSlsI5Fe+uSrd2Mk5B1EH/kW7Wr0H1s2eIL6pUiyl4N5I4fcEjhGBK5s++9u1HGpZIiI2019cRB",
+	"CQKnOS5OKCqS9jg0M39CE4crWXm5zgXgsThYi3k+ayGu7wQcfcVNddTh/rSkrG24ZWuwxnM2vOR97XPv",
+	"IiekAV/sDIko5pNKJyITUo8Ii9ql+kgyotxfAz4P3+C3H7xHDB5BdiUkSRIebf7hzDmxYR4bpHbJhGVr",
+	"Bcavp50A1fyCfU4oF2gOu/cnr9VaZBdiTWO4aBdctgvt6g91FgK9fGAVtn2JbX2FhvrnVkyHm/SsLP2k",
+	"w5Xxk88FWIVgCMGJF5JFcC2PkFuPH482Qm6jEZp0nyKhYc0NJ6XiPdyXTkOp9fYoWHGjchRFLZiLU0+a",
+	"3JMK/2shg6iVviCy5JVAG+PkpnQ/k2lus02LDR2K66oDV7oMzVjvlXvXoTobHLSQbBbmGN7Gpkr8AOOo",
+	"GzTvOlzuWTgUSN2RMPGSF3WEY6LmO0lVXojKuW3yzoYq8CnGgYx7sQVjQvRetwxU10+iJRO57lSs5dib",
+	"aCgT5rLK12Axy2LKHv8VfWX0leWVJgPhDrKqrlFWlgyB6mbC71ObnyhT0lTbkblCgztOlwvDjYHtMqWH",
+	"vqo/Ql7vMFIaGsvw31SBruGd8bGNR+c6CIGM+XHlH/q5G1JSL9L0AvOvTccE3Sl3R0cz9e0Ivel/r5Re",
+	"qHUbkE+c/3qMy8V7lOJvX+PFEaeH7oWRuqulzt5MIZuKvoeEZ3Xe0Y4lnDui7c3pNy+xZR3gQ8Mk4Ne8",
+	"GMgvEvudufvVmSyGsoxkg0lxuPXp+SxnoyxoMOWZix7seLL1nQqHIgZdwOD9uZP5tY4iNERY9wH6LqRv",
+	"YCUXPmqkYRZ9zPrw2H4ipCnBrM0Gdxfhk9kMejx9dz2UeCYYBOl7XHXG+/XP/eMgXAtV+Q2royKDSuh+",
+	"XVFawnZ1mYH1J8OD/2h3skHnt0tf3tgt0+vk3/3sYmgZSKv3/wNc4Xqb3i1dlJB2qUVEsF4F7jnVDCi1",
+	"rVtxSqWkVFEeLxsGW5ljLS1a6hU56pHVqyniQA8fH+ez8/yoCzNV2GnmRkkdu9dohKS6EH8BnoN+c6Du",
+	"RVPrgo5YqYxoCtQWOJh/qN7QcCdTw4+RgEVct6M/VnjKuYbMUlXiJtxGAxxTxQMn86fgf+tfjKjTdZS2",
+	"L3sxVuuiX4r4wB3fS0cXpVQceqcfrOxwVgdVEp8mR441SLJo5p3URpMTrKxWkFlxfSD93183IKPUcvNg",
+	"l3FP1VE2QFFnNqjSPiWHzEUNQAW/JTwFvz9whtJNXcH+gWEtakjWla0zcdwmcThhwPmRlIMulM6Q7ONI",
+	"hKkpg7AQggRdd2hKsKQYCU0XJbO85VyBJBmPE1yOTJmuiT9pLux6VNpXCtIfSvfRL6k9rH+8ogrmxofM",
+	"8DrxeKylo8GxW57pxicup2SN9dtJ8EYCE34LmVndLIW4gsaBw79U4bNgaJE0vQSrzmLkPuql9WMiDfSq",
+	"nlk0Id197/P+HrvsCFmhUIxYDKWYaL+t1iFID4yLFXP1Z0F7uFagtaMAbIljw8Kq4IY2BscYKgwFxN0K",
+	"CWawyJYDbjD1/dsmtz8VG+SU6p77OLh4gUzDliN0OsrAPzznGLJfuu8hV1bwOTpoYarp9XDV4xDML0wP",
+	"iTHVr5i/LQ/n4LqNsUlICXoRXp666fgl6PZrSKlVXmXugo4PRm2Qm/zUPsJKknaarL/Kjo4Q5bK6gv2p",
+	"90L35aLDDsZAO8nJgR6lce5s8r2a30wK7vW9gPdHWq7ms1KpYjHw2HHeryHQpfgrgRV4GN4UIeh1oIQ/",
+	"+4xs7PVr9s1mH3LmlyVIyB+eMIa2L3KndQB3ilh2JpcP7Nj8O5o1r1xZD29UO3kn0/Ha5Get78jNwjDj",
+	"PMyAzO88lRtkfCK7G6hfgAVxDD0YD3DGca28/9TcdatpiMpBkZJJLtyL1Us66CnDESVFi1Lq0UMmZ/6l",
+	"i5lCpYIsb5O4DYdKYyqejACyICeIZTRgnEUuiQDvxeN5UCjkn1S8Cp6BS8ltQmxcne3X53N2LyztqvkT",
+	"9a/LKHWSVUx5SG6X0jeuQWcOsnvy/vCJcyCuSxIKaNDhpUuLiTplTPBAbhodXbS/VTGkxn3qqY6kq5AZ",
+	"ZSghVPg+dTnUSViWK2gvCQc6fjFR+q2xtQwWozpfkSVCkI+FDvTWrofjO7f5UnuhFLJuKGcNz/9emVBr",
+	"RiNgxf7fGa9HcXIvlXJDelsV6gbn27oA4r+Tu/udTe+eMEdPX5IO+u4DYA0LmfknJNuedB7R32E46zO7",
+	"2SgD3UTP/nDmIA/njivL81e9HNDj7wuBfL+jo4l7hJuJBOrLVF8BlL74e8s6b44m2ji17GFvJYerAzsZ",
+	"ZLAJvNSHJK0pYRqJJrjDrby/IesQl02lmnva2jSXPbCNh9NVjxxjKuczJdfz75V0+hBsNEhjWLlH8Lr5",
+	"jv/PPQGpa2D4CLTYmL/AgnSBI00i9BGrR5Owukm8cu+JNRv7hmOXUb7LY1jlpDSbcTTUrfJqNuk0Pd7G",
+	"dvMrtTtwH7liYtKSTR43LsqdMM6x5s7GE95hsZtYUYBBKmfvQW6Gp0Td+Mj6pdpN52lp98lLD1MqG8qk",
+	"vDQjD604bkDaLcZOn8p5SA5yWNw/GBdQhwQ029WEBfT3BqPpFqQ1L+qCiykxtSAJKzYKhRLTTTdmFaWR",
+	"rOMLuPEGQwzazlmmtIYs7pGO33NAbZWGRaHW6TDt12JlDSvEViDxSlaoNVMlboOrW5rmPkNzVRI3ACOm",
+	"I+/uBAao2he9NSnm+7C6z9Qp0bbj/JkWZPJbT2b42MflVG2KALhFL5xP3UBKEzA+6b/HkGvch5foxiXk",
+	"7r5pH1UxtHVZt9PJUg9WasigzrEbIcw7Ewf7nd1oVa03UbkmdiOKIjxc4TboKoQWRaNQaVZqbzxjryVg",
+	"Y3GAKygpPHALW6X3TuUIAQdzVpmKvOoRuOdsq1xQLUjLlATDPkMGoFVRtJ8snQF37f0wvue7syyzr5W6",
+	"wuSxD/+dqQJtLh4qnDEoKr7cCHv++LGzM80x8EwiWXOdbbD+I03g3L2lsjVO83nI6dkNP6lXMxbb6mSu",
+	"IEpOFjxayo4JftpEJgPmgR45EbiBURwt+Xhe1/PfOCQERWBO4LGH3UPO+gvrrqvNbtMW9zPJuFVbkaWP",
+	"3T9XYMhgOMcA9fQfYcLZ9/Qcx6gFM2YwnOeVt+6FIMtIRFGSWVW2awrG4d7e0h7dVq7oxng4W7JsZBg9",
+	"LOp4C0zHiJf2+a6L306p0HYHYBKacMoalK7W+lUjIN0Bhlh6TVHcIHW1lKLEgcXn8uAf6Bi109iYIOOl",
+	"N8+0sKl0SprwqQkRkOH8hKnMhI8enTCswhK5mBqsXoJ/UvmOvlB5v06Kt/PujOI0jvTuTIkvKR7oevjc",
+	"6NSMhMpYjq0DAEh86qMGJF55qX338pd3hCYGgv+lV6LuuGwF3PbmjmTovkznLf2LbPA9ogMAQeoS9tpK",
+	"k0tr67WgloDU2mmNJCN0AZ0ocVK0zN1gwxHuHSgLdwKqF6FXA/iZM9rMnfnTRfuR5ua+P2zqGN0K+I/j",
+	"VN6SGobCkC4a0tLUpDb/DogCSb16PGbnkpI1L6dG7pjgZTlR+o8AGI7lacEwKaLnWDBWHEM6F9wOKCLk",
+	"RzGPXoN95qdo9JCegGZhGXfKBfrwcVFUGny6f7p/mG77aJbcboKwgs373k7oOQNO5P8NtCIWnc8jH0Eo",
+	"XNaizoO1KhcFXEMrxMnRsqlIDRXXEPqaujPLAUrymO36caRidyI8di94v/ZFFP0xBbvJ136HWLdT7MBT",
+	"ftLxYCcX7piYqUcJIboWecVb+DNHW1lbrip4lBOo6tkPFs5OAPnUaX5yI7wNA5yF/ikdJmDi/TQ+dDQL",
+	"SqNujAEdjOWrzNCpl+lQvrjARu0ESLPltbOwI/GGb5iS38hhp5k+yTemmIn7JJSMEPv1DjKSatqxanfH",
+	"CaPBmBHrw2toCOJuzld/CA2PkvDgeCnp14C3qTQh8sE1MqyjpguvqTdvyBJF5KLwuSU9//f8b86WVRgI",
+	"bYAuVVGsCbyC4OVKRXZrBz+3olB1hjx8HLod7+8bEEUUjYz+2UrTP1JZ9o+KF2LlMgU68EM3ZjYcSci7",
+	"1Tp/bx/jhxOPCybzAFiwYaowlVu3mDpmNNweR4mAxiuQKe09NLf8CuJtIFd2x3kyiyzHVMutMIYuu852",
+	"9rHgFx9S8m95HlnyXGGwfesmCtUdsfe/N5lO4qlCPR966MrD5hm+7TiRkRhRExe6HxxjO7iMSCC0iohW",
+	"h6TWuXOScPira0OQJEL/WQqrud6PBOYe9LtJxZeT5HwI7EgAjxwN7m0ZE1P9dAqdjyQRmrSU+96FERHr",
+	"kHdQC8KOp9AnQHGyLN/QMqaA/wlRO2CeikGiJp8Cka309ceYs1DGuObFiLX0krw56Zm8U4Q6vI35vikT",
+	"Vrgw+gMI04j2lFoGmtQlUTO8nXKxWoF2kWbGcplzncfNhaQMzhxT7vK9uf0bJEKrK5jHTDj5DMmjq7qd",
+	"8Cx6kKQdd4AUe+/PescnwhpAfo9vhRPe+C43kHzfcxq/VQNPen0Y0mn4+Q6fYSnhyAAB+sJ29AhLzZiS",
+	"9AzhLvvj5jHiNxifhmr6+qAYq2jWKVOMn7MfCXUkzf8khR09ac5U1M0A40L03EEI9C/XTZyw25w+/ZdZ",
+	"erKynbinm0007LWLF3DzDaWrbpsnB3aRPKZ9xqfYFnmE9b7llJ1gyl5BW5DiZkYigcE0Ua/kbeQ0+l5k",
+	"Slfjc0iZ+8RKR14ZzkzK81wMvLJchkcD489We9raux7HmX7LRq7kaYhKVS6yKeFhrux37gAIkLZhHHsI",
+	"HqWO2pPe1NXpY2psl6mn8cxtZLlOmfyDKTizMQ1ySFsf4KBtS7BaES+jI+xsFErHmvm8m46ibY2omQTj",
+	"TENWabLW3fB90kGq5Zm5sGkoQyYvN3J4JwkJCmqoPTE6dmSaJ62e7+YxdrAEh0zQa8KV8/4XM+Tref/L",
+	"8WFy6QXgqz02RCjH6a2xGAdSSdAa6qkJBhcCwW6xwCFD1YQkS/e2VfVp+T02KHmhj+QT6T+S1wmGJoHW",
+	"T7iTwCYBMJBJo5UDIQoCj6q/aWcjImtSMLx3+cX3jUH+YMgnQRI6HAAvTo3RtKudLTw4f3AZte9rpERL",
+	"eT9ECa3lH8q24RfYvGBEW+S1CmvB+cW6mKH2vkSpVMzLOkPJgBjRS2SilbLk8lUUiQQoTtGhMxUTjpAW",
+	"9DUvPn0Sk2+ENvaM8AH52+Gw5zgLRoxkh0pzuxy8r/mkuQv+O0wt31DSlaHSOGeS+aH840WP+ZOaygvn",
+	"slpXt0GHP5/hHHeaPfmCLX1p3VJDJkz3UcRZriNPyGvQaButi4GMZ5k4tM6flb0DGa/CCyb7ITJuKtKz",
+	"GwibI/oHM5WBk5uk8hT19cgigb8Ujxr3VmpdF1etQJMhR6V7Tul2e6efeGWUPHfy8mgddOlUBvrrnHxb",
+	"j4fHOAinIL7JRzi5Di4WzF5OSSOYLoCL3SmP4b1Uwj2qDu7vkMHQ4ciP4edNUczPQzntXd72geqKnf3A",
+	"QowHrbFxrUwM8AIJRhiqBvk3Xyn6096lAQIXJdM/qg7Wu6SCc4hJrLU1eTRVVAVzQgFM3y1R7pIyFmSV",
+	"FnZ/gfgPGq/4W9KN8ds6b5fP+1Ybm/3dZ9UVyPDa12T5qky4Xb9VvKD7yNnAJTCrVHHCvt7xbVkEp88/",
+	"P1j+Gzz70/P88bMn/7b80+PPH2fw/PMvHz/mXz7nT7589gSe/unz54/hyeqLL5dP86fPny6fP33+xedf",
+	"Zs+eP1k+/+LLf3swm88EguwADTE8L2b/z+KsWKvF2ZvzxSUC2+CElwJTo338SKrlSuHyCakZnUTYclHM",
+	"XoSf/u9wwk4ytW2GD7/OfDX22cba0rw4Pb25uTmJu5yuKa3Pwqoq25yGeT7OOxg/e3Ne+za6V1ja0cbc",
+	"czJrSOGMvr39+uKSnb05P2kIZvZi9vjk8ckTHF+VIHkpZi9mz+gnOj0b2vdTT2yzFx8+zmenG+CF3fg/",
+	"tmC1yMInCmf3/zc3fL0GfUJ+6+6n66enQaw4/eADtT+OfTuNH/hOP0R/LUR+oCe9XJ1+CPF3461j7f3U",
+	"+wVEHSZCMdbsdKl2RzQFEzUeXgopG+b0A4nLg7+f+sq+6Y+ktrjzcBpSpaVbtrD0we4Q1k6PDE3JVXn6",
+	"gf5D9Plx/OvpShQQNQllfU5D7K7/4JJsn9qdPKWHkNMPLUz4zz1MtH9vusctrrcqh7BYtVoZsAc+n35w",
+	"/0YTYb0+LVBodInt/KNPfSTPc6wlEDV6uYHsajafOduBcTz26ePHiQoEUS/mjj46SuR4bp8/fj6hg1Q2",
+	"7uTDj/odf3KVpBjlq3b3QLXdcr0n+cpWWhr243doqIfuFMKEGYj3cHwZ/2VWVstCZLP5LG4/e//RI83F",
+	"iZyaqiyLfYPL8PNeZskf+9vcyk058PPph9af7ZNkNpXN1U3Ul+jPmRH68+HHynT/PsXChyhb+USHfGVB",
+	"pzpr4FtPURGtdKtXYCvTFOs1zdtgFGPDDTOgMZGRAWkpBs2auSsLiddr7TS9puJSLrCMfY2Pi7/SsL+6",
+	"PlTmMcrKZ8i2JULVFWtcFnJBMeTeSSoERNcgzqlhXPuXS5cZTbvwLMqTZk3Lksf+GkDcmnXJXVXirbfm",
+	"hEk7ERKYNupXCrsL4NOLuLRsCSulwVuCEIMMZG7YEshBjHG/voxTjVYsDg+aAmARifkJe1kIqJ16NGRK",
+	"Slyqj/b7FdXIxdc44+L81a9B4NFgqi0w2m/f0+H5olmnM0YpDHLsBDLiBe5DFakbbp9iWQzIih4nlGIr",
+	"jtBuBGWlE0VYJO6Cn4UcoTQQAYRUshJuQhQjns42U3KE9pWjxvmsFt7wAB80DikPQB3l2MSQRltAwJiG",
+	"Fl3ngK46/rEGkGS0f1Sg940MVRchIo0Az8tWSHxanr14nHo3TqSFDtEpN1FQf2C/kePff178+ANTmnkT",
+	"5BskyZrwesuMYzmx5xD8XjuJFwASof8lxPZ58o8Urcby0U/URt61kcUzd8m2fTHLUVRSU9MCpRct8X7K",
+	"zXQWNlitElzoxF1Qj4PI7A1SFnb2lFosXG/8sYFk1JjfqqNAMnnHSZfnLETb0+RPPuHk59K5ReJ16LSI",
+	"5oL+RCB0z6fSXXZb59MK/pYI5OefdJPOpQUteRHEDJz/2Sec/wL0tciAXcK2VJprUezZT7KFkFtJSI6R",
+	"+st6Hl+DJqo2FC4D41yaXOKs3r1+hBBlgRenvkhaI2TQr01dkt4XKrYS/RhHWyR/PaWLYfBjV2lKffVK",
+	"w0Cj4PEVPjcGlNggQZdSbYr45T0yKsd63H3V6NcvTk/Jv3WjjD2dfZzH30zn4/sa2x8Ckyy1uEZoPr7/",
+	"+P8PALQrzD9xCgEA",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -124,6 +124,12 @@ const (
 	GetTransactionGroupLedgerStateDeltasForRoundParamsFormatMsgpack GetTransactionGroupLedgerStateDeltasForRoundParamsFormat = "msgpack"
 )
 
+// Defines values for StreamBlocksParamsFormat.
+const (
+	StreamBlocksParamsFormatJson    StreamBlocksParamsFormat = "json"
+	StreamBlocksParamsFormatMsgpack StreamBlocksParamsFormat = "msgpack"
+)
+
 // Defines values for GetPendingTransactionsParamsFormat.
 const (
 	GetPendingTransactionsParamsFormatJson    GetPendingTransactionsParamsFormat = "json"
@@ -292,6 +298,15 @@ type ApplicationLocalState struct {
 	Schema ApplicationStateSchema `json:"schema"`
 }
 
+// ApplicationLocalReference References an account's local state for an application.
+type ApplicationLocalReference struct {
+	// Account Address of the account with the local state.
+	Account string `json:"account"`
+
+	// App Application ID of the local state application.
+	App uint64 `json:"app"`
+}
+
 // ApplicationParams Stores the global information associated with an application.
 type ApplicationParams struct {
 	// ApprovalProgram \[approv\] approval program.
@@ -316,6 +331,24 @@ type ApplicationParams struct {
 	LocalStateSchema *ApplicationStateSchema `json:"local-state-schema,omitempty"`
 }
 
+// ApplicationStateOperation An operation against an application's global/local/box state.
+type ApplicationStateOperation struct {
+	// Account For local state changes, the address of the account associated with the local state.
+	Account *string `json:"account,omitempty"`
+
+	// AppStateType Type of application state. Value `g` is **global state**, `l` is **local state**, `b` is **boxes**.
+	AppStateType string `json:"app-state-type"`
+
+	// Key The key (name) of the global/local/box state.
+	Key []byte `json:"key"`
+
+	// NewValue Represents an AVM value.
+	NewValue *AvmValue `json:"new-value,omitempty"`
+
+	// Operation Operation type. Value `w` is **write**, `d` is **delete**.
+	Operation string `json:"operation"`
+}
+
 // ApplicationStateSchema Specifies maximums on the number of each type that may be stored.
 type ApplicationStateSchema struct {
 	// NumByteSlice \[nbs\] num of byte slices.
@@ -354,6 +387,15 @@ type AssetHolding struct {
 	IsFrozen bool `json:"is-frozen"`
 }
 
+// AssetHoldingReference References an asset held by an account.
+type AssetHoldingReference struct {
+	// Account Address of the account holding the asset.
+	Account string `json:"account"`
+
+	// Asset Asset ID of the holding.
+	Asset uint64 `json:"asset"`
+}
+
 // AssetParams AssetParams specifies the parameters for an asset.
 //
 // \[apar\] when part of an AssetConfig transaction.
@@ -407,6 +449,18 @@ type AssetParams struct {
 	UrlB64 *[]byte `json:"url-b64,omitempty"`
 }
 
+// AvmValue Represents an AVM value.
+type AvmValue struct {
+	// Bytes bytes value.
+	Bytes *[]byte `json:"bytes,omitempty"`
+
+	// Type value type. Value `1` refers to **bytes**, value `2` refers to **uint64**
+	Type uint64 `json:"type"`
+
+	// Uint uint value.
+	Uint *uint64 `json:"uint,omitempty"`
+}
+
 // Box Box name and its content.
 type Box struct {
 	// Name \[name\] box name, base64 encoded
@@ -425,6 +479,15 @@ type BoxDescriptor struct {
 	Name []byte `json:"name"`
 }
 
+// BoxReference References a box of an application.
+type BoxReference struct {
+	// App Application ID which this box belongs to
+	App uint64 `json:"app"`
+
+	// Name Base64 encoded box name
+	Name []byte `json:"name"`
+}
+
 // BuildVersion defines model for BuildVersion.
 type BuildVersion struct {
 	Branch      string `json:"branch"`
@@ -435,6 +498,102 @@ type BuildVersion struct {
 	Minor       uint64 `json:"minor"`
 }
 
+// ConsensusProposal A proposal value seen in the current round.
+type ConsensusProposal struct {
+	// BlockDigest The digest of the proposed block.
+	BlockDigest string `json:"block-digest"`
+
+	// Lowest Whether this value carries the lowest proposal credential seen in the current period.
+	Lowest bool `json:"lowest"`
+
+	// OriginalPeriod The period in which the value was originally proposed.
+	OriginalPeriod uint64 `json:"original-period"`
+
+	// OriginalProposer The address of the original proposer. Empty for the value voted for when no block is agreed upon.
+	OriginalProposer string `json:"original-proposer"`
+
+	// PayloadReceived Whether the block for this value has been received.
+	PayloadReceived bool `json:"payload-received"`
+
+	// PayloadValidated Whether the block for this value has been validated.
+	PayloadValidated bool `json:"payload-validated"`
+
+	// Pinned Whether a certificate may have formed for this value in an earlier period.
+	Pinned bool `json:"pinned"`
+
+	// Staging Whether a soft vote threshold was observed for this value in the current period.
+	Staging bool `json:"staging"`
+}
+
+// ConsensusProposalWeight The vote weight counted towards a proposal value.
+type ConsensusProposalWeight struct {
+	// BlockDigest The digest of the proposed block.
+	BlockDigest string `json:"block-digest"`
+
+	// OriginalPeriod The period in which the value was originally proposed.
+	OriginalPeriod uint64 `json:"original-period"`
+
+	// OriginalProposer The address of the original proposer. Empty for the value voted for when no block is agreed upon.
+	OriginalProposer string `json:"original-proposer"`
+
+	// Weight The vote weight counted towards the value, including equivocators.
+	Weight uint64 `json:"weight"`
+}
+
+// ConsensusState A snapshot of the agreement state machine of the node.
+type ConsensusState struct {
+	// Deadline Time of the next timeout expected by the node, measured from the start of the round, in nanoseconds.
+	Deadline uint64 `json:"deadline"`
+
+	// FastRecoveryDeadline Time of the next fast recovery timeout expected by the node, measured from the start of the round, in nanoseconds.
+	FastRecoveryDeadline uint64 `json:"fast-recovery-deadline"`
+
+	// Napping Whether the node has already voted in its current next step and is waiting for the following step.
+	Napping bool `json:"napping"`
+
+	// Period The current period of the round.
+	Period uint64 `json:"period"`
+
+	// Proposals The proposal values seen in the current round.
+	Proposals []ConsensusProposal `json:"proposals"`
+
+	// Round The round the node is trying to agree on.
+	Round uint64 `json:"round"`
+
+	// RoundTime Time spent in the current round, in nanoseconds.
+	RoundTime uint64 `json:"round-time"`
+
+	// Step The current step of the period.
+	Step uint64 `json:"step"`
+
+	// StepTime Time spent in the current step, in nanoseconds.
+	StepTime uint64 `json:"step-time"`
+
+	// Tallies The votes counted in each step of each period of the current round.
+	Tallies []ConsensusVoteTally `json:"tallies"`
+}
+
+// ConsensusVoteTally The votes counted in a single step of a period.
+type ConsensusVoteTally struct {
+	// EquivocatorWeight The vote weight of voters which equivocated, which counts towards every value.
+	EquivocatorWeight uint64 `json:"equivocator-weight"`
+
+	// Period The period of the step.
+	Period uint64 `json:"period"`
+
+	// Step The step.
+	Step uint64 `json:"step"`
+
+	// Threshold The vote weight a value needs to reach in this step. Zero if unknown.
+	Threshold uint64 `json:"threshold"`
+
+	// Voters The number of distinct voters seen in this step.
+	Voters uint64 `json:"voters"`
+
+	// Weights The vote weight counted towards each value, highest first.
+	Weights []ConsensusProposalWeight `json:"weights"`
+}
+
 // DryrunRequest Request data type for dryrun endpoint. Given the Transactions and simulated ledger state upload, run TEAL scripts and return debugging information.
 type DryrunRequest struct {
 	Accounts []Account     `json:"accounts"`
@@ -630,6 +789,78 @@ type PendingTransactionResponse struct {
 	Txn map[string]interface{} `json:"txn"`
 }
 
+// ScratchChange A write operation into a scratch slot.
+type ScratchChange struct {
+	// NewValue Represents an AVM value.
+	NewValue AvmValue `json:"new-value"`
+
+	// Slot The scratch slot written.
+	Slot uint64 `json:"slot"`
+}
+
+// SimulateAccountOverride Replaces parts of an account's state during simulation.
+type SimulateAccountOverride struct {
+	// Address The account to override.
+	Address string `json:"address"`
+
+	// AppLocalStates Local state keys to set. The account is opted into any app it is not already opted into.
+	AppLocalStates *[]SimulateAppLocalStateOverride `json:"app-local-states,omitempty"`
+
+	// AssetHoldings Asset holdings to set. The account is opted into any asset it does not already hold.
+	AssetHoldings *[]SimulateAssetHoldingOverride `json:"asset-holdings,omitempty"`
+
+	// Balance If provided, replaces the account's balance in microalgos. The account totals are adjusted accordingly; a balance that would overflow them is rejected.
+	Balance *uint64 `json:"balance,omitempty"`
+}
+
+// SimulateAppLocalStateOverride Sets keys in an account's local state for an app during simulation.
+type SimulateAppLocalStateOverride struct {
+	// AppID The app whose local state is overridden.
+	AppID uint64 `json:"app-id"`
+
+	// KeyValue Key-value pairs to set. Keys that are not listed keep their current values.
+	KeyValue *[]SimulateTealKeyValue `json:"key-value,omitempty"`
+}
+
+// SimulateApplicationOverride Replaces the programs and sets global state keys of an existing app during simulation.
+type SimulateApplicationOverride struct {
+	// AppID The app to override.
+	AppID uint64 `json:"app-id"`
+
+	// ApprovalProgram If provided, replaces the app's approval program.
+	ApprovalProgram *[]byte `json:"approval-program,omitempty"`
+
+	// ClearStateProgram If provided, replaces the app's clear state program.
+	ClearStateProgram *[]byte `json:"clear-state-program,omitempty"`
+
+	// GlobalState Key-value pairs to set. Keys that are not listed keep their current values.
+	GlobalState *[]SimulateTealKeyValue `json:"global-state,omitempty"`
+}
+
+// SimulateAssetHoldingOverride Replaces an account's holding of an asset during simulation.
+type SimulateAssetHoldingOverride struct {
+	// Amount The amount of the asset held.
+	Amount uint64 `json:"amount"`
+
+	// AssetID The asset whose holding is overridden.
+	AssetID uint64 `json:"asset-id"`
+
+	// IsFrozen Whether the holding is frozen.
+	IsFrozen *bool `json:"is-frozen,omitempty"`
+}
+
+// SimulateBoxOverride Sets the contents of a box of an existing app during simulation, creating the box if needed.
+type SimulateBoxOverride struct {
+	// AppID The app that owns the box.
+	AppID uint64 `json:"app-id"`
+
+	// Name The box name, base64 encoded.
+	Name []byte `json:"name"`
+
+	// Value The box contents, base64 encoded.
+	Value []byte `json:"value"`
+}
+
 // SimulateRequest Request type for simulation endpoint.
 type SimulateRequest struct {
 	// AllowEmptySignatures Allow transactions without signatures to be simulated as if they had correct signatures.
@@ -638,12 +869,21 @@ type SimulateRequest struct {
 	// AllowMoreLogging Lifts limits on log opcode usage during simulation.
 	AllowMoreLogging *bool `json:"allow-more-logging,omitempty"`
 
+	// AllowUnnamedResources Allows access to unnamed resources during simulation.
+	AllowUnnamedResources *bool `json:"allow-unnamed-resources,omitempty"`
+
 	// ExecTraceConfig An object that configures simulation execution trace.
 	ExecTraceConfig *SimulateTraceConfig `json:"exec-trace-config,omitempty"`
 
 	// ExtraOpcodeBudget Applies extra opcode budget during simulation for each transaction group.
 	ExtraOpcodeBudget *uint64 `json:"extra-opcode-budget,omitempty"`
 
+	// Round If provided, specifies the round preceding the simulation. State changes through this round will be used to run this simulation. Only rounds whose state is still kept in memory are available, usually the 4 most recent ones (controlled by the node config value MaxAcctLookback); older rounds are rejected with a 400 error, even on archival nodes. If not specified, defaults to the latest available round.
+	Round *uint64 `json:"round,omitempty"`
+
+	// StateOverrides Changes to the ledger state applied for the duration of the simulation, on top of the state of the round being simulated against.
+	StateOverrides *SimulateStateOverrides `json:"state-overrides,omitempty"`
+
 	// TxnGroups The transaction groups to simulate.
 	TxnGroups []SimulateRequestTransactionGroup `json:"txn-groups"`
 }
@@ -654,10 +894,40 @@ type SimulateRequestTransactionGroup struct {
 	Txns []json.RawMessage `json:"txns"`
 }
 
+// SimulateStateOverrides Changes to the ledger state applied for the duration of the simulation, on top of the state of the round being simulated against.
+type SimulateStateOverrides struct {
+	// Accounts Account state overrides.
+	Accounts *[]SimulateAccountOverride `json:"accounts,omitempty"`
+
+	// Applications Application state overrides.
+	Applications *[]SimulateApplicationOverride `json:"applications,omitempty"`
+
+	// Boxes Box content overrides.
+	Boxes *[]SimulateBoxOverride `json:"boxes,omitempty"`
+}
+
+// SimulateTealKeyValue A key and the value to set it to in an application store during simulation. Value type `1` refers to **bytes**, `2` refers to **uint**. For bytes values, `bytes` is base64 encoded.
+type SimulateTealKeyValue struct {
+	// Key The key, base64 encoded.
+	Key []byte `json:"key"`
+
+	// Value Represents a TEAL value.
+	Value TealValue `json:"value"`
+}
+
 // SimulateTraceConfig An object that configures simulation execution trace.
 type SimulateTraceConfig struct {
 	// Enable A boolean option for opting in execution trace features simulation endpoint.
 	Enable *bool `json:"enable,omitempty"`
+
+	// ScratchChange A boolean option enabling returning scratch slot changes together with execution trace during simulation.
+	ScratchChange *bool `json:"scratch-change,omitempty"`
+
+	// StackChange A boolean option enabling returning stack changes together with execution trace during simulation.
+	StackChange *bool `json:"stack-change,omitempty"`
+
+	// StateChange A boolean option enabling returning application state changes (global, local, and box changes) with the execution trace during simulation.
+	StateChange *bool `json:"state-change,omitempty"`
 }
 
 // SimulateTransactionGroupResult Simulation result for an atomic transaction group
@@ -676,6 +946,9 @@ type SimulateTransactionGroupResult struct {
 
 	// TxnResults Simulation result for individual transactions
 	TxnResults []SimulateTransactionResult `json:"txn-results"`
+
+	// UnnamedResourcesAccessed These are resources that were accessed by this group that would normally have caused failure, but were allowed in simulation. Depending on where this object is in the response, the unnamed resources it contains may or may not qualify for group resource sharing. If this is a field in SimulateTransactionGroupResult, the resources do qualify, but if this is a field in SimulateTransactionResult, they do not qualify. In order to make this group valid for actual submission, resources that qualify for group sharing can be made available by any transaction of the group; otherwise, resources must be placed in the same transaction which accessed them.
+	UnnamedResourcesAccessed *SimulateUnnamedResourcesAccessed `json:"unnamed-resources-accessed,omitempty"`
 }
 
 // SimulateTransactionResult Simulation result for an individual transaction
@@ -691,6 +964,30 @@ type SimulateTransactionResult struct {
 
 	// TxnResult Details about a pending transaction. If the transaction was recently confirmed, includes confirmation details like the round and reward details.
 	TxnResult PendingTransactionResponse `json:"txn-result"`
+
+	// UnnamedResourcesAccessed These are resources that were accessed by this group that would normally have caused failure, but were allowed in simulation. Depending on where this object is in the response, the unnamed resources it contains may or may not qualify for group resource sharing. If this is a field in SimulateTransactionGroupResult, the resources do qualify, but if this is a field in SimulateTransactionResult, they do not qualify. In order to make this group valid for actual submission, resources that qualify for group sharing can be made available by any transaction of the group; otherwise, resources must be placed in the same transaction which accessed them.
+	UnnamedResourcesAccessed *SimulateUnnamedResourcesAccessed `json:"unnamed-resources-accessed,omitempty"`
+}
+
+// SimulateUnnamedResourcesAccessed These are resources that were accessed by this group that would normally have caused failure, but were allowed in simulation. Depending on where this object is in the response, the unnamed resources it contains may or may not qualify for group resource sharing. If this is a field in SimulateTransactionGroupResult, the resources do qualify, but if this is a field in SimulateTransactionResult, they do not qualify. In order to make this group valid for actual submission, resources that qualify for group sharing can be made available by any transaction of the group; otherwise, resources must be placed in the same transaction which accessed them.
+type SimulateUnnamedResourcesAccessed struct {
+	// Accounts The unnamed accounts that were referenced. The order of this array is arbitrary.
+	Accounts *[]string `json:"accounts,omitempty"`
+
+	// AppLocals The unnamed application local states that were referenced. The order of this array is arbitrary.
+	AppLocals *[]ApplicationLocalReference `json:"app-locals,omitempty"`
+
+	// Apps The unnamed applications that were referenced. The order of this array is arbitrary.
+	Apps *[]uint64 `json:"apps,omitempty"`
+
+	// AssetHoldings The unnamed asset holdings that were referenced. The order of this array is arbitrary.
+	AssetHoldings *[]AssetHoldingReference `json:"asset-holdings,omitempty"`
+
+	// Assets The unnamed assets that were referenced. The order of this array is arbitrary.
+	Assets *[]uint64 `json:"assets,omitempty"`
+
+	// Boxes The unnamed boxes that were referenced. The order of this array is arbitrary.
+	Boxes *[]BoxReference `json:"boxes,omitempty"`
 }
 
 // SimulationEvalOverrides The set of parameters and limits override during simulation. If this set of parameters is present, then evaluation parameters may differ from standard evaluation in certain ways.
@@ -698,6 +995,9 @@ type SimulationEvalOverrides struct {
 	// AllowEmptySignatures If true, transactions without signatures are allowed and simulated as if they were properly signed.
 	AllowEmptySignatures *bool `json:"allow-empty-signatures,omitempty"`
 
+	// AllowUnnamedResources If true, allows access to unnamed resources during simulation.
+	AllowUnnamedResources *bool `json:"allow-unnamed-resources,omitempty"`
+
 	// ExtraOpcodeBudget The extra opcode budget added to each transaction group during simulation
 	ExtraOpcodeBudget *uint64 `json:"extra-opcode-budget,omitempty"`
 
@@ -713,8 +1013,20 @@ type SimulationOpcodeTraceUnit struct {
 	// Pc The program counter of the current opcode being evaluated.
 	Pc uint64 `json:"pc"`
 
+	// ScratchChanges The writes into scratch slots.
+	ScratchChanges *[]ScratchChange `json:"scratch-changes,omitempty"`
+
 	// SpawnedInners The indexes of the traces for inner transactions spawned by this opcode, if any.
 	SpawnedInners *[]uint64 `json:"spawned-inners,omitempty"`
+
+	// StackAdditions The values added by this opcode to the stack.
+	StackAdditions *[]AvmValue `json:"stack-additions,omitempty"`
+
+	// StackPopCount The number of deleted stack values by this opcode.
+	StackPopCount *uint64 `json:"stack-pop-count,omitempty"`
+
+	// StateChanges The operations against the current application's states.
+	StateChanges *[]ApplicationStateOperation `json:"state-changes,omitempty"`
 }
 
 // SimulationTransactionExecTrace The execution trace of calling an app or a logic sig, containing the inner app call trace in a recursive way.
@@ -950,6 +1262,9 @@ type CompileResponse struct {
 	Sourcemap *map[string]interface{} `json:"sourcemap,omitempty"`
 }
 
+// ConsensusStateResponse A snapshot of the agreement state machine of the node.
+type ConsensusStateResponse = ConsensusState
+
 // DisassembleResponse defines model for DisassembleResponse.
 type DisassembleResponse struct {
 	// Result disassembled Teal code
@@ -1297,11 +1612,35 @@ type GetTransactionGroupLedgerStateDeltasForRoundParams struct {
 // GetTransactionGroupLedgerStateDeltasForRoundParamsFormat defines parameters for GetTransactionGroupLedgerStateDeltasForRound.
 type GetTransactionGroupLedgerStateDeltasForRoundParamsFormat string
 
+// StartCatchupFromFilesParams defines parameters for StartCatchupFromFiles.
+type StartCatchupFromFilesParams struct {
+	// CatchpointFile Path of the catchpoint file on the node's file system.
+	CatchpointFile string `form:"catchpoint-file" json:"catchpoint-file"`
+
+	// BlocksFile Path of the blocks file on the node's file system. It contains a sequence of msgpack encoded blocks with their certificates, ending at the catchpoint round and covering its lookback.
+	BlocksFile string `form:"blocks-file" json:"blocks-file"`
+}
+
 // ShutdownNodeParams defines parameters for ShutdownNode.
 type ShutdownNodeParams struct {
 	Timeout *uint64 `form:"timeout,omitempty" json:"timeout,omitempty"`
 }
 
+// StreamBlocksParams defines parameters for StreamBlocks.
+type StreamBlocksParams struct {
+	// Round The first round to stream. If not provided, the stream starts with the round after the latest round.
+	Round *uint64 `form:"round,omitempty" json:"round,omitempty"`
+
+	// Format Configures whether the response object is JSON or MessagePack encoded. If not provided, defaults to JSON.
+	Format *StreamBlocksParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+
+	// Deltas Include the state delta of each round.
+	Deltas *bool `form:"deltas,omitempty" json:"deltas,omitempty"`
+}
+
+// StreamBlocksParamsFormat defines parameters for StreamBlocks.
+type StreamBlocksParamsFormat string
+
 // TealCompileTextBody defines parameters for TealCompile.
 type TealCompileTextBody = openapi_types.File
 
@@ -27,6 +27,9 @@ type ServerInterface interface {
 	// Starts a catchpoint catchup.
 	// (POST /v2/catchup/{catchpoint})
 	StartCatchup(ctx echo.Context, catchpoint string) error
+	// Starts a catchpoint catchup from local files.
+	// (POST /v2/catchup/{catchpoint}/file)
+	StartCatchupFromFiles(ctx echo.Context, catchpoint string, params StartCatchupFromFilesParams) error
 
 	// (POST /v2/shutdown)
 	ShutdownNode(ctx echo.Context, params ShutdownNodeParams) error
@@ -73,6 +76,40 @@ func (w *ServerInterfaceWrapper) StartCatchup(ctx echo.Context) error {
 	return err
 }
 
+// StartCatchupFromFiles converts echo context to params.
+func (w *ServerInterfaceWrapper) StartCatchupFromFiles(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "catchpoint" -------------
+	var catchpoint string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "catchpoint", runtime.ParamLocationPath, ctx.Param("catchpoint"), &catchpoint)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter catchpoint: %s", err))
+	}
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params StartCatchupFromFilesParams
+	// ------------- Required query parameter "catchpoint-file" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "catchpoint-file", ctx.QueryParams(), &params.CatchpointFile)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter catchpoint-file: %s", err))
+	}
+
+	// ------------- Required query parameter "blocks-file" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "blocks-file", ctx.QueryParams(), &params.BlocksFile)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter blocks-file: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.StartCatchupFromFiles(ctx, catchpoint, params)
+	return err
+}
+
 // ShutdownNode converts echo context to params.
 func (w *ServerInterfaceWrapper) ShutdownNode(ctx echo.Context) error {
 	var err error
@@ -123,6 +160,7 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 
 	router.DELETE(baseURL+"/v2/catchup/:catchpoint", wrapper.AbortCatchup, m...)
 	router.POST(baseURL+"/v2/catchup/:catchpoint", wrapper.StartCatchup, m...)
+	router.POST(baseURL+"/v2/catchup/:catchpoint/file", wrapper.StartCatchupFromFiles, m...)
 	router.POST(baseURL+"/v2/shutdown", wrapper.ShutdownNode, m...)
 
 }
@@ -130,186 +168,232 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+x9/XPcNrLgv4KafVWOfUNJ/kh2raqtd4qdZHVxHJelZO8925fFkD0zWHEAhgBHM/Hp",
-	"f3+FboAESXCGIyn2pmp/sjXER6PRaPQXuj9OUrUqlARp9OT046TgJV+BgRL/4mmqKmkSkdm/MtBpKQoj",
-	"lJyc+m9Mm1LIxWQ6EfbXgpvlZDqRfAVNG9t/Oinh10qUkE1OTVnBdKLTJay4HdhsC9u6HmmTLFTihjij",
-	"Ic5fTm52fOBZVoLWfSh/lPmWCZnmVQbMlFxqntpPml0Ls2RmKTRznZmQTElgas7MstWYzQXkmT7yi/y1",
-	"gnIbrNJNPrykmwbEpFQ59OF8oVYzIcFDBTVQ9YYwo1gGc2y05IbZGSysvqFRTAMv0yWbq3IPqARECC/I",
-	"ajU5fTfRIDMocbdSEGv877wE+A0Sw8sFmMmHaWxxcwNlYsQqsrRzh/0SdJUbzbAtrnEh1iCZ7XXEfqi0",
-	"YTNgXLK3375gT58+fW4XsuLGQOaIbHBVzezhmqj75HSScQP+c5/WeL5QJZdZUrd/++0LnP/CLXBsK641",
-	"xA/Lmf3Czl8OLcB3jJCQkAYWuA8t6rc9Ioei+XkGc1XCyD2hxve6KeH8n3VXUm7SZaGENJF9YfiV0eco",
-	"Dwu67+JhNQCt9oXFVGkHfXeSPP/w8fH08cnNn96dJf/t/vzy6c3I5b+ox92DgWjDtCpLkOk2WZTA8bQs",
-	"uezj462jB71UVZ6xJV/j5vMVsnrXl9m+xDrXPK8snYi0VGf5QmnGHRllMOdVbpifmFUyt2zKjuaonQnN",
-	"ilKtRQbZ1HLf66VIlyzlmobAduxa5LmlwUpDNkRr8dXtOEw3IUosXLfCBy7oXxcZzbr2YAI2yA2SNFca",
-	"EqP2XE/+xuEyY+GF0txV+rDLil0ugeHk9gNdtog7aWk6z7fM4L5mjGvGmb+apkzM2VZV7Bo3JxdX2N+t",
-	"xmJtxSzScHNa96g9vEPo6yEjgryZUjlwicjz566PMjkXi6oEza6XYJbuzitBF0pqYGr2T0iN3fb/c/Hj",
-	"a6ZK9gNozRfwhqdXDGSqMsiO2PmcSWUC0nC0hDi0PYfW4eCKXfL/1MrSxEovCp5exW/0XKxEZFU/8I1Y",
-	"VSsmq9UMSrul/goxipVgqlIOAUQj7iHFFd/0J70sK5ni/jfTtmQ5S21CFznfIsJWfPPXk6kDRzOe56wA",
-	"mQm5YGYjB+U4O/d+8JJSVTIbIeYYu6fBxaoLSMVcQMbqUXZA4qbZB4+Qh8HTCF8BOH6QQXDqWfaAI2ET",
-	"oRl7uu0XVvAFBCRzxH5yzA2/GnUFsiZ0Ntvip6KEtVCVrjsNwIhT75bApTKQFCXMRYTGLhw6LIOhNo4D",
-	"r5wMlCppuJCQWeaMQCsDxKwGYQom3K3v9G/xGdfw1bOhO775OnL356q76zt3fNRuY6OEjmTk6rRf3YGN",
-	"S1at/iP0w3BuLRYJ/dzbSLG4tLfNXOR4E/3T7p9HQ6WRCbQQ4e8mLRaSm6qE0/fykf2LJezCcJnxMrO/",
-	"rOinH6rciAuxsD/l9NMrtRDphVgMILOGNapwYbcV/WPHi7Njs4nqFa+UuqqKcEFpS3Gdbdn5y6FNpjEP",
-	"JcyzWtsNFY/LjVdGDu1hNvVGDgA5iLuC24ZXsC3BQsvTOf6zmSM98Xn5m/2nKHLb2xTzGGotHbsrGc0H",
-	"zqxwVhS5SLlF4lv32X61TABIkeBNi2O8UE8/BiAWpSqgNIIG5UWR5CrleaINNzjSf5Qwn5xO/nTc2F+O",
-	"qbs+DiZ/ZXtdYCcrspIYlPCiOGCMN1b00TuYhWXQ+AnZBLE9FJqEpE20pCQsC85hzaU5alSWFj+oD/A7",
-	"N1ODb5J2CN8dFWwQ4YwazkCTBEwNH2gWoJ4hWhmiFQXSRa5m9Q9fnBVFg0H8flYUhA+UHkGgYAYboY1+",
-	"iMvnzUkK5zl/ecS+C8dGUVzJfGsvBxI17N0wd7eWu8Vq25JbQzPiA81wO1V5ZLfGo8GK+fdBcahWLFVu",
-	"pZ69tGIb/821DcnM/j6q8x+DxELcDhMXKloOc6Tj4C+BcvNFh3L6hOPMPUfsrNv3dmRjR4kTzK1oZed+",
-	"0rg78Fij8LrkBQHovtBdKiQqadSIYL0jNx3J6KIwB2c4oDWE6tZnbe95iEKCpNCB4etcpVd/43p5D2d+",
-	"5sfqHz+chi2BZ1CyJdfLo0lMygiPVzPamCNmG6KCz2bBVEf1Eu9reXuWlnHDg6U5eONiCaEe+yHTgzKi",
-	"u/yI/+E5s5/t2basn4Y9YpfIwDQdZ+dkyKy2TwoCzWQboBVCsRUp+Mxq3QdB+aKZPL5Po/boG7IpuB1y",
-	"i8AdUpt7PwZfq00Mhq/VpncE1Ab0fdCHHQfFSAMrPQK+lw4yhfvv0MfLkm/7SMaxxyDZLtCKrhpPgwxv",
-	"fDtLY5w9m6nydtynw1Yka0zOjNtRA+Y77SAJm1ZF4kgxYraiBp2BGi/fbqbRHT6GsRYWLgz/HbCg7aj3",
-	"gYX2QPeNBbUqRA73QPrLKNOfcQ1Pn7CLv519+fjJL0++/MqSZFGqRclXbLY1oNkXTjdj2mxzeNhfGWpH",
-	"VW7io3/1zBsq2+PGxtGqKlNY8aI/FBlASQSiZsy262OtjWZcdQ3gmMN5CZaTE9oZ2fYtaC+FthLWanYv",
-	"mzGEsKyZJWMOkgz2EtOhy2um2YZLLLdldR+qLJSlKiP2NTxiRqUqT9ZQaqEi3pQ3rgVzLbx4W3R/J2jZ",
-	"NdfMzo2m30qiQBGhLLOR4/k+DX25kQ1udnJ+Wm9kdW7eMfvSRr63JGpWQJmYjWQZzKpFSxOal2rFOMuw",
-	"I97R34FBUeBSrODC8FXx43x+P6qiwoEiKptYgbYzMWph5XoNqZIUCbFHO3OjjkFPFzHeRGeGAXAYudjK",
-	"FO2M93FshxXXlZDo9NBbmQZarIUxh2zRIsu7a6tD6KCpHugIOBYdr/AzGjpeQm74t6q8bCyB35WqKu5d",
-	"yOvOOXY53C3GmVIy29fr0EIu8nb0zcLCfhRb42dZ0At/fN0aEHqkyFdisTSBWvGmVGp+/zDGZokBih9I",
-	"Kcttn75q9lpllpmYSt+DCNYM1nA4S7chX+MzVRnGmVQZ4OZXOi6cDcRroKMY/dsmlPfMkvSsGVjqSnll",
-	"V1sVDL23vfui6ZjwlE5ogqjRA76r2ulIrWg6igXIS+DZls0AJFMz5yByritcJEfXs/HijRMNI/yiBVdR",
-	"qhS0hixxhqm9oPl2dHWYHXhCwBHgehamFZvz8s7AXq33wnkF2wQDJTT74vuf9cPPAK9Rhud7EIttYuit",
-	"1XznBexDPW76XQTXnTwkO14C8/cKMwql2RwMDKHwIJwM7l8Xot4u3h0tayjRH/e7Uryf5G4EVIP6O9P7",
-	"XaGtioHwP6feWgnPbpjkUnnBKjZYzrVJ9rFl26ilg9sVBJwwxolx4AHB6xXXhnzIQmZo+qLrBOchIcxO",
-	"MQzwoBpiR/7ZayD9sVN7D0pd6Vod0VVRqNJAFluDhM2OuV7Dpp5LzYOxa53HKFZp2DfyEJaC8R2yaCWE",
-	"IG5qV4sLsugvDh0S9p7fRlHZAqJBxC5ALnyrALthCNQAIEI3iCbCEbpDOXXc1XSijSoKyy1MUsm63xCa",
-	"Lqj1mfmpadsnLm6aeztToDHyyrV3kF8TZin4bck1c3CwFb+ysgeaQcjZ3YfZHsZEC5lCsovyUcWzrcIj",
-	"sPeQVsWi5BkkGeR82x/0J/rM6POuAXDHG3VXGUgoiim+6Q0l+6CRHUMrHE/HhEeGX1hqj6BVBRoCcb33",
-	"jJwBjh1jTo6OHtRD4VzRLfLj4bJpqyMj4m24VsbuuKMHBNlx9DEAD+ChHvr2qMDOSaN7dqf4L9BuglqO",
-	"OHySLeihJTTjH7SAARuqCxAPzkuHvXc4cJRtDrKxPXxk6MgOGHTf8NKIVBSo63wP23tX/boTRN2MLAPD",
-	"RQ4ZCz6QGliE/RnF33THvJ0qOMr21ge/Z3yLLCcXGkWeNvBXsEWd+w0FdgamjvvQZSOj2vuJS4aA+nAx",
-	"K4KHTWDDU5NvraBmlrBl11AC09VsJYyhgO22qmtUkYQDRP0aO2Z0TjwKivQ7MMareIFDBcvrb8V0QjrB",
-	"bvguO4pBCx1OFyiUykdYyHrIiEIwKt6DFcruunCx4z562FNSC0jHtNGDW1//D3QLzbgC9l+qYimXqHJV",
-	"BmqZRpUoKKAAaWewIlg9p4vsaDAEOayANEn88uhRd+GPHrk9F5rN4do/uLANu+h49AjtOG+UNq3DdQ/2",
-	"UHvcziPXBzp87MXntJAuT9kfWeBGHrOTbzqD114ie6a0doRrl39nBtA5mZsxaw9pZFxUBY47ypcTDB1b",
-	"N+77hVhVOTf34bWCNc8TtYayFBns5eRuYqHkN2ue/1h3w8ckkFoaTSFJ8QnEyLHg0vahVxP7dMMmmkys",
-	"VpAJbiDfsqKEFCjK34p8uobxiFH8X7rkcoGSfqmqhQtAo3GQU1eabCplJXtDRKUhs5EJWqdjnNsFHfuH",
-	"HlYOAm51sa5pmzSPa17P5972jLlSA+R1Tf1R79Z0MqiqWqSuG1WVkNN+rTKCi7cEtQA/zcQjfSCIOiu0",
-	"9PEVbos9BXZzfx9bezN0DMr+xEFIXPNxKCrO6sn59h6kFRqIlVCUoPFuCe1Lmr6qefgyzV0+eqsNrPom",
-	"eOr6y8Dxezuo6CmZCwnJSknYRh9jCwk/4MfoccL7baAzShpDfbvKQwv+DljtecZQ413xi7vdPaFdV5P+",
-	"VpX35cukAUfL5SNch3v95G7K2zo4eZ5HfILu3UqXAehp/U5elIxrrVKBwtZ5pqd00Jwb0T1yaaP/TR2N",
-	"ew9nrztux/kVPolE4y7kBeMszQWafpXUpqxS815yNC4FS41ELXktetjc+MI3ids3I+ZHN9R7yTFirTY5",
-	"RSMt5hCxr3wL4K2OulosQJuOkjIHeC9dKyFZJYXBuVb2uCR0XgooMXToiFqu+JbNLU0YxX6DUrFZZdpi",
-	"Oz7L0kbkufPE2WmYmr+X3LAcuDbsByEvNzic99b7IyvBXKvyqsZC/HZfgAQtdBKPrvqOvmLgq1v+0gXB",
-	"4jN6+ky+Gzt+83Zri7an5mn4//viP0/fnSX/zZPfTpLn/+v4w8dnNw8f9X58cvPXv/7/9k9Pb/768D//",
-	"I7ZTHvbYoyEH+flLp9Kev0S9pXHe9GD/ZIb7lZBJlMjCMIwObbEv8IGsI6CHbauWWcJ7aTbSEtKa5yKz",
-	"vOU25NC9YXpnkU5Hh2paG9GxYvm1HqgN3IHLsAiT6bDGW0tR/YDE+PM89Ca6F3d4XuaVpK300je9PvGB",
-	"YWo+rZ9gUnaWU4bv85bcRzW6P598+dVk2ryrq79PphP39UOEkkW2ib2ezGATU/LcAcGD8UCzgm81mDj3",
-	"QNijMXAUlBEOu4LVDEq9FMWn5xTaiFmcw/mYfmcs2shzScH29vygb3LrXB5q/unhNiVABoVZxrI2tAQ1",
-	"bNXsJkAnXqQo1RrklIkjOOoaazKrL7povBz4HLMHoPapxmhD9TkgQvNUEWA9XMgoi0iMflDkcdz6Zjpx",
-	"l7++d3XIDRyDqztn7Yj0fxvFHnz3zSU7dgxTP6CHvDR08PQyokq710WtSCLLzShXDQl57+V7+RLmQgr7",
-	"/fS9zLjhxzOuRaqPKw3l1zznMoWjhWKn/sHSS274e9mTtAbTSQVPxVhRzXKRsqtQIWnIk1KE9Ed4//4d",
-	"zxfq/fsPvaCKvvrgporyF5ogsYKwqkziEhwkJVzzMua00vUDdxyZMpjsmpWEbFWRZdMnUHDjx3keLwrd",
-	"fejaX35R5Hb5ARlq94zTbhnTRpVeFrECCkGD+/tauYuh5NferlJp0OwfK168E9J8YMn76uTkKbDWy89/",
-	"uCvf0uS2gNHWlcGHuF2jCi6c1ErYmJInBV/EfGPv378zwAvcfZSXV2jjyHOG3VovTn1EPQ7VLMDjY3gD",
-	"CI6DX8/h4i6ol09mFV8CfsItxDZW3Gg89rfdr+AN6q23q/OOtbdLlVkm9mxHV6UtifudqXPcLKyQ5cMo",
-	"tFigturSAc2ApUtIr1yeFlgVZjttdfeROk7Q9KxDaMrgQy/IMIcEehZmwKoi404U53LbfcyvwRgfD/wW",
-	"rmB7qZoUFIe83m8/JtdDBxUpNZAuLbGGx9aN0d18Fw6Gin1R+DfZ+DjPk8VpTRe+z/BBJpH3Hg5xjCha",
-	"j52HEMHLCCKI+AdQcIuF2vHuRPqx5VktY0Y3XySbj+f9zDVplCcXuRWuBq3u9H0FmA5MXWs241ZuVy6T",
-	"FT2YDrhYpfkCBiTk0Lkz8llyyyGEg+y796I3nZp3L7TefRMFmRonds1RSgH7xZIKKjOdeD0/E/kPnWcC",
-	"E1Q6hM1yFJPqwEZiOrxsOdko494QaHEChlI2AocHo42RULJZcu2TbGEuMn+WR8kAv2MCgF1pX86DULMg",
-	"4Vid1MXz3O457WmXLvmLz/ji07yEquWIlC1Wwsfo9th2KIkCUAY5LGjh1NgTSpOMoNkgC8eP83kuJLAk",
-	"FrUWmEGDa8bNAVY+fsQYWeDZ6BFiZByAjX5xHJi9VuHZlItDgJQumQL3Y6NHPfgb4u++KI7bijyqsCxc",
-	"DHi1Us8BuAt1rO+vTsAtDsOEnDLL5tY8t2zOaXzNIL3sIyi2dnKNuMiMh0Pi7A4HCF0sB62JrqLbrCaU",
-	"mTzQcYFuB8QztUno4WdU4p1tZpbeo6Ht+Aw1djApz8sDzWZqg9E+eLVQKPUeWIbh8GAEGv5GaKRX7Dd0",
-	"mxMwu6bdLU3FqFAjyThzXk0uQ+LEmKkHJJghcvkiSN1yKwA6xo4mD7JTfvcqqW3xpH+ZN7fatElJ5l8N",
-	"xY7/0BGK7tIA/vpWmDrZypuuxBK1U7SDVtp5ZgIRMkb0lk30nTR9V5CGHFApSFpCVHIV85xa3Qbwxrnw",
-	"3QLjBWaz4XL7MIiEKmEhtIHGiO7jJD6HeZJjEj2l5sOrM0U5t+t7q1R9TZEbETu2lvnJV4ChxHNRapOg",
-	"ByK6BNvoW41K9be2aVxWasdaUcpZkcV5A057BdskE3kVp1c37/cv7bSva5aoqxnyWyEpYGWGKZKjEZg7",
-	"pqYg3Z0LfkULfsXvbb3jToNtaicuLbm05/iDnIsO593FDiIEGCOO/q4NonQHgwxezva5YyA3BT7+o13W",
-	"195hyvzYe6N2/PvdoTuKRoquJTAY7FyFQDeRFUuECTIM95+0DpwBXhQi23RsoTTqoMbMDzJ4+LxsHSzg",
-	"7rrB9mAgsHvGXtWUoNsp+BoBn3JFtzLgHI3CzGU7UV7IEMKphPaVDvqIql/d7cPVJfD8e9j+bNviciY3",
-	"08ndTKcxXLsR9+D6Tb29UTyja55MaS1PyIEo50VRqjXPE2dgHiLNUq0daWJzb4/+xKwubsa8/Obs1RsH",
-	"/s10kubAy6QWFQZXhe2KP8yqKNvfwAHxmdStzudldhIlg82vU5SFRunrJbiU1IE02sud2TgcgqPojNTz",
-	"eITQXpOz843QEnf4SKCoXSSN+Y48JG2vCF9zkXu7mYd2IJoHFzcuAWuUK4QD3Nm7EjjJkntlN73THT8d",
-	"DXXt4UnhXDuSZq8oL7xmSnZd6BjzvC2c133FMfMlWUX6zElWK7QkJDoXadzGKmfaEock35ltzLDxgDBq",
-	"R6zEgCtWViIYyzYbk9umA2QwRxSZOppep8HdTLmaP5UUv1bARAbS2E8lnsrOQcU0Kc7a3r9OrezQn8sN",
-	"TBb6Zvi7yBhh1tfujYdA7BYwQk9dD9yXtcrsF1pbpOwPgUviAId/OGPvStzhrHf04aiZgheXbY9bWKKn",
-	"z/8sYVCu9v31gbzy6tLPDswRrfcjdDIv1W8Q1/NQPY48WPJ5bgVGufwG4UOHsMpFi8XU1p2mbFEz++B2",
-	"D0k3oRWqHaQwQPW484FbDhNuegs1l7TV9JCkFesWJ5gwqvSYxm8IxsHci8TN+fWMx7KRWiHDwnTWOIBb",
-	"tnSjmO/sca/r1xY0Owt8yXVbQY/RCyibt4T9xDa3FBho2tGiQiMZINWGMsGU/H+5VpFhKnnNJVVxsf3o",
-	"KLneGsj4ZXtdqxJTSei42T+DVKx4HpccsrRv4s3EQlCBkkpDUAHDDUTFn4iKXBWR+g2RQ835nJ1MgzI8",
-	"bjcysRZazHLAFo+pxYxr5OS1IaruYpcH0iw1Nn8yovmyklkJmVlqQqxWrBbqUL2pnVczMNcAkp1gu8fP",
-	"2RfottNiDQ8tFt39PDl9/ByNrvTHSewCcAVmdnGTDNnJ3x07idMx+i1pDMu43ahH0Vf3VGFumHHtOE3U",
-	"dcxZwpaO1+0/Sysu+QLikSKrPTBRX9xNNKR18CIzKo+kTam2TJj4/GC45U8D0eeW/REYLFWrlTAr59zR",
-	"amXpqSlvQZP64ajWkstM7OHyH9FHWngXUUeJ/LRGU7rfYqtGT/ZrvoI2WqeMU/6QXDTRCz5fOjv36Ykw",
-	"VXOdoZlwY+eyS0cxB4MZ5qwohTSoWFRmnvyFpUte8tSyv6MhcJPZV88i6anbaVLlYYB/cryXoKFcx1Ff",
-	"DpC9lyFcX/aFVDJZWY6SPWxeewSnctCZG3fbDfkOdw89ViizoySD5Fa1yI0HnPpOhCd3DHhHUqzXcxA9",
-	"HryyT06ZVRknD17ZHfrp7SsnZaxUGcs52Bx3J3GUYEoBa4zdi2+SHfOOe1Hmo3bhLtB/Xs+DFzkDscyf",
-	"5Zgi8LWKaKc+ZXptSXex6hHrwNAxtR8sGczcUFPWTk/96fno/URBxT1d3rDdd2zZLx4P+EcXEZ+ZXHAD",
-	"G18+rWSAUIL0/FGSyervgY+ds6/VZizhdE6hJ55/ARRFUVKJPPu5efnZqX5Qcpkuoz6zme34S1OnrV4c",
-	"3YHR9IFLLiXk0eFI3vzFy6URyfmfauw8KyFHtu0WZKDldhbXAN4G0wPlJ7ToFSa3E4RYbT+qq4O284XK",
-	"GM7T5Kprjmu/kEeQbv3XCrSJPVDCDxQ4hrZRyw4o2zcDmaFGesS+o1LMS2CtRESoCfpMEe1X01WRK55N",
-	"MYPF5TdnrxjNSn2o2hBlG1+gItReRccmFqThHBeC7AsHxZ9HjB9nd7y2XbU2SZ0cPPYA1bZo0peLjp8A",
-	"VaQQO0fsZVBUld6q2iEYJjApV1arq0cj+Qhpwv7HGJ4uUe1rsdZhkh+fJt9TpQ5KU9YlpurclHjuLNwu",
-	"Uz4lyp8yZXXza6GpAi+sof3mtX4A7swO/g1se3llJSVRytEBt1ydifJQtHvg6Ir0roQoZB3EHyj0U5WJ",
-	"Q6sGXGCvaKqsbgmCXk1KekFZlw7yldVTLpUUKSaqil3RrlTvGD/biJxeXUOuP+LuhEYOV7TwQR2K57A4",
-	"WArBM0KHuL6hP/hqN5Wog/40WBN2yQ1bgNGOs0E29fU7nK1RSA0u1ygWdg74pCpbvkvkkFF3eFK7TQ4k",
-	"I3x6M6A8fmu/vXamBYxJvxISlQiHNif4kTUQK4kaq3kIwxYKtFtP+/2xfmf7HOFT3Aw2H4585VEcg1x/",
-	"dtnk5+4Pdea93s7LbNu+sG1dgqT651aUM016VhRu0uHqLlF5wGzkIIIj3svEu48C5Nbjh6PtILed4Sp4",
-	"n1pCgzU6u6HAe7hHGHWlk04VLSu0EkVhC0ZhYtEsCUJGwHglJDR1cSMXRBq9EnBj8LwO9NNpyQ2JgKN4",
-	"2iXwHD3cMYamjXNv3HWobnooixJco59jeBubIi0DjKNu0AhuXG7rcryWugNh4gXWAXeI7JdcQanKCVEZ",
-	"vlroFGGJMQ7LuH2Zp/YF0D8GfZmIumOutENvoqGHqLMqW4BJeJbFUr9+jV8ZfmVZhZIDbCCt6hShRcFS",
-	"zLvSTkTTpzY3UaqkrlY75vIN7jhdUNUoQg1hZSW/w/jQZbbFf2P5MYd3xgV6HBxq6KM6ssOyL/VDJ2NS",
-	"r6XpRItFMh4TeKfcHR3N1Lcj9Kb/vVJ6rhZtQD5x+oldXC7coxh/+8ZeHGF2hl7SV7pa6uQJGNinfC1K",
-	"VBvrZ79troRXWS8LLDqU6lp3uw0Qw1Xrpnj5DYT3Bkk3ON2v5KEcCvJNB2PSuXGv4wxnO1nQ4IsjihCi",
-	"t0UIRdw6OxQVREFB9nOv9zjJsCdnm3jiwwChPtysD9D3PpaVFVw493vDLPqYdVHv/XcIY+Jhmw3uLsLF",
-	"kg9a7L5fD8V9+2Rs+L1b1eoK3JP5ooS1UJV3bPvIJ68S0q+tGlF15H10/X3DK071ec2hg8bbS1ddgJbp",
-	"dPLvf6Y4OQbSlNt/AVNub9N79bL60i6Zp5omrE5MPSpRdetWHJOoMJYTz8mGrYpde+qN9cjq5RhxoF8/",
-	"bDo5zw66MGN5FSc0SuzYxauBDaedalJN4RErlBZNfvhYmbCRIYaXWOkrSJvVH8vH96whNVgUoIlbKAEO",
-	"SaJlJwsKj/47/dSAOl1HYrqsU7tSTfUrAey543uvwYIXjZRF/Wh8YqWzOjoN+TRmQ16AdLU/2+88Rkeb",
-	"z+eQGrHe8/ru70uQwcuuqbfLUA3v4DGeqKOXMXnL4VbHBqBdj+N2whMkUbwzOENvb65g+0CzFjVE07pP",
-	"/VV7m7wdiAHkDoklEaVj0R9kSHYOeaFrykAs+Ggr6g5NBrTBilDBW9JbzuVJ0l4czfvSHVPGS9KMmst2",
-	"PejVNQbiDj3Q61e0GNY/XmIBEV1Xa/R5P0ItnZ33syNeu7wh+Fay9p34DCKg/W/+YTTNkosrCGtWoafq",
-	"mpeZbxE1vXirTrLjPuq9qvPVGLpAz+uZRRMb239HFcm3hRHQaa6sGJEMhZG3w1HrWI4HmoJuKP07Btpa",
-	"uOZQutp+KP/mSkNilI+l3QXHLlRQZNGtkKAHc1wScIOZZ942qXUw1y/HTDPcBRSFC2QlrLiFrgwS4AzP",
-	"uQvZL+i7fzjkc73utTDV9Lq/6ICPiha6h8SQ6ufM3Zb7HyTdxtgkpKT60TqWDUdC2faGFKXKqpQu6PBg",
-	"1Aa50bmmdrCSqJ0m7a+yoyMErzqvYHtMSpCv1uB3MASaJCcCPcii0NnkezW/6Rjci3sB73NarqaTQqk8",
-	"GXB2nPdT+HQp/kqkV5Axe1P46MGBCjrsC7Sx197s6+XWp6wpCpCQPTxi7ExSvLZ3bLdzSHcmlw/Mrvk3",
-	"OGtWUVYtZ1Q7ei/jga+Y76q8Izfzw+zmYRosq7vjVDTIngQxm4H0QSW/jtSTOhqrlfddzd0aPw1RERQx",
-	"maQpX7MnTqYOkWkqfzRhMn3pIM/VdYJUlNT5v2I6h23XZpI+42nTzWJ7BkG8DdfuAt2yJc9YqsoS0rBH",
-	"/IkDAbVSJSS5wvCbmGdwbqw8tMK4ZslytWCqsGoupdHzPpRoWZpgrvsqwUPPdQmChBw+AwkRQLvnuQ5c",
-	"atyHd0cVnMMr7FwuI3Yb3DC/WweX0XEEd3D1iwDMEYS+32Z1FqsS1F5Xt17VUPU4o1YijaP7jxWtMhhj",
-	"EqPeGCpcAlp6AIfN8ICHPKV2TuLp6aMZJJ/lMY8Nc8fPOWmQzu1/8Qbrjsvm4JjLAD+LPMDctepY5afI",
-	"rtZTucJU/k3lAIVEHd67/ctUDXA21stcZ5weyQwCAIb9zi0YRnmfDwVjjtU1Ex5B8nkt809bxY9Fh+P5",
-	"bIB0slNOOv8SmB27KsG98aMygJ26QwU3Sy8D2OZ9zdxqeaDxAR4VT+Ga7EjenuVqEHaFK1UkOayh5Y53",
-	"Dw+rNAWtxRrC+oXUmWUABVp3uzpHzM8c8vaOIOrWngSeyjHYjUqmhFjaKbZH7IwKyRuZ0DHRY4+ShWgt",
-	"soq38KfvUMltqIhb5PLxsH4YxykOZhLxxe1iEXsjQ5Dmo+dSxgNDwnevtUkJZ8tq0zMRYXOydcGv5bAK",
-	"1ifKRnYaXwMxQOw3G0jxHmpHPtwdJwwHY7rzpn1QaCrrHb6tKj9IZbuIrFcRMiq1afAVfcP0M17wdX0j",
-	"0i4ZHYWODCB0wxswjhKaOL2g2YpvWSbmcyjJraINlxkvs7C5kCyF0nBhdcytvr2CYaEtK5ju1TEsp8ZB",
-	"PbOKaRtoISRA8q1T3obk/xFyO/rQIjI7XdtGDRWr7O1K/GEH31g9ByPcBojAPUlHLYcOq5IoYrIVv4ID",
-	"59HiN9g9DSaKcVZYo3DWMVPc7KT1HxF1eOB/ksLspHYS/bohh+QTImL0NCgXjWOaNqdPg7Eo0UsqmRRG",
-	"inYrEPi9JgMVzQcDGRUd70yQp+odLl/QQa2k1Jns+uJAjxkTMFMXQXuQtNA1N6R7mFKURQ+cibasruZI",
-	"nbgpdDFh3EDNjqfdiJb2FVRvO1b/TKsShahrvt2fmK25huLBwDSyV2d8jEMNtdtqIjBNBSWiec8OEU8i",
-	"NB+rqdDPOHX/i6Eo98YP9/stx1na4wsIK7TvprdGkPekEqE1Lrexo+NtybdY4JB0MiJO8962qj4tv8cG",
-	"RVn07RKRjgKtH7MXwWZQOXh3GEWYp7h5AF1S6Ce6Xb0+1OUXPzR60rgaxr7DHvDC6JqgirF3dDhwPvNL",
-	"4h9qpARL+TBECa3l7wvYcQtsFMtgi5ysZgxQ1nh6fdbelyAaS7+og5yGCm53Y6EwKbEVDvI8EkNF4iOV",
-	"uA0Ix96T5Zrnnz4OCrNVnyE+IHs77DkNA2lCJBMq9e2e8b3io+YOgmbub2r5BuO2/g52j6LXghvKaaw9",
-	"5o/CP8/Jyj/39S7XINk1jklB34+/YjOX5qQoIRW6qwlf+1JUddwIVmZ0Tyc3Zk+gyr51/qzMHch47g1L",
-	"7HVT1gYN2QvZQNgc0c/MVAZObpTKY9TXI4sI/mI8Ksw3uue6uGpFgzdSXXCjqRLuOSo8eN91YFR4P5Pq",
-	"2OVR5LO9dCoN/XWOvq1buI1c1M3axj5p6CN3V+2TMS8R4iWNbHd8CkEIwXpgDEFl/3j8D1bCHAv+Kvbo",
-	"EU7w6NHUNf3Hk/Zne5wfPYoqeZ/sEQThyI3h5o1RzM9Dz+Lp6fdABobOflQiz/YRRiufRlMyGzNG/OKy",
-	"9nyWot2/UGBm/6i6wql3iCYnxETW2po8mCrIlDEiSYbrFkmJgUEPaVUKs8Vkwl7jFb9En2t8V4f+utDx",
-	"2oTn7j6jrqBOR90EClfa367fKZ7jfUSWRWlvIZUfsW82fFXk4A7KXx/M/gxP//IsO3n6+M+zv5x8eZLC",
-	"sy+fn5zw58/44+dPH8OTv3z57AQez796PnuSPXn2ZPbsybOvvnyePn32ePbsq+d/fmD5kAWZAJ341HWT",
-	"/4uV7ZOzN+fJpQW2wQkvxPewpSK6lox9eV6e4kmEFRf55NT/9L/9CTtK1aoZ3v86cZmxJktjCn16fHx9",
-	"fX0UdjleYGRgYlSVLo/9PL36vWdvzmsXJBn9cUcpqYR35nhSOMNvb7+5uGRnb86PGoKZnE5Ojk6OHtvx",
-	"VQGSF2JyOnmKP+HpWeK+Hztim5x+vJlOjpfAcwykt3+swJQi9Z9K4NnW/V9f88UCyiNXs9j+tH5y7MWK",
-	"448uQvJm17fjsPzX8cdWIGm2pyeWBzr+6LPe7m7dSivrAmiDDiOh2NXseIbJtMY2BR00Hl4KKhv6+COK",
-	"y4O/H7vsP/GPqLbQeTj20dbxli0sfTQbC2unR8pNuqyK44/4H6TPG2IYOcRiqylpDmdN8ykThvGZKjHd",
-	"rEmXlkf4PJdCBy0nSLVE8OeZJXTb6wVB4DNaU4mP03f9GAAciPmRkCtYkm8ObWumhi+jkyCoOlHfOq32",
-	"zd3z7iR5/uHj4+njk5s/2bvF/fnl05uRwRcv6nHZRX1xjGz4AZNEoo8Iz/KTk5OD6ov31KRmkbRJ9avX",
-	"/r3uaGHYQ+y2qjMQq5GxJ5ldZ/hYPfab6eTZgSveaUtqvQSO1FX/mmfMx8fh3I8/3dznEp+oWB7P6A67",
-	"mU6+/JSrP5eW5HnOsGWQnbi/9T/JK6mupW9pBY5qteLl1h9j3WIKzG02Xmt8odGLUIo1RzlPKtkquTr5",
-	"gIGysRjFAX6jDb8Fv7mwvf7Nbz4Vv8FNug9+0x7onvnNkwPP/B9/xf/msH80DntB7O5OHNYJfJQ+5dhs",
-	"5DFGHBx/bAmo7nNPQG3/3nQPW6xXKgMvg6r5nAqz7Pp8/JH+DSaCTQGlWIGkhNXuV3pafozpkrf9n7cy",
-	"jf7YX0e3Knrs5+OP7ap8LQTpZWUydU05QqNXFpae4bnLU4/m2lr1M4r5AZp3vOxHl3ok36KNWmTAOOZE",
-	"VJVpdHPbuY4KrL0ndgSml85MvRASJ0AzOM5CBRl48EJOQ6ok1RfvXI8Ostcqg/71iBfgrxWU2+YGdDBO",
-	"pi3+6Ag8Uv7gztdNn53dHEb+aK4nX1OfOOqi4q2/j6+5MPYSdQ9qEaP9zgZ4fuyy53V+bRLW9L5gFp7g",
-	"xzC0MfrrcV1BKPqxqwrHvjpVcKCRj47ynxuzWGhmQpKoDUzvPtidxfz0jloaq8np8TE+UlsqbY4nN9OP",
-	"HYtK+PFDvZk+qXC9qTcfbv4nAAD//87z2lBO0wAA",
+	"H4sIAAAAAAAC/+x9/XPctpLgv4Ka3SrHvqHkr2RfvPVqT7GTPF2cxGUpebcb+14wJGYGTxyAAUBpJj79",
+	"71fdAEiQBDgcaeJsrvKTrSE+Go1Go9GfH2a53FRSMGH07MWHWUUV3TDDFP5F81zWwmS8gL8KpnPFK8Ol",
+	"mL3w34g2iovVbD7j8GtFzXo2nwm6YbMXYf/5TLFfaq5YMXthVM3mM52v2YbCwGZXQetmpG22kpkb4swO",
+	"cf5qdjvygRaFYloPofxelDvCRV7WBSNGUaFpDp80ueFmTcyaa+I6Ey6IFIzIJTHrTmOy5Kws9Ilf5C81",
+	"U7tglW7y9JJuWxAzJUs2hPOl3Cy4YB4q1gDVbAgxkhRsiY3W1BCYAWD1DY0kmlGVr8lSqj2gWiBCeJmo",
+	"N7MXP800EwVTuFs549f436Vi7FeWGapWzMzez2OLWxqmMsM3kaWdO+wrpuvSaIJtcY0rfs0EgV4n5Nta",
+	"G7JghAry9quX5NmzZ5/DQjbUGFY4Ikuuqp09XJPtPnsxK6hh/vOQ1mi5koqKImvav/3qJc5/4RY4tRXV",
+	"msUPyxl8IeevUgvwHSMkxIVhK9yHDvVDj8ihaH9esKVUbOKe2MZH3ZRw/t91V3Jq8nUluTCRfSH4ldjP",
+	"UR4WdB/jYQ0AnfYVYErBoD89zj5//+HJ/Mnj23/56Sz7L/fnp89uJy7/ZTPuHgxEG+a1Ukzku2ylGMXT",
+	"sqZiiI+3jh70WtZlQdb0GjefbpDVu74E+lrWeU3LGuiE50qelSupCXVkVLAlrUtD/MSkFiXTGkdz1E64",
+	"JpWS17xgxZxwQW7WPF+TnGo7BLYjN7wsgQZrzYoUrcVXN3KYbkOUAFx3wgcu6L8vMtp17cEE2yI3yPJS",
+	"apYZued68jcOFQUJL5T2rtKHXVbkcs0ITg4f7GWLuBNA02W5Iwb3tSBUE0r81TQnfEl2siY3uDklv8L+",
+	"bjWAtQ0BpOHmdO5ROLwp9A2QEUHeQsqSUYHI8+duiDKx5KtaMU1u1sys3Z2nmK6k0IzIxT9ZbmDb/9fF",
+	"998Rqci3TGu6Ym9ofkWYyGXBihNyviRCmoA0HC0hDqFnah0Ortgl/08tgSY2elXR/Cp+o5d8wyOr+pZu",
+	"+abeEFFvFkzBlvorxEiimKmVSAFkR9xDihu6HU56qWqR4/6303ZkOaA2rquS7hBhG7r96+O5A0cTWpak",
+	"YqLgYkXMViTlOJh7P3iZkrUoJog5BvY0uFh1xXK+5KwgzSgjkLhp9sHDxWHwtMJXAA4Xe8DhYho4gm0j",
+	"NAOnG76Qiq5YQDIn5AfH3PCrkVdMNIROFjv8VCl2zWWtm04JGHHqcQlcSMOySrElj9DYhUOHJpTYNo4D",
+	"b5wMlEthKBesIFxYoKVhllklYQomHH/vDG/xBdXss+ez231fJ+7+UvZ3fXTHJ+02NsrskYxcnfDVHdi4",
+	"ZNXpP+F9GM6t+SqzPw82kq8u4bZZ8hJvon/C/nk01BqZQAcR/m7SfCWoqRV78U48gr9IRi4MFQVVBfyy",
+	"sT99W5eGX/AV/FTan17LFc8v+CqBzAbW6IMLu23sPzBenB2bbfRd8VrKq7oKF5R3Hq6LHTl/ldpkO+ah",
+	"hHnWvHbDh8fl1j9GDu1hts1GJoBM4q6i0PCK7RQDaGm+xH+2S6QnulS/wj9VVUJvUy1jqAU6dlcyqg+c",
+	"WuGsqkqeU0DiW/cZvgITYPYhQdsWp3ihvvgQgFgpWTFluB2UVlVWypyWmTbU4Ej/qthy9mL2L6et/uXU",
+	"dtenweSvodcFdgKR1YpBGa2qA8Z4A6KPHmEWwKDxE7IJy/ZQaOLCbiKQEgcWXLJrKszJbB47k+0B/snN",
+	"1OLbSjsW370nWBLhxDZcMG0lYNvwgSYB6gmilSBaUSBdlXLR/PDJWVW1GMTvZ1Vl8YHSI+MomLEt10Y/",
+	"xOXT9iSF85y/OiFfh2OjKC5BvbRgTtSAu2Hpbi13izW6JbeGdsQHmuB2grLmdt6gQWtmjkFx+KxYyxKk",
+	"nr20Ao3/5tqGZAa/T+r8xyCxELdp4oJWxGHOvnHwl+Bx80mPcoaE49Q9J+Ss3/duZAOjxAnmTrQyup92",
+	"3BE8Nii8UbSyALov9i7lAh9ptpGF9Z7cdCKji8Lcfg5pDaG681nbex6ikMCHPgxflDK/+hvV6yOc+YUf",
+	"a3j8cBqyZrRgiqypXp/MYlJGeLza0aYcMWiID3yyCKY6aZZ4rOXtWVpBDT2Z9eGNiyUW9dgPmR5TkbfL",
+	"9/gfWhL4DGebGv90B7UFxyMqAyNDAa99+0CwM0ED2HgjycY+8Am8ug+C8mU7eXyfJu3Rl1an4HbILQJ3",
+	"SG6Pfgy+kNsYDF/I7eAIyC3Tx6APubX/4YZt9AT4XjnIJO6/Qx9Viu6GSMaxpyAZFgiiq8bTIMIbH2Zp",
+	"lbNnC6nuxn16bEWQVuVMKIwaMN95D0nYtK4yR4oRtZVt0BuotfKNM43+8DGMdbBwYehvgAVtaAD8PbDQ",
+	"HejYWJCbipfsCKS/jjJ9UBI8e0ou/nb26ZOn/3j66WdAkpWSK0U3ZLEzTJNP3NuMaLMr2cPhyuYz+3SO",
+	"j/7Zc6+o7I4bG0fLWuVsQ6vhUFYBakUg24xAuyHWumjGVTcATjmclww4uUU7sbp9PJSAfqFrjc+Eo7PC",
+	"7vBR0YBoQSu9lsajga4UYxtmadkAPvI1b43TQhZWsnrFNdWabRZHoaPUXhftLAVxSCzY3nNw6M600+yC",
+	"3Xmldqo+xiucKSVVRDWI3MHIXJbZNVOay4gh6I1rQVwLL5lX/d8ttOSGagJzo9a6FigLRQ4FqKMnX1l2",
+	"6MutaHEzemnZ9UZW5+adsi9d5HslqCYVGNm2ghRsUa86j7ilkhtCSYEdkUa/ZgalmEu+YReGbqrvl8vj",
+	"vHIlDhR5bfIN0zATsS0IF0SzXArrxLHnYelGnYKePmK8dtGkAXAYudiJHFWkxzi26Tf3hgu01+idyIMH",
+	"OMBYsmLF1AR8TH9op9Bhp3qgI+AAOl7jZ+SOr1hp6FdSXbZKzK+VrKujM+X+nFOXQ91iHF8uoK9//nOx",
+	"KruOQyuA/SS2xt9lQS/98XVrQOiRIl/z1doEL6I3Ssrl8WGMzRIDFD/Y92QJfYavyu9kAczE1PoI0mM7",
+	"WMvhgG5DvkYXsjaE4tWLm1/ruFyZcDVBGzea5k0oqpq1fSIuGFBXTmtYLaj0Zey+aDtmNLcnNEPU6PiE",
+	"rb3UtrLTWTeGUjFagBqKCSIXzrblrG64SIpW80YkcVJthF904KqUzJnWoD60SqG9oPl29uowI3hCwBHg",
+	"ZhaiJVlSdW9gr673wnnFdhn6eGjyyTc/6oe/A7xGGlruQSy2iaG30VBwkYB62vRjBNefPCQ7qhjx9wox",
+	"EgXxkhmWQuFBOEnuXx+iwS7eHy3XTKEp8TeleD/J/QioAfU3pvf7QltXCc9F9zIHCQ82TFAhvWAVG6yk",
+	"2mT72DI0CteiYQUBJ4xxYhw4IXi9ptpY8zcXBWrt7HWC82AfnCINcPIZAiP/6F8gw7Fz/9JsniO6riqp",
+	"DCtiawCfifRc37FtM5dcBmM3bx4jSa3ZvpFTWArGd8iyK7EIoqaxEjn/kOHi0JYC9/wuisoOEC0ixgC5",
+	"8K0C7IbeWwlAuG4RbQmH6x7lNC5j85k2sqqAW5isFk2/FJoubOsz80Pbdkhc1LT3diGZRqcx195BfmMx",
+	"a/321lQTBwfZ0CuQPVCDY+30Q5jhMGaai5xlY5SPTzxoFR6BvYe0rlaKFiwrWEl3w0F/sJ+J/Tw2AO54",
+	"+9yVhmXWASu+6S0le3+XkaEljhdhmt9Jgl9IDkcQngItgbjee0YuGI4dY06Ojh40Q+Fc0S3y4+Gy7VZH",
+	"RsTb8Foa2HHbyILsOPoUgBN4aIa+Oyqwc9a+PftT/CfTbgLf5g6T7JhOLaEd/6AFJNS/zrc9OC899t7j",
+	"wFG2mWRje/hI6sgmdNFvqDI85xW+db5hu6M//foTxNWgBTOUg5Ix+GCfgVXYn1jXof6Yd3sKTtK9DcEf",
+	"KN8iyym5RpGnC/wV2+Gb+431SQ1UHcd4y0ZGJdy6mgOg3tMNRPCwCdvS3JQ7QvES3pEbphjR9WLDjbG+",
+	"5t2nrpFVFg4QNcmMzOjsj9af0+/AFIPoBQ4VLG+4FfOZfROMw3fZexh00OHeApWU5QQN2QAZUQgmuaqQ",
+	"SsKuc+f27h2fPSV1gHRMu9x5cN1VEaIZV0D+U9YkpwKfXLVhjUwjFQoK0Bdn4DqY0zmltBhiJZokGuw8",
+	"etRf+KNHbs+5Jkt242NFHj0aouPRI9TjvJHadA7XEfShcNzOI9cH2qrg4nOvkD5P2e8U4UaespNveoP7",
+	"SfFMae0IF5Z/bwbQO5nbKWsPaWSaQ4jZTlx5sJ7ounHfL/imLu9qbesumF3TMpPXTClesL2c3E3Mpfjy",
+	"mpbfN90wDoblQKM5y3KM3pg4FruEPjbgY9/bsHWE45sNKzg1rNyRSrGcFVZdzjXRDYwnxLou5msqVijp",
+	"K1mvnO+cHQc5NQQEYQhGLQZDRKUhsxUZaqdjnNv5S/sYFZCDGIW3WF+1bV8eN7SZjxUdhj4ReX1Vf9S6",
+	"NZ8ln6qA1Ov2qWqR0w20mcDFO4JagJ924ok2EEQdCC1DfIXbAqcANve30bW3Q8egHE4cePO1H1MOffBO",
+	"LndHkFbsQESxSjGNd0uoX9L2q1yGQXXu8tE7bdhmqIK3Xf+ROH5vkw89KUouWLaRgu2iceRcsG/xY6y3",
+	"vd8SnVHSSPXtPx468PfA6s4zhRrvi1/c7f4J7Zua9FdSHcuWaQecLJdPMB3utZO7Ke9q4ITwsqFN0IXc",
+	"9BmAnjch/lwRqrXMOQpb54We24PmzIguPqeL/jeNI/ERzl5/3J7xK4zmROUuKytCSV5yVP1KoY2qc/NO",
+	"UFQuBUuNOFz5V3Ra3dh4ycT1mxH1oxvqnaDobNeonKKeFksW0a98xZjXOup6tWLa9B4pS8beCdeKC1IL",
+	"bnCuDRyXzJ6Xiin0ejqxLTd0R5ZAE0aSX5mSZFGbrtiOEWXagPLSWuJgGiKX7wQ1pGRUG/ItBz8PGM5b",
+	"6/2RFczcSHXVYCF+u6+YYJrrLO4Y9rX9ij67bvlr578L/3edre0Gxm/DznaGdaLa/88n//ECotlp9uvj",
+	"7PP/cfr+w/Pbh48GPz69/etf/2/3p2e3f334H/8a2ykPOy+SkJ+/ck/a81f4bmmNNwPYP5riHoIko0QW",
+	"umH0aIt8grG9joAedrVaZs3eCfCxMRJCy3lBzd3IoX/DDM6iPR09qulsRE+L5dd64GvgHlyGRJhMjzXe",
+	"WYoa+lLGIwthI32wILQiy1rYrfTStw2c8Y5hcjlvokdtYpkXBEML19Q7ZLo/n3762WzehgQ232fzmfv6",
+	"PkLJvNjGAj8Lto098twBwYPxQJOK7jQzce6BsEd94KxTRjjshoF2QK959fE5hTZ8EedwPhzBKYu24lzY",
+	"OAE4P2ib3DmTh1x+fLiNYqxglVnHEk50BDVs1e4mYz1/EQgYYmJO+Ak76StrCngvOm+8ktElEKi1r8kp",
+	"r6HmHFhC81QRYD1cyCSNSIx+UORx3Pp2PnOXvz76c8gNHIOrP2djiPR/G0kefP3lJTl1DFM/QGy5oYOo",
+	"0chT2n7oehIZQl2aHSvkvRPvxCu25ILD9xfvREENPV1QzXN9WmumvqAlFTk7WUnywsdavaKGvhMDSSuZ",
+	"CSuIciNVvSh5DoroGHna7CbDEd69+wnUse/evR84VQyfD26qKH+xE2QgCMvaZC43Q6bYDVUxo5VuYvNx",
+	"ZOw9OqsVsmVtNZtufOLGj/M8WlW6H6M7XH5VlbD8gAy1i0CFLSPaSOVlEa49NLi/30l3MSh64/UqtWaa",
+	"/Lyh1U9cmPcke1c/fvyMkU7Q6s/uygea3FVssnYlGUPcV6rgwu2zkm2NollFVzHb2Lt3PxlGK9x9lJc3",
+	"sAUg6GK3ECdNMAAO1S7A4yO9ARaOgwP/cHEXtpfPwxVfAn7CLcQ2IG60Fvu77lcQPnvn7eqF4A52qTbr",
+	"DM52dFUaSNzvTJOeZ0W50N6NAiwwcAhcJqMFqBRZfuVSzLBNZXbzTne57AiannVwbZMP2eA3TH+BlgVI",
+	"SlQV1IniVOz6eQg0M8b7A79lV2x3KdvsGYckHujGwevUQUVKDaRLINbw2Lox+pvv3MEAUlpVPpwc4wo9",
+	"Wbxo6ML3SR9kK/Ie4RDHiKITp51CBFURRGCHFArusFAY716kH1sevDIW9uaLJCLyvJ+4Ju3jyXluhau5",
+	"XDffMahmpeSNJgsKcrt0SbhsrHfAxWpNVywhIYfGnYkR1R2DEA6y796L3nRgTu5eaIP7JgqybZzBmqOU",
+	"wuALkAo+Znr+en4maz90lgnMrekQtihRTGocGy3ToapjZBOrMdDiBMyUaAUOD0YXI6Fks6ba5wcr5sFZ",
+	"niQD/Ia5C8Yy1pwHrmZBrrQmH43nuf1zOnhdurw1PlmNz1ATPi0nZJuZz5x3e2w7pEABqGAlW9mF28ae",
+	"UNo8Cu0GARzfL5clF4xkMa+1QA0aXDNuDgby8SNCrAaeTB4hRsYB2GgXx4HJdzI8m2J1CJDC5YGgfmy0",
+	"qAd/s3jcl/XjBpFHVsDCecKqlXsOQJ2rY3N/9RxucRjCxZwAm7umJRPGv/jaQQaJU1Bs7aVJcZ4ZD1Pi",
+	"7IgBxF4sB60Je9xpNaHM5IGOC3QjEC/kNrMxq1GJd7FdAL1HXduhV/Rg2hQ1DzRZyC16++DVYl2p98CS",
+	"hsOD0QKAuUdg7dgvdZtbYMamHZemYlSoySeNbNOSS0qcmDJ1QoJJkcsnQdaZOwHQU3a0KZzd43fvI7Ur",
+	"ngwv8/ZWm7fZ1HzUUOz4p45QdJcS+BtqYZo8MW/6EktUT9Fp1UuRE4iQMaInXESMNENTkGYlw0dB1hGi",
+	"siu2i79tGN44F75boLzARDxU7B4GnlCKrbg2rFWiez+J30M9STH/n5TL9OpMpZawvreyDf7GjlY52Vnm",
+	"R18BuhIvuQKfVbBARJcAjb7S+Kj+CprGZaXOZhObLZcXcd6A00L0ScHLOk6vbt5vXsG03zUsUdcL5Ldc",
+	"WIeVBWZ3jnpgjkxtnXRHF/zaLvg1Pdp6p50GaAoTKyCX7hx/kHPR47xj7CBCgDHiGO5aEqUjDDKInB1y",
+	"x0BuCmz8J2Pa18FhKvzYe712fPxu6o6yI0XX0gI6vgqOZiIQS7gJkiMPQ1oTZ4BWFS+2PV2oHTX5YqYH",
+	"KTx8SrkeFnB33WB7MIAi7Vu2ZIpFVQjNJ+sd3YhLYUpBOCvdLD6RTU8q/7uqNNeurfEQTHQHJZhLApne",
+	"49b3MlxRbymRKgPDWWsuzGfPB3vR6vgBlim7cRFXrV8YqVgX8cFzC/G1bxN44uEedArZczgV175kxpBs",
+	"mxjIfZQLCUy+YbsfoS0uZ3Y7n91PkR2jfDfiHly/aQ5bFM/oKGEVmx271IEopxWYH2mZOXV/ilEoee0Y",
+	"BTb31oGPfPHEKfvyy7PXbxz4oFEtGVVZI7glV4Xtqj/MqmzayMQBcUwKX+D+BWUF+2Dzm1x3oYngZs1c",
+	"bvPgbTBIwtqaf9rxvMlgGffX2sv7nKXKLnHEYsWqxmDVKlOxc89GRa8pL70W00Ob8K3CxU3L5BvlCuEA",
+	"97Z1BSbL7KjsZnC646ejpa49PAnn+h5TIsWlE+ESJiErcrarLgt6oB1lneKqT0G90tyeE+/kr6TqMH/n",
+	"WB+1fblBBoxx791tb2eHqYQzka+I0RctTwhSC/l59TOct0ePwsP06NGc/Fy6DwEI+PvC/Y7qoEePomBF",
+	"3xXABvDZIOiGPWzcAJOo7vM3Mwz/vpl2a55db3C10EmmaaMhG2tZ8hi6cQu+UdyhoHC/gPIVftof1dLO",
+	"Otgzi60pZH2R8m5vHBc2tm6GJlL0/XQwsAKoATkwuI8umFO9Dula1BtUV2a65HnckCMWGniesAZ6aEyw",
+	"ceLFCyPWPOHvIWoejAXNpiTQ6gEZzBFFpo7m8Gpxt5DuzNWC/1IzwgsmDHxSeNn07h8vseOoAykRHijD",
+	"udzA2CcY/j4PmTArdl+QQyDGXzGhO8AA3FeNXs4vtFF7U9Gxex7gVRTOOOCmIx5Bjj4cNVsP6XXXrD/t",
+	"cTGlfprnTS49d2KOaD00rrOlkr+yuDIJdXCRqEg3Eb4RsPdJJPa+f3M2KuS2rFs7+77tnv5gTW38vR+o",
+	"ftFN6vG7vE7jp/qwjbzLS1THc/fNZ+GRjMNlP5Kuu1mCteDxChwsMOuztzVSYc+TDQnseC3HT2XQQp/a",
+	"8dtT6WDu72pe0psFza/iDxSAKdjejlXUSOI7+w3QTdycnZ0EXkFNW27TilRMtVHhwxRld3xs2GknPzPa",
+	"VwV07Lwn5taTo9QyMkwtbqgwzGf1t/zK9dbMmjGg141UmBRIx8W7guV8Q8v4q6PIh8a6gq+4rZJVaxaU",
+	"YXID2QqElopcKasmGtSh5nxJHs/bM+l3o+DXXPNFybDFE9tiQTVel20+Wd8FlseEWWts/nRC83UtCsUK",
+	"s9YWsVqS5kGIQl7jhrBg5oYxQR5juyefk0/QAUPza/YQsOiEoNmLJ5+j+cz+8Th2y7oqZ2Msu0Ce/XfH",
+	"s+N0jB4odgxgkm7Uk2j+FFvmNH07jJwm23XKWcKW7kLZf5Y2VNAVi/v8bfbAZPvibqJJpIcXUdgafdoo",
+	"uSPcxOdnhgJ/SsQRAfuzYJBcbjbcbJyZXssN0FNbY8lO6oezBf8sT2/g8h/R26Xyxv6eAurjmr+sEBFb",
+	"NfokfUc3rIvWOaE2E1TJWz80X7SDnPtEc1gvoCkTYHEDc8HSUZaELcRc3VwYVErUZpn9Bd6qiubA/k5S",
+	"4GaLz55HaiR0c3WLwwD/6HhXTDN1HUe9SpC9l1lcX4isEtmGA6t/2MbtBacy6ZYTndakvEDGh54q+cIo",
+	"WZLc6g650YBT34vwxMiA9yTFZj0H0ePBK/volFmrOHnQGnboh7evnZSxkSqWPbY97k7iUMwozq5Zkdwk",
+	"GPOee6HKSbtwH+h/XxuyFzkDscyf5ehDwCudxqKvQIT/8VtX03cgeyc8xvDnts9ePVlcNYj9u5quJz8T",
+	"BY8/FCAfPcJ5QOFlm/78tPvZ8pVHj+Jp0KK6Hvi1Bfw+TzHsG0M7VIR58SFRLqUxRbtgryHKk9wRPsDp",
+	"W7ih5qRbmuLjX1/HcSOOu4rECRc8Q+CLxwP+0UfE73xKcQNbZzi7kgShBKV5oiRTNN8DJzVKvpDbqYTT",
+	"Y36eeP4boCiBkol6IVzJoPRQ1Hi713sgoFEYdcFKCa8bI6OkeV88j6MG4J2PIKjmZfFjm1uix64VFfk6",
+	"6pWzgI7/aIvYNlBZ7hZbKJiMBCujw9l30D/8eynyovunnDrPhouJbfvVquxye4trAe+C6YHyEwJ6uSlh",
+	"ghCr3bD9JiysXMmC4DxtNtyWnw2rnIXldt4oWUkdk7jPSOW+uStOMyb6Cbob97VInbis4CumE/me7bcm",
+	"CxzO5OuixXNGyJvoYH9vUgM3upCcKuVVh7Zbu5RcscLm9ouup2KKyyKunJCKr7gAayw2iq/LfoNh29TJ",
+	"FiosR+OGKHcOIpZwR2znss326PIcGn0vP7g6IV+CzqNJWWEhAcc8fwMyQYS0aCdc25pHBakrKaK7UNFd",
+	"KWmR+fiesf1wiQ5aNaGdfU21DTDwY8Sx7WfyaTfuNVUzSGIuLsTYBLRTehDMgxgmAUeMFf1JMfSSMKpK",
+	"ztQoQWlDV1HjUjuvlkuD+0XMWjENL2xLSAt8Yscmn0bOfRNsj7ZjFDjvHuvmSLYLaTAZIZTYjr6fwpn+",
+	"zqD6SCLhHGDmBhvYGAXUu9kANdrjXx+FS/3JI+azmztuWDN5GDSIsUcyp0aqSXWj7kLHDuBRakx4Td6x",
+	"alyfEgtGi5ILFs/m3vRlW4NlrWRtCNtWLDfdcjFzsmFU1+hg7utE2PKJbgRlbTPdfPBx6lpisiGWQ1LP",
+	"XXYAgEv0x3cdPxq4glbVKC/1k+Kl4AvvWCrnwj57m3xOW9g8VtkHsSY3lGOMlz8hS1mW8gZ+gVaJK2Xk",
+	"/He5c2exyWRAyMgS0VldPqfHBbVJ8eBD8TASG773jd2gHFMaW2OatAeEpHKiYs9E3REkNV0FFsLO6qYR",
+	"CmzZ+Lbg1nuW379AeyMdDCl0mgaooWXJmU7zUd1wUB9q4yHHP7rUdU86+FEadgl30t5Mjl630DBgRHh7",
+	"QEO8dbZ73nLBJPcJj0KLoVHG3UI+DZFN8kePTBoQQZdrB3dTNvXSk0v8U/nw/GYMiJW3P7nwfX8tMuSj",
+	"Kb3gHlbTJYIeu5p0KtJ9Gol0/6qphZ8IxuCil0QhifqoZpyD/BdTknAw51wJeSPScVpqb9mkAqNyc+Nx",
+	"3XJEP1t0cAutPlx0wdU42WXNV2umDcGQqbtzXCf27jtu/WPWbkqDq3mMUNvFxk6PLzn6Sx0Vjd0HG7kO",
+	"nfFqtOVGCRMFOlKckK8xvxYQXqcSAjow+FTV3bStdQUvhDmm0AYHemJntX0UM7Vy5U5XaL/vKjmiDlfT",
+	"09j6/GGJ/EzTxxlPGAOr1iZrqpPGMmBCi7Z+Ku+5xqNlP8TOCXllnSq0N9nbSQhmUMc3ajOdM+uhygj+",
+	"YwzN19BAdlTTaY3Y9Dq9XmnV+nJR//+8LY6FzwaA25XqtZV650SC1HbDISn2mhpggt2XrZukFT1tEs7u",
+	"8lQthKWUkwOsBE0prEPR3rlkGzfjKGQ9xB9oq7YVug8tW3yBvWJEOaiB3PMD9ikcfSJ38q1zN8qpkILn",
+	"+FqNmTgwQeA0x8UJRUXiHod65k5o5HBFKy83uQAcFpO1mOezDuKGTsDBV9hUSx32T4OPtTU1ZMWMdpwN",
+	"LnlX+9y5yHGhmSt2BkQU8kmpIpEJMSNC1rhUH0hGmPsr4fPwFXz7znnEwBEkV1ygJOHQ5gxn1okN8tgA",
+	"tQvCDVlJpt16uglQ9U/Q5wRzgRZs+/7ktVzx/IKvcAwb7QLLtqFdw6HOfKCXC6yCti+hravQ0Pzciemw",
+	"k55VlZs0XRk/ai6AKgQpBEcsJJl3LQ+Q24wfjjZCbqMRmnifAqFBzQ0rpcI9PJROfan17ihQcaO2FIUt",
+	"iI1Tj6rcow/+11x4USt+QeTRKwE3xspN8X46V9Tk6w4b2hfX1QSu9BmaNs4r975D9TbYv0LymZ8jvY1t",
+	"lfgE42gatHYdKnbEHwqg7kCYeEnLJsIxUvMdpSonRBXUtHlnfRX4GOMAxp1tmNY+eq9fBqrvJ9GRiWx3",
+	"LNZy6E2UyoS5qIsVM5BlMaaP/wK/EvxKilqhgnDL8rqpUVZVBIDqZ8IfUpubKJdC15uRuXyDe05XcE21",
+	"ZptF7B36qvnIimaHgdJAWQb/xgp0pXfGxTYenOvABzIWh5V/GOZuiEm9QNMZ5F+bjgm8U+6PjnbquxF6",
+	"2/+olF7KVReQj5z/eozLhXsU429fwsURpocehJHaq6XJ3owhmxK/+4RnTd7RniacWqIdzOk2L7JlPeB9",
+	"wyjg17RM5BcJ/c7s/WpVFqksI3kyKQ41Lj2foWSUBSVTntnowZ4n29CpMBUxaAMGj+dO5tY6ilAfYT0E",
+	"6BufvoFUlLuokZZZDDHrwmOHiZCmBLO2G9xfhEtmk/R4+uY6lXjGKwTxe1h1xvn1z51xkF1zWbsNa6Ii",
+	"/ZPQ/rrEtITd6jKJ9UfDg39vd7Kk89ulK29sl+ne5N/8aGNoCRNG7f4buMINNr1fuigi7WKLgGDdE3jg",
+	"VJN41HZuxSmVkmJFeZxs6HVllrV0aGlQ5GhAVq+miAMDfNzOZ+fFQRdmrLDTzI4SO3avQQmJdSH+xmjB",
+	"1Js9dS/aWhd4xCqpeVugtoTBnKF6jcOdTA0/BgLmYd2O4VjelHPNcoNVidtwG8XYIVU8YDJ3Cv6sfzHy",
+	"nG6itF3Zi7FaF8NSxHvu+EE6uiClYspOn6zscNYEVSKfRkeOFROo0Sx6qY0mJ1hZLllu+PWe9H9/XzMR",
+	"pJabe72MNVUH2QB5k9mgjvuU7FMXtQCV9I7wlPR44KTSTV2x3QNNOtQQrSvbZOK4S+JwxID1I6mSLpRW",
+	"keziSLhuKAOx4IMEbXfWlmCJMRKcLkhmece5PEkSGia4HJkyXhN/0lzQ9aC0rxikn0r3MSypnX5/vMIK",
+	"5tqFzNAm8Xj4SgeFY788041LXI7JGhvbifdGYtr/5jOz2llKfsVaBw5nqQKzoG8RVb14rU42ch8N0voR",
+	"Hgd62czM25Duoff5cI9tdoS8lCBGZKkUE13bahOC9EDbWDFbf5YpB9eSKWUpAFrC2Cwz0ruhjcExhgqN",
+	"AXF3QoJOFtmywCVT379tc/tjsUGKqe6pi4MLF0gU21CATgUZ+NNzjiH7pf3uc2V5n6O9GqaGXvdXPfbB",
+	"/FwPkBhS/ZK423J/Dq67KJu4EExl3vLUT8cvmOpaQyolizq3F3R4MBqF3GRT+wgriepp8uEqe2+EIJfV",
+	"FdudOi90Vy7a72AItJWcLOhBGufeJh9V/aZjcK+OAt7vqbmazyopyyxh7Dgf1hDoU/wVhwo8BG4KH/Sa",
+	"KOFPPkEde2PNvlnvfM78qmKCFQ9PCAHdF7rTWoB7RSx7k4sHZmz+Lc5a1Lash1OqnbwT8Xht9LNW9+Rm",
+	"fphxHqaZKO49lR1kfCKzTdQvgII4Gg3GCc44/iofmpr7bjUtUVkoYjLJhbVYvcSDHlMcYVK0IKUeGjIp",
+	"cZYuoksZC7K8S+I2GCqOqXAyBMgwMUEswwHDLHJRBDgvHseDfCH/6MOrpDmzKbm1j41rsv26fM7WwtKt",
+	"mj/x/XUZpE4ykkgHyd1S+oY16PRedo/eHy5xDgvrkvgCGnh48dIivEkZ4z2Q20YHF+3vVAxpcB8z1aF0",
+	"5TOjpBJC+e9Tl4OduCGFZN0lwUCHLyZIvzW2lmQxqvMlaiI4+lgoT2/dejiuc5cvdReKIesac9bQ4p+1",
+	"9rVmFABW7v6d0GYUK/diKTegt2Upb2C+jQ0g/ie6u99b9e4Ic/T0Relg6D7AjCY+M/+EZNuTziP4O6Sz",
+	"PpObtdSsn+jZHc6Cif2546rq/NUgB/S4fcGT7zd4NGGPYDOBQF2Z6ivGKlf8vaOd1wcTbZhadr+3ksXV",
+	"np30MtgEXupCklaYMA1FE9jhTt5fn3WIirZSzZG2Ns5l92zj/nTVI8cYy/lMyfX8WyWd3gcbDtIqVo4I",
+	"Xj/f8f+/JyB2DaSPQIeNuQvMSxcw0iRCH9F6tAmr28QrR0+s2eo3LLsM8l0ewionpdkMo6HulFezTafp",
+	"8Da2m1/I7Z77yBYTEwZ18rBxQe6EcY41tzoeb4eFbnyJAQaxnL17uRmcEnnjIusXcjudp8XdJy8dTLFs",
+	"KJPy0owYWmFcj7Q7jB0/lXOfHGS/uL83LqAJCWi3qw0LGO4NRNNl+GrOmoKLMTG1RAkrVAr5EtNtN2Ik",
+	"ppFs4guodgpDCNouSC6VYnnYIx6/Z4HaSMWyUq7iYdqv+dJoUvINB+IVpJQrIivYBlu3NM59UnPVAjYA",
+	"IqYD7+4IBrDaF9qaJHF9SNNn6pSg27H+TBmq/FaTGT70sTlV2yIAdtGZ9alLpDRh2iX9dxiyjYfwIt3Y",
+	"hNx9m/ZBFUM7l3U3nSz2IJViOWty7AYIc87EXn9n1krWq3VQronc8LL0hivYBlX70KJgFCzNiu21Y+yN",
+	"BKwNDHDFKgwP3LCNVDv75PABB3NS6xq96gG452QjbVAtE4ZIwTT5BBiAkmXZNVlaBe7K+WF8S7dneW5e",
+	"S3kFyWMf/juRJehcHFQwo3+ouHIj5Pnjx1bPNIfAMwFkTVW+hvqPOIF19xbSNDgt5j6nZz/8pFnNWGyr",
+	"lbm8KDlZ8Og8drT300YySagHBuSE4HpGcbDk43jdwH9jnxAUgDmBx+53DzkbLqy/ri67jWvczwShRm54",
+	"Hj92f6zAkGQ4R4J6hkYYf/YdPYcxal6N6RXnRe20ez7IMhBRpCBGVt2agmG4t9O0B7eVLboxHs4WLRvp",
+	"R/eLOlwD01PixX2+m+K3Uyq03QOYyEs4pg2KV2v9ohWQ7gFDKL3GKC5JXZ1HUeTAgrnc+wdaRm1fbISj",
+	"8tKpZzrYlComTbjUhABIOj9hLDPho0cnBKqwBC6mGqqXwJ9YvmMoVB7XSfFu3p1BnMaB3p0x8SXGA20P",
+	"lxsdm6FQGcqxTQAAik9D1DABV15s35385RyhkYHAf9FK1B+XLBk1g7kDGXoo0zlNf5Yn7RE9ABBSm7DX",
+	"1ApdWjvWgkYCkiv7akQZoQ/oRIkTo2XuBxuMcHSgDLsXUIMIvQbAT6zSZm7VnzbaD19u9vvDto7RnYC/",
+	"HafyjtSQCkO6aElLYZNG/ZsQBaLv6vGYnUtM1ryYGrmjvZflROk/ACAdy9OBYVJEz6FgLCmEdGbUJB4i",
+	"6EcxD6zBLvNTMLpPT4CzkJzaxwX48FFe1oq5dP94/xDV9dGsqFl7YQWaD72dwHOGWZH/V6YksuhiHvgI",
+	"stJmLeoZrGWVleyadUKcLC3rGp+h/Jr5vrrpTArGKvSY7ftxxGJ3Ajz2L3i39iyI/piC3ai13yLW7hTZ",
+	"Y8qPOh5sRWaPiZ56lACia17UtIM/fbCWteOqAkc5gqqB/iCzegJWTJ3mBzvCWz/Ame8fe8N4TLyfxocO",
+	"ZkFx1I0xoL2xfLVOnXoRD+ULC2w0ToA4W9E4C1sSb/mGruiNSDvNDEm+VcVM3CcuRYDYL7csR6mmG6t2",
+	"f5wQHIxovtq/hpYg7ud89bvQ8CgJJ8eLSb+aOZ1KGyLvXSP9Ohq6cC/11oYsQEQuS5db0vF/x//mZFH7",
+	"gUAHaFMVhS+BV8x7uWKR3cbBz67IV51BDx+Lbsv7hwpEHkQjg3+2VPiPkIb8UtOSL22mQAu+70b0mgIJ",
+	"Obda6+/tYvxg4nHBZO4B8zpM6aey6+ZTxwyG28EoAdBwBRKpnIfmhl6xcBvQld1yntwAy9H1YsO1xsuu",
+	"t51DLLjF+5T8G1oEmjxbGGzXuYl8dUfo/e9tppNwKl/PBw1dhd88TTc9JzIUIxriAveDQ3QHlwEJ+FYB",
+	"0Sqf1LqwThIWf01tCJRE8D8LbhRVu5HA3L1+N7H4cpSc94EdCOCBo8HRljEx1U+v0PlIEqFJSzn2LoyI",
+	"WPu8gzoQ9jyFPgKKo2X5UsuYAv5HRG1CPRWChE0+BiI76esPUWeBjHFNyxFt6SV6c6KZvFeE2tvGXN+Y",
+	"CstfGMMBuG5Fe0wtw9rUJUEzuJ0KvlwyZSPNtKGioKoIm3OBGZwppNylO313GyRAq2o2D5lw1AxJg6u6",
+	"m/AsMEjijltAyp3zZ72nibABkB7RVjjBxne5ZlH7nn3xG5kw6Q1hiKfhp1sww2LCkQQBusJ2aITFZkQK",
+	"NEPYy/6weTT/lY1PgzV9XVCMkTjrlCnGz9n3iDqU5n8Q3IyeNKsq6meAsSF69iB4+herNk7Ybs6Q/qs8",
+	"PlnVTdzTzybq99rGC9j5Uumqu+rJxC6ix7TL+BTqIg/Q3necsiNM2T3QMny46ZFIYKbbqFf0NrIv+kFk",
+	"Sv/FZ5Eyd4mVDrwyrJqUFgVPWFkuvdFAu7PVnbbxrodxpt+ygSt5HKJKVlk+JTzMlv0uLAAe0i6MY4bg",
+	"UepoPOl1U50+pMZumXocT99FluuVyd+bgjMfe0GmXusJDtrVBMsl8jI8wlZHIVX4Mp/301F0tRENkyCU",
+	"KJbXCrV1N3QXdZDqeGZmJg6lz+RlR/Z2Ep+goIHaEaNlR7o1aQ18Nw/Rg0U4ZIReI66cx19Mytfz+Mtx",
+	"YXLxBYDVHhoClOP01mqMPalEaA3eqREG5wPB7rDAlKJqQpKlo21Vc1p+iw2KXugj+USGRvImwdAk0IYJ",
+	"dyLYRAASmTQ6ORCCIPCg+puyOiLUJnnFe59ffNsq5PeGfCIkvsMe8MLUGG27xtnCgfM7l1H7tkFKsJT3",
+	"KUroLH9ftg23wNaCEWyRe1UYw6xfrI0Z6u5LkEpFv2wylCTEiEEiEyWlQZevsowkQLEPHTxTIeFwYZi6",
+	"puXHT2LyFVfanCE+WPE2HfYcZsEIkWxRqe+Wg/c1nTR3SX+DqcUbTLqSKo1zJogbyhkvBswfn6m0tC6r",
+	"TXUbcPhzGc5hp8mTz8jCldatFMu57htFrOY68IS8Zgp0o00xkPEsE/vW+aM09yDjpbdgku8C5abEd3YL",
+	"YXtEf2emkji5USqPUd+ALCL4i/GocW+lznVx1Qk0STkqHTml292dfsKVYfLcycvDdeClU2s2XOfk23o8",
+	"PMZCOAXxbT7CyXVwoWD2YkoawXgBXOiOeQyPUgn3oDq4v0EGQ4sjN4abN0YxP6Zy2tu87Ynqir39gEKM",
+	"e7WxYa1MCPBigmmusRrkP1yl6I97l3oIbJTM8KhaWO+TCs4iJrLWzuTBVEEVzAkFMF23SLlLzFiQ14qb",
+	"3QXg3794+T+iboxfN3m7XN63Rtns7j4jr5jw1r42y1et/e36taQl3kdWBy4YMVKWJ+TLLd1UpXf6/OuD",
+	"xb+xZ395Xjx+9uTfFn95/OnjnD3/9PPHj+nnz+mTz589YU//8unzx+zJ8rPPF0+Lp8+fLp4/ff7Zp5/n",
+	"z54/WTz/7PN/ezCbzziAbAH1MTwvZv87OytXMjt7c55dArAtTmjFITXa7S0+LZcSlo9IzfEksg3l5eyF",
+	"/+l/+hN2kstNO7z/deaqsc/WxlT6xenpzc3NSdjldIVpfTIj63x96ue5nfcwfvbmvPFttFZY3NFW3XMy",
+	"a0nhDL+9/fLikpy9OT9pCWb2Yvb45PHJExhfVkzQis9ezJ7hT3h61rjvp47YZi8+3M5np2tGS7N2f2yY",
+	"UTz3nzCc3f1f39DViqkT9Fu3P10/PfVixekHF6h9O/btNDTwnX4I/sp4sacnWq5OP/j4u/HW4ev91PkF",
+	"BB0mQjHW7HQhtwc0ZTponF4KPjb06QcUl5O/n7rKvvGP+Gyx5+HUp0qLt+xg6YPZAqy9Hjmokuvq9AP+",
+	"B+nz1jKMksUicW3FG0ra5nPCDaELqYy2vwKPsCEaqBFtW87ms4bgzwsgdOj10kKABOxNXrMXPw1dVXEg",
+	"4kdCrgAk3x7azkwtX0Zr0czeS51bp9O+vXt+epx9/v7Dk/mTx7f/AneL+/PTZ7cTwzleNuOSi+bimNjw",
+	"/XxmdRPa8vCnjx97BuaeBwHxnbqzGixu8ExqF2k3qXGPGd7rjhbSrohuq3oDkQYZ4+J/f/iheII8+/mB",
+	"Kx7VJXXSeOPw/cLhBfHBnjj3k48397mwTjlwN9g77HY++/Rjrv5cAMnTkmBLe2thnNtw63+wJct8y9v5",
+	"TNebDVU7f4x1hykQt9l4rdGVRiuC4tcU5TwhRZCbVKxm7zHLlTaT+Q0W0TyY31xArz/5zcfiN7hJx+A3",
+	"3YGOzG+eHnjm//gr/pPD/tE47IVld/fisCMC3+mS23iu4/Ng92bsQA6zoTHGJQTW9pdlp6aczRCIH/RO",
+	"G7aZEy60YRQrdBXyRpSS+uD2TVte2RWjOxll/l8pufmKl0z/eQvYhgNjJG0zovd3LrlDJx5Bv9RM7WIY",
+	"yqD1KJoGDG4MspB60lCR844lVQMzEtaYutGrCpw4vD3ODejj1rhCpzoo50gN03PiHNCd4SpATJsZGQv6",
+	"QiNuNCldXoIUZux8h2Plz1v7z1v7z1v7D3xr2/vKevPD6dcHX+O+mvKpT5nmPtjaZqdmK07R//T0Q0cB",
+	"5T4PFFDd39vuYYvrjSyY1zHJ5VIzs+fz6Qf7bzAR21ZM8Q0Thpbtrzb/xKmuq6rcDX/eiTz643AdnZoX",
+	"iZ9PP3T+7Gro9Lo2IF2kxaGLiuWclmRDBV3ZkNJGtWsk8QO0RTbI964uWLnzGXsIxYLFsjat7p0Y2QR4",
+	"tt4RMALRa2eGXnGBE6CZG2ehS+hKA//EoOZ+TwJykH0nCzYUfGL3k4NxNu9wUncUHkecf+97MQ0Z3+1h",
+	"5wJPg/UlGRIHfKx1/+/TG8oNiEeu2gViNNZZMbpx9N3+bBgtT13F296vbZG5wResnBf8GIbORn89xd1K",
+	"fuxrwGNfnQY40ci77/vPrTUstC4hpTR2pZ/ew4Zrpq49EbXGkhenp8je1lKbU5Qwu4aU8OP7Zo8/eMrz",
+	"e337/vb/DQAJoLfCPhQBAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -702,259 +702,303 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+y9fXPbtrI4/FUw+v1m8nJFOa+9p57p3MdN0h7fpmkmcXvuuU2eFiJXEo4pgAcAZal5",
-	"8t2fwQIgQRKUKFt2ktZ/JRZJYLFYLPZ9P4xSsSwEB67V6PjDqKCSLkGDxL9omoqS64Rl5q8MVCpZoZng",
-	"o2P/jCgtGZ+PxiNmfi2oXozGI06XUL9jvh+PJPy7ZBKy0bGWJYxHKl3AkpqB9aYwb1cjrZO5SNwQJ3aI",
-	"0+ejj1se0CyToFQXyp94viGMp3mZAdGSckVT80iRC6YXRC+YIu5jwjgRHIiYEb1ovExmDPJMTfwi/12C",
-	"3ASrdJP3L+ljDWIiRQ5dOJ+J5ZRx8FBBBVS1IUQLksEMX1pQTcwMBlb/ohZEAZXpgsyE3AGqBSKEF3i5",
-	"HB3/OlLAM5C4WymwFf53JgH+gERTOQc9ej+OLW6mQSaaLSNLO3XYl6DKXCuC7+Ia52wFnJivJuTHUmky",
-	"BUI5efPdM/L48eOvzUKWVGvIHJH1rqqePVyT/Xx0PMqoBv+4S2s0nwtJeZZU77/57hnO/9YtcOhbVCmI",
-	"H5YT84ScPu9bgP8wQkKMa5jjPjSo33wRORT1z1OYCQkD98S+fNBNCef/pLuSUp0uCsG4juwLwafEPo7y",
-	"sODzbTysAqDxfmEwJc2gvz5Ivn7/4eH44YOP/+fXk+R/3Z9PH38cuPxn1bg7MBB9MS2lBJ5ukrkEiqdl",
-	"QXkXH28cPaiFKPOMLOgKN58ukdW7b4n51rLOFc1LQycsleIknwtFqCOjDGa0zDXxE5OS54ZNmdEctROm",
-	"SCHFimWQjQ33vViwdEFSquwQ+B65YHluaLBUkPXRWnx1Ww7TxxAlBq5L4QMX9Pkio17XDkzAGrlBkuZC",
-	"QaLFjuvJ3ziUZyS8UOq7Su13WZGzBRCc3Dywly3ijhuazvMN0bivGaGKUOKvpjFhM7IRJbnAzcnZOX7v",
-	"VmOwtiQGabg5jXvUHN4+9HWQEUHeVIgcKEfk+XPXRRmfsXkpQZGLBeiFu/MkqEJwBURM/wWpNtv+329/",
-	"ekWEJD+CUnQOr2l6ToCnIoNsQk5nhAsdkIajJcSh+bJvHQ6u2CX/LyUMTSzVvKDpefxGz9mSRVb1I12z",
-	"ZbkkvFxOQZot9VeIFkSCLiXvA8iOuIMUl3TdnfRMljzF/a+nbchyhtqYKnK6QYQt6fqbB2MHjiI0z0kB",
-	"PGN8TvSa98pxZu7d4CVSlDwbIOZos6fBxaoKSNmMQUaqUbZA4qbZBQ/j+8FTC18BOH6QXnCqWXaAw2Ed",
-	"oRlzus0TUtA5BCQzIT875oZPtTgHXhE6mW7wUSFhxUSpqo96YMSpt0vgXGhICgkzFqGxtw4dhsHYdxwH",
-	"XjoZKBVcU8YhM8wZgRYaLLPqhSmYcLu+073Fp1TBV0/67vj66cDdn4n2rm/d8UG7jS8l9khGrk7z1B3Y",
-	"uGTV+H6AfhjOrdg8sT93NpLNz8xtM2M53kT/Mvvn0VAqZAINRPi7SbE5p7qUcPyO3zd/kYS81ZRnVGbm",
-	"l6X96ccy1+wtm5ufcvvTSzFn6Vs270FmBWtU4cLPlvYfM16cHet1VK94KcR5WYQLShuK63RDTp/3bbId",
-	"c1/CPKm03VDxOFt7ZWTfL/S62sgeIHtxV1Dz4jlsJBhoaTrDf9YzpCc6k3+Yf4oiN1/rYhZDraFjdyWj",
-	"+cCZFU6KImcpNUh84x6bp4YJgFUkaP3GEV6oxx8CEAspCpCa2UFpUSS5SGmeKE01jvR/JcxGx6P/c1Tb",
-	"X47s5+oomPyl+eotfmREVisGJbQo9hjjtRF91BZmYRg0PkI2YdkeCk2M2000pMQMC85hRbme1CpLgx9U",
-	"B/hXN1ONbyvtWHy3VLBehBP74hSUlYDti3cUCVBPEK0E0YoC6TwX0+qHuydFUWMQn58UhcUHSo/AUDCD",
-	"NVNa3cPl0/okhfOcPp+Q78OxURQXPN+Yy8GKGuZumLlby91ilW3JraEe8Y4iuJ1CTszWeDQYMf8QFIdq",
-	"xULkRurZSSvm5b+7d0MyM78P+vjLILEQt/3EhYqWw5zVcfCXQLm526KcLuE4c8+EnLS/vRzZmFHiBHMp",
-	"Wtm6n3bcLXisUHghaWEBdE/sXco4Kmn2JQvrFbnpQEYXhTk4wwGtIVSXPms7z0MUEiSFFgzf5iI9/ztV",
-	"iwOc+akfq3v8cBqyAJqBJAuqFpNRTMoIj1c92pAjZl5EBZ9Mg6km1RIPtbwdS8uopsHSHLxxscSiHr9D",
-	"pgcyorv8hP+hOTGPzdk2rN8OOyFnyMCUPc7OyZAZbd8qCHYm8wJaIQRZWgWfGK17Lyif1ZPH92nQHr2w",
-	"NgW3Q24RuENiffBj8K1Yx2D4Vqw7R0CsQR2CPsw4KEZqWKoB8D13kAncf4c+KiXddJGMYw9BslmgEV0V",
-	"ngYe3vhmlto4ezIV8nLcp8VWOKlNzoSaUQPmO24hCV8ti8SRYsRsZV9oDVR7+bYzjfbwMYw1sPBW02vA",
-	"gjKjHgILzYEOjQWxLFgOByD9RZTpT6mCx4/I27+fPH346LdHT78yJFlIMZd0SaYbDYrcdboZUXqTw73u",
-	"ylA7KnMdH/2rJ95Q2Rw3No4SpUxhSYvuUNYAakUg+xox73Wx1kQzrroCcMjhPAPDyS3aibXtG9CeM2Uk",
-	"rOX0IJvRh7CsniUjDpIMdhLTvsurp9mES5QbWR5ClQUphYzY1/CIaZGKPFmBVExEvCmv3RvEveHF26L9",
-	"u4WWXFBFzNxo+i05ChQRytJrPpzv26HP1rzGzVbOb9cbWZ2bd8i+NJHvLYmKFCATveYkg2k5b2hCMymW",
-	"hJIMP8Q7+nvQKAqcsSW81XRZ/DSbHUZVFDhQRGVjS1BmJmLfMHK9glRwGwmxQztzow5BTxsx3kSn+wFw",
-	"GHm74SnaGQ9xbPsV1yXj6PRQG54GWqyBMYds3iDLq2urfeiwU91REXAMOl7iYzR0PIdc0++EPKstgd9L",
-	"URYHF/Lacw5dDnWLcaaUzHzrdWjG53kz+mZuYJ/E1vhJFvTMH1+3BoQeKfIlmy90oFa8lkLMDg9jbJYY",
-	"oPjAKmW5+aarmr0SmWEmulQHEMHqwWoOZ+g25Gt0KkpNKOEiA9z8UsWFs554DXQUo39bh/KeXlg9awqG",
-	"ulJamtWWBUHvbee+qD9MaGpPaIKoUT2+q8rpaN+y09lYgFwCzTZkCsCJmDoHkXNd4SIpup61F2+caBjh",
-	"Fw24CilSUAqyxBmmdoLm37NXh96CJwQcAa5mIUqQGZVXBvZ8tRPOc9gkGCihyN0fflH3PgG8Wmia70As",
-	"vhNDb6XmOy9gF+ph028juPbkIdlRCcTfK0QLlGZz0NCHwr1w0rt/bYg6u3h1tKxAoj/uWineT3I1AqpA",
-	"vWZ6vyq0ZdET/ufUWyPhmQ3jlAsvWMUGy6nSyS62bF5q6OBmBQEnjHFiHLhH8HpJlbY+ZMYzNH3Z6wTn",
-	"sUKYmaIf4F41xIz8i9dAumOn5h7kqlSVOqLKohBSQxZbA4f1lrlewbqaS8yCsSudRwtSKtg1ch+WgvEd",
-	"suxKLIKorlwtLsiiuzh0SJh7fhNFZQOIGhHbAHnr3wqwG4ZA9QDCVI1oSzhMtSinirsaj5QWRWG4hU5K",
-	"Xn3Xh6a39u0T/XP9bpe4qK7v7UyAwsgr976D/MJi1ga/LagiDg6ypOdG9kAziHV2d2E2hzFRjKeQbKN8",
-	"VPHMW+ER2HlIy2IuaQZJBjnddAf92T4m9vG2AXDHa3VXaEhsFFN802tK9kEjW4YWOJ6KCY8En5DUHEGj",
-	"CtQE4r7eMXIGOHaMOTk6ulMNhXNFt8iPh8u2Wx0ZEW/DldBmxx09IMiOow8BuAcP1dCXRwV+nNS6Z3uK",
-	"f4JyE1RyxP6TbED1LaEef68F9NhQXYB4cF5a7L3FgaNss5eN7eAjfUe2x6D7mkrNUlagrvMDbA6u+rUn",
-	"iLoZSQaashwyEjywamARfk9s/E17zMupgoNsb13wO8a3yHJyplDkaQJ/DhvUuV/bwM7A1HEIXTYyqrmf",
-	"KCcIqA8XMyJ4+AqsaarzjRHU9AI25AIkEFVOl0xrG7DdVHW1KJJwgKhfY8uMzolngyL9DgzxKr7FoYLl",
-	"dbdiPLI6wXb4zlqKQQMdThcohMgHWMg6yIhCMCjegxTC7DpzseM+ethTUgNIx7TRg1td/3dUA824AvJP",
-	"UZKUclS5Sg2VTCMkCgooQJoZjAhWzekiO2oMQQ5LsJokPrl/v73w+/fdnjNFZnDhEy7Mi2103L+PdpzX",
-	"QunG4TqAPdQct9PI9YEOH3PxOS2kzVN2Rxa4kYfs5OvW4JWXyJwppRzhmuVfmQG0TuZ6yNpDGhkWVYHj",
-	"DvLlBEPH1o37/pYty5zqQ3itYEXzRKxASpbBTk7uJmaCv1jR/KfqM0wmgdTQaApJiikQA8eCM/ONzZrY",
-	"pRvW0WRsuYSMUQ35hhQSUrBR/kbkUxWME2Lj/9IF5XOU9KUo5y4AzY6DnLpU1qYiS94ZIioN6TVP0Dod",
-	"49wu6Ngnehg5CKjRxdqmbat5XNBqPpfbM+RKDZDXNvVHvVvjUa+qapC6qlVVi5xmtsoALt4Q1AL81BMP",
-	"9IEg6ozQ0sVXuC3mFJjNvR5bez10DMruxEFIXP2wLyrO6Mn55gDSih2ISCgkKLxbQvuSsk/FLMxMc5eP",
-	"2igNy64J3n76W8/xe9Or6AmeMw7JUnDYRJOxGYcf8WH0OOH91vMxShp937aVhwb8LbCa8wyhxqviF3e7",
-	"fULbrib1nZCH8mXaAQfL5QNchzv95G7Kyzo4aZ5HfIIub6XNANS4ypNnklClRMpQ2DrN1NgeNOdGdEku",
-	"TfS/rqJxD3D22uO2nF9hSiQadyEvCCVpztD0K7jSskz1O07RuBQsNRK15LXofnPjM/9K3L4ZMT+6od5x",
-	"ihFrlckpGmkxg4h95TsAb3VU5XwOSreUlBnAO+7eYpyUnGmca2mOS2LPSwESQ4cm9s0l3ZCZoQktyB8g",
-	"BZmWuim2Y1qW0izPnSfOTEPE7B2nmuRAlSY/Mn62xuG8t94fWQ76QsjzCgvx230OHBRTSTy66nv7FANf",
-	"3fIXLggW0+jtY+u7MePXuVsbtD3VqeH/793/Ov71JPlfmvzxIPn6P47ef3jy8d79zo+PPn7zzf/X/Onx",
-	"x2/u/df/je2Uhz2WNOQgP33uVNrT56i31M6bDuw3ZrhfMp5EiSwMw2jRFrmLCbKOgO41rVp6Ae+4XnND",
-	"SCuas8zwlsuQQ/uG6ZxFezpaVNPYiJYVy691T23gClyGRJhMizVeWorqBiTG0/PQm+gy7vC8zEput9JL",
-	"3zb7xAeGidm4SsG01VmOCebnLaiPanR/Pnr61Whc59VVz0fjkXv6PkLJLFvHsiczWMeUPHdA8GDcUaSg",
-	"GwU6zj0Q9mgMnA3KCIddwnIKUi1YcfOcQmk2jXM4H9PvjEVrfsptsL05P+ib3DiXh5jdPNxaAmRQ6EWs",
-	"akNDUMO36t0EaMWLFFKsgI8Jm8CkbazJjL7oovFyoDOsHoDapxiiDVXnwBKap4oA6+FCBllEYvSDIo/j",
-	"1h/HI3f5q4OrQ27gGFztOStHpP9bC3Ln+xdn5MgxTHXHJvLaoYPUy4gq7bKLGpFEhpvZWjVWyHvH3/Hn",
-	"MGOcmefH73hGNT2aUsVSdVQqkN/SnPIUJnNBjn3C0nOq6TvekbR6y0kFqWKkKKc5S8l5qJDU5GlLhHRH",
-	"ePfuV5rPxbt37ztBFV31wU0V5S92gsQIwqLUiStwkEi4oDLmtFJVgjuObCuYbJvVCtmitJZNX0DBjR/n",
-	"ebQoVDvRtbv8osjN8gMyVC6N02wZUVpIL4sYAcVCg/v7SriLQdILb1cpFSjy+5IWvzKu35PkXfngwWMg",
-	"jczP392Vb2hyU8Bg60pvIm7bqIILt2olrLWkSUHnMd/Yu3e/aqAF7j7Ky0u0ceQ5wc8aGac+oh6Hqhfg",
-	"8dG/ARaOvbPncHFv7Ve+mFV8CfgItxDfMeJG7bG/7H4FOaiX3q5WHmtnl0q9SMzZjq5KGRL3O1PVuJkb",
-	"IcuHUSg2R23VlQOaAkkXkJ67Oi2wLPRm3PjcR+o4QdOzDqZsBR+bQYY1JNCzMAVSFhl1ojjlm3YyvwKt",
-	"fTzwGziHzZmoS1Dsk73fTCZXfQcVKTWQLg2xhsfWjdHefBcOhop9UficbEzO82RxXNGF/6b/IFuR9wCH",
-	"OEYUjWTnPkRQGUGEJf4eFFxioWa8K5F+bHlGy5jamy9SzcfzfuJeqZUnF7kVrgat7vb5ErAcmLhQZEqN",
-	"3C5cJSubMB1wsVLROfRIyKFzZ2BacsMhhIPsuveiN52YtS+0zn0TBdm+nJg1RykFzBNDKqjMtOL1/EzW",
-	"f+g8E1ig0iFsmqOYVAU2WqZDZcPJZivu9YEWJ2CQvBY4PBhNjISSzYIqX2QLa5H5szxIBrjGAgDbyr6c",
-	"BqFmQcGxqqiL57ntc9rRLl3xF1/xxZd5CVXLASVbjISP0e2x7RAcBaAMcpjbhduXPaHUxQjqDTJw/DSb",
-	"5YwDSWJRa4EZNLhm3Bxg5OP7hFgLPBk8QoyMA7DRL44Dk1ciPJt8vg+Q3BVToH5s9KgHf0M878vGcRuR",
-	"RxSGhbMer1bqOQB1oY7V/dUKuMVhCONjYtjciuaGzTmNrx6kU30ExdZWrREXmXGvT5zd4gCxF8tea7JX",
-	"0WVWE8pMHui4QLcF4qlYJzbxMyrxTtdTQ+/R0HZMQ40dTFvn5Y4iU7HGaB+8Wmwo9Q5Y+uHwYAQa/pop",
-	"pFf8ru82t8Bsm3a7NBWjQoUk48x5Fbn0iRNDpu6RYPrI5W5QuuVSALSMHXUdZKf87lRSm+JJ9zKvb7Vx",
-	"XZLMZw3Fjn/fEYruUg/+ulaYqtjK67bEErVTNINWmnVmAhEyRvSGTXSdNF1XkIIcUClIGkJUch7znBrd",
-	"BvDGees/C4wXWM2G8s29IBJKwpwpDbUR3cdJfArzJMUiekLM+lenCzkz63sjRHVNWTcifthY5o2vAEOJ",
-	"Z0wqnaAHIroE89J3CpXq78yrcVmpGWtlS86yLM4bcNpz2CQZy8s4vbp5f3hupn1VsURVTpHfMm4DVqZY",
-	"Ijkagbllahuku3XBL+2CX9KDrXfYaTCvmomlIZfmHF/IuWhx3m3sIEKAMeLo7lovSrcwyCBztssdA7kp",
-	"8PFPtllfO4cp82PvjNrx+bt9d5QdKbqWwGCwdRUM3URGLGE6qDDcTWntOQO0KFi2btlC7ai9GjPdy+Dh",
-	"67K1sIC76wbbgYHA7hnLqpGgmiX4agHf1opuVMCZDMLMWbNQXsgQwqmY8p0Ouoiqsu524eoMaP4DbH4x",
-	"7+JyRh/Ho6uZTmO4diPuwPXranujeEbXvDWlNTwhe6KcFoUUK5onzsDcR5pSrBxp4uveHn3DrC5uxjx7",
-	"cfLytQP/43iU5kBlUokKvavC94ovZlW22l/PAfGV1I3O52V2K0oGm1+VKAuN0hcLcCWpA2m0UzuzdjgE",
-	"R9EZqWfxCKGdJmfnG7FL3OIjgaJykdTmO+shaXpF6Iqy3NvNPLQ90Ty4uGEFWKNcIRzgyt6VwEmWHJTd",
-	"dE53/HTU1LWDJ4VzbSmavbR14RURvO1Cx5jnTeG87kuKlS+tVaTLnHi5REtConKWxm2sfKoMcXDrOzMv",
-	"E3y5Rxg1I5asxxXLSxaMZV4bUtumBWQwRxSZKlpep8bdVLiePyVn/y6BsAy4No8knsrWQcUyKc7a3r1O",
-	"jezQncsNbC309fBXkTHCqq/tGw+B2C5ghJ66DrjPK5XZL7SySJkfApfEHg7/cMbOlbjFWe/ow1GzDV5c",
-	"ND1uYYueLv8zhGFrte/uD+SVV1d+tmeOaL8fppKZFH9AXM9D9TiSsOTr3DKMcvkDwkSHsMtFg8VU1p26",
-	"bVE9e+9290k3oRWqGaTQQ/W484FbDgtuegs15XarbSJJI9YtTjBhVOmRHb8mGAdzJxI3pxdTGqtGaoQM",
-	"A9NJ7QBu2NK1IP5jj3tVZVvY2UngS67eZTYZvQBZ5xJ2C9tcUmCw0w4WFWrJAKk2lAnG1v+XKxEZpuQX",
-	"lNsuLuY7e5Tc1wqs8ct8dSEklpJQcbN/Bilb0jwuOWRp18SbsTmzDUpKBUEHDDeQbf5kqch1EalyiBxq",
-	"TmfkwThow+N2I2Mrptg0B3zjoX1jShVy8soQVX1ilgdcLxS+/mjA64uSZxIyvVAWsUqQSqhD9aZyXk1B",
-	"XwBw8gDfe/g1uYtuO8VWcM9g0d3Po+OHX6PR1f7xIHYBuAYz27hJhuzkH46dxOkY/ZZ2DMO43aiTaNa9",
-	"7TDXz7i2nCb76ZCzhG86Xrf7LC0pp3OIR4osd8Bkv8XdRENaCy88s+2RlJZiQ5iOzw+aGv7UE31u2J8F",
-	"g6RiuWR66Zw7SiwNPdXtLeykfjjba8lVJvZw+YfoIy28i6ilRN6s0dTeb7FVoyf7FV1CE61jQm39kJzV",
-	"0Qu+Xjo59eWJsFRzVaHZ4sbMZZaOYg4GM8xIIRnXqFiUepb8jaQLKmlq2N+kD9xk+tWTSHnqZplUvh/g",
-	"N453CQrkKo562UP2XoZw35K7XPBkaThKdq/O9ghOZa8zN+626/Mdbh96qFBmRkl6ya1skBsNOPWVCI9v",
-	"GfCKpFitZy963HtlN06ZpYyTBy3NDv385qWTMpZCxmoO1sfdSRwStGSwwti9+CaZMa+4FzIftAtXgf7T",
-	"eh68yBmIZf4sxxSBb0VEO/Ul0ytLuotVj1gH+o6peWDIYOqGGpNmeeqb56OHiYKKe7q8Ybvr2DJPPB7w",
-	"jzYiPjG54AbWvny7kh5CCcrzR0kmq54HPnZKvhXroYTTOoWeeD4DFEVRUrI8+6XO/Gx1P5CUp4uoz2xq",
-	"Pvyt7tNWLc7egdHygQvKOeTR4ay8+ZuXSyOS87/E0HmWjA98t92QwS63tbga8CaYHig/oUEv07mZIMRq",
-	"M6muCtrO5yIjOE9dq64+rt1GHkG59X+XoHQsQQkf2MAxtI0admCrfRPgGWqkE/K9bcW8ANIoRISaoK8U",
-	"0cyaLotc0GyMFSzOXpy8JHZW+43tNmSrjc9REWquomUTC8pwDgtB9o2D4ukRw8fZHq9tVq10UhUHjyWg",
-	"mjfq8uWs5SdAFSnEzoQ8D5qq2lxVMwTBAiZyabS6ajQrHyFNmP9oTdMFqn0N1tpP8sPL5HuqVEFryqrF",
-	"VFWbEs+dgdtVyreF8sdEGN38ginbgRdW0Mx5rRLAndnB58A2lydLzi2lTPa45apKlPui3QNnr0jvSohC",
-	"1kL8nkK/7TKxb9eAt/hVtFRWuwVBpyelzaCsWgf5zuop5YKzFAtVxa5o16p3iJ9tQE2vtiHXH3F3QiOH",
-	"K9r4oArFc1jsbYXgGaFDXNfQHzw1m2qpw/6psSfsgmoyB60cZ4Ns7Pt3OFsj4wpcrVFs7BzwSSEbvkvk",
-	"kFF3eFK5TfYkI0y96VEevzPPXjnTAsaknzOOSoRDmxP8rDUQO4lqo3kwTeYClFtPM/9Y/Wq+mWAqbgbr",
-	"9xPfeRTHsK4/s2zr5+4OdeK93s7LbN59Zt51BZKqnxtRznbSk6Jwk/Z3d4nKA3rNexEc8V4m3n0UILca",
-	"PxxtC7ltDVfB+9QQGqzQ2Q0F3sMdwqg6nbS6aBmh1VIUvkFsmFi0SgLjETBeMg51X9zIBZFGrwTcGDyv",
-	"Pd+pVFJtRcBBPO0MaI4e7hhDU9q5N646VLs8lEEJrtHP0b+NdZOWHsZRvVALbpRvqna8hroDYeIZ9gF3",
-	"iOy2XEGpyglRGWYttJqwxBiHYdy+zVPzAugeg65MZD/HWmn73kR9iajTMpuDTmiWxUq/fotPCT4lWYmS",
-	"A6whLasSoUVBUqy70ixE06U2N1EquCqXW+byL1xxuqCrUYQaws5Kfocx0WW6wX9j9TH7d8YFeuwdauij",
-	"OrL9qi91QydjUq+h6USxeTIcE3inXB0d9dSXI/T6+4NSei7mTUBuuPzENi4X7lGMv70wF0dYnaFT9NVe",
-	"LVXxBAzsE74XJaqNVdpvkyvhVdapAosOparX3XYDRH/XujFefj3hvUHRDWrvV+uh7AvyTXtj0ql22XGa",
-	"kq0sqDfjyEYI2dwihCJune2LCrJBQeZx5+thkmFHztbxwocBQn24WRegH3wsKykoc+73mll0Meui3rt5",
-	"CEPiYesNbi/CxZL3Wux+WPXFfftibPi83dXqHFzKfCFhxUTpHds+8smrhPbXRo+oKvI+uv6u4RWn+rTm",
-	"0F7j7ZnrLmCX6XTyH36xcXIEuJabz8CU29n0Tr+srrRrzVP1K6QqTD2oUHXjVhxSqDBWE8/Jho2OXTv6",
-	"jXXI6vkQcaDbP2w8Os32ujBjdRVHdpTYsYt3A+svO1WXmsIjVgjF6vrwsTZhA0MMz7DTV1A2qzuWj+9Z",
-	"QaqxKUAdtyAB9imiZSYLGo/elp/qUaerSExXdWpbqaluJ4Add3wnGyzIaLRV1CfDCyudVNFpyKexGvIc",
-	"uOv92czzGBxtPptBqtlqR/bdPxbAg8yusbfL2B7eQTIeq6KXsXjL/lbHGqBtyXFb4QmKKF4ZnL7cm3PY",
-	"3FGkQQ3Rsu5jf9Vepm4HYgC5Q2JIRKhY9Ic1JDuHPFMVZSAWfLSV/RzqCmi9HaGCXNJLzuVJ0lwcdX7p",
-	"linjLWkGzWU+3SvrGgNx+xL0uh0t+vWP59hARFXdGn3dj1BLJ6fd6ogXrm4I5kpWvhNfQQSU/80nRttZ",
-	"cnYOYc8q9FRdUJn5N6KmF2/VSbbcR52sOt+NoQ30rJqZ1bGx3TyqSL0tjIBOc2HEiKQvjLwZjlrFctxR",
-	"NujGln/HQFsD1wyk6+2H8m8uFCRa+FjabXBsQ4WNLLoUElRvjUsLXG/lmTd1aR2s9Uux0gx1AUXhAomE",
-	"JTXQyaAATv+c25D9zD73iUO+1utOC1NFr7ubDvioaKY6SAypfkbcbbk7IekyxibGue0frWLVcDjIpjek",
-	"kCIrU3tBhwejMsgNrjW1hZVE7TRpd5UtHSHI6jyHzZFVgny3Br+DIdBWcrKgB1UUWpt8UPObisE9Pwh4",
-	"n9JyNR4VQuRJj7PjtFvCp03x5yw9h4yYm8JHD/Z00CF30cZeebMvFhtfsqYogEN2b0LICbfx2t6x3awh",
-	"3Zqc39Hb5l/jrFlpq2o5o9rkHY8HvmK9K3lFbuaH2c7DFBhWd8Wp7CA7CsSse8oHSXoR6Sc1GaqVd13N",
-	"7R4/NVFZKGIySd2+ZkecTBUiU3f+qMNkutJBnouLBKkoqep/xXQO816TSfqKp/VnBttTCOJtqHIX6IYs",
-	"aEZSISWk4RfxFAcL1FJISHKB4Tcxz+BMG3loiXHNnORiTkRh1FxbRs/7UKJtaYK5DtWCx6brWggS6/Dp",
-	"KYgAyqXnOnDty114t3TB2b/DztkiYrfBDfO7tXcbHUdwe3e/CMAcQOi7bVYnsS5BzXW1+1X1dY/TYsnS",
-	"OLq/rGiV3hiTGPXGUOEK0NoEOHwND3jIUyrnJJ6eLpqB02ke89gQd/yckwbp3PwXb7D2uGQGjrn08LNI",
-	"Aua2Vcc6P0V2tZrKNabyOZU9FBJ1eG/3L9tugNOhXuaq4vRAZhAA0O93bsAwyPu8Lxgz7K6Z0AiSTyuZ",
-	"f9xofsxaHM9XA7QnO6VW518AMWOXElyOn20D2Oo7VFC98DKAeb2rmRstDxQm4NnmKVRZO5K3Z7kehG3h",
-	"ShRJDitouONd4mGZpqAUW0HYv9B+TDKAAq27bZ0j5mcOeXtLEHVrTwJP5RDsRiVTi1i7U2SH2BkVktc8",
-	"scdEDT1KBqIVy0rawJ+6Qie3viZukcvHw/p+GKfYm0nEF7eNReyMDEGaj55LHg8MCfNeK5MSzpZVpmdL",
-	"hPXJVgW94P0qWJcoa9lpeA/EALEv1pDiPdSMfLg6TggORlQrp71XaJLVDl9Wle+lsm1E1ukIGZXaFPiO",
-	"vmH5GS/4um8j0q41OjIVGYCpmjdgHCXUcXrBa0u6IRmbzUBat4rSlGdUZuHrjJMUpKbM6JgbdXkFw0Ar",
-	"Sxjv1DEMp8ZBPbOKaRtoIbSA5BunvPXJ/wPkdvShRWR2e21r0dessrMr8cQOujZ6Dka49RCBS0lHLcce",
-	"VsFRxCRLeg57zqPYH7B9GiwU46ywWuCsQ6b4uJXWf0LU4YH/mTO9ldqt6NcOObQ+IUuMngb5vHZM283p",
-	"0mAsSvTMtkwKI0XbHQj8XlsDlZ0PeioqOt6ZIE9VW1y+oIJeSakz2XXFgQ4ztsCMXQTtXtJC29yQ7mBK",
-	"URbdcyaasrqYIXXiptiLCeMGKnY8bke0NK+gatux+2daShSiLuhmd2G2+hqKBwPbkb0642McKqjdVlsC",
-	"U7ahRLTu2T7iSYTmYz0VuhWnDr8YG+Ve++GubznO0h5fQNihfTu91YK8J5UIrVG+iR0db0u+xAL7pJMB",
-	"cZoH26rqtFzHBkVZ9OUKkQ4CrRuzF8Fm0Dl4exhFWKe4ToCWNvQT3a5eH2rzix9rPWlYD2P/wQ7wwuia",
-	"oIuxd3Q4cD5xJvGPFVKCpbzvo4TG8ncF7LgF1oplsEVOVtMabNV4m33W3JcgGks9q4Kc+hput2OhsCix",
-	"EQ7yPBJDZcVH2+I2IBxzT8oVzW8+DgqrVZ8gPiB70+85DQNpQiRbVKrLpfG9pIPmDoJmDjc1f41xW/8A",
-	"s0fRa8EN5TTWDvNH4Z/m1so/8/0uV8DJBY5pg74ffkWmrsxJISFlqq0JX/hWVFXcCHZmdKmTa70jUGXX",
-	"On8R+gpkPPOGJfKqbmuDhuw5ryGsj+gnZio9JzdK5THq65BFBH8xHhXWG91xXZw3osFrqS640YSEA0eF",
-	"B/lde0aFdyupDl2ejXw2l06poLvOwbd1A7eRi7pe29CUhi5yt/U+GZKJEG9pZD7HVAiLEOwHRhBU8vvD",
-	"34mEGTb8FeT+fZzg/v2xe/X3R83H5jjfvx9V8m4sCcLiyI3h5o1RzC99afE29bunAkNrP0qWZ7sIo1FP",
-	"o26ZjRUjfnNVez5J0+7fbGBm96i6xqlXiCa3iImstTF5MFVQKWNAkQz3WaQkBgY9pKVkeoPFhL3Gy36L",
-	"pmt8X4X+utDxyoTn7j4tzqEqR10HCpfK367fC5rjfWQti9zcQiKfkBdruixycAflmzvT/4THf3uSPXj8",
-	"8D+nf3vw9EEKT55+/eAB/foJffj144fw6G9PnzyAh7Ovvp4+yh49eTR98ujJV0+/Th8/eTh98tXX/3nH",
-	"8CEDsgV05EvXjf4HO9snJ69PkzMDbI0TWrAfYGOb6Boy9u15aYonEZaU5aNj/9P/40/YJBXLenj/68hV",
-	"xhottC7U8dHRxcXFJPzkaI6RgYkWZbo48vN0+veevD6tXJDW6I87aotKeGeOJ4UTfPbmxdszcvL6dFIT",
-	"zOh49GDyYPLQjC8K4LRgo+PRY/wJT88C9/3IEdvo+MPH8ehoATTHQHrzxxK0ZKl/JIFmG/d/dUHnc5AT",
-	"17PY/LR6dOTFiqMPLkLyo5khavK09VSCIhrdVr4u2hotN7ZeSqM1nnKd2sZVw0TnW+IZlrmwQYeGzVWI",
-	"O83qzkCnNdPy9ZFtw4jjXyNZK95B7cv2NtopO2c2U+S/3/70ighJnHrzmqbnlXOenM5srUspVgyrJ2RB",
-	"yQ3z5cTT779LkJuavhznC5sh+P53zsu/VPOimcBdS1UxI0msbTLObMgiIOwqnrlmXGhFD5vNV2zYsNYH",
-	"ydfvPzz928fRAEAwuF4Blsr8neb57+SCYfdddCf5YtOumOg40usNpelxHR+LH9Q7OUYDTvU0bOdbvdOs",
-	"e/I7Fxx+79sGB1h0H2iemxcFh9gevMdijkgseOYePXhwsD7gVakf65SvRvEkcYmBugzJPqr6iV9IWtiz",
-	"6NuBY1SYM6zal7D7+ZMDLrSZqHvl5baH6yz6W5phi1VQ2i7l4Re7lFOO+S3mgiD2Avw4Hj39gvfmlBue",
-	"Q3OCbwaVkrsXzc/8nIsL7t80wk+5XFK5QdEm6APdKiNG5wq9Gcgi7dludH4dvf/Ye+sdhY0tjz40UiSy",
-	"K92JnZ6+p893XJN3VB/n7PYZafXNNM+rtojoGnLNQbFRo7o3Id+HXyP3xrKdtihmKTlkPsPB33pVHXJf",
-	"3byG7Y4KK5pGL+3AXHx7f3/q+/ukaexo9LKIAdM4BVth6jh+r3qBdiNjglSIPYrgBR24ml3bL9EH7Frb",
-	"M7d0TTvT+5gquJNR3+KuB3d9YlIAbyUxNTuPXj9r9hn11U3SuDKukXF/4ULfjzQ3dBIst1W5zjZ4uRUG",
-	"/zLCYJV5O7fSmetpdjXxELsbH33wTXsOIBK6pkUDhMFQrQ6+DQLz7rbYyb2J7cATvnM5nuFSbXeKedhK",
-	"6VbA+wwEvG6bshgYdfOpTyfUIQyLuo/ZzpZpvgNZKI34/nCD+619oVLcXxhZvWKbgXS3wHYJ9tkRxhyz",
-	"vja2+qcUwhzSbsWvv7T4VRXAuJIA1mg06EqqBG6sK1nv2tY5pitJrFkEJeBsmEFjGIo7wuM6ONiwGBtd",
-	"6+Jq1dhrhuhOtUqj3axxR2/siljfQ6igfrs5fb5LuvqC7DyDexlEboH43lw3L426Hd7cjNthGG968uDJ",
-	"zUEQ7sIrocl3eItfM4e8VpYWJ6t9Wdg2jnQ0tZ2ctnEl3mJLyCjqDk0Bj6qqP42D5+ZtG6VxF1ORmtUv",
-	"702I7xulqi6YLo93Lgyj8gkYVM7tR4bXGWSQO/7PYxz/zoR8hwkrWo0x2Ey7FonkDuP6+OGjx0/cK5Je",
-	"2Fiu9nvTr54cn3zzjXut7hJm9ZzO60rL4wXkuXAfuDuiO655cPw///zfyWRyZydbFetvN69sufzPhbeO",
-	"Y4nyFQH07dYXvkkxbd03vtqFuhtx338r1tFbQKxvb6FPdgsZ7P8pbp9pk4ycIlpZMhsF9Q54G9ljss99",
-	"NPYdsQzfqS6TCXklXG3TMqeSCJmBdG2D5yWVlGuAbOIpFWsMKFvLMc0Z5lhKgo1QZaJYBnWpkSrDuZCw",
-	"whh5nB51+gYEuxk9RtJ+tkz+R7oO6h1Oq2taC7dkNHsu6dq3YsZmo0LiT998Qx6Ma+0lz80ASYWYGHNd",
-	"0vXoBq1+FbENij9vdjLcGaCLYw+xINXST1UyIWyb9tfm3F+s5G7J3W3sgTjn3o6f2rET2hFcBdGtFgQr",
-	"2NlGzdg5eFMXXzFSnheh4izOzDDUOPAZ+wh2mqajSmgbvbeH+NYIcCVW0iaoPdkGZp2qow+ol4c8o3Nu",
-	"MWvur+UuDXxHUiy980iQGeh04RJ2W6iPsCffR7GfNy0ZZ0sD5YPxtUs1uIvdwkRhA4eM2jT5ITVCg1xK",
-	"dOCBjBDxT76lkXnMZraemK86eObq3qNrivke7VV7djsTcdWAtKjyes0u7gXls3ryrkCGaDmE//MWwfsh",
-	"uMMcX/g+3Ygxt4g/Q8S/VyUT8krUaeOuReSf0fV4nTf7dS/oleBgfexG8rW0eOtOrcQOwzgsUny9EKu/",
-	"VM26Li2CHPmO81vlkL/bfu9bZZEht7eZ7Iu8wv/usLTlljFrm+wshlCPNoQ5mxdtocJm+6hPqMV8En76",
-	"Gao2n4Jj3QyLwUPq+YwTC/hhmQ6W4LHEfFR1DurjQPFmbIO5kRZVGFq0f9oUcsHn6vNkRVvb4kXxEqGS",
-	"qk1dvBfdX+/sPsPqPkbltRGQrt6TYjwFosQSbDNYpsiSKeWCJZ88+NvNQajZ0rff4GHu6ifmLk8fPL65",
-	"6d+CXLEUyBksCyGpZPmG/MzpirIc68Vfgdthp72q/pq3BkebK6K3qVkXLA2LGF2eCTZC1z7oNcs+7maG",
-	"Qd3BPfkg4wEfDCvQ0qIAKi/PAHe7rto9JU6fh9HBjQZwVUWtCCgGRXsGyP/HaKDdCdPexcxdfiW3gPrq",
-	"X45NuNBdMRtXwTFGChCzY/KO3ydqQZ8+fPTbo6df+T8fPf2qx3Jm5nFFe7q2s3og89gOM8SA9kWbAw8r",
-	"tVf4Pb7p3d5vE8cjlq2jLaLqpq+dHgdOLLujSEE3vX3kih1Na8Nh6wa2N1/sUGk2XUT1K6/+VL1TTvm3",
-	"lRZsK/K5Xq+3zWp7kicCPmMIre5aW2F9ewPbLdJkiyyrTqE3rZzWSQb2ovPIk60755MKuvpTKakJ6qjA",
-	"vWDTRMunkymxjdk4cHcXUmiRitzGrpRFIaSuTreaDBL3oM9t15D2+gh3L2EupTpdlMXRB/wPVvj6WCce",
-	"YO1jdaTX/AgL/B992BoigCBG+qhbuTTaQaerJg9o474rBKB1YsbtQ2SbFWAsQUQ+ux7p7C8t1OzXFv+q",
-	"Ju3IiJ0DXOXVBQX6K9oNCn/7VDnbcCFCwrcumM9rQbVRZMZ4RmiwjS3dTciaEVyzYeS6F/0p7Cw373d6",
-	"+gWfs1dCk9NlYfujQXa16B3S5nD+9th63e4nGLirvxvi073zwxvfByZW1vWdF/weDrkgFRv8dFRibrS5",
-	"q6/H9n17k3/eN/kzX3K4QYa39/KXcy9LH055ewV//lfw4y92NdfoiBl4Jfub6NLXcK2J73khR1pho8mg",
-	"5Qrf5qdB1bu9SvWdkL69xe0t/oU6GexODk5aGmKh2ZXK5KY8ROjsZwX9MDtDnkcsDX0HdWx7/egFMCw6",
-	"I1KG9cNPMzW2h9gZJ9wpvhV8PmvBJ9jrW7nn1vTwhZkeeqQcp/U3e3L3CRr7CkCrpcjAR52I2cwVeeuT",
-	"fpq9Zwx5Kk2XBbFfRqUc9MaesSW8NW/+ZKc46BVbg90Si1rgGWQpSAXP1ACvqBv1svcQunH7AbhxD2i1",
-	"Ax4Wl/49uTTJvglqyHQogbSRr7BnkC9255CRwYosXZflq5Lt0Qf7L5rTCqFiTfY9AXc25q7bFlu9z47b",
-	"AJC8RiHUNSN2X4kZeWCL+JUcM3Xq5oCUZ0TLjRFUfc0SCTQnaSNCv4Kje3Le9p6cnapAZ3U9a4rrAqI+",
-	"oYcMZ21lR/1w4wfgGeWO5LsI0oJQwmFONVuBj1uf3GbUX/o2c/nsWxjgmNAss6ex3gRYgdwQVU6VkXV4",
-	"M9Dyjmqelz0YBqwLkMxc0TSvHfBWTTiy6fLbAirf2jeueGm1eJFN0pfNKCB/s7oUfjEjP7JUipN8LpSP",
-	"61IbpWHZab3nPv2tp+iqNyR0Y8AEzxmHZCl4rCHcT/j0R3wY+xpLDvR9fGYe9n3bum+b8LfAas4z5E6+",
-	"Kn4/k9N/pVyN1molFEIa7XZqm9Ra+t/zKPlDs+Fp9yRteBo4tdzDYKCwfVzj56MPjT9dsQz3plqUOhMX",
-	"wbeo2dugnyF58kGj6ktY0loNn9X12tKu04cU4CF2YqqnkdZfQTvy3u5ff9H8EOdyCYnEtehfgVQt9ew2",
-	"SeRPlSQyeN/34rG21eUujlaqw0okr0QGdtxmp9lYfWYuMnAdObuCSBXsGA+s97dS/V4r1Dml5XyhSVkQ",
-	"LWJB1fWHCU0tk02sehOfMKiIZpUgnG5BV0Bojn1OyRSAEzE1i67vR1wkVViTzkdmu5DOqCgUwFVIkYJS",
-	"kCW+HvUu0Ko+pxjHrbfgCQFHgKtZiBJkRuWVgT1f7YSz6hOuyN0ffjEK843Da0XB7Yi1lbAi6K2qbThp",
-	"rwv1sOm3EVx78pDsqATiRQNMJBHLIgeXShJB4V446d2/NkSdXbw6WjDXgl0zxftJrkZAFajXTO9XhbYs",
-	"EnN/d0F8Zp+esSVKYpxy4e2KscFyqnSyiy2bl8K1KLOCgBPGODEO3KNwvqRKv3FZhRlWoLHXCc5jZWwz",
-	"RT/Aq75+9GbkX6pu9J2xU3MfclWqqmW9yxSALLYGDustc72CdTUXpnX6satUBGvh2zVyH5aC8R2ygqLc",
-	"hOrAm2+GiywO7Y/UGSi6qGwAUSNiGyBv/VsBdkM3fg8gTNWItoSDRUZDypkKkQPlNqNLFIXhFjopefVd",
-	"H5re2rdP9M/1u13iorq+tzMBKkwTcZBfWMwqNNAuqCIODrKk5y6TZO6aLHVhNocxwQzwZBvlo8nWvBUe",
-	"gZ2HtCzmkmaQZJDTiCnlZ/uY2MfbBsAd9+SZrISGZAozISG+6TUly14TUTW0wPFUTHgk+ISk5gga5bkm",
-	"EPf1jpEzwLFjzMnR0Z1qKJwrukV+PFy23eoes5QZw+y4owcE2XH0IQD34KEa+vKowI+T2nzQnuKfoNwE",
-	"lRyx/yQbUH1LqMffawFtc154gTVuihZ7b3HgKNvsZWM7+EjfkY0ZEL9IY387dukaq780DaiBAji5jHJ7",
-	"dEGZTmZCWkE6oTMNcmdA/D8o8+5w5xrQwtUmIDiCuzfdOMjkw1YXjotYEIi7LgyJdP1vZqrvhBxUYrNZ",
-	"SIYyTUquWR6UGa9U5c/PYHhrBLg1AtwaAW6NALdGgFsjwK0R4NYIcGsEuDUC3BoBbo0Af10jwKcqmpt4",
-	"icOXEuOCJ+2oRHIblfinKjJZ3VXeKIFmjAvKtOua6fP93ZOr1djVQHPEAcuhP07ahm+evTh5SZQoZQok",
-	"NRAyToqcGt0A1rrq4dbsDur7FttGkLbxKFXw+BF5+/cTXwtv4Wq2Nd+9e+L6fyu9yeGe65IAPLOiqG+X",
-	"ANwg3XVLoP5O8L3eXOc7lmOMuSIv8O3nsIJcFCBtmS2iZRkx+ZwBzZ853Oyw+PzDTO6CVn83o/0+bhia",
-	"HNqWtPByvl8rVYTa3EXyPMhm/H1GcwW/9yU02vGWtIi1W6tuPmsLQm7yrcg2rRNidu0IN7B5NuqKeIxT",
-	"uYnUW+omE7RJQwvDrxxhdY1ZHw9et7FLtF0y20VhMXFdgoqe421UHi1YWG1YZyib8jpr0ckolq3ZrtI3",
-	"qgAcEgJ7hgkHdk/IG/vdp60KjxC5I1Yz888mcrD5ZsU08F2jRTjW86VG5XvER08vnv2xIeysTIEwrYgv",
-	"/bj7ehmP1okZaQ48cQwomYpskzTY16hxC2VMUaVgOd19E4X80zUYdpePebL9nvo018jzYHHbeHJINOvE",
-	"MeAe7rzRMJg3V9jCER17DjB+3Sy6j42GIBDHn2JWpRbv25fp1dNsbhnfLeMLTmNLImDclcptM5HJNTI+",
-	"uZEl7+d5L9aQlga48CTfRfM8+uRgrRuOzQym5XyOjZI7TjqzNMDxmOCfiBXa5Q7lgvtRkB28ap551XTv",
-	"9nBd7hJkYN/1NQ7v4XZQvkFvxrKgfON9vpAotixzi0PbY+6wjNZWs+1GAqA/1hn/+szar73NLzDeuqu2",
-	"+btFC7mgitj9hYyUPHO5Q52a12s+vGKIHfpszWs2vbU6iF1vZHVu3iFXhN/lZtK2IgXIRK+5PVDNTuq2",
-	"trY9uZPbBrF/jWvDpnxDD4Pt1omuGcKBbg8Z8DW8PoJuIHUyXKNHCFot+lNHwtYg9s2DRo90hm8GkdQm",
-	"Feckhbwg1HfvTwVXWpapfscpOmmChU26ASbeGt3P3575V+J+wogbzw31jlNs7l65bqJ8bgYRP8V3AJ6N",
-	"qnI+B2V4ZUgkM4B33L3FOCm50bTEjCxZKkViE1HNGTLyycS+uaQbMsP6H4L8AVKQqbnZg123BmOlWZ67",
-	"iBYzDRGzd5xqkgNVmvzIDJc1w/niA1UoF+gLIc8rLMQ7RcyBg2IqiRtfvrdPsRmDW7438qHB0j6ui6jf",
-	"bBcGDzvLeiE/fW7gpli7OGdK10EQHdhvzAG+ZDyJEtnZAoiLCWvTFrmLFdMcAd1reof0At5xc8NpQZCr",
-	"U305cmi7eTpn0Z6OFtU0NqLlDfJrHaTiHYTLkAiTuXWt/IlSMwM68O5L3Hhbjb6193u6URpXLvDMPO25",
-	"kO1T17yr5yWnJDQMYa1yMO6NswbIf97G7++vR1/0aDyYxtgdsMuumu2ZEG9+w8eE5oLPbRVCo0EK3CfG",
-	"i1JjYPV1GulgRfNErEBKloEauFIm+IsVzX+qPvs4HsEa0kRLmkJirQZDsXZmvrF0uusiDZrULZeQMaoh",
-	"35BCQgqZrbfFFKmV7YmtWEDSBeVzvHOlKOcL+5od5wIkVP28jH7bHiJe72TNE1t7rQvjCbGGyrA8LdB0",
-	"EemPgjeTUag9JdhyEkNU5ggrwMqafRr0eNQrIRukrurANoucJn8YcP03LvIAP/XEhyhFekutt9T6yag1",
-	"VvIPUTdr2QAsvsJtuWZj0XUXuLxB29MnqX57W0L+z15C3nMgRSiRtCH1x3uXUUWYJhdY4GcKxFw8Jdq8",
-	"XYtzpyFPiGFIgX3fVoJUrvNmuqCMu+owVboAwqFdd2Dt2xFei7nQMjO0Exp0QFpKpjeoJ9CC/XYO5v/v",
-	"jaCtQK68ClHKfHQ8WmhdHB8d5SKl+UIofTT6OA6fqdbD9xX8H7z0X0i2MhrNx/cf//8AAAD//ycflPTr",
-	"fAEA",
+	"H4sIAAAAAAAC/+y9+3fbtrIw+q9g6fvWyuMT5bzas+uzur7rJm23T5M0K3a7zz5NbgORkIRtCuAGQFtq",
+	"rv/3u2YAkCAJSpQtO0nrnxKLeAwGg8Fgnh9HqVwWUjBh9Ojw46igii6ZYQr/omkqS2ESnsFfGdOp4oXh",
+	"UowO/TeijeJiPhqPOPxaULMYjUeCLtnoMOw/Hin275Irlo0OjSrZeKTTBVtSGNisC2hdjbRK5jJxQxzZ",
+	"IY5fjC43fKBZppjWXSh/FvmacJHmZcaIUVRomsInTS64WRCz4Jq4zoQLIgUjckbMotGYzDjLMz3xi/x3",
+	"ydQ6WKWbvH9JlzWIiZI568L5XC6nXDAPFauAqjaEGEkyNsNGC2oIzACw+oZGEs2oShdkJtUWUC0QIbxM",
+	"lMvR4W8jzUTGFO5Wyvg5/nemGPuDJYaqOTOj9+PY4maGqcTwZWRpxw77iukyN5pgW1zjnJ8zQaDXhLwq",
+	"tSFTRqggb394Tp4+ffoNLGRJjWGZI7LeVdWzh2uy3UeHo4wa5j93aY3mc6moyJKq/dsfnuP8J26BQ1tR",
+	"rVn8sBzBF3L8om8BvmOEhLgwbI770KB+6BE5FPXPUzaTig3cE9t4r5sSzv9JdyWlJl0UkgsT2ReCX4n9",
+	"HOVhQfdNPKwCoNG+AEwpGPS3R8k37z8+Hj9+dPm/fjtK/sf9+dXTy4HLf16NuwUD0YZpqRQT6TqZK0bx",
+	"tCyo6OLjraMHvZBlnpEFPcfNp0tk9a4vgb6WdZ7TvAQ64amSR/lcakIdGWVsRsvcED8xKUXOtMbRHLUT",
+	"rkmh5DnPWDYmXJCLBU8XJKXaDoHtyAXPc6DBUrOsj9biq9twmC5DlABcV8IHLujzRUa9ri2YYCvkBkma",
+	"S80SI7dcT/7GoSIj4YVS31V6t8uKnC4Ywcnhg71sEXcCaDrP18TgvmaEakKJv5rGhM/IWpbkAjcn52fY",
+	"360GsLYkgDTcnMY9Coe3D30dZESQN5UyZ1Qg8vy566JMzPi8VEyTiwUzC3fnKaYLKTQjcvovlhrY9v86",
+	"+fk1kYq8YlrTOXtD0zPCRCozlk3I8YwIaQLScLSEOISefetwcMUu+X9pCTSx1POCpmfxGz3nSx5Z1Su6",
+	"4stySUS5nDIFW+qvECOJYqZUog8gO+IWUlzSVXfSU1WKFPe/nrYhywG1cV3kdI0IW9LVt4/GDhxNaJ6T",
+	"gomMizkxK9Erx8Hc28FLlCxFNkDMMbCnwcWqC5byGWcZqUbZAImbZhs8XOwGTy18BeBwsQUcLoaBI9gq",
+	"QjNwuuELKeicBSQzIb845oZfjTxjoiJ0Ml3jp0Kxcy5LXXXqgRGn3iyBC2lYUig24xEaO3Ho0IQS28Zx",
+	"4KWTgVIpDOWCZYQLC7Q0zDKrXpiCCTe/d7q3+JRq9vWz0eW2rwN3fybbu75xxwftNjZK7JGMXJ3w1R3Y",
+	"uGTV6D/gfRjOrfk8sT93NpLPT+G2mfEcb6J/wf55NJQamUADEf5u0nwuqCkVO3wnHsJfJCEnhoqMqgx+",
+	"WdqfXpW54Sd8Dj/l9qeXcs7TEz7vQWYFa/TBhd2W9h8YL86OzSr6rngp5VlZhAtKGw/X6Zocv+jbZDvm",
+	"roR5VL12w4fH6co/RnbtYVbVRvYA2Yu7gkLDM7ZWDKCl6Qz/Wc2QnuhM/QH/FEUOvU0xi6EW6Nhdyag+",
+	"cGqFo6LIeUoBiW/dZ/gKTIDZhwStWxzghXr4MQCxULJgynA7KC2KJJcpzRNtqMGR/rdis9Hh6H8d1PqX",
+	"A9tdHwSTv4ReJ9gJRFYrBiW0KHYY4w2IPnoDswAGjZ+QTVi2h0ITF3YTgZQ4sOCcnVNhJqNx7EzWB/g3",
+	"N1ONbyvtWHy3nmC9CCe24ZRpKwHbhvc0CVBPEK0E0YoC6TyX0+qH+0dFUWMQvx8VhcUHSo+Mo2DGVlwb",
+	"/QCXT+uTFM5z/GJCfgzHRlFcgnppypyoAXfDzN1a7hardEtuDfWI9zTB7QRlzeW4QoPWzOyD4vBZsZA5",
+	"SD1baQUa/921DckMfh/U+csgsRC3/cQFrYjDnH3j4C/B4+Z+i3K6hOPUPRNy1O57NbKBUeIEcyVa2bif",
+	"dtwNeKxQeKFoYQF0X+xdygU+0mwjC+s1uelARheFuf4c0hpCdeWztvU8RCGBD20YvstlevZ3qhd7OPNT",
+	"P1b3+OE0ZMFoxhRZUL2YjGJSRni86tGGHDFoiA98Mg2mmlRL3Nfytiwto4ZORm1442KJRT32Q6bHVOTt",
+	"8jP+h+YEPsPZpsY/3UFtwfGIysDIkMFr3z4Q7EzQADbeSLK0D3wCr+6doHxeTx7fp0F79L3VKbgdcovA",
+	"HZKrvR+D7+QqBsN3ctU5AnLF9D7oQ67sf7hhSz0AvhcOMon779BHlaLrLpJx7CFIhgWC6KrxNIjwxodZ",
+	"auXs0VSqq3GfFlsRpFY5EwqjBsx33EISNi2LxJFiRG1lG7QGqq18m5lGe/gYxhpYODH0BrCgDQ2AvwYW",
+	"mgPtGwtyWfCc7YH0F1GmD0qCp0/Iyd+Pvnr85PcnX30NJFkoOVd0SaZrwzS5795mRJt1zh50VzYe2adz",
+	"fPSvn3lFZXPc2DhaliplS1p0h7IKUCsC2WYE2nWx1kQzrroCcMjhPGXAyS3aidXt46EE9Atdanwm7J0V",
+	"NoePigZEC1rohTQeDXSuGFsyS8sG8JEueG2cFjKzktULrqnWbDndCx317XVWz5IRh8SMbT0Hu+5MPc06",
+	"2J0Xaq3KfbzCmVJSRVSDyB2MTGWenDOluYwYgt64FsS18JJ50f7dQksuqCYwN2qtS4GyUORQgDp68JVl",
+	"hz5diRo3Gy8tu97I6ty8Q/aliXyvBNWkACPbSpCMTct54xE3U3JJKMmwI9Loj8ygFHPKl+zE0GXx82y2",
+	"n1euxIEir02+ZBpmIrYF4YJolkphnTi2PCzdqEPQ00aM1y6afgAcRk7WIkUV6T6Obf+be8kF2mv0WqTB",
+	"AxxgzFk2Z2oAPoY/tPvQYae6pyPgADpe4mfkji9YbugPUp3WSswflSyLvTPl9pxDl0PdYhxfzqCvf/5z",
+	"Mc+bjkNzgH0SW+MnWdBzf3zdGhB6pMiXfL4wwYvojZJytn8YY7PEAMUP9j2ZQ5/uq/K1zICZmFLvQXqs",
+	"B6s5HNBtyNfoVJaGULx6cfNLHZcre1xN0MaNpnkTiqpmYZ+IUwbUldISVgsqfRm7L+qOCU3tCU0QNTo+",
+	"YW0vta3sdNaNIVeMZqCGYoLIqbNtOasbLpKi1bwSSZxUG+EXDbgKJVOmNagPrVJoK2i+nb06zAY8IeAI",
+	"cDUL0ZLMqLo2sGfnW+E8Y+sEfTw0uf/Tr/rBJ4DXSEPzLYjFNjH0VhoKLnqgHjb9JoJrTx6SHVWM+HuF",
+	"GImCeM4M60PhTjjp3b82RJ1dvD5azplCU+KNUryf5HoEVIF6w/R+XWjLosdz0b3MQcKDDRNUSC9YxQbL",
+	"qTbJNrYMjcK1aFhBwAljnBgH7hG8XlJtrPmbiwy1dvY6wXmwD07RD3DvMwRG/tW/QLpjp/6lWT1HdFkU",
+	"UhmWxdYAPhP9c71mq2ouOQvGrt48RpJSs20j92EpGN8hy67EIoiaykrk/EO6i0NbCtzz6ygqG0DUiNgE",
+	"yIlvFWA39N7qAYTrGtGWcLhuUU7lMjYeaSOLAriFSUpR9etD04ltfWR+qdt2iYua+t7OJNPoNObaO8gv",
+	"LGat396CauLgIEt6BrIHanCsnb4LMxzGRHORsmQT5eMTD1qFR2DrIS2LuaIZSzKW03V30F/sZ2I/bxoA",
+	"d7x+7krDEuuAFd/0mpK9v8uGoSWOF2GaryXBLySFIwhPgZpAXO8tI2cMx44xJ0dH96qhcK7oFvnxcNl2",
+	"qyMj4m14Lg3suG1kQXYcfQjAPXiohr46KrBzUr8921P8k2k3gW9zhUnWTPctoR5/pwX0qH+db3twXlrs",
+	"vcWBo2yzl41t4SN9R7ZHF/2GKsNTXuBb5ye23vvTrz1BXA2aMUM5KBmDD/YZWIT9iXUdao95tafgIN1b",
+	"F/yO8i2ynJxrFHmawJ+xNb6531if1EDVsY+3bGRUwq2rOQDqPd1ABA+bsBVNTb4mFC/hNblgihFdTpfc",
+	"GOtr3nzqGlkk4QBRk8yGGZ390fpz+h0YYhA9waGC5XW3Yjyyb4LN8J22HgYNdLi3QCFlPkBD1kFGFIJB",
+	"riqkkLDr3Lm9e8dnT0kNIB3TztceXHdVhGjGFZB/ypKkVOCTqzSskmmkQkEB+uIMXAdzOqeUGkMsR5NE",
+	"hZ2HD9sLf/jQ7TnXZMYufKzIw4dddDx8iHqcN1KbxuHagz4Ujttx5PpAWxVcfO4V0uYp250i3MhDdvJN",
+	"a3A/KZ4prR3hwvKvzQBaJ3M1ZO0hjQxzCDGrgSsP1hNdN+77CV+W+VWtbc0Fs3OaJ/KcKcUztpWTu4m5",
+	"FN+f0/znqhvGwbAUaDRlSYrRGwPHYqfQxwZ8bHsb1o5wfLlkGaeG5WtSKJayzKrLuSa6gnFCrOtiuqBi",
+	"jpK+kuXc+c7ZcZBTQ0AQhmCUojNEVBoyK5GgdjrGuZ2/tI9RATmIUXiLtVXb9uVxQav5WNZg6AOR11b1",
+	"R61b41HvUxWQel4/VS1ymoE2A7h4Q1AL8FNPPNAGgqgDoaWLr3Bb4BTA5t6Mrr0eOgZld+LAm6/+2OfQ",
+	"B+/kfL0HacUORBQrFNN4t4T6JW2/ylkYVOcuH73Whi27Knjb9fee4/e296EnRc4FS5ZSsHU0jpwL9go/",
+	"xnrb+62nM0oafX3bj4cG/C2wmvMMocbr4hd3u31C26Ym/YNU+7Jl2gEHy+UDTIdb7eRuyqsaOCG8rGsT",
+	"dCE3bQagx1WIP1eEai1TjsLWcabH9qA5M6KLz2mi/03lSLyHs9cet2X8CqM5UbnL8oJQkuYcVb9SaKPK",
+	"1LwTFJVLwVIjDlf+Fd2vbqy8ZOL6zYj60Q31TlB0tqtUTlFPixmL6Fd+YMxrHXU5nzNtWo+UGWPvhGvF",
+	"BSkFNzjXEo5LYs9LwRR6PU1syyVdkxnQhJHkD6YkmZamKbZjRJk2oLy0ljiYhsjZO0ENyRnVhrzi4OcB",
+	"w3lrvT+ygpkLqc4qLMRv9zkTTHOdxB3DfrRf0WfXLX/h/Hfh/66ztd3A+HXY2dqwRlT7/3v//x5CNDtN",
+	"/niUfPN/Dt5/fHb54GHnxyeX3377/zV/enr57YP/+79jO+Vh51kv5Mcv3JP2+AW+W2rjTQf2W1PcQ5Bk",
+	"lMhCN4wWbZH7GNvrCOhBU6tlFuydAB8bIyG0nGfUXI0c2jdM5yza09GimsZGtLRYfq07vgauwWVIhMm0",
+	"WOOVpaiuL2U8shA20gcLQisyK4XdSi9928AZ7xgmZ+MqetQmljkkGFq4oN4h0/355KuvR+M6JLD6PhqP",
+	"3Nf3EUrm2SoW+JmxVeyR5w4IHox7mhR0rZmJcw+EPeoDZ50ywmGXDLQDesGL2+cU2vBpnMP5cASnLFqJ",
+	"Y2HjBOD8oG1y7Uwecnb7cBvFWMYKs4glnGgIatiq3k3GWv4iEDDExJjwCZu0lTUZvBedN17O6AwI1NrX",
+	"5JDXUHUOLKF5qgiwHi5kkEYkRj8o8jhufTkeuctf7/055AaOwdWeszJE+r+NJPd+/P6UHDiGqe8httzQ",
+	"QdRo5CltPzQ9iQyhLs2OFfLeiXfiBZtxweH74TuRUUMPplTzVB+UmqnvaE5FyiZzSQ59rNULaug70ZG0",
+	"ejNhBVFupCinOU9BER0jT5vdpDvCu3e/gTr23bv3HaeK7vPBTRXlL3aCBARhWZrE5WZIFLugKma00lVs",
+	"Po6MvTfOaoVsWVrNphufuPHjPI8WhW7H6HaXXxQ5LD8gQ+0iUGHLiDZSeVmEaw8N7u9r6S4GRS+8XqXU",
+	"TJMPS1r8xoV5T5J35aNHTxlpBK1+cFc+0OS6YIO1K70xxG2lCi7cPivZyiiaFHQes429e/ebYbTA3Ud5",
+	"eQlbAIIudgtxUgUD4FD1Ajw++jfAwrFz4B8u7sT28nm44kvAT7iF2AbEjdpif9X9CsJnr7xdrRDczi6V",
+	"ZpHA2Y6uSgOJ+52p0vPMKRfau1GABQYOgctkNAWVIkvPXIoZtizMetzoLmcNQdOzDq5t8iEb/IbpL9Cy",
+	"AEmJiow6UZyKdTsPgWbGeH/gt+yMrU9lnT1jl8QDzTh43XdQkVID6RKINTy2boz25jt3MICUFoUPJ8e4",
+	"Qk8WhxVd+D79B9mKvHs4xDGiaMRp9yGCqggisEMfCq6wUBjvWqQfWx68Mqb25oskIvK8n7gm9ePJeW6F",
+	"qzldVN8xqGau5IUmUwpyu3RJuGysd8DFSk3nrEdCDo07AyOqGwYhHGTbvRe96cCc3LzQOvdNFGTbOIE1",
+	"RymFwRcgFXzMtPz1/EzWfugsE5hb0yFsmqOYVDk2WqZDVcPIJuabQIsTMFOiFjg8GE2MhJLNgmqfHywb",
+	"B2d5kAxwg7kLNmWsOQ5czYJcaVU+Gs9z2+e087p0eWt8shqfoSZ8Wg7INjMeOe/22HZIgQJQxnI2twu3",
+	"jT2h1HkU6g0COH6ezXIuGEliXmuBGjS4ZtwcDOTjh4RYDTwZPEKMjAOw0S6OA5PXMjybYr4LkMLlgaB+",
+	"bLSoB3+zeNyX9eMGkUcWwMJ5j1Ur9RyAOlfH6v5qOdziMISLMQE2d05zJox/8dWDdBKnoNjaSpPiPDMe",
+	"9ImzGwwg9mLZaU3Y40qrCWUmD3RcoNsA8VSuEhuzGpV4p6sp0HvUtR16RQ+mTVFzT5OpXKG3D14t1pV6",
+	"Cyz9cHgwagAw9wisHfv13eYWmE3TbpamYlSoyf1KtqnJpU+cGDJ1jwTTRy73g6wzVwKgpeyoUzi7x+/W",
+	"R2pTPOle5vWtNq6zqfmoodjx7ztC0V3qwV9XC1PliXnTlliieopGq1aKnECEjBE94SJipOmagjTLGT4K",
+	"koYQlZyxdfxtw/DGOfHdAuUFJuKhYv0g8IRSbM61YbUS3ftJfAr1JMX8f1LO+ldnCjWD9b2VdfA3drTK",
+	"ycYyb30F6Eo84wp8VsECEV0CNPpB46P6B2gal5Uam01stlyexXkDTgvRJxnPyzi9unl/egHTvq5Yoi6n",
+	"yG+5sA4rU8zuHPXA3DC1ddLduOCXdsEv6d7WO+w0QFOYWAG5NOf4Qs5Fi/NuYgcRAowRR3fXelG6gUEG",
+	"kbNd7hjITYGNf7JJ+9o5TJkfe6vXjo/f7buj7EjRtdSAbl4FRzMRiCXcBMmRuyGtPWeAFgXPVi1dqB21",
+	"98VMd1J4+JRyLSzg7rrBtmAARdq3bMYUi6oQqk/WO7oSl8KUgnBWmll8Ipveq/xvqtJcu7rGQzDRFZRg",
+	"Lglk/x7XvpfhilpLiVQZ6M5acmG+ftbZi1rHD7AM2Y2TuGr9xEjFmogPnluIr22bwHse7kGnkD2HU3Ht",
+	"S2Z0ybaKgdxGuZDA5Ce2/hXa4nJGl+PR9RTZMcp3I27B9ZvqsEXxjI4SVrHZsEvtiHJagPmR5olT9/cx",
+	"CiXPHaPA5t46cMsXT5yyT78/evnGgQ8a1ZxRlVSCW++qsF3xxazKpo3sOSCOSeEL3L+grGAfbH6V6y40",
+	"EVwsmMttHrwNOklYa/NPPZ43Gczi/lpbeZ+zVNklbrBYsaIyWNXKVOzcslHRc8pzr8X00Pb4VuHihmXy",
+	"jXKFcIBr27oCk2WyV3bTOd3x01FT1xaehHP9jCmR4tKJcAmTkBU521WTBd3TjrIOcNUHoF6pbs+Bd/IP",
+	"UjWYv3Osj9q+3CAdxrj17ra3s8NUjzORr4jRFi0nBKmFfJh/gPP28GF4mB4+HJMPufsQgIC/T93vqA56",
+	"+DAKVvRdAWwAnw2CLtmDyg2wF9Vt/ma64d8Xw27No/MlrhY6yX7aqMjGWpY8hi7cgi8UdyjI3C+gfIWf",
+	"tke11LN29sxiawhZn/R5t1eOC0tbN0MTKdp+OhhYAdSAHBjcR6fMqV67dC3KJaorE53zNG7IEVMNPE9Y",
+	"Az00Jti458ULI5a8x99DlDwYC5oNSaDVAjKYI4pMHc3hVeNuKt2ZKwX/d8kIz5gw8EnhZdO6f7zEjqN2",
+	"pER4oHTncgNjn2D46zxkwqzYbUEOgdj8igndATrgvqj0cn6hldqbiobdcwevonDGDjfd4BHk6MNRs/WQ",
+	"XjTN+sMeF0Pqp3ne5NJz98wRrYfGdTJT8g8WVyahDi4SFekmwjcC9p5EYu/bN2elQq7LutWzb9vu4Q/W",
+	"vo2/9gPVL7pKPX6V12n8VO+2kVd5iep47r7xKDyScbjsR9J0N+thLXi8AgcLzPrsbY1U2PNkQwIbXsvx",
+	"Uxm00Ad2/PpUOpjbu5rm9GJK07P4AwVgCra3YRU1kvjOfgN0FTdnZyeBV1DVltu0IgVTdVR4N0XZFR8b",
+	"dtrBz4z6VQEdG++JsfXkyLWMDFOKCyoM81n9Lb9yvTWzZgzodSEVJgXScfEuYylf0jz+6sjSrrEu43Nu",
+	"q2SVmgVlmNxAtgKhpSJXyqqKBnWoOZ6RR+P6TPrdyPg513yaM2zx2LaYUo3XZZ1P1neB5TFhFhqbPxnQ",
+	"fFGKTLHMLLRFrJakehCikFe5IUyZuWBMkEfY7vE35D46YGh+zh4AFp0QNDp8/A2az+wfj2K3rKtytoll",
+	"Z8iz/+F4dpyO0QPFjgFM0o06ieZPsWVO+2+HDafJdh1ylrClu1C2n6UlFXTO4j5/yy0w2b64m2gSaeFF",
+	"ZLZGnzZKrgk38fmZocCfeuKIgP1ZMEgql0tuls5Mr+US6KmusWQn9cPZgn+Wp1dw+Y/o7VJ4Y39LAXW7",
+	"5i8rRMRWjT5Jr+mSNdE6JtRmgsp57Yfmi3aQY59oDusFVGUCLG5gLlg6ypKwhZirmwuDSonSzJK/wVtV",
+	"0RTY36QP3GT69bNIjYRmrm6xG+C3jnfFNFPncdSrHrL3MovrC5FVIllyYPUP6ri94FT2uuVEpzV9XiCb",
+	"hx4q+cIoSS+5lQ1yowGnvhbhiQ0DXpMUq/XsRI87r+zWKbNUcfKgJezQL29fOiljKVUse2x93J3EoZhR",
+	"nJ2zrHeTYMxr7oXKB+3CdaD/tDZkL3IGYpk/y9GHgFc6bYq+AhH+11eupm9H9u7xGMOf6z5b9WRx1SD2",
+	"b2q6Hn8gCh5/KEA+fIjzgMLLNv3wpPnZ8pWHD+Np0KK6Hvi1Bvw6TzHsG0M7VIQ5/NhTLqUyRbtgry7K",
+	"e7kjfIDTN3VDjUmzNMXtX1/7cSOOu4rECRc8Q+CLxwP+0UbEJz6luIG1M5xdSQ+hBKV5oiSTVd8DJzVK",
+	"vpOroYTTYn6eeD4DFPWgZKBeCFfSKT0UNd5u9R4IaBRGnbJcwuvGyChpXhfPm1ED8I43IKjkefZrnVui",
+	"xa4VFeki6pUzhY6/10VsK6gsd4stFExGguXR4ew76Hf/Xoq86P4lh86z5GJg23a1Krvc1uJqwJtgeqD8",
+	"hIBebnKYIMRqM2y/CgvL5zIjOE+dDbfmZ90qZ2G5nTdKFlLHJO4jUrhv7orTjIl2gu7KfS1SJy7J+Jzp",
+	"nnzP9luVBQ5n8nXR4jkj5EV0sH9UqYErXUhKlfKqQ9utXkqqWGZz+0XXUzDFZRZXTkjF51yANRYbxddl",
+	"v8GwdepkCxWWo3FD5GsHEetxR6znss226PIcGn0vP7iakO9B51GlrLCQgGOevwGZIEJatBOubc2jjJSF",
+	"FNFdKOg6lzRLfHzPpv1wiQ5qNaGdfUG1DTDwY8Sx7WfyaTeuNVU1SM9cXIhNE9BG6UEwD2KYBBwxlrUn",
+	"xdBLwqjKOVMbCUobOo8al+p5tZwZ3C9iFoppeGFbQpriEzs2+TBybptgW7Qdo8Bx81hXR7JeSIXJCKHE",
+	"dvT9EM70DwbVR3oSzgFmLrCBjVFAvZsNUKMt/nUrXOqOR4xHF1fcsGryMGgQY49kSo1Ug+pGXYWOHcAb",
+	"qbHHa/KKVePalJgxmuVcsHg296ovWxksayVLQ9iqYKlplosZkyWjukQHc18nwpZPdCMoa5tp5oOPU9cM",
+	"kw2xFJJ6rpMdAJyhP77reGvgCloUG3mpnxQvBV94x1I5F/bZW+VzWsHmscI+iDW5oBxjvPwJmck8lxfw",
+	"C7TquVI2nP8md24stjcZEDKynuisJp/TmwW1QfHgXfEwEhu+9Y1doRxTGltjmrQHhPTlRMWePXVHkNR0",
+	"EVgIG6sbRiiwZZu3Bbfes/z2BdoaaWdIodMwQA3Nc850Px/VFQf1oTYecvyjSV3XpINfpWGncCdtzeTo",
+	"dQsVA0aE1wc0xFtju8c1F+zlPuFRqDG0kXHXkA9DZJX80SOTBkTQ5NrB3ZQMvfTkDP9UPjy/GgNi5e1P",
+	"LnzfX4sM+WifXnALq2kSQYtdDToV/X0qiXT7qqmFnwjG4KKXRCGJ+qhmnIP8D1OScDDnnAl5IfrjtNTW",
+	"skkZRuWmxuO65oh+tujgFlq9u+iCq3Gyy4LPF0wbgiFTV+e4Tuzddtzax6zelApX4xih1ouNnR5fcvTf",
+	"ZVQ0dh9s5Dp0xqvRlhslTGToSDEhP2J+LSC8RiUEdGDwqaqbaVvLAl4IY0yhDQ70xM5q+yhmSuXKnc7R",
+	"ft9UckQdroansfX5w3ryMw0fZ3PCGFi1NklVnTSWARNa1PVTecs1Hi37IXYm5IV1qtDeZG8nIZhBHd+o",
+	"1XTOrIcqI/iPMTRdQAPZUE33a8SG1+n1Sqval4v6/6d1cSx8NgDcrlSvrdQ7JhKktgsOSbEX1AATbL5s",
+	"3SS16GmTcDaXp0ohLKVMdrASVKWwdkV745Kt3IyjkLUQv6Ot2lbo3rVs8Qn2ihFlpwZyyw/Yp3D0idzJ",
+	"K+dulFIhBU/xtRozcWCCwGGOiwOKisQ9DvXIndDI4YpWXq5yATgs9tZiHo8aiOs6AQdfYVMtddg/DT7W",
+	"FtSQOTPacTa45F3tc+cix4VmrtgZEFHIJ6WKRCbEjAhJ5VK9Ixlh7q8en4cf4Ntr5xEDR5CccYGShEOb",
+	"M5xZJzbIYwPULgg3ZC6ZdutpJkDVv0GfCeYCzdjq/eSlnPP0hM9xDBvtAsu2oV3doY58oJcLrIK2z6Gt",
+	"q9BQ/dyI6bCTHhWFm7S/Mn7UXABVCPoQHLGQJN61PEBuNX442gZy2xihifcpEBrU3LBSKtzDXenUl1pv",
+	"jgIVN0pLUdiC2Dj1qMo9+uB/yYUXteIXRBq9EnBjrNwU76dTRU26aLChbXFdVeBKm6Fp47xyrztUa4P9",
+	"KyQd+Tn6t7GuEt/DOKoGtV2HijXxhwKoOxAmntO8inCM1HxHqcoJURk1dd5ZXwU+xjiAcSdLprWP3muX",
+	"gWr7STRkItsdi7XsehP1ZcKcltmcGciyGNPHf4dfCX4lWalQQbhiaVnVKCsKAkC1M+F3qc1NlEqhy+WG",
+	"uXyDa06XcU21Zstp7B36ovrIsmqHgdJAWQb/xgp09e+Mi23cOdeBD2TMdiv/0M3dEJN6gaYTyL82HBN4",
+	"p1wfHfXUVyP0uv9eKT2X8yYgt5z/ehOXC/coxt++h4sjTA/dCSO1V0uVvRlDNiV+9wnPqryjLU04tUTb",
+	"mdNtXmTLWsD7hlHAz2nek18k9Duz96tVWfRlGUl7k+JQ49LzGUo2sqDelGc2erDlydZ1KuyLGLQBg/tz",
+	"J3Nr3YhQH2HdBegnn76BFJS7qJGaWXQx68Jju4mQhgSz1hvcXoRLZtPr8fTTeV/iGa8QxO9h1Rnn1z92",
+	"xkF2zmXpNqyKivRPQvvrDNMSNqvL9Kw/Gh78qd3Jep3fTl15Y7tM9yb/6VcbQ0uYMGr9GbjCdTa9Xboo",
+	"Iu1ii4Bg3RO441TT86ht3IpDKiXFivI42dDryixradBSp8hRh6xeDBEHOvi4HI+Os50uzFhhp5EdJXbs",
+	"XoISEutC/J3RjKk3W+pe1LUu8IgVUvO6QG0OgzlD9QKHmwwNPwYC5mHdju5Y3pRzzlKDVYnrcBvF2C5V",
+	"PGAydwru6l9seE5XUdqu7MWmWhfdUsRb7vhOOrogpWKfnb63ssNRFVSJfBodOeZMoEYza6U2GpxgZTZj",
+	"qeHnW9L//WPBRJBabuz1MtZUHWQD5FVmgzLuU7JNXVQDlNMrwpPT/YHTl27qjK3vadKghmhd2SoTx1US",
+	"hyMGrB9J0etCaRXJLo6E64oyEAs+SNB2Z3UJlhgjwemCZJZXnMuTJKFhgssNU8Zr4g+aC7rulPYVg/T7",
+	"0n10S2r3vz9eYAVz7UJmaJV4PHylg8KxXZ7pwiUux2SNle3EeyMx7X/zmVntLDk/Y7UDh7NUgVnQt4iq",
+	"XrxWJ9lwH3XS+hEeB3pWzczrkO6u93l3j212hDSXIEYkfSkmmrbVKgTpnraxYrb+LFMOrhlTylIAtISx",
+	"WWKkd0PbBMcmVGgMiLsSEnRvkS0LXG/q+7d1bn8sNkgx1T11cXDhAoliSwrQqSADf/+cm5D93H73ubK8",
+	"z9FWDVNFr9urHvtgfq47SAypfkbcbbk9B9dVlE1cCKYSb3lqp+MXTDWtIYWSWZnaCzo8GJVCbrCpfQMr",
+	"iepp0u4qW2+EIJfVGVsfOC90Vy7a72AItJWcLOhBGufWJu9V/aZjcM/3At6n1FyNR4WUedJj7Dju1hBo",
+	"U/wZhwo8BG4KH/TaU8Kf3Ecde2XNvlisfc78omCCZQ8mhIDuC91pLcCtIpatycU9s2n+Fc6albash1Oq",
+	"Td6JeLw2+lmra3IzP8xmHqaZyK49lR1k80Rm1VO/AAriaDQY93DGza/yrqm57VZTE5WFIiaTnFiL1XM8",
+	"6DHFESZFC1LqoSGTEmfpIjqXsSDLqyRug6HimAonQ4AMEwPEMhwwzCIXRYDz4nE8yBfyjz68cpoym5Jb",
+	"+9i4Ktuvy+dsLSzNqvkD31+nQeokI4l0kFwtpW9Yg05vZffo/eES57CwLokvoIGHFy8twquUMd4DuW60",
+	"c9H+RsWQCvcxUx1KVz4zSl9CKP996HKwEzckk6y5JBho98UE6bc2raW3GNXxDDURHH0slKe3Zj0c17nJ",
+	"l5oLxZB1jTlraPavUvtaMwoAy9f/SWg1ipV7sZQb0Nsslxcw39IGEP8L3d2vrXp3hLnx9EXpoOs+wIwm",
+	"PjP/gGTbg84j+Dv0Z30mFwupWTvRszucGRPbc8cVxfGLTg7ozfYFT74/4dGEPYLNBAJ1ZarPGCtc8feG",
+	"dl7vTLRhatnt3koWV1t20stgA3ipC0maY8I0FE1ghxt5f33WISrqSjV72to4l92yjdvTVW84xljOZ0iu",
+	"55tKOr0NNhykVqzsEbx2vuM/7wmIXQP9R6DBxtwF5qULGGkQoW/QetQJq+vEK3tPrFnrNyy7DPJd7sIq",
+	"B6XZDKOhrpRXs06n6fC2aTe/k6st95EtJiYM6uRh44LcCZs51tjqeLwdFrrxGQYYxHL2buVmcErkhYus",
+	"n8rVcJ4Wd588dTDFsqEMykuzwdAK43qkXWHs+Kkc++Qg28X9rXEBVUhAvV11WEB3byCaLsFXc1IVXIyJ",
+	"qTlKWKFSyJeYrrsRIzGNZBVfQLVTGELQdkZSqRRLwx7x+D0L1FIqluRyHg/TfslnRpOcLzkQryC5nBNZ",
+	"wDbYuqVx7tM3VylgAyBiOvDujmAAq32hrUkS14dUfYZOCbod68+UoMpvPpjhQx+bU7UuAmAXnVifup6U",
+	"Jky7pP8OQ7ZxF16kG5uQu23T3qliaOOybqaTxR6kUCxlVY7dAGHOmdjr78xCyXK+CMo1kQue595wBdug",
+	"Sh9aFIyCpVmxvXaMvZKAtYEBzliB4YFLtpRqbZ8cPuBgTEpdolc9APeMLKUNqmXCECmYJveBASiZ502T",
+	"pVXgzp0fxiu6OkpT81LKM0ge++A/icxB5+Kgghn9Q8WVGyHPHj2yeqYxBJ4JIGuq0gXUf8QJrLu3kKbC",
+	"aTb2OT3b4SfVajbFtlqZy4uSgwWPxmNHez9tJJMe9UCHnBBczyh2lnwcr+v4b2wTggIwB/DY7e4hR92F",
+	"tdfVZLdxjfuRINTIJU/jx+7LCgzpDefooZ6uEcaffUfPYYyaV2N6xXlWOu2eD7IMRBQpiJFFs6ZgGO7t",
+	"NO3BbWWLbmwOZ4uWjfSj+0XtroFpKfHiPt9V8dshFdquAUzkJRzTBsWrtX5XC0jXgCGUXmMU10tdjUdR",
+	"5MCCudz7B1pGbV9shKPy0qlnGtiUKiZNuNSEAEh/fsJYZsKHDycEqrAELqYaqpfAn1i+oytU7tdJ8Wre",
+	"nUGcxo7enTHxJcYDbQ+XGx2boVAZyrFVAACKT13UMAFXXmzfnfzlHKGRgcB/0UrUHpfMGDWduQMZuivT",
+	"OU1/kvbaI1oAIKQ2Ya8pFbq0NqwFlQQk5/bViDJCG9CBEidGy1wPNhhh70AZdi2gOhF6FYD3rdJmbNWf",
+	"NtoPX272+4O6jtGVgL/cTOUNqaEvDOmkJi2FTSr1b48oEH1Xb47ZOcVkzdOhkTvae1kOlP4DAPpjeRow",
+	"DIro2RWMGYWQzoSanocI+lGMA2uwy/wUjO7TE+AsJKX2cQE+fJTnpWIu3T/eP0Q1fTQLahZeWIHmXW8n",
+	"8JxhVuT/gymJLDobBz6CLLdZi1oGa1kkOTtnjRAnS8u6xGcoP2e+r646k4yxAj1m234csdidAI/tC96t",
+	"PQmiP4ZgN2rtt4i1O0W2mPKjjgcrkdhjooceJYDonGclbeBP76xlbbiqwFGOoKqjP0isnoBlQ6f5xY7w",
+	"1g9w5PvH3jAeE++H8aGdWVAcdZsY0NZYvlL3nXoRD+ULC2xUToA4W1Y5C1sSr/mGLuiF6Hea6ZJ8rYoZ",
+	"uE9cigCx369YilJNM1bt+jghOBjRfL59DTVBXM/56pPQ8EYS7h0vJv1q5nQqdYi8d43066jowr3Uaxuy",
+	"ABE5z11uScf/Hf8bk2npBwIdoE1VFL4EXjDv5YpFdisHP7siX3UGPXwsui3v7yoQeRCNDP7ZUuE/Qhry",
+	"75LmfGYzBVrwfTeiFxRIyLnVWn9vF+MHE28WTMYeMK/DlH4qu24+dMxguDWMEgANVyCRynloLukZC7cB",
+	"Xdkt50kNsBxdTpdca7zsWtvZxYJbvE/Jv6RZoMmzhcHWjZvIV3eE3v9ZZzoJp/L1fNDQlfnN03TZciJD",
+	"MaIiLnA/2EV3cBqQgG8VEK3ySa0z6yRh8VfVhkBJBP8z5UZRtd4QmLvV7yYWX46S8zawAwE8cDTY2zIG",
+	"pvppFTrfkERo0FL2vQsbRKxt3kENCFueQreA4mhZvr5lDAH/FlHbo54KQcImt4HIRvr6XdRZIGOc03yD",
+	"tvQUvTnRTN4qQu1tY65vTIXlL4zuAFzXoj2mlmF16pKgGdxOGZ/NmLKRZtpQkVGVhc25wAzOFFLu0rW+",
+	"ug0SoFUlG4dMOGqGpMFV3Ux4FhgkccctIPna+bNe00RYAUj3aCscYOM7XbCofc+++I3sMel1YYin4acr",
+	"MMNiwpEeAnSF7dAIi82IFGiGsJf9bvNo/gfbPA3W9HVBMUbirEOm2HzOfkbUoTT/i+Bm40mzqqJ2Bhgb",
+	"omcPgqd/Ma/jhO3mdOm/SOOTFc3EPe1son6vbbyAna8vXXVTPdmzi+gx7TI+hbrIHbT3DafsCFN2D7QE",
+	"H256QyQw03XUK3ob2Rd9JzKl/eKzSBm7xEo7XhlWTUqzjPdYWU690UC7s9WctvKuh3GG37KBK3kcokIW",
+	"STokPMyW/c4sAB7SJoybDMEbqaPypNdVdfqQGptl6nE8fRVZrlUmf2sKznTTC7Lvtd7DQZuaYDlDXoZH",
+	"2OoopApf5uN2OoqmNqJiEoQSxdJSobbugq6jDlINz8zExKH0mbzsyN5O4hMUVFA7YrTsSNcmrY7v5i56",
+	"sAiHjNBrxJVz/4vp8/Xc/3JcmFx8AWC1h4YA5WZ6qzXGnlQitAbv1AiD84FgV1hgn6JqQJKlvW1VdVpu",
+	"YoOiF/qGfCJdI3mVYGgQaN2EOxFsIgA9mTQaORCCIPCg+puyOiLUJnnFe5tfvKoV8ltDPhES32ELeGFq",
+	"jLpd5WzhwPnEZdReVUgJlvK+jxIay9+WbcMtsLZgBFvkXhXGMOsXa2OGmvsSpFLRz6sMJT1iRCeRiZLS",
+	"oMtXnkcSoNiHDp6pkHC4MEyd0/z2k5j8wJU2R4gPlr3tD3sOs2CESLao1FfLwfuSDpo7pzcwtXiDSVf6",
+	"SuMcCeKGcsaLDvPHZyrNrctqVd0GHP5chnPYafL4azJ1pXULxVKu20YRq7kOPCHPmQLdaFUMZHOWiW3r",
+	"/FWaa5DxzFswyetAuSnxnV1DWB/RT8xUek5ulMpj1Nchiwj+Yjxqs7dS47o4awSa9Dkq7Tml29WdfsKV",
+	"YfLcwcvDdeClU2rWXefg23pzeIyFcAji63yEg+vgQsHs6ZA0gvECuNAd8xjupRLuTnVwbyCDocWRG8PN",
+	"G6OYX/ty2tu87T3VFVv7AYUYt2pjw1qZEODFBNNcYzXI312l6Nu9Sz0ENkqme1QtrNdJBWcRE1lrY/Jg",
+	"qqAK5oACmK5bpNwlZixIS8XN+gTw71+8/PeoG+OPVd4ul/etUja7u8/IMya8ta/O8lVqf7v+KGmO95HV",
+	"gQtGjJT5hHy/ossi906f396b/gd7+rdn2aOnj/9j+rdHXz1K2bOvvnn0iH7zjD7+5ulj9uRvXz17xB7P",
+	"vv5m+iR78uzJ9NmTZ19/9U369Nnj6bOvv/mPe6PxiAPIFlAfw3M4+u/kKJ/L5OjNcXIKwNY4oQWH1GiX",
+	"l/i0nElYPiI1xZPIlpTno0P/0//jT9gklct6eP/ryFVjHy2MKfThwcHFxcUk7HIwx7Q+iZFlujjw81yO",
+	"Wxg/enNc+TZaKyzuaK3umYxqUjjCb2+/PzklR2+OJzXBjA5HjyaPJo9hfFkwQQs+Ohw9xZ/w9Cxw3w8c",
+	"sY0OP16ORwcLRnOzcH8smVE89Z8wnN39X1/Q+ZypCfqt25/Onxx4seLgowvUvoQZogpyWwwlqIDh+pKi",
+	"nOY89YlEubaaG+th2AhgtyqtUo+rCHTnxCQyNDTbgDw9Go8qxB1ntYP4cc20EB3egDI6/C2SctJ7vl4E",
+	"AYNVOt/aqeC/Tn5+TaQi7nnzBnR+3u3XB4rUYThhnAj0nHj6/XfJ1LqmLwvoaDyy7BIJU5RLYCIubmCp",
+	"50Uz+3otVcWUJB1c+5mBLOqJ62RkNeNCc0oASc2GgbU+Sr55//Grv12OBgCCmfE0QyfvDzTPP9hAIrZC",
+	"z6KWcXXcZ9ke18mtsEO9k2NU4FRfg+51m2bRkg9CCvahbxscYNF9oHkODaVgsT14Px55YsEz9+TRI89o",
+	"nBgfQHfgzlQwy6A6Pc2AhANPElcYqMuQ7Ke3Vf5qRQt7Ft0X63PvFKu20QT4zrM9LrSZZfvay20P11n0",
+	"dxSMgza0E5fy+ItdyrGwHj1wsdgL8HI8+uoL3ptjYZgSNCfY0t6geIy7F80vtnyabwnCT7lcUrVG0cZU",
+	"vLBdIpzONVozkEXasx2kSBXz0fvL3lvvIFg9/Fz/lfDsWnci3nG0URN/yzV5T/dxThyrkR/jfiN3Cn4/",
+	"Koo3wC01GvAYx9sPQ9H1gwn5MeyN3FtCmOeUOUhY5tMT+luvqr/LdTvP2j1tmbXNDhu9tAN18d39/anv",
+	"76OmsoNjIfkZZ6oHmMYp2AhTx03guhdo10k6yGm1q1tbVcPCiRYJLYodxrDHaVDFWFtv2tbERpfV2rsB",
+	"kyrl7JyKIZmj7UzvY0/BrYz6Dnc9uOsTkwJ4K4nJNpyy22LNPh1+dZM0rowbZNxfuND3iuZAJ8FyW2Xn",
+	"jl/cCYN/KWGwSps9t9JZUexBPETH24OPPn3QHkRCGGmYMBg+q4O+gQvp/RY7eTAhR+02V+MZLk/2VjEP",
+	"2t0JeJ+DgIf7vlW0q9NgfTqhLvTb38WNviGNwO+DOn/hUtxfGFm9YhtAul1guwL77AhjjlnfGFv9Uwph",
+	"Dml34tdfWvyqqldcSwAL/TkPXKRoYMa6lvaurZ3jppLEwk8NzobB1BgzaY/wuHYOBhZjvWt9ctuxfxnC",
+	"J/dotJs17rwbuyLWjyx8oH63Pn6xTbr6gvQ8A9UI0Vsgvjc3zUujZoe3t2N2GMabnj16dnsQhLvwWhry",
+	"A97iN8whb5SlxclqVxa2iSMdTOVqG1cSLbZUpd+xCVkDHlUlYxsH36G19dK4j4FrzYxWDybkO9e0jtR2",
+	"IdpzSfM6AIOque0EvA6QQe75Pw9x/Hs2ERfHVK6ltpWkbEMuzOHjJ0+fuSZQ8gL9mNrtpl8/Ozz69lvX",
+	"rFBcGPQHsO+cTnNt1OGC5bl0Hdwd0R0XPhz+9z//ZzKZ3NvKVuXqu/Vrm0D2c+Gt41g+p4oA+nbrC9+k",
+	"2Gtd2H3ZirpbMd9/J1fRW0CuQnZxdwvd6i0E2P9T3D7TJhm5h2ilyWxUw9vjbcT0rvfR2N0/GGpRXSYT",
+	"8lq6wqRlTpWN/nc52uclVVQYBoo7R6mYHkbbQoxpzjEiVxHNFBSC0rxKB1wqVsXiQ51qaBiksGtAsJ3R",
+	"M/05M/lXdBVEo06ra9pIt2RUey7pylfi0cyMbZabFfn2W/JoXL9eIMuzXCUVYmLMdUlXo1vU+lXENjTp",
+	"wwuHHam2O+ji2EM0SLX0U2XPqp8af3XO/cVK7pbc3cbuiXPubPipDTuhHgF/3KJBsIIdFnAiuiyKfF1n",
+	"+aN5LULFWRzMMFQ58BnbCLaqpqOP0DZ67w7xnRLgWqykTVA7sg2MOtUHH/FdHvKMzrnFqLm/lrk0sB0p",
+	"ufTGI0lmzICmAhDSRn2EPSkXNNjPm5ZcQKqb0eGj8Y1LNbiL3RyVQfAxyagNkx9S4DOIpUQDHlMRIv4Z",
+	"/wOROgDIzCau9XUMTl3CQTRN2cuGVSXP7eObIsU4f34f11vQRgn37VA+ryfvCmS5bNDE1e2fdwjeDcEd",
+	"5vi9y0lgj5dbxJ/B498/JRPyWtZh4/YF9ac0Pd7kzX7TC3otBavrollavDOnVmIH1m5DpPh8Ifb9Upcf",
+	"uqoIcgDBqlvlkL9Doy2yyJDbGyb7Iq/wvzssbbhlYG2TrckQ6tGGMGdo6KoHBlNNPuUr5pPw08/wafMp",
+	"ONbtsBg8pJ7P2J+k2C/TwRQ8lpgPCp8vqY8DvYTGgVxmsxIN5kZGVm5oLJL7h0xZLsVcf56saBN1xPES",
+	"oRL84FLfd9Y/+Que3ecuL71xMcUu35PmImVEyyXDJwPI6Jgr3TpLPnv0t9uD0HBwmpMlJq0KYlc/MXf5",
+	"6tHT25v+hKlznjJyypaFVFTxfE1+EVX++etwO02o2/NQGxxhDlygtamZFywNkxhdnQk2XNc+mhWY3LYy",
+	"wyDv4I58kIuADwZzgxKcUXV1BrjddNWuUnn8IvQOllWqEb8rPaAAinZ0kP8/o4F6J2gELNJefqWwgPrs",
+	"X45NONddORtXzjFSQLdD8k48JHpBv3r85PcnX33t/3zy1dc9mjOYxyXt6erO6oHgsx1miALti1YH7ldq",
+	"r/B7eNu7vdsmjkc8W3WBxLJWQZbmZjEtJ5bd06Sga+9G20lCVcQTUVbSQDjskoEYrxe8uP1kh9rw6SL6",
+	"vvLPn6oa67H4rnoF24x8IHwXnyLJ3XhkFGMZK8xia+5LbFXvJnNZMLl2CcZthsIx4RM2wTa1nR8KtGr7",
+	"oqYkZ3Tm82ArKYcETwR8BgjNU0WA9XAhQ96kUfrBhCFIlLf/OK2DDOxF55GnWnfOJxV0zad6pCb4RmXC",
+	"CzZNtHw6mdLV4q7N3YWSRqYyt74rZVFIZarTrSeDxD3WZ7ZrSHt9hLuTMJdSky7K4uAj/gczfF3WgQex",
+	"rwcznrOgCaBI6FIf+Ph79wHzJusDsxIHWEri4ONG9wJcnivkjF0bMm20zGP3iY3d6/TOP0jVKdC9zX2g",
+	"ddrG7QOIs5PjF152aMp2NyPZ/aUFoo26g9aGX18dHhmxc/irmLwguT9tFiEPKdiV9oiQ8J355vNaUK1Q",
+	"mXGRERpsY+vdV9WWO35x00qVm170p9DR3L7N6qsv+JyBy9ExJCZdMmFYdj3PH9LmcP722Hjd7iZUuKu/",
+	"6x7UvfPDG987NVaa+a0X/A7GvCCMm/npqIL/arirb0ZvfneTf943+XOfrrhBhnf38pdzLyvvinl3BX/+",
+	"V/DTL3Y1N2jEGXgl+5voytdw/RLf8ULuCAOublPLjL7JxoNP7/Yq9Q9S+dIYd7f4F2qgsDs5OOBpiIZm",
+	"WxiUm3IfbrefFfTD9AxQ+amjaeg7qGNbJ8gsGMeENTLlmHv8ONNje4idcsKd4jvB57MWfIK9vpN77lQP",
+	"X5jqoUfKca/+PI/wr46gsasAdL6UGfMeK3I2cwni+qSfZt0aIE9t6LIgtmdUykFL7ilfshNo+bOdYq9X",
+	"bA12SyxqgQfI0iyVItMDLKpu1KveQ4An0w/ArVtPqx3wsLjQ8cmVSfZtkH+mQwmkjXyN9YZ8ojyHjIyd",
+	"k6UvqHxNsj34aP9FdVohdWQ1J8zEwSX33bbYzH923AaA5A0Koa6QseslZ+SRTQBYCo2+tVVhQSoyYtQa",
+	"69a7fCeKQSRRw7u/gqN7ck56T87Wp0BndT1rir8FZH1C9+kK24qs+unWD8BzKhzJdxFkJKFEsDk1UF/a",
+	"rWVyF41/5dvMxcJvYIBjiGe3p7HeBHbO1JrocqpB1hFNJ817unledmAYbFUwxeGKpnltgLfPhAMbar/J",
+	"GfPEtrjmpdXiRThmXdi0ebNamIDBvOKpklAyTHufML3Whi07Zftc1997ErZ6RULXf0yKnAuWLKWIFZP7",
+	"Gb++wo+x3piuoK/zKXzs69u6b5vwt8BqzjPkTr4ufj+T03+tOI/WahUrpILX7dQWuLX0v+NR8odmLdLu",
+	"SVqLNDBquY/BQFL0/HzwsfGnS7ThWupFaTJ5EfTFl711GBoSYx8Uub6CJq1VLFrfrC7tJm1IAR5iJ6b6",
+	"GikbVn/srxz2F40tcSaXkEhcef9zpnTreXYXYPKnCjAZvO878VhbJnMbRyv1fiWS1zJjdtxmldpYbmch",
+	"M+aqeXYFkcoVMu6U72+lul3LTTqlJQTolAUxMuaQXXdMaGqZbGKfN/EJg2xq2MpOt6DnjNAca6SSKWOC",
+	"yCksur4fcZFUYz4779XtHD6jolAAV6FkyrSGnPsul/U20Hw76wNuNuAJAUeAq1mIlmRG1bWBPTvfCmdV",
+	"Y1yT+z/9qh98AnitKLgZsdgmht4qUwcXPVAPm34TwbUnD8mOKka8aIBBKBK0h4b1ALMbTnr3rw1RZxev",
+	"jxaM0+A3TPF+kusRUAXqDdP7daEtiwTu7y6Iz+1X0A3BhgkqpNcrxgbLqTbJNrYMjcK1aFhBwAljnBgH",
+	"7nlwvqTavHURiRncQa4yB86DfXCKfoDP+2rZw8i/VpXsO2NXDvdVuXsXZcCy2BoEW22Y6zVbVXPJWTB2",
+	"FcZgNXzbRu7DUjC+Q1aQ0JtQE1jzYbjI4lD/SJ2CoovKBhA1IjYBcuJbBdgNzfg9gHBdI9oSDtctyplK",
+	"mTMqbDSYLArgFiYpRdWvD00ntvWR+aVu2yUuaup7O5NMhyEmDvILi1mNCtoF1cTBQZb0zEWhzF2Bpi7M",
+	"cBgTjB5PNlE+qmyhVXgEth7SspgrmrEkYzmNqFJ+sZ+J/bxpANxxT57JuTQsmbKZVCy+6TUlq14VUTW0",
+	"xPEiTPO1JPiFpHAE4fFcE4jrvWXkjOHYMebk6OheNRTOFd0iPx4u2251j1oKxoAdd/SAIDuOPgTgHjxU",
+	"Q18dFdg5qdUH7Sn+ybSbwLe5wiRrpvuWUI+/0wLa6rzwAmvcFC323uLAUbbZy8a28JG+IxtTIH6Ryv62",
+	"79INZo5pKlCDB+DkKo/bgwvKDSS6s4J0QmeGqa0O8f+g3JvDnWnASJfXgOAI7t504yCTD8tkOC5iQSDu",
+	"ugAS6drfYKofpBqUnrOZhIZyQ0pheB6kKK+eyp+fwvBOCXCnBLhTAtwpAe6UAHdKgDslwJ0S4E4JcKcE",
+	"uFMC3CkB/rpKgE+VcDfxEodPQyakSNpeieTOK/FPlaCyuqu8UgLVGKBEcBU3fby/+3K9/LzaKEaXB9W7",
+	"xf1sGM0RNTZzUY/7tPXqPP3+6CXRslQpIykAzgUpcsoFMWxlqrJwzYKjvhSyrS1pa5lSzZ4+ISd/P/Lp",
+	"9RYuDVyz7f0jV1Jcm3XOHrjCC0xkVkL1FRiYgL1wBRiovyp8+ThXTI/n6HquyffY+gU7Z7ksmLKZu4hR",
+	"ZUQTdMpo/tzhZosi6B8wufNl/QCjfRg39E8ObUtaePHfr5VqQm1II3kRBDl+mNFcsw99cY52vCUtYhXc",
+	"qgvRqoiQyXwns3Xr4MCuHeAGNo9MnWSPC6rWkTRM3RiDNmkYCWzMEVZXx3W591SQXaLtktk2CotJ8Yrp",
+	"6PHeROWxceoN6wxlI2FnLToZxYI424n/RhWAQzxjTzEOwe4JeWv7fdJ7jyBE7ojVPP6zcShstqyYBrYV",
+	"0njW86U663vER08vnv0xEHZWpoxwo4mjuAG3DhS1gZHmTCSOASVTma2TBvsaXYa3UMY11Zotp9tvopB/",
+	"uprF7vIxi8hyGvfUp7lGXgSL28STQ6JZJY4B93DntWGDeXOFLRzRsecA4zfNovvYaAgCcfwppmxq8b5d",
+	"mV49zfqO8d0xvuA0tiQCLlz23TYTmdwg41NrVYp+nvf9iqUlABee5PuotUdTHWhxQntnxqblfI61lzu2",
+	"O1gaw/GgOM+nYYV2uUO54G4UZAev6nFeNwq8PVyXuwSB2fd96sMHuB1UrNHIsSyoWHtTMGgjlmVucWjL",
+	"1u2X0doEuV0HgfHIK/r6td1vXItQp+uu2ubvFi3kgmpi95dlpBSZCylqT2xWYngiETv06UrUbHpj0hC7",
+	"3sjq3LxDrgi/y81Ybk0KphKzEvZANYuz23Td9uRO7mrO/jWuDRsJznoYbDf1dM0Q9nR7qICv4fVRTxbq",
+	"dIJfD1Br0R9RElYbsS336lTSGb7pW1KrVJztlOUFoSTNOVpWpdBGlal5JyjaboKFTbp+J15J3c/fnvsm",
+	"cfNhxLrnhnonKNaLryw6UT43YxHzxQ+MeTaqy/mcaeCVIZHMGHsnXCsuSCm4wbmWPFUysfGpcIZAPpnY",
+	"lku6JjNMCyLJH0xJMi1NOKa2emRtwDZoHV1gGiJn7wQ1JGdUG/KKA5eF4XxOgsrDi5kLqc4qLMSLT8yZ",
+	"YJrrJK58+dF+xfoObvleyQf/d53rvOy3W9jBw86zXsiPXwDcFFMa51yb2jeiA/ut2cWXXCRRIgMDvnMV",
+	"a9MWuY+J1BwBPWgajcyCvRNwwxlJkKtTczVyaFt/OmfRno4W1TQ2omUk8msd9MTbC5chESZzZ3H5E0Vs",
+	"BnTgrZq48TZJfWvvd7OuNK9cJiBdTN+FbL+6emA9jdwjoaEIa2WJcS1OGyD/eWvJv7+Z96JH495ejN0B",
+	"L8cxj7zwtjaS+A0fEwrFKm1yQnhBStwnLorSoL/1TSrp2DnNE3nOlOIZ0wNXyqX4/pzmP1fdLscj0DAk",
+	"RtGUJVZrMBRrp9DH0um2izSoe7dcsoxTw/I1KRRLWWbTcHFN6sf2xCYyIOmCijneuUqW84VtZse5YIpV",
+	"JcLgfdseInopm5VIbEq2LoxHxCoqw6y1jKaLSNkUvJkuaDWfyzIx5MkcYQWYcLPvBT0e9UrIgNTz2t/N",
+	"IqfJHwZc/42LPMBPPfE+MpTeUesdtX4yao1lAkTUzVo6AIuvcFtuWFl003kvb1H39EmS4t5llv+zZ5b3",
+	"HEgTShRtSP3xkmZUE27IBeb9mTICF0+JOm9XNd29kMGcwoKj7hJEalfMM11QLlzSmCqKAOEwruCw8RUO",
+	"b0RdaJkZ6gkBHSwtFTdrfCfQgv9+xuD/70HQ1kyd+ydEqfLR4WhhTHF4cJDLlOYLqc3B6HIcftOtj+8r",
+	"+D966b9Q/JwaNrp8f/n/DwD0WAfUN7YBAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
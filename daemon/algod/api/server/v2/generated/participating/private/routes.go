@@ -21,6 +21,9 @@ import (
 
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
+	// Get a snapshot of the agreement state machine.
+	// (GET /v2/consensus/state)
+	GetConsensusState(ctx echo.Context) error
 	// Return a list of participation keys
 	// (GET /v2/participation)
 	GetParticipationKeys(ctx echo.Context) error
@@ -43,6 +46,17 @@ type ServerInterfaceWrapper struct {
 	Handler ServerInterface
 }
 
+// GetConsensusState converts echo context to params.
+func (w *ServerInterfaceWrapper) GetConsensusState(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetConsensusState(ctx)
+	return err
+}
+
 // GetParticipationKeys converts echo context to params.
 func (w *ServerInterfaceWrapper) GetParticipationKeys(ctx echo.Context) error {
 	var err error
@@ -147,6 +161,7 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 		Handler: si,
 	}
 
+	router.GET(baseURL+"/v2/consensus/state", wrapper.GetConsensusState, m...)
 	router.GET(baseURL+"/v2/participation", wrapper.GetParticipationKeys, m...)
 	router.POST(baseURL+"/v2/participation", wrapper.AddParticipationKey, m...)
 	router.DELETE(baseURL+"/v2/participation/:participation-id", wrapper.DeleteParticipationKeyByID, m...)
@@ -158,188 +173,233 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+y9e5PbNrIo/lVQOqfKiX/ijF/Jbly1dX4TO8nOjZO47En2nmP7ZiGyJWGHArgAOCPF",
-	"19/9FroBEiRBiZqZ2LtV+cseEY9Go9HoF7rfz3K1qZQEac3s6ftZxTXfgAWNf/E8V7W0mSjcXwWYXIvK",
-	"CiVnT8M3ZqwWcjWbz4T7teJ2PZvPJN9A28b1n880/LMWGorZU6trmM9MvoYNdwPbXeVaNyNts5XK/BBn",
-	"NMT589mHPR94UWgwZgjlT7LcMSHzsi6AWc2l4bn7ZNi1sGtm18Iw35kJyZQEppbMrjuN2VJAWZiTsMh/",
-	"1qB30Sr95ONL+tCCmGlVwhDOZ2qzEBICVNAA1WwIs4oVsMRGa26Zm8HBGhpaxQxwna/ZUukDoBIQMbwg",
-	"683s6ZuZAVmAxt3KQVzhf5ca4DfILNcrsLN389TilhZ0ZsUmsbRzj30Npi6tYdgW17gSVyCZ63XCfqiN",
-	"ZQtgXLJX3z5jjx8//sotZMOthcIT2eiq2tnjNVH32dNZwS2Ez0Na4+VKaS6LrGn/6ttnOP9rv8Cprbgx",
-	"kD4sZ+4LO38+toDQMUFCQlpY4T50qN/1SByK9ucFLJWGiXtCje90U+L5P+mu5Nzm60oJaRP7wvAro89J",
-	"HhZ138fDGgA67SuHKe0GffMg++rd+4fzhw8+/Mebs+x//J9fPP4wcfnPmnEPYCDZMK+1BpnvspUGjqdl",
-	"zeUQH688PZi1qsuCrfkVbj7fIKv3fZnrS6zzipe1oxORa3VWrpRh3JNRAUtel5aFiVktS8em3Gie2pkw",
-	"rNLqShRQzB33vV6LfM1ybmgIbMeuRVk6GqwNFGO0ll7dnsP0IUaJg+tG+MAF/esio13XAUzAFrlBlpfK",
-	"QGbVgesp3DhcFiy+UNq7yhx3WbGLNTCc3H2gyxZxJx1Nl+WOWdzXgnHDOAtX05yJJdupml3j5pTiEvv7",
-	"1TisbZhDGm5O5x51h3cMfQNkJJC3UKoELhF54dwNUSaXYlVrMOx6DXbt7zwNplLSAFOLf0Bu3bb/r9c/",
-	"/ciUZj+AMXwFL3l+yUDmqoDihJ0vmVQ2Ig1PS4hD13NsHR6u1CX/D6McTWzMquL5ZfpGL8VGJFb1A9+K",
-	"Tb1hst4sQLstDVeIVUyDrbUcA4hGPECKG74dTnqha5nj/rfTdmQ5R23CVCXfIcI2fPuXB3MPjmG8LFkF",
-	"shByxexWjspxbu7D4GVa1bKYIOZYt6fRxWoqyMVSQMGaUfZA4qc5BI+Qx8HTCl8ROGGQUXCaWQ6AI2Gb",
-	"oBl3ut0XVvEVRCRzwn72zA2/WnUJsiF0ttjhp0rDlVC1aTqNwIhT75fApbKQVRqWIkFjrz06HIOhNp4D",
-	"b7wMlCtpuZBQOOaMQCsLxKxGYYom3K/vDG/xBTfw5ZOxO779OnH3l6q/63t3fNJuY6OMjmTi6nRf/YFN",
-	"S1ad/hP0w3huI1YZ/TzYSLG6cLfNUpR4E/3D7V9AQ22QCXQQEe4mI1aS21rD07fyvvuLZey15bLgunC/",
-	"bOinH+rSitdi5X4q6acXaiXy12I1gswG1qTChd029I8bL82O7TapV7xQ6rKu4gXlHcV1sWPnz8c2mcY8",
-	"ljDPGm03VjwutkEZObaH3TYbOQLkKO4q7hpewk6Dg5bnS/xnu0R64kv9m/unqkrX21bLFGodHfsrGc0H",
-	"3qxwVlWlyLlD4iv/2X11TABIkeBti1O8UJ++j0CstKpAW0GD8qrKSpXzMjOWWxzpPzUsZ09n/3Ha2l9O",
-	"qbs5jSZ/4Xq9xk5OZCUxKONVdcQYL53oY/YwC8eg8ROyCWJ7KDQJSZvoSEk4FlzCFZf2pFVZOvygOcBv",
-	"/EwtvknaIXz3VLBRhDNquABDEjA1vGdYhHqGaGWIVhRIV6VaND98dlZVLQbx+1lVET5QegSBghlshbHm",
-	"c1w+b09SPM/58xP2XTw2iuJKljt3OZCo4e6Gpb+1/C3W2Jb8GtoR7xmG26n0iduagAYn5t8FxaFasVal",
-	"k3oO0opr/FffNiYz9/ukzv8eJBbjdpy4UNHymCMdB3+JlJvPepQzJBxv7jlhZ/2+NyMbN0qaYG5EK3v3",
-	"k8bdg8cGhdeaVwSg/0J3qZCopFEjgvWW3HQio0vCHJ3hiNYQqhuftYPnIQkJkkIPhq9LlV/+lZv1HZz5",
-	"RRhrePxwGrYGXoBma27WJ7OUlBEfr3a0KUfMNUQFny2iqU6aJd7V8g4sreCWR0vz8KbFEkI99kOmBzqh",
-	"u/yE/+Elc5/d2Xasn4Y9YRfIwAwdZ+9kKJy2TwoCzeQaoBVCsQ0p+Mxp3UdB+aydPL1Pk/boG7Ip+B3y",
-	"i8AdUts7PwZfq20Khq/VdnAE1BbMXdCHGwfFSAsbMwG+5x4yhfvv0ce15rshknHsKUh2C3Siq8HTIOMb",
-	"383SGmfPFkrfjPv02IpkrcmZcTdqxHznPSRh07rKPCkmzFbUoDdQ6+XbzzT6w6cw1sHCa8t/BywYN+pd",
-	"YKE70F1jQW0qUcIdkP46yfQX3MDjR+z1X8++ePjo10dffOlIstJqpfmGLXYWDPvM62bM2F0Jnw9XhtpR",
-	"Xdr06F8+CYbK7ripcYyqdQ4bXg2HIgMoiUDUjLl2Q6x10YyrbgCccjgvwHFyQjsj274D7bkwTsLaLO5k",
-	"M8YQVrSzFMxDUsBBYjp2ee00u3iJeqfru1BlQWulE/Y1PGJW5arMrkAboRLelJe+BfMtgnhb9X8naNk1",
-	"N8zNjabfWqJAkaAsu5XT+T4NfbGVLW72cn5ab2J1ft4p+9JFfrAkGlaBzuxWsgIW9aqjCS212jDOCuyI",
-	"d/R3YFEUuBAbeG35pvppubwbVVHhQAmVTWzAuJkYtXByvYFcSYqEOKCd+VGnoKePmGCis+MAeIy83skc",
-	"7Yx3cWzHFdeNkOj0MDuZR1qsg7GEYtUhy9trq2PooKnumQQ4Dh0v8DMaOp5Dafm3Sl+0lsDvtKqrOxfy",
-	"+nNOXQ73i/GmlML1DTq0kKuyG32zcrCfpNb4SRb0LBxfvwaEHinyhVitbaRWvNRKLe8extQsKUDxAyll",
-	"peszVM1+VIVjJrY2dyCCtYO1HM7RbczX+ELVlnEmVQG4+bVJC2cj8RroKEb/to3lPbsmPWsBjrpyXrvV",
-	"1hVD7+3gvmg7ZjynE5ohasyI76pxOlIrmo5iAUoNvNixBYBkauEdRN51hYvk6Hq2QbzxomGCX3TgqrTK",
-	"wRgoMm+YOghaaEdXh92DJwQcAW5mYUaxJde3Bvby6iCcl7DLMFDCsM++/8V8/gngtcry8gBisU0KvY2a",
-	"772AQ6inTb+P4PqTx2THNbBwrzCrUJotwcIYCo/Cyej+9SEa7OLt0XIFGv1xvyvFh0luR0ANqL8zvd8W",
-	"2roaCf/z6q2T8NyGSS5VEKxSg5Xc2OwQW3aNOjq4W0HECVOcGAceEbxecGPJhyxkgaYvuk5wHhLC3BTj",
-	"AI+qIW7kX4IGMhw7d/egNLVp1BFTV5XSForUGiRs98z1I2ybudQyGrvReaxitYFDI49hKRrfI4tWQgji",
-	"tnG1+CCL4eLQIeHu+V0SlR0gWkTsA+R1aBVhNw6BGgFEmBbRRDjC9Cinibuaz4xVVeW4hc1q2fQbQ9Nr",
-	"an1mf27bDomL2/beLhQYjLzy7T3k14RZCn5bc8M8HGzDL53sgWYQcnYPYXaHMTNC5pDto3xU8Vyr+Agc",
-	"PKR1tdK8gKyAku+Gg/5Mnxl93jcA7nir7ioLGUUxpTe9peQQNLJnaIXjmZTwyPALy90RdKpASyC+94GR",
-	"C8CxU8zJ09G9ZiicK7lFYTxcNm11YkS8Da+UdTvu6QFB9hx9CsAjeGiGvjkqsHPW6p79Kf4bjJ+gkSOO",
-	"n2QHZmwJ7fhHLWDEhuoDxKPz0mPvPQ6cZJujbOwAHxk7siMG3ZdcW5GLCnWd72F356pff4Kkm5EVYLko",
-	"oWDRB1IDq7g/o/ib/pg3UwUn2d6G4A+Mb4nllMKgyNMF/hJ2qHO/pMDOyNRxF7psYlR3P3HJENAQLuZE",
-	"8LgJbHluy50T1OwaduwaNDBTLzbCWgrY7qq6VlVZPEDSr7FnRu/Eo6DIsANTvIqvcahoecOtmM9IJ9gP",
-	"30VPMeigw+sClVLlBAvZABlJCCbFe7BKuV0XPnY8RA8HSuoA6Zk2enCb6/+e6aAZV8D+W9Us5xJVrtpC",
-	"I9MojYICCpBuBieCNXP6yI4WQ1DCBkiTxC/37/cXfv++33Nh2BKuw4ML17CPjvv30Y7zUhnbOVx3YA91",
-	"x+08cX2gw8ddfF4L6fOUw5EFfuQpO/myN3jjJXJnyhhPuG75t2YAvZO5nbL2mEamRVXguJN8OdHQqXXj",
-	"vr8Wm7rk9i68VnDFy0xdgdaigIOc3E8slPzmipc/Nd3wMQnkjkZzyHJ8AjFxLLhwfejVxCHdsI0mE5sN",
-	"FIJbKHes0pADRfk7kc80MJ4wiv/L11yuUNLXql75ADQaBzl1bcimoms5GCIpDdmtzNA6neLcPug4PPRw",
-	"chBwp4v1TdukeVzzZj7/tmfKlRohr2/qT3q35rNRVdUh9apVVQk53dcqE7h4R1CL8NNOPNEHgqhzQssQ",
-	"X/G2uFPgNvf3sbW3Q6egHE4chcS1H8ei4pyeXO7uQFqhgZiGSoPBuyW2Lxn6qpbxyzR/+ZidsbAZmuCp",
-	"668jx+/VqKKnZCkkZBslYZd8jC0k/IAfk8cJ77eRzihpjPXtKw8d+HtgdeeZQo23xS/udv+E9l1N5lul",
-	"78qXSQNOlssnuA4P+sn9lDd1cPKyTPgE/buVPgMw8+advNCMG6NygcLWeWHmdNC8G9E/cumi/2UTjXsH",
-	"Z68/bs/5FT+JROMulBXjLC8Fmn6VNFbXuX0rORqXoqUmopaCFj1ubnwWmqTtmwnzox/qreQYsdaYnJKR",
-	"FktI2Fe+BQhWR1OvVmBsT0lZAryVvpWQrJbC4lwbd1wyOi8VaAwdOqGWG75jS0cTVrHfQCu2qG1XbMdn",
-	"WcaKsvSeODcNU8u3kltWAjeW/SDkxRaHC976cGQl2GulLxsspG/3FUgwwmTp6Krv6CsGvvrlr30QLD6j",
-	"p8/ku3Hjt2+3dmh7ap+G/5/P/uvpm7Psf3j224Psq//v9N37Jx8+vz/48dGHv/zl/3Z/evzhL5//13+m",
-	"dirAnno05CE/f+5V2vPnqLe0zpsB7B/NcL8RMksSWRyG0aMt9hk+kPUE9HnXqmXX8FbarXSEdMVLUTje",
-	"chNy6N8wg7NIp6NHNZ2N6FmxwlqP1AZuwWVYgsn0WOONpahhQGL6eR56E/2LOzwvy1rSVgbpm16fhMAw",
-	"tZw3TzApO8tThu/z1jxENfo/H33x5Wzevqtrvs/mM//1XYKSRbFNvZ4sYJtS8vwBwYNxz7CK7wzYNPdA",
-	"2JMxcBSUEQ+7gc0CtFmL6uNzCmPFIs3hQky/NxZt5bmkYHt3ftA3ufMuD7X8+HBbDVBAZdeprA0dQQ1b",
-	"tbsJ0IsXqbS6Ajln4gRO+saawumLPhqvBL7E7AGofaop2lBzDojQAlVEWI8XMskikqIfFHk8t/4wn/nL",
-	"39y5OuQHTsHVn7NxRIa/rWL3vvvmgp16hmnu0UNeGjp6eplQpf3rok4kkeNmlKuGhLy38q18Dkshhfv+",
-	"9K0suOWnC25Ebk5rA/prXnKZw8lKsafhwdJzbvlbOZC0RtNJRU/FWFUvSpGzy1ghacmTUoQMR3j79g0v",
-	"V+rt23eDoIqh+uCnSvIXmiBzgrCqbeYTHGQarrlOOa1M88AdR6YMJvtmJSFb1WTZDAkU/PhpnseryvQf",
-	"ug6XX1WlW35EhsY/43RbxoxVOsgiTkAhaHB/f1T+YtD8OthVagOG/X3DqzdC2ncse1s/ePAYWOfl59/9",
-	"le9oclfBZOvK6EPcvlEFF05qJWyt5lnFVynf2Nu3byzwCncf5eUN2jjKkmG3zovTEFGPQ7ULCPgY3wCC",
-	"4+jXc7i419QrJLNKLwE/4RZiGydutB77m+5X9Ab1xtvVe8c62KXarjN3tpOrMo7Ew840OW5WTsgKYRRG",
-	"rFBb9emAFsDyNeSXPk8LbCq7m3e6h0gdL2gG1iEMZfChF2SYQwI9CwtgdVVwL4pzues/5jdgbYgHfgWX",
-	"sLtQbQqKY17vdx+Tm7GDipQaSZeOWONj68fob74PB0PFvqrCm2x8nBfI4mlDF6HP+EEmkfcODnGKKDqP",
-	"nccQwXUCEUT8Iyi4wULdeLci/dTynJaxoJsvkc0n8H7mm7TKk4/cileDVnf6vgFMB6auDVtwJ7crn8mK",
-	"HkxHXKw2fAUjEnLs3Jn4LLnjEMJBDt17yZtOLfsX2uC+SYJMjTO35iSlgPviSAWVmV68XpiJ/IfeM4EJ",
-	"Kj3CFiWKSU1gIzEdrjtONsq4NwZamoBBy1bgCGB0MRJLNmtuQpItzEUWzvIkGeB3TACwL+3LeRRqFiUc",
-	"a5K6BJ7bP6cD7dInfwkZX0Kal1i1nJCyxUn4GN2e2g4lUQAqoIQVLZwaB0JpkxG0G+Tg+Gm5LIUElqWi",
-	"1iIzaHTN+DnAycf3GSMLPJs8QoqMI7DRL44Dsx9VfDbl6hggpU+mwMPY6FGP/ob0uy+K43Yij6ocCxcj",
-	"Xq08cADuQx2b+6sXcIvDMCHnzLG5K146Nuc1vnaQQfYRFFt7uUZ8ZMbnY+LsHgcIXSxHrYmuopusJpaZ",
-	"AtBpgW4PxAu1zejhZ1LiXWwXjt6Toe34DDV1MCnPyz3DFmqL0T54tVAo9QFYxuEIYEQa/lYYpFfsN3ab",
-	"EzD7pt0vTaWo0CDJeHNeQy5j4sSUqUckmDFy+SxK3XIjAHrGjjYPsld+DyqpXfFkeJm3t9q8TUkWXg2l",
-	"jv/YEUru0gj+hlaYJtnKy77EkrRTdINWunlmIhEyRfSOTQydNENXkIESUCnIOkJUdpnynDrdBvDGeR26",
-	"RcYLzGbD5e7zKBJKw0oYC60RPcRJfArzJMckekotx1dnK71063ulVHNNkRsRO3aW+dFXgKHES6GNzdAD",
-	"kVyCa/StQaX6W9c0LSt1Y60o5awo0rwBp72EXVaIsk7Tq5/3++du2h8blmjqBfJbISlgZYEpkpMRmHum",
-	"piDdvQt+QQt+we9svdNOg2vqJtaOXLpz/Jucix7n3ccOEgSYIo7hro2idA+DjF7ODrljJDdFPv6TfdbX",
-	"wWEqwtgHo3bC+92xO4pGSq4lMhjsXYVAN5ETS4SNMgwPn7SOnAFeVaLY9myhNOqoxsyPMniEvGw9LODu",
-	"+sEOYCCye6Ze1Wgw3RR8rYBPuaI7GXBOJmHmopsoL2YI8VTChEoHQ0Q1r+4O4eoCePk97H5xbXE5sw/z",
-	"2e1Mpylc+xEP4Ppls71JPKNrnkxpHU/IkSjnVaXVFS8zb2AeI02trjxpYvNgj/7IrC5txrz45uzFSw/+",
-	"h/ksL4HrrBEVRleF7ap/m1VRtr+RAxIyqTudL8jsJEpGm9+kKIuN0tdr8CmpI2l0kDuzdThER9EbqZfp",
-	"CKGDJmfvG6El7vGRQNW4SFrzHXlIul4RfsVFGexmAdqRaB5c3LQErEmuEA9wa+9K5CTL7pTdDE53+nS0",
-	"1HWAJ8Vz7UmavaG88IYp2XehY8zzrvJe9w3HzJdkFRkyJ1lv0JKQmVLkaRurXBhHHJJ8Z64xw8Yjwqgb",
-	"sRYjrlhZi2gs12xKbpsekNEcSWSaZHqdFncL5Wv+1FL8swYmCpDWfdJ4KnsHFdOkeGv78Dp1ssNwLj8w",
-	"Wejb4W8jY8RZX/s3HgKxX8CIPXUDcJ83KnNYaGORcj9ELokjHP7xjIMrcY+z3tOHp2YKXlx3PW5xiZ4h",
-	"/3OEQbnaD9cHCsqrTz87Mkey3o8w2VKr3yCt56F6nHiwFPLcCoxy+Q3ihw5xlYsOi2msO23Zonb20e0e",
-	"k25iK1Q3SGGE6nHnI7ccJtwMFmouaavpIUkn1i1NMHFU6SmN3xKMh3kQiVvy6wVPZSN1QoaD6ax1AHds",
-	"6Vax0Dng3jSvLWh2FvmSm7aCHqNXoNu3hMPENjcUGGjayaJCKxkg1cYywZz8f6VRiWFqec0lVXFx/ego",
-	"+d4GyPjlel0rjakkTNrsX0AuNrxMSw5FPjTxFmIlqEBJbSCqgOEHouJPREW+ikjzhsij5nzJHsyjMjx+",
-	"NwpxJYxYlIAtHlKLBTfIyRtDVNPFLQ+kXRts/mhC83UtCw2FXRtCrFGsEepQvWmcVwuw1wCSPcB2D79i",
-	"n6Hbzogr+Nxh0d/Ps6cPv0KjK/3xIHUB+AIz+7hJgezkb56dpOkY/ZY0hmPcftST5Kt7qjA3zrj2nCbq",
-	"OuUsYUvP6w6fpQ2XfAXpSJHNAZioL+4mGtJ6eJEFlUcyVqsdEzY9P1ju+NNI9LljfwQGy9VmI+zGO3eM",
-	"2jh6astb0KRhOKq15DMTB7jCR/SRVsFF1FMiP67RlO631KrRk/0j30AXrXPGKX9IKdrohZAvnZ2H9ESY",
-	"qrnJ0Ey4cXO5paOYg8EMS1ZpIS0qFrVdZn9m+Zprnjv2dzIGbrb48kkiPXU3Tao8DvCPjncNBvRVGvV6",
-	"hOyDDOH7ss+kktnGcZTi8/a1R3QqR525abfdmO9w/9BThTI3SjZKbnWH3HjEqW9FeHLPgLckxWY9R9Hj",
-	"0Sv76JRZ6zR58Nrt0M+vXngpY6N0Kudge9y9xKHBagFXGLuX3iQ35i33QpeTduE20H9az0MQOSOxLJzl",
-	"lCLwtUpopyFlemNJ97HqCevA2DF1HxwZLPxQc9ZNT/3x+ejdREGlPV3BsD10bLkvAQ/4Rx8Rn5hccANb",
-	"Xz6tZIRQovT8SZIpmu+Rj52zr9V2KuH0TmEgnn8BFCVRUouy+KV9+dmrfqC5zNdJn9nCdfy1rdPWLI7u",
-	"wGT6wDWXEsrkcCRv/hrk0oTk/A81dZ6NkBPb9gsy0HJ7i2sB74IZgAoTOvQKW7oJYqx2H9U1QdvlShUM",
-	"52lz1bXHdVjII0q3/s8ajE09UMIPFDiGtlHHDijbNwNZoEZ6wr6jUsxrYJ1ERKgJhkwR3VfTdVUqXswx",
-	"g8XFN2cvGM1KfajaEGUbX6Ei1F1FzyYWpeGcFoIcCgeln0dMH2d/vLZbtbFZkxw89QDVtWjTl4uenwBV",
-	"pBg7J+x5VFSV3qq6IRgmMNEbp9U1o5F8hDTh/mMtz9eo9nVY6zjJT0+TH6jSRKUpmxJTTW5KPHcObp8p",
-	"nxLlz5lyuvm1MFSBF66g++a1eQDuzQ7hDWx3ebqWkijl5IhbrslEeSzaA3B0RQZXQhKyHuKPFPqpysSx",
-	"VQNeY69kqqx+CYJBTUp6QdmUDgqV1XMulRQ5JqpKXdG+VO8UP9uEnF59Q2444v6EJg5XsvBBE4rnsTha",
-	"CiEwQo+4oaE/+uo2laiD/rRYE3bNLVuBNZ6zQTEP9Tu8rVFIAz7XKBZ2jvik0h3fJXLIpDs8a9wmR5IR",
-	"Pr0ZUR6/dd9+9KYFjEm/FBKVCI82L/iRNRAriVqneQjLVgqMX0/3/bF54/qc4FPcArbvTkLlURyDXH9u",
-	"2eTnHg51Frze3svs2j5zbX2CpObnTpQzTXpWVX7S8eouSXnAbuUoghPeyyy4jyLkNuPHo+0ht73hKnif",
-	"OkKDK3R2Q4X38IAwmkonvSpaTmglisIWjMLEklkShEyA8UJIaOviJi6IPHkl4MbgeR3pZ3LNLYmAk3ja",
-	"BfASPdwphmasd2/cdqh+eiiHElxjmGN8G9siLSOMo2nQCm5c7ppyvI66I2HiGdYB94gcllxBqcoLUQW+",
-	"WugVYUkxDse4Q5mn7gUwPAZDmYi6Y660Y2+isYeoi7pYgc14UaRSv36NXxl+ZUWNkgNsIa+bFKFVxXLM",
-	"u9JNRDOkNj9RrqSpN3vmCg1uOV1U1ShBDXFlpbDD+NBlscN/U/kxx3fGB3ocHWoYojqK47IvDUMnU1Kv",
-	"o+nMiFU2HRN4p9weHe3UNyP0tv+dUnqpVl1APnL6iX1cLt6jFH/7xl0ccXaGQdJXulqa5AkY2KdCLUpU",
-	"G5tnv12uhFfZIAssOpSaWnf7DRDjVevmePmNhPdGSTc43a/koRwL8s1HY9K59a/jLGd7WdDoiyOKEKK3",
-	"RQhF2jo7FhVEQUHu86D3NMlwIGfbdOLDCKEh3GwI0PchlpVVXHj3e8sshpj1Ue/DdwhT4mHbDe4vwseS",
-	"j1rsvr8ai/sOydjwe7+q1SX4J/OVhiuh6uDYDpFPQSWkXzs1oprI++T6h4ZXnOrTmkNHjbcXvroALdPr",
-	"5N//QnFyDKTVu38BU+5g0wf1sobSLpmn2iasSUw9KVF151ackqgwlRPPy4adil0H6o0NyOr5FHFgWD9s",
-	"PjsvjrowU3kVZzRK6tilq4GNp51qU03hEauUEW1++FSZsIkhhhdY6StKmzUcK8T3XEFusShAG7egAY5J",
-	"ouUmiwqP/pF+akSdbiIxfdapfammhpUADtzxg9dg0YtGyqJ+Mj2x0lkTnYZ8GrMhr0D62p/ddx6To82X",
-	"S8ituDrw+u5va5DRy655sMtQDe/oMZ5oopcxecvxVscWoH2P4/bCEyVRvDU4Y29vLmF3z7AONSTTus/D",
-	"VXuTvB2IAeQOmSMRZVLRH2RI9g55YRrKQCyEaCvqDm0GtNGKUNFb0hvOFUjSXRzt+9I9U6ZL0kyay3U9",
-	"6tU1BuKOPdAbVrQY1z+eYwER01RrDHk/Yi2dnQ+zI177vCH4VrLxnYQMImDCb+FhNM1SikuIa1ahp+qa",
-	"6yK0SJpeglUn23MfDV7VhWoMfaCXzcyijY0dvqNK5NvCCOi8VE6MyMbCyLvhqE0sxz1DQTeU/h0DbR1c",
-	"S9C+th/Kv6UykFkVYmn3wbEPFRRZdCMkmNEclwTcaOaZV21qHcz1yzHTDPcBRfECmYYNd9DpKAHO+Jz7",
-	"kP2MvoeHQyHX60ELU0Ovh4sOhKhoYQZIjKl+yfxtefhB0k2MTUJKqh9tUtlwJOiuN6TSqqhzuqDjg9EY",
-	"5CbnmtrDSpJ2mny4yp6OEL3qvITdKSlBoVpD2MEYaJKcCPQoi0Jvk+/U/GZScK/uBLxPabmazyqlymzE",
-	"2XE+TOHTp/hLkV9CwdxNEaIHRyrosM/Qxt54s6/Xu5CypqpAQvH5CWNnkuK1g2O7m0O6N7m8Z/fNv8VZ",
-	"i5qyanmj2slbmQ58xXxX+pbcLAyzn4cZcKzullPRIAcSxGxH0gdpfp2oJ3UyVSsfupr7NX5aoiIoUjJJ",
-	"W77mQJxMEyLTVv5ow2SG0kFZqusMqShr8n+ldA7XrsskQ8bTtpvD9gKieBtu/AW6Y2tesFxpDXncI/3E",
-	"gYDaKA1ZqTD8JuUZXFonD20wrlmyUq2YqpyaS2n0gg8lWZYmmuuuSvDQc12CICOHz0hCBDD+ea4HlxoP",
-	"4d1TBef4CjsX64TdBjcs7NbRZXQ8wR1d/SICcwKhH7ZZnaWqBHXX1a9XNVY9zqqNyNPo/veKVhmNMUlR",
-	"bwoVPgEtPYDDZnjAY57SOCfx9AzRDJIvypTHhvnj5500SOfuv3iD9cdlS/DMZYSfJR5g7lt1qvJTYleb",
-	"qXxhqvCmcoRCkg7v/f5lqga4mOplbjJOT2QGEQDjfucODJO8z8eCscTqmhlPIPm8kfnnneLHosfxQjZA",
-	"Otk5J51/DcyNXWvwb/yoDGCv7lDF7TrIAK75UDN3Wh4YfIBHxVO4ITtSsGf5GoR94UpVWQlX0HHH+4eH",
-	"dZ6DMeIK4vqF1JkVABVad/s6R8rPHPP2niDq155Fnsop2E1KpoRY2il2QOxMCslbmdExMVOPkoPoShQ1",
-	"7+DP3KKS21gRt8TlE2B9N41THM0k0ovbxyIORoYgzSfPpUwHhsTvXhuTEs5WNKZnIsL2ZJuKX8txFWxI",
-	"lK3sNL0GYoTYb7aQ4z3UjXy4PU4YDsZM7037qNCkmx2+qSo/SmX7iGxQETIptRkIFX3j9DNB8PV9E9Iu",
-	"GR2FSQwgTMsbMI4S2ji9qNmG71ghlkvQ5FYxlsuC6yJuLiTLQVsunI65MzdXMBy0uob5QR3DcWocNDCr",
-	"lLaBFkICpNx55W1M/p8gt6MPLSGz07Vt1VixysGupB928K3TczDCbYQI/JN01HLosCqJIibb8Es4ch4j",
-	"foP902CiGG+FtQpnnTLFh720/hOiDg/8z1LYvdROol8/5JB8QkSMgQblqnVM0+YMaTAVJXpBJZPiSNF+",
-	"BYKw12SgovlgJKOi550Z8lSzx+ULJqqVlHuT3VAcGDBjAmbuI2iPkhb65ob8AFNKsuiRM9GV1dUSqRM3",
-	"hS4mjBto2PG8H9HSvYKabcfqn3mtUYi65rvDidnaaygdDEwjB3UmxDg0UPutJgIzVFAimffsGPEkQfOp",
-	"mgrDjFN3vxiKcm/9cL/fcrylPb2AuEL7fnprBflAKgla43KXOjrBlnyDBY5JJxPiNO9sq5rT8ntsUJJF",
-	"3ywR6STQhjF7CWxGlYP3h1HEeYrbB9CaQj/R7Rr0oT6/+KHVk6bVMA4dDoAXR9dEVYyDo8OD84lfEv/Q",
-	"ICVayrsxSugs/1DAjl9gq1hGW+RlNWuBssbT67PuvkTRWOZZE+Q0VnC7HwuFSYmdcFCWiRgqEh+pxG1E",
-	"OO6e1Fe8/PhxUJit+gzxAcWrcc9pHEgTI5lQaW72jO8FnzR3FDRzd1PLlxi39Tdwe5S8FvxQXmMdMH8U",
-	"/nlJVv5lqHd5BZJd45gU9P3wS7bwaU4qDbkwfU34OpSiauJGsDKjfzq5tQcCVQ6t8xdlb0HGy2BYYj+2",
-	"ZW3QkL2SLYTtEf3ETGXk5CapPEV9A7JI4C/Fo+J8oweui8tONHgr1UU3mtJwx1Hh0fuuI6PCh5lUpy6P",
-	"Ip/dpVMbGK5z8m3dwW3iom7XNvVJwxC5+2qfTHmJkC5p5LrjUwhCCNYDYwgq+/vDvzMNSyz4q9j9+zjB",
-	"/ftz3/Tvj7qf3XG+fz+p5H20RxCEIz+GnzdFMb+MPYunp98jGRh6+1GLsjhEGJ18Gm3JbMwY8avP2vNJ",
-	"inb/SoGZw6PqC6feIpqcEJNYa2fyaKooU8aEJBm+WyIlBgY95LUWdofJhIPGK35NPtf4rgn99aHjjQnP",
-	"331WXUKTjroNFK5NuF2/U7zE+4gsi9LdQqo8Yd9s+aYqwR+Uv9xb/Ake//lJ8eDxwz8t/vzgiwc5PPni",
-	"qwcP+FdP+MOvHj+ER3/+4skDeLj88qvFo+LRk0eLJ4+efPnFV/njJw8XT7786k/3HB9yIBOgs5C6bva/",
-	"sbJ9dvbyPLtwwLY44ZX4HnZURNeRcSjPy3M8ibDhopw9DT/9/+GEneRq0w4ffp35zFiztbWVeXp6en19",
-	"fRJ3OV1hZGBmVZ2vT8M8g/q9Zy/PGxckGf1xRympRHDmBFI4w2+vvnl9wc5enp+0BDN7Ontw8uDkoRtf",
-	"VSB5JWZPZ4/xJzw9a9z3U09ss6fvP8xnp2vgJQbSuz82YLXIwycNvNj5/5trvlqBPvE1i91PV49Og1hx",
-	"+t5HSH7Y9+00Lv91+r4TSFoc6InlgU7fh6y3+1t30sr6ANqow0Qo9jU7XWAyralNwUSNx5eCyoY5fY/i",
-	"8ujvpz77T/ojqi10Hk5DtHW6ZQdL7+3WwdrrkXObr+vq9D3+B+kzAove2p7arTxF8/Tp+85q/OfBarq/",
-	"t93jFlcbVUAAWC2XlMV73+fT9/RvNBFsK9DCCX4Y3+5/pXdIp5hbbzf8eSfz5I/DdQxKaCZN/a8o8Q9n",
-	"pTA2XchnhueVjvp5gRzY9t+DUD0ucg/hMX704MFRpcWnRZf2X6EM77Qh89q3sg/z2ZMjAd1r/em83U0A",
-	"8zUvWIhow7kffry5zyU+KnFcmdGtgxA8+XgQdIuffQ879qOy7FtUjz7MZ198zJ04l05Y4yXDllFu4+ER",
-	"+VleSnUtQ0snrtSbDde7ycfH8pVBV4QWV9wLi1E9zNk7DLWlKMfuUTsrigHRk9gGxn6t8P4bw9jGrCqf",
-	"qaNFWiu1CumWMFR7B6i6WEPiQRc9OwjBJlIVMIvlSatr+HBLntDzanFtzxNWHDRHYoXKZchGHoGafJ3U",
-	"9xDRyEON4xAJt0n5Tb3YCBPUhT94yh88RdP0jz/e9K9BX4kc2AVsKqW5FuWO/SybPGs35nFnRZF80tk9",
-	"+gd53Hy2zXJVwApk5hlYtlDFLtSr6ExwCaSgDgSZ0/fdonMk0s0KKMEmn6u53xlnK8yXOFzEYsfOnw8k",
-	"HOrW57xf77BpVMzt6Zv3pOE59aVVwPogDjhjXEesz5vepbnmPrJ3C1kpywgLhV/UH4zoD0Z0K+Fm8uGZ",
-	"It8ktQ/KYsoHd/Y8JCRNpbvmdgjKFB3lkx7fO9n4of6T0nfoaSwULPpAcYJ9NP/BIv5gEbdjEd9B4jDi",
-	"qfVMI0F0x+lDUxkGBmwX/dLO6OQIzeuS6yg89JCZ4wxH9MaNj8E1PrZSl8QV6XRcttXvExt4t3reHyzv",
-	"D5b378Pyzg4zmq5gcmvN6BJ2G141+pBZ17ZQ15GfA2GhGKShHdh9rE3/79NrLmy2VNonWsHSZ8POFnh5",
-	"6rMq935tExkOvmB2xujH+MlL8tfTprJk8mPfRZL66l0EI41C1Hz43LpLY/cjsvbG8fjmnWPLWLfIc/3W",
-	"m/b09BSTF6yVsaezD/P3PU9b/PFdQwLvm7vCk8KHdx/+XwAAAP//YEWO0mbdAAA=",
+	"H4sIAAAAAAAC/+x9/XPcNrLgv4Ka96oc+2YkfyVv462td0qcZHVxEpelZO+92LfBkD0ziDgAFwAlTXz6",
+	"36+6AZAgCXI4kmLvXuUnW0N8NBqNRnejP97PMrUtlQRpzezF+1nJNd+CBU1/8SxTlbQLkeNfOZhMi9IK",
+	"JWcvwjdmrBZyPZvPBP5acruZzWeSb2H2Iu4/n2n4RyU05LMXVlcwn5lsA1uOA9tdia3rka4Xa7XwQ5y4",
+	"IU5fzm5GPvA812BMH8ofZLFjQmZFlQOzmkvDM/xk2JWwG2Y3wjDfmQnJlASmVsxuWo3ZSkCRm6OwyH9U",
+	"oHfRKv3kw0u6aUBcaFVAH84v1XYpJASooAaq3hBmFcthRY023DKcAWENDa1iBrjONmyl9B5QHRAxvCCr",
+	"7ezFzzMDMgdNu5WBuKT/rjTAb7CwXK/Bzt7NU4tbWdALK7aJpZ167GswVWENo7a0xrW4BMmw1xH7rjKW",
+	"LYFxyd58/SV79uzZ57iQLbcWck9kg6tqZo/X5LrPXsxybiF87tMaL9ZKc5kv6vZvvv6S5j/zC5zaihsD",
+	"6cNygl/Y6cuhBYSOCRIS0sKa9qFF/dgjcSian5ewUhom7olrfK+bEs//UXcl4zbblEpIm9gXRl+Z+5zk",
+	"YVH3MR5WA9BqXyKmNA768+PF5+/eP5k/eXzzbz+fLP7b//nps5uJy/+yHncPBpINs0prkNlusdbA6bRs",
+	"uOzj442nB7NRVZGzDb+kzedbYvW+L8O+jnVe8qJCOhGZVifFWhnGPRnlsOJVYVmYmFWyAGNoNE/tTBhW",
+	"anUpcsjnTEh2tRHZhmXcuCGoHbsSRYE0WBnIh2gtvbqRw3QTowThuhU+aEH/vMho1rUHE3BN3GCRFcrA",
+	"wqo911O4cbjMWXyhNHeVOeyyYucbYDQ5fnCXLeFOIk0XxY5Z2tecccM4C1fTnIkV26mKXdHmFOKC+vvV",
+	"INa2DJFGm9O6R/HwDqGvh4wE8pZKFcAlIS+cuz7K5EqsKw2GXW3Abvydp8GUShpgavkrZBa3/X+d/fA9",
+	"U5p9B8bwNbzm2QUDmakc8iN2umJS2Yg0PC0RDrHn0Do8XKlL/lejkCa2Zl3y7CJ9oxdiKxKr+o5fi221",
+	"ZbLaLkHjloYrxCqmwVZaDgHkRtxDilt+3Z/0XFcyo/1vpm3JckhtwpQF3xHCtvz6L4/nHhzDeFGwEmQu",
+	"5JrZazkox+Hc+8FbaFXJfIKYY3FPo4vVlJCJlYCc1aOMQOKn2QePkIfB0whfEThC7gFHyGngSLhO0Aye",
+	"bvzCSr6GiGSO2I+eudFXqy5A1oTOljv6VGq4FKoydacBGGnqcQlcKguLUsNKJGjszKPDMM5cG8+Bt14G",
+	"ypS0XEjImZAOaGXBMatBmKIJx/Wd/i2+5AY+ez672fd14u6vVHfXR3d80m5To4U7komrE7/6A5uWrFr9",
+	"J+iH8dxGrBfu595GivU53jYrUdBN9CvuX0BDZYgJtBAR7iYj1pLbSsOLt/IR/sUW7MxymXOd4y9b99N3",
+	"VWHFmVjjT4X76ZVai+xMrAeQWcOaVLio29b9g+Ol2bG9TuoVr5S6qMp4QVlLcV3u2OnLoU12Yx5KmCe1",
+	"thsrHufXQRk5tIe9rjdyAMhB3JUcG17ATgNCy7MV/XO9InriK/0b/lOWBfa25SqFWqRjfyWT+cCbFU7K",
+	"shAZRyS+8Z/xKzIBcIoEb1oc04X64n0EYqlVCdoKNygvy0WhMl4sjOWWRvp3DavZi9m/HTf2l2PX3RxH",
+	"k7/CXmfUCUVWJwYteFkeMMZrFH3MCLNABk2fiE04tkdCk5BuE5GUBLLgAi65tEezeepMNgf4Zz9Tg28n",
+	"7Th8d1SwQYQz13AJxknAruEDwyLUM0IrI7SSQLou1LL+4ZOTsmwwSN9PytLhg6RHECSYwbUw1jyk5fPm",
+	"JMXznL48Yt/EY5MortC8tAQvauDdsPK3lr/FatuSX0Mz4gPDaDvRWHMzr9FgDNj7oDhSKzaqQKlnL61g",
+	"47/6tjGZ4e+TOv9rkFiM22HiwlbMY87pOPRLpNx80qGcPuF4c88RO+n2vR3Z4ChpgrkVrYzupxt3BI81",
+	"Cq80Lx2A/ou7S4UkJc01crDekZtOZHRJmJvPMa0RVLc+a3vPQxIS/NCF4YtCZRd/5WZzD2d+GcbqHz+a",
+	"hm2A56DZhpvN0SwlZcTHqxltyhHDhqTgs2U01VG9xPta3p6l5dzyo1kX3rRY4lBP/YjpgU7oLj/Qf3jB",
+	"8DOebW6D6o5mC0FHVEWPDDlq+05BcDNhA9x4q9jWKfgMte6DoPyymTy9T5P26CtnU/A75BdBO6Su7/0Y",
+	"fKGuUzB8oa57R0Bdg7kP+lDX7j/CwtZMgO+lh0zR/nv0ca35ro9kGnsKknGBKLoaOg0yvvFxlsY4e7JU",
+	"+nbcp8NWJGtMzozjqBHznXeQRE2rcuFJMWG2cg06AzWvfONMozt8CmMtLJxZ/jtgwVgeAX8HLLQHum8s",
+	"qG0pCrgH0t8kmT4aCZ49ZWd/Pfn0ydO/P/30MyTJUqu15lu23Fkw7BOvmzFjdwU87K9sPnOqc3r0z54H",
+	"Q2V73NQ4RlU6gy0v+0M5A6gTgVwzhu36WGujmVZdAzjlcJ4DcnKHduZs+3QoEf3SVIbUhHtnhe3hk6IB",
+	"M5KXZqNsQANfa4AtOFq2iI9sI5rHaalyJ1m9FIYbA9vlvdDR0F7nzSw580jMYe85OHRnmml20e681Dtd",
+	"3YcWDlornTANEnewKlPF4hK0ESrxEPTat2C+RZDMy+7vDlp2xQ3DuclqXUmShRKHAs3Rk68sN/T5tWxw",
+	"M3ppufUmVufnnbIvbeQHI6hhJT6yXUuWw7Jat5S4lVZbxllOHYlGvwFLUsy52MKZ5dvyh9XqfrRcRQMl",
+	"tE2xBYMzMdeCCckMZEo6J449iqUfdQp6uogJ1kU7DIDHyNlOZmQivY9jO6xzb4Wk9xqzk1mkgCOMBeRr",
+	"0BPwMV3RHkKHm+qBSYCD6HhFn4k7voTC8q+VPm+MmN9oVZX3zpS7c05dDveL8Xw5x75B/RdyXbQdh9YI",
+	"+1FqjR9lQV+G4+vXQNATRb4S642NNKLXWqnV/cOYmiUFKH1w+mSBffpa5fcqR2ZiK3MP0mMzWMPhkG5j",
+	"vsaXqrKM09VLm1+ZtFw54GpCb9z0NG9jUdVunIq4BKSujFe4WjTpq9R90XRc8Myd0AWhxqQnbN5LXSs3",
+	"nXNjKDTwHM1QIJla+rct/+pGi+T0al6LJF6qTfCLFlylVhkYg+ZDZxTaC1po564OO4InApwArmdhRrEV",
+	"13cG9uJyL5wXsFuQj4dhn3z7k3n4EeC1yvJiD2KpTQq9tYVCyAGop00/RnDdyWOy4xpYuFeYVSSIF2Bh",
+	"CIUH4WRw/7oQ9Xbx7mi5BE1Pib8rxYdJ7kZANai/M73fFdqqHPBc9Jo5Sni4YZJLFQSr1GAFN3axjy1j",
+	"o3gtBlcQccIUJ6aBBwSvV9xY9/wtZE5WO3ed0DzUh6YYBnhQDcGRfwoaSH/sLGiatTpiqrJU2kKeWgP6",
+	"TAzP9T1c13OpVTR2rfNYxSoD+0YewlI0vkeWW4lDELf1K5H3D+kvjt5S8J7fJVHZAqJBxBggZ6FVhN3Y",
+	"e2sAEGEaRDvCEaZDObXL2HxmrCpL5BZ2Ucm63xCazlzrE/tj07ZPXNw293auwJDTmG/vIb9ymHV+extu",
+	"mIeDbfkFyh5kwXHv9H2Y8TAujJAZLMYon1Q8bBUfgb2HtCrXmuewyKHgu/6gP7rPzH0eG4B2vFF3lYWF",
+	"c8BKb3pDycHfZWRoReMlmOb3itEXluERRFWgIRDfe8/IOdDYKebk6ehBPRTNldyiMB4t2211YkS6DS+V",
+	"xR13jRzInqNPAXgAD/XQt0cFdV40umd3iv8C4ycIbW4xyQ7M0BKa8Q9awID51/u2R+elw947HDjJNgfZ",
+	"2B4+MnRkB2zRr7m2IhMl6Trfwu7eVb/uBGkzaA6WCzQyRh+cGljG/ZlzHeqOeTtVcJLtrQ9+z/iWWE4h",
+	"DIk8beAvYEc692vnkxqZOu5Dl02MyoRzNUdAg6cbiuBxE7jmmS12jNMlvGNXoIGZarkV1jpf87aqa1W5",
+	"iAdIPsmMzOjfH50/Z9iBKQ+iZzRUtLz+VsxnTicYh++8oxi00OF1gVKpYoKFrIeMJASTXFVYqXDXhXd7",
+	"D47PgZJaQHqmXewCuP6qiNFMK2D/pSqWcUkqV2WhlmmUJkEB+9IMwkRzeqeUBkNQ0JNEjZ1Hj7oLf/TI",
+	"77kwbAVXIVbk0aM+Oh49IjvOa2Vs63Ddgz0Uj9tp4vqgtyq8+LwW0uUp+50i/MhTdvJ1Z/AwKZ0pYzzh",
+	"4vLvzAA6J/N6ytpjGpnmEGKvJ648Wk9y3bTvZ2JbFbd9bWsvGC55sVCXoLXIYS8n9xMLJb+65MUPdTeK",
+	"g4EMaTSDRUbRGxPHgnPs4wI+9umGjSOc2G4hF9xCsWOlhgxyZy4XhpkaxiPmXBezDZdrkvS1qtbed86N",
+	"Q5waA4IoBKOSvSGS0pC9lguyTqc4t/eXDjEqKAcBR12sa9p2mscVr+eDvMXQJyKva+pPvm7NZ4OqKiL1",
+	"slFVHXLagTYTuHhLUIvw00w88Q2EUIdCSx9f8bbgKcDN/X1s7c3QKSj7E0fefM3HIYc+1JOL3T1IK24g",
+	"pqHUYOhuie1Lxn1Vqziozl8+ZmcsbPsmeNf17wPH782goqdkISQstkrCLhlHLiR8Rx9Tvd39NtCZJI2h",
+	"vl3loQV/B6z2PFOo8a74pd3untDuU5P5Wun7est0A06Wyyc8He59J/dT3vaBE8PL+m+CPuSmywDMvA7x",
+	"F5pxY1QmSNg6zc3cHTT/jOjjc9rof107Et/D2euO23n8iqM5ybgLRck4ywpBpl8ljdVVZt9KTsalaKkJ",
+	"h6ugRQ+bG2svmbR9M2F+9EO9lZyc7WqTU9LTYgUJ+8rXAMHqaKr1GoztKCkrgLfStxKSVVJYmmuLx2Xh",
+	"zksJmryejlzLLd+xFdKEVew30IotK9sW2ymizFg0XrqXOJyGqdVbyS0rgBvLvhPo54HDhdf6cGQl2Cul",
+	"L2ospG/3NUgwwizSjmHfuK/ks+uXv/H+u/h/39m93eD4TdjZzkIrqv3/fPKfLzCanS9+e7z4/H8cv3v/",
+	"/Obho96PT2/+8pf/2/7p2c1fHv7nv6d2KsAu8kHIT196lfb0JektzeNND/YPZrjHIMkkkcVuGB3aYp9Q",
+	"bK8noIdtq5bdwFuJPjZWYWi5yLm9HTl0b5jeWXSno0M1rY3oWLHCWg/UBu7AZViCyXRY462lqL4vZTqy",
+	"EDcyBAtiK7aqpNvKIH27wJngGKZW8zp61CWWecEotHDDg0Om//Ppp5/N5k1IYP19Np/5r+8SlCzy61Tg",
+	"Zw7XKSXPHxA6GA8MK/nOgE1zD4I96QPnnDLiYbeA1gGzEeWH5xTGimWaw4VwBG8supan0sUJ4Pmht8md",
+	"f/JQqw8Pt9UAOZR2k0o40RLUqFWzmwAdfxEMGAI5Z+IIjrrGmhz1Re+NVwBfIYG69zU1RRuqz4EjtEAV",
+	"EdbjhUyyiKToh0Qez61v5jN/+Zt7V4f8wCm4unPWD5Hhb6vYg2++OmfHnmGaB4QtP3QUNZpQpd2HtieR",
+	"Zdyn2XFC3lv5Vr6ElZACv794K3Nu+fGSG5GZ48qA/oIXXGZwtFbsRYi1esktfyt7ktZgJqwoyo2V1bIQ",
+	"GRqiU+Tpspv0R3j79mc0x759+67nVNFXH/xUSf7iJligIKwqu/C5GRYarrhOPVqZOjafRqbeo7M6IVtV",
+	"zrLpx2d+/DTP42VpujG6/eWXZYHLj8jQ+AhU3DJmrNJBFhEmQEP7+73yF4PmV8GuUhkw7JctL38W0r5j",
+	"i7fV48fPgLWCVn/xVz7S5K6EydaVwRjirlGFFu7USri2mi9Kvk69jb19+7MFXtLuk7y8xS1AQZe6xTip",
+	"gwFoqGYBAR/DG+DgODjwjxZ35nqFPFzpJdAn2kJqg+JG82J/2/2KwmdvvV2dENzeLlV2s8CznVyVQRIP",
+	"O1On51lzIU1wo8AXGDwEPpPREk2KkF34FDOwLe1u3uquVi1BM7AOYVzyIRf8Rukv6GUBkxKVOfeiOJe7",
+	"bh4CA9YGf+A3cAG7c9Vkzzgk8UA7Dt4MHVSi1Ei6RGKNj60fo7v53h0MIeVlGcLJKa4wkMWLmi5Cn+GD",
+	"7ETeezjEKaJoxWkPIYLrBCKowxAKbrFQHO9OpJ9aHmoZS3fzJRIRBd7PfJNGefKeW/Fqzjf1dwqqWWt1",
+	"ZdiSo9yufBIuF+sdcbHK8DUMSMjx487EiOrWgxANsu/eS950+JzcvtB6900SZNd4gWtOUgrgFyQVUmY6",
+	"/nphJvd+6F8mKLemR9iyIDGpdmx0TIfr1iObXI+BliZg0LIROAIYbYzEks2Gm5AfLJ9HZ3mSDPA75i4Y",
+	"y1hzGrmaRbnS6nw0ged2z2lPu/R5a0KympChJlYtJ2Sbmc+8d3tqO5QkASiHAtZu4a5xIJQmj0KzQQjH",
+	"D6tVISSwRcprLTKDRteMnwNQPn7EmLPAs8kjpMg4ApvexWlg9r2Kz6ZcHwKk9HkgeBibXtSjvyEd9+X8",
+	"uFHkUSWycDHwqpUFDsC9q2N9f3UcbmkYJuScIZu75AVIGzS+ZpBe4hQSWztpUrxnxsMhcXbkAcRdLAet",
+	"iXrcajWxzBSATgt0IxAv1fXCxawmJd7l9RLpPenajr2SB9OlqHlg2FJdk7cPXS3OlXoPLMNwBDAaACj3",
+	"CK6d+g3d5g6YsWnHpakUFRr2SS3bNOQyJE5MmXpAghkil0+irDO3AqBj7GhSOHvld6+S2hZP+pd5c6vN",
+	"m2xqIWoodfyHjlBylwbw17fC1HliXncllqSdotWqkyInEiFTRM+ETDzS9J+CDBRASsGiJUQtLmCX1m2A",
+	"bpyz0C0yXlAiHi53DyNPKA1rYSw0RvTgJ/ExzJOc8v8ptRpenS31Ctf3RjXB39TRGSdby/zgKyBX4pXQ",
+	"6LOKLxDJJWCjrw0p1V9j07Ss1Nps5rLlijzNG2hajD7JRVGl6dXP++1LnPb7miWaakn8VkjnsLKk7M5J",
+	"D8yRqZ2T7uiCX7kFv+L3tt5ppwGb4sQayaU9x7/Iuehw3jF2kCDAFHH0d20QpSMMMoqc7XPHSG6K3viP",
+	"xqyvvcOUh7H3eu2E+N2hO8qNlFxLA+j4KgQ9E6FYImyUHLkf0jpwBnhZivy6Ywt1ow5qzPwgg0dIKdfB",
+	"Au2uH2wPBkikfQMr0JA0IdSfnHd0LS7FKQXxrLSz+CQ2fdD43zal+XZNjYdoolsYwXwSyOE9bnwv4xV1",
+	"lpKoMtCftRLSfva8txeNjR9hmbIbZ2nT+plVGtqIj9Qtwte+TRADinvUKWbP8VTChJIZfbKtYyD3US4m",
+	"MPkWdj9hW1rO7GY+u5shO0X5fsQ9uH5dH7YknslRwhk2W+9SB6Kcl/j8yIuFN/cPMQqtLj2joObhdeAD",
+	"Xzxpyj7/6uTVaw8+WlQL4HpRC26Dq6J25b/MqlzayIED4pkUaeBBg3KCfbT5da67+IngagM+t3mkG/SS",
+	"sDbPP8144clglfbX2sv7/EuVW+LIixWU9YNVY0ylzp03Kn7JRRGsmAHaAd8qWty0TL5JrhAPcOe3rujJ",
+	"cnGv7KZ3utOno6GuPTyJ5vqBUiKlpRPpEyYRK/JvV20W9MB4yjqmVR+jeaW+PSfeyV8r3WL+3rE++fbl",
+	"B+kxxr13t7udPaYGnIlCRYyuaHnEiFrYL+tf8Lw9ehQfpkeP5uyXwn+IQKDfl/53Mgc9epQEK6lXIBsg",
+	"tUHyLTys3QAHUd3lb7Yf/n017dY8udzSarGTGqaNmmzcy1LA0JVf8JUWHgW5/wWNr/jT/qiWZtbenjls",
+	"TSHrsyHv9tpxYevqZhimZNdPhwIrkBqIA6P76BK86bVP17LakrlyYQqRpR9y5NIgz5PugR4bM2o8oPHi",
+	"iJUY8PeQlYjGwmZTEmh1gIzmSCLTJHN4NbhbKn/mKin+UQETOUiLnzRdNp37J0jsNGpPSkQFpT+XH5j6",
+	"RMPfRZGJs2J3BTkCYlyLid0BeuC+rO1yYaG12ZvL1rvnAV5F8Yw9bjriEeTpw1Oz85DetJ/1pykXU+qn",
+	"Bd7k03MPzJGshybMYqXVb5A2JpENLhEV6SciHYF6HyVi77s3Z21Cbsq6NbPv2+7pCuvQxt9ZQQ2LrlOP",
+	"30Y7TZ/qwzbyNpqoSefum8/iI5mGy31kbXezAdZCxytysKCsz+GtkUt3nlxIYMtrOX0qoxbm2I3fnEoP",
+	"c3dXs4JfLXl2kVZQEKZoe1uvolax0DlsgKnj5tzsLPIKqtsKl1akBN1EhfdTlN1S2XDTTlYzGq0CO7b0",
+	"ibnz5CiMSgxTySsuLYSs/o5f+d4G3DMG9rpSmpICmbR4l0MmtrxIax151n+sy8VauCpZlYGoDJMfyFUg",
+	"dFTkS1nV0aAeNacr9njenMmwG7m4FEYsC6AWT1yLJTd0XTb5ZEMXXB5IuzHU/OmE5ptK5hpyuzEOsUax",
+	"WiEkIa92Q1iCvQKQ7DG1e/I5+4QcMIy4hIeIRS8EzV48+Zyez9wfj1O3rK9yNsayc+LZf/M8O03H5IHi",
+	"xkAm6Uc9SuZPcWVOh2+HkdPkuk45S9TSXyj7z9KWS76GtM/fdg9Mri/tJj2JdPAic1ejz1itdkzY9Pxg",
+	"OfKngTgiZH8ODJap7VbYrX+mN2qL9NTUWHKThuFcwT/H02u4wkfydinDY3/HAPVhn7+cEJFaNfkkfc+3",
+	"0EbrnHGXCaoQjR9aKNrBTkOiOaoXUJcJcLjBuXDpJEviFlKubiEtGSUqu1r8CXVVzTNkf0dD4C6Wnz1P",
+	"1Eho5+qWhwH+wfGuwYC+TKNeD5B9kFl8X4yskoutQFb/sInbi07loFtOclo75AUyPvRUyRdHWQySW9Ui",
+	"Nx5x6jsRnhwZ8I6kWK/nIHo8eGUfnDIrnSYPXuEO/fjmlZcytkqnssc2x91LHBqsFnAJ+eAm4Zh33Atd",
+	"TNqFu0D/cd+Qg8gZiWXhLCcVgWB0Gou+QhH+p+98Td+e7D3gMUY/N3322snSpkHq37Z0PfmFaVT+SIB8",
+	"9IjmQYOXa/rL0/Znx1cePUqnQUvaevDXBvC7qGLUN4V2rAjz4v1AuZT6KdoHe/VRPsgd8QOevqUfas7a",
+	"pSk+/PV1P27EaVeRNOGiZwh+CXigP7qI+MinlDawcYZzKxkglKg0T5Jk8vp75KTG2RfqeirhdJhfIJ5/",
+	"AhQNoGSiXYhW0is9lHy83es9ENEojrqEQqF2Y1WSNO+K53HUILzzEQRVosh/anJLdNi15jLbJL1yltjx",
+	"700R2xoqx91SC8UnIwlFcjinB/096EsJje5XNXWerZAT23arVbnldhbXAN4GMwAVJkT0ClvgBDFW22H7",
+	"dVhYsVY5o3mabLgNP+tXOYvL7bzWqlQmJXGfsNJ/81ecAZDdBN21+1qiTtwiF2swA/me3bc6CxzNFOqi",
+	"pXNGqKvkYH+rUwPXtpCMax1Mh65bs5RMQ+5y+yXXU4IWKk8bJ5QWayHxNZYapdflvuGwTepkBxWVo/FD",
+	"FDsPEQy4IzZzuWZ7bHkejaFXGFwfsa/Q5lGnrHCQoGNeuAFBMqkc2pkwruZRzqpSyeQulHxXKJ4vQnzP",
+	"2H74RAeNmdDNvuHGBRiEMdLYDjOFtBt3mqoeZGAuIeXYBLxVehCfBylMAo8Y5N1JKfSSAdeFAD1KUMby",
+	"dfJxqZnXqJWl/WJ2o8Gghu0IaUkqdmryaeTcfYLt0HaKAuftY10fyWYhNSYThJLa0XdTONPfAKuPDCSc",
+	"Q8xcUQMXo0B2Nxegxjv864NwqT94xHx2dcsNqyePgwYp9khl3Co9qW7UbejYAzxKjQNek7esGtelxBx4",
+	"XggJ6WzudV+4tlTWSlWWwXUJmW2Xi5mzLXBTkYN5qBPhyif6EbR7m2nng09T14qSDUGGST13iwMAXJE/",
+	"vu/4wcCVvCxHeWmYlC6FUHjHUbmQTu2t8zld4+ZB6RRiw664oBivcEJWqijUFf6CrQaulJHz3+bOrcUO",
+	"JgMiRjYQndXmc2ZcUJsUD94XDxOx4Xt17BrllNLYPaYpd0DYUE5U6jlQd4RIzZTRC2FrddMIBbdsfFto",
+	"6wPL716gnZEOhhQ7TQPU8qIQYIb5qKk5aAi1CZDTH23quiMd/KQsnOOdtDeTY7At1AyYEN4c0Bhvre2e",
+	"N1xwkPvER6HB0CjjbiCfhsg6+WNAJo+IoM21o7tpMfXSUyv6U4fw/HoMjJV3P/nw/XAtAvHRIbvgHlbT",
+	"JoIOu5p0Kob71BLp/lVzBz+TAHjRK6aJRENUM83B/hu0YgKfcy6kupLDcVp6b9mknKJyMxtw3XDEMFty",
+	"cAetOVx0odV42WUj1hswllHI1O05rhd79x237jFrNqXG1TxFqM1iU6cnlBz9R5UUjf0HF7mOnelqdOVG",
+	"GcicHCmO2DeUXwsJr1UJgRwYQqrqdtrWqkQNYU4ptNGBnrlZXR8NttK+3Oma3u/bRo6kw9X0NLYhf9hA",
+	"fqbp44wnjMFVG7uoq5OmMmBii6Z+qui4xtPLfoydI/bSOVWY8GTvJmGUQZ101Ho6/6xHJiP8j7U822AD",
+	"1TJND1vEptfpDUarxpeLh/9nTXEsUhsQbl+q11XqnTOFUtuVwKTYG26RCbY1Wz9JI3q6JJzt5elKSkcp",
+	"Rwe8EtSlsA5Fe+uSrd2Mk5B1EH/gW7Wr0H1o2eIz6pUiyl4N5I4fcEjhGBK5s++8u1HGpZIiI2019cRB",
+	"CQKnOS5OKCqS9jg0M39CE4crWXm5zgXgsThYi3k+ayGu7wQcfcVNddTh/rSkrG24ZWuwxnM2vOR97XPv",
+	"IiekAV/sDIko5pNKJyITUo8Ii9ql+kAyotxfAz4PX+O3771HDB5BdiEkSRIebf7hzDmxYR4bpHbJhGVr",
+	"Bcavp50A1fyMfY4oF2gO1++OXqm1yM7EmsZw0S64bBfa1R/qJAR6+cAqbPsltvUVGuqfWzEdbtKTsvST",
+	"DlfGTz4XYBWCIQQnXkgWwbU8Qm49fjzaCLmNRmjSfYqEhjU3nJSK93BfOg2l1tujYMWNylEUtWAuTj1p",
+	"ck8q/K+EDKJW+oLIklcCbYyTm9L9TKa5zTYtNrQvrqsOXOkyNGO9V+5dh+pscNBCslmYY3gbmyrxA4yj",
+	"btC863C5Y+FQIHVHwsSXvKgjHBM130mq8kJUzm2TdzZUgU8xDmTciy0YE6L3umWgun4SLZnIdadiLYfe",
+	"REOZMJdVvgaLWRZT9vgv6CujryyvNBkIryGr6hplZckQqG4m/D61+YkyJU21HZkrNLjjdLkw3BjYLlN6",
+	"6Mv6I+T1DiOlobEM/00V6BreGR/beHCugxDImB9W/qGfuyEl9SJNLzD/2nRM0J1yd3Q0U9+O0Jv+90rp",
+	"hVq3AfnA+a/HuFy8Ryn+9hVeHHF66F4Yqbta6uzNFLKp6HtIeFbnHe1Ywrkj2t6cfvMSW9YBPjRMAn7J",
+	"i4H8IrHfmbtfncliKMtINpgUh1ufns9yNsqCBlOeuejBjidb36lwKGLQBQzenzuZX+soQkOEdR+gb0P6",
+	"BlZy4aNGGmbRx6wPj+0nQpoSzNpscHcRPpnNoMfTt5dDiWeCQZC+x1VnvF//3D8OwqVQld+wOioyqITu",
+	"1xWlJWxXlxlYfzI8+GO7kw06v5378sZumV4n//YnF0PLQFq9+ydwhetterd0UULapRYRwXoVuOdUM6DU",
+	"tm7FKZWSUkV5vGwYbGWOtbRoqVfkqEdWL6eIAz183Mxnp/lBF2aqsNPMjZI6dq/QCEl1If4KPAf9ek/d",
+	"i6bWBR2xUhnRFKgtcDD/UL2h4Y6mhh8jAYu4bkd/rPCUcwmZparETbiNBjikigdO5k/BH/UvRtTpOkrb",
+	"l70Yq3XRL0W8547vpaOLUioOvdMPVnY4qYMqiU+TI8caJFk0805qo8kJVlYryKy43JP+728bkFFquXmw",
+	"y7in6igboKgzG1Rpn5J95qIGoILfEp6C3x84Q+mmLmD3wLAWNSTrytaZOG6TOJww4PxIykEXSmdI9nEk",
+	"wtSUQVgIQYKuOzQlWFKMhKaLklnecq5AkozHCS5HpkzXxJ80F3Y9KO0rBekPpfvol9Qe1j9eUgVz40Nm",
+	"eJ14PNbS0eDYLc905ROXU7LG+u0keCOBCb+FzKxulkJcQOPA4V+q8FkwtEiaXoJVZzFyH/XS+jGRBnpV",
+	"zyyakO6+93l/j112hKxQKEYshlJMtN9W6xCkB8bFirn6s6A9XCvQ2lEAtsSxYWFVcEMbg2MMFYYC4m6F",
+	"BDNYZMsBN5j6/k2T25+KDXJKdc99HFy8QKZhyxE6HWXgH55zDNlfuu8hV1bwOdprYarpdX/V4xDML0wP",
+	"iTHVr5i/Lffn4LqNsUlICXoRXp666fgl6PZrSKlVXmXugo4PRm2Qm/zUPsJKknaarL/Kjo4Q5bK6gN2x",
+	"90L35aLDDsZAO8nJgR6lce5s8r2a30wK7vW9gPcxLVfzWalUsRh47Djt1xDoUvyFwAo8DG+KEPQ6UMKf",
+	"fUI29vo1+2qzCznzyxIk5A+PGEPbF7nTOoA7RSw7k8sHdmz+a5o1r1xZD29UO3or0/Ha5Get78jNwjDj",
+	"PMyAzO88lRtkfCJ7PVC/AAviGHowHuCM41p5/6m561bTEJWDIiWTnLkXqy/poKcMR5QULUqpRw+ZnPmX",
+	"LmYKlQqyvE3iNhwqjal4MgLIgpwgltGAcRa5JAK8F4/nQaGQf1LxKngGLiW3CbFxdbZfn8/ZvbC0q+ZP",
+	"1L/Oo9RJVjHlIbldSt+4Bp3Zy+7J+8MnzoG4LkkooEGHly4tJuqUMcEDuWl0cNH+VsWQGveppzqSrkJm",
+	"lKGEUOH71OVQJ2FZrqC9JBzo8MVE6bfG1jJYjOp0RZYIQT4WOtBbux6O79zmS+2FUsi6oZw1PP+1MqHW",
+	"jEbAit2fGa9HcXIvlXJDelsV6grn27oA4l/J3f3OpndPmKOnL0kHffcBsIaFzPwTkm1POo/o7zCc9Zld",
+	"bZSBbqJnfzhzkPtzx5Xl6cteDujx94VAvt/S0cQ9ws1EAvVlqi8ASl/8vWWdNwcTbZxadr+3ksPVnp0M",
+	"MtgEXupDktaUMI1EE9zhVt7fkHWIy6ZSzT1tbZrL7tnG/emqR44xlfOZkuv590o6vQ82GqQxrNwjeN18",
+	"x///noDUNTB8BFpszF9gQbrAkSYR+ojVo0lY3SReuffEmo19w7HLKN/lIaxyUprNOBrqVnk1m3SaHm9j",
+	"u/mFut5zH7liYtKSTR43LsqdMM6x5s7GE95hsZtYUYBBKmfvXm6Gp0Rd+cj6pbqeztPS7pPnHqZUNpRJ",
+	"eWlGHlpx3IC0W4ydPpXzkBxkv7i/Ny6gDglotqsJC+jvDUbTLUhrXtQFF1NiakESVmwUCiWmm27MKkoj",
+	"WccXcOMNhhi0nbNMaQ1Z3CMdv+eA2ioNi0Kt02Har8TKGlaIrUDilaxQa6ZK3AZXtzTNfYbmqiRuAEZM",
+	"R97dCQxQtS96a1LM92F1n6lTom3H+TMtyOS3nszwsY/LqdoUAXCLXjifuoGUJmB80n+PIde4Dy/RjUvI",
+	"3X3TPqhiaOuybqeTpR6s1JBBnWM3Qph3Jg72O7vRqlpvonJN7EoURXi4wm3QVQgtikah0qzU3njGXkvA",
+	"xuIAF1BSeOAWtkrvnMoRAg7mrDIVedUjcM/ZVrmgWpCWKQmGfYIMQKuiaD9ZOgPu2vthfMevT7LMvlLq",
+	"ApPHPvwzUwXaXDxUOGNQVHy5Efb88WNnZ5pj4JlEsuY622D9R5rAuXtLZWuc5vOQ07MbflKvZiy21clc",
+	"QZScLHi0lB0T/LSJTAbMAz1yInADozhY8vG8rue/sU8IisCcwGP3u4ec9BfWXVeb3aYt7ieScau2Iksf",
+	"u3+twJDBcI4B6uk/woSz7+k5jlELZsxgOM8rb90LQZaRiKIks6ps1xSMw729pT26rVzRjfFwtmTZyDB6",
+	"WNThFpiOES/t810Xv51Soe0OwCQ04ZQ1KF2t9YtGQLoDDLH0mqK4QepqKUWJA4vP5cE/0DFqp7ExQcZL",
+	"b55pYVPplDThUxMiIMP5CVOZCR89OmJYhSVyMTVYvQT/pPIdfaHyfp0Ub+fdGcVpHOjdmRJfUjzQ9fC5",
+	"0akZCZWxHFsHAJD41EcNSLzyUvvu5S/vCE0MBP9Lr0TdcdkKuO3NHcnQfZnOW/oX2eB7RAcAgtQl7LWV",
+	"JpfW1mtBLQGptdMaSUboAjpR4qRombvBhiPcO1AW7gRUL0KvBvATZ7SZO/Oni/Yjzc19f9jUMboV8Dfj",
+	"VN6SGobCkM4a0tLUpDb/DogCSb16PGbnnJI1L6dG7pjgZTlR+o8AGI7lacEwKaLnUDBWHEM6F9wOKCLk",
+	"RzGPXoN95qdo9JCegGZhGXfKBfrwcVFUGny6f7p/mG77aJbcboKwgs373k7oOQNO5P8NtCIWnc8jH0Eo",
+	"XNaizoO1KhcFXEIrxMnRsqlIDRWXEPqaujPLAUrymO36caRidyI8di94v/ZFFP0xBbvJ136HWLdTbM9T",
+	"ftLx4Fou3DExU48SQnQp8oq38GcOtrK2XFXwKCdQ1bMfLJydAPKp0/zoRngTBjgJ/VM6TMDEu2l86GAW",
+	"lEbdGAPaG8tXmaFTL9OhfHGBjdoJkGbLa2dhR+IN3zAlv5LDTjN9km9MMRP3SSgZIfara8hIqmnHqt0d",
+	"J4wGY0as96+hIYi7OV99FBoeJeHB8VLSrwFvU2lC5INrZFhHTRdeU2/ekCWKyEXhc0t6/u/535wtqzAQ",
+	"2gBdqqJYE3gJwcuViuzWDn5uRaHqDHn4OHQ73t83IIooGhn9s5Wmf6Sy7B8VL8TKZQp04IduzGw4kpB3",
+	"q3X+3j7GDyceF0zmAbBgw1RhKrduMXXMaLgdjhIBjVcgU9p7aG75BcTbQK7sjvNkFlmOqZZbYQxddp3t",
+	"7GPBLz6k5N/yPLLkucJgu9ZNFKo7Yu8/N5lO4qlCPR966MrD5hm+7TiRkRhRExe6HxxiOziPSCC0iohW",
+	"h6TWuXOScPira0OQJEL/WQqrud6NBObu9btJxZeT5LwP7EgAjxwN7m0ZE1P9dAqdjyQRmrSU+96FERFr",
+	"n3dQC8KOp9AHQHGyLN/QMqaA/wFRO2CeikGiJh8Cka309YeYs1DGuOTFiLX0nLw56Zm8U4Q6vI35vikT",
+	"Vrgw+gMI04j2lFoGmtQlUTO8nXKxWoF2kWbGcplzncfNhaQMzhxT7vKduf0bJEKrK5jHTDj5DMmjq7qd",
+	"8Cx6kKQdd4AUO+/PescnwhpAfo9vhRPe+M43kHzfcxq/VQNPen0Y0mn4+TU+w1LCkQEC9IXt6BGWmjEl",
+	"6RnCXfaHzWPEbzA+DdX09UExVtGsU6YYP2c/EOpImv9RCjt60pypqJsBxoXouYMQ6F+umzhhtzl9+i+z",
+	"9GRlO3FPN5to2GsXL+DmG0pX3TZPDuwieUz7jE+xLfIA633LKTvBlL2CtiDFzYxEAoNpol7J28hp9L3I",
+	"lK7G55Ay94mVDrwynJmU57kYeGU5D48Gxp+t9rS1dz2OM/2WjVzJ0xCVqlxkU8LDXNnv3AEQIG3DOPYQ",
+	"PEodtSe9qavTx9TYLlNP45nbyHKdMvl7U3BmYxrkkLY+wEHblmC1Il5GR9jZKJSONfN5Nx1F2xpRMwnG",
+	"mYas0mStu+K7pINUyzNzYdNQhkxebuTwThISFNRQe2J07Mg0T1o9381D7GAJDpmg14Qr5/0vZsjX8/6X",
+	"48Pk0gvAV3tsiFCO01tjMQ6kkqA11FMTDC4Egt1igUOGqglJlu5tq+rT8ntsUPJCH8kn0n8krxMMTQKt",
+	"n3AngU0CYCCTRisHQhQEHlV/085GRNakYHjv8ovvGoP83pBPgiR02ANenBqjaVc7W3hwPnIZte9qpERL",
+	"eTdECa3l78u24RfYvGBEW+S1CmvB+cW6mKH2vkSpVMyXdYaSATGil8hEK2XJ5asoEglQnKJDZyomHCEt",
+	"6EtefPgkJl8LbewJ4QPyN8Nhz3EWjBjJDpXmdjl4X/FJcxf8d5havqakK0OlcU4k80P5x4se8yc1lRfO",
+	"ZbWuboMOfz7DOe40e/IZW/rSuqWGTJjuo4izXEeekJeg0TZaFwMZzzKxb50/KXsHMl6FF0z2fWTcVKRn",
+	"NxA2R/QjM5WBk5uk8hT19cgigb8Ujxr3VmpdFxetQJMhR6V7Tul2e6efeGWUPHfy8mgddOlUBvrrnHxb",
+	"j4fHOAinIL7JRzi5Di4WzF5OSSOYLoCL3SmP4b1Uwj2oDu7vkMHQ4ciP4edNUcxPQzntXd72geqKnf3A",
+	"Qox7rbFxrUwM8AIJRhiqBvl3Xyn6w96lAQIXJdM/qg7Wu6SCc4hJrLU1eTRVVAVzQgFM3y1R7pIyFmSV",
+	"FnZ3hvgPGq/4e9KN8Zs6b5fP+1Ybm/3dZ9UFyPDa12T5qky4Xb9RvKD7yNnAJTCrVHHEvrrm27IITp9/",
+	"ebD8D3j2p+f542dP/mP5p8efPs7g+aefP37MP3/On3z+7Ak8/dOnzx/Dk9Vnny+f5k+fP10+f/r8s08/",
+	"z549f7J8/tnn//FgNp8JBNkBGmJ4Xsz+9+KkWKvFyevTxTkC2+CElwJTo93ckGq5Urh8QmpGJxG2XBSz",
+	"F+Gn/xlO2FGmts3w4deZr8Y+21hbmhfHx1dXV0dxl+M1pfVZWFVlm+Mwz828g/GT16e1b6N7haUdbcw9",
+	"R7OGFE7o25uvzs7ZyevTo4ZgZi9mj48eHz3B8VUJkpdi9mL2jH6i07OhfT/2xDZ78f5mPjveAC/sxv+x",
+	"BatFFj5ROLv/v7ni6zXoI/Jbdz9dPj0OYsXxex+ofTP27Th+4Dt+H/21EPmenvRydfw+xN+Nt46192Pv",
+	"FxB1mAjFWLPjpbo+oCmYqPHwUkjZMMfvSVwe/P3YV/ZNfyS1xZ2H45AqLd2yhaX39hph7fTI0JRclcfv",
+	"6T9EnzfjX49XooCoSSjrc1zH7iafT96QQymp5NPqCb5I1T/z1Z5cKngo58zuqQ7n+9XO6K1iWF7n3rbK",
+	"i+F5q8/laU7s0naqJM5nzoBgHKN9+vhx4DJeho8o5NgfqJm7GSeXSXIzERu7l4qMN/PZ88dP7g3Odobs",
+	"BJin0vmcIOtzLPpmPvv08eMPCYEFLXnBqKWb/tmHm/6k3hWfEcXX6nG3A8WT9Y/Jj640WAAZL/Zqu+V6",
+	"5+hw+vGhC4WvDdnvtbjkXsKqszzK9ezdjT/ELiH+sb2Wx/Roefy+xbX85x7Xav/edI9bXG5VDoExqdXK",
+	"gN3z+fi9+zeaCGtraoGL5EXzqwtaOjZVWRa7/s87mSV/7K+jlSh1DwejJLwmvOS386uaFOvoJm01d+Ue",
+	"01LAdWZNyK4JxjKyMmIfH/DwfsFzFsKw/ylY1/PHzz8cBK3twzQX7Htl2ddIt/8EbPRW3Gva8dnPsuaz",
+	"UrnQ/PZRO8nzHtE79QyM/ULluxGMbc269OV0GqQ12qmQuIS+eetmnjDW9ZbFXG7Q8G6Nt/Es1hvRm+Xm",
+	"jjyh4+vAtT1NWGvp2cEnELE9UJMphLsvwW7kvmVhHwmfvgyTNo6nf/CUP3jKxxHNzkBfigzYOWxLpbkW",
+	"xY79KGuP5lvzuJM8T+Zdbx/9vTwOLX/4QLgG9OAnel0sVb4LmVlaE1yAM0T1BJnj960/vSI6c+4rqZzS",
+	"+DvjbE1FTfuLWO7Y6cuehOO6dTnvFztq2jgxzl78/N5ZctBM0RhauiD2OOM82vMub3qX5ppjZI8LWStb",
+	"O/G4Rf3BiP5gRHcSbiYfninyTVL7cKWGee/OnoeqwS1HScoLTy/sPVCm6Cgf9fjey8b39Z+UvuPy12N8",
+	"WPPBV0TvoPkPFvEHi7gbi0DrTZ8v4Kn1TCNBdIfpQ1MZBkUA5y0Pl5DlNzSvCq6jgIV9Zo4TGtEbNz4E",
+	"1/jQSl0SV06ni/P4JTbwfvW8P1jeHyzvX4flnexnNG3B5M6a0QXstrys9SGzqWyurqL3TIKFQEnYs/Fj",
+	"Zbp/H19xYdEBw1dD4isLOtVZA996U3bzswVeHPuK6J1fmyKkvS9UWTX6MU6tkPz1mLju4MfuC2nqq38h",
+	"HGgUwrvC58ZbIvY+II5f+x38/A65tQF9GS6D5jH9xfExBbNulLHHs5v5+85De/zxXU0Z7+srxFPIzbub",
+	"/zcAXeTVOl4aAQA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -177,197 +177,242 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+y9fXPcNpIw/lXwm7sq27qhJL8ku1ZV6n6ynWR1sR2XpWR3z/KTxZA9M1iRAAOAo5n4",
-	"8Xd/Cg2ABElwhiMp9ubOf9ka4qXRaDT6Dd0fJqkoSsGBazU5+TApqaQFaJD4F01TUXGdsMz8lYFKJSs1",
-	"E3xy4r8RpSXji8l0wsyvJdXLyXTCaQFNG9N/OpHwa8UkZJMTLSuYTlS6hIKagfWmNK3rkdbJQiRuiFM7",
-	"xNmLycctH2iWSVCqD+WPPN8QxtO8yoBoSbmiqfmkyDXTS6KXTBHXmTBOBAci5kQvW43JnEGeqUO/yF8r",
-	"kJtglW7y4SV9bEBMpMihD+dzUcwYBw8V1EDVG0K0IBnMsdGSamJmMLD6hloQBVSmSzIXcgeoFogQXuBV",
-	"MTl5N1HAM5C4WymwFf53LgF+g0RTuQA9eT+NLW6uQSaaFZGlnTnsS1BVrhXBtrjGBVsBJ6bXIXlVKU1m",
-	"QCgnb797Th4/fvzULKSgWkPmiGxwVc3s4Zps98nJJKMa/Oc+rdF8ISTlWVK3f/vdc5z/3C1wbCuqFMQP",
-	"y6n5Qs5eDC3Ad4yQEOMaFrgPLeo3PSKHovl5BnMhYeSe2MZ3uinh/J91V1Kq02UpGNeRfSH4ldjPUR4W",
-	"dN/Gw2oAWu1LgylpBn13nDx9/+Hh9OHxx397d5r8t/vzq8cfRy7/eT3uDgxEG6aVlMDTTbKQQPG0LCnv",
-	"4+Otowe1FFWekSVd4ebTAlm960tMX8s6VzSvDJ2wVIrTfCEUoY6MMpjTKtfET0wqnhs2ZUZz1E6YIqUU",
-	"K5ZBNjXc93rJ0iVJqbJDYDtyzfLc0GClIBuitfjqthymjyFKDFw3wgcu6F8XGc26dmAC1sgNkjQXChIt",
-	"dlxP/sahPCPhhdLcVWq/y4pcLIHg5OaDvWwRd9zQdJ5viMZ9zQhVhBJ/NU0Jm5ONqMg1bk7OrrC/W43B",
-	"WkEM0nBzWveoObxD6OshI4K8mRA5UI7I8+eujzI+Z4tKgiLXS9BLd+dJUKXgCoiY/RNSbbb9v85/fE2E",
-	"JK9AKbqANzS9IsBTkUF2SM7mhAsdkIajJcSh6Tm0DgdX7JL/pxKGJgq1KGl6Fb/Rc1awyKpe0TUrqoLw",
-	"qpiBNFvqrxAtiARdST4EkB1xBykWdN2f9EJWPMX9b6ZtyXKG2pgqc7pBhBV0/c3x1IGjCM1zUgLPGF8Q",
-	"veaDcpyZezd4iRQVz0aIOdrsaXCxqhJSNmeQkXqULZC4aXbBw/h+8DTCVwCOH2QQnHqWHeBwWEdoxpxu",
-	"84WUdAEByRySnxxzw69aXAGvCZ3MNviplLBiolJ1pwEYcertEjgXGpJSwpxFaOzcocMwGNvGceDCyUCp",
-	"4JoyDplhzgi00GCZ1SBMwYTb9Z3+LT6jCr5+MnTHN19H7v5cdHd9646P2m1slNgjGbk6zVd3YOOSVav/",
-	"CP0wnFuxRWJ/7m0kW1yY22bOcryJ/mn2z6OhUsgEWojwd5NiC051JeHkkh+Yv0hCzjXlGZWZ+aWwP72q",
-	"cs3O2cL8lNufXooFS8/ZYgCZNaxRhQu7FfYfM16cHet1VK94KcRVVYYLSluK62xDzl4MbbIdc1/CPK21",
-	"3VDxuFh7ZWTfHnpdb+QAkIO4K6lpeAUbCQZams7xn/Uc6YnO5W/mn7LMTW9dzmOoNXTsrmQ0HzizwmlZ",
-	"5iylBolv3Wfz1TABsIoEbVoc4YV68iEAsZSiBKmZHZSWZZKLlOaJ0lTjSP8uYT45mfzbUWN/ObLd1VEw",
-	"+UvT6xw7GZHVikEJLcs9xnhjRB+1hVkYBo2fkE1YtodCE+N2Ew0pMcOCc1hRrg8blaXFD+oD/M7N1ODb",
-	"SjsW3x0VbBDhxDacgbISsG14T5EA9QTRShCtKJAucjGrf7h/WpYNBvH7aVlafKD0CAwFM1gzpdUDXD5t",
-	"TlI4z9mLQ/J9ODaK4oLnG3M5WFHD3A1zd2u5W6y2Lbk1NCPeUwS3U8hDszUeDUbMvwuKQ7ViKXIj9eyk",
-	"FdP4L65tSGbm91Gd/xgkFuJ2mLhQ0XKYszoO/hIoN/c7lNMnHGfuOSSn3b43IxszSpxgbkQrW/fTjrsF",
-	"jzUKryUtLYDui71LGUclzTaysN6Sm45kdFGYgzMc0BpCdeOztvM8RCFBUujA8CwX6dVfqFrewZmf+bH6",
-	"xw+nIUugGUiypGp5OIlJGeHxakYbc8RMQ1TwySyY6rBe4l0tb8fSMqppsDQHb1wssajHfsj0QEZ0lx/x",
-	"PzQn5rM524b122EPyQUyMGWPs3MyZEbbtwqCnck0QCuEIIVV8InRuveC8nkzeXyfRu3Rt9am4HbILQJ3",
-	"SKzv/Bg8E+sYDM/EuncExBrUXdCHGQfFSA2FGgHfCweZwP136KNS0k0fyTj2GCSbBRrRVeFp4OGNb2Zp",
-	"jLOnMyFvxn06bIWTxuRMqBk1YL7TDpKwaVUmjhQjZivboDNQ4+XbzjS6w8cw1sLCuaa/AxaUGfUusNAe",
-	"6K6xIIqS5XAHpL+MMv0ZVfD4ETn/y+lXDx/98uirrw1JllIsJC3IbKNBkftONyNKb3J40F8ZakdVruOj",
-	"f/3EGyrb48bGUaKSKRS07A9lDaBWBLLNiGnXx1obzbjqGsAxh/MCDCe3aCfWtm9Ae8GUkbCK2Z1sxhDC",
-	"smaWjDhIMthJTPsur5lmEy5RbmR1F6osSClkxL6GR0yLVOTJCqRiIuJNeeNaENfCi7dl93cLLbmmipi5",
-	"0fRbcRQoIpSl13w837dDX6x5g5utnN+uN7I6N++YfWkj31sSFSlBJnrNSQazatHShOZSFISSDDviHf09",
-	"aBQFLlgB55oW5Y/z+d2oigIHiqhsrABlZiK2hZHrFaSC20iIHdqZG3UMerqI8SY6PQyAw8j5hqdoZ7yL",
-	"YzusuBaMo9NDbXgaaLEGxhyyRYssb6+tDqHDTnVPRcAx6HiJn9HQ8QJyTb8T8qKxBH4vRVXeuZDXnXPs",
-	"cqhbjDOlZKav16EZX+Tt6JuFgf0wtsbPsqDn/vi6NSD0SJEv2WKpA7XijRRifvcwxmaJAYofrFKWmz59",
-	"1ey1yAwz0ZW6AxGsGazhcIZuQ75GZ6LShBIuMsDNr1RcOBuI10BHMfq3dSjv6aXVs2ZgqCullVltVRL0",
-	"3vbui6ZjQlN7QhNEjRrwXdVOR9vKTmdjAXIJNNuQGQAnYuYcRM51hYuk6HrWXrxxomGEX7TgKqVIQSnI",
-	"EmeY2gmab2evDr0FTwg4AlzPQpQgcypvDezVaiecV7BJMFBCkfs//KwefAZ4tdA034FYbBNDb63mOy9g",
-	"H+px028juO7kIdlRCcTfK0QLlGZz0DCEwr1wMrh/XYh6u3h7tKxAoj/ud6V4P8ntCKgG9Xem99tCW5UD",
-	"4X9OvTUSntkwTrnwglVssJwqnexiy6ZRSwc3Kwg4YYwT48ADgtdLqrT1ITOeoenLXic4jxXCzBTDAA+q",
-	"IWbkn70G0h87NfcgV5Wq1RFVlaWQGrLYGjist8z1Gtb1XGIejF3rPFqQSsGukYewFIzvkGVXYhFEde1q",
-	"cUEW/cWhQ8Lc85soKltANIjYBsi5bxVgNwyBGgCEqQbRlnCY6lBOHXc1nSgtytJwC51UvO43hKZz2/pU",
-	"/9S07RMX1c29nQlQGHnl2jvIry1mbfDbkiri4CAFvTKyB5pBrLO7D7M5jIliPIVkG+WjimdahUdg5yGt",
-	"yoWkGSQZ5HTTH/Qn+5nYz9sGwB1v1F2hIbFRTPFNbyjZB41sGVrgeComPBL8QlJzBI0q0BCI671j5Axw",
-	"7BhzcnR0rx4K54pukR8Pl223OjIi3oYroc2OO3pAkB1HHwPwAB7qoW+OCuycNLpnd4q/g3IT1HLE/pNs",
-	"QA0toRl/rwUM2FBdgHhwXjrsvcOBo2xzkI3t4CNDR3bAoPuGSs1SVqKu8wNs7lz1604QdTOSDDRlOWQk",
-	"+GDVwDLsT2z8TXfMm6mCo2xvffB7xrfIcnKmUORpA38FG9S539jAzsDUcRe6bGRUcz9RThBQHy5mRPCw",
-	"CaxpqvONEdT0EjbkGiQQVc0KprUN2G6rulqUSThA1K+xZUbnxLNBkX4HxngVz3GoYHn9rZhOrE6wHb6L",
-	"jmLQQofTBUoh8hEWsh4yohCMivcgpTC7zlzsuI8e9pTUAtIxbfTg1tf/PdVCM66A/F1UJKUcVa5KQy3T",
-	"CImCAgqQZgYjgtVzusiOBkOQQwFWk8QvBwfdhR8cuD1niszh2j+4MA276Dg4QDvOG6F063DdgT3UHLez",
-	"yPWBDh9z8TktpMtTdkcWuJHH7OSbzuC1l8icKaUc4Zrl35oBdE7meszaQxoZF1WB447y5QRDx9aN+37O",
-	"iiqn+i68VrCieSJWICXLYCcndxMzwb9d0fzHuhs+JoHU0GgKSYpPIEaOBRemj301sUs3bKLJWFFAxqiG",
-	"fENKCSnYKH8j8qkaxkNi4//SJeULlPSlqBYuAM2Og5y6UtamIiveGyIqDek1T9A6HePcLujYP/QwchBQ",
-	"o4t1TdtW87im9Xzubc+YKzVAXtfUH/VuTSeDqqpB6qpRVS1y2q9VRnDxlqAW4KeZeKQPBFFnhJY+vsJt",
-	"MafAbO7vY2tvho5B2Z84CIlrPg5FxRk9Od/cgbRiByISSgkK75bQvqTsVzEPX6a5y0dtlIaib4K3XX8Z",
-	"OH5vBxU9wXPGISkEh030MTbj8Ao/Ro8T3m8DnVHSGOrbVR5a8HfAas8zhhpvi1/c7e4J7bqa1HdC3pUv",
-	"0w44Wi4f4Trc6Sd3U97UwUnzPOITdO9WugxATet38kwSqpRIGQpbZ5ma2oPm3IjukUsb/W/qaNw7OHvd",
-	"cTvOr/BJJBp3IS8JJWnO0PQruNKySvUlp2hcCpYaiVryWvSwufG5bxK3b0bMj26oS04xYq02OUUjLeYQ",
-	"sa98B+CtjqpaLEDpjpIyB7jkrhXjpOJM41yFOS6JPS8lSAwdOrQtC7ohc0MTWpDfQAoyq3RbbMdnWUqz",
-	"PHeeODMNEfNLTjXJgSpNXjF+scbhvLfeH1kO+lrIqxoL8dt9ARwUU0k8uup7+xUDX93yly4IFp/R28/W",
-	"d2PGb95ubdD21DwN/z/3//Pk3Wny3zT57Th5+h9H7z88+fjgoPfjo4/ffPN/2z89/vjNg//899hOedhj",
-	"j4Yc5GcvnEp79gL1lsZ504P9kxnuC8aTKJGFYRgd2iL38YGsI6AHbauWXsIl12tuCGlFc5YZ3nITcuje",
-	"ML2zaE9Hh2paG9GxYvm17qkN3ILLkAiT6bDGG0tR/YDE+PM89Ca6F3d4XuYVt1vppW/7+sQHhon5tH6C",
-	"abOznBB8n7ekPqrR/fnoq68n0+ZdXf19Mp24r+8jlMyydez1ZAbrmJLnDggejHuKlHSjQMe5B8IejYGz",
-	"QRnhsAUUM5BqycpPzymUZrM4h/Mx/c5YtOZn3Abbm/ODvsmNc3mI+aeHW0uADEq9jGVtaAlq2KrZTYBO",
-	"vEgpxQr4lLBDOOwaazKjL7povBzoHLMHoPYpxmhD9TmwhOapIsB6uJBRFpEY/aDI47j1x+nEXf7qztUh",
-	"N3AMru6ctSPS/60Fuff9txfkyDFMdc8+5LVDB08vI6q0e13UiiQy3MzmqrFC3iW/5C9gzjgz308ueUY1",
-	"PZpRxVJ1VCmQz2hOeQqHC0FO/IOlF1TTS96TtAbTSQVPxUhZzXKWkqtQIWnI06YI6Y9wefmO5gtxefm+",
-	"F1TRVx/cVFH+YidIjCAsKp24BAeJhGsqY04rVT9wx5FtBpNts1ohW1TWsukTKLjx4zyPlqXqPnTtL78s",
-	"c7P8gAyVe8ZptowoLaSXRYyAYqHB/X0t3MUg6bW3q1QKFPlHQct3jOv3JLmsjo8fA2m9/PyHu/INTW5K",
-	"GG1dGXyI2zWq4MKtWglrLWlS0kXMN3Z5+U4DLXH3UV4u0MaR5wS7tV6c+oh6HKpZgMfH8AZYOPZ+PYeL",
-	"O7e9fDKr+BLwE24htjHiRuOxv+l+BW9Qb7xdnXesvV2q9DIxZzu6KmVI3O9MneNmYYQsH0ah2AK1VZcO",
-	"aAYkXUJ65fK0QFHqzbTV3UfqOEHTsw6mbAYf+4IMc0igZ2EGpCoz6kRxyjfdx/wKtPbxwG/hCjYXoklB",
-	"sc/r/fZjcjV0UJFSA+nSEGt4bN0Y3c134WCo2Jelf5ONj/M8WZzUdOH7DB9kK/LewSGOEUXrsfMQIqiM",
-	"IMIS/wAKbrBQM96tSD+2PKNlzOzNF8nm43k/cU0a5clFboWrQau7/V4ApgMT14rMqJHbhctkZR9MB1ys",
-	"UnQBAxJy6NwZ+Sy55RDCQXbde9GbTsy7F1rvvomCbBsnZs1RSgHzxZAKKjOdeD0/k/UfOs8EJqh0CJvl",
-	"KCbVgY2W6VDZcrLZjHtDoMUJGCRvBA4PRhsjoWSzpMon2cJcZP4sj5IBfscEANvSvpwFoWZBwrE6qYvn",
-	"ud1z2tMuXfIXn/HFp3kJVcsRKVuMhI/R7bHtEBwFoAxyWNiF28aeUJpkBM0GGTh+nM9zxoEksai1wAwa",
-	"XDNuDjDy8QEh1gJPRo8QI+MAbPSL48DktQjPJl/sAyR3yRSoHxs96sHfEH/3ZeO4jcgjSsPC2YBXK/Uc",
-	"gLpQx/r+6gTc4jCE8SkxbG5Fc8PmnMbXDNLLPoJiayfXiIvMeDAkzm5xgNiLZa812avoJqsJZSYPdFyg",
-	"2wLxTKwT+/AzKvHO1jND79HQdnyGGjuYNs/LPUVmYo3RPni12FDqHbAMw+HBCDT8NVNIr9hv6Da3wGyb",
-	"drs0FaNChSTjzHk1uQyJE2OmHpBghsjlfpC65UYAdIwdTR5kp/zuVFLb4kn/Mm9utWmTksy/Good/6Ej",
-	"FN2lAfz1rTB1spU3XYklaqdoB62088wEImSM6A2b6Dtp+q4gBTmgUpC0hKjkKuY5NboN4I1z7rsFxgvM",
-	"ZkP55kEQCSVhwZSGxoju4yQ+h3mSYhI9IebDq9OlnJv1vRWivqasGxE7tpb5yVeAocRzJpVO0AMRXYJp",
-	"9J1Cpfo70zQuK7VjrWzKWZbFeQNOewWbJGN5FadXN+8PL8y0r2uWqKoZ8lvGbcDKDFMkRyMwt0xtg3S3",
-	"LvilXfBLemfrHXcaTFMzsTTk0p7jD3IuOpx3GzuIEGCMOPq7NojSLQwyeDnb546B3BT4+A+3WV97hynz",
-	"Y++M2vHvd4fuKDtSdC2BwWDrKhi6iYxYwnSQYbj/pHXgDNCyZNm6Ywu1ow5qzHQvg4fPy9bBAu6uG2wH",
-	"BgK7Z+xVjQTVTsHXCPg2V3QrA87hKMxctBPlhQwhnIopX+mgj6j61d0uXF0AzX+Azc+mLS5n8nE6uZ3p",
-	"NIZrN+IOXL+ptzeKZ3TNW1NayxOyJ8ppWUqxonniDMxDpCnFypEmNvf26E/M6uJmzItvT1++ceB/nE7S",
-	"HKhMalFhcFXYrvzDrMpm+xs4ID6TutH5vMxuRclg8+sUZaFR+noJLiV1II32cmc2DofgKDoj9TweIbTT",
-	"5Ox8I3aJW3wkUNYuksZ8Zz0kba8IXVGWe7uZh3YgmgcXNy4Ba5QrhAPc2rsSOMmSO2U3vdMdPx0Nde3g",
-	"SeFcW5JmFzYvvCKCd13oGPO8KZ3XvaCY+dJaRfrMiVcFWhISlbM0bmPlM2WIg1vfmWlMsPGAMGpGrNiA",
-	"K5ZXLBjLNBuT26YDZDBHFJkqml6nwd1MuJo/FWe/VkBYBlybTxJPZeegYpoUZ23vX6dGdujP5Qa2Fvpm",
-	"+NvIGGHW1+6Nh0BsFzBCT10P3Be1yuwXWlukzA+BS2IPh384Y+9K3OKsd/ThqNkGLy7bHrewRE+f/xnC",
-	"sLnad9cH8sqrSz87MEe03g9TyVyK3yCu56F6HHmw5PPcMoxy+Q3Chw5hlYsWi6mtO03Zomb2we0ekm5C",
-	"K1Q7SGGA6nHnA7ccJtz0FmrK7VbbhyStWLc4wYRRpUd2/IZgHMy9SNycXs9oLBupETIMTKeNA7hlS9eC",
-	"+M4e96p+bWFnJ4EvuW7L7GP0EmTzlrCf2OaGAoOddrSo0EgGSLWhTDC1/r9cicgwFb+m3FZxMf3sUXK9",
-	"FVjjl+l1LSSmklBxs38GKStoHpccsrRv4s3YgtkCJZWCoAKGG8gWf7JU5KqI1G+IHGrO5uR4GpThcbuR",
-	"sRVTbJYDtnhoW8yoQk5eG6LqLmZ5wPVSYfNHI5ovK55JyPRSWcQqQWqhDtWb2nk1A30NwMkxtnv4lNxH",
-	"t51iK3hgsOju58nJw6dodLV/HMcuAFdgZhs3yZCd/NWxkzgdo9/SjmEYtxv1MPrq3laYG2ZcW06T7Trm",
-	"LGFLx+t2n6WCcrqAeKRIsQMm2xd3Ew1pHbzwzJZHUlqKDWE6Pj9oavjTQPS5YX8WDJKKomC6cM4dJQpD",
-	"T015CzupH87WWnKZiT1c/iP6SEvvIuookZ/WaGrvt9iq0ZP9mhbQRuuUUJs/JGdN9ILPl07OfHoiTNVc",
-	"Z2i2uDFzmaWjmIPBDHNSSsY1KhaVnid/JumSSpoa9nc4BG4y+/pJJD11O00q3w/wT453CQrkKo56OUD2",
-	"XoZwfcl9LnhSGI6SPWheewSnctCZG3fbDfkOtw89VigzoySD5Fa1yI0GnPpWhMe3DHhLUqzXsxc97r2y",
-	"T06ZlYyTB63MDv309qWTMgohYzkHm+PuJA4JWjJYYexefJPMmLfcC5mP2oXbQP95PQ9e5AzEMn+WY4rA",
-	"MxHRTn3K9NqS7mLVI9aBoWNqPhgymLmhpqSdnvrT89G7iYKKe7q8Ybvv2DJfPB7wjy4iPjO54AY2vny7",
-	"kgFCCdLzR0kmq78HPnZKnon1WMLpnEJPPP8CKIqipGJ59nPz8rNT/UBSni6jPrOZ6fhLU6etXpy9A6Pp",
-	"A5eUc8ijw1l58xcvl0Yk53+KsfMUjI9s2y3IYJfbWVwDeBtMD5Sf0KCX6dxMEGK1/aiuDtrOFyIjOE+T",
-	"q645rv1CHkG69V8rUDr2QAk/2MAxtI0admCzfRPgGWqkh+R7W4p5CaSViAg1QZ8pov1quipzQbMpZrC4",
-	"+Pb0JbGz2j622pDNNr5ARai9io5NLEjDOS4E2RcOij+PGD/O9nhts2qlkzo5eOwBqmnRpC9nHT8Bqkgh",
-	"dg7Ji6Coqn2raoYgmMBEFkarq0ez8hHShPmP1jRdotrXYq3DJD8+Tb6nShWUpqxLTNW5KfHcGbhdpnyb",
-	"KH9KhNHNr5myFXhhBe03r/UDcGd28G9g28uTFeeWUg73uOXqTJT7ot0DZ69I70qIQtZB/J5Cv60ysW/V",
-	"gHPsFU2V1S1B0KtJaV9Q1qWDfGX1lHLBWYqJqmJXtCvVO8bPNiKnV9eQ64+4O6GRwxUtfFCH4jksDpZC",
-	"8IzQIa5v6A++mk211GH/1FgTdkk1WYBWjrNBNvX1O5ytkXEFLtcoFnYO+KSQLd8lcsioOzyp3SZ7khE+",
-	"vRlQHr8z31470wLGpF8xjkqEQ5sT/Kw1ECuJaqN5ME0WApRbT/v9sXpn+hziU9wM1u8PfeVRHMO6/syy",
-	"rZ+7P9Sp93o7L7Np+9y0dQmS6p9bUc520tOydJMOV3eJygN6zQcRHPFeJt59FCC3Hj8cbQu5bQ1XwfvU",
-	"EBqs0NkNJd7DPcKoK510qmgZodVSFLYgNkwsmiWB8QgYLxmHpi5u5IJIo1cCbgye14F+KpVUWxFwFE+7",
-	"AJqjhzvG0JR27o3bDtVND2VQgmv0cwxvY1OkZYBx1A0awY3yTV2O11B3IEw8xzrgDpH9kisoVTkhKsNX",
-	"C50iLDHGYRi3L/PUvgD6x6AvE9numCtt35to6CHqrMoWoBOaZbHUr8/wK8GvJKtQcoA1pFWdIrQsSYp5",
-	"V9qJaPrU5iZKBVdVsWUu3+CW0wVVjSLUEFZW8juMD11mG/w3lh9zeGdcoMfeoYY+qiPbL/tSP3QyJvUa",
-	"mk4UWyTjMYF3yu3R0Ux9M0Jv+t8ppedi0QbkE6ef2Mblwj2K8bdvzcURZmfoJX21V0udPAED+4SvRYlq",
-	"Y/3st82V8CrrZYFFh1Jd6267AWK4at0UL7+B8N4g6Qa196v1UA4F+aaDMelUu9dxmpKtLGjwxZGNELJv",
-	"ixCKuHV2KCrIBgWZz73e4yTDnpyt44kPA4T6cLM+QD/4WFZSUubc7w2z6GPWRb333yGMiYdtNri7CBdL",
-	"Pmix+2E1FPftk7Hh925VqytwT+ZLCSsmKu/Y9pFPXiW0v7ZqRNWR99H19w2vONXnNYcOGm8vXHUBu0yn",
-	"k//ws42TI8C13PwLmHJ7m96rl9WXdq15qmlC6sTUoxJVt27FMYkKYznxnGzYqti1o95Yj6xejBEH+vXD",
-	"ppOzbK8LM5ZXcWJHiR27eDWw4bRTTaopPGKlUKzJDx8rEzYyxPACK30FabP6Y/n4nhWkGosCNHELEmCf",
-	"JFpmsqDw6Jf0UwPqdB2J6bJObUs11a8EsOOO770GC1402izqh+MTK53W0WnIpzEb8gK4q/3ZfucxOtp8",
-	"PodUs9WO13d/XQIPXnZNvV3G1vAOHuOxOnoZk7fsb3VsANr2OG4rPEESxVuDM/T25go29xRpUUM0rfvU",
-	"X7U3yduBGEDukBgSESoW/WENyc4hz1RNGYgFH21lu0OTAW2wIlTwlvSGc3mSNBdH8750y5TxkjSj5jJd",
-	"93p1jYG4Qw/0+hUthvWPF1hARNXVGn3ej1BLJ2f97IjXLm8IvpWsfSc+gwgo/5t/GG1nydkVhDWr0FN1",
-	"TWXmW0RNL96qk2y5j3qv6nw1hi7Q83pm1sTG9t9RRfJtYQR0mgsjRiRDYeTtcNQ6luOeskE3Nv07Btoa",
-	"uOYgXW0/lH9zoSDRwsfSboNjGypsZNGNkKAGc1xa4AYzz7xtUutgrl+KmWaoCygKF0gkFNRAJ4MEOMNz",
-	"bkP2c/vdPxzyuV53Wphqet1ddMBHRTPVQ2JI9XPibsvdD5JuYmxinNv60SqWDYeDbHtDSimyKrUXdHgw",
-	"aoPc6FxTW1hJ1E6T9lfZ0RGCV51XsDmySpCv1uB3MATaSk4W9CCLQmeT79T8pmJwL+4EvM9puZpOSiHy",
-	"ZMDZcdZP4dOl+CuWXkFGzE3howcHKuiQ+2hjr73Z18uNT1lTlsAhe3BIyCm38dresd3OId2ZnN/T2+Zf",
-	"46xZZbNqOaPa4SWPB75ivit5S27mh9nOwxQYVnfLqewgOxLErAfSB0l6HakndThWK++7mrs1fhqislDE",
-	"ZJKmfM2OOJk6RKap/NGEyfSlgzwX1wlSUVLn/4rpHKZdm0n6jKdNN4PtGQTxNlS5C3RDljQjqZAS0rBH",
-	"/ImDBaoQEpJcYPhNzDM410YeKjCumZNcLIgojZpr0+h5H0q0LE0w112V4LHPdS0EiXX4DCREAOWe5zpw",
-	"beM+vFuq4OxfYediGbHb4Ib53dq7jI4juL2rXwRgjiD03Tar01iVoPa6uvWqhqrHaVGwNI7uP1a0ymCM",
-	"SYx6Y6hwCWjtAzhshgc85Cm1cxJPTx/NwOksj3lsiDt+zkmDdG7+izdYd1wyB8dcBvhZ5AHmtlXHKj9F",
-	"drWeyhWm8m8qBygk6vDe7l+21QBnY73MdcbpkcwgAGDY79yCYZT3eV8w5lhdM6ERJJ/VMv+0VfyYdTie",
-	"zwZoT3ZKrc6/BGLGriS4N362DGCn7lBJ9dLLAKZ5XzM3Wh4ofIBni6dQZe1I3p7lahB2hStRJjmsoOWO",
-	"dw8PqzQFpdgKwvqFtjPJAEq07nZ1jpifOeTtHUHUrT0JPJVjsBuVTC1i7U6RHWJnVEhe88QeEzX2KBmI",
-	"ViyraAt/6haV3IaKuEUuHw/r+3GcYm8mEV/cNhaxMzIEaT56Lnk8MCR891qblHC2rDY9WyJsTrYq6TUf",
-	"VsH6RNnITuNrIAaI/XYNKd5D7ciH2+OE4GBEdd60DwpNst7hm6ryg1S2jch6FSGjUpsCX9E3TD/jBV/X",
-	"NyLtWqMjU5EBmGp4A8ZRQhOnFzQr6IZkbD4Had0qSlOeUZmFzRknKUhNmdExN+rmCoaBVlYw3aljGE6N",
-	"g3pmFdM20EJoAck3Tnkbkv9HyO3oQ4vI7Pba1mKoWGVvV+IPO+ja6DkY4TZABO5JOmo59rAKjiImKegV",
-	"7DmPYr/B9mkwUYyzwmqBs46Z4uNWWv8RUYcH/ifO9FZqt6JfN+TQ+oQsMXoa5IvGMW03p0+DsSjRC1sy",
-	"KYwU7VYg8HttDVR2PhjIqOh4Z4I8VW1x+YIKaiWlzmTXFwd6zNgCM3URtHtJC11zQ7qDKUVZ9MCZaMvq",
-	"Yo7UiZtiLyaMG6jZ8bQb0dK+guptx+qfaSVRiLqmm92J2ZprKB4MbEf26oyPcaihdlttCUzZghLRvGf7",
-	"iCcRmo/VVOhnnLr7xdgo98YP9/stx1na4wsIK7Rvp7dGkPekEqE1yjexo+NtyTdY4JB0MiJO8862qj4t",
-	"v8cGRVn0zRKRjgKtH7MXwWZQOXh7GEWYp7h5AC1t6Ce6Xb0+1OUXrxo9aVwNY99hB3hhdE1Qxdg7Ohw4",
-	"n/kl8asaKcFS3g9RQmv5uwJ23AIbxTLYIieraQ02a7x9fdbelyAaSz2vg5yGCm53Y6EwKbERDvI8EkNl",
-	"xUdb4jYgHHNPyhXNP30cFGarPkV8QPZ22HMaBtKESLaoVDd7xveSjpo7CJq5u6n5G4zb+iuYPYpeC24o",
-	"p7H2mD8K/zS3Vv65r3e5Ak6ucUwb9P3wazJzaU5KCSlTXU342peiquNGsDKjezq51jsCVXat82ehb0HG",
-	"c29YIq+bsjZoyF7wBsLmiH5mpjJwcqNUHqO+HllE8BfjUWG+0R3XxVUrGryR6oIbTUi446jw4H3XnlHh",
-	"/UyqY5dnI5/NpVMp6K9z9G3dwm3kom7WNvZJQx+522qfjHmJEC9pZLrjUwiLEKwHRhBU8o+H/yAS5ljw",
-	"V5CDA5zg4GDqmv7jUfuzOc4HB1El75M9grA4cmO4eWMU8/PQs3j79HsgA0NnPyqWZ7sIo5VPoymZjRkj",
-	"fnFZez5L0e5fbGBm/6i6wqm3iCa3iImstTV5MFWQKWNEkgzXLZISA4Me0koyvcFkwl7jZb9En2t8X4f+",
-	"utDx2oTn7j4trqBOR90EClfK367fC5rjfWQti9zcQiI/JN+uaVHm4A7KN/dmf4LHf36SHT9++KfZn4+/",
-	"Ok7hyVdPj4/p0yf04dPHD+HRn796cgwP518/nT3KHj15NHvy6MnXXz1NHz95OHvy9dM/3TN8yIBsAZ34",
-	"1HWTv2Fl++T0zVlyYYBtcEJL9gNsbBFdQ8a+PC9N8SRCQVk+OfE//f/+hB2momiG979OXGasyVLrUp0c",
-	"HV1fXx+GXY4WGBmYaFGlyyM/T69+7+mbs9oFaY3+uKM2qYR35nhSOMVvb789vyCnb84OG4KZnEyOD48P",
-	"H5rxRQmclmxyMnmMP+HpWeK+Hzlim5x8+DidHC2B5hhIb/4oQEuW+k8SaLZx/1fXdLEAeehqFpufVo+O",
-	"vFhx9MFFSH7c9u0oLP919KEVSJrt6InlgY4++Ky321u30sq6AFqz9Kgt9nvQ7s2E0mFtwpYJYLbxMaBT",
-	"ooR0gWWlZMKcqqm5IjNIJVA8A0JiFhAtK55aS7KdAjj+99Xp39Ca/ur0b+Qbcjx1yWEUqh2x6W3YVE0O",
-	"Z5kFu+9AUM82p3VIclAT4+RdzHISq6WMx8nQSkDt9YgNN0PTeliBvubNht8eJ0/ff/jqzx9jMl9Pgq2R",
-	"FETphqjXwmeGRaQVdP3NEMrWzu1pxv21ArlpFlHQ9SQEuG9qjjxd8lEKPndzq6a2i2hgivzX+Y+viZDE",
-	"6bhvaHpVR2gYkDHhqRQrhik0siDviuk5BLG7/kKgfRFEF+pRqEXZfsVfo/k9ZpNEQPHQPzo+3qsQecf4",
-	"1Cc0TAMTWN/68WyKwJqmOt8QqgIniqpmTebXThyNKJOW93arva8/o6+jFjNm7xtSF0kzg/XKtsN30cmS",
-	"2UKHC3TAuo27nxb0kBGF4H3ssg+31tPIl939n7G7fdmBlMKcaYaBYc2V46+zFpBNNS0H7kC08CH5u6hQ",
-	"wrP1ciGWvh5nQLe+n9M9bgje0DXxKvjl4KC78IMDt+dMkTlcI5OlHBt20XFwcGh26smerGyrNbmVC2DU",
-	"2dlnuN5mvaLrOms4JVzwhGM51xWQQC18cvzwD7vCM44v64xoSqzo/XE6+eoPvGVn3Ag2NCfY0q7m8R92",
-	"NecgVywFcgFFKSSVLN+Qn3idKi5IQd9nfz/xKy6uuUeE0SqroqBy44RoWvOcigfJ+7byn94zhUbQRi5K",
-	"FwpdzCiiTlply/li8v6j1wFGKhbbmh3NMD/u2KaggsbD2gn6D9TRB7SAD/5+5BJ6xj+iJ8KquEf+AWW8",
-	"ZUvx+aDXBtZOj5TqdFmVRx/wP6hyBmDZ9DlHes2PMOLk6ENrNe5zbzXt35vuYYtVITLwAIv53Bbm2fb5",
-	"6IP9N5gI1iVIZu4UfLLqfrWpBY4wXfam//OGp9Ef++voVsWP/Xz0oV2VsYUgtax0Jq6DvugBsO6r/nx1",
-	"nfLW30fXlGkjv7g3ulg1o99ZA82PXEK+zq9NDpzeF0zsE/zYkXhKYd+CtJXNt/T6ohV5KG0w/TOBBoIh",
-	"XrhOZowjgwgZWGPXsx/72kuPbV0swcYQeddoRDzUgsykoFlKFRZjcKkre2rrx1uqRt3Y/7OI4wvBREtA",
-	"/7mnOeqHO70hOO4Y+S/Yl6CGEcrhytoDf2eZqQfRM5oR/3goIa9objYcMnLqJPMWNn5veefzCyifWaL4",
-	"ZCLAM3/4FKH4xK2lu8n4o5ogx+yY+94oeIYBLIAnjgUlM5FtfEkuSa/12r7Z6DK3o7q2WvTjHRgJ/7Ut",
-	"g7sMgl/scF/scF8sNV/scF9294sdbqQd7ouV6ouV6n+llWof01RMzHSmmWFpE2uP0Na8VrejTY6nmsW3",
-	"X4wyXctk/VJWTB8ScoEZdKi5JWAFkuZY7lMFKbEKDIHEd6eQnVzypAWJDTQ0E99v/msjPC+r4+PHQI4f",
-	"dPsozfI85M39vijv4iebf/cbcjm5nPRGklCIFWT20U6YY8T22jns/1eP+2MvORG+UFvSFdTPU4mq5nOW",
-	"MovyXPAFoQvRRCcbvk24wC8gDXA2xSNheurSqDJFrs3iXQWYdiqUtuTelwDOmi3c6dHvkEvcmW8Ib09P",
-	"/n+MceP/r5bSb/HK81aMdOvYPa76hat8Cq7y2fnKH91HGpgP/0eKmU+On/xhFxQam18LTb7DyPvbiWN1",
-	"Va1YpsubClr+Ebc39zXRu2E0LN6idRzsu/fmIsAyuu6CbYI7T46OMJfeUih9NDHXXzvwM/z4vobZ1z6c",
-	"lJKtsJTC+4//LwAA//9Tf3Gr9esAAA==",
+	"H4sIAAAAAAAC/+x9a3cbN5LoX8Hl7j22taQkP5KdaE/OXiVOMtrYjo+lZHY39p2A3SCJURPoAGiJjK/+",
+	"+z1VeDS6G91sSoo9mfUnW2w8CoVCoapQj/eTTK5LKZgwenLyflJSRdfMMIV/0SyTlTAznsNfOdOZ4qXh",
+	"UkxO/DeijeJiOZlOOPxaUrOaTCeCrtnkJO4/nSj2a8UVyycnRlVsOtHZiq0pDGy2JbQOI21mSzlzQ5za",
+	"Ic6eT24GPtA8V0zrLpQ/iGJLuMiKKmfEKCo0zeCTJtfcrIhZcU1cZ8IFkYIRuSBm1WhMFpwVuT70i/y1",
+	"YmobrdJN3r+kmxrEmZIF68L5tVzPuWAeKhaAChtCjCQ5W2CjFTUEZgBYfUMjiWZUZSuykGoHqBaIGF4m",
+	"qvXk5OeJZiJnCncrY/wK/7tQjP3GZoaqJTOTd9PU4haGqZnh68TSzhz2FdNVYTTBtrjGJb9igkCvQ/Ky",
+	"0obMGaGCvPn2a/L06dMvYCFragzLHZH1rqqePV6T7T45meTUMP+5S2u0WEpFRT4L7d98+zXOf+4WOLYV",
+	"1ZqlD8spfCFnz/sW4DsmSIgLw5a4Dw3qhx6JQ1H/PGcLqdjIPbGN73VT4vk/6q5k1GSrUnJhEvtC8Cux",
+	"n5M8LOo+xMMCAI32JWBKwaA/H8++ePf+8fTx8c0//Xw6+2/352dPb0Yu/+sw7g4MJBtmlVJMZNvZUjGK",
+	"p2VFRRcfbxw96JWsipys6BVuPl0jq3d9CfS1rPOKFhXQCc+UPC2WUhPqyChnC1oVhviJSSUKpjWO5qid",
+	"cE1KJa94zvIp4YJcr3i2IhnVdghsR655UQANVprlfbSWXt3AYbqJUQJw3QofuKC/X2TU69qBCbZBbjDL",
+	"CqnZzMgd15O/cajISXyh1HeV3u+yIhcrRnBy+GAvW8SdAJouii0xuK85oZpQ4q+mKeELspUVucbNKfgl",
+	"9nerAaytCSANN6dxj8Lh7UNfBxkJ5M2lLBgViDx/7rooEwu+rBTT5HrFzMrdeYrpUgrNiJz/jWUGtv0/",
+	"zn94RaQiL5nWdMle0+ySMJHJnOWH5GxBhDQRaThaQhxCz751OLhSl/zftASaWOtlSbPL9I1e8DVPrOol",
+	"3fB1tSaiWs+Zgi31V4iRRDFTKdEHkB1xBymu6aY76YWqRIb7X0/bkOWA2rguC7pFhK3p5svjqQNHE1oU",
+	"pGQi52JJzEb0ynEw927wZkpWIh8h5hjY0+hi1SXL+IKznIRRBiBx0+yCh4v94KmFrwgcLnaAw8U4cATb",
+	"JGgGTjd8ISVdsohkDsmPjrnhVyMvmQiETuZb/FQqdsVlpUOnHhhx6mEJXEjDZqViC56gsXOHDk0osW0c",
+	"B147GSiTwlAuWE64sEBLwyyz6oUpmnBY3+ne4nOq2efPJje7vo7c/YVs7/rgjo/abWw0s0cycXXCV3dg",
+	"05JVo/8I/TCeW/PlzP7c2Ui+vIDbZsELvIn+Bvvn0VBpZAINRPi7SfOloKZS7OStOIC/yIycGypyqnL4",
+	"ZW1/elkVhp/zJfxU2J9eyCXPzvmyB5kB1qTChd3W9h8YL82OzSapV7yQ8rIq4wVlDcV1viVnz/s22Y65",
+	"L2GeBm03VjwuNl4Z2beH2YSN7AGyF3clhYaXbKsYQEuzBf6zWSA90YX6Df4pywJ6m3KRQi3QsbuS0Xzg",
+	"zAqnZVnwjAIS37jP8BWYALOKBK1bHOGFevI+ArFUsmTKcDsoLctZITNazLShBkf6Z8UWk5PJPx3V9pcj",
+	"210fRZO/gF7n2AlEVisGzWhZ7jHGaxB99ACzAAaNn5BNWLaHQhMXdhOBlDiw4IJdUWEOJ9PUmawP8M9u",
+	"phrfVtqx+G6pYL0IJ7bhnGkrAduGDzSJUE8QrQTRigLpspDz8MPD07KsMYjfT8vS4gOlR8ZRMGMbro1+",
+	"hMun9UmK5zl7fki+i8dGUVyCeWnOnKgBd8PC3VruFgu2JbeGesQHmuB2grHmZhrQoDUz90FxqFasZAFS",
+	"z05agcZ/dm1jMoPfR3X+Y5BYjNt+4oJWxGHO6jj4S6TcPGxRTpdwnLnnkJy2+96ObGCUNMHcilYG99OO",
+	"O4DHgMJrRUsLoPti71IuUEmzjSysd+SmIxldEub6c0xrCNWtz9rO85CEBD60YfiqkNnln6le3cOZn/ux",
+	"uscPpyErRnOmyIrq1eEkJWXEx6sebcwRg4ao4JN5NNVhWOJ9LW/H0nJq6OGkDW9aLLGox37I9JhK6C4/",
+	"4H9oQeAznG1qvOoOZguOR1RGjww5aPtWQbAzQQPYeCPJ2ir4BLTuvaD8up48vU+j9ugba1NwO+QWgTsk",
+	"N/d+DL6SmxQMX8lN5wjIDdP3QR9yY//DDVvrEfA9d5BJ3H+HPqoU3XaRjGOPQTIsEERXjadBxDc+zFIb",
+	"Z0/nUt2O+7TYiiC1yZlQGDVivtMWkrBpVc4cKSbMVrZBa6D6lW+YabSHT2GsgYVzQ38HLGhDI+DvgIXm",
+	"QPeNBbkuecHugfRXSaYPRoKnT8j5n08/e/zkr08++xxIslRyqeiazLeGafLQ6WZEm23BHnVXNp1Y1Tk9",
+	"+ufPvKGyOW5qHC0rlbE1LbtDWQOoFYFsMwLtulhrohlXHQAcczgvGHByi3Zibft4KAH9Qlca1YR7Z4XN",
+	"4ZOiAdGClnoljUcDXSrG1szSsgF8ZCteP04LmVvJ6jnXVGu2nt8LHfXtdV7PkhOHxJztPAf77kw9zTba",
+	"nedqq6r70MKZUlIlTIPIHYzMZDG7YkpzmXgIeu1aENfCS+Zl+3cLLbmmmsDcaLWuBMpCiUMB5ujRV5Yd",
+	"+mIjatwMXlp2vYnVuXnH7EsT+d4IqkkJj2wbQXI2r5YNJW6h5JpQkmNHpNHvmEEp5oKv2bmh6/KHxeJ+",
+	"tFyJAyW0Tb5mGmYitgXhgmiWSWGdOHYolm7UMehpI8ZbF00/AA4j51uRoYn0Po5tv8695gLfa/RWZJEC",
+	"DjAWLF8yNQIf4xXtPnTYqR7oBDiAjhf4Gbnjc1YY+q1UF7UR8zslq/LemXJ7zrHLoW4xji/n0Ner/1ws",
+	"i6bj0BJgP0yt8aMs6Gt/fN0aEHqkyBd8uTKRRvRaSbm4fxhTs6QAxQ9WnyygT1erfCVzYCam0vcgPdaD",
+	"1RwO6Dbma3QuK0MoXr24+ZVOy5U9rib4xo1P8yYWVc3KqohzBtSV0QpWCyZ9mbov6o4zmtkTOkPU6PSE",
+	"9XupbWWns24MhWI0BzMUE0TO3duWe3XDRVJ8NQ8iiZNqE/yiAVepZMa0BvOhNQrtBM23s1eHGcATAo4A",
+	"h1mIlmRB1Z2BvbzaCecl287Qx0OTh9//pB99BHiNNLTYgVhsk0JvsFBw0QP1uOmHCK49eUx2VDHi7xVi",
+	"JAriBTOsD4V74aR3/9oQdXbx7mi5YgqfEn9XiveT3I2AAqi/M73fFdqq7PFcdJo5SHiwYYIK6QWr1GAF",
+	"1Wa2iy1Do3gtGlYQccIUJ8aBewSvF1Qb+/zNRY5WO3ud4DzYB6foB7hXDYGRf/IaSHfszGuaQR3RVVlK",
+	"ZVieWgP4TPTP9YptwlxyEY0ddB4jSaXZrpH7sBSN75BlV2IRRE14JXL+Id3F4VsK3PPbJCobQNSIGALk",
+	"3LeKsBt7b/UAwnWNaEs4XLcoJ7iMTSfayLIEbmFmlQj9+tB0blufmh/rtl3ioqa+t3PJNDqNufYO8muL",
+	"Weu3t6KaODjIml6C7IEWHPtO34UZDuNMc5Gx2RDlo4oHreIjsPOQVuVS0ZzNclbQbXfQH+1nYj8PDYA7",
+	"Xqu70rCZdcBKb3pNyd7fZWBoieMlmOYrSfALyeAIgipQE4jrvWPknOHYKebk6OhBGArnSm6RHw+Xbbc6",
+	"MSLehlfSwI7bRhZkx9HHANyDhzD07VGBnWe17tme4r+YdhP4NreYZMt03xLq8fdaQI/51/m2R+elxd5b",
+	"HDjJNnvZ2A4+0ndke2zRr6kyPOMl6jrfs+29q37tCdJm0JwZysHIGH2wamAZ9yfWdag95u1UwVG2ty74",
+	"HeNbYjkF1yjyNIG/ZFvUuV9bn9TI1HEfumxiVMKtqzkA6j3dQASPm7ANzUyxJRQv4S25ZooRXc3X3Bjr",
+	"a95UdY0sZ/EAySeZgRnd+6P15/Q7MOZB9ByHipbX3YrpxOoEw/BdtBSDBjqcLlBKWYywkHWQkYRglKsK",
+	"KSXsOndu797x2VNSA0jHtIutB9ddFTGacQXkv2RFMipQ5aoMCzKNVCgoQF+cgetoTueUUmOIFfgkEbBz",
+	"cNBe+MGB23OuyYJd+1iRg4MuOg4O0I7zWmrTOFz3YA+F43aWuD7wrQouPqeFtHnKbqcIN/KYnXzdGtxP",
+	"imdKa0e4sPw7M4DWydyMWXtMI+McQsxm5Mqj9STXjft+ztdVcdvXtuaC2RUtZvKKKcVztpOTu4m5FN9c",
+	"0eKH0A3jYFgGNJqxWYbRGyPHYhfQxwZ87NINa0c4vl6znFPDii0pFctYbs3lXBMdYDwk1nUxW1GxRElf",
+	"yWrpfOfsOMipISAIQzAq0RkiKQ2ZjZihdTrFuZ2/tI9RATmIUdDF2qZtq3lc0zAfyxsMfSTy2qb+5OvW",
+	"dNKrqgJSr2pV1SKnGWgzgos3BLUIP/XEI99AEHUgtHTxFW8LnALY3N/H1l4PnYKyO3HkzVd/7HPoAz25",
+	"2N6DtGIHIoqVimm8W2L7krZf5SIOqnOXj95qw9ZdE7zt+tee4/emV9GTouCCzdZSsG0yjpwL9hI/pnrb",
+	"+62nM0oafX3bykMD/hZYzXnGUONd8Yu73T6h7acm/a1U9/WWaQccLZePeDrc+U7uprztAyeEl3XfBF3I",
+	"TZsB6GkI8eeKUK1lxlHYOsv11B4094zo4nOa6H8dHInv4ey1x209fsXRnGjcZUVJKMkKjqZfKbRRVWbe",
+	"CorGpWipCYcrr0X3mxuDl0zavpkwP7qh3gqKznbB5JT0tFiwhH3lW8a81VFXyyXTpqWkLBh7K1wrLkgl",
+	"uMG51nBcZva8lEyh19OhbbmmW7IAmjCS/MaUJPPKNMV2jCjTBoyX9iUOpiFy8VZQQwpGtSEvOfh5wHD+",
+	"td4fWcHMtVSXAQvp233JBNNcz9KOYd/Zr+iz65a/cv678H/X2b7dwPh12NnWsEZU+/99+O8nEM1OZ78d",
+	"z774l6N375/dPDro/Pjk5ssv/1/zp6c3Xz76939O7ZSHnee9kJ89dyrt2XPUW+rHmw7sH8xwD0GSSSKL",
+	"3TBatEUeYmyvI6BHTauWWbG3AnxsjITQcp5TcztyaN8wnbNoT0eLahob0bJi+bXuqQ3cgcuQBJNpscZb",
+	"S1FdX8p0ZCFspA8WhFZkUQm7lV76toEz3jFMLqYhetQmljkhGFq4ot4h0/355LPPJ9M6JDB8n0wn7uu7",
+	"BCXzfJMK/MzZJqXkuQOCB+OBJiXdambS3ANhT/rAWaeMeNg1A+uAXvHyw3MKbfg8zeF8OIIzFm3EmbBx",
+	"AnB+8G1y65485OLDw20UYzkrzSqVcKIhqGGrejcZa/mLQMAQE1PCD9lh21iTg77ovPEKRhdAoPZ9TY7R",
+	"hsI5sITmqSLCeryQURaRFP2gyOO49c104i5/fe/qkBs4BVd7zvAQ6f82kjz47psLcuQYpn6A2HJDR1Gj",
+	"CVXafmh6EhlCXZodK+S9FW/Fc7bggsP3k7cip4YezanmmT6qNFNf0YKKjB0uJTnxsVbPqaFvRUfS6s2E",
+	"FUW5kbKaFzwDQ3SKPG12k+4Ib9/+DObYt2/fdZwquuqDmyrJX+wEMxCEZWVmLjfDTLFrqlKPVjrE5uPI",
+	"2HtwVitky8paNt34xI2f5nm0LHU7Rre7/LIsYPkRGWoXgQpbRrSRyssiXHtocH9fSXcxKHrt7SqVZpr8",
+	"sqblz1yYd2T2tjo+fspII2j1F3flA01uSzbautIbQ9w2quDCrVrJNkbRWUmXqbext29/NoyWuPsoL69h",
+	"C0DQxW4xTkIwAA5VL8Djo38DLBx7B/7h4s5tL5+HK70E/IRbiG1A3Khf7G+7X1H47K23qxWC29mlyqxm",
+	"cLaTq9JA4n5nQnqeJeVCezcKeIGBQ+AyGc3BpMiyS5dihq1Ls502ustFQ9D0rINrm3zIBr9h+gt8WYCk",
+	"RGVOnShOxbadh0AzY7w/8Bt2ybYXss6esU/igWYcvO47qEipkXQJxBofWzdGe/OdOxhASsvSh5NjXKEn",
+	"i5NAF75P/0G2Iu89HOIUUTTitPsQQVUCEdihDwW3WCiMdyfSTy0PtIy5vfkSiYg87yeuSa08Oc+teDUX",
+	"q/Adg2qWSl5rMqcgt0uXhMvGekdcrNJ0yXok5PhxZ2REdeNBCAfZde8lbzp4Tm5eaJ37JgmybTyDNScp",
+	"hcEXIBVUZlr+en4m+37oXiYwt6ZD2LxAMSk4NlqmQ1XjkU0sh0BLEzBTohY4PBhNjMSSzYpqnx8sn0Zn",
+	"eZQM8DvmLhjKWHMWuZpFudJCPhrPc9vntKNdurw1PlmNz1ATq5Yjss1MJ867PbUdUqAAlLOCLe3CbWNP",
+	"KHUehXqDAI4fFouCC0ZmKa+1yAwaXTNuDgby8QEh1gJPRo+QIuMIbHwXx4HJKxmfTbHcB0jh8kBQPza+",
+	"qEd/s3Tcl/XjBpFHlsDCec+rVuY5AHWujuH+ajnc4jCEiykBNndFCyaM1/jqQTqJU1BsbaVJcZ4Zj/rE",
+	"2YEHEHux7LUm7HGr1cQykwc6LdANQDyXm5mNWU1KvPPNHOg96doOvZIH06aoeaDJXG7Q2wevFutKvQOW",
+	"fjg8GDUAmHsE1o79+m5zC8zQtMPSVIoKNXkYZJuaXPrEiTFT90gwfeTyMMo6cysAWsaOOoWzU353KqlN",
+	"8aR7mde32rTOpuajhlLHv+8IJXepB39dK0zIE/O6LbEk7RSNVq0UOZEImSJ6wkXikab7FKRZwVApmDWE",
+	"qNkl26Z1G4Y3zrnvFhkvMBEPFdtHkSeUYkuuDauN6N5P4mOYJynm/5Ny0b86U6oFrO+NrIO/saM1TjaW",
+	"+cFXgK7EC67AZxVeIJJLgEbfalSqv4WmaVmpsdnEZsvleZo34LQQfZLzokrTq5v3++cw7avAEnU1R37L",
+	"hXVYmWN256QH5sDU1kl3cMEv7IJf0Htb77jTAE1hYgXk0pzjD3IuWpx3iB0kCDBFHN1d60XpAIOMIme7",
+	"3DGSm6I3/sMh62vnMOV+7J1eOz5+t++OsiMl11IDOrwKjs9EIJZwEyVH7oa09pwBWpY837RsoXbUXo2Z",
+	"7mXw8CnlWljA3XWD7cAAirRv2IIpljQhhE/WOzqIS3FKQTgrzSw+iU3vNf43TWmuXV3jIZroFkYwlwSy",
+	"f49r38t4Ra2lJKoMdGetuDCfP+vsRW3jB1jG7MZ52rR+bqRiTcRH6hbia9cm8B7FPeoUs+d4Kq59yYwu",
+	"2YYYyF2UCwlMvmfbn6AtLmdyM53czZCdonw34g5cvw6HLYlndJSwhs3Gu9SeKKclPD/SYubM/X2MQskr",
+	"xyiwuX8d+MAXT5qyL745ffHagQ8W1YJRNQuCW++qsF35h1mVTRvZc0Ack0IN3GtQVrCPNj/kuoufCK5X",
+	"zOU2j3SDThLW+vmnHs8/GSzS/lo7eZ97qbJLHHixYmV4sKqNqdi59UZFrygvvBXTQ9vjW4WLG5fJN8kV",
+	"4gHu/NYVPVnO7pXddE53+nTU1LWDJ+FcP2BKpLR0IlzCJGRF7u2qyYIeaEdZR7jqIzCvhNtz5J38rVQN",
+	"5u8c65NvX26QDmPceXfb29lhqseZyFfEaIuWhwSphfyy/AXO28FBfJgODqbkl8J9iEDA3+fudzQHHRwk",
+	"wUrqFcAGUG0QdM0eBTfAXlS3+Zvphn9fj7s1T6/WuFroJPtpI5CNfVnyGLp2C75W3KEgd7+A8RV+2h3V",
+	"Us/a2TOLrTFkfd7n3R4cF9a2boYmUrT9dDCwAqgBOTC4j86ZM7126VpUazRXznTBs/RDjphr4HnCPtBD",
+	"Y4KNezReGLHiPf4eouLRWNBsTAKtFpDRHElk6mQOrxp3c+nOXCX4rxUjPGfCwCeFl03r/vESO47akRJB",
+	"QenO5QbGPtHwd1Fk4qzYbUEOgRjWYmJ3gA64z4Ndzi80mL2paLx77uFVFM/Y4aYDHkGOPhw1Ww/pVfNZ",
+	"f5xyMaZ+mudNLj13zxzJemhczxZK/sbSxiS0wSWiIt1EqCNg78NE7H375gwm5LqsWz37ru0er7D2bfyd",
+	"FVS/6JB6/DbaafpU77eRt9FEdTp333QSH8k0XPYjabqb9bAWPF6RgwVmffZvjVTY82RDAhtey+lTGbXQ",
+	"R3b8+lQ6mNu7mhX0ek6zy7SCAjBF29t4FTWS+M5+A3SIm7Ozk8grKLTlNq1IyVQdFd5NUXZLZcNOO1rN",
+	"qLUK6NjQJ6bWk6PQMjFMJa6pMMxn9bf8yvXWzD5jQK9rqTApkE6LdznL+JoWaa0jz7qPdTlfclslq9Is",
+	"KsPkBrIVCC0VuVJWIRrUoeZsQY6n9Zn0u5HzK675vGDY4rFtMacar8s6n6zvAstjwqw0Nn8yovmqErli",
+	"uVlpi1gtSVAIUcgLbghzZq4ZE+QY2z3+gjxEBwzNr9gjwKITgiYnj7/A5zP7x3HqlnVVzoZYdo48+y+O",
+	"Z6fpGD1Q7BjAJN2oh8n8KbbMaf/tMHCabNcxZwlbugtl91laU0GXLO3zt94Bk+2Lu4lPIi28iNzW6NNG",
+	"yS3hJj0/MxT4U08cEbA/CwbJ5HrNzdo902u5BnqqayzZSf1wtuCf5ekBLv8RvV1K/9jfMkB92OcvK0Sk",
+	"Vo0+Sa/omjXROiXUZoIqeO2H5ot2kDOfaA7rBYQyARY3MBcsHWVJ2ELM1c2FQaNEZRazP4GuqmgG7O+w",
+	"D9zZ/PNniRoJzVzdYj/APzjeFdNMXaVRr3rI3sssri9EVonZmgOrf1TH7UWnstctJzmt6fMCGR56rOQL",
+	"o8x6ya1qkBuNOPWdCE8MDHhHUgzr2Yse917ZB6fMSqXJg1awQz++eeGkjLVUqeyx9XF3EodiRnF2xfLe",
+	"TYIx77gXqhi1C3eB/uO+IXuRMxLL/FlOKgLe6DQUfQUi/E8vXU3fjuzd4zGGP9d9dtrJ0qZB7N+0dD3+",
+	"hShQ/lCAPDjAecDgZZv+8qT52fKVg4N0GrSkrQd+rQG/iyqGfVNoh4owJ+97yqWEp2gX7NVFeS93hA9w",
+	"+uZuqClplqb48NfX/bgRp11F0oQLniHwxeMB/2gj4iOfUtzA2hnOrqSHUKLSPEmSycP3yEmNkq/kZizh",
+	"tJifJ56/AxT1oGSkXQhX0ik9lHy83ek9ENEojDpnhQTtxsgkad4Vz8OoAXinAwiqeJH/VOeWaLFrRUW2",
+	"SnrlzKHjX+sitgEqy91SC4UnI8GK5HBWD/qr15cSGt3f5Nh51lyMbNuuVmWX21pcDXgTTA+UnxDQy00B",
+	"E8RYbYbth7CwYilzgvPU2XBrftatchaX23mtZCl1SuI+JaX75q44zZhoJ+gO7muJOnGznC+Z7sn3bL+F",
+	"LHA4k6+Lls4ZIa+Tg/0lpAYOtpCMKuVNh7ZbvZRMsdzm9kuup2SKyzxtnJCKL7mA11hslF6X/QbD1qmT",
+	"LVRYjsYNUWwdRKzHHbGeyzbbYctzaPS9/ODqkHwDNo+QssJCAo55/gZkgghp0U64tjWPclKVUiR3oaTb",
+	"QtJ85uN7hvbDJTqozYR29hXVNsDAj5HGtp/Jp92401RhkJ65uBBDE9BG6UF4HsQwCThiLG9PiqGXhFFV",
+	"cKYGCUobukw+LtXzarkwuF/ErBTToGFbQpqjip2afBw5t59gW7SdosBp81iHI1kvJGAyQSipHX03hjP9",
+	"hUH1kZ6Ec4CZa2xgYxTQ7mYD1GiLf30QLvWJR0wn17fcsDB5HDSIsUcyo0aqUXWjbkPHDuBBauzxmrxl",
+	"1bg2JeaM5gUXLJ3NPfRlG4NlrWRlCNuULDPNcjFTsmZUV+hg7utE2PKJbgRl32aa+eDT1LXAZEMsg6Se",
+	"29keAC7QH991/GDgClqWg7zUT4qXgi+8Y6mcC6v2hnxOG9g8VlqFWJNryjHGy5+QhSwKeQ2/QKueK2Xg",
+	"/De5c2OxvcmAkJH1RGc1+ZweFtRGxYN3xcNEbPhOHTugHFMa28c0aQ8I6cuJij176o4gqekyeiFsrG4c",
+	"ocCWDW8Lbr1n+e0LtDXS3pBCp3GAGloUnOl+PqoDB/WhNh5y/KNJXXekg5+kYRdwJ+3M5OhtC4EBI8Lr",
+	"AxrjrbHd05oL9nKf+CjUGBpk3DXk4xAZkj96ZNKICJpcO7qbZmMvPbnAP5UPzw9jQKy8/cmF7/trkSEf",
+	"7bML7mA1TSJosatRp6K/T5BId6+aWviJYAwuekkUkqiPasY5yH8zJQmH55xLIa9Ff5yW2lk2Kceo3Mx4",
+	"XNcc0c+WHNxCq/cXXXA1TnZZ8eWKaUMwZOr2HNeJvbuOW/uY1ZsScDVNEWq92NTp8SVHf62SorH7YCPX",
+	"oTNejbbcKGEiR0eKQ/Id5tcCwmtUQkAHBp+qupm2tSpBQ5hiCm1woCd2VttHMVMpV+50ie/3TSNH0uFq",
+	"fBpbnz+sJz/T+HGGE8bAqrWZheqkqQyY0KKun8pbrvH4sh9j55A8t04V2j/Z20kIZlBHHTVM55710GQE",
+	"/zGGZitoIBum6X6L2Pg6vd5oVftyUf//rC6OhWoDwO1K9dpKvVMiQWq75pAUe0UNMMGmZusmqUVPm4Sz",
+	"uTxVCWEp5XCPV4JQCmtftDcu2eBmnISshfg936pthe59yxafY68UUXZqILf8gH0KR5/Inbx07kYZFVLw",
+	"DLXV1BMHJggc57g4oqhI2uNQT9wJTRyuZOXlkAvAYbG3FvN00kBc1wk4+gqbaqnD/mlQWVtRQ5bMaMfZ",
+	"4JJ3tc+dixwXmrliZ0BEMZ+UKhGZkHpEmAWX6j3JCHN/9fg8fAvfXjmPGDiC5JILlCQc2tzDmXVigzw2",
+	"QO2CcEOWkmm3nmYCVP0z9DnEXKA527w7fCGXPDvnSxzDRrvAsm1oV3eoUx/o5QKroO3X0NZVaAg/N2I6",
+	"7KSnZekm7a+Mn3wugCoEfQhOvJDMvGt5hNwwfjzaALkNRmjifQqEBjU3rJQK93BXOvWl1pujQMWNylIU",
+	"tiA2Tj1pck8q/C+48KJW+oLIklcCboyVm9L9dKaoyVYNNrQrrisErrQZmjbOK/euQ7U22Gsh2cTP0b+N",
+	"dZX4HsYRGtTvOlRsiT8UQN2RMPE1LUKEY6LmO0pVTojKqanzzvoq8CnGAYx7tmZa++i9dhmotp9EQyay",
+	"3bFYy743UV8mzHmVL5mBLIspe/xX+JXgV5JXCg2EG5ZVoUZZWRIAqp0Jv0ttbqJMCl2tB+byDe44Xc41",
+	"1Zqt5yk99Hn4yPKww0BpYCyDf1MFuvp3xsU27p3rwAcy5vuVf+jmbkhJvUDTM8i/Nh4TeKfcHR311Lcj",
+	"9Lr/vVJ6IZdNQD5w/ushLhfvUYq/fQMXR5weuhNGaq+WkL0ZQzYlfvcJz0Le0ZYlnFqi7czpNi+xZS3g",
+	"fcMk4Fe06MkvEvud2fvVmiz6soxkvUlxqHHp+QwlgyyoN+WZjR5sebJ1nQr7IgZtwOD9uZO5tQ4i1EdY",
+	"dwH63qdvICXlLmqkZhZdzLrw2G4ipDHBrPUGtxfhktn0ejx9f9WXeMYbBPF7XHXG+fVP3eMgu+KychsW",
+	"oiK9Smh/XWBawmZ1mZ71J8ODP7Y7Wa/z24Urb2yX6XTy73+yMbSECaO2fweucJ1Nb5cuSki72CIiWKcC",
+	"d5xqepTaxq04plJSqiiPkw29rcyylgYtdYocdcjq+RhxoIOPm+nkLN/rwkwVdprYUVLH7gUYIbEuxJ8Z",
+	"zZl6vaPuRV3rAo9YKTWvC9QWMJh7qF7hcIdjw4+BgHlct6M7ln/KuWKZwarEdbiNYmyfKh4wmTsFn+pf",
+	"DKjTIUrblb0YqnXRLUW8447vpKOLUir2vdP3VnY4DUGVyKfRkWPJBFo081Zqo9EJVhYLlhl+tSP9319W",
+	"TESp5abeLmOfqqNsgDxkNqjSPiW7zEU1QAW9JTwFvT9w+tJNXbLtA00a1JCsKxsycdwmcThiwPqRlL0u",
+	"lNaQ7OJIuA6UgVjwQYK2O6tLsKQYCU4XJbO85VyeJAmNE1wOTJmuiT9qLui6V9pXDNLvS/fRLandr388",
+	"xwrm2oXM0JB4PNbSweDYLs907RKXY7LG8HbivZGY9r/5zKx2loJfstqBw71UwbOgb5E0vXirzmzgPuqk",
+	"9SM8DfQizMzrkO6u93l3j212hKyQIEbM+lJMNN9WQwjSA21jxWz9WaYcXAumlKUAaAljs5mR3g1tCI4h",
+	"VGgMiLsVEnRvkS0LXG/q+zd1bn8sNkgx1T11cXDxAoliawrQqSgDf/+cQ8j+2n73ubK8z9FOC1Og191V",
+	"j30wP9cdJMZUvyDuttydg+s2xiYuBFMz//LUTscvmGq+hpRK5lVmL+j4YASD3Oin9gFWkrTTZN1VtnSE",
+	"KJfVJdseOS90Vy7a72AMtJWcLOhRGufWJt+r+U2n4F7eC3gf03I1nZRSFrOex46zbg2BNsVfcqjAQ+Cm",
+	"8EGvPSX8yUO0sYfX7OvV1ufML0smWP7okBCwfaE7rQW4VcSyNbl4YIbm3+CseWXLejij2uFbkY7XRj9r",
+	"dUdu5ocZ5mGaifzOU9lBhicym576BVAQR+ODcQ9nHNbKu0/NbbeamqgsFCmZ5Ny+WH2NBz1lOMKkaFFK",
+	"PXzIpMS9dBFdyFSQ5W0St8FQaUzFkyFAhokRYhkOGGeRSyLAefE4HuQL+ScVr4JmzKbk1j42LmT7dfmc",
+	"7QtLs2r+SP3rIkqdZCSRDpLbpfSNa9DpnewevT9c4hwW1yXxBTTw8OKlRXhIGeM9kOtGexftb1QMCbhP",
+	"PdWhdOUzo/QlhPLfxy4HO3FDcsmaS4KB9l9MlH5raC29xajOFmiJ4OhjoTy9NevhuM5NvtRcKIasa8xZ",
+	"Q/O/VdrXmlEAWLH9N0LDKFbuxVJuQG+LQl7DfGsbQPw3dHe/s+ndEebg6UvSQdd9gBlNfGb+Ecm2R51H",
+	"8Hfoz/pMrldSs3aiZ3c4cyZ2544ry7PnnRzQw+8Lnny/x6MJewSbCQTqylRfMla64u8N67zem2jj1LK7",
+	"vZUsrnbspJfBRvBSF5K0xIRpKJrADjfy/vqsQ1TUlWruaWvTXHbHNu5OVz1wjLGcz5hcz79X0uldsOEg",
+	"tWHlHsFr5zv+xz0BqWug/wg02Ji7wLx0ASONIvQBq0edsLpOvHLviTVr+4Zll1G+y31Y5ag0m3E01K3y",
+	"atbpNB3ehnbzK7nZcR/ZYmLCoE0eNi7KnTDMsabWxuPfYaEbX2CAQSpn705uBqdEXrvI+rncjOdpaffJ",
+	"CwdTKhvKqLw0Aw+tMK5H2i3GTp/KqU8Oslvc3xkXEEIC6u2qwwK6ewPRdDPUmmeh4GJKTC1QwoqNQr7E",
+	"dN2NGIlpJEN8AdXOYAhB2znJpFIsi3uk4/csUGup2KyQy3SY9gu+MJoUfM2BeAUp5JLIErbB1i1Nc5++",
+	"uSoBGwAR05F3dwIDWO0L35okcX1I6DN2SrDtWH+mGZr8lqMZPvSxOVXrIgB20TPrU9eT0oRpl/TfYcg2",
+	"7sKLdGMTcrfftPeqGNq4rJvpZLEHKRXLWMixGyHMORN7+51ZKVktV1G5JnLNi8I/XME2qMqHFkWjYGlW",
+	"bK8dYw8SsDYwwCUrMTxwzdZSba3K4QMOpqTSFXrVA3DPyFraoFomDJGCafIQGICSRdF8srQG3KXzw3hJ",
+	"N6dZZl5IeQnJYx/9G5EF2FwcVDCjV1RcuRHy7PjY2pmmEHgmgKypylZQ/xEnsO7eQpqA03zqc3q2w0/C",
+	"aoZiW63M5UXJ0YJHQ9nR3k8byaTHPNAhJwTXM4q9JR/H6zr+G7uEoAjMETx2t3vIaXdh7XU12W3a4n4q",
+	"CDVyzbP0sftjBYb0hnP0UE/3EcaffUfPcYyaN2N6w3leOeueD7KMRBQpiJFls6ZgHO7tLO3RbWWLbgyH",
+	"syXLRvrR/aL2t8C0jHhpn+9Q/HZMhbY7AJPQhFPWoHS11q9qAekOMMTSa4rieqmroRQlDiw8l3v/QMuo",
+	"rcZGOBovnXmmgU2pUtKES00IgPTnJ0xlJjw4OCRQhSVyMdVQvQT+xPIdXaHyfp0Ub+fdGcVp7OndmRJf",
+	"UjzQ9nC50bEZCpWxHBsCAFB86qKGCbjyUvvu5C/nCI0MBP6Lr0TtccmCUdOZO5KhuzKds/TPst73iBYA",
+	"CKlN2GsqhS6tjdeCIAHJpdUaUUZoAzpS4sRombvBBiPcO1CG3QmoToReAPChNdpMrfnTRvuh5ma/P6rr",
+	"GN0K+JthKm9IDX1hSOc1aSlsEsy/PaJAUq8ejtm5wGTN87GRO9p7WY6U/iMA+mN5GjCMiujZF4wFhZDO",
+	"GTU9igj6UUyj12CX+Ska3acnwFlIRq1yAT58lBeVYi7dP94/RDV9NEtqVl5YgeZdbyfwnGFW5P+NKYks",
+	"Op9GPoKssFmLWg/WspwV7Io1QpwsLesK1VB+xXxfHTqTnLESPWbbfhyp2J0Ij+0L3q19FkV/jMFu8rXf",
+	"ItbuFNnxlJ90PNiImT0meuxRAoiueF7RBv703lbWhqsKHOUEqjr2g5m1E7B87DQ/2hHe+AFOff+UDuMx",
+	"8W4cH9qbBaVRN8SAdsbyVbrv1It0KF9cYCM4AeJseXAWtiRe8w1d0mvR7zTTJfnaFDNyn7gUEWK/2bAM",
+	"pZpmrNrdcUJwMKL5cvcaaoK4m/PVR6HhQRLuHS8l/WrmbCp1iLx3jfTrCHThNPX6DVmAiFwULrek4/+O",
+	"/03JvPIDgQ3QpiqKNYHnzHu5YpHd4OBnV+SrzqCHj0W35f1dAyKPopHBP1sq/EdIQ36taMEXNlOgBd93",
+	"I3pFgYScW63193YxfjDxsGAy9YB5G6b0U9l187FjRsNtYZQIaLgCiVTOQ3NNL1m8DejKbjlPZoDl6Gq+",
+	"5lrjZdfazi4W3OJ9Sv41zSNLni0Mtm3cRL66I/T+tzrTSTyVr+eDD1253zxN1y0nMhQjAnGB+8E+toOL",
+	"iAR8q4holU9qnVsnCYu/UBsCJRH8z5wbRdV2IDB3p99NKr4cJeddYEcCeORocG/LGJnqp1XofCCJ0Kil",
+	"3PcuDIhYu7yDGhC2PIU+AIqTZfn6ljEG/A+I2h7zVAwSNvkQiGykr9/HnAUyxhUtBqylF+jNic/krSLU",
+	"/m3M9U2ZsPyF0R2A61q0x9QyrE5dEjWD2ynniwVTNtJMGypyqvK4OReYwZlCyl261bd/gwRoVcWmMRNO",
+	"PkPS6KpuJjyLHiRxxy0gxdb5s97xiTAASO/xrXDEG9/FiiXf96zGb2TPk14XhnQafrqBZ1hMONJDgK6w",
+	"HT7CYjMiBT5D2Mt+v3k0/40NT4M1fV1QjJE465gphs/ZD4g6lOZ/FNwMnjRrKmpngLEhevYgePoXyzpO",
+	"2G5Ol/7LLD1Z2Uzc084m6vfaxgvY+frSVTfNkz27iB7TLuNTbIvcw3rfcMpOMGWnoM1QcdMDkcBM11Gv",
+	"6G1kNfpOZEpb47NImbrESnteGdZMSvOc97yyXPhHA+3OVnPa4F0P44y/ZSNX8jREpSxn2ZjwMFv2O7cA",
+	"eEibMA49BA9SR/Ck16E6fUyNzTL1OJ6+jSzXKpO/MwVnNqRB9mnrPRy0aQmWC+RleIStjUKqWDOfttNR",
+	"NK0RgUkQShTLKoXWumu6TTpINTwzZyYNpc/kZUf27yQ+QUGA2hGjZUe6ftLq+G7uYwdLcMgEvSZcOe9/",
+	"MX2+nve/HBcml14AvNpDQ4BymN5qi7EnlQStgZ6aYHA+EOwWC+wzVI1IsnRvWxVOy++xQckLfSCfSPeR",
+	"PCQYGgVaN+FOApsIQE8mjUYOhCgIPKr+pqyNCK1J3vDe5hcva4P8zpBPhMR32AFenBqjbhecLRw4H7mM",
+	"2suAlGgp7/ooobH8Xdk23ALrF4xoi5xWYQyzfrE2Zqi5L1EqFf11yFDSI0Z0EpkoKQ26fBVFIgGKVXTw",
+	"TMWEw4Vh6ooWHz6JybdcaXOK+GD5m/6w5zgLRoxki0p9uxy8L+iouQv6O0wtXmPSlb7SOKeCuKHc40WH",
+	"+aOaSgvrshqq24DDn8twDjtNHn9O5q60bqlYxnX7UcRariNPyCumwDYaioEMZ5nYtc6fpLkDGS/8CyZ5",
+	"FRk3JerZNYT1Ef3ITKXn5CapPEV9HbJI4C/Fo4a9lRrXxWUj0KTPUemeU7rd3uknXhkmzx29PFwHXjqV",
+	"Zt11jr6th8NjLIRjEF/nIxxdBxcKZs/HpBFMF8CF7pjH8F4q4e5VB/d3yGBoceTGcPOmKOanvpz2Nm97",
+	"T3XF1n5AIcad1ti4ViYEeDHBNNdYDfKvrlL0h71LPQQ2SqZ7VC2sd0kFZxGTWGtj8miqqArmiAKYrlui",
+	"3CVmLMgqxc32HPDvNV7+16Qb43chb5fL+xaMze7uM/KSCf/aV2f5qrS/Xb+TtMD7yNrABSNGyuKQfLOh",
+	"67LwTp9fPpj/K3v6p2f58dPH/zr/0/Fnxxl79tkXx8f0i2f08RdPH7Mnf/rs2TF7vPj8i/mT/MmzJ/Nn",
+	"T559/tkX2dNnj+fPPv/iXx9MphMOIFtAfQzPyeQ/Z6fFUs5OX5/NLgDYGie05JAa7eYGVcuFhOUjUjM8",
+	"iWxNeTE58T/9H3/CDjO5rof3v05cNfbJyphSnxwdXV9fH8ZdjpaY1mdmZJWtjvw8N9MWxk9fnwXfRvsK",
+	"iztam3sOJzUpnOK3N9+cX5DT12eHNcFMTibHh8eHj2F8WTJBSz45mTzFn/D0rHDfjxyxTU7e30wnRytG",
+	"C7Nyf6yZUTzznzCc3f1fX9PlkqlD9Fu3P109OfJixdF7F6h9M/TtKH7gO3of/TXj+Y6e+HJ19N7H3w23",
+	"jrX3I+cXAEtPWu6/Y8YlPLSVDhPZstCA50afEi2VywpTKi7hVGFBq5xlilE8A/hoBW8klcjsm4edggn8",
+	"78vT/8R3n5en/0m+JMdT506pUe1ITW9zngRyOMst2F1fEv3V9jTkE6vfiCYnP6csJxZxpKzmBc+IlSbw",
+	"OAGtRNQeRqy5Gb6xTCw3R/t54M3Ab49nX7x7/9mfblIyX0eCDUiKUmzFqDeS5FyXBd0i0tZ082Ufyjb2",
+	"pOAafq2Y2taLWNPNJAa4+zCRyDvq3Z+vo6jRkNO59iz5j/MfXhGpiNNxX4Ph1/t++2ihOhYrDhaCnn0Q",
+	"u+svBpqJag03iQseWetl2UzBH9D8bjrxgOKhf3J87Dmd0yOi03fkDnU0U8v41CU0WDeNrG/dZDRgN6MZ",
+	"pJKjOnruQ88SY1JO9eBsGQ8wbO/rzui2JOljuW8+nK6sipkxdsBnHW17CNn5sJRwFe5OQNNBRhKCd6nL",
+	"Pt5aTyOfdvcfY3e7sgMppbEVvSFaMnBTf501gHQSY7H14Pak+jok/yUrlPBAdq8MCyxQKmRn4cLkOprT",
+	"ZSasMRQ5RuOXg4P2wg8OaqeOBbtGJksFNmyj4+DgEHbq2Z6sbNCa3EjkP+rs7DNcZ7Ne0k3wbKNESDET",
+	"bEkNvEpFauGz48d/2BWeCetLCCKtFb1vppPP/sBbdiYMU4IWBFva1Tz9w67mnKkrnjFywdalVFTxYkt+",
+	"FMFZ06omKJ902d+Pti6lRwRoldV6TdXWCdE08JxKRJX3BvlPm/FEgjZyUQoeeT9PrIhqZVqfh1gsJ+9u",
+	"vA4wUrEYanY0l5s9mjIdNe7XTvD9QB+9Rwt47+9HYAvo/YgvEVbFPfLZj9MtG4rPe7MBWFs9MmqyVVUe",
+	"vcf/oMp5M/z1aMELFjXxlTqPfDoe98HWzTkyG3GEvk1H7xuYcJ87mGj+XnePW1ytZc78YuVioZnZ8fno",
+	"vf03mghKcCsO9xEt6l9tbPORrsqy2HZ/3oos+WN3HY186j0/H71v/NkkFb2qTC6vo76IYNy/BN7gY6Xb",
+	"fx9BsW6QfVxybrowTKU6K0bXDmX1z4bR4sgV6Gv9WtfE6XzBQj/Rjy0hqpTa9EqdTf5AFQtF/4EVRDr1",
+	"gmE1XzTnHpJm1L/P5BNcxAtGNSNUp5V5uahbuiyccTarNcgx3Hjf+JJuNaEGxzTk8fH/JmuJ0QXUVZ22",
+	"zbgJg0x94fSQXCK4uAPkbso13foeOFsaVJHZZbsK3zQuKw6OfQgaZsd4/LkfzopcoVfKevCGXl80ooqU",
+	"zbfwlcy3A5fbZjbnAjl+fCPVhlr7sauO3kwT71awk+GtOyHvG0nmStI8A7wb6QuJduwQN3fUddvpIc4S",
+	"L5kIpkuu1b63gHcf7nzewnHHCPTRvpCz54FYQ0DG7y4EdyD6iubEpzaakZe0gA1nOTl1qlYDG7+3APvx",
+	"Jc6PLCJ+MJnuK3/4NKGYcLihjKt03pWo4u8YAQ40dmAASwbRaEhis7nMtz7LmKLXkH34JnG1HKF9U6fv",
+	"nXux+v59m3p3WXg/GVY/GVY/md4+GVY/7e4nw+pIw+ons+Mns+P/SLPjPrbGlJjpbG390ia/YsL66bd0",
+	"O1pX3AosvplrhpsgkzWi3bC4FzeHBOJklc28oNkVU7QgGdVWunI5ddbo04oZa1h+8lbMGpBYz1GY+GH9",
+	"X+uy+7Y6Pn7KyPGjdh+bwjPizd2+KO/iJ1sN+UvydvJ20hlJsbW8YrmN2YsrvtheO4f9X2HcHzqlotDI",
+	"gvkkfGIboqvFgmfcoryQYknoUtbu5mhYERK/oF3IFdwk3HjrC9c2AardlVZhmqbk3pUAzuot3Omi0SKX",
+	"tHcGEN6erhn/MsYv43+0lH7bDC53ZaSDY99MP3GVj8BVPjpf+aM/ekfmw39IMfPZ8bM/7IJiY/Mraci3",
+	"cBjuKI65tHJZsu7obQUtnz/Cm/tqd+zYvRlv0eDY/PM7uAg0U1f+gq29dU+OjjBbzkpqczS5mcbfdOvj",
+	"uwDze387lYpfATQ3727+/wCuW4PNvyYBAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
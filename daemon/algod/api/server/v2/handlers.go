@@ -24,6 +24,7 @@ import (
 	"fmt"
 	"github.com/algorand/go-algorand/ledger/eval"
 	"io"
+	"io/fs"
 	"math"
 	"net/http"
 	"strings"
@@ -71,6 +72,9 @@ type Handlers struct {
 	Node     NodeInterface
 	Log      logging.Logger
 	Shutdown <-chan struct{}
+
+	// BlockStream feeds the block stream endpoint. The endpoint is unavailable if it is nil.
+	BlockStream *BlockStream
 }
 
 // LedgerForAPI describes the Ledger methods used by the v2 API.
@@ -93,6 +97,7 @@ type LedgerForAPI interface {
 	AddressTxns(id basics.Address, r basics.Round) ([]transactions.SignedTxnWithAD, error)
 	GetStateDeltaForRound(rnd basics.Round) (ledgercore.StateDelta, error)
 	GetTracer() logic.EvalTracer
+	RegisterBlockListeners(listeners []ledgercore.BlockListener)
 }
 
 // NodeInterface represents node fns used by the handlers.
@@ -107,6 +112,7 @@ type NodeInterface interface {
 	GetPendingTxnsFromPool() ([]transactions.SignedTxn, error)
 	SuggestedFee() basics.MicroAlgos
 	StartCatchup(catchpoint string) error
+	StartCatchupFromFiles(catchpoint, catchpointFile, blocksFile string) error
 	AbortCatchup(catchpoint string) error
 	Config() config.Local
 	InstallParticipationKey(partKeyBinary []byte) (account.ParticipationID, error)
@@ -119,6 +125,7 @@ type NodeInterface interface {
 	UnsetSyncRound()
 	GetBlockTimeStampOffset() (*int64, error)
 	SetBlockTimeStampOffset(int64) error
+	AgreementState(ctx context.Context) (agreement.StateSnapshot, error)
 }
 
 func roundToPtrOrNil(value basics.Round) *uint64 {
@@ -337,6 +344,81 @@ func (v2 *Handlers) AppendKeys(ctx echo.Context, participationID string) error {
 	return nil
 }
 
+// consensusStateTimeout bounds how long GetConsensusState waits for the
+// agreement state machine to finish handling its current event.
+const consensusStateTimeout = 5 * time.Second
+
+// GetConsensusState returns a snapshot of the agreement state machine.
+// (GET /v2/consensus/state)
+func (v2 *Handlers) GetConsensusState(ctx echo.Context) error {
+	ctxWithTimeout, cancel := context.WithTimeout(ctx.Request().Context(), consensusStateTimeout)
+	defer cancel()
+
+	snapshot, err := v2.Node.AgreementState(ctxWithTimeout)
+	if err != nil {
+		return serviceUnavailable(ctx, err, fmt.Sprintf(errFailedRetrievingConsensusState, err), v2.Log)
+	}
+	return ctx.JSON(http.StatusOK, convertConsensusState(snapshot))
+}
+
+func convertProposalValue(v agreement.ProposalValue) (proposer string, digest string) {
+	if v == (agreement.ProposalValue{}) {
+		return "", ""
+	}
+	return v.OriginalProposer.String(), v.BlockDigest.String()
+}
+
+func convertConsensusState(snapshot agreement.StateSnapshot) model.ConsensusState {
+	res := model.ConsensusState{
+		Round:                uint64(snapshot.Round),
+		Period:               snapshot.Period,
+		Step:                 snapshot.Step,
+		Napping:              snapshot.Napping,
+		StepTime:             uint64(snapshot.StepTime),
+		RoundTime:            uint64(snapshot.RoundTime),
+		Deadline:             uint64(snapshot.Deadline),
+		FastRecoveryDeadline: uint64(snapshot.FastRecoveryDeadline),
+		Proposals:            make([]model.ConsensusProposal, 0, len(snapshot.Proposals)),
+		Tallies:              make([]model.ConsensusVoteTally, 0, len(snapshot.Tallies)),
+	}
+
+	for _, p := range snapshot.Proposals {
+		proposer, digest := convertProposalValue(p.ProposalValue)
+		res.Proposals = append(res.Proposals, model.ConsensusProposal{
+			OriginalPeriod:   p.OriginalPeriod,
+			OriginalProposer: proposer,
+			BlockDigest:      digest,
+			Lowest:           p.Lowest,
+			Staging:          p.Staging,
+			Pinned:           p.Pinned,
+			PayloadReceived:  p.PayloadReceived,
+			PayloadValidated: p.PayloadValidated,
+		})
+	}
+
+	for _, t := range snapshot.Tallies {
+		tally := model.ConsensusVoteTally{
+			Period:            t.Period,
+			Step:              t.Step,
+			Threshold:         t.Threshold,
+			Voters:            t.Voters,
+			EquivocatorWeight: t.EquivocatorWeight,
+			Weights:           make([]model.ConsensusProposalWeight, 0, len(t.Weights)),
+		}
+		for _, w := range t.Weights {
+			proposer, digest := convertProposalValue(w.ProposalValue)
+			tally.Weights = append(tally.Weights, model.ConsensusProposalWeight{
+				OriginalPeriod:   w.OriginalPeriod,
+				OriginalProposer: proposer,
+				BlockDigest:      digest,
+				Weight:           w.Weight,
+			})
+		}
+		res.Tallies = append(res.Tallies, tally)
+	}
+	return res
+}
+
 // ShutdownNode shuts down the node.
 // (POST /v2/shutdown)
 func (v2 *Handlers) ShutdownNode(ctx echo.Context, params model.ShutdownNodeParams) error {
@@ -871,6 +953,97 @@ func (v2 *Handlers) WaitForBlock(ctx echo.Context, round uint64) error {
 	return v2.GetStatus(ctx)
 }
 
+// StreamBlocks streams the blocks added to the ledger as server-sent events.
+// (GET /v2/stream/blocks)
+func (v2 *Handlers) StreamBlocks(ctx echo.Context, params model.StreamBlocksParams) error {
+	handle, _, err := getCodecHandle((*string)(params.Format))
+	if err != nil {
+		return badRequest(ctx, err, errFailedParsingFormatOption, v2.Log)
+	}
+	if v2.BlockStream == nil {
+		return serviceUnavailable(ctx, errors.New(errBlockStreamNotAvailable), errBlockStreamNotAvailable, v2.Log)
+	}
+
+	stat, err := v2.Node.Status()
+	if err != nil {
+		return internalError(ctx, err, errFailedRetrievingNodeStatus, v2.Log)
+	}
+	if stat.Catchpoint != "" {
+		// node is currently catching up to the requested catchpoint.
+		return serviceUnavailable(ctx, fmt.Errorf("StreamBlocks failed as the node was catchpoint catchuping"), errOperationNotAvailableDuringCatchup, v2.Log)
+	}
+
+	// Subscribe before looking at the ledger, so that no block is missed
+	// between catching up and following the stream.
+	sub, latest := v2.BlockStream.subscribe()
+	defer v2.BlockStream.unsubscribe(sub)
+
+	ledger := v2.Node.LedgerForAPI()
+	w := blockStreamWriter{
+		ctx:          ctx,
+		ledger:       ledger,
+		handle:       handle,
+		deltas:       params.Deltas != nil && *params.Deltas,
+		writeTimeout: time.Duration(v2.Node.Config().RestWriteTimeoutSeconds) * time.Second,
+		rc:           http.NewResponseController(ctx.Response().Writer),
+		next:         latest + 1,
+	}
+	if params.Round != nil {
+		w.next = basics.Round(*params.Round)
+	}
+	if id := ctx.Request().Header.Get(lastEventIDHeader); id != "" {
+		w.next, err = parseLastEventID(id)
+		if err != nil {
+			return badRequest(ctx, err, errFailedParsingLastEventID, v2.Log)
+		}
+	}
+
+	// Check that the first round can be served before starting the stream.
+	if w.next <= latest {
+		if _, err = ledger.BlockHdr(w.next); err != nil {
+			return notFound(ctx, err, errFailedLookingUpLedger, v2.Log)
+		}
+		if w.deltas {
+			if _, err = ledger.GetStateDeltaForRound(w.next); err != nil {
+				return notFound(ctx, err, fmt.Sprintf(errFailedRetrievingStateDelta, err), v2.Log)
+			}
+		}
+	}
+
+	err = w.start()
+	if err == nil {
+		err = w.catchUp(latest)
+	}
+
+	keepAlive := time.NewTicker(blockStreamKeepAlive)
+	defer keepAlive.Stop()
+	for err == nil {
+		select {
+		case <-v2.Shutdown:
+			err = w.fail(errors.New(errServiceShuttingDown), errServiceShuttingDown)
+		case <-ctx.Request().Context().Done():
+			err = ctx.Request().Context().Err()
+		case <-keepAlive.C:
+			err = w.keepAlive()
+		case <-sub.lagged:
+			// Blocks were dropped from the queue, serve them from the ledger.
+			err = w.catchUp(v2.BlockStream.Latest())
+		case sb := <-sub.blocks:
+			if sb.block.Round() < w.next {
+				continue
+			}
+			err = w.catchUp(sb.block.Round() - 1)
+			if err == nil {
+				err = w.writeBlock(sb.block, &sb.delta)
+			}
+		}
+	}
+
+	// The response has been committed, so errors can only be logged.
+	v2.Log.Debugf("StreamBlocks: stream ended before round %d: %v", w.next, err)
+	return nil
+}
+
 // decodeTxGroup attempts to decode a request body containing a transaction group.
 func decodeTxGroup(body io.Reader, maxTxGroupSize int) ([]transactions.SignedTxn, error) {
 	var txgroup []transactions.SignedTxn
@@ -929,19 +1102,21 @@ func (v2 *Handlers) RawTransaction(ctx echo.Context) error {
 
 // PreEncodedSimulateTxnResult mirrors model.SimulateTransactionResult
 type PreEncodedSimulateTxnResult struct {
-	Txn                    PreEncodedTxInfo                      `codec:"txn-result"`
-	AppBudgetConsumed      *uint64                               `codec:"app-budget-consumed,omitempty"`
-	LogicSigBudgetConsumed *uint64                               `codec:"logic-sig-budget-consumed,omitempty"`
-	TransactionTrace       *model.SimulationTransactionExecTrace `codec:"exec-trace,omitempty"`
+	Txn                      PreEncodedTxInfo                        `codec:"txn-result"`
+	AppBudgetConsumed        *uint64                                 `codec:"app-budget-consumed,omitempty"`
+	LogicSigBudgetConsumed   *uint64                                 `codec:"logic-sig-budget-consumed,omitempty"`
+	TransactionTrace         *model.SimulationTransactionExecTrace   `codec:"exec-trace,omitempty"`
+	UnnamedResourcesAccessed *model.SimulateUnnamedResourcesAccessed `codec:"unnamed-resources-accessed,omitempty"`
 }
 
 // PreEncodedSimulateTxnGroupResult mirrors model.SimulateTransactionGroupResult
 type PreEncodedSimulateTxnGroupResult struct {
-	AppBudgetAdded    *uint64                       `codec:"app-budget-added,omitempty"`
-	AppBudgetConsumed *uint64                       `codec:"app-budget-consumed,omitempty"`
-	FailedAt          *[]uint64                     `codec:"failed-at,omitempty"`
-	FailureMessage    *string                       `codec:"failure-message,omitempty"`
-	Txns              []PreEncodedSimulateTxnResult `codec:"txn-results"`
+	AppBudgetAdded           *uint64                                 `codec:"app-budget-added,omitempty"`
+	AppBudgetConsumed        *uint64                                 `codec:"app-budget-consumed,omitempty"`
+	FailedAt                 *[]uint64                               `codec:"failed-at,omitempty"`
+	FailureMessage           *string                                 `codec:"failure-message,omitempty"`
+	Txns                     []PreEncodedSimulateTxnResult           `codec:"txn-results"`
+	UnnamedResourcesAccessed *model.SimulateUnnamedResourcesAccessed `codec:"unnamed-resources-accessed,omitempty"`
 }
 
 // PreEncodedSimulateResponse mirrors model.SimulateResponse
@@ -960,11 +1135,14 @@ type PreEncodedSimulateRequestTransactionGroup struct {
 
 // PreEncodedSimulateRequest mirrors model.SimulateRequest
 type PreEncodedSimulateRequest struct {
-	TxnGroups            []PreEncodedSimulateRequestTransactionGroup `codec:"txn-groups"`
-	AllowEmptySignatures bool                                        `codec:"allow-empty-signatures,omitempty"`
-	AllowMoreLogging     bool                                        `codec:"allow-more-logging,omitempty"`
-	ExtraOpcodeBudget    uint64                                      `codec:"extra-opcode-budget,omitempty"`
-	ExecTraceConfig      simulation.ExecTraceConfig                  `codec:"exec-trace-config,omitempty"`
+	TxnGroups             []PreEncodedSimulateRequestTransactionGroup `codec:"txn-groups"`
+	Round                 basics.Round                                `codec:"round,omitempty"`
+	AllowEmptySignatures  bool                                        `codec:"allow-empty-signatures,omitempty"`
+	AllowMoreLogging      bool                                        `codec:"allow-more-logging,omitempty"`
+	AllowUnnamedResources bool                                        `codec:"allow-unnamed-resources,omitempty"`
+	ExtraOpcodeBudget     uint64                                      `codec:"extra-opcode-budget,omitempty"`
+	ExecTraceConfig       simulation.ExecTraceConfig                  `codec:"exec-trace-config,omitempty"`
+	StateOverrides        simulation.StateOverrides                   `codec:"state-overrides,omitempty"`
 }
 
 // SimulateTransaction simulates broadcasting a raw transaction to the network, returning relevant simulation results.
@@ -1339,6 +1517,17 @@ func (v2 *Handlers) getPendingTransactions(ctx echo.Context, max *uint64, format
 
 // startCatchup Given a catchpoint, it starts catching up to this catchpoint
 func (v2 *Handlers) startCatchup(ctx echo.Context, catchpoint string) error {
+	return v2.startCatchupWith(ctx, catchpoint, v2.Node.StartCatchup)
+}
+
+// startCatchupFromFiles Given a catchpoint, it starts catching up to this catchpoint using the given local files
+func (v2 *Handlers) startCatchupFromFiles(ctx echo.Context, catchpoint, catchpointFile, blocksFile string) error {
+	return v2.startCatchupWith(ctx, catchpoint, func(catchpoint string) error {
+		return v2.Node.StartCatchupFromFiles(catchpoint, catchpointFile, blocksFile)
+	})
+}
+
+func (v2 *Handlers) startCatchupWith(ctx echo.Context, catchpoint string, start func(catchpoint string) error) error {
 	_, _, err := ledgercore.ParseCatchpointLabel(catchpoint)
 	if err != nil {
 		return badRequest(ctx, err, errFailedToParseCatchpoint, v2.Log)
@@ -1346,7 +1535,7 @@ func (v2 *Handlers) startCatchup(ctx echo.Context, catchpoint string) error {
 
 	// Select 200/201, or return an error
 	var code int
-	err = v2.Node.StartCatchup(catchpoint)
+	err = start(catchpoint)
 	switch err.(type) {
 	case nil:
 		code = http.StatusCreated
@@ -1355,6 +1544,9 @@ func (v2 *Handlers) startCatchup(ctx echo.Context, catchpoint string) error {
 	case *node.CatchpointUnableToStartError:
 		return badRequest(ctx, err, err.Error(), v2.Log)
 	default:
+		if errors.Is(err, fs.ErrNotExist) {
+			return badRequest(ctx, err, fmt.Sprintf(errFailedToStartCatchup, err), v2.Log)
+		}
 		return internalError(ctx, err, fmt.Sprintf(errFailedToStartCatchup, err), v2.Log)
 	}
 
@@ -1547,6 +1739,12 @@ func (v2 *Handlers) StartCatchup(ctx echo.Context, catchpoint string) error {
 	return v2.startCatchup(ctx, catchpoint)
 }
 
+// StartCatchupFromFiles Given a catchpoint, it starts catching up to this catchpoint using local files
+// (POST /v2/catchup/{catchpoint}/file)
+func (v2 *Handlers) StartCatchupFromFiles(ctx echo.Context, catchpoint string, params model.StartCatchupFromFilesParams) error {
+	return v2.startCatchupFromFiles(ctx, catchpoint, params.CatchpointFile, params.BlocksFile)
+}
+
 // AbortCatchup Given a catchpoint, it aborts catching up to this catchpoint
 // (DELETE /v2/catchup/{catchpoint})
 func (v2 *Handlers) AbortCatchup(ctx echo.Context, catchpoint string) error {
@@ -17,6 +17,7 @@
 package v2
 
 import (
+	"encoding"
 	"fmt"
 	"math"
 	"reflect"
@@ -106,6 +107,12 @@ func makeTagGraph(rootType reflect.Type, seen map[reflect.Type]*tagNode) *tagNod
 	}
 	seen[rootType] = node
 
+	if rootType.Implements(reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()) ||
+		rootType == reflect.TypeOf([]byte(nil)) {
+		// encoded as a string, such as a basics.Address, or as a byte string
+		return node
+	}
+
 	switch rootType.Kind() {
 	case reflect.Map:
 		keyGraph := makeTagGraph(rootType.Key(), seen)
@@ -117,6 +124,7 @@ func makeTagGraph(rootType reflect.Type, seen map[reflect.Type]*tagNode) *tagNod
 	case reflect.Ptr:
 		// Directly embed value type graph
 		node = makeTagGraph(rootType.Elem(), seen)
+		seen[rootType] = node
 	case reflect.Struct:
 		for i := 0; i < rootType.NumField(); i++ {
 			field := rootType.Field(i)
@@ -56,6 +56,9 @@ func (l *mockLedger) GetTracer() logic.EvalTracer {
 	return l.tracer
 }
 
+func (l *mockLedger) RegisterBlockListeners(listeners []ledgercore.BlockListener) {
+}
+
 func (l *mockLedger) GetStateDeltaForRound(rnd basics.Round) (ledgercore.StateDelta, error) {
 	args := l.Called(rnd)
 	return args.Get(0).(ledgercore.StateDelta), args.Error(1)
@@ -19,14 +19,17 @@ package test
 import (
 	"bytes"
 	"context"
+	"encoding/base64"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
+	"io/fs"
 	"math"
 	"net/http"
 	"net/http/httptest"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -1227,6 +1230,46 @@ func TestStartCatchup(t *testing.T) {
 	startCatchupTest(t, badCatchPoint, nil, 400)
 }
 
+func startCatchupFromFilesTest(t *testing.T, mockLedger *data.Ledger, catchpoint string, nodeError error, expectedCode int) {
+	dummyShutdownChan := make(chan struct{})
+	mockNode := makeMockNode(mockLedger, t.Name(), nodeError, cannedStatusReportGolden, false)
+	handler := v2.Handlers{Node: mockNode, Log: logging.Base(), Shutdown: dummyShutdownChan}
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodPost, "/", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	params := model.StartCatchupFromFilesParams{CatchpointFile: "ledger.catchpoint", BlocksFile: "ledger.blocks"}
+	err := handler.StartCatchupFromFiles(c, catchpoint, params)
+	require.NoError(t, err)
+	require.Equal(t, expectedCode, rec.Code)
+}
+
+func TestStartCatchupFromFiles(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	// share one ledger across the cases, reopening the in-memory ledger of the test hangs later tests
+	numAccounts := 1
+	numTransactions := 1
+	offlineAccounts := true
+	mockLedger, _, _, _, releasefunc := testingenv(t, numAccounts, numTransactions, offlineAccounts)
+	defer releasefunc()
+
+	goodCatchPoint := "5894690#DVFRZUYHEFKRLK5N6DNJRR4IABEVN2D6H76F3ZSEPIE6MKXMQWQA"
+	startCatchupFromFilesTest(t, mockLedger, goodCatchPoint, nil, 201)
+
+	inProgressError := node.MakeCatchpointAlreadyInProgressError("catchpoint")
+	startCatchupFromFilesTest(t, mockLedger, goodCatchPoint, inProgressError, 200)
+
+	missingFileError := fmt.Errorf("MakeNewCatchpointCatchupServiceFromFiles: %w", fs.ErrNotExist)
+	startCatchupFromFilesTest(t, mockLedger, goodCatchPoint, missingFileError, 400)
+
+	startCatchupFromFilesTest(t, mockLedger, goodCatchPoint, errors.New("anothing else is internal"), 500)
+
+	badCatchPoint := "bad catchpoint"
+	startCatchupFromFilesTest(t, mockLedger, badCatchPoint, nil, 400)
+}
+
 func abortCatchupTest(t *testing.T, catchpoint string, expectedCode int) {
 	numAccounts := 1
 	numTransactions := 1
@@ -2203,3 +2246,282 @@ func TestDeltasForTxnGroup(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, 501, rec.Code)
 }
+
+// syncRecorder lets a test read the body while a streaming handler writes it.
+type syncRecorder struct {
+	*httptest.ResponseRecorder
+	mu sync.Mutex
+}
+
+func (r *syncRecorder) WriteHeader(code int) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.ResponseRecorder.WriteHeader(code)
+}
+
+func (r *syncRecorder) Write(b []byte) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.ResponseRecorder.Write(b)
+}
+
+func (r *syncRecorder) Flush() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.ResponseRecorder.Flush()
+}
+
+type streamEvent struct {
+	id    string
+	event string
+	data  string
+}
+
+// events parses the server-sent events written so far.
+func (r *syncRecorder) events() []streamEvent {
+	r.mu.Lock()
+	body := r.Body.String()
+	r.mu.Unlock()
+
+	var events []streamEvent
+	for _, chunk := range strings.Split(body, "\n\n") {
+		var ev streamEvent
+		for _, line := range strings.Split(chunk, "\n") {
+			switch {
+			case strings.HasPrefix(line, "id: "):
+				ev.id = strings.TrimPrefix(line, "id: ")
+			case strings.HasPrefix(line, "event: "):
+				ev.event = strings.TrimPrefix(line, "event: ")
+			case strings.HasPrefix(line, "data: "):
+				if ev.data != "" {
+					ev.data += "\n"
+				}
+				ev.data += strings.TrimPrefix(line, "data: ")
+			}
+		}
+		if ev.event != "" {
+			events = append(events, ev)
+		}
+	}
+	return events
+}
+
+type streamedBlock struct {
+	Block       bookkeeping.Block      `codec:"block"`
+	Certificate agreement.Certificate  `codec:"cert"`
+	Delta       *ledgercore.StateDelta `codec:"delta"`
+}
+
+// startBlockStream runs StreamBlocks in the background, and returns a function
+// that cancels the request and waits for the handler to return.
+func startBlockStream(t *testing.T, handler v2.Handlers, params model.StreamBlocksParams, lastEventID string) (*syncRecorder, func()) {
+	reqCtx, cancel := context.WithCancel(context.Background())
+	req := httptest.NewRequest(http.MethodGet, "/", nil).WithContext(reqCtx)
+	if lastEventID != "" {
+		req.Header.Set("Last-Event-ID", lastEventID)
+	}
+	rec := &syncRecorder{ResponseRecorder: httptest.NewRecorder()}
+	c := echo.New().NewContext(req, rec)
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		require.NoError(t, handler.StreamBlocks(c, params))
+	}()
+	return rec, func() {
+		cancel()
+		<-done
+	}
+}
+
+func TestStreamBlocks(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+	a := require.New(t)
+
+	handler, _, _, _, _, releasefunc := setupTestForMethodGet(t, cannedStatusReportGolden)
+	defer releasefunc()
+	handler.BlockStream = v2.MakeBlockStream(handler.Node.LedgerForAPI())
+
+	deltas := true
+	rec, stop := startBlockStream(t, handler, model.StreamBlocksParams{Round: numOrNil(1), Deltas: &deltas}, "")
+
+	// The blocks are added after the stream started, so they are pushed by the ledger.
+	insertRounds(a, handler, 3)
+	a.Eventually(func() bool { return len(rec.events()) == 3 }, 10*time.Second, 10*time.Millisecond)
+	stop()
+
+	a.Equal(http.StatusOK, rec.Code)
+	a.Equal("text/event-stream", rec.Header().Get("Content-Type"))
+	for i, ev := range rec.events() {
+		round := basics.Round(i + 1)
+		a.Equal(fmt.Sprint(round), ev.id)
+		a.Equal("block", ev.event)
+
+		// Make sure the block can be decoded with the standard JSON decoder.
+		var sb struct {
+			Block json.RawMessage            `codec:"block"`
+			Cert  json.RawMessage            `codec:"cert"`
+			Delta map[string]json.RawMessage `codec:"delta"`
+		}
+		a.NoError(json.Unmarshal([]byte(ev.data), &sb))
+		// The block fields are named by their codec tags.
+		var block bookkeeping.Block
+		a.NoError(protocol.DecodeJSON(sb.Block, &block))
+		a.Equal(round, block.Round())
+		a.NotEmpty(sb.Cert)
+		a.Contains(sb.Delta, "Hdr")
+	}
+}
+
+func TestStreamBlocksResume(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+	a := require.New(t)
+
+	handler, _, _, _, _, releasefunc := setupTestForMethodGet(t, cannedStatusReportGolden)
+	defer releasefunc()
+	shutdown := make(chan struct{})
+	handler.Shutdown = shutdown
+
+	// The blocks are added before the stream started, so they are read from the ledger.
+	insertRounds(a, handler, 3)
+	handler.BlockStream = v2.MakeBlockStream(handler.Node.LedgerForAPI())
+
+	format := "msgpack"
+	params := model.StreamBlocksParams{Round: numOrNil(3), Format: (*model.StreamBlocksParamsFormat)(&format)}
+	rec, stop := startBlockStream(t, handler, params, "1")
+	a.Eventually(func() bool { return len(rec.events()) == 2 }, 10*time.Second, 10*time.Millisecond)
+	close(shutdown)
+	a.Eventually(func() bool { return len(rec.events()) == 3 }, 10*time.Second, 10*time.Millisecond)
+	stop()
+
+	events := rec.events()
+	// Last-Event-ID takes precedence over the round parameter.
+	for i, ev := range events[:2] {
+		round := basics.Round(i + 2)
+		a.Equal(fmt.Sprint(round), ev.id)
+		a.Equal("block", ev.event)
+
+		data, err := base64.StdEncoding.DecodeString(ev.data)
+		a.NoError(err)
+		var sb streamedBlock
+		a.NoError(protocol.DecodeReflect(data, &sb))
+		a.Equal(round, sb.Block.Round())
+		a.Nil(sb.Delta)
+	}
+
+	a.Equal("error", events[2].event)
+	var errResp model.ErrorResponse
+	a.NoError(json.Unmarshal([]byte(events[2].data), &errResp))
+	a.Contains(errResp.Message, "shutting down")
+}
+
+func TestStreamBlocksErrors(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	streamBlocksTest := func(t *testing.T, stream bool, params model.StreamBlocksParams, lastEventID string, expectedCode int) {
+		a := require.New(t)
+		handler, c, rec, _, _, releasefunc := setupTestForMethodGet(t, cannedStatusReportGolden)
+		defer releasefunc()
+		insertRounds(a, handler, 3)
+		if stream {
+			handler.BlockStream = v2.MakeBlockStream(handler.Node.LedgerForAPI())
+		}
+		if lastEventID != "" {
+			c.Request().Header.Set("Last-Event-ID", lastEventID)
+		}
+		a.NoError(handler.StreamBlocks(c, params))
+		a.Equal(expectedCode, rec.Code)
+	}
+
+	badFormat := "bad format"
+	deltas := true
+	t.Run("format-400", func(t *testing.T) {
+		t.Parallel()
+		streamBlocksTest(t, true, model.StreamBlocksParams{Format: (*model.StreamBlocksParamsFormat)(&badFormat)}, "", 400)
+	})
+	t.Run("last-event-id-400", func(t *testing.T) {
+		t.Parallel()
+		streamBlocksTest(t, true, model.StreamBlocksParams{}, "not a round", 400)
+	})
+	t.Run("delta-404", func(t *testing.T) {
+		t.Parallel()
+		// There is no state delta for the genesis round.
+		streamBlocksTest(t, true, model.StreamBlocksParams{Round: new(uint64), Deltas: &deltas}, "", 404)
+	})
+	t.Run("no-stream-503", func(t *testing.T) {
+		t.Parallel()
+		streamBlocksTest(t, false, model.StreamBlocksParams{}, "", 503)
+	})
+}
+
+func TestGetConsensusState(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	handler, c, rec, rootkeys, _, releasefunc := setupTestForMethodGet(t, cannedStatusReportGolden)
+	defer releasefunc()
+
+	// 503 - agreement is not running
+	err := handler.GetConsensusState(c)
+	require.NoError(t, err)
+	require.Equal(t, 503, rec.Code)
+	require.Equal(t, "{\"message\":\"failed retrieving agreement state: agreement is not running\"}\n", rec.Body.String())
+	c, rec = newReq(t)
+
+	// 200
+	proposer := rootkeys[0].Address()
+	value := agreement.ProposalValue{OriginalPeriod: 1, OriginalProposer: proposer, BlockDigest: crypto.Digest{1}}
+	handler.Node.(*mockNode).agreementState = &agreement.StateSnapshot{
+		Round:     10,
+		Period:    1,
+		Step:      3,
+		StepTime:  2 * time.Second,
+		RoundTime: 9 * time.Second,
+		Deadline:  17 * time.Second,
+		Proposals: []agreement.ProposalSnapshot{{ProposalValue: value, Lowest: true, PayloadReceived: true}},
+		Tallies: []agreement.VoteTally{{
+			Period:            1,
+			Step:              3,
+			Threshold:         1000,
+			Voters:            2,
+			EquivocatorWeight: 5,
+			Weights: []agreement.ProposalWeight{
+				{ProposalValue: value, Weight: 600},
+				{Weight: 300},
+			},
+		}},
+	}
+	err = handler.GetConsensusState(c)
+	require.NoError(t, err)
+	require.Equal(t, 200, rec.Code)
+
+	var state model.ConsensusState
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &state))
+	require.Equal(t, uint64(10), state.Round)
+	require.Equal(t, uint64(1), state.Period)
+	require.Equal(t, uint64(3), state.Step)
+	require.Equal(t, uint64(2*time.Second), state.StepTime)
+	require.Equal(t, uint64(9*time.Second), state.RoundTime)
+	require.Equal(t, uint64(17*time.Second), state.Deadline)
+
+	require.Equal(t, []model.ConsensusProposal{{
+		OriginalPeriod:   1,
+		OriginalProposer: proposer.String(),
+		BlockDigest:      crypto.Digest{1}.String(),
+		Lowest:           true,
+		PayloadReceived:  true,
+	}}, state.Proposals)
+
+	require.Len(t, state.Tallies, 1)
+	tally := state.Tallies[0]
+	require.Equal(t, uint64(1000), tally.Threshold)
+	require.Equal(t, uint64(2), tally.Voters)
+	require.Equal(t, uint64(5), tally.EquivocatorWeight)
+	require.Equal(t, []model.ConsensusProposalWeight{
+		{OriginalPeriod: 1, OriginalProposer: proposer.String(), BlockDigest: crypto.Digest{1}.String(), Weight: 600},
+		{Weight: 300},
+	}, tally.Weights)
+}
@@ -17,6 +17,7 @@
 package test
 
 import (
+	"context"
 	"fmt"
 	"math/rand"
 	"strconv"
@@ -100,6 +101,7 @@ type mockNode struct {
 	status          node.StatusReport
 	devmode         bool
 	timestampOffset *int64
+	agreementState  *agreement.StateSnapshot
 }
 
 func (m *mockNode) InstallParticipationKey(partKeyBinary []byte) (account.ParticipationID, error) {
@@ -237,6 +239,10 @@ func (m *mockNode) StartCatchup(catchpoint string) error {
 	return m.err
 }
 
+func (m *mockNode) StartCatchupFromFiles(catchpoint, catchpointFile, blocksFile string) error {
+	return m.err
+}
+
 func (m *mockNode) AbortCatchup(catchpoint string) error {
 	return m.err
 }
@@ -258,6 +264,13 @@ func (m *mockNode) GetBlockTimeStampOffset() (*int64, error) {
 	return m.timestampOffset, nil
 }
 
+func (m *mockNode) AgreementState(ctx context.Context) (agreement.StateSnapshot, error) {
+	if m.agreementState == nil {
+		return agreement.StateSnapshot{}, fmt.Errorf("agreement is not running")
+	}
+	return *m.agreementState, nil
+}
+
 ////// mock ledger testing environment follows
 
 var sinkAddr = basics.Address{0x7, 0xda, 0xcb, 0x4b, 0x6d, 0x9e, 0xd1, 0x41, 0xb1, 0x75, 0x76, 0xbd, 0x45, 0x9a, 0xe6, 0x42, 0x1d, 0x48, 0x6d, 0xa3, 0xd4, 0xef, 0x22, 0x47, 0xc4, 0x9, 0xa3, 0x96, 0xb8, 0x2e, 0xa2, 0x21}
@@ -17,9 +17,11 @@
 package v2
 
 import (
+	"bytes"
 	"encoding/base64"
 	"fmt"
 	"net/http"
+	"sort"
 	"strings"
 	"unicode"
 	"unicode/utf8"
@@ -30,6 +32,7 @@ import (
 	"github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated/model"
 	"github.com/algorand/go-algorand/data/basics"
 	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/data/transactions/logic"
 	"github.com/algorand/go-algorand/ledger/simulation"
 	"github.com/algorand/go-algorand/logging"
 	"github.com/algorand/go-algorand/node"
@@ -360,6 +363,59 @@ func ConvertInnerTxn(txn *transactions.SignedTxnWithAD) PreEncodedTxInfo {
 	return response
 }
 
+func convertTealValue(tv basics.TealValue) model.AvmValue {
+	switch tv.Type {
+	case basics.TealBytesType:
+		bytes := []byte(tv.Bytes)
+		return model.AvmValue{Type: uint64(tv.Type), Bytes: &bytes}
+	default:
+		return model.AvmValue{Type: uint64(tv.Type), Uint: numOrNil(tv.Uint)}
+	}
+}
+
+func convertScratchChanges(scratchChanges []simulation.ScratchChange) *[]model.ScratchChange {
+	if len(scratchChanges) == 0 {
+		return nil
+	}
+	modelScratchChanges := make([]model.ScratchChange, len(scratchChanges))
+	for i, scratchChange := range scratchChanges {
+		modelScratchChanges[i] = model.ScratchChange{
+			Slot:     scratchChange.Slot,
+			NewValue: convertTealValue(scratchChange.NewValue),
+		}
+	}
+	return &modelScratchChanges
+}
+
+func convertStateOperations(stateOperations []simulation.StateOperation) *[]model.ApplicationStateOperation {
+	if len(stateOperations) == 0 {
+		return nil
+	}
+	modelStateOperations := make([]model.ApplicationStateOperation, len(stateOperations))
+	for i, stateOperation := range stateOperations {
+		modelStateOperation := model.ApplicationStateOperation{Key: []byte(stateOperation.Key)}
+		switch stateOperation.AppStateOp {
+		case logic.AppStateWrite:
+			modelStateOperation.Operation = "w"
+			newValue := convertTealValue(stateOperation.NewValue)
+			modelStateOperation.NewValue = &newValue
+		case logic.AppStateDelete:
+			modelStateOperation.Operation = "d"
+		}
+		switch stateOperation.AppState {
+		case logic.GlobalState:
+			modelStateOperation.AppStateType = "g"
+		case logic.LocalState:
+			modelStateOperation.AppStateType = "l"
+			modelStateOperation.Account = addrOrNil(stateOperation.Account)
+		case logic.BoxState:
+			modelStateOperation.AppStateType = "b"
+		}
+		modelStateOperations[i] = modelStateOperation
+	}
+	return &modelStateOperations
+}
+
 func convertProgramTrace(programTrace []simulation.OpcodeTraceUnit) *[]model.SimulationOpcodeTraceUnit {
 	if len(programTrace) == 0 {
 		return nil
@@ -375,9 +431,21 @@ func convertProgramTrace(programTrace []simulation.OpcodeTraceUnit) *[]model.Sim
 			}
 			spawnedInnersPtr = &spawnedInners
 		}
+		var stackAdditionsPtr *[]model.AvmValue
+		if len(programTrace[i].StackAdded) > 0 {
+			stackAdditions := make([]model.AvmValue, len(programTrace[i].StackAdded))
+			for j, tv := range programTrace[i].StackAdded {
+				stackAdditions[j] = convertTealValue(tv)
+			}
+			stackAdditionsPtr = &stackAdditions
+		}
 		modelProgramTrace[i] = model.SimulationOpcodeTraceUnit{
-			Pc:            programTrace[i].PC,
-			SpawnedInners: spawnedInnersPtr,
+			Pc:             programTrace[i].PC,
+			SpawnedInners:  spawnedInnersPtr,
+			StackAdditions: stackAdditionsPtr,
+			StackPopCount:  numOrNil(programTrace[i].StackPopCount),
+			ScratchChanges: convertScratchChanges(programTrace[i].ScratchSlotChanges),
+			StateChanges:   convertStateOperations(programTrace[i].StateChanges),
 		}
 	}
 
@@ -408,12 +476,91 @@ func convertTxnTrace(txnTrace *simulation.TransactionTrace) *model.SimulationTra
 	return &execTraceModel
 }
 
+func convertUnnamedResourcesAccessed(resources *simulation.ResourceTracker) *model.SimulateUnnamedResourcesAccessed {
+	if resources == nil {
+		return nil
+	}
+	converted := model.SimulateUnnamedResourcesAccessed{}
+
+	if len(resources.Accounts) != 0 {
+		accounts := make([]string, 0, len(resources.Accounts))
+		for addr := range resources.Accounts {
+			accounts = append(accounts, addr.String())
+		}
+		sort.Strings(accounts)
+		converted.Accounts = &accounts
+	}
+
+	if len(resources.Assets) != 0 {
+		assets := make([]uint64, 0, len(resources.Assets))
+		for aid := range resources.Assets {
+			assets = append(assets, uint64(aid))
+		}
+		sort.Slice(assets, func(i, j int) bool { return assets[i] < assets[j] })
+		converted.Assets = &assets
+	}
+
+	if len(resources.Apps) != 0 {
+		apps := make([]uint64, 0, len(resources.Apps))
+		for aid := range resources.Apps {
+			apps = append(apps, uint64(aid))
+		}
+		sort.Slice(apps, func(i, j int) bool { return apps[i] < apps[j] })
+		converted.Apps = &apps
+	}
+
+	if len(resources.Boxes) != 0 {
+		boxes := make([]model.BoxReference, 0, len(resources.Boxes))
+		for box := range resources.Boxes {
+			boxes = append(boxes, model.BoxReference{App: uint64(box.App), Name: []byte(box.Name)})
+		}
+		sort.Slice(boxes, func(i, j int) bool {
+			if boxes[i].App != boxes[j].App {
+				return boxes[i].App < boxes[j].App
+			}
+			return bytes.Compare(boxes[i].Name, boxes[j].Name) < 0
+		})
+		converted.Boxes = &boxes
+	}
+
+	if len(resources.AssetHoldings) != 0 {
+		holdings := make([]model.AssetHoldingReference, 0, len(resources.AssetHoldings))
+		for holding := range resources.AssetHoldings {
+			holdings = append(holdings, model.AssetHoldingReference{Account: holding.Address.String(), Asset: uint64(holding.Asset)})
+		}
+		sort.Slice(holdings, func(i, j int) bool {
+			if holdings[i].Account != holdings[j].Account {
+				return holdings[i].Account < holdings[j].Account
+			}
+			return holdings[i].Asset < holdings[j].Asset
+		})
+		converted.AssetHoldings = &holdings
+	}
+
+	if len(resources.AppLocals) != 0 {
+		locals := make([]model.ApplicationLocalReference, 0, len(resources.AppLocals))
+		for local := range resources.AppLocals {
+			locals = append(locals, model.ApplicationLocalReference{Account: local.Address.String(), App: uint64(local.App)})
+		}
+		sort.Slice(locals, func(i, j int) bool {
+			if locals[i].Account != locals[j].Account {
+				return locals[i].Account < locals[j].Account
+			}
+			return locals[i].App < locals[j].App
+		})
+		converted.AppLocals = &locals
+	}
+
+	return &converted
+}
+
 func convertTxnResult(txnResult simulation.TxnResult) PreEncodedSimulateTxnResult {
 	return PreEncodedSimulateTxnResult{
-		Txn:                    ConvertInnerTxn(&txnResult.Txn),
-		AppBudgetConsumed:      numOrNil(txnResult.AppBudgetConsumed),
-		LogicSigBudgetConsumed: numOrNil(txnResult.LogicSigBudgetConsumed),
-		TransactionTrace:       convertTxnTrace(txnResult.Trace),
+		Txn:                      ConvertInnerTxn(&txnResult.Txn),
+		AppBudgetConsumed:        numOrNil(txnResult.AppBudgetConsumed),
+		LogicSigBudgetConsumed:   numOrNil(txnResult.LogicSigBudgetConsumed),
+		TransactionTrace:         convertTxnTrace(txnResult.Trace),
+		UnnamedResourcesAccessed: convertUnnamedResourcesAccessed(txnResult.UnnamedResourcesAccessed),
 	}
 }
 
@@ -424,10 +571,11 @@ func convertTxnGroupResult(txnGroupResult simulation.TxnGroupResult) PreEncodedS
 	}
 
 	encoded := PreEncodedSimulateTxnGroupResult{
-		Txns:              txnResults,
-		FailureMessage:    strOrNil(txnGroupResult.FailureMessage),
-		AppBudgetAdded:    numOrNil(txnGroupResult.AppBudgetAdded),
-		AppBudgetConsumed: numOrNil(txnGroupResult.AppBudgetConsumed),
+		Txns:                     txnResults,
+		FailureMessage:           strOrNil(txnGroupResult.FailureMessage),
+		AppBudgetAdded:           numOrNil(txnGroupResult.AppBudgetAdded),
+		AppBudgetConsumed:        numOrNil(txnGroupResult.AppBudgetConsumed),
+		UnnamedResourcesAccessed: convertUnnamedResourcesAccessed(txnGroupResult.UnnamedResourcesAccessed),
 	}
 
 	if len(txnGroupResult.FailedAt) > 0 {
@@ -443,10 +591,11 @@ func convertSimulationResult(result simulation.Result) PreEncodedSimulateRespons
 	var evalOverrides *model.SimulationEvalOverrides
 	if result.EvalOverrides != (simulation.ResultEvalOverrides{}) {
 		evalOverrides = &model.SimulationEvalOverrides{
-			AllowEmptySignatures: trueOrNil(result.EvalOverrides.AllowEmptySignatures),
-			MaxLogSize:           result.EvalOverrides.MaxLogSize,
-			MaxLogCalls:          result.EvalOverrides.MaxLogCalls,
-			ExtraOpcodeBudget:    numOrNil(result.EvalOverrides.ExtraOpcodeBudget),
+			AllowEmptySignatures:  trueOrNil(result.EvalOverrides.AllowEmptySignatures),
+			AllowUnnamedResources: trueOrNil(result.EvalOverrides.AllowUnnamedResources),
+			MaxLogSize:            result.EvalOverrides.MaxLogSize,
+			MaxLogCalls:           result.EvalOverrides.MaxLogCalls,
+			ExtraOpcodeBudget:     numOrNil(result.EvalOverrides.ExtraOpcodeBudget),
 		}
 	}
 
@@ -471,11 +620,14 @@ func convertSimulationRequest(request PreEncodedSimulateRequest) simulation.Requ
 		txnGroups[i] = txnGroup.Txns
 	}
 	return simulation.Request{
-		TxnGroups:            txnGroups,
-		AllowEmptySignatures: request.AllowEmptySignatures,
-		AllowMoreLogging:     request.AllowMoreLogging,
-		ExtraOpcodeBudget:    request.ExtraOpcodeBudget,
-		TraceConfig:          request.ExecTraceConfig,
+		Round:                 request.Round,
+		TxnGroups:             txnGroups,
+		AllowEmptySignatures:  request.AllowEmptySignatures,
+		AllowMoreLogging:      request.AllowMoreLogging,
+		AllowUnnamedResources: request.AllowUnnamedResources,
+		ExtraOpcodeBudget:     request.ExtraOpcodeBudget,
+		TraceConfig:           request.ExecTraceConfig,
+		StateOverrides:        request.StateOverrides,
 	}
 }
 
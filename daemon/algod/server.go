@@ -307,8 +307,9 @@ func (s *Server) Start() {
 		MaxHeaderBytes: maxHeaderBytes,
 	}
 
+	scopedTokensFile := filepath.Join(s.RootPath, tokens.AlgodScopedTokensFilename)
 	e := apiServer.NewRouter(
-		s.log, s.node, s.stopping, apiToken, adminAPIToken, listener,
+		s.log, s.node, s.stopping, apiToken, adminAPIToken, scopedTokensFile, listener,
 		cfg.RestConnectionsSoftLimit)
 
 	// Set up files for our PID and our listening address
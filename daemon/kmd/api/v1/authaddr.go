@@ -0,0 +1,113 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package v1
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+
+	algodclient "github.com/algorand/go-algorand/daemon/algod/api/client"
+	"github.com/algorand/go-algorand/daemon/kmd/config"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/util"
+	"github.com/algorand/go-algorand/util/tokens"
+)
+
+// authAddrLookup asks algod which address a transaction sender was rekeyed
+// to, so that kmd can sign with the authorizing key
+type authAddrLookup struct {
+	cfg config.AlgodConfig
+}
+
+// makeAuthAddrLookup returns an authAddrLookup for the passed algod config,
+// defaulting its data directory to the parent of the kmd data directory
+func makeAuthAddrLookup(cfg config.AlgodConfig, kmdDataDir string) *authAddrLookup {
+	if cfg.Disable {
+		return nil
+	}
+	if cfg.Address == "" && cfg.DataDir == "" {
+		if kmdDataDir == "" {
+			return nil
+		}
+		cfg.DataDir = filepath.Dir(kmdDataDir)
+	}
+	return &authAddrLookup{cfg: cfg}
+}
+
+// client builds a client for the configured algod. ok is false when no algod
+// is configured and none runs in the configured data directory.
+func (l *authAddrLookup) client() (client algodclient.RestClient, ok bool, err error) {
+	if l.cfg.Address != "" {
+		u, err := url.Parse(l.cfg.Address)
+		if err != nil {
+			return client, false, err
+		}
+		return algodclient.MakeRestClient(*u, l.cfg.Token), true, nil
+	}
+
+	// algod writes algod.net when it starts and removes it when it stops
+	addr, err := util.GetFirstLineFromFile(filepath.Join(l.cfg.DataDir, "algod.net"))
+	if errors.Is(err, os.ErrNotExist) {
+		return client, false, nil
+	}
+	if err != nil {
+		return client, false, err
+	}
+	token, err := tokens.GetAndValidateAPIToken(l.cfg.DataDir, tokens.AlgodTokenFilename)
+	if err != nil {
+		return client, false, err
+	}
+	u := url.URL{Scheme: "http", Host: addr}
+	if strings.HasPrefix(addr, "http:") || strings.HasPrefix(addr, "https:") {
+		parsed, err := url.Parse(addr)
+		if err != nil {
+			return client, false, err
+		}
+		u = *parsed
+	}
+	return algodclient.MakeRestClient(u, token), true, nil
+}
+
+// AuthAddr returns the address the sender was rekeyed to, or the zero address
+// if it wasn't rekeyed, algod doesn't know the account, or no algod is
+// configured. Any other failure to ask algod is returned.
+func (l *authAddrLookup) AuthAddr(sender basics.Address) (authAddr basics.Address, err error) {
+	if l == nil {
+		return
+	}
+	client, ok, err := l.client()
+	if err != nil || !ok {
+		return
+	}
+	info, err := client.AccountInformation(sender.String(), false)
+	if err != nil {
+		var httpErr algodclient.HTTPError
+		if errors.As(err, &httpErr) && httpErr.StatusCode == http.StatusNotFound {
+			return basics.Address{}, nil
+		}
+		return basics.Address{}, fmt.Errorf("%w: %v", errAuthAddrLookup, err)
+	}
+	if info.AuthAddr == nil || *info.AuthAddr == "" {
+		return
+	}
+	return basics.UnmarshalChecksumAddress(*info.AuthAddr)
+}
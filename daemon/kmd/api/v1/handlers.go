@@ -17,11 +17,14 @@
 package v1
 
 import (
+	"fmt"
 	"net/http"
+	"strings"
 
 	"github.com/gorilla/mux"
 
 	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/daemon/kmd/config"
 	"github.com/algorand/go-algorand/daemon/kmd/lib/kmdapi"
 	"github.com/algorand/go-algorand/daemon/kmd/session"
 	"github.com/algorand/go-algorand/daemon/kmd/wallet"
@@ -35,7 +38,8 @@ import (
 // reqContext is passed to each of the handlers below via wrapCtx, allowing
 // handlers to interact with kmd's session store
 type reqContext struct {
-	sm *session.Manager
+	sm        *session.Manager
+	authAddrs *authAddrLookup
 }
 
 // errorResponse sets the specified status code (should != 200), and fills in the
@@ -364,6 +368,184 @@ func postMasterKeyExportHandler(ctx reqContext, w http.ResponseWriter, r *http.R
 	successResponse(w, resp)
 }
 
+// postWalletExportHandler handles `POST /v1/wallet/export`
+func postWalletExportHandler(ctx reqContext, w http.ResponseWriter, r *http.Request) {
+	// swagger:operation POST /v1/wallet/export ExportWallet
+	//---
+	//    Summary: Export a wallet backup
+	//    Description: >
+	//      Export a backup of the wallet, encrypted with the wallet password. Unlike the master
+	//      derivation key, the backup also holds the keys imported into the wallet and its multisig
+	//      preimages, and can be restored with `POST /v1/wallet/import`.
+	//    Produces:
+	//    - application/json
+	//    Parameters:
+	//      - name: Export Wallet Request
+	//        in: body
+	//        required: true
+	//        schema:
+	//          "$ref": "#/definitions/ExportWalletRequest"
+	//    Responses:
+	//      "200":
+	//        "$ref": "#/responses/ExportWalletResponse"
+	var req kmdapi.APIV1POSTWalletExportRequest
+
+	// Decode the request
+	decoder := protocol.NewJSONDecoder(r.Body)
+	err := decoder.Decode(&req)
+	if err != nil {
+		errorResponse(w, http.StatusBadRequest, errCouldNotDecode)
+		return
+	}
+
+	// Fetch the wallet from the WalletHandleToken
+	wallet, _, err := ctx.sm.AuthWithWalletHandleToken([]byte(req.WalletHandleToken))
+	if err != nil {
+		errorResponse(w, http.StatusUnauthorized, err)
+		return
+	}
+
+	// Export the backup
+	backup, err := wallet.Backup([]byte(req.WalletPassword))
+	if err != nil {
+		errorResponse(w, http.StatusBadRequest, err)
+		return
+	}
+
+	// Build the response
+	resp := kmdapi.APIV1POSTWalletExportResponse{
+		Backup: backup,
+	}
+
+	// Return and encode the response
+	successResponse(w, resp)
+}
+
+// postWalletUpgradeKDFHandler handles `POST /v1/wallet/upgrade-kdf`
+func postWalletUpgradeKDFHandler(ctx reqContext, w http.ResponseWriter, r *http.Request) {
+	// swagger:operation POST /v1/wallet/upgrade-kdf UpgradeWalletKDF
+	//---
+	//    Summary: Re-encrypt a wallet under the configured key derivation function
+	//    Description: >
+	//      Re-encrypt the wallet under the wallet password, using the key derivation function and
+	//      parameters currently set in the kmd configuration. This lets wallets created with scrypt
+	//      move to Argon2id. The wallet password is unchanged.
+	//    Produces:
+	//    - application/json
+	//    Parameters:
+	//      - name: Upgrade Wallet KDF Request
+	//        in: body
+	//        required: true
+	//        schema:
+	//          "$ref": "#/definitions/UpgradeWalletKDFRequest"
+	//    Responses:
+	//      "200":
+	//        "$ref": "#/responses/UpgradeWalletKDFResponse"
+	var req kmdapi.APIV1POSTWalletUpgradeKDFRequest
+
+	// Decode the request
+	decoder := protocol.NewJSONDecoder(r.Body)
+	err := decoder.Decode(&req)
+	if err != nil {
+		errorResponse(w, http.StatusBadRequest, errCouldNotDecode)
+		return
+	}
+
+	// Fetch the wallet from the WalletHandleToken
+	wallet, _, err := ctx.sm.AuthWithWalletHandleToken([]byte(req.WalletHandleToken))
+	if err != nil {
+		errorResponse(w, http.StatusUnauthorized, err)
+		return
+	}
+
+	// Re-encrypt the wallet
+	err = wallet.UpgradeKDF([]byte(req.WalletPassword))
+	if err != nil {
+		errorResponse(w, http.StatusBadRequest, err)
+		return
+	}
+
+	// Build the response
+	resp := kmdapi.APIV1POSTWalletUpgradeKDFResponse{}
+
+	// Return and encode the response
+	successResponse(w, resp)
+}
+
+// postWalletImportHandler handles `POST /v1/wallet/import`
+func postWalletImportHandler(ctx reqContext, w http.ResponseWriter, r *http.Request) {
+	// swagger:operation POST /v1/wallet/import ImportWallet
+	//---
+	//    Summary: Import a wallet backup
+	//    Description: >
+	//      Create a new wallet from a backup exported with `POST /v1/wallet/export`. The wallet
+	//      password must be the one the backup was encrypted with. If the wallet name is blank, the
+	//      name of the backed up wallet is used.
+	//    Produces:
+	//    - application/json
+	//    Parameters:
+	//      - name: Import Wallet Request
+	//        in: body
+	//        required: true
+	//        schema:
+	//          "$ref": "#/definitions/ImportWalletRequest"
+	//    Responses:
+	//      "200":
+	//        "$ref": "#/responses/ImportWalletResponse"
+	var req kmdapi.APIV1POSTWalletImportRequest
+
+	// Decode the request
+	decoder := protocol.NewJSONDecoder(r.Body)
+	err := decoder.Decode(&req)
+	if err != nil {
+		errorResponse(w, http.StatusBadRequest, errCouldNotDecode)
+		return
+	}
+
+	// Fetch the wallet driver
+	walletDriver, err := driver.FetchWalletDriver(req.WalletDriverName)
+	if err != nil {
+		errorResponse(w, http.StatusBadRequest, err)
+		return
+	}
+
+	// Generate a wallet ID
+	walletID, err := wallet.GenerateWalletID()
+	if err != nil {
+		errorResponse(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	// Restore the wallet via its driver
+	err = walletDriver.RestoreWallet([]byte(req.WalletName), walletID, []byte(req.WalletPassword), req.Backup)
+	if err != nil {
+		errorResponse(w, http.StatusBadRequest, err)
+		return
+	}
+
+	// Fetch the wallet
+	wallet, err := walletDriver.FetchWallet(walletID)
+	if err != nil {
+		errorResponse(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	// Fetch metadata about the wallet we just restored
+	metadata, err := wallet.Metadata()
+	if err != nil {
+		errorResponse(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	// Build the response
+	resp := kmdapi.APIV1POSTWalletImportResponse{
+		Wallet: apiWalletFromMetadata(metadata),
+	}
+
+	// Return and encode the response
+	successResponse(w, resp)
+}
+
 // postWalletReleaseHandler handles `POST /v1/wallet/release`
 func postWalletReleaseHandler(ctx reqContext, w http.ResponseWriter, r *http.Request) {
 	// swagger:operation POST /v1/wallet/release ReleaseWalletHandleToken
@@ -803,7 +985,9 @@ func postTransactionSignHandler(ctx reqContext, w http.ResponseWriter, r *http.R
 	//    Summary: Sign a transaction
 	//    Description: >
 	//      Signs the passed transaction with a key from the wallet, determined
-	//      by the sender encoded in the transaction.
+	//      by the sender encoded in the transaction, or by the address algod
+	//      reports the sender was rekeyed to. Pass public_key to sign with a
+	//      specific key instead.
 	//    Produces:
 	//    - application/json
 	//    Parameters:
@@ -842,9 +1026,26 @@ func postTransactionSignHandler(ctx reqContext, w http.ResponseWriter, r *http.R
 		return
 	}
 
+	// If no key was requested, sign with the key the sender was rekeyed to
+	pk := req.PublicKey
+	var authAddr basics.Address
+	if (pk == crypto.PublicKey{}) {
+		authAddr, err = ctx.authAddrs.AuthAddr(tx.Sender)
+		if err != nil {
+			errorResponse(w, http.StatusServiceUnavailable, err)
+			return
+		}
+		if !authAddr.IsZero() {
+			pk = crypto.PublicKey(authAddr)
+		}
+	}
+
 	// Sign the transaction
-	stx, err := wallet.SignTransaction(tx, req.PublicKey, []byte(req.WalletPassword))
+	stx, err := wallet.SignTransaction(tx, pk, []byte(req.WalletPassword))
 	if err != nil {
+		if !authAddr.IsZero() {
+			err = fmt.Errorf("sender %s was rekeyed to %s: %w", tx.Sender, authAddr, err)
+		}
 		errorResponse(w, http.StatusBadRequest, err)
 		return
 	}
@@ -916,6 +1117,160 @@ func postProgramSignHandler(ctx reqContext, w http.ResponseWriter, r *http.Reque
 	successResponse(w, resp)
 }
 
+// postTransactionGroupSignHandler handles `POST /v1/transaction-group/sign`
+func postTransactionGroupSignHandler(ctx reqContext, w http.ResponseWriter, r *http.Request) {
+	// swagger:operation POST /v1/transaction-group/sign SignTransactionGroup
+	//---
+	//    Summary: Sign a transaction group
+	//    Description: >
+	//      Signs all the passed transactions, which must share the same group
+	//      ID, with keys from the wallet. Each transaction is signed with the
+	//      key given for it, or with the key of its sender. If no keys are
+	//      given, the key each sender was rekeyed to is used instead.
+	//    Produces:
+	//    - application/json
+	//    Parameters:
+	//      - name: Sign Transaction Group Request
+	//        in: body
+	//        required: true
+	//        schema:
+	//          "$ref": "#/definitions/SignTransactionGroupRequest"
+	//    Responses:
+	//      "200":
+	//        "$ref": "#/responses/SignTransactionGroupResponse"
+	var req kmdapi.APIV1POSTTransactionGroupSignRequest
+
+	// Decode the request
+	decoder := protocol.NewJSONDecoder(r.Body)
+	err := decoder.Decode(&req)
+	if err != nil {
+		errorResponse(w, http.StatusBadRequest, errCouldNotDecode)
+		return
+	}
+
+	// Fetch the wallet from the WalletHandleToken
+	wallet, _, err := ctx.sm.AuthWithWalletHandleToken([]byte(req.WalletHandleToken))
+	if err != nil {
+		errorResponse(w, http.StatusUnauthorized, err)
+		return
+	}
+
+	// Decode the transactions
+	txs := make([]transactions.Transaction, len(req.Transactions))
+	for i := range req.Transactions {
+		err = protocol.Decode(req.Transactions[i], &txs[i])
+		if err != nil {
+			errorResponse(w, http.StatusBadRequest, errCouldNotDecodeTx)
+			return
+		}
+	}
+
+	// If no keys were requested, sign each transaction with the key its
+	// sender was rekeyed to
+	pks := req.PublicKeys
+	var rekeyed []string
+	if len(pks) == 0 {
+		pks = make([]crypto.PublicKey, len(txs))
+		for i, tx := range txs {
+			authAddr, err := ctx.authAddrs.AuthAddr(tx.Sender)
+			if err != nil {
+				errorResponse(w, http.StatusServiceUnavailable, err)
+				return
+			}
+			if !authAddr.IsZero() {
+				pks[i] = crypto.PublicKey(authAddr)
+				rekeyed = append(rekeyed, fmt.Sprintf("%s to %s", tx.Sender, authAddr))
+			}
+		}
+	}
+
+	// Sign the transactions
+	stxs, err := wallet.SignTransactionGroup(txs, pks, []byte(req.WalletPassword))
+	if err != nil {
+		if len(rekeyed) != 0 {
+			err = fmt.Errorf("senders were rekeyed (%s): %w", strings.Join(rekeyed, ", "), err)
+		}
+		errorResponse(w, http.StatusBadRequest, err)
+		return
+	}
+
+	// Build the response
+	resp := kmdapi.APIV1POSTTransactionGroupSignResponse{
+		SignedTransactions: stxs,
+	}
+
+	// Return and encode the response
+	successResponse(w, resp)
+}
+
+// postDataSignHandler handles `POST /v1/data/sign`
+func postDataSignHandler(ctx reqContext, w http.ResponseWriter, r *http.Request) {
+	// swagger:operation POST /v1/data/sign SignData
+	//---
+	//    Summary: Sign data
+	//    Description: >
+	//      Signs the passed data under the passed domain with a key from the
+	//      wallet, determined by the account named in the request. The
+	//      signature is computed over a prefix reserved for kmd data, the
+	//      domain and the data, so it can't be used as the signature of a
+	//      transaction, a program, or data under another domain.
+	//    Produces:
+	//    - application/json
+	//    Parameters:
+	//      - name: Sign Data Request
+	//        in: body
+	//        required: true
+	//        schema:
+	//          "$ref": "#/definitions/SignDataRequest"
+	//    Responses:
+	//      "200":
+	//        "$ref": "#/responses/SignDataResponse"
+	var req kmdapi.APIV1POSTDataSignRequest
+
+	// Decode the request
+	decoder := protocol.NewJSONDecoder(r.Body)
+	err := decoder.Decode(&req)
+	if err != nil {
+		errorResponse(w, http.StatusBadRequest, errCouldNotDecode)
+		return
+	}
+
+	// Check the domain
+	data, err := wallet.MakeDomainData(req.Domain, req.Data)
+	if err != nil {
+		errorResponse(w, http.StatusBadRequest, err)
+		return
+	}
+
+	// Fetch the wallet from the WalletHandleToken
+	wallet, _, err := ctx.sm.AuthWithWalletHandleToken([]byte(req.WalletHandleToken))
+	if err != nil {
+		errorResponse(w, http.StatusUnauthorized, err)
+		return
+	}
+
+	// Decode the address
+	reqAddr, err := basics.UnmarshalChecksumAddress(req.Address)
+	if err != nil {
+		errorResponse(w, http.StatusBadRequest, errCouldNotDecodeAddress)
+		return
+	}
+
+	sig, err := wallet.SignData(data, crypto.Digest(reqAddr), []byte(req.WalletPassword))
+	if err != nil {
+		errorResponse(w, http.StatusBadRequest, err)
+		return
+	}
+
+	// Build the response
+	resp := kmdapi.APIV1POSTDataSignResponse{
+		Signature: sig,
+	}
+
+	// Return and encode the response
+	successResponse(w, resp)
+}
+
 // postMultisigListHandler handles `POST /v1/multisig/list`
 func postMultisigListHandler(ctx reqContext, w http.ResponseWriter, r *http.Request) {
 	// swagger:operation POST /v1/multisig/list ListMultisg
@@ -1280,7 +1635,7 @@ func reqCallbackMiddleware(reqCB func()) func(http.Handler) http.Handler {
 }
 
 // RegisterHandlers sets up the API handlers on the passed router
-func RegisterHandlers(router *mux.Router, sm *session.Manager, log logging.Logger, apiToken string, reqCB func()) {
+func RegisterHandlers(router *mux.Router, sm *session.Manager, algod config.AlgodConfig, dataDir string, log logging.Logger, apiToken string, reqCB func()) {
 	// All /v1 requests require a valid auth token
 	router.Use(authMiddleware(log, apiToken))
 
@@ -1289,7 +1644,8 @@ func RegisterHandlers(router *mux.Router, sm *session.Manager, log logging.Logge
 
 	// ctx holds the global context passed to each of the handlers
 	ctx := reqContext{
-		sm: sm,
+		sm:        sm,
+		authAddrs: makeAuthAddrLookup(algod, dataDir),
 	}
 
 	router.HandleFunc("/wallets", wrapCtx(ctx, getWalletsHandler)).Methods("GET")
@@ -1299,6 +1655,9 @@ func RegisterHandlers(router *mux.Router, sm *session.Manager, log logging.Logge
 	router.HandleFunc("/wallet/renew", wrapCtx(ctx, postWalletRenewHandler)).Methods("POST")
 	router.HandleFunc("/wallet/rename", wrapCtx(ctx, postWalletRenameHandler)).Methods("POST")
 	router.HandleFunc("/wallet/info", wrapCtx(ctx, postWalletInfoHandler)).Methods("POST")
+	router.HandleFunc("/wallet/export", wrapCtx(ctx, postWalletExportHandler)).Methods("POST")
+	router.HandleFunc("/wallet/upgrade-kdf", wrapCtx(ctx, postWalletUpgradeKDFHandler)).Methods("POST")
+	router.HandleFunc("/wallet/import", wrapCtx(ctx, postWalletImportHandler)).Methods("POST")
 	router.HandleFunc("/master-key/export", wrapCtx(ctx, postMasterKeyExportHandler)).Methods("POST")
 
 	router.HandleFunc("/key/list", wrapCtx(ctx, postKeyListHandler)).Methods("POST")
@@ -1316,4 +1675,6 @@ func RegisterHandlers(router *mux.Router, sm *session.Manager, log logging.Logge
 
 	router.HandleFunc("/transaction/sign", wrapCtx(ctx, postTransactionSignHandler)).Methods("POST")
 	router.HandleFunc("/program/sign", wrapCtx(ctx, postProgramSignHandler)).Methods("POST")
+	router.HandleFunc("/transaction-group/sign", wrapCtx(ctx, postTransactionGroupSignHandler)).Methods("POST")
+	router.HandleFunc("/data/sign", wrapCtx(ctx, postDataSignHandler)).Methods("POST")
 }
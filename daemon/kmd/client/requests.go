@@ -99,6 +99,15 @@ func getPathAndMethod(req kmdapi.APIV1Request) (reqPath string, reqMethod string
 	case kmdapi.APIV1POSTWalletInfoRequest:
 		reqPath = "v1/wallet/info"
 		reqMethod = "POST"
+	case kmdapi.APIV1POSTWalletExportRequest:
+		reqPath = "v1/wallet/export"
+		reqMethod = "POST"
+	case kmdapi.APIV1POSTWalletUpgradeKDFRequest:
+		reqPath = "v1/wallet/upgrade-kdf"
+		reqMethod = "POST"
+	case kmdapi.APIV1POSTWalletImportRequest:
+		reqPath = "v1/wallet/import"
+		reqMethod = "POST"
 	case kmdapi.APIV1POSTMasterKeyExportRequest:
 		reqPath = "v1/master-key/export"
 		reqMethod = "POST"
@@ -123,6 +132,12 @@ func getPathAndMethod(req kmdapi.APIV1Request) (reqPath string, reqMethod string
 	case kmdapi.APIV1POSTTransactionSignRequest:
 		reqPath = "v1/transaction/sign"
 		reqMethod = "POST"
+	case kmdapi.APIV1POSTTransactionGroupSignRequest:
+		reqPath = "v1/transaction-group/sign"
+		reqMethod = "POST"
+	case kmdapi.APIV1POSTDataSignRequest:
+		reqPath = "v1/data/sign"
+		reqMethod = "POST"
 	case kmdapi.APIV1POSTMultisigListRequest:
 		reqPath = "v1/multisig/list"
 		reqMethod = "POST"
@@ -197,6 +197,38 @@ func (kcl KMDClient) ExportMasterDerivationKey(walletHandle []byte, walletPasswo
 	return
 }
 
+// ExportWallet wraps kmdapi.APIV1POSTWalletExportRequest
+func (kcl KMDClient) ExportWallet(walletHandle []byte, walletPassword []byte) (resp kmdapi.APIV1POSTWalletExportResponse, err error) {
+	req := kmdapi.APIV1POSTWalletExportRequest{
+		WalletHandleToken: string(walletHandle),
+		WalletPassword:    string(walletPassword),
+	}
+	err = kcl.DoV1Request(req, &resp)
+	return
+}
+
+// UpgradeWalletKDF wraps kmdapi.APIV1POSTWalletUpgradeKDFRequest
+func (kcl KMDClient) UpgradeWalletKDF(walletHandle []byte, walletPassword []byte) (resp kmdapi.APIV1POSTWalletUpgradeKDFResponse, err error) {
+	req := kmdapi.APIV1POSTWalletUpgradeKDFRequest{
+		WalletHandleToken: string(walletHandle),
+		WalletPassword:    string(walletPassword),
+	}
+	err = kcl.DoV1Request(req, &resp)
+	return
+}
+
+// ImportWallet wraps kmdapi.APIV1POSTWalletImportRequest
+func (kcl KMDClient) ImportWallet(walletName []byte, walletDriverName string, walletPassword []byte, backup []byte) (resp kmdapi.APIV1POSTWalletImportResponse, err error) {
+	req := kmdapi.APIV1POSTWalletImportRequest{
+		WalletName:       string(walletName),
+		WalletDriverName: walletDriverName,
+		WalletPassword:   string(walletPassword),
+		Backup:           backup,
+	}
+	err = kcl.DoV1Request(req, &resp)
+	return
+}
+
 // SignTransaction wraps kmdapi.APIV1POSTTransactionSignRequest
 func (kcl KMDClient) SignTransaction(walletHandle, pw []byte, pk crypto.PublicKey, tx transactions.Transaction) (resp kmdapi.APIV1POSTTransactionSignResponse, err error) {
 	txBytes := protocol.Encode(&tx)
@@ -221,3 +253,32 @@ func (kcl KMDClient) SignProgram(walletHandle, pw []byte, addr string, data []by
 	err = kcl.DoV1Request(req, &resp)
 	return
 }
+
+// SignTransactionGroup wraps kmdapi.APIV1POSTTransactionGroupSignRequest
+func (kcl KMDClient) SignTransactionGroup(walletHandle, pw []byte, pks []crypto.PublicKey, txs []transactions.Transaction) (resp kmdapi.APIV1POSTTransactionGroupSignResponse, err error) {
+	txsBytes := make([][]byte, len(txs))
+	for i := range txs {
+		txsBytes[i] = protocol.Encode(&txs[i])
+	}
+	req := kmdapi.APIV1POSTTransactionGroupSignRequest{
+		WalletHandleToken: string(walletHandle),
+		WalletPassword:    string(pw),
+		PublicKeys:        pks,
+		Transactions:      txsBytes,
+	}
+	err = kcl.DoV1Request(req, &resp)
+	return
+}
+
+// SignData wraps kmdapi.APIV1POSTDataSignRequest
+func (kcl KMDClient) SignData(walletHandle, pw []byte, addr string, domain string, data []byte) (resp kmdapi.APIV1POSTDataSignResponse, err error) {
+	req := kmdapi.APIV1POSTDataSignRequest{
+		WalletHandleToken: string(walletHandle),
+		WalletPassword:    string(pw),
+		Address:           addr,
+		Domain:            domain,
+		Data:              data,
+	}
+	err = kcl.DoV1Request(req, &resp)
+	return
+}
@@ -18,6 +18,8 @@ package config
 
 import (
 	"encoding/json"
+	"net"
+	"net/url"
 	"os"
 	"path/filepath"
 
@@ -31,6 +33,16 @@ const (
 	defaultScryptN             = 65536
 	defaultScryptR             = 1
 	defaultScryptP             = 32
+	defaultArgon2Time          = 3
+	defaultArgon2MemoryKiB     = 64 * 1024
+	defaultArgon2Threads       = 4
+)
+
+const (
+	// KDFScrypt derives wallet encryption keys from passwords with scrypt
+	KDFScrypt = "scrypt"
+	// KDFArgon2id derives wallet encryption keys from passwords with Argon2id
+	KDFArgon2id = "argon2id"
 )
 
 // KMDConfig contains global configuration information for kmd
@@ -40,12 +52,26 @@ type KMDConfig struct {
 	SessionLifetimeSecs uint64       `json:"session_lifetime_secs"`
 	Address             string       `json:"address"`
 	AllowedOrigins      []string     `json:"allowed_origins"`
+	Algod               AlgodConfig  `json:"algod"`
+}
+
+// AlgodConfig tells kmd which algod to ask whether the sender of a transaction
+// it signs was rekeyed. If Address is empty, kmd reads algod.net and
+// algod.token from DataDir, which defaults to the parent of the kmd data
+// directory, where goal starts kmd. If neither is available, kmd signs with
+// the sender key as before.
+type AlgodConfig struct {
+	Disable bool   `json:"disable"`
+	DataDir string `json:"data_dir"`
+	Address string `json:"address"`
+	Token   string `json:"token"`
 }
 
 // DriverConfig contains config info specific to each wallet driver
 type DriverConfig struct {
 	SQLiteWalletDriverConfig SQLiteWalletDriverConfig `json:"sqlite"`
 	LedgerWalletDriverConfig LedgerWalletDriverConfig `json:"ledger"`
+	RemoteWalletDriverConfig RemoteWalletDriverConfig `json:"remote"`
 }
 
 // SQLiteWalletDriverConfig is configuration specific to the SQLiteWalletDriver
@@ -53,6 +79,8 @@ type SQLiteWalletDriverConfig struct {
 	WalletsDir   string       `json:"wallets_dir"`
 	UnsafeScrypt bool         `json:"allow_unsafe_scrypt"`
 	ScryptParams ScryptParams `json:"scrypt"`
+	KDF          string       `json:"kdf"`
+	Argon2Params Argon2Params `json:"argon2id"`
 }
 
 // LedgerWalletDriverConfig is configuration specific to the LedgerWalletDriver
@@ -60,6 +88,57 @@ type LedgerWalletDriverConfig struct {
 	Disable bool `json:"disable"`
 }
 
+// RemoteWalletDriverConfig is configuration specific to the RemoteWalletDriver
+type RemoteWalletDriverConfig struct {
+	Signers []RemoteSignerConfig `json:"signers"`
+}
+
+// RemoteSignerConfig describes an external signer exposed as a wallet by the
+// RemoteWalletDriver. The URL is either an https URL, an http URL on a
+// loopback address, or a unix:// URL pointing to a unix domain socket.
+// PasswordHash protects the wallet the same way a password protects a sqlite
+// wallet; it's generated by `kmd remote-password-hash`.
+type RemoteSignerConfig struct {
+	Name         string `json:"name"`
+	URL          string `json:"url"`
+	Token        string `json:"token"`
+	PasswordHash string `json:"password_hash"`
+	TimeoutSecs  uint64 `json:"timeout_secs"`
+}
+
+// Validate ensures that the remote signer configuration is valid
+func (r RemoteSignerConfig) Validate() error {
+	if r.Name == "" {
+		return ErrRemoteSignerNameMissing
+	}
+	if r.PasswordHash == "" {
+		return ErrRemoteSignerPasswordHashMissing
+	}
+	u, err := url.Parse(r.URL)
+	if err != nil {
+		return ErrRemoteSignerURL
+	}
+	switch u.Scheme {
+	case "https":
+		return nil
+	case "unix":
+		if u.Path == "" {
+			return ErrRemoteSignerURL
+		}
+		return nil
+	case "http":
+		// keys are never sent to the signer, but transactions and signatures
+		// are, so plain http is limited to the local host
+		if u.Hostname() == "localhost" {
+			return nil
+		}
+		if ip := net.ParseIP(u.Hostname()); ip != nil && ip.IsLoopback() {
+			return nil
+		}
+	}
+	return ErrRemoteSignerURL
+}
+
 // ScryptParams stores the parameters used for key derivation. This allows
 // upgrading security parameters over time
 type ScryptParams struct {
@@ -68,6 +147,14 @@ type ScryptParams struct {
 	ScryptP int `json:"scrypt_p"`
 }
 
+// Argon2Params stores the parameters used for Argon2id key derivation, when
+// it is the configured KDF
+type Argon2Params struct {
+	Argon2Time      uint32 `json:"argon2_time"`
+	Argon2MemoryKiB uint32 `json:"argon2_memory_kib"`
+	Argon2Threads   uint8  `json:"argon2_threads"`
+}
+
 // defaultConfig returns the default KMDConfig
 func defaultConfig(dataDir string) KMDConfig {
 	return KMDConfig{
@@ -80,6 +167,12 @@ func defaultConfig(dataDir string) KMDConfig {
 					ScryptR: defaultScryptR,
 					ScryptP: defaultScryptP,
 				},
+				KDF: KDFScrypt,
+				Argon2Params: Argon2Params{
+					Argon2Time:      defaultArgon2Time,
+					Argon2MemoryKiB: defaultArgon2MemoryKiB,
+					Argon2Threads:   defaultArgon2Threads,
+				},
 			},
 		},
 	}
@@ -95,6 +188,37 @@ func (k KMDConfig) Validate() error {
 			return ErrSQLiteWalletNotAbsolute
 		}
 	}
+
+	// Ensure new sqlite wallets can be encrypted with the configured KDF
+	switch k.DriverConfig.SQLiteWalletDriverConfig.KDF {
+	case "", KDFScrypt, KDFArgon2id:
+	default:
+		return ErrSQLiteWalletKDF
+	}
+
+	// Ensure algod can be found where kmd is told to look for it
+	if k.Algod.DataDir != "" && !filepath.IsAbs(k.Algod.DataDir) {
+		return ErrAlgodDataDirNotAbsolute
+	}
+	if k.Algod.Address != "" {
+		u, err := url.Parse(k.Algod.Address)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return ErrAlgodAddress
+		}
+	}
+
+	// Ensure the remote signers are valid and uniquely named
+	names := make(map[string]bool)
+	for _, signer := range k.DriverConfig.RemoteWalletDriverConfig.Signers {
+		err := signer.Validate()
+		if err != nil {
+			return err
+		}
+		if names[signer.Name] {
+			return ErrRemoteSignerNameDuplicate
+		}
+		names[signer.Name] = true
+	}
 	return nil
 }
 
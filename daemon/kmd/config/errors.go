@@ -22,3 +22,24 @@ import (
 
 // ErrSQLiteWalletNotAbsolute is returned when the passed sqlite wallet directory is relative
 var ErrSQLiteWalletNotAbsolute = fmt.Errorf("sqlite wallets path must be absolute path")
+
+// ErrSQLiteWalletKDF is returned when the sqlite wallet kdf is neither scrypt nor argon2id
+var ErrSQLiteWalletKDF = fmt.Errorf("sqlite wallets kdf must be %s or %s", KDFScrypt, KDFArgon2id)
+
+// ErrRemoteSignerNameMissing is returned when a remote signer has no name
+var ErrRemoteSignerNameMissing = fmt.Errorf("remote signer name must be set")
+
+// ErrRemoteSignerNameDuplicate is returned when two remote signers share the same name
+var ErrRemoteSignerNameDuplicate = fmt.Errorf("remote signer names must be unique")
+
+// ErrRemoteSignerPasswordHashMissing is returned when a remote signer has no password hash
+var ErrRemoteSignerPasswordHashMissing = fmt.Errorf("remote signer password_hash must be set")
+
+// ErrRemoteSignerURL is returned when a remote signer url is neither an https url, a loopback http url nor a unix socket url
+var ErrRemoteSignerURL = fmt.Errorf("remote signer url must be an https url, a loopback http url or a unix socket url")
+
+// ErrAlgodDataDirNotAbsolute is returned when the passed algod data directory is relative
+var ErrAlgodDataDirNotAbsolute = fmt.Errorf("algod data dir must be absolute path")
+
+// ErrAlgodAddress is returned when the passed algod address is not an http or https url
+var ErrAlgodAddress = fmt.Errorf("algod address must be an http or https url")
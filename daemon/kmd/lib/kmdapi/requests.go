@@ -109,6 +109,41 @@ type APIV1POSTMasterKeyExportRequest struct {
 	WalletPassword    string `json:"wallet_password"`
 }
 
+// APIV1POSTWalletExportRequest is the request for `POST /v1/wallet/export`
+//
+// swagger:model ExportWalletRequest
+type APIV1POSTWalletExportRequest struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	WalletHandleToken string `json:"wallet_handle_token"`
+	WalletPassword    string `json:"wallet_password"`
+}
+
+// APIV1POSTWalletUpgradeKDFRequest is the request for `POST /v1/wallet/upgrade-kdf`
+//
+// swagger:model UpgradeWalletKDFRequest
+type APIV1POSTWalletUpgradeKDFRequest struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	WalletHandleToken string `json:"wallet_handle_token"`
+	WalletPassword    string `json:"wallet_password"`
+}
+
+// APIV1POSTWalletImportRequest is the request for `POST /v1/wallet/import`
+//
+// swagger:model ImportWalletRequest
+type APIV1POSTWalletImportRequest struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	WalletName       string `json:"wallet_name"`
+	WalletDriverName string `json:"wallet_driver_name"`
+	WalletPassword   string `json:"wallet_password"`
+	// Backup produced by `POST /v1/wallet/export`, encrypted with WalletPassword
+	//
+	// swagger:strfmt byte
+	Backup []byte `json:"backup"`
+}
+
 // APIV1POSTKeyImportRequest is the request for `POST /v1/key/import`
 //
 // swagger:model ImportKeyRequest
@@ -191,6 +226,38 @@ type APIV1POSTProgramSignRequest struct {
 	WalletPassword string `json:"wallet_password"`
 }
 
+// APIV1POSTTransactionGroupSignRequest is the request for `POST /v1/transaction-group/sign`
+//
+// swagger:model SignTransactionGroupRequest
+type APIV1POSTTransactionGroupSignRequest struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	WalletHandleToken string `json:"wallet_handle_token"`
+	// Base64 encodings of msgpack encodings of the `Transaction` objects of
+	// the group, which must all share the same group ID
+	Transactions [][]byte `json:"transactions"`
+	// Optional keys to sign each of the transactions with. A zero key signs
+	// with the key of the transaction sender. Without any keys, each
+	// transaction is signed with the key its sender was rekeyed to
+	PublicKeys     []crypto.PublicKey `json:"public_keys"`
+	WalletPassword string             `json:"wallet_password"`
+}
+
+// APIV1POSTDataSignRequest is the request for `POST /v1/data/sign`
+//
+// swagger:model SignDataRequest
+type APIV1POSTDataSignRequest struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	WalletHandleToken string `json:"wallet_handle_token"`
+	Address           string `json:"address"`
+	// Domain the data is signed under, which the signature is bound to
+	Domain string `json:"domain"`
+	// swagger:strfmt byte
+	Data           []byte `json:"data"`
+	WalletPassword string `json:"wallet_password"`
+}
+
 // APIV1POSTMultisigListRequest is the request for `POST /v1/multisig/list`
 //
 // swagger:model ListMultisigRequest
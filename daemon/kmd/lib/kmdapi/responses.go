@@ -173,6 +173,49 @@ type exportMasterKeyResponse struct {
 	Body *APIV1POSTMasterKeyExportResponse
 }
 
+// APIV1POSTWalletExportResponse is the response to `POST /v1/wallet/export`
+// friendly:ExportWalletResponse
+type APIV1POSTWalletExportResponse struct {
+	APIV1ResponseEnvelope
+
+	// swagger:strfmt byte
+	Backup []byte `json:"backup"`
+}
+
+// Response to `POST /v1/wallet/export`
+// swagger:response ExportWalletResponse
+type exportWalletResponse struct {
+	//	in:body
+	Body *APIV1POSTWalletExportResponse
+}
+
+// APIV1POSTWalletUpgradeKDFResponse is the response to `POST /v1/wallet/upgrade-kdf`
+// friendly:UpgradeWalletKDFResponse
+type APIV1POSTWalletUpgradeKDFResponse struct {
+	APIV1ResponseEnvelope
+}
+
+// Response to `POST /v1/wallet/upgrade-kdf`
+// swagger:response UpgradeWalletKDFResponse
+type upgradeWalletKDFResponse struct {
+	//	in:body
+	Body *APIV1POSTWalletUpgradeKDFResponse
+}
+
+// APIV1POSTWalletImportResponse is the response to `POST /v1/wallet/import`
+// friendly:ImportWalletResponse
+type APIV1POSTWalletImportResponse struct {
+	APIV1ResponseEnvelope
+	Wallet APIV1Wallet `json:"wallet"`
+}
+
+// Response to `POST /v1/wallet/import`
+// swagger:response ImportWalletResponse
+type importWalletResponse struct {
+	//	in:body
+	Body *APIV1POSTWalletImportResponse
+}
+
 // APIV1POSTKeyImportResponse is the response to `POST /v1/key/import`
 // friendly:ImportKeyResponse
 type APIV1POSTKeyImportResponse struct {
@@ -274,6 +317,37 @@ type signProgramResponse struct {
 	Body *APIV1POSTProgramSignResponse
 }
 
+// APIV1POSTTransactionGroupSignResponse is the response to `POST /v1/transaction-group/sign`
+// friendly:SignTransactionGroupResponse
+type APIV1POSTTransactionGroupSignResponse struct {
+	APIV1ResponseEnvelope
+
+	SignedTransactions [][]byte `json:"signed_transactions"`
+}
+
+// Response to `POST /v1/transaction-group/sign`
+// swagger:response SignTransactionGroupResponse
+type signTransactionGroupResponse struct {
+	//	in:body
+	Body *APIV1POSTTransactionGroupSignResponse
+}
+
+// APIV1POSTDataSignResponse is the response to `POST /v1/data/sign`
+// friendly:SignDataResponse
+type APIV1POSTDataSignResponse struct {
+	APIV1ResponseEnvelope
+
+	// swagger:strfmt byte
+	Signature []byte `json:"sig"`
+}
+
+// Response to `POST /v1/data/sign`
+// swagger:response SignDataResponse
+type signDataResponse struct {
+	//	in:body
+	Body *APIV1POSTDataSignResponse
+}
+
 // APIV1POSTMultisigListResponse is the response to `POST /v1/multisig/list`
 // friendly:ListMultisigResponse
 type APIV1POSTMultisigListResponse struct {
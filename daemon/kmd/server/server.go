@@ -30,6 +30,7 @@ import (
 	"github.com/gofrs/flock"
 
 	"github.com/algorand/go-algorand/daemon/kmd/api"
+	"github.com/algorand/go-algorand/daemon/kmd/config"
 	"github.com/algorand/go-algorand/daemon/kmd/session"
 	"github.com/algorand/go-algorand/logging"
 	"github.com/algorand/go-algorand/util/tokens"
@@ -54,6 +55,7 @@ type WalletServerConfig struct {
 	DataDir        string
 	Address        string
 	AllowedOrigins []string
+	Algod          config.AlgodConfig
 	SessionManager *session.Manager
 	Log            logging.Logger
 	Timeout        *time.Duration
@@ -211,7 +213,7 @@ func (ws *WalletServer) start(kill chan os.Signal) (died chan error, sock string
 	// Initialize HTTP server
 	watchdogCB := ws.makeWatchdogCallback(kill)
 	srv := http.Server{
-		Handler: api.Handler(ws.SessionManager, ws.Log, ws.AllowedOrigins, ws.APIToken, watchdogCB),
+		Handler: api.Handler(ws.SessionManager, ws.Algod, ws.DataDir, ws.Log, ws.AllowedOrigins, ws.APIToken, watchdogCB),
 	}
 
 	// Read the kill channel and shut down the server gracefully
@@ -28,6 +28,7 @@ import (
 var walletDrivers = map[string]Driver{
 	sqliteWalletDriverName: &SQLiteWalletDriver{},
 	ledgerWalletDriverName: &LedgerWalletDriver{},
+	remoteWalletDriverName: &RemoteWalletDriver{},
 }
 
 // Driver is the interface that all wallet drivers must expose in order to be
@@ -39,6 +40,7 @@ type Driver interface {
 	ListWalletMetadatas() ([]wallet.Metadata, error)
 	CreateWallet(name []byte, id []byte, pw []byte, mdk crypto.MasterDerivationKey) error
 	RenameWallet(newName []byte, id []byte, pw []byte) error
+	RestoreWallet(name []byte, id []byte, pw []byte, backup []byte) error
 	FetchWallet(id []byte) (wallet.Wallet, error)
 }
 
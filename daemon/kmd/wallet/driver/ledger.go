@@ -219,6 +219,11 @@ func (lwd *LedgerWalletDriver) ListWalletMetadatas() (metadatas []wallet.Metadat
 	return metadatas, nil
 }
 
+// RestoreWallet implements the Driver interface.
+func (lwd *LedgerWalletDriver) RestoreWallet(name []byte, id []byte, pw []byte, backup []byte) error {
+	return errNotSupported
+}
+
 // RenameWallet implements the Driver interface.
 func (lwd *LedgerWalletDriver) RenameWallet(newName []byte, id []byte, pw []byte) error {
 	return errNotSupported
@@ -239,6 +244,16 @@ func (lw *LedgerWallet) ExportMasterDerivationKey(pw []byte) (crypto.MasterDeriv
 	return crypto.MasterDerivationKey{}, errNotSupported
 }
 
+// Backup implements the Wallet interface.
+func (lw *LedgerWallet) Backup(pw []byte) ([]byte, error) {
+	return nil, errNotSupported
+}
+
+// UpgradeKDF implements the Wallet interface.
+func (lw *LedgerWallet) UpgradeKDF(pw []byte) error {
+	return errNotSupported
+}
+
 func pathToID(path string) string {
 	// The Path USB info field is platform-dependent and sometimes
 	// very long. We hash it to make the wallet name/ID less unwieldy
@@ -358,6 +373,28 @@ func (lw *LedgerWallet) SignTransaction(tx transactions.Transaction, pk crypto.P
 	return protocol.Encode(&stxn), nil
 }
 
+// SignTransactionGroup implements the Wallet interface. The device is asked to
+// sign each of the transactions in turn.
+func (lw *LedgerWallet) SignTransactionGroup(txs []transactions.Transaction, pks []crypto.PublicKey, pw []byte) ([][]byte, error) {
+	err := wallet.CheckTxGroup(txs, pks)
+	if err != nil {
+		return nil, err
+	}
+
+	stxs := make([][]byte, len(txs))
+	for i, tx := range txs {
+		var pk crypto.PublicKey
+		if len(pks) != 0 {
+			pk = pks[i]
+		}
+		stxs[i], err = lw.SignTransaction(tx, pk, pw)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return stxs, nil
+}
+
 // SignProgram implements the Wallet interface.
 func (lw *LedgerWallet) SignProgram(data []byte, src crypto.Digest, pw []byte) ([]byte, error) {
 	sig, err := lw.signProgramHelper(data)
@@ -368,6 +405,11 @@ func (lw *LedgerWallet) SignProgram(data []byte, src crypto.Digest, pw []byte) (
 	return sig[:], nil
 }
 
+// SignData implements the Wallet interface.
+func (lw *LedgerWallet) SignData(data wallet.DomainData, src crypto.Digest, pw []byte) ([]byte, error) {
+	return nil, errNotSupported
+}
+
 // MultisigSignTransaction implements the Wallet interface.
 func (lw *LedgerWallet) MultisigSignTransaction(tx transactions.Transaction, pk crypto.PublicKey, partial crypto.MultisigSig, pw []byte, signer crypto.Digest) (crypto.MultisigSig, error) {
 	isValidKey := false
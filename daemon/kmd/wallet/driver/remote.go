@@ -0,0 +1,652 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package driver
+
+import (
+	"bytes"
+	"context"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/daemon/kmd/config"
+	"github.com/algorand/go-algorand/daemon/kmd/wallet"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/data/transactions/logic"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+const (
+	remoteWalletDriverName    = "remote"
+	remoteWalletDriverVersion = 1
+	remoteDefaultTimeout      = 10 * time.Second
+	// remoteMaxResponseBytes bounds the size of the responses read from a remote signer
+	remoteMaxResponseBytes = 1 << 20
+
+	// remoteSignerKeysPath lists the public keys the signer holds, as a remoteKeysResponse
+	remoteSignerKeysPath = "/v1/keys"
+	// remoteSignerSignPath signs a remoteSignRequest, returning a remoteSignResponse
+	remoteSignerSignPath = "/v1/sign"
+
+	// remotePasswordHashScheme prefixes the password hashes of remote wallets,
+	// which are encoded as scrypt$N$r$p$salt$key with a base64 salt and key
+	remotePasswordHashScheme = "scrypt"
+	// remotePasswordScrypt{N,R,P} match the default sqlite wallet parameters
+	remotePasswordScryptN = 65536
+	remotePasswordScryptR = 1
+	remotePasswordScryptP = 32
+)
+
+var remoteWalletSupportedTxs = []protocol.TxType{protocol.PaymentTx, protocol.KeyRegistrationTx}
+
+// remoteKeysResponse is returned by the remote signer keys endpoint
+type remoteKeysResponse struct {
+	Keys [][]byte `json:"keys"`
+}
+
+// remoteSignRequest is sent to the remote signer sign endpoint. Message holds
+// the exact bytes to be signed, which are domain separated by their
+// protocol.HashID prefix ("TX" for transactions, "Program" for programs).
+// Transaction requests carry the msgpack encoded transaction as well, so that
+// the signer could apply its own policies before signing.
+type remoteSignRequest struct {
+	PublicKey   []byte `json:"public_key"`
+	Message     []byte `json:"message"`
+	Transaction []byte `json:"transaction,omitempty"`
+}
+
+// remoteSignResponse is returned by the remote signer sign endpoint
+type remoteSignResponse struct {
+	Signature []byte `json:"signature"`
+}
+
+// remoteErrorResponse is returned by the remote signer along with a non-200 status code
+type remoteErrorResponse struct {
+	Error string `json:"error"`
+}
+
+// RemoteWalletDriver exposes external signers, such as KMS shims or HSM
+// proxies, as wallets. Signing requests are delegated to the signer over
+// HTTP, so private keys never enter kmd's memory. Each configured signer
+// is a single wallet, protected by the password hash of its configuration.
+//
+// Signers are only reached over HTTP(S) or a unix domain socket: the protocol
+// is two small JSON endpoints, which every KMS shim or HSM proxy can serve
+// without generated stubs, and a gRPC transport would add the grpc and
+// protobuf modules to kmd's dependencies for no additional capability.
+type RemoteWalletDriver struct {
+	mu      deadlock.Mutex
+	wallets map[string]*RemoteWallet
+	log     logging.Logger
+}
+
+// RemoteWallet represents a single external signer under the RemoteWalletDriver
+type RemoteWallet struct {
+	id           string
+	name         string
+	baseURL      string
+	token        string
+	passwordHash remotePasswordHash
+	client       *http.Client
+
+	// once the password was checked against the (slow) scrypt hash, it's
+	// checked against a fast salted hash, as sqlite wallets do
+	mu         deadlock.Mutex
+	fastSalt   [saltLen]byte
+	fastHash   crypto.Digest
+	fastHashed bool
+}
+
+// remotePasswordHash is the parsed form of a remote wallet password hash
+type remotePasswordHash struct {
+	params config.ScryptParams
+	salt   [saltLen]byte
+	key    [masterKeyLen]byte
+}
+
+// HashRemoteWalletPassword returns the password hash to configure for a remote
+// signer wallet, so that it could be unlocked with the given password.
+func HashRemoteWalletPassword(pw []byte) (string, error) {
+	h, err := makeRemotePasswordHash(pw, config.ScryptParams{
+		ScryptN: remotePasswordScryptN,
+		ScryptR: remotePasswordScryptR,
+		ScryptP: remotePasswordScryptP,
+	})
+	if err != nil {
+		return "", err
+	}
+	return h.String(), nil
+}
+
+func makeRemotePasswordHash(pw []byte, params config.ScryptParams) (h remotePasswordHash, err error) {
+	h.params = params
+	err = fillRandomBytes(h.salt[:])
+	if err != nil {
+		return
+	}
+	key, err := deriveEncryptionKeyWithSalt(pw, &h.salt, kdfParams{Version: kdfScrypt, Scrypt: params})
+	if err != nil {
+		return
+	}
+	h.key = *key
+	return h, nil
+}
+
+func parseRemotePasswordHash(encoded string) (h remotePasswordHash, err error) {
+	fields := strings.Split(encoded, "$")
+	if len(fields) != 6 || fields[0] != remotePasswordHashScheme {
+		return h, errRemotePasswordHash
+	}
+	for i, param := range []*int{&h.params.ScryptN, &h.params.ScryptR, &h.params.ScryptP} {
+		*param, err = strconv.Atoi(fields[i+1])
+		if err != nil || *param <= 0 {
+			return h, errRemotePasswordHash
+		}
+	}
+	// the hash is read off the configuration, so it's checked like the
+	// parameters of a wallet blob
+	if (kdfParams{Version: kdfScrypt, Scrypt: h.params}).checkMaximums() != nil {
+		return h, errRemotePasswordHash
+	}
+	salt, err := base64.StdEncoding.DecodeString(fields[4])
+	if err != nil || len(salt) != len(h.salt) {
+		return h, errRemotePasswordHash
+	}
+	key, err := base64.StdEncoding.DecodeString(fields[5])
+	if err != nil || len(key) != len(h.key) {
+		return h, errRemotePasswordHash
+	}
+	copy(h.salt[:], salt)
+	copy(h.key[:], key)
+	return h, nil
+}
+
+// String encodes the password hash the way it's stored in the kmd configuration
+func (h remotePasswordHash) String() string {
+	return strings.Join([]string{
+		remotePasswordHashScheme,
+		strconv.Itoa(h.params.ScryptN),
+		strconv.Itoa(h.params.ScryptR),
+		strconv.Itoa(h.params.ScryptP),
+		base64.StdEncoding.EncodeToString(h.salt[:]),
+		base64.StdEncoding.EncodeToString(h.key[:]),
+	}, "$")
+}
+
+func (h remotePasswordHash) check(pw []byte) error {
+	key, err := deriveEncryptionKeyWithSalt(pw, &h.salt, kdfParams{Version: kdfScrypt, Scrypt: h.params})
+	if err != nil {
+		return err
+	}
+	if subtle.ConstantTimeCompare(key[:], h.key[:]) != 1 {
+		return errRemoteWrongPassword
+	}
+	return nil
+}
+
+// InitWithConfig creates a wallet for each of the configured remote signers.
+func (rwd *RemoteWalletDriver) InitWithConfig(cfg config.KMDConfig, log logging.Logger) error {
+	rwd.mu.Lock()
+	defer rwd.mu.Unlock()
+
+	rwd.log = log
+	rwd.wallets = make(map[string]*RemoteWallet)
+	for _, signer := range cfg.DriverConfig.RemoteWalletDriverConfig.Signers {
+		err := signer.Validate()
+		if err != nil {
+			return err
+		}
+		rw, err := makeRemoteWallet(signer)
+		if err != nil {
+			return err
+		}
+		rwd.wallets[rw.id] = rw
+	}
+	return nil
+}
+
+func makeRemoteWallet(signer config.RemoteSignerConfig) (*RemoteWallet, error) {
+	u, err := url.Parse(signer.URL)
+	if err != nil {
+		return nil, err
+	}
+	passwordHash, err := parseRemotePasswordHash(signer.PasswordHash)
+	if err != nil {
+		return nil, fmt.Errorf("remote signer %s: %w", signer.Name, err)
+	}
+
+	timeout := remoteDefaultTimeout
+	if signer.TimeoutSecs > 0 {
+		timeout = time.Duration(signer.TimeoutSecs) * time.Second
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	baseURL := strings.TrimSuffix(signer.URL, "/")
+	if u.Scheme == "unix" {
+		// requests are sent over the unix domain socket; the host part of the url is ignored.
+		socketPath := u.Path
+		transport.DialContext = func(ctx context.Context, _, _ string) (net.Conn, error) {
+			var dialer net.Dialer
+			return dialer.DialContext(ctx, "unix", socketPath)
+		}
+		baseURL = "http://unix"
+	}
+
+	return &RemoteWallet{
+		id:           pathToID(remoteWalletDriverName + "/" + signer.Name),
+		name:         signer.Name,
+		baseURL:      baseURL,
+		token:        signer.Token,
+		passwordHash: passwordHash,
+		client: &http.Client{
+			Transport: transport,
+			Timeout:   timeout,
+		},
+	}, nil
+}
+
+// ListWalletMetadatas returns all wallets supported by this driver.
+func (rwd *RemoteWalletDriver) ListWalletMetadatas() (metadatas []wallet.Metadata, err error) {
+	rwd.mu.Lock()
+	defer rwd.mu.Unlock()
+
+	for _, w := range rwd.wallets {
+		md, err := w.Metadata()
+		if err != nil {
+			return nil, err
+		}
+		metadatas = append(metadatas, md)
+	}
+
+	// Sort metadatas by ID
+	sort.Slice(metadatas, func(i, j int) bool {
+		return bytes.Compare(metadatas[i].ID, metadatas[j].ID) < 0
+	})
+	return metadatas, nil
+}
+
+// CreateWallet implements the Driver interface. Remote wallets are defined
+// by the kmd configuration, and can't be created.
+func (rwd *RemoteWalletDriver) CreateWallet(name []byte, id []byte, pw []byte, mdk crypto.MasterDerivationKey) error {
+	return errNotSupported
+}
+
+// RenameWallet implements the Driver interface.
+func (rwd *RemoteWalletDriver) RenameWallet(newName []byte, id []byte, pw []byte) error {
+	return errNotSupported
+}
+
+// RestoreWallet implements the Driver interface.
+func (rwd *RemoteWalletDriver) RestoreWallet(name []byte, id []byte, pw []byte, backup []byte) error {
+	return errNotSupported
+}
+
+// FetchWallet looks up a wallet by ID and returns it
+func (rwd *RemoteWalletDriver) FetchWallet(id []byte) (w wallet.Wallet, err error) {
+	rwd.mu.Lock()
+	defer rwd.mu.Unlock()
+
+	rw, ok := rwd.wallets[string(id)]
+	if !ok {
+		return nil, errWalletNotFound
+	}
+	return rw, nil
+}
+
+// Init implements the Wallet interface. It checks the password against the
+// configured password hash, and then caches a fast hash of it.
+func (rw *RemoteWallet) Init(pw []byte) error {
+	err := rw.passwordHash.check(pw)
+	if err != nil {
+		return err
+	}
+
+	rw.mu.Lock()
+	defer rw.mu.Unlock()
+	err = fillRandomBytes(rw.fastSalt[:])
+	if err != nil {
+		return err
+	}
+	rw.fastHash = fastHashWithSalt(pw, rw.fastSalt[:])
+	rw.fastHashed = true
+	return nil
+}
+
+// CheckPassword implements the Wallet interface.
+func (rw *RemoteWallet) CheckPassword(pw []byte) error {
+	rw.mu.Lock()
+	if rw.fastHashed {
+		pwhash := fastHashWithSalt(pw, rw.fastSalt[:])
+		rw.mu.Unlock()
+		if subtle.ConstantTimeCompare(pwhash[:], rw.fastHash[:]) == 1 {
+			return nil
+		}
+		return errRemoteWrongPassword
+	}
+	rw.mu.Unlock()
+
+	return rw.passwordHash.check(pw)
+}
+
+// ExportMasterDerivationKey implements the Wallet interface.
+func (rw *RemoteWallet) ExportMasterDerivationKey(pw []byte) (crypto.MasterDerivationKey, error) {
+	return crypto.MasterDerivationKey{}, errNotSupported
+}
+
+// Backup implements the Wallet interface. Keys of remote wallets never leave
+// their signer.
+func (rw *RemoteWallet) Backup(pw []byte) ([]byte, error) {
+	return nil, errNotSupported
+}
+
+// UpgradeKDF implements the Wallet interface. Remote wallets hold no
+// password-encrypted keys.
+func (rw *RemoteWallet) UpgradeKDF(pw []byte) error {
+	return errNotSupported
+}
+
+// Metadata implements the Wallet interface.
+func (rw *RemoteWallet) Metadata() (wallet.Metadata, error) {
+	return wallet.Metadata{
+		ID:                    []byte(rw.id),
+		Name:                  []byte(rw.name),
+		DriverName:            remoteWalletDriverName,
+		DriverVersion:         remoteWalletDriverVersion,
+		SupportedTransactions: remoteWalletSupportedTxs,
+	}, nil
+}
+
+// ListKeys implements the Wallet interface.
+func (rw *RemoteWallet) ListKeys() ([]crypto.Digest, error) {
+	var response remoteKeysResponse
+	err := rw.call(http.MethodGet, remoteSignerKeysPath, nil, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([]crypto.Digest, len(response.Keys))
+	for i, key := range response.Keys {
+		if len(key) != len(keys[i]) {
+			return nil, fmt.Errorf("remote signer %s returned a key of %d bytes", rw.name, len(key))
+		}
+		copy(keys[i][:], key)
+	}
+	return keys, nil
+}
+
+// ImportKey implements the Wallet interface.
+func (rw *RemoteWallet) ImportKey(sk crypto.PrivateKey) (crypto.Digest, error) {
+	return crypto.Digest{}, errNotSupported
+}
+
+// ExportKey implements the Wallet interface.
+func (rw *RemoteWallet) ExportKey(pk crypto.Digest, pw []byte) (crypto.PrivateKey, error) {
+	return crypto.PrivateKey{}, errNotSupported
+}
+
+// GenerateKey implements the Wallet interface.
+func (rw *RemoteWallet) GenerateKey(displayMnemonic bool) (crypto.Digest, error) {
+	return crypto.Digest{}, errNotSupported
+}
+
+// DeleteKey implements the Wallet interface.
+func (rw *RemoteWallet) DeleteKey(pk crypto.Digest, pw []byte) error {
+	return errNotSupported
+}
+
+// ImportMultisigAddr implements the Wallet interface.
+func (rw *RemoteWallet) ImportMultisigAddr(version, threshold uint8, pks []crypto.PublicKey) (crypto.Digest, error) {
+	return crypto.Digest{}, errNotSupported
+}
+
+// LookupMultisigPreimage implements the Wallet interface.
+func (rw *RemoteWallet) LookupMultisigPreimage(crypto.Digest) (version, threshold uint8, pks []crypto.PublicKey, err error) {
+	return 0, 0, nil, errNotSupported
+}
+
+// ListMultisigAddrs implements the Wallet interface.
+func (rw *RemoteWallet) ListMultisigAddrs() (addrs []crypto.Digest, err error) {
+	return nil, nil
+}
+
+// DeleteMultisigAddr implements the Wallet interface.
+func (rw *RemoteWallet) DeleteMultisigAddr(addr crypto.Digest, pw []byte) error {
+	return errNotSupported
+}
+
+// SignTransaction implements the Wallet interface. When no key is given, the
+// transaction is signed with the key of its sender.
+func (rw *RemoteWallet) SignTransaction(tx transactions.Transaction, pk crypto.PublicKey, pw []byte) ([]byte, error) {
+	if (pk == crypto.PublicKey{}) {
+		pk = crypto.PublicKey(tx.Src())
+	}
+
+	sig, err := rw.sign(pk, tx, protocol.Encode(&tx), pw)
+	if err != nil {
+		return nil, err
+	}
+
+	stxn := transactions.SignedTxn{
+		Txn: tx,
+		Sig: sig,
+	}
+
+	// Set the AuthAddr if the key we signed with doesn't match the txn sender
+	if basics.Address(pk) != tx.Sender {
+		stxn.AuthAddr = basics.Address(pk)
+	}
+
+	return protocol.Encode(&stxn), nil
+}
+
+// SignTransactionGroup implements the Wallet interface.
+func (rw *RemoteWallet) SignTransactionGroup(txs []transactions.Transaction, pks []crypto.PublicKey, pw []byte) ([][]byte, error) {
+	err := wallet.CheckTxGroup(txs, pks)
+	if err != nil {
+		return nil, err
+	}
+
+	stxs := make([][]byte, len(txs))
+	for i, tx := range txs {
+		var pk crypto.PublicKey
+		if len(pks) != 0 {
+			pk = pks[i]
+		}
+		stxs[i], err = rw.SignTransaction(tx, pk, pw)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return stxs, nil
+}
+
+// SignProgram implements the Wallet interface.
+func (rw *RemoteWallet) SignProgram(data []byte, src crypto.Digest, pw []byte) ([]byte, error) {
+	progb := logic.Program(data)
+	sig, err := rw.sign(crypto.PublicKey(src), &progb, nil, pw)
+	if err != nil {
+		return nil, err
+	}
+	return sig[:], nil
+}
+
+// SignData implements the Wallet interface.
+func (rw *RemoteWallet) SignData(data wallet.DomainData, src crypto.Digest, pw []byte) ([]byte, error) {
+	sig, err := rw.sign(crypto.PublicKey(src), data, nil, pw)
+	if err != nil {
+		return nil, err
+	}
+	return sig[:], nil
+}
+
+// MultisigSignTransaction implements the Wallet interface. Remote wallets
+// don't store multisig preimages, so the partial multisig is required.
+func (rw *RemoteWallet) MultisigSignTransaction(tx transactions.Transaction, pk crypto.PublicKey, partial crypto.MultisigSig, pw []byte, signer crypto.Digest) (crypto.MultisigSig, error) {
+	addr, err := rw.checkMultisigPartial(pk, partial)
+	if err != nil {
+		return partial, err
+	}
+
+	// Check that the multisig address equals to either sender or signer
+	if addr != crypto.Digest(tx.Src()) && addr != signer {
+		return partial, errMsigWrongAddr
+	}
+
+	sig, err := rw.sign(pk, tx, protocol.Encode(&tx), pw)
+	if err != nil {
+		return partial, err
+	}
+	return setMultisigSubsig(partial, pk, sig), nil
+}
+
+// MultisigSignProgram implements the Wallet interface. Remote wallets
+// don't store multisig preimages, so the partial multisig is required.
+func (rw *RemoteWallet) MultisigSignProgram(data []byte, src crypto.Digest, pk crypto.PublicKey, partial crypto.MultisigSig, pw []byte) (crypto.MultisigSig, error) {
+	addr, err := rw.checkMultisigPartial(pk, partial)
+	if err != nil {
+		return partial, err
+	}
+
+	if addr != src {
+		return partial, errMsigWrongAddr
+	}
+
+	progb := logic.Program(data)
+	sig, err := rw.sign(pk, &progb, nil, pw)
+	if err != nil {
+		return partial, err
+	}
+	return setMultisigSubsig(partial, pk, sig), nil
+}
+
+// checkMultisigPartial ensures that pk is one of the keys of the given
+// partial multisig, and returns the multisig address.
+func (rw *RemoteWallet) checkMultisigPartial(pk crypto.PublicKey, partial crypto.MultisigSig) (crypto.Digest, error) {
+	if partial.Version == 0 && partial.Threshold == 0 && len(partial.Subsigs) == 0 {
+		return crypto.Digest{}, errMsigDataNotFound
+	}
+
+	addr, err := crypto.MultisigAddrGenWithSubsigs(partial.Version, partial.Threshold, partial.Subsigs)
+	if err != nil {
+		return crypto.Digest{}, err
+	}
+
+	for _, subsig := range partial.Subsigs {
+		if subsig.Key == pk {
+			return addr, nil
+		}
+	}
+	return crypto.Digest{}, errMsigWrongKey
+}
+
+func setMultisigSubsig(partial crypto.MultisigSig, pk crypto.PublicKey, sig crypto.Signature) crypto.MultisigSig {
+	// copy the subsigs, so the caller's partial multisig isn't modified
+	subsigs := make([]crypto.MultisigSubsig, len(partial.Subsigs))
+	copy(subsigs, partial.Subsigs)
+	for i := range subsigs {
+		if subsigs[i].Key == pk {
+			subsigs[i].Sig = sig
+		}
+	}
+	partial.Subsigs = subsigs
+	return partial
+}
+
+// sign checks the wallet password, asks the remote signer to sign the given
+// message with the key pk, and verifies the returned signature.
+func (rw *RemoteWallet) sign(pk crypto.PublicKey, message crypto.Hashable, encodedTxn []byte, pw []byte) (crypto.Signature, error) {
+	err := rw.CheckPassword(pw)
+	if err != nil {
+		return crypto.Signature{}, err
+	}
+
+	request := remoteSignRequest{
+		PublicKey:   pk[:],
+		Message:     crypto.HashRep(message),
+		Transaction: encodedTxn,
+	}
+	var response remoteSignResponse
+	err = rw.call(http.MethodPost, remoteSignerSignPath, &request, &response)
+	if err != nil {
+		return crypto.Signature{}, err
+	}
+
+	var sig crypto.Signature
+	if len(response.Signature) != len(sig) {
+		return crypto.Signature{}, fmt.Errorf("remote signer %s returned a signature of %d bytes", rw.name, len(response.Signature))
+	}
+	copy(sig[:], response.Signature)
+	if !crypto.SignatureVerifier(pk).Verify(message, sig) {
+		return crypto.Signature{}, errRemoteSignature
+	}
+	return sig, nil
+}
+
+// call sends a request to the remote signer, decoding its json response into out.
+func (rw *RemoteWallet) call(method string, path string, in interface{}, out interface{}) error {
+	var body io.Reader
+	if in != nil {
+		encoded, err := json.Marshal(in)
+		if err != nil {
+			return err
+		}
+		body = bytes.NewReader(encoded)
+	}
+
+	req, err := http.NewRequest(method, rw.baseURL+path, body)
+	if err != nil {
+		return err
+	}
+	if in != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if rw.token != "" {
+		req.Header.Set("Authorization", "Bearer "+rw.token)
+	}
+
+	resp, err := rw.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("%w %s : %v", errRemoteSignerUnavailable, rw.name, err)
+	}
+	defer resp.Body.Close()
+
+	decoder := json.NewDecoder(io.LimitReader(resp.Body, remoteMaxResponseBytes))
+	if resp.StatusCode != http.StatusOK {
+		var errResponse remoteErrorResponse
+		if decoder.Decode(&errResponse) != nil || errResponse.Error == "" {
+			errResponse.Error = resp.Status
+		}
+		if resp.StatusCode == http.StatusNotFound && path == remoteSignerSignPath {
+			return errKeyNotFound
+		}
+		return fmt.Errorf("remote signer %s failed : %s", rw.name, errResponse.Error)
+	}
+	return decoder.Decode(out)
+}
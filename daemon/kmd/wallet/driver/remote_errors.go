@@ -0,0 +1,26 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package driver
+
+import (
+	"fmt"
+)
+
+var errRemoteSignerUnavailable = fmt.Errorf("unable to reach remote signer")
+var errRemoteSignature = fmt.Errorf("remote signer returned an invalid signature")
+var errRemotePasswordHash = fmt.Errorf("malformed remote signer password hash")
+var errRemoteWrongPassword = fmt.Errorf("wrong password for remote signer wallet")
@@ -0,0 +1,363 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package driver
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/daemon/kmd/config"
+	"github.com/algorand/go-algorand/daemon/kmd/wallet"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/data/transactions/logic"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+const mockSignerToken = "mock-signer-token"
+
+var mockWalletPassword = []byte("mock-wallet-password")
+
+// mockWalletPasswordHash hashes mockWalletPassword with cheap scrypt parameters
+func mockWalletPasswordHash(t *testing.T) string {
+	h, err := makeRemotePasswordHash(mockWalletPassword, config.ScryptParams{ScryptN: 1024, ScryptR: 1, ScryptP: 1})
+	require.NoError(t, err)
+	return h.String()
+}
+
+// mockSigner is an in-process remote signer holding its keys in memory
+type mockSigner struct {
+	keys    map[crypto.PublicKey]*crypto.SignatureSecrets
+	corrupt atomic.Bool
+}
+
+func makeMockSigner(n int) *mockSigner {
+	ms := &mockSigner{keys: make(map[crypto.PublicKey]*crypto.SignatureSecrets)}
+	for i := 0; i < n; i++ {
+		var seed crypto.Seed
+		crypto.RandBytes(seed[:])
+		secrets := crypto.GenerateSignatureSecrets(seed)
+		ms.keys[secrets.SignatureVerifier] = secrets
+	}
+	return ms
+}
+
+func (ms *mockSigner) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Header.Get("Authorization") != "Bearer "+mockSignerToken {
+		w.WriteHeader(http.StatusUnauthorized)
+		json.NewEncoder(w).Encode(remoteErrorResponse{Error: "bad token"})
+		return
+	}
+
+	switch r.URL.Path {
+	case remoteSignerKeysPath:
+		var response remoteKeysResponse
+		for pk := range ms.keys {
+			response.Keys = append(response.Keys, append([]byte{}, pk[:]...))
+		}
+		json.NewEncoder(w).Encode(response)
+	case remoteSignerSignPath:
+		var request remoteSignRequest
+		err := json.NewDecoder(r.Body).Decode(&request)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		var pk crypto.PublicKey
+		copy(pk[:], request.PublicKey)
+		secrets, ok := ms.keys[pk]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			json.NewEncoder(w).Encode(remoteErrorResponse{Error: "unknown key"})
+			return
+		}
+		sig := secrets.SignBytes(request.Message)
+		if ms.corrupt.Load() {
+			sig[0]++
+		}
+		json.NewEncoder(w).Encode(remoteSignResponse{Signature: sig[:]})
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func (ms *mockSigner) pks() (pks []crypto.PublicKey) {
+	for pk := range ms.keys {
+		pks = append(pks, pk)
+	}
+	return pks
+}
+
+func makeMockRemoteWallet(t *testing.T, ms *mockSigner) *RemoteWallet {
+	server := httptest.NewServer(ms)
+	t.Cleanup(server.Close)
+
+	var cfg config.KMDConfig
+	cfg.DriverConfig.RemoteWalletDriverConfig.Signers = []config.RemoteSignerConfig{{
+		Name:         "mock",
+		URL:          server.URL,
+		Token:        mockSignerToken,
+		PasswordHash: mockWalletPasswordHash(t),
+	}}
+	require.NoError(t, cfg.Validate())
+
+	var rwd RemoteWalletDriver
+	require.NoError(t, rwd.InitWithConfig(cfg, logging.TestingLog(t)))
+
+	mds, err := rwd.ListWalletMetadatas()
+	require.NoError(t, err)
+	require.Len(t, mds, 1)
+	require.Equal(t, "mock", string(mds[0].Name))
+
+	w, err := rwd.FetchWallet(mds[0].ID)
+	require.NoError(t, err)
+	return w.(*RemoteWallet)
+}
+
+func TestRemoteWalletListKeys(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	ms := makeMockSigner(3)
+	rw := makeMockRemoteWallet(t, ms)
+
+	keys, err := rw.ListKeys()
+	require.NoError(t, err)
+	require.Len(t, keys, 3)
+	for _, key := range keys {
+		require.Contains(t, ms.keys, crypto.PublicKey(key))
+	}
+
+	rw.token = "wrong"
+	_, err = rw.ListKeys()
+	require.ErrorContains(t, err, "bad token")
+}
+
+func TestRemoteWalletSignTransaction(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	ms := makeMockSigner(2)
+	rw := makeMockRemoteWallet(t, ms)
+	pks := ms.pks()
+
+	tx := transactions.Transaction{
+		Type: protocol.PaymentTx,
+		Header: transactions.Header{
+			Sender: basics.Address(pks[0]),
+			Fee:    basics.MicroAlgos{Raw: 1000},
+		},
+	}
+
+	// sign with the sender key
+	encoded, err := rw.SignTransaction(tx, crypto.PublicKey{}, mockWalletPassword)
+	require.NoError(t, err)
+	var stxn transactions.SignedTxn
+	require.NoError(t, protocol.Decode(encoded, &stxn))
+	require.Equal(t, tx, stxn.Txn)
+	require.True(t, pks[0].Verify(tx, stxn.Sig))
+	require.True(t, stxn.AuthAddr.IsZero())
+
+	// sign with another key, as for a rekeyed sender
+	encoded, err = rw.SignTransaction(tx, pks[1], mockWalletPassword)
+	require.NoError(t, err)
+	stxn = transactions.SignedTxn{}
+	require.NoError(t, protocol.Decode(encoded, &stxn))
+	require.True(t, pks[1].Verify(tx, stxn.Sig))
+	require.Equal(t, basics.Address(pks[1]), stxn.AuthAddr)
+
+	// unknown key
+	var unknown crypto.PublicKey
+	crypto.RandBytes(unknown[:])
+	_, err = rw.SignTransaction(tx, unknown, mockWalletPassword)
+	require.ErrorIs(t, err, errKeyNotFound)
+
+	// a signer returning bad signatures is detected
+	ms.corrupt.Store(true)
+	_, err = rw.SignTransaction(tx, crypto.PublicKey{}, mockWalletPassword)
+	require.ErrorIs(t, err, errRemoteSignature)
+}
+
+func TestRemoteWalletSignProgram(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	ms := makeMockSigner(1)
+	rw := makeMockRemoteWallet(t, ms)
+	pk := ms.pks()[0]
+
+	program := []byte{0x06, 0x81, 0x01}
+	sigBytes, err := rw.SignProgram(program, crypto.Digest(pk), mockWalletPassword)
+	require.NoError(t, err)
+	var sig crypto.Signature
+	copy(sig[:], sigBytes)
+	require.True(t, pk.Verify(logic.Program(program), sig))
+}
+
+func TestRemoteWalletSignTransactionGroup(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	ms := makeMockSigner(2)
+	rw := makeMockRemoteWallet(t, ms)
+	pks := ms.pks()
+
+	var group crypto.Digest
+	crypto.RandBytes(group[:])
+	var txs []transactions.Transaction
+	for _, pk := range pks {
+		txs = append(txs, transactions.Transaction{
+			Type: protocol.PaymentTx,
+			Header: transactions.Header{
+				Sender: basics.Address(pk),
+				Fee:    basics.MicroAlgos{Raw: 1000},
+				Group:  group,
+			},
+		})
+	}
+
+	encoded, err := rw.SignTransactionGroup(txs, nil, mockWalletPassword)
+	require.NoError(t, err)
+	require.Len(t, encoded, len(txs))
+	for i := range encoded {
+		var stxn transactions.SignedTxn
+		require.NoError(t, protocol.Decode(encoded[i], &stxn))
+		require.True(t, pks[i].Verify(txs[i], stxn.Sig))
+	}
+
+	_, err = rw.SignTransactionGroup(txs, pks[:1], mockWalletPassword)
+	require.ErrorIs(t, err, wallet.ErrTxGroupKeysMismatch)
+
+	txs[1].Group = crypto.Digest{}
+	_, err = rw.SignTransactionGroup(txs, nil, mockWalletPassword)
+	require.ErrorIs(t, err, wallet.ErrTxGroupMismatch)
+}
+
+func TestRemoteWalletSignData(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	ms := makeMockSigner(1)
+	rw := makeMockRemoteWallet(t, ms)
+	pk := ms.pks()[0]
+
+	data, err := wallet.MakeDomainData("example.com", []byte("hello"))
+	require.NoError(t, err)
+	sigBytes, err := rw.SignData(data, crypto.Digest(pk), mockWalletPassword)
+	require.NoError(t, err)
+	var sig crypto.Signature
+	copy(sig[:], sigBytes)
+	require.True(t, pk.Verify(data, sig))
+	require.False(t, pk.Verify(logic.Program(data.Data), sig))
+}
+
+func TestRemoteWalletMultisigSignTransaction(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	ms := makeMockSigner(2)
+	rw := makeMockRemoteWallet(t, ms)
+	pks := ms.pks()
+
+	var other crypto.PublicKey
+	crypto.RandBytes(other[:])
+	msigPks := []crypto.PublicKey{pks[0], pks[1], other}
+	addr, err := crypto.MultisigAddrGen(1, 2, msigPks)
+	require.NoError(t, err)
+
+	tx := transactions.Transaction{
+		Type: protocol.PaymentTx,
+		Header: transactions.Header{
+			Sender: basics.Address(addr),
+			Fee:    basics.MicroAlgos{Raw: 1000},
+		},
+	}
+
+	// without a partial multisig there is no preimage to sign for
+	_, err = rw.MultisigSignTransaction(tx, pks[0], crypto.MultisigSig{}, mockWalletPassword, crypto.Digest{})
+	require.ErrorIs(t, err, errMsigDataNotFound)
+
+	partial := crypto.MultisigSig{Version: 1, Threshold: 2}
+	for _, pk := range msigPks {
+		partial.Subsigs = append(partial.Subsigs, crypto.MultisigSubsig{Key: pk})
+	}
+
+	// a key outside of the multisig is rejected
+	_, err = rw.MultisigSignTransaction(tx, pks[0], crypto.MultisigSig{Version: 1, Threshold: 1, Subsigs: partial.Subsigs[2:]}, mockWalletPassword, crypto.Digest{})
+	require.ErrorIs(t, err, errMsigWrongKey)
+
+	for _, pk := range pks {
+		partial, err = rw.MultisigSignTransaction(tx, pk, partial, mockWalletPassword, crypto.Digest{})
+		require.NoError(t, err)
+	}
+	require.NoError(t, crypto.MultisigVerify(tx, addr, partial))
+}
+
+func TestRemoteWalletPassword(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	ms := makeMockSigner(1)
+	rw := makeMockRemoteWallet(t, ms)
+	pk := ms.pks()[0]
+	wrongPassword := []byte("wrong-password")
+
+	// the scrypt hash is checked until the wallet is initialized
+	require.ErrorIs(t, rw.CheckPassword(wrongPassword), errRemoteWrongPassword)
+	require.NoError(t, rw.CheckPassword(mockWalletPassword))
+	require.ErrorIs(t, rw.Init(wrongPassword), errRemoteWrongPassword)
+	require.NoError(t, rw.Init(mockWalletPassword))
+
+	// and the fast hash afterwards
+	require.True(t, rw.fastHashed)
+	require.ErrorIs(t, rw.CheckPassword(wrongPassword), errRemoteWrongPassword)
+	require.NoError(t, rw.CheckPassword(mockWalletPassword))
+
+	// signing requests never reach the signer with a wrong password
+	_, err := rw.SignProgram([]byte{1}, crypto.Digest(pk), wrongPassword)
+	require.ErrorIs(t, err, errRemoteWrongPassword)
+	_, err = rw.SignProgram([]byte{1}, crypto.Digest(pk), mockWalletPassword)
+	require.NoError(t, err)
+
+	// the password hash round trips through its configuration encoding
+	encoded := mockWalletPasswordHash(t)
+	h, err := parseRemotePasswordHash(encoded)
+	require.NoError(t, err)
+	require.Equal(t, encoded, h.String())
+	require.NoError(t, h.check(mockWalletPassword))
+
+	for _, malformed := range []string{"", "argon2$1024$1$1$AA==$AA==", "scrypt$1024$1$1", "scrypt$0$1$1$AA==$AA==", encoded + "A"} {
+		_, err = parseRemotePasswordHash(malformed)
+		require.ErrorIs(t, err, errRemotePasswordHash, malformed)
+	}
+
+	// signers without a password hash are rejected by the configuration
+	var cfg config.KMDConfig
+	cfg.DriverConfig.RemoteWalletDriverConfig.Signers = []config.RemoteSignerConfig{{
+		Name: "mock",
+		URL:  "https://signer.example.com",
+	}}
+	require.ErrorIs(t, cfg.Validate(), config.ErrRemoteSignerPasswordHashMissing)
+}
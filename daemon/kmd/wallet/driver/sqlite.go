@@ -138,7 +138,7 @@ func (swd *SQLiteWalletDriver) InitWithConfig(cfg config.KMDConfig, log logging.
 	swd.globalCfg = cfg
 	swd.sqliteCfg = cfg.DriverConfig.SQLiteWalletDriverConfig
 
-	// Make sure the scrypt params are reasonable
+	// Make sure the kdf params are reasonable
 	if !swd.sqliteCfg.UnsafeScrypt {
 		if swd.sqliteCfg.ScryptParams.ScryptN < minScryptN {
 			return fmt.Errorf("slow scrypt N must be at least %d", minScryptN)
@@ -149,6 +149,36 @@ func (swd *SQLiteWalletDriver) InitWithConfig(cfg config.KMDConfig, log logging.
 		if swd.sqliteCfg.ScryptParams.ScryptP < minScryptP {
 			return fmt.Errorf("slow scrypt P must be at least %d", minScryptP)
 		}
+		if swd.sqliteCfg.KDF == config.KDFArgon2id && swd.sqliteCfg.Argon2Params.Argon2MemoryKiB < minArgon2Mem {
+			return fmt.Errorf("argon2id memory must be at least %d KiB", minArgon2Mem)
+		}
+	}
+	// Make sure the wallets we create can be opened again
+	if swd.sqliteCfg.ScryptParams.ScryptN > maxScryptN {
+		return fmt.Errorf("scrypt N must be at most %d", maxScryptN)
+	}
+	if swd.sqliteCfg.ScryptParams.ScryptR > maxScryptR {
+		return fmt.Errorf("scrypt R must be at most %d", maxScryptR)
+	}
+	if swd.sqliteCfg.ScryptParams.ScryptP > maxScryptP {
+		return fmt.Errorf("scrypt P must be at most %d", maxScryptP)
+	}
+	if swd.sqliteCfg.KDF == config.KDFArgon2id {
+		if swd.sqliteCfg.Argon2Params.Argon2Time > maxArgon2Time {
+			return fmt.Errorf("argon2id time must be at most %d", maxArgon2Time)
+		}
+		if swd.sqliteCfg.Argon2Params.Argon2MemoryKiB > maxArgon2Mem {
+			return fmt.Errorf("argon2id memory must be at most %d KiB", maxArgon2Mem)
+		}
+		if swd.sqliteCfg.Argon2Params.Argon2Threads > maxArgon2Threads {
+			return fmt.Errorf("argon2id threads must be at most %d", maxArgon2Threads)
+		}
+		if swd.sqliteCfg.Argon2Params.Argon2Time < minArgon2Time {
+			return fmt.Errorf("argon2id time must be at least %d", minArgon2Time)
+		}
+		if swd.sqliteCfg.Argon2Params.Argon2Threads < minArgon2Threads {
+			return fmt.Errorf("argon2id threads must be at least %d", minArgon2Threads)
+		}
 	}
 
 	// Make the wallets directory if it doesn't already exist
@@ -458,7 +488,7 @@ func (swd *SQLiteWalletDriver) CreateWallet(name []byte, id []byte, pw []byte, m
 
 	// Encrypt the master encryption password using the user's password (which
 	// may be blank)
-	encryptedMEPBlob, err := encryptBlobWithPasswordBlankOK(masterKey[:], PTMasterKey, pw, &swd.sqliteCfg.ScryptParams)
+	encryptedMEPBlob, err := encryptBlobWithPasswordBlankOK(masterKey[:], PTMasterKey, pw, makeKDFParams(swd.sqliteCfg))
 	if err != nil {
 		return err
 	}
@@ -690,6 +720,37 @@ func (sw *SQLiteWallet) CheckPassword(pw []byte) error {
 	return err
 }
 
+// UpgradeKDF re-encrypts the master encryption key under the password, using
+// the key derivation function and parameters currently configured. The rest
+// of the wallet is encrypted under the master encryption key, so it is left
+// untouched.
+func (sw *SQLiteWallet) UpgradeKDF(pw []byte) error {
+	masterEncryptionKey, err := sw.decryptAndGetMasterKey(pw)
+	if err != nil {
+		return err
+	}
+
+	encryptedMEPBlob, err := encryptBlobWithPasswordBlankOK(masterEncryptionKey, PTMasterKey, pw, makeKDFParams(sw.cfg))
+	if err != nil {
+		return err
+	}
+
+	// Connect to the database
+	db, err := sqlx.Connect("sqlite3", dbConnectionURL(sw.dbPath))
+	if err != nil {
+		return errDatabaseConnect
+	}
+	defer db.Close()
+
+	// Swap in the re-encrypted master encryption key
+	_, err = db.Exec("UPDATE metadata SET mep_encrypted=?", encryptedMEPBlob)
+	if err != nil {
+		return errDatabase
+	}
+
+	return nil
+}
+
 // ListKeys lists all the addresses in the wallet
 func (sw *SQLiteWallet) ListKeys() (addrs []crypto.Digest, err error) {
 	// Connect to the database
@@ -1141,6 +1202,51 @@ func (sw *SQLiteWallet) SignTransaction(tx transactions.Transaction, pk crypto.P
 	return
 }
 
+// SignTransactionGroup signs each of the passed transactions, which must share
+// the same group ID, with the private key whose public key is provided for it,
+// or with the key of its sender when the provided public key is zero or no
+// public keys are provided
+func (sw *SQLiteWallet) SignTransactionGroup(txs []transactions.Transaction, pks []crypto.PublicKey, pw []byte) (stxs [][]byte, err error) {
+	err = wallet.CheckTxGroup(txs, pks)
+	if err != nil {
+		return
+	}
+
+	// Check the password once for the whole group
+	err = sw.CheckPassword(pw)
+	if err != nil {
+		return
+	}
+
+	secrets := make(map[crypto.Digest]*crypto.SignatureSecrets)
+	stxs = make([][]byte, len(txs))
+	for i, tx := range txs {
+		addr := crypto.Digest(tx.Src())
+		if len(pks) != 0 && (pks[i] != crypto.PublicKey{}) {
+			addr = crypto.Digest(pks[i])
+		}
+
+		// Fetch the required key, unless it was already used for the group
+		s, ok := secrets[addr]
+		if !ok {
+			var sk crypto.PrivateKey
+			sk, err = sw.fetchSecretKey(addr)
+			if err != nil {
+				return nil, err
+			}
+			s, err = crypto.SecretKeyToSignatureSecrets(sk)
+			if err != nil {
+				return nil, errSKToPK
+			}
+			secrets[addr] = s
+		}
+
+		stxn := tx.Sign(s)
+		stxs[i] = protocol.Encode(&stxn)
+	}
+	return
+}
+
 // SignProgram signs the passed data for the src address
 func (sw *SQLiteWallet) SignProgram(data []byte, src crypto.Digest, pw []byte) (stx []byte, err error) {
 	// Check the password
@@ -1169,6 +1275,32 @@ func (sw *SQLiteWallet) SignProgram(data []byte, src crypto.Digest, pw []byte) (
 	return
 }
 
+// SignData signs the passed domain separated data for the src address
+func (sw *SQLiteWallet) SignData(data wallet.DomainData, src crypto.Digest, pw []byte) (sig []byte, err error) {
+	// Check the password
+	err = sw.CheckPassword(pw)
+	if err != nil {
+		return
+	}
+
+	// Fetch the required key
+	sk, err := sw.fetchSecretKey(src)
+	if err != nil {
+		return
+	}
+
+	// Generate the signature secrets
+	secrets, err := crypto.SecretKeyToSignatureSecrets(sk)
+	if err != nil {
+		err = errSKToPK
+		return
+	}
+
+	signature := secrets.Sign(data)
+	sig = signature[:]
+	return
+}
+
 // MultisigSignTransaction starts a multisig signature or adds a signature to a
 // partially signed multisig transaction signature of the passed transaction
 // using the key
@@ -0,0 +1,299 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package driver
+
+import (
+	"database/sql"
+	"os"
+
+	"github.com/jmoiron/sqlx"
+
+	"github.com/algorand/go-algorand/crypto"
+)
+
+// sqliteWalletBackupVersion is the version of the backups produced by Backup.
+// RestoreWallet refuses backups with a different version.
+const sqliteWalletBackupVersion = 1
+
+// sqliteWalletBackupFile is the outer, unencrypted, layer of a wallet backup.
+// It lets us check that we can make sense of a backup before spending time
+// on deriving its encryption key.
+type sqliteWalletBackupFile struct {
+	DriverName string `codec:"driver_name"`
+	Version    uint32 `codec:"version"`
+	// Backup is an encrypted blob of the encoded sqliteWalletBackup, using the
+	// wallet password and the wallet scrypt parameters
+	Backup []byte `codec:"backup"`
+}
+
+// sqliteWalletBackup holds everything needed to restore a sqlite wallet
+type sqliteWalletBackup struct {
+	ID                  []byte                       `codec:"id"`
+	Name                []byte                       `codec:"name"`
+	MasterDerivationKey crypto.MasterDerivationKey   `codec:"mdk"`
+	MaxKeyIdx           uint64                       `codec:"max_key_idx"`
+	Keys                []sqliteWalletBackupKey      `codec:"keys"`
+	MultisigAddrs       []sqliteWalletBackupMultisig `codec:"msig_addrs"`
+}
+
+// sqliteWalletBackupKey is a key of the wallet. KeyIdx is the index the key
+// was derived with from the master derivation key, or 0 for imported keys.
+type sqliteWalletBackupKey struct {
+	SecretKey crypto.PrivateKey `codec:"sk"`
+	KeyIdx    uint64            `codec:"key_idx"`
+}
+
+// sqliteWalletBackupMultisig is the preimage of an imported multisig address
+type sqliteWalletBackupMultisig struct {
+	Version   uint8              `codec:"version"`
+	Threshold uint8              `codec:"threshold"`
+	PKs       []crypto.PublicKey `codec:"pks"`
+}
+
+// Backup exports all of the wallet keys, multisig preimages and metadata as
+// a single blob, encrypted with the wallet password
+func (sw *SQLiteWallet) Backup(pw []byte) (backup []byte, err error) {
+	// Check the password
+	err = sw.CheckPassword(pw)
+	if err != nil {
+		return
+	}
+
+	// Connect to the database
+	db, err := sqlx.Connect("sqlite3", dbConnectionURL(sw.dbPath))
+	if err != nil {
+		err = errDatabaseConnect
+		return
+	}
+	defer db.Close()
+
+	var wb sqliteWalletBackup
+	copy(wb.MasterDerivationKey[:], sw.masterDerivationKey)
+
+	var encryptedIdxBlob []byte
+	row := db.QueryRow("SELECT wallet_id, wallet_name, max_key_idx_encrypted FROM metadata LIMIT 1")
+	err = row.Scan(&wb.ID, &wb.Name, &encryptedIdxBlob)
+	if err != nil {
+		err = errDatabase
+		return
+	}
+	idxBlob, err := decryptBlobWithPassword(encryptedIdxBlob, PTMaxKeyIdx, sw.masterEncryptionKey)
+	if err != nil {
+		return
+	}
+	err = msgpackDecode(idxBlob, &wb.MaxKeyIdx)
+	if err != nil {
+		return
+	}
+
+	// Collect the keys
+	rows, err := db.Query("SELECT address, key_idx FROM keys")
+	if err != nil {
+		err = errDatabase
+		return
+	}
+	var addrs []crypto.Digest
+	var keyIdxs []uint64
+	for rows.Next() {
+		var addrBytes []byte
+		var keyIdx sql.NullInt64
+		err = rows.Scan(&addrBytes, &keyIdx)
+		if err != nil {
+			rows.Close()
+			err = errDatabase
+			return
+		}
+		var addr crypto.Digest
+		copy(addr[:], addrBytes)
+		addrs = append(addrs, addr)
+		keyIdxs = append(keyIdxs, uint64(keyIdx.Int64))
+	}
+	rows.Close()
+
+	for i, addr := range addrs {
+		var sk crypto.PrivateKey
+		sk, err = sw.fetchSecretKey(addr)
+		if err != nil {
+			return
+		}
+		wb.Keys = append(wb.Keys, sqliteWalletBackupKey{SecretKey: sk, KeyIdx: keyIdxs[i]})
+	}
+
+	// Collect the multisig preimages
+	rows, err = db.Query("SELECT version, threshold, pks FROM msig_addrs")
+	if err != nil {
+		err = errDatabase
+		return
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var version, threshold int
+		var pksBlob []byte
+		err = rows.Scan(&version, &threshold, &pksBlob)
+		if err != nil {
+			err = errDatabase
+			return
+		}
+		msig := sqliteWalletBackupMultisig{Version: uint8(version), Threshold: uint8(threshold)}
+		err = msgpackDecode(pksBlob, &msig.PKs)
+		if err != nil {
+			return
+		}
+		wb.MultisigAddrs = append(wb.MultisigAddrs, msig)
+	}
+
+	// Encrypt the backup with the wallet password
+	encryptedBackup, err := encryptBlobWithPasswordBlankOK(msgpackEncode(wb), PTWalletBackup, pw, makeKDFParams(sw.cfg))
+	if err != nil {
+		return
+	}
+
+	backupFile := sqliteWalletBackupFile{
+		DriverName: sqliteWalletDriverName,
+		Version:    sqliteWalletBackupVersion,
+		Backup:     encryptedBackup,
+	}
+	return msgpackEncode(backupFile), nil
+}
+
+// RestoreWallet creates a new wallet with the given name, id and password,
+// holding the keys and multisig preimages of the passed backup. The backup
+// must have been encrypted with the same password. If name is blank, the
+// name of the backed up wallet is used.
+func (swd *SQLiteWalletDriver) RestoreWallet(name []byte, id []byte, pw []byte, backup []byte) error {
+	var backupFile sqliteWalletBackupFile
+	err := msgpackDecode(backup, &backupFile)
+	if err != nil {
+		return err
+	}
+	if backupFile.DriverName != sqliteWalletDriverName {
+		return errBackupWrongDriver
+	}
+	if backupFile.Version != sqliteWalletBackupVersion {
+		return errBackupVersion
+	}
+
+	encodedBackup, err := decryptBlobWithPassword(backupFile.Backup, PTWalletBackup, pw)
+	if err != nil {
+		return err
+	}
+	var wb sqliteWalletBackup
+	err = msgpackDecode(encodedBackup, &wb)
+	if err != nil {
+		return err
+	}
+
+	if len(name) == 0 {
+		name = wb.Name
+	}
+
+	err = swd.CreateWallet(name, id, pw, wb.MasterDerivationKey)
+	if err != nil {
+		return err
+	}
+
+	w, err := swd.FetchWallet(id)
+	if err != nil {
+		return err
+	}
+	sw, ok := w.(*SQLiteWallet)
+	if !ok {
+		return errSQLiteWrongType
+	}
+
+	err = sw.Init(pw)
+	if err == nil {
+		err = sw.restoreBackup(&wb)
+	}
+	if err != nil {
+		// Don't leave a partially restored wallet behind
+		os.Remove(sw.dbPath)
+		return err
+	}
+	return nil
+}
+
+// restoreBackup inserts the keys and multisig preimages of the backup into
+// the freshly created wallet, in a single database transaction
+func (sw *SQLiteWallet) restoreBackup(wb *sqliteWalletBackup) (err error) {
+	// Connect to the database
+	db, err := sqlx.Connect("sqlite3", dbConnectionURL(sw.dbPath))
+	if err != nil {
+		return errDatabaseConnect
+	}
+	defer db.Close()
+
+	tx, err := db.Beginx()
+	if err != nil {
+		return errDatabase
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		}
+	}()
+
+	for _, key := range wb.Keys {
+		// Extract the seed from the secret key so that we don't trust the public part
+		seed, err := crypto.SecretKeyToSeed(key.SecretKey)
+		if err != nil {
+			return errSKToPK
+		}
+		sigSecrets := crypto.GenerateSignatureSecrets(seed)
+
+		skEncrypted, err := encryptBlobWithKey(msgpackEncode(sigSecrets.SK), PTSecretKey, sw.masterEncryptionKey)
+		if err != nil {
+			return err
+		}
+
+		addr := publicKeyToAddress(sigSecrets.SignatureVerifier)
+		keyIdx := sql.NullInt64{Int64: int64(key.KeyIdx), Valid: key.KeyIdx != 0}
+		_, err = tx.Exec("INSERT INTO keys (address, secret_key_encrypted, key_idx) VALUES(?, ?, ?)", addr[:], skEncrypted, keyIdx)
+		err = checkDBError(err)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, msig := range wb.MultisigAddrs {
+		addr, err := crypto.MultisigAddrGen(msig.Version, msig.Threshold, msig.PKs)
+		if err != nil {
+			return err
+		}
+		_, err = tx.Exec("INSERT INTO msig_addrs (address, version, threshold, pks) VALUES (?, ?, ?, ?)", addr[:], msig.Version, msig.Threshold, msgpackEncode(msig.PKs))
+		err = checkDBError(err)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Restore the max key index, so that we don't generate keys twice
+	encryptedIdxBlob, err := encryptBlobWithKey(msgpackEncode(wb.MaxKeyIdx), PTMaxKeyIdx, sw.masterEncryptionKey)
+	if err != nil {
+		return err
+	}
+	_, err = tx.Exec("UPDATE metadata SET max_key_idx_encrypted = ?", encryptedIdxBlob)
+	if err != nil {
+		return errDatabase
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return errDatabase
+	}
+	return nil
+}
@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"io"
 
+	"golang.org/x/crypto/argon2"
 	"golang.org/x/crypto/hkdf"
 	"golang.org/x/crypto/nacl/secretbox"
 	"golang.org/x/crypto/scrypt"
@@ -31,13 +32,22 @@ import (
 )
 
 const (
-	saltLen        = 32
-	nonceLen       = 24
-	masterKeyLen   = 32
-	minScryptN     = 32768
-	minScryptR     = 1
-	minScryptP     = 32
-	hkdfInfoFormat = "AlgorandDeterministicKey-%d"
+	saltLen          = 32
+	nonceLen         = 24
+	masterKeyLen     = 32
+	minScryptN       = 32768
+	minScryptR       = 1
+	minScryptP       = 32
+	minArgon2Time    = 1
+	minArgon2Mem     = 64 * 1024
+	minArgon2Threads = 1
+	maxScryptN       = 1 << 20
+	maxScryptR       = 8
+	maxScryptP       = 128
+	maxArgon2Time    = 64
+	maxArgon2Mem     = 1024 * 1024
+	maxArgon2Threads = 64
+	hkdfInfoFormat   = "AlgorandDeterministicKey-%d"
 )
 
 type plaintextType string
@@ -51,8 +61,39 @@ var (
 	PTMasterDerivationKey plaintextType = "master_derivation_key"
 	// PTMaxKeyIdx is the plaintext type for the maximum key index
 	PTMaxKeyIdx plaintextType = "max_key_idx"
+	// PTWalletBackup is the plaintext type for a wallet backup
+	PTWalletBackup plaintextType = "wallet_backup"
 )
 
+// kdfVersion identifies the function used to derive the key of an
+// encryptedDBBlob from a password
+type kdfVersion uint8
+
+const (
+	// kdfScrypt is the zero value, so that scrypt blobs keep the layout that
+	// older versions of kmd can read. Blobs predating kdfVersion only derive
+	// their key with scrypt if DoScrypt is set.
+	kdfScrypt kdfVersion = 0
+	// kdfArgon2id derives the key with Argon2id
+	kdfArgon2id kdfVersion = 1
+)
+
+// kdfParams selects the key derivation function applied to a password, along
+// with its parameters
+type kdfParams struct {
+	Version kdfVersion
+	Scrypt  config.ScryptParams
+	Argon2  config.Argon2Params
+}
+
+// makeKDFParams returns the kdfParams new blobs are encrypted with under cfg
+func makeKDFParams(cfg config.SQLiteWalletDriverConfig) *kdfParams {
+	if cfg.KDF == config.KDFArgon2id {
+		return &kdfParams{Version: kdfArgon2id, Argon2: cfg.Argon2Params}
+	}
+	return &kdfParams{Version: kdfScrypt, Scrypt: cfg.ScryptParams}
+}
+
 // typedPlaintext prevents us from confusing differently typed data encrypted
 // under the same key
 type typedPlaintext struct {
@@ -64,29 +105,75 @@ type typedPlaintext struct {
 // to decrypt a secretbox message
 type encryptedDBBlob struct {
 	config.ScryptParams
-	DoScrypt   bool           `codec:"do_scrypt"`
-	Ciphertext []byte         `codec:"ciphertext"`
-	Nonce      [nonceLen]byte `codec:"nonce"`
-	Salt       [saltLen]byte  `codec:"salt"`
+	DoScrypt   bool                `codec:"do_scrypt"`
+	KDF        kdfVersion          `codec:"kdf,omitempty"`
+	Argon2     config.Argon2Params `codec:"argon2id,omitempty"`
+	Ciphertext []byte              `codec:"ciphertext"`
+	Nonce      [nonceLen]byte      `codec:"nonce"`
+	Salt       [saltLen]byte       `codec:"salt"`
+}
+
+// derivesKey returns whether the key of the blob is derived from a password,
+// as opposed to being the password itself
+func (b encryptedDBBlob) derivesKey() bool {
+	return b.DoScrypt || b.KDF != kdfScrypt
+}
+
+// kdfParams returns the parameters the key of the blob was derived with
+func (b encryptedDBBlob) kdfParams() kdfParams {
+	return kdfParams{
+		Version: b.KDF,
+		Scrypt:  b.ScryptParams,
+		Argon2:  b.Argon2,
+	}
 }
 
-// deriveEncryptionKeyWithSalt uses scrypt to derive a key suitable for
-// secretbox, but requires that you pass it a salt along with the password
-func deriveEncryptionKeyWithSalt(password []byte, salt *[saltLen]byte, cfg config.ScryptParams) (*[masterKeyLen]byte, error) {
+// checkMaximums makes sure that deriving a key with the parameters does not
+// take more memory or time than any wallet we would create. The parameters of
+// a blob are read off the wallet database, so they cannot be trusted.
+func (p kdfParams) checkMaximums() error {
+	switch p.Version {
+	case kdfScrypt:
+		if p.Scrypt.ScryptN > maxScryptN || p.Scrypt.ScryptR > maxScryptR || p.Scrypt.ScryptP > maxScryptP {
+			return errKDFParams
+		}
+	case kdfArgon2id:
+		if p.Argon2.Argon2Time > maxArgon2Time || p.Argon2.Argon2MemoryKiB > maxArgon2Mem || p.Argon2.Argon2Threads > maxArgon2Threads {
+			return errKDFParams
+		}
+	}
+	return nil
+}
+
+// deriveEncryptionKeyWithSalt uses the key derivation function selected by
+// cfg to derive a key suitable for secretbox, but requires that you pass it a
+// salt along with the password
+func deriveEncryptionKeyWithSalt(password []byte, salt *[saltLen]byte, cfg kdfParams) (*[masterKeyLen]byte, error) {
 	var key [masterKeyLen]byte
 
 	// derive the encryption key from the password + salt
-	keySlice, err := scrypt.Key(password, salt[:], cfg.ScryptN, cfg.ScryptR, cfg.ScryptP, masterKeyLen)
-	if err != nil {
-		err = errDeriveKey
-		return nil, err
+	var keySlice []byte
+	switch cfg.Version {
+	case kdfScrypt:
+		var err error
+		keySlice, err = scrypt.Key(password, salt[:], cfg.Scrypt.ScryptN, cfg.Scrypt.ScryptR, cfg.Scrypt.ScryptP, masterKeyLen)
+		if err != nil {
+			return nil, errDeriveKey
+		}
+	case kdfArgon2id:
+		// argon2 panics rather than returning errors on bad parameters
+		if cfg.Argon2.Argon2Time == 0 || cfg.Argon2.Argon2Threads == 0 {
+			return nil, errDeriveKey
+		}
+		keySlice = argon2.IDKey(password, salt[:], cfg.Argon2.Argon2Time, cfg.Argon2.Argon2MemoryKiB, cfg.Argon2.Argon2Threads, masterKeyLen)
+	default:
+		return nil, errUnknownKDF
 	}
 
-	// scrypt.Key returns a slice; we need an array pointer
+	// the kdf returns a slice; we need an array pointer
 	n := copy(key[:], keySlice)
 	if n != masterKeyLen {
-		err = errDeriveKey
-		return nil, err
+		return nil, errDeriveKey
 	}
 
 	return &key, nil
@@ -104,7 +191,7 @@ func fillRandomBytes(out []byte) error {
 
 // deriveEncryptionKey derives a key from the password, returning the key with
 // the salt required to generate the same key again.
-func deriveEncryptionKey(password []byte, cfg config.ScryptParams) (*[masterKeyLen]byte, *[saltLen]byte, error) {
+func deriveEncryptionKey(password []byte, cfg kdfParams) (*[masterKeyLen]byte, *[saltLen]byte, error) {
 	var saltArr [saltLen]byte
 
 	// Generate salt
@@ -120,15 +207,15 @@ func deriveEncryptionKey(password []byte, cfg config.ScryptParams) (*[masterKeyL
 // encryptBlobWithKey takes plaintext and a key, encrypts the plaintext
 // using the key, and produces a blob that can be combined with the key
 // to produce the plaintext.  It's similar to encryptBlobWithPassword,
-// but does not apply scrypt to the input key.
+// but does not apply a key derivation function to the input key.
 func encryptBlobWithKey(plaintext []byte, ptType plaintextType, key []byte) ([]byte, error) {
 	return encryptBlobWithPasswordBlankOK(plaintext, ptType, key, nil)
 }
 
 // encryptBlobWithPasswordBlankOK accepts a password, and optionally derives a
 // key from it.  If cfg is nil, the password is assumed to be already a
-// cryptographic key, and no scrypt key derivation is applied.
-func encryptBlobWithPasswordBlankOK(plaintext []byte, ptType plaintextType, password []byte, cfg *config.ScryptParams) (blob []byte, err error) {
+// cryptographic key, and no key derivation is applied.
+func encryptBlobWithPasswordBlankOK(plaintext []byte, ptType plaintextType, password []byte, cfg *kdfParams) (blob []byte, err error) {
 	var nonceArr [nonceLen]byte
 
 	// Generate the key and salt
@@ -171,8 +258,14 @@ func encryptBlobWithPasswordBlankOK(plaintext []byte, ptType plaintextType, pass
 	}
 
 	if cfg != nil {
-		dbblob.ScryptParams = *cfg
-		dbblob.DoScrypt = true
+		switch cfg.Version {
+		case kdfScrypt:
+			dbblob.ScryptParams = cfg.Scrypt
+			dbblob.DoScrypt = true
+		default:
+			dbblob.KDF = cfg.Version
+			dbblob.Argon2 = cfg.Argon2
+		}
 		dbblob.Salt = *salt
 	} else {
 		dbblob.DoScrypt = false
@@ -193,8 +286,12 @@ func decryptBlobWithPassword(blob []byte, ptType plaintextType, password []byte)
 
 	// Derive the encryption key
 	var key *[masterKeyLen]byte
-	if dbblob.DoScrypt {
-		key, err = deriveEncryptionKeyWithSalt(password, &dbblob.Salt, dbblob.ScryptParams)
+	if dbblob.derivesKey() {
+		err = dbblob.kdfParams().checkMaximums()
+		if err != nil {
+			return
+		}
+		key, err = deriveEncryptionKeyWithSalt(password, &dbblob.Salt, dbblob.kdfParams())
 		if err != nil {
 			return
 		}
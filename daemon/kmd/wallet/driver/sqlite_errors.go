@@ -29,7 +29,9 @@ var errSKToSeed = fmt.Errorf("could not convert secret key to seed")
 var errTampering = fmt.Errorf("derived public key mismatch, something fishy is going on with this wallet")
 var errNoMnemonicUX = fmt.Errorf("sqlite wallet driver cannot display mnemonics")
 var errKeyExists = fmt.Errorf("key already exists in wallet")
-var errDeriveKey = fmt.Errorf("scrypt lib could not derive key from password")
+var errDeriveKey = fmt.Errorf("could not derive key from password")
+var errKDFParams = fmt.Errorf("key derivation parameters exceed the supported maximums. something fishy is going on with this wallet")
+var errUnknownKDF = fmt.Errorf("unknown key derivation function. wallet made by a newer version of kmd?")
 var errWrongDriver = fmt.Errorf("found database with wrong driver name in wallets dir")
 var errRandBytes = fmt.Errorf("error reading random bytes")
 var errTooManyKeys = fmt.Errorf("too many keys")
@@ -45,3 +47,5 @@ var errNameTooLong = fmt.Errorf("wallet name too long, must be <= %d bytes", sql
 var errIDTooLong = fmt.Errorf("wallet id too long, must be <= %d bytes", sqliteMaxWalletIDLen)
 var errMsigWrongAddr = fmt.Errorf("given multisig preimage hashes to neither Sender nor AuthAddr")
 var errMsigWrongKey = fmt.Errorf("given key is not a possible signer for this multisig")
+var errBackupWrongDriver = fmt.Errorf("wallet backup was not made by the sqlite wallet driver")
+var errBackupVersion = fmt.Errorf("unsupported wallet backup version")
@@ -0,0 +1,222 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package driver
+
+import (
+	"encoding/base64"
+	"fmt"
+	"testing"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/daemon/kmd/config"
+	"github.com/algorand/go-algorand/daemon/kmd/wallet"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+var testWalletPassword = []byte("test-wallet-password")
+
+// makeTestSQLiteDriver initializes a sqlite wallet driver over walletsDir,
+// encrypting new wallets with cheap parameters of the given kdf
+func makeTestSQLiteDriver(t *testing.T, walletsDir string, kdf string) *SQLiteWalletDriver {
+	var cfg config.KMDConfig
+	cfg.DataDir = walletsDir
+	cfg.DriverConfig.SQLiteWalletDriverConfig = config.SQLiteWalletDriverConfig{
+		WalletsDir:   walletsDir,
+		UnsafeScrypt: true,
+		ScryptParams: config.ScryptParams{ScryptN: 2, ScryptR: 1, ScryptP: 1},
+		KDF:          kdf,
+		Argon2Params: config.Argon2Params{Argon2Time: 1, Argon2MemoryKiB: 1024, Argon2Threads: 1},
+	}
+	swd := &SQLiteWalletDriver{}
+	require.NoError(t, swd.InitWithConfig(cfg, logging.TestingLog(t)))
+	return swd
+}
+
+// openTestSQLiteWallet fetches the wallet with the given id and unlocks it
+func openTestSQLiteWallet(t *testing.T, swd *SQLiteWalletDriver, id []byte, pw []byte) (wallet.Wallet, error) {
+	w, err := swd.FetchWallet(id)
+	require.NoError(t, err)
+	return w, w.Init(pw)
+}
+
+// readMasterKeyBlob reads the encrypted master key blob of the wallet
+func readMasterKeyBlob(t *testing.T, w wallet.Wallet) encryptedDBBlob {
+	db, err := sqlx.Connect("sqlite3", dbConnectionURL(w.(*SQLiteWallet).dbPath))
+	require.NoError(t, err)
+	defer db.Close()
+
+	var blob []byte
+	require.NoError(t, db.Get(&blob, "SELECT mep_encrypted FROM metadata LIMIT 1"))
+	var dbblob encryptedDBBlob
+	require.NoError(t, msgpackDecode(blob, &dbblob))
+	return dbblob
+}
+
+func TestSQLiteWalletUpgradeKDF(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	walletsDir := t.TempDir()
+	id := []byte("test-wallet-id")
+
+	// Create a wallet with scrypt, the way kmd did before Argon2id
+	swd := makeTestSQLiteDriver(t, walletsDir, config.KDFScrypt)
+	require.NoError(t, swd.CreateWallet([]byte("test-wallet"), id, testWalletPassword, crypto.MasterDerivationKey{}))
+	w, err := openTestSQLiteWallet(t, swd, id, testWalletPassword)
+	require.NoError(t, err)
+	blob := readMasterKeyBlob(t, w)
+	require.Equal(t, kdfScrypt, blob.KDF)
+	require.True(t, blob.DoScrypt)
+	addr, err := w.GenerateKey(false)
+	require.NoError(t, err)
+
+	// Reopen it with a driver configured for Argon2id: it is still scrypt
+	swd = makeTestSQLiteDriver(t, walletsDir, config.KDFArgon2id)
+	w, err = openTestSQLiteWallet(t, swd, id, testWalletPassword)
+	require.NoError(t, err)
+	require.Equal(t, kdfScrypt, readMasterKeyBlob(t, w).KDF)
+
+	// Upgrading requires the wallet password
+	require.ErrorIs(t, w.UpgradeKDF([]byte("wrong")), errDecrypt)
+	require.Equal(t, kdfScrypt, readMasterKeyBlob(t, w).KDF)
+
+	require.NoError(t, w.UpgradeKDF(testWalletPassword))
+	blob = readMasterKeyBlob(t, w)
+	require.Equal(t, kdfArgon2id, blob.KDF)
+	require.False(t, blob.DoScrypt)
+	require.Equal(t, swd.sqliteCfg.Argon2Params, blob.Argon2)
+
+	// Reopen the upgraded wallet: it only opens with the same password, and
+	// still holds its key
+	swd = makeTestSQLiteDriver(t, walletsDir, config.KDFArgon2id)
+	_, err = openTestSQLiteWallet(t, swd, id, []byte("wrong"))
+	require.ErrorIs(t, err, errDecrypt)
+	w, err = openTestSQLiteWallet(t, swd, id, testWalletPassword)
+	require.NoError(t, err)
+	addrs, err := w.ListKeys()
+	require.NoError(t, err)
+	require.Equal(t, []crypto.Digest{addr}, addrs)
+	_, err = w.ExportKey(addr, testWalletPassword)
+	require.NoError(t, err)
+}
+
+func TestSQLiteDriverKDFMaximums(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	var cfg config.KMDConfig
+	cfg.DriverConfig.SQLiteWalletDriverConfig = config.SQLiteWalletDriverConfig{
+		WalletsDir:   t.TempDir(),
+		UnsafeScrypt: true,
+		ScryptParams: config.ScryptParams{ScryptN: maxScryptN * 2, ScryptR: 1, ScryptP: 1},
+	}
+	swd := &SQLiteWalletDriver{}
+	require.ErrorContains(t, swd.InitWithConfig(cfg, logging.TestingLog(t)), "scrypt N must be at most")
+
+	cfg.DriverConfig.SQLiteWalletDriverConfig.ScryptParams = config.ScryptParams{ScryptN: 2, ScryptR: maxScryptR + 1, ScryptP: 1}
+	require.ErrorContains(t, swd.InitWithConfig(cfg, logging.TestingLog(t)), "scrypt R must be at most")
+
+	cfg.DriverConfig.SQLiteWalletDriverConfig.ScryptParams = config.ScryptParams{ScryptN: 2, ScryptR: 1, ScryptP: maxScryptP + 1}
+	require.ErrorContains(t, swd.InitWithConfig(cfg, logging.TestingLog(t)), "scrypt P must be at most")
+
+	cfg.DriverConfig.SQLiteWalletDriverConfig.ScryptParams = config.ScryptParams{ScryptN: 2, ScryptR: 1, ScryptP: 1}
+	cfg.DriverConfig.SQLiteWalletDriverConfig.KDF = config.KDFArgon2id
+	cfg.DriverConfig.SQLiteWalletDriverConfig.Argon2Params = config.Argon2Params{Argon2Time: maxArgon2Time + 1, Argon2MemoryKiB: 1024, Argon2Threads: 1}
+	require.ErrorContains(t, swd.InitWithConfig(cfg, logging.TestingLog(t)), "argon2id time must be at most")
+
+	cfg.DriverConfig.SQLiteWalletDriverConfig.Argon2Params = config.Argon2Params{Argon2Time: 1, Argon2MemoryKiB: maxArgon2Mem + 1, Argon2Threads: 1}
+	require.ErrorContains(t, swd.InitWithConfig(cfg, logging.TestingLog(t)), "argon2id memory must be at most")
+
+	cfg.DriverConfig.SQLiteWalletDriverConfig.Argon2Params = config.Argon2Params{Argon2Time: 1, Argon2MemoryKiB: 1024, Argon2Threads: maxArgon2Threads + 1}
+	require.ErrorContains(t, swd.InitWithConfig(cfg, logging.TestingLog(t)), "argon2id threads must be at most")
+}
+
+func TestDecryptBlobKDFMaximums(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	plaintext := []byte("plaintext")
+	scryptParams := kdfParams{Version: kdfScrypt, Scrypt: config.ScryptParams{ScryptN: 2, ScryptR: 1, ScryptP: 1}}
+	argon2Params := kdfParams{Version: kdfArgon2id, Argon2: config.Argon2Params{Argon2Time: 1, Argon2MemoryKiB: 1024, Argon2Threads: 1}}
+
+	// tamper re-encodes an encrypted blob after modifying its parameters, as
+	// a crafted wallet database would
+	tamper := func(params kdfParams, modify func(*encryptedDBBlob)) []byte {
+		blob, err := encryptBlobWithPasswordBlankOK(plaintext, PTMasterKey, testWalletPassword, &params)
+		require.NoError(t, err)
+		out, err := decryptBlobWithPassword(blob, PTMasterKey, testWalletPassword)
+		require.NoError(t, err)
+		require.Equal(t, plaintext, out)
+
+		var dbblob encryptedDBBlob
+		require.NoError(t, msgpackDecode(blob, &dbblob))
+		modify(&dbblob)
+		return msgpackEncode(dbblob)
+	}
+
+	blob := tamper(scryptParams, func(b *encryptedDBBlob) { b.ScryptN = maxScryptN * 2 })
+	_, err := decryptBlobWithPassword(blob, PTMasterKey, testWalletPassword)
+	require.ErrorIs(t, err, errKDFParams)
+
+	blob = tamper(scryptParams, func(b *encryptedDBBlob) { b.ScryptR = maxScryptR + 1 })
+	_, err = decryptBlobWithPassword(blob, PTMasterKey, testWalletPassword)
+	require.ErrorIs(t, err, errKDFParams)
+
+	blob = tamper(scryptParams, func(b *encryptedDBBlob) { b.ScryptP = maxScryptP + 1 })
+	_, err = decryptBlobWithPassword(blob, PTMasterKey, testWalletPassword)
+	require.ErrorIs(t, err, errKDFParams)
+
+	blob = tamper(argon2Params, func(b *encryptedDBBlob) { b.Argon2.Argon2Time = maxArgon2Time + 1 })
+	_, err = decryptBlobWithPassword(blob, PTMasterKey, testWalletPassword)
+	require.ErrorIs(t, err, errKDFParams)
+
+	blob = tamper(argon2Params, func(b *encryptedDBBlob) { b.Argon2.Argon2MemoryKiB = 1 << 31 })
+	_, err = decryptBlobWithPassword(blob, PTMasterKey, testWalletPassword)
+	require.ErrorIs(t, err, errKDFParams)
+
+	blob = tamper(argon2Params, func(b *encryptedDBBlob) { b.Argon2.Argon2Threads = maxArgon2Threads + 1 })
+	_, err = decryptBlobWithPassword(blob, PTMasterKey, testWalletPassword)
+	require.ErrorIs(t, err, errKDFParams)
+
+	// Parameters within the maximums are still used to derive the key, which
+	// no longer decrypts the blob
+	blob = tamper(scryptParams, func(b *encryptedDBBlob) { b.ScryptN = 1024 })
+	_, err = decryptBlobWithPassword(blob, PTMasterKey, testWalletPassword)
+	require.ErrorIs(t, err, errDecrypt)
+}
+
+func TestParseRemotePasswordHashKDFMaximums(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	salt := base64.StdEncoding.EncodeToString(make([]byte, saltLen))
+	key := base64.StdEncoding.EncodeToString(make([]byte, masterKeyLen))
+	encode := func(n, r, p int) string {
+		return fmt.Sprintf("%s$%d$%d$%d$%s$%s", remotePasswordHashScheme, n, r, p, salt, key)
+	}
+
+	_, err := parseRemotePasswordHash(encode(maxScryptN, maxScryptR, maxScryptP))
+	require.NoError(t, err)
+	for _, encoded := range []string{
+		encode(maxScryptN*2, 1, 1),
+		encode(2, maxScryptR+1, 1),
+		encode(2, 1, maxScryptP+1),
+	} {
+		_, err = parseRemotePasswordHash(encoded)
+		require.ErrorIs(t, err, errRemotePasswordHash, encoded)
+	}
+}
@@ -0,0 +1,108 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package wallet
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+// MaxDataDomainLength is the longest domain accepted for DomainData
+const MaxDataDomainLength = 64
+
+// ErrDataDomainEmpty is returned when signing data without a domain
+var ErrDataDomainEmpty = fmt.Errorf("data to be signed must have a domain")
+
+// ErrDataDomainInvalid is returned when signing data with a domain that is too
+// long or isn't printable ascii
+var ErrDataDomainInvalid = fmt.Errorf("data domain must be at most %d printable ascii characters", MaxDataDomainLength)
+
+// ErrTxGroupEmpty is returned when signing an empty transaction group
+var ErrTxGroupEmpty = fmt.Errorf("transaction group is empty")
+
+// ErrTxGroupTooLarge is returned when signing more transactions than a group can hold
+var ErrTxGroupTooLarge = fmt.Errorf("transaction group has more than %d transactions", config.MaxTxGroupSize)
+
+// ErrTxGroupMismatch is returned when signing transactions that don't share a group ID
+var ErrTxGroupMismatch = fmt.Errorf("transactions do not share the same group ID")
+
+// ErrTxGroupKeysMismatch is returned when the number of keys doesn't match the number of transactions
+var ErrTxGroupKeysMismatch = fmt.Errorf("number of public keys does not match the number of transactions")
+
+// DomainData is arbitrary data signed under a domain chosen by the caller.
+// Signatures are computed over the KMDSignedData prefix, followed by the
+// length prefixed domain and the data, so they can never be replayed as
+// signatures of transactions, programs, or data of another domain.
+type DomainData struct {
+	Domain string
+	Data   []byte
+}
+
+// MakeDomainData checks the domain and returns the DomainData to be signed
+func MakeDomainData(domain string, data []byte) (DomainData, error) {
+	if domain == "" {
+		return DomainData{}, ErrDataDomainEmpty
+	}
+	if len(domain) > MaxDataDomainLength {
+		return DomainData{}, ErrDataDomainInvalid
+	}
+	for i := 0; i < len(domain); i++ {
+		if domain[i] < 0x20 || domain[i] > 0x7e {
+			return DomainData{}, ErrDataDomainInvalid
+		}
+	}
+	return DomainData{Domain: domain, Data: data}, nil
+}
+
+// ToBeHashed implements the crypto.Hashable interface
+func (d DomainData) ToBeHashed() (protocol.HashID, []byte) {
+	buf := make([]byte, 0, binary.MaxVarintLen64+len(d.Domain)+len(d.Data))
+	buf = binary.AppendUvarint(buf, uint64(len(d.Domain)))
+	buf = append(buf, d.Domain...)
+	buf = append(buf, d.Data...)
+	return protocol.KMDSignedData, buf
+}
+
+// CheckTxGroup ensures that txs can be signed together as a single group:
+// there are at most config.MaxTxGroupSize of them, all sharing the same
+// non-zero group ID (unless there is a single transaction). pks is either
+// empty, or holds the key to sign each of the transactions with.
+func CheckTxGroup(txs []transactions.Transaction, pks []crypto.PublicKey) error {
+	if len(txs) == 0 {
+		return ErrTxGroupEmpty
+	}
+	if len(txs) > config.MaxTxGroupSize {
+		return ErrTxGroupTooLarge
+	}
+	if len(pks) != 0 && len(pks) != len(txs) {
+		return ErrTxGroupKeysMismatch
+	}
+	if len(txs) > 1 && (txs[0].Group == crypto.Digest{}) {
+		return ErrTxGroupMismatch
+	}
+	for _, tx := range txs[1:] {
+		if tx.Group != txs[0].Group {
+			return ErrTxGroupMismatch
+		}
+	}
+	return nil
+}
@@ -36,6 +36,8 @@ type Wallet interface {
 	Init(pw []byte) error
 	CheckPassword(pw []byte) error
 	ExportMasterDerivationKey(pw []byte) (crypto.MasterDerivationKey, error)
+	Backup(pw []byte) ([]byte, error)
+	UpgradeKDF(pw []byte) error
 
 	Metadata() (Metadata, error)
 
@@ -52,11 +54,14 @@ type Wallet interface {
 	DeleteMultisigAddr(addr crypto.Digest, pw []byte) error
 
 	SignTransaction(tx transactions.Transaction, pk crypto.PublicKey, pw []byte) ([]byte, error)
+	SignTransactionGroup(txs []transactions.Transaction, pks []crypto.PublicKey, pw []byte) ([][]byte, error)
 
 	MultisigSignTransaction(tx transactions.Transaction, pk crypto.PublicKey, partial crypto.MultisigSig, pw []byte, signer crypto.Digest) (crypto.MultisigSig, error)
 
 	SignProgram(program []byte, src crypto.Digest, pw []byte) ([]byte, error)
 	MultisigSignProgram(program []byte, src crypto.Digest, pk crypto.PublicKey, partial crypto.MultisigSig, pw []byte) (crypto.MultisigSig, error)
+
+	SignData(data DomainData, src crypto.Digest, pw []byte) ([]byte, error)
 }
 
 // Metadata represents high-level information about a wallet, like its name, id
@@ -30,6 +30,23 @@ var ErrStaleBlockAssemblyRequest = errors.New("AssembleBlock: requested block as
 // ErrPendingQueueReachedMaxCap indicates the current transaction pool has reached its max capacity
 var ErrPendingQueueReachedMaxCap = errors.New("TransactionPool.checkPendingQueueSize: transaction pool have reached capacity")
 
+// ErrReplacementRateLimited indicates a sender of a replacement transaction group has already replaced pending groups in the current round
+var ErrReplacementRateLimited = errors.New("TransactionPool.checkReplacement: sender has already replaced pending transactions this round")
+
+// ErrReplacementRoundLimited indicates the pool has already replaced as many pending transaction groups as it allows in the current round
+var ErrReplacementRoundLimited = errors.New("TransactionPool.checkReplacement: pool has already replaced as many pending transactions as it allows this round")
+
+// ErrReplacementFeeTooLow is returned for a transaction group holding the lease of pending groups of the same sender, but not paying enough more than them to replace them
+type ErrReplacementFeeTooLow struct {
+	fee    uint64
+	minFee uint64
+}
+
+func (e *ErrReplacementFeeTooLow) Error() string {
+	return fmt.Sprintf("fee %d too low to replace pending transactions holding the same lease: replacements must pay at least %d%% more than each group they replace, %d in total",
+		e.fee, replaceFeeBumpPercent, e.minFee)
+}
+
 // ErrNoPendingBlockEvaluator indicates there is no pending block evaluator to accept a new tx group
 var ErrNoPendingBlockEvaluator = errors.New("TransactionPool.ingest: no pending block evaluator")
 
@@ -0,0 +1,205 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package pools
+
+import (
+	"math"
+	"math/bits"
+	"sort"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/util/metrics"
+)
+
+var txPoolReplacedByFee = metrics.MakeCounter(metrics.MetricName{Name: "algod_tx_pool_replaced_by_fee_total", Description: "Number of transaction groups evicted from the pool by a conflicting group paying a higher fee"})
+var txPoolReplaceByFeeFailed = metrics.MakeCounter(metrics.MetricName{Name: "algod_tx_pool_replace_by_fee_failed_total", Description: "Number of replacement transaction groups rejected by the pool, leaving the groups they conflicted with in place"})
+var txPoolPriorityDeferred = metrics.MakeCounter(metrics.MetricName{Name: "algod_tx_pool_priority_deferred_total", Description: "Number of transaction groups that failed in fee order and were retried in arrival order"})
+
+// groupPriority is the effective fee per byte of a transaction group, kept as a
+// fraction to avoid rounding.
+type groupPriority struct {
+	// stateProof is set for state proof groups, which pay no fee but always come first
+	stateProof bool
+	fee        uint64
+	length     uint64
+}
+
+func makeGroupPriority(txgroup []transactions.SignedTxn) groupPriority {
+	var p groupPriority
+	for _, stxn := range txgroup {
+		if stxn.Txn.Type == protocol.StateProofTx {
+			p.stateProof = true
+		}
+		p.fee = basics.AddSaturate(p.fee, stxn.Txn.Fee.Raw)
+		p.length = basics.AddSaturate(p.length, uint64(stxn.GetEncodedLength()))
+	}
+	return p
+}
+
+// higherThan returns true if p must be evaluated before other
+func (p groupPriority) higherThan(other groupPriority) bool {
+	if p.stateProof != other.stateProof {
+		return p.stateProof
+	}
+	// p.fee / p.length > other.fee / other.length, without division or overflow
+	hi, lo := bits.Mul64(p.fee, other.length)
+	otherHi, otherLo := bits.Mul64(other.fee, p.length)
+	return hi > otherHi || (hi == otherHi && lo > otherLo)
+}
+
+// priorityOrder returns the indexes of txgroups in the order they should be
+// evaluated for block assembly: state proofs first, then by decreasing
+// effective fee per byte. Groups paying the same fee per byte keep their
+// arrival order.
+func priorityOrder(txgroups [][]transactions.SignedTxn) []int {
+	priorities := make([]groupPriority, len(txgroups))
+	order := make([]int, len(txgroups))
+	for i, txgroup := range txgroups {
+		priorities[i] = makeGroupPriority(txgroup)
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return priorities[order[i]].higherThan(priorities[order[j]])
+	})
+	return order
+}
+
+// replaceMaxPerSenderPerRound is the number of replacements a sender may make
+// per round. Each replacement recomputes the pending block evaluator, so the
+// fee bump alone, of which only the final fee is ever paid, is not enough to
+// bound the work a sender can cause.
+const replaceMaxPerSenderPerRound = 1
+
+// replaceMaxPerRound is the number of replacements the pool makes per round,
+// across all senders. Each sender is limited on its own, but many senders
+// replacing together would still keep the pool recomputing.
+const replaceMaxPerRound = 16
+
+// replaceFeeBumpPercent is the minimum increase, in percent of the total fee
+// of each group it replaces, that a replacement group must pay. It makes every
+// replacement cost a fraction of the fee it displaces, so that a sender cannot
+// churn the pool by repeatedly bumping a fee by a single microalgo.
+const replaceFeeBumpPercent = 10
+
+// replaceKey identifies pending transactions that a new transaction may
+// replace: those of the same sender holding the same lease. Transactions
+// without a lease are never replaced, since nothing else tells a resubmission
+// apart from an unrelated transaction of the same sender.
+type replaceKey struct {
+	sender basics.Address
+	lease  [32]byte
+}
+
+// pendingGroupRef refers to a pending transaction group by the ID of its
+// first transaction, along with the total fee it pays and its number of
+// transactions.
+type pendingGroupRef struct {
+	id   transactions.Txid
+	fee  uint64
+	size int
+}
+
+// makeReplaceKey returns the key under which txn may be replaced, if it has a
+// lease.
+func makeReplaceKey(txn transactions.Transaction) (replaceKey, bool) {
+	if txn.Lease == [32]byte{} {
+		return replaceKey{}, false
+	}
+	return replaceKey{sender: txn.Sender, lease: txn.Lease}, true
+}
+
+// indexReplaceable adds a pending transaction group to pool.pendingReplaceable.
+// The caller is assumed to be holding pool.pendingMu.
+func (pool *TransactionPool) indexReplaceable(txgroup []transactions.SignedTxn) {
+	if len(txgroup) == 0 {
+		return
+	}
+	ref := pendingGroupRef{id: txgroup[0].ID(), fee: makeGroupPriority(txgroup).fee, size: len(txgroup)}
+	for _, stxn := range txgroup {
+		if key, ok := makeReplaceKey(stxn.Txn); ok {
+			pool.pendingReplaceable[key] = append(pool.pendingReplaceable[key], ref)
+		}
+	}
+}
+
+// conflictingTxGroups returns the pending transaction groups that txgroup
+// conflicts with: those with a transaction from the same sender as one of
+// txgroup, with the same lease. They are keyed by the ID of their first
+// transaction.
+func (pool *TransactionPool) conflictingTxGroups(txgroup []transactions.SignedTxn) map[transactions.Txid]pendingGroupRef {
+	pool.pendingMu.RLock()
+	defer pool.pendingMu.RUnlock()
+
+	var conflicts map[transactions.Txid]pendingGroupRef
+	for _, stxn := range txgroup {
+		key, ok := makeReplaceKey(stxn.Txn)
+		if !ok {
+			continue
+		}
+		for _, ref := range pool.pendingReplaceable[key] {
+			if conflicts == nil {
+				conflicts = make(map[transactions.Txid]pendingGroupRef)
+			}
+			conflicts[ref.id] = ref
+		}
+	}
+	return conflicts
+}
+
+// replaceableTxGroups returns the pending transaction groups that txgroup
+// replaces, if it conflicts with some. It fails with an
+// ErrReplacementFeeTooLow if txgroup does not pay enough to replace each of
+// them. A resubmission of a pending group replaces nothing.
+func (pool *TransactionPool) replaceableTxGroups(txgroup []transactions.SignedTxn) (map[transactions.Txid]pendingGroupRef, error) {
+	conflicts := pool.conflictingTxGroups(txgroup)
+	if len(conflicts) == 0 {
+		return nil, nil
+	}
+	if _, ok := conflicts[txgroup[0].ID()]; ok {
+		return nil, nil
+	}
+	var minFee uint64
+	for _, ref := range conflicts {
+		if f := minReplacementFee(ref.fee); f > minFee {
+			minFee = f
+		}
+	}
+	if fee := makeGroupPriority(txgroup).fee; fee < minFee {
+		return nil, &ErrReplacementFeeTooLow{fee: fee, minFee: minFee}
+	}
+	return conflicts, nil
+}
+
+// minReplacementFee returns the minimum total fee of a group replacing a group
+// paying replacedFee: replacedFee plus replaceFeeBumpPercent percent, rounded
+// up, and at least one microalgo more than replacedFee.
+func minReplacementFee(replacedFee uint64) uint64 {
+	hi, lo := bits.Mul64(replacedFee, 100+replaceFeeBumpPercent)
+	if hi >= 100 {
+		return math.MaxUint64
+	}
+	minFee, rem := bits.Div64(hi, lo, 100)
+	if rem != 0 {
+		minFee = basics.AddSaturate(minFee, 1)
+	}
+	if minFee <= replacedFee {
+		minFee = basics.AddSaturate(replacedFee, 1)
+	}
+	return minFee
+}
@@ -19,6 +19,7 @@ package pools
 import (
 	"errors"
 	"fmt"
+	"sort"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -46,6 +47,15 @@ import (
 // only if its fees are sufficiently high and its state changes are
 // consistent with the prior transactions in the queue.
 //
+// When the pool is recomputed for a new round, pending groups are
+// evaluated by decreasing effective fee per byte, so block assembly
+// favors the groups that pay the most. A new group holding the lease
+// of pending groups of the same sender, and paying at least
+// replaceFeeBumpPercent percent more than each of them, replaces them.
+// A sender may replace groups at most replaceMaxPerSenderPerRound times
+// per round, and the pool makes at most replaceMaxPerRound replacements
+// per round.
+//
 // TransactionPool.AssembleBlock constructs a valid block for
 // proposal given a deadline.
 type TransactionPool struct {
@@ -75,10 +85,19 @@ type TransactionPool struct {
 	assemblyRound   basics.Round
 	assemblyResults poolAsmResults
 
-	// pendingMu protects pendingTxGroups and pendingTxids
+	// pendingMu protects pendingTxGroups, pendingTxids and pendingReplaceable
 	pendingMu       deadlock.RWMutex
 	pendingTxGroups [][]transactions.SignedTxn
 	pendingTxids    map[transactions.Txid]transactions.SignedTxn
+	// pendingReplaceable indexes pendingTxGroups by the keys under which a
+	// new transaction group may replace them
+	pendingReplaceable map[replaceKey][]pendingGroupRef
+
+	// replacementCount counts the replacements made by each sender since
+	// the last block, and replacementTotal those made by all senders. They
+	// are protected by mu.
+	replacementCount map[basics.Address]int
+	replacementTotal int
 
 	// Calls to remember() add transactions to rememberedTxGroups and
 	// rememberedTxids.  Calling rememberCommit() adds them to the
@@ -116,6 +135,8 @@ func MakeTransactionPool(ledger *ledger.Ledger, cfg config.Local, log logging.Lo
 	}
 	pool := TransactionPool{
 		pendingTxids:         make(map[transactions.Txid]transactions.SignedTxn),
+		pendingReplaceable:   make(map[replaceKey][]pendingGroupRef),
+		replacementCount:     make(map[basics.Address]int),
 		rememberedTxids:      make(map[transactions.Txid]transactions.SignedTxn),
 		expiredTxCount:       make(map[basics.Round]int),
 		ledger:               ledger,
@@ -175,6 +196,9 @@ func (pool *TransactionPool) Reset() {
 	defer pool.cond.Broadcast()
 	pool.pendingTxids = make(map[transactions.Txid]transactions.SignedTxn)
 	pool.pendingTxGroups = nil
+	pool.pendingReplaceable = make(map[replaceKey][]pendingGroupRef)
+	pool.replacementCount = make(map[basics.Address]int)
+	pool.replacementTotal = 0
 	pool.rememberedTxids = make(map[transactions.Txid]transactions.SignedTxn)
 	pool.rememberedTxGroups = nil
 	pool.expiredTxCount = make(map[basics.Round]int)
@@ -240,6 +264,7 @@ func (pool *TransactionPool) rememberCommit(flush bool) {
 		pool.pendingTxGroups = pool.rememberedTxGroups
 		pool.stateproofOverflowed = false
 		pool.pendingTxids = pool.rememberedTxids
+		pool.pendingReplaceable = make(map[replaceKey][]pendingGroupRef)
 		pool.ledger.VerifiedTransactionCache().UpdatePinned(pool.pendingTxids)
 	} else {
 		pool.pendingTxGroups = append(pool.pendingTxGroups, pool.rememberedTxGroups...)
@@ -248,6 +273,9 @@ func (pool *TransactionPool) rememberCommit(flush bool) {
 			pool.pendingTxids[txid] = txn
 		}
 	}
+	for _, txgroup := range pool.rememberedTxGroups {
+		pool.indexReplaceable(txgroup)
+	}
 
 	pool.rememberedTxGroups = nil
 	pool.rememberedTxids = make(map[transactions.Txid]transactions.SignedTxn)
@@ -371,6 +399,19 @@ func (pool *TransactionPool) checkSufficientFee(txgroup []transactions.SignedTxn
 // Test performs basic duplicate detection and well-formedness checks
 // on a transaction group without storing the group.
 func (pool *TransactionPool) Test(txgroup []transactions.SignedTxn) error {
+	replaced, err := pool.replaceableTxGroups(txgroup)
+	if err != nil {
+		return err
+	}
+	if len(replaced) != 0 {
+		pool.mu.Lock()
+		defer pool.mu.Unlock()
+		if err := pool.checkSufficientFee(txgroup); err != nil {
+			return err
+		}
+		return pool.checkReplacement(txgroup, replaced, false)
+	}
+
 	if err := pool.checkPendingQueueSize(txgroup); err != nil {
 		return err
 	}
@@ -458,14 +499,29 @@ func (pool *TransactionPool) RememberOne(t transactions.SignedTxn) error {
 // Remember stores the provided transaction group.
 // Precondition: Only Remember() properly-signed and well-formed transactions (i.e., ensure t.WellFormed())
 func (pool *TransactionPool) Remember(txgroup []transactions.SignedTxn) error {
-	if err := pool.checkPendingQueueSize(txgroup); err != nil {
-		return err
+	// A full pool still accepts replacements, since they free the space of
+	// the groups they evict.
+	queueErr := pool.checkPendingQueueSize(txgroup)
+	if queueErr != nil && len(pool.conflictingTxGroups(txgroup)) == 0 {
+		return queueErr
 	}
 
 	pool.mu.Lock()
 	defer pool.mu.Unlock()
 
-	err := pool.remember(txgroup)
+	replaced, err := pool.replaceableTxGroups(txgroup)
+	if err != nil {
+		txPoolReplaceByFeeFailed.Inc(nil)
+		return fmt.Errorf("TransactionPool.Remember: %w", err)
+	}
+	if len(replaced) != 0 {
+		return pool.replace(txgroup, replaced)
+	}
+	if queueErr != nil {
+		return queueErr
+	}
+
+	err = pool.remember(txgroup)
 	if err != nil {
 		return fmt.Errorf("TransactionPool.Remember: %w", err)
 	}
@@ -474,6 +530,98 @@ func (pool *TransactionPool) Remember(txgroup []transactions.SignedTxn) error {
 	return nil
 }
 
+// checkReplacement checks whether txgroup can replace the pending groups in
+// replaced. Neither its senders nor the pool must have used up their
+// replacements for this round, the pool must stay within its size limit once
+// the groups are evicted, and txgroup must be valid against the latest ledger
+// state. The pending block evaluator cannot tell the latter, since it holds
+// the state changes of the groups to evict, so txgroup is checked by an
+// evaluator of its own. Only the checks of Test are performed, unless
+// evaluate is set.
+// The caller is assumed to be holding pool.mu.
+func (pool *TransactionPool) checkReplacement(txgroup []transactions.SignedTxn, replaced map[transactions.Txid]pendingGroupRef, evaluate bool) error {
+	if pool.replacementTotal >= replaceMaxPerRound {
+		return ErrReplacementRoundLimited
+	}
+	for _, stxn := range txgroup {
+		if pool.replacementCount[stxn.Txn.Sender] >= replaceMaxPerSenderPerRound {
+			return ErrReplacementRateLimited
+		}
+	}
+
+	evictedCount := 0
+	for _, ref := range replaced {
+		evictedCount += ref.size
+	}
+	if pool.pendingTxIDsCount()-evictedCount+len(txgroup) > pool.txPoolMaxSize {
+		return ErrPendingQueueReachedMaxCap
+	}
+
+	if pool.pendingBlockEvaluator == nil {
+		return ErrNoPendingBlockEvaluator
+	}
+	prev, err := pool.ledger.BlockHdr(pool.pendingBlockEvaluator.Round() - 1)
+	if err != nil {
+		return err
+	}
+	next := bookkeeping.MakeBlock(prev)
+	eval, err := pool.ledger.StartEvaluator(next.BlockHeader, len(txgroup), 0, nil)
+	if err != nil {
+		return err
+	}
+	if !evaluate {
+		return eval.TestTransactionGroup(txgroup)
+	}
+	return eval.TransactionGroup(transactions.WrapSignedTxnsWithAD(txgroup))
+}
+
+// replace evicts the pending transaction groups that txgroup replaces, and
+// queues txgroup instead. If txgroup cannot replace them, they are left in
+// place. The caller is assumed to be holding pool.mu.
+//
+// The pending block evaluator holds the state changes of the evicted groups,
+// so it is recomputed from the remaining groups and txgroup, as it is for a
+// new block. The eviction only takes effect if txgroup is accepted by the
+// recomputed evaluator.
+func (pool *TransactionPool) replace(txgroup []transactions.SignedTxn, replaced map[transactions.Txid]pendingGroupRef) error {
+	err := pool.checkSufficientFee(txgroup)
+	if err == nil {
+		err = pool.checkReplacement(txgroup, replaced, true)
+	}
+	if err != nil {
+		txPoolReplaceByFeeFailed.Inc(nil)
+		return fmt.Errorf("TransactionPool.Remember: %w", err)
+	}
+
+	// The recomputation is the expensive part of a replacement, so it counts
+	// against the senders and the pool whether or not the replacement is
+	// accepted.
+	for _, stxn := range txgroup {
+		pool.replacementCount[stxn.Txn.Sender]++
+	}
+	pool.replacementTotal++
+
+	r := poolReplacement{txgroup: txgroup, replaced: replaced}
+	pool.recomputeBlockEvaluatorReplacing(nil, 0, &r)
+	pool.cond.Broadcast()
+
+	// txgroup is valid on its own, but may still fail along with the other
+	// pending groups, e.g. if their sender cannot pay for all of them.
+	if !r.accepted {
+		txPoolReplaceByFeeFailed.Inc(nil)
+		_, txErr, _ := pool.statusCache.check(txgroup[0].ID())
+		return fmt.Errorf("TransactionPool.Remember: replacement dropped from the pool: %s", txErr)
+	}
+
+	for _, group := range r.evicted {
+		for _, tx := range group {
+			pool.statusCache.put(tx, "replaced by a transaction group paying a higher fee")
+		}
+	}
+	txPoolReplacedByFee.AddUint64(uint64(len(r.evicted)), nil)
+	return nil
+}
+
 // Lookup returns the error associated with a transaction that used
 // to be in the pool.  If no status information is available (e.g., because
 // it was too long ago, or the transaction committed successfully), then
@@ -542,6 +690,10 @@ func (pool *TransactionPool) OnNewBlock(block bookkeeping.Block, delta ledgercor
 			}
 		}
 
+		// Senders may replace their pending groups again in the new round.
+		pool.replacementCount = make(map[basics.Address]int)
+		pool.replacementTotal = 0
+
 		// Recompute the pool by starting from the new latest block.
 		// This has the side-effect of discarding transactions that
 		// have been committed (or that are otherwise no longer valid).
@@ -657,6 +809,26 @@ func (pool *TransactionPool) addToPendingBlockEvaluator(txgroup []transactions.S
 // in-pool transactions to it (removing any transactions that are rejected
 // by the BlockEvaluator). Expects that the pool.mu mutex would be already taken.
 func (pool *TransactionPool) recomputeBlockEvaluator(committedTxIds map[transactions.Txid]ledgercore.IncludedTransactions, knownCommitted uint) (stats telemetryspec.ProcessBlockMetrics) {
+	return pool.recomputeBlockEvaluatorReplacing(committedTxIds, knownCommitted, nil)
+}
+
+// poolReplacement is a transaction group replacing pending groups, evaluated
+// by recomputeBlockEvaluatorReplacing.
+type poolReplacement struct {
+	txgroup  []transactions.SignedTxn
+	replaced map[transactions.Txid]pendingGroupRef
+
+	// evicted holds the pending groups the replacement evicts, and accepted
+	// whether it was accepted, once the evaluator is recomputed.
+	evicted  [][]transactions.SignedTxn
+	accepted bool
+}
+
+// recomputeBlockEvaluatorReplacing is recomputeBlockEvaluator, with the
+// pending groups that replacement replaces swapped for the replacement, if
+// not nil. If the replacement is rejected, the evicted groups are fed to the
+// evaluator after all others instead, so that they stay in the pool.
+func (pool *TransactionPool) recomputeBlockEvaluatorReplacing(committedTxIds map[transactions.Txid]ledgercore.IncludedTransactions, knownCommitted uint, replacement *poolReplacement) (stats telemetryspec.ProcessBlockMetrics) {
 	pool.pendingBlockEvaluator = nil
 
 	latest := pool.ledger.Latest()
@@ -688,6 +860,22 @@ func (pool *TransactionPool) recomputeBlockEvaluator(committedTxIds map[transact
 	pendingCount := pool.pendingCountNoLock()
 	pool.pendingMu.RUnlock()
 
+	if replacement != nil {
+		// pendingTxGroups is shared with the callers of PendingTxGroups, so
+		// it is copied rather than modified in place.
+		remaining := make([][]transactions.SignedTxn, 0, len(txgroups)+1)
+		for _, txgroup := range txgroups {
+			if len(txgroup) != 0 {
+				if _, ok := replacement.replaced[txgroup[0].ID()]; ok {
+					replacement.evicted = append(replacement.evicted, txgroup)
+					continue
+				}
+			}
+			remaining = append(remaining, txgroup)
+		}
+		txgroups = append(remaining, replacement.txgroup)
+	}
+
 	pool.assemblyMu.Lock()
 	pool.assemblyResults = poolAsmResults{
 		roundStartedEvaluating: prev.Round + basics.Round(1),
@@ -723,8 +911,13 @@ func (pool *TransactionPool) recomputeBlockEvaluator(committedTxIds map[transact
 
 	firstTxnGrpTime := time.Now()
 
-	// Feed the transactions in order
-	for _, txgroup := range txgroups {
+	// Feed the transactions by decreasing fee per byte. A group may depend on
+	// the effects of a group that arrived before it but pays less, so groups
+	// failing for any reason other than being committed, expired or otherwise
+	// unfixable are retried once, in arrival order, after all other groups.
+	var deferred []int
+	for _, i := range priorityOrder(txgroups) {
+		txgroup := txgroups[i]
 		if len(txgroup) == 0 {
 			asmStats.InvalidCount++
 			continue
@@ -735,33 +928,32 @@ func (pool *TransactionPool) recomputeBlockEvaluator(committedTxIds map[transact
 		}
 		err := pool.add(txgroup, &asmStats)
 		if err != nil {
-			for _, tx := range txgroup {
-				pool.statusCache.put(tx, err.Error())
+			if isRetriableRecomputeError(err) {
+				deferred = append(deferred, i)
+				continue
 			}
-			// metrics here are duplicated for historic reasons. stats is hardly used and should be removed in favor of asmstats
-			switch terr := err.(type) {
-			case *ledgercore.TransactionInLedgerError:
-				asmStats.CommittedCount++
-				stats.RemovedInvalidCount++
-			case *transactions.TxnDeadError:
-				if int(terr.LastValid-terr.FirstValid) > 20 {
-					// cutoff value  here is picked as a somewhat arbitrary cutoff trying to separate longer lived transactions from very short lived ones
-					asmStats.ExpiredLongLivedCount++
+			pool.recordRecomputeError(txgroup, err, &asmStats, &stats)
+		}
+	}
+
+	sort.Ints(deferred)
+	txPoolPriorityDeferred.AddUint64(uint64(len(deferred)), nil)
+	for _, i := range deferred {
+		txgroup := txgroups[i]
+		err := pool.add(txgroup, &asmStats)
+		if err != nil {
+			pool.recordRecomputeError(txgroup, err, &asmStats, &stats)
+		}
+	}
+
+	if replacement != nil {
+		_, replacement.accepted = pool.rememberedTxids[replacement.txgroup[0].ID()]
+		if !replacement.accepted {
+			for _, txgroup := range replacement.evicted {
+				err := pool.add(txgroup, &asmStats)
+				if err != nil {
+					pool.recordRecomputeError(txgroup, err, &asmStats, &stats)
 				}
-				asmStats.ExpiredCount++
-				stats.ExpiredCount++
-			case *ledgercore.LeaseInLedgerError:
-				asmStats.LeaseErrorCount++
-				stats.RemovedInvalidCount++
-				pool.log.Infof("Cannot re-add pending transaction to pool: %v", err)
-			case *transactions.MinFeeError:
-				asmStats.MinFeeErrorCount++
-				stats.RemovedInvalidCount++
-				pool.log.Infof("Cannot re-add pending transaction to pool: %v", err)
-			default:
-				asmStats.InvalidCount++
-				stats.RemovedInvalidCount++
-				pool.log.Warnf("Cannot re-add pending transaction to pool: %v", err)
 			}
 		}
 	}
@@ -795,6 +987,50 @@ func (pool *TransactionPool) recomputeBlockEvaluator(committedTxIds map[transact
 	return
 }
 
+// isRetriableRecomputeError returns true if a transaction group that failed to
+// be re-added to the pool with err may succeed once other groups are added.
+func isRetriableRecomputeError(err error) bool {
+	switch err.(type) {
+	case *ledgercore.TransactionInLedgerError, *transactions.TxnDeadError, *ledgercore.LeaseInLedgerError, *transactions.MinFeeError:
+		return false
+	default:
+		return err != ledgercore.ErrNoSpace
+	}
+}
+
+// recordRecomputeError records why a transaction group was dropped from the
+// pool by recomputeBlockEvaluator.
+func (pool *TransactionPool) recordRecomputeError(txgroup []transactions.SignedTxn, err error, asmStats *telemetryspec.AssembleBlockMetrics, stats *telemetryspec.ProcessBlockMetrics) {
+	for _, tx := range txgroup {
+		pool.statusCache.put(tx, err.Error())
+	}
+	// metrics here are duplicated for historic reasons. stats is hardly used and should be removed in favor of asmstats
+	switch terr := err.(type) {
+	case *ledgercore.TransactionInLedgerError:
+		asmStats.CommittedCount++
+		stats.RemovedInvalidCount++
+	case *transactions.TxnDeadError:
+		if int(terr.LastValid-terr.FirstValid) > 20 {
+			// cutoff value  here is picked as a somewhat arbitrary cutoff trying to separate longer lived transactions from very short lived ones
+			asmStats.ExpiredLongLivedCount++
+		}
+		asmStats.ExpiredCount++
+		stats.ExpiredCount++
+	case *ledgercore.LeaseInLedgerError:
+		asmStats.LeaseErrorCount++
+		stats.RemovedInvalidCount++
+		pool.log.Infof("Cannot re-add pending transaction to pool: %v", err)
+	case *transactions.MinFeeError:
+		asmStats.MinFeeErrorCount++
+		stats.RemovedInvalidCount++
+		pool.log.Infof("Cannot re-add pending transaction to pool: %v", err)
+	default:
+		asmStats.InvalidCount++
+		stats.RemovedInvalidCount++
+		pool.log.Warnf("Cannot re-add pending transaction to pool: %v", err)
+	}
+}
+
 func (pool *TransactionPool) getStateProofStats(txib *transactions.SignedTxnInBlock, encodedLen int) telemetryspec.StateProofStats {
 	stateProofStats := telemetryspec.StateProofStats{
 		ProvenWeight:   0,
@@ -20,11 +20,13 @@ import (
 	"bufio"
 	"bytes"
 	"fmt"
+	"math"
 	"math/rand"
 	"os"
 	"runtime"
 	"runtime/pprof"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -867,6 +869,414 @@ func TestRemove(t *testing.T) {
 	require.Equal(t, transactionPool.PendingTxGroups(), [][]transactions.SignedTxn{{signedTx}})
 }
 
+// makePoolTestPayment returns a payment from sender to receiver, valid for the
+// first 10 rounds, paying fee.
+func makePoolTestPayment(l *ledger.Ledger, sender, receiver basics.Address, amount, fee uint64) transactions.Transaction {
+	return transactions.Transaction{
+		Type: protocol.PaymentTx,
+		Header: transactions.Header{
+			Sender:      sender,
+			Fee:         basics.MicroAlgos{Raw: fee},
+			FirstValid:  0,
+			LastValid:   10,
+			Note:        []byte{0},
+			GenesisHash: l.GenesisHash(),
+		},
+		PaymentTxnFields: transactions.PaymentTxnFields{
+			Receiver: receiver,
+			Amount:   basics.MicroAlgos{Raw: amount},
+		},
+	}
+}
+
+func recomputePool(pool *TransactionPool) {
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+	pool.recomputeBlockEvaluator(nil, 0)
+}
+
+func TestPriorityOrdering(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	numOfAccounts := 4
+	secrets := make([]*crypto.SignatureSecrets, numOfAccounts)
+	addresses := make([]basics.Address, numOfAccounts)
+	for i := 0; i < numOfAccounts; i++ {
+		secrets[i] = keypair()
+		addresses[i] = basics.Address(secrets[i].SignatureVerifier)
+	}
+
+	l := makeMockLedger(t, initAccFixed(addresses, 1<<32))
+	cfg := config.GetDefaultLocal()
+	cfg.TxPoolSize = testPoolSize
+	cfg.EnableProcessBlockStats = false
+	transactionPool := MakeTransactionPool(l, cfg, logging.Base())
+
+	receiver := addresses[numOfAccounts-1]
+	fees := []uint64{proto.MinTxnFee, 3 * proto.MinTxnFee, 2 * proto.MinTxnFee}
+	signed := make([]transactions.SignedTxn, len(fees))
+	for i, fee := range fees {
+		signed[i] = makePoolTestPayment(l, addresses[i], receiver, 1, fee).Sign(secrets[i])
+		require.NoError(t, transactionPool.RememberOne(signed[i]))
+	}
+	// Groups are kept in arrival order until the pool is recomputed
+	require.Equal(t, [][]transactions.SignedTxn{{signed[0]}, {signed[1]}, {signed[2]}}, transactionPool.PendingTxGroups())
+
+	recomputePool(transactionPool)
+	expected := [][]transactions.SignedTxn{{signed[1]}, {signed[2]}, {signed[0]}}
+	require.Equal(t, expected, transactionPool.PendingTxGroups())
+
+	blk, err := transactionPool.AssembleBlock(transactionPool.pendingBlockEvaluator.Round(), time.Now().Add(time.Second))
+	require.NoError(t, err)
+	payset, err := blk.Block().DecodePaysetFlat()
+	require.NoError(t, err)
+	require.Len(t, payset, len(expected))
+	for i, group := range expected {
+		require.Equal(t, group[0].ID(), payset[i].ID())
+	}
+}
+
+func TestPriorityOrderingKeepsDependencies(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	funder := keypair()
+	funderAddr := basics.Address(funder.SignatureVerifier)
+	spender := keypair()
+	spenderAddr := basics.Address(spender.SignatureVerifier)
+	var receiver basics.Address
+	crypto.RandBytes(receiver[:])
+
+	l := makeMockLedger(t, initAccFixed([]basics.Address{funderAddr}, 1<<32))
+	cfg := config.GetDefaultLocal()
+	cfg.TxPoolSize = testPoolSize
+	cfg.EnableProcessBlockStats = false
+	transactionPool := MakeTransactionPool(l, cfg, logging.Base())
+
+	// The spender can only pay once funded, but pays a higher fee than its funding
+	fund := makePoolTestPayment(l, funderAddr, spenderAddr, 10*minBalance, proto.MinTxnFee).Sign(funder)
+	spend := makePoolTestPayment(l, spenderAddr, receiver, minBalance, 10*proto.MinTxnFee).Sign(spender)
+	require.NoError(t, transactionPool.RememberOne(fund))
+	require.NoError(t, transactionPool.RememberOne(spend))
+
+	recomputePool(transactionPool)
+	require.Equal(t, [][]transactions.SignedTxn{{fund}, {spend}}, transactionPool.PendingTxGroups())
+	_, txErr, found := transactionPool.Lookup(spend.ID())
+	require.True(t, found)
+	require.Empty(t, txErr)
+}
+
+func TestReplaceByFee(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	numOfAccounts := 2
+	secrets := make([]*crypto.SignatureSecrets, numOfAccounts)
+	addresses := make([]basics.Address, numOfAccounts)
+	for i := 0; i < numOfAccounts; i++ {
+		secrets[i] = keypair()
+		addresses[i] = basics.Address(secrets[i].SignatureVerifier)
+	}
+	sender := addresses[0]
+	receiver := addresses[1]
+
+	l := makeMockLedger(t, initAcc(map[basics.Address]uint64{sender: 1 << 32, receiver: 1 << 32}))
+	cfg := config.GetDefaultLocal()
+	cfg.TxPoolSize = testPoolSize
+	cfg.EnableProcessBlockStats = false
+	transactionPool := MakeTransactionPool(l, cfg, logging.Base())
+
+	var lease [32]byte
+	crypto.RandBytes(lease[:])
+	leased := func(amount, fee uint64) transactions.SignedTxn {
+		tx := makePoolTestPayment(l, sender, receiver, amount, fee)
+		tx.Lease = lease
+		return tx.Sign(secrets[0])
+	}
+	requireReplaced := func(tx transactions.SignedTxn) {
+		t.Helper()
+		_, txErr, found := transactionPool.Lookup(tx.ID())
+		require.True(t, found)
+		require.Contains(t, txErr, "replaced")
+	}
+
+	t.Run("lease", func(t *testing.T) {
+		original := leased(1, proto.MinTxnFee)
+		require.NoError(t, transactionPool.RememberOne(original))
+
+		// Resubmitting it replaces nothing
+		require.Error(t, transactionPool.RememberOne(original))
+		require.Equal(t, [][]transactions.SignedTxn{{original}}, transactionPool.PendingTxGroups())
+
+		// The same fee does not replace, and the lease is taken
+		var feeErr *ErrReplacementFeeTooLow
+		sameFee := leased(2, proto.MinTxnFee)
+		require.ErrorAs(t, transactionPool.Test([]transactions.SignedTxn{sameFee}), &feeErr)
+		require.ErrorAs(t, transactionPool.RememberOne(sameFee), &feeErr)
+		require.Contains(t, transactionPool.PendingTxIDs(), original.ID())
+
+		// Too small a fee bump does not replace either, and the error tells
+		// the fee a replacement must pay
+		smallBump := leased(3, proto.MinTxnFee+proto.MinTxnFee/20)
+		err := transactionPool.RememberOne(smallBump)
+		require.ErrorAs(t, err, &feeErr)
+		require.ErrorContains(t, err, fmt.Sprintf("at least %d%% more", replaceFeeBumpPercent))
+		require.ErrorContains(t, err, fmt.Sprintf("%d in total", proto.MinTxnFee+proto.MinTxnFee*replaceFeeBumpPercent/100))
+		require.Contains(t, transactionPool.PendingTxIDs(), original.ID())
+
+		// A higher fee replaces, even with different contents
+		replacement := leased(4, proto.MinTxnFee+proto.MinTxnFee*replaceFeeBumpPercent/100)
+		require.NoError(t, transactionPool.Test([]transactions.SignedTxn{replacement}))
+		require.NoError(t, transactionPool.RememberOne(replacement))
+		require.Equal(t, [][]transactions.SignedTxn{{replacement}}, transactionPool.PendingTxGroups())
+		requireReplaced(original)
+
+		// Replacements survive recomputation
+		recomputePool(transactionPool)
+		require.Equal(t, [][]transactions.SignedTxn{{replacement}}, transactionPool.PendingTxGroups())
+	})
+
+	transactionPool.Reset()
+
+	t.Run("no lease", func(t *testing.T) {
+		original := makePoolTestPayment(l, sender, receiver, 1, proto.MinTxnFee).Sign(secrets[0])
+		require.NoError(t, transactionPool.RememberOne(original))
+
+		// Without a lease, a transaction of the same sender and validity
+		// range paying more is unrelated, and replaces nothing
+		other := makePoolTestPayment(l, sender, receiver, 2, 2*proto.MinTxnFee).Sign(secrets[0])
+		require.NoError(t, transactionPool.RememberOne(other))
+		require.ElementsMatch(t, []transactions.Txid{original.ID(), other.ID()}, transactionPool.PendingTxIDs())
+		_, txErr, found := transactionPool.Lookup(original.ID())
+		require.True(t, found)
+		require.Empty(t, txErr)
+
+		// nor does a leased one
+		replacement := leased(3, 4*proto.MinTxnFee)
+		require.NoError(t, transactionPool.RememberOne(replacement))
+		require.ElementsMatch(t, []transactions.Txid{original.ID(), other.ID(), replacement.ID()}, transactionPool.PendingTxIDs())
+	})
+
+	transactionPool.Reset()
+
+	t.Run("pending evaluator", func(t *testing.T) {
+		// The original spends most of the balance of the sender
+		original := leased(1<<32-1<<20, proto.MinTxnFee)
+		require.NoError(t, transactionPool.RememberOne(original))
+		spend := makePoolTestPayment(l, sender, receiver, 1<<31, proto.MinTxnFee).Sign(secrets[0])
+		require.Error(t, transactionPool.RememberOne(spend))
+
+		// Once replaced, the original is no longer proposed, and no longer
+		// counts against the balance of the sender
+		replacement := leased(1, 2*proto.MinTxnFee)
+		require.NoError(t, transactionPool.RememberOne(replacement))
+		requireReplaced(original)
+		block, err := transactionPool.AssembleBlock(transactionPool.pendingBlockEvaluator.Round(), time.Now().Add(time.Second))
+		require.NoError(t, err)
+		payset := block.Block().Payset
+		require.Len(t, payset, 1)
+		require.Equal(t, replacement.Sig, payset[0].Sig)
+		require.NoError(t, transactionPool.RememberOne(spend))
+	})
+
+	transactionPool.Reset()
+
+	t.Run("invalid replacement", func(t *testing.T) {
+		original := leased(1, proto.MinTxnFee)
+		require.NoError(t, transactionPool.RememberOne(original))
+
+		// The replacement overspends, so the original is left in place
+		overspend := leased(1<<33, 2*proto.MinTxnFee)
+		require.Error(t, transactionPool.RememberOne(overspend))
+		require.Equal(t, [][]transactions.SignedTxn{{original}}, transactionPool.PendingTxGroups())
+		_, txErr, found := transactionPool.Lookup(original.ID())
+		require.True(t, found)
+		require.Empty(t, txErr)
+	})
+
+	transactionPool.Reset()
+
+	t.Run("rejected along with the pending groups", func(t *testing.T) {
+		original := leased(1, proto.MinTxnFee)
+		require.NoError(t, transactionPool.RememberOne(original))
+
+		// Another pending group of the sender, paying the most, spends most
+		// of its balance
+		spendSigned := makePoolTestPayment(l, sender, receiver, 1<<32-1<<20, 10*proto.MinTxnFee).Sign(secrets[0])
+		require.NoError(t, transactionPool.RememberOne(spendSigned))
+
+		// The replacement is valid on its own, but not after that group, so
+		// the original stays in the pool
+		replacement := leased(1<<31, 2*proto.MinTxnFee)
+		require.ErrorContains(t, transactionPool.RememberOne(replacement), "replacement dropped from the pool")
+		require.ElementsMatch(t, []transactions.Txid{original.ID(), spendSigned.ID()}, transactionPool.PendingTxIDs())
+		_, txErr, found := transactionPool.Lookup(original.ID())
+		require.True(t, found)
+		require.Empty(t, txErr)
+	})
+
+	transactionPool.Reset()
+
+	t.Run("rate limit", func(t *testing.T) {
+		original := leased(1, proto.MinTxnFee)
+		require.NoError(t, transactionPool.RememberOne(original))
+		replacement := leased(2, 2*proto.MinTxnFee)
+		require.NoError(t, transactionPool.RememberOne(replacement))
+
+		// The sender cannot replace again in the same round
+		again := leased(3, 4*proto.MinTxnFee)
+		require.ErrorIs(t, transactionPool.Test([]transactions.SignedTxn{again}), ErrReplacementRateLimited)
+		require.ErrorIs(t, transactionPool.RememberOne(again), ErrReplacementRateLimited)
+		require.Equal(t, [][]transactions.SignedTxn{{replacement}}, transactionPool.PendingTxGroups())
+
+		// but can once a new block is added
+		prev, err := l.BlockHdr(l.Latest())
+		require.NoError(t, err)
+		eval, err := l.StartEvaluator(bookkeeping.MakeBlock(prev).BlockHeader, 0, 0, nil)
+		require.NoError(t, err)
+		blk, err := eval.GenerateBlock()
+		require.NoError(t, err)
+		require.NoError(t, l.AddValidatedBlock(*blk, agreement.Certificate{}))
+		transactionPool.OnNewBlock(blk.Block(), ledgercore.StateDelta{})
+		require.NoError(t, transactionPool.RememberOne(again))
+		require.Equal(t, [][]transactions.SignedTxn{{again}}, transactionPool.PendingTxGroups())
+		requireReplaced(replacement)
+	})
+
+	transactionPool.Reset()
+
+	t.Run("fee threshold", func(t *testing.T) {
+		original := leased(1, proto.MinTxnFee)
+		require.NoError(t, transactionPool.RememberOne(original))
+
+		// A replacement must also pay the fee the pool currently requires
+		transactionPool.mu.Lock()
+		transactionPool.feeThresholdMultiplier = 1000
+		transactionPool.mu.Unlock()
+		defer func() {
+			transactionPool.mu.Lock()
+			transactionPool.feeThresholdMultiplier = 0
+			transactionPool.mu.Unlock()
+		}()
+
+		replacement := leased(2, 2*proto.MinTxnFee)
+		var feeErr *ErrTxPoolFeeError
+		require.ErrorAs(t, transactionPool.Test([]transactions.SignedTxn{replacement}), &feeErr)
+		require.ErrorAs(t, transactionPool.RememberOne(replacement), &feeErr)
+		require.Equal(t, [][]transactions.SignedTxn{{original}}, transactionPool.PendingTxGroups())
+	})
+
+	transactionPool.Reset()
+
+	t.Run("pool size", func(t *testing.T) {
+		defer func(size int) { transactionPool.txPoolMaxSize = size }(transactionPool.txPoolMaxSize)
+		transactionPool.txPoolMaxSize = 1
+
+		original := leased(1, proto.MinTxnFee)
+		require.NoError(t, transactionPool.RememberOne(original))
+		require.ErrorIs(t, transactionPool.RememberOne(makePoolTestPayment(l, receiver, sender, 1, proto.MinTxnFee).Sign(secrets[1])), ErrPendingQueueReachedMaxCap)
+
+		// A replacement larger than what it evicts must fit in the pool
+		pay := makePoolTestPayment(l, sender, receiver, 1, 2*proto.MinTxnFee)
+		pay.Lease = lease
+		payBack := makePoolTestPayment(l, receiver, sender, 1, proto.MinTxnFee)
+		var group transactions.TxGroup
+		group.TxGroupHashes = []crypto.Digest{crypto.Digest(pay.ID()), crypto.Digest(payBack.ID())}
+		pay.Group = crypto.HashObj(group)
+		payBack.Group = pay.Group
+		txgroup := []transactions.SignedTxn{pay.Sign(secrets[0]), payBack.Sign(secrets[1])}
+		require.ErrorIs(t, transactionPool.Test(txgroup), ErrPendingQueueReachedMaxCap)
+		require.ErrorIs(t, transactionPool.Remember(txgroup), ErrPendingQueueReachedMaxCap)
+		require.Equal(t, [][]transactions.SignedTxn{{original}}, transactionPool.PendingTxGroups())
+
+		// A replacement of the same size fits in a full pool
+		replacement := leased(2, 2*proto.MinTxnFee)
+		require.NoError(t, transactionPool.RememberOne(replacement))
+		require.Equal(t, [][]transactions.SignedTxn{{replacement}}, transactionPool.PendingTxGroups())
+		requireReplaced(original)
+	})
+}
+
+func TestMinReplacementFee(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	require.Equal(t, uint64(1), minReplacementFee(0))
+	require.Equal(t, uint64(2), minReplacementFee(1))
+	require.Equal(t, uint64(1100), minReplacementFee(1000))
+	require.Equal(t, uint64(1102), minReplacementFee(1001))
+	require.Equal(t, uint64(math.MaxUint64), minReplacementFee(math.MaxUint64/10*10))
+	require.Equal(t, uint64(math.MaxUint64), minReplacementFee(math.MaxUint64))
+}
+
+func TestReplaceByFeeRoundLimit(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	numOfSenders := 2 * replaceMaxPerRound
+	secrets := make([]*crypto.SignatureSecrets, numOfSenders)
+	addresses := make([]basics.Address, numOfSenders)
+	for i := 0; i < numOfSenders; i++ {
+		secrets[i] = keypair()
+		addresses[i] = basics.Address(secrets[i].SignatureVerifier)
+	}
+	var receiver basics.Address
+	crypto.RandBytes(receiver[:])
+
+	l := makeMockLedger(t, initAccFixed(addresses, 1<<32))
+	cfg := config.GetDefaultLocal()
+	cfg.TxPoolSize = testPoolSize
+	cfg.EnableProcessBlockStats = false
+	transactionPool := MakeTransactionPool(l, cfg, logging.Base())
+
+	var lease [32]byte
+	crypto.RandBytes(lease[:])
+	leased := func(i int, fee uint64) transactions.SignedTxn {
+		tx := makePoolTestPayment(l, addresses[i], receiver, minBalance, fee)
+		tx.Lease = lease
+		return tx.Sign(secrets[i])
+	}
+	for i := range addresses {
+		require.NoError(t, transactionPool.RememberOne(leased(i, proto.MinTxnFee)))
+	}
+
+	// Every sender replaces its group at once, each within its own limit,
+	// but the pool only makes replaceMaxPerRound replacements
+	replacements := make([]transactions.SignedTxn, numOfSenders)
+	errs := make([]error, numOfSenders)
+	var wg sync.WaitGroup
+	for i := range addresses {
+		replacements[i] = leased(i, 2*proto.MinTxnFee)
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			errs[i] = transactionPool.RememberOne(replacements[i])
+		}(i)
+	}
+	wg.Wait()
+
+	var limited []int
+	for i, err := range errs {
+		if err != nil {
+			require.ErrorIs(t, err, ErrReplacementRoundLimited)
+			limited = append(limited, i)
+			continue
+		}
+		require.Contains(t, transactionPool.PendingTxIDs(), replacements[i].ID())
+	}
+	require.Len(t, limited, numOfSenders-replaceMaxPerRound)
+	require.Len(t, transactionPool.PendingTxIDs(), numOfSenders)
+	require.ErrorIs(t, transactionPool.Test([]transactions.SignedTxn{replacements[limited[0]]}), ErrReplacementRoundLimited)
+
+	// The limit is lifted once a new block is added
+	prev, err := l.BlockHdr(l.Latest())
+	require.NoError(t, err)
+	eval, err := l.StartEvaluator(bookkeeping.MakeBlock(prev).BlockHeader, 0, 0, nil)
+	require.NoError(t, err)
+	blk, err := eval.GenerateBlock()
+	require.NoError(t, err)
+	require.NoError(t, l.AddValidatedBlock(*blk, agreement.Certificate{}))
+	transactionPool.OnNewBlock(blk.Block(), ledgercore.StateDelta{})
+	require.NoError(t, transactionPool.RememberOne(replacements[limited[0]]))
+	require.Contains(t, transactionPool.PendingTxIDs(), replacements[limited[0]].ID())
+}
+
 func TestLogicSigOK(t *testing.T) {
 	partitiontest.PartitionTest(t)
 
@@ -949,6 +1359,7 @@ func TestTransactionPool_CurrentFeePerByte(t *testing.T) {
 	transactionPool := MakeTransactionPool(l, cfg, logging.Base())
 
 	for i, sender := range addresses {
+		fee := uint64(rand.Int()%10000) + proto.MinTxnFee
 		for j := 0; j < testPoolSize*15/len(addresses); j++ {
 			var receiver basics.Address
 			crypto.RandBytes(receiver[:])
@@ -956,7 +1367,7 @@ func TestTransactionPool_CurrentFeePerByte(t *testing.T) {
 				Type: protocol.PaymentTx,
 				Header: transactions.Header{
 					Sender:      sender,
-					Fee:         basics.MicroAlgos{Raw: uint64(rand.Int()%10000) + proto.MinTxnFee},
+					Fee:         basics.MicroAlgos{Raw: fee},
 					FirstValid:  0,
 					LastValid:   basics.Round(proto.MaxTxnLife),
 					Note:        make([]byte, 2),
@@ -1000,6 +1411,7 @@ func BenchmarkTransactionPoolRememberOne(b *testing.B) {
 	transactionPool := MakeTransactionPool(ledger, cfg, logging.Base())
 	signedTransactions := make([]transactions.SignedTxn, 0, b.N)
 	for i, sender := range addresses {
+		fee := uint64(rand.Int()%10000) + proto.MinTxnFee
 		for j := 0; j < b.N/len(addresses); j++ {
 			var receiver basics.Address
 			crypto.RandBytes(receiver[:])
@@ -1007,7 +1419,7 @@ func BenchmarkTransactionPoolRememberOne(b *testing.B) {
 				Type: protocol.PaymentTx,
 				Header: transactions.Header{
 					Sender:      sender,
-					Fee:         basics.MicroAlgos{Raw: uint64(rand.Int()%10000) + proto.MinTxnFee},
+					Fee:         basics.MicroAlgos{Raw: fee},
 					FirstValid:  0,
 					LastValid:   basics.Round(proto.MaxTxnLife),
 					Note:        make([]byte, 2),
@@ -1063,6 +1475,7 @@ func BenchmarkTransactionPoolPending(b *testing.B) {
 		block.Payset = make(transactions.Payset, 0)
 
 		for i, sender := range addresses {
+			fee := uint64(rand.Int()%10000) + proto.MinTxnFee
 			for j := 0; j < benchPoolSize/len(addresses); j++ {
 				var receiver basics.Address
 				crypto.RandBytes(receiver[:])
@@ -1070,7 +1483,7 @@ func BenchmarkTransactionPoolPending(b *testing.B) {
 					Type: protocol.PaymentTx,
 					Header: transactions.Header{
 						Sender:      sender,
-						Fee:         basics.MicroAlgos{Raw: uint64(rand.Int()%10000) + proto.MinTxnFee},
+						Fee:         basics.MicroAlgos{Raw: fee},
 						FirstValid:  0,
 						LastValid:   basics.Round(proto.MaxTxnLife),
 						Note:        make([]byte, 2),
@@ -1229,6 +1642,11 @@ func BenchmarkTransactionPoolSteadyState(b *testing.B) {
 	cfg.EnableProcessBlockStats = false
 	transactionPool := MakeTransactionPool(l, cfg, logging.Base())
 
+	fees := make([]uint64, numOfAccounts)
+	for i := range fees {
+		fees[i] = uint64(rand.Int()%10000) + proto.MinTxnFee
+	}
+
 	var signedTransactions []transactions.SignedTxn
 	for i := 0; i < b.N; i++ {
 		var receiver basics.Address
@@ -1237,7 +1655,7 @@ func BenchmarkTransactionPoolSteadyState(b *testing.B) {
 			Type: protocol.PaymentTx,
 			Header: transactions.Header{
 				Sender:      addresses[i%numOfAccounts],
-				Fee:         basics.MicroAlgos{Raw: uint64(rand.Int()%10000) + proto.MinTxnFee},
+				Fee:         basics.MicroAlgos{Raw: fees[i%numOfAccounts]},
 				FirstValid:  0,
 				LastValid:   basics.Round(proto.MaxTxnLife),
 				GenesisHash: l.GenesisHash(),
@@ -28,6 +28,7 @@ const (
 	boxRead
 	boxWrite
 	boxDelete
+	boxResize
 )
 
 func (cx *EvalContext) availableBox(name string, operation int, createSize uint64) ([]byte, bool, error) {
@@ -36,6 +37,11 @@ func (cx *EvalContext) availableBox(name string, operation int, createSize uint6
 	}
 
 	dirty, ok := cx.available.boxes[boxRef{cx.appID, name}]
+	if !ok && cx.UnnamedResources != nil && cx.UnnamedResources.AvailableBox(cx.appID, name) {
+		// Grant the I/O budget that naming the box would have provided.
+		cx.ioBudget += cx.Proto.BytesPerBoxReference
+		ok = true
+	}
 	if !ok {
 		return nil, false, fmt.Errorf("invalid Box reference %#x", name)
 	}
@@ -76,6 +82,13 @@ func (cx *EvalContext) availableBox(name string, operation int, createSize uint6
 			cx.available.dirtyBytes -= uint64(len(content))
 		}
 		dirty = false
+	case boxResize:
+		// The box is rewritten at its new size, createSize
+		if dirty {
+			cx.available.dirtyBytes -= uint64(len(content))
+		}
+		cx.available.dirtyBytes += createSize
+		dirty = true
 	case boxRead:
 		/* nothing to do */
 	}
@@ -189,6 +202,109 @@ func opBoxReplace(cx *EvalContext) error {
 	return cx.Ledger.SetBox(cx.appID, name, bytes)
 }
 
+func opBoxSplice(cx *EvalContext) error {
+	last := len(cx.stack) - 1 // replacement
+	prev := last - 1          // length
+	pprev := prev - 1         // start
+	ppprev := pprev - 1       // name
+
+	replacement := cx.stack[last].Bytes
+	length := cx.stack[prev].Uint
+	start := cx.stack[pprev].Uint
+	name := string(cx.stack[ppprev].Bytes)
+
+	err := argCheck(cx, name, 0)
+	if err != nil {
+		return err
+	}
+
+	contents, exists, err := cx.availableBox(name, boxWrite, 0 /* size is already known */)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return fmt.Errorf("no such box %#x", name)
+	}
+
+	bytes, err := spliceCarefully(contents, replacement, start, length)
+	if err != nil {
+		return err
+	}
+	cx.stack = cx.stack[:ppprev]
+	return cx.Ledger.SetBox(cx.appID, name, bytes)
+}
+
+// spliceCarefully is used to make a NEW byteslice copy of original, with
+// replacement written over the length bytes starting at start. The returned
+// slice is always the size of original, so zero bytes are shifted in at the
+// end, or bytes are shifted out of the end, as needed.
+func spliceCarefully(original []byte, replacement []byte, start uint64, length uint64) ([]byte, error) {
+	if start > uint64(len(original)) {
+		return nil, fmt.Errorf("splice start %d beyond length: %d", start, len(original))
+	}
+	end := start + length
+	if end < start {
+		return nil, fmt.Errorf("splice end exceeds uint64")
+	}
+	if end > uint64(len(original)) {
+		return nil, fmt.Errorf("splice end %d beyond original length: %d", end, len(original))
+	}
+	if start+uint64(len(replacement)) > uint64(len(original)) {
+		return nil, fmt.Errorf("splice replacement end %d beyond original length: %d",
+			start+uint64(len(replacement)), len(original))
+	}
+
+	// Do NOT use the append trick to make a copy here.
+	// append(nil, []byte{}...) would return a nil, which means "not a bytearray" to AVM.
+	clone := make([]byte, len(original))
+	copy(clone[:start], original)
+	copied := copy(clone[start:], replacement)
+	// Whatever follows the replaced range moves to just after the replacement.
+	// If that leaves room at the end, it remains zeroed; if it doesn't fit,
+	// its tail is dropped.
+	copy(clone[start+uint64(copied):], original[end:])
+	return clone, nil
+}
+
+func opBoxResize(cx *EvalContext) error {
+	last := len(cx.stack) - 1 // size
+	prev := last - 1          // name
+
+	name := string(cx.stack[prev].Bytes)
+	size := cx.stack[last].Uint
+
+	err := argCheck(cx, name, size)
+	if err != nil {
+		return err
+	}
+
+	contents, exists, err := cx.availableBox(name, boxResize, size)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return fmt.Errorf("no such box %#x", name)
+	}
+
+	resized := make([]byte, size)
+	copy(resized, contents)
+
+	// Deleting and recreating the box keeps the box totals of the app account,
+	// and so its minimum balance requirement, in step with the new size.
+	appAddr := cx.getApplicationAddress(cx.appID)
+	_, err = cx.Ledger.DelBox(cx.appID, name, appAddr)
+	if err != nil {
+		return err
+	}
+	err = cx.Ledger.NewBox(cx.appID, name, resized, appAddr)
+	if err != nil {
+		return err
+	}
+
+	cx.stack = cx.stack[:prev]
+	return nil
+}
+
 func opBoxDel(cx *EvalContext) error {
 	last := len(cx.stack) - 1 // name
 	name := string(cx.stack[last].Bytes)
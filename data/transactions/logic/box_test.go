@@ -141,6 +141,92 @@ func TestBoxReadWrite(t *testing.T) {
 		"invalid Box reference")
 }
 
+func TestBoxSplice(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	ep, txn, ledger := MakeSampleEnv()
+
+	ledger.NewApp(txn.Sender, 888, basics.AppParams{})
+	TestApp(t, `byte "self"; int 8; box_create; assert
+                byte "self"; byte "abcdefgh"; box_put; int 1`, ep)
+
+	// A longer replacement shifts the tail right, losing bytes off the end.
+	TestApp(t, `byte "self"; int 2; int 2; byte "XYZ"; box_splice;
+                byte "self"; box_get; assert; byte "abXYZefg"; ==`, ep)
+	// A shorter replacement shifts the tail left, zero filling the end.
+	TestApp(t, `byte "self"; int 0; int 3; byte "Q"; box_splice;
+                byte "self"; box_get; assert; byte 0x51595a6566670000; ==`, ep)
+	// Equal lengths work like box_replace
+	TestApp(t, `byte "self"; int 6; int 2; byte 0x6869; box_splice;
+                byte "self"; box_get; assert; byte "QYZefghi"; ==`, ep)
+	// Deleting from the middle, and inserting at the end are allowed
+	TestApp(t, `byte "self"; int 1; int 2; byte ""; box_splice;
+                byte "self"; box_get; assert; byte 0x5165666768690000; ==`, ep)
+	TestApp(t, `byte "self"; int 8; int 0; byte ""; box_splice;
+                byte "self"; box_len; assert; int 8; ==`, ep)
+
+	TestApp(t, `byte "self"; int 9; int 0; byte ""; box_splice; int 1`, ep,
+		"splice start 9 beyond length")
+	TestApp(t, `byte "self"; int 4; int 5; byte ""; box_splice; int 1`, ep,
+		"splice end 9 beyond original length")
+	TestApp(t, `byte "self"; int 4; int 0xffffffffffffffff; byte ""; box_splice; int 1`, ep,
+		"splice end exceeds uint64")
+	TestApp(t, `byte "self"; int 4; int 0; byte "12345"; box_splice; int 1`, ep,
+		"splice replacement end 9 beyond original length")
+
+	ledger.DelBoxes(888, "self")
+	TestApp(t, `byte "self"; int 1; int 1; byte 0x3031; box_splice; int 1`, ep,
+		"no such box")
+	TestApp(t, `byte "junk"; int 1; int 1; byte 0x3031; box_splice; int 1`, ep,
+		"invalid Box reference")
+}
+
+func TestBoxResize(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	ep, txn, ledger := MakeSampleEnv()
+
+	ledger.NewApp(txn.Sender, 888, basics.AppParams{})
+	TestApp(t, `byte "self"; int 4; box_create; assert
+                byte "self"; byte 0x01020304; box_put; int 1`, ep)
+
+	// Growing keeps the contents, and adds zeros to the end
+	TestApp(t, `byte "self"; int 6; box_resize;
+                byte "self"; box_get; assert; byte 0x010203040000; ==`, ep)
+	TestApp(t, `int 888; app_params_get AppAddress; assert;
+                acct_params_get AcctTotalBoxBytes; pop; int 10; ==`, ep)
+
+	// Shrinking removes bytes from the end
+	TestApp(t, `byte "self"; int 2; box_resize;
+                byte "self"; box_get; assert; byte 0x0102; ==`, ep)
+	TestApp(t, `int 888; app_params_get AppAddress; assert;
+                acct_params_get AcctTotalBoxBytes; pop; int 6; ==`, ep)
+	TestApp(t, `int 888; app_params_get AppAddress; assert;
+                acct_params_get AcctTotalBoxes; pop; int 1; ==`, ep)
+
+	// A resize to the same size is harmless, and zero is a legal size
+	TestApp(t, `byte "self"; int 2; box_resize;
+                byte "self"; box_get; assert; byte 0x0102; ==`, ep)
+	TestApp(t, `byte "self"; int 0; box_resize;
+                byte "self"; box_len; assert; !`, ep)
+
+	// The new size counts against the write budget (two refs, 200 bytes)
+	TestApp(t, `byte "self"; int 200; box_resize; int 1`, ep)
+	TestApp(t, `byte "self"; int 201; box_resize; int 1`, ep, "write budget")
+	// Resizing after a write does not double count the box
+	TestApp(t, `byte "self"; int 0; int 1; byte 0x01; box_splice;
+                byte "self"; int 200; box_resize; int 1`, ep)
+
+	TestApp(t, `byte "self"; int 1001; box_resize; int 1`, ep,
+		"box size too large")
+
+	ledger.DelBoxes(888, "self")
+	TestApp(t, `byte "self"; int 8; box_resize; int 1`, ep, "no such box")
+	TestApp(t, `byte "junk"; int 8; box_resize; int 1`, ep, "invalid Box reference")
+}
+
 func TestBoxAcrossTxns(t *testing.T) {
 	partitiontest.PartitionTest(t)
 	t.Parallel()
@@ -217,6 +303,8 @@ func TestBoxUnavailableWithClearState(t *testing.T) {
 		"box_len":     `byte "self"; box_len`,
 		"box_put":     `byte "put"; byte "self"; box_put`,
 		"box_replace": `byte "self"; int 0; byte "new"; box_replace`,
+		"box_splice":  `byte "self"; int 0; int 1; byte "x"; box_splice`,
+		"box_resize":  `byte "self"; int 10; box_resize`,
 	}
 
 	for name, program := range tests {
@@ -523,6 +611,8 @@ func TestEarlyPanics(t *testing.T) {
 		"box_len":     `byte "%s"; box_len`,
 		"box_put":     `byte "%s"; byte "hello"; box_put`,
 		"box_replace": `byte "%s"; int 0; byte "new"; box_replace`,
+		"box_splice":  `byte "%s"; int 0; int 1; byte "x"; box_splice`,
+		"box_resize":  `byte "%s"; int 10; box_resize`,
 	}
 
 	for name, program := range tests {
@@ -290,6 +290,12 @@ type EvalParams struct {
 	// optional tracer
 	Tracer EvalTracer
 
+	// UnnamedResources, if set, is consulted before rejecting access to a
+	// resource that is not available to the transaction. It is only meant for
+	// simulation, to discover the resources a group needs. It is not passed on
+	// to inner transactions, whose resources are named by their caller.
+	UnnamedResources UnnamedResourcePolicy
+
 	// MinAvmVersion is the minimum allowed AVM version of this program.
 	// The program must reject if its version is less than this version. If
 	// MinAvmVersion is nil, we will compute it ourselves
@@ -629,6 +635,10 @@ func (cx *EvalContext) RunMode() RunMode {
 // PC returns the program counter of the current application being evaluated
 func (cx *EvalContext) PC() int { return cx.pc }
 
+// SharesResources reports whether the program being evaluated may use resources
+// made available by other transactions of its group.
+func (cx *EvalContext) SharesResources() bool { return cx.version >= sharedResourcesVersion }
+
 // avmType describes the type of a value on the operand stack
 // avmTypes are a subset of StackTypes
 type avmType byte
@@ -4353,6 +4363,10 @@ func (cx *EvalContext) availableAccount(addr basics.Address) bool {
 		return true
 	}
 
+	if cx.UnnamedResources != nil && cx.version >= directRefEnabledVersion {
+		return cx.UnnamedResources.AvailableAccount(addr)
+	}
+
 	return false
 }
 
@@ -5212,6 +5226,10 @@ func (cx *EvalContext) availableAsset(aid basics.AssetIndex) bool {
 		}
 	}
 
+	if cx.UnnamedResources != nil && cx.version >= directRefEnabledVersion {
+		return cx.UnnamedResources.AvailableAsset(aid)
+	}
+
 	return false
 }
 
@@ -5256,6 +5274,10 @@ func (cx *EvalContext) availableApp(aid basics.AppIndex) bool {
 		}
 	}
 
+	if cx.UnnamedResources != nil && cx.version >= directRefEnabledVersion {
+		return cx.UnnamedResources.AvailableApp(aid)
+	}
+
 	return false
 }
 
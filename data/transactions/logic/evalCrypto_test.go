@@ -17,6 +17,7 @@
 package logic
 
 import (
+	"bytes"
 	"crypto/ecdsa"
 	"crypto/elliptic"
 	"crypto/rand"
@@ -25,11 +26,9 @@ import (
 	"encoding/hex"
 	"fmt"
 	"math/big"
-	mrand "math/rand"
 	"strconv"
 	"testing"
 
-	"github.com/consensys/gnark-crypto/ecc/bn254"
 	"github.com/stretchr/testify/require"
 
 	"github.com/algorand/go-algorand/crypto"
@@ -872,131 +871,53 @@ int 1`
 	})
 }
 
-type benchmarkBn256Data struct {
-	a        []byte
-	k        []byte
-	g1       []byte
-	g2       []byte
-	programs []byte
-}
-
-func benchmarkBn256DataGenData(b *testing.B) (data []benchmarkBn256Data) {
-	data = make([]benchmarkBn256Data, b.N)
-	var g1Gen bn254.G1Jac
-	var g1GenAff bn254.G1Affine
-	g1Gen.X.SetString("1")
-	g1Gen.Y.SetString("2")
-	g1Gen.Z.SetString("1")
-	g1GenAff.FromJacobian(&g1Gen)
-	var a bn254.G1Affine
-	a.ScalarMultiplication(&g1GenAff, new(big.Int).SetUint64(mrand.Uint64()))
-
-	for i := 0; i < b.N; i++ {
-		var a bn254.G1Affine
-		a.ScalarMultiplication(&g1GenAff, new(big.Int).SetUint64(mrand.Uint64()))
-
-		data[i].a = bN254G1ToBytes(&a)
-		data[i].k = new(big.Int).SetUint64(mrand.Uint64()).Bytes()
-
-		// Pair one g1 and one g2
-		data[i].g1, _ = hex.DecodeString("0ebc9fc712b13340c800793386a88385e40912a21bacad2cc7db17d36e54c802238449426931975cced7200f08681ab9a86a2e5c2336cf625451cf2413318e32")
-		data[i].g2, _ = hex.DecodeString("217fbd9a9db5719cfbe3580e3d8750cada058fdfffe95c440a0528ffc608f36e05d6a67604658d40b3e4cac3c46150f2702d87739b7774d79a8147f7271773b420f9429ee13c1843404bfd70e75efa886c173e57dde32970274d8bc53dfd562403f6276318990d053785b4ca342ebc4581a23a39285804bb74e079aa2ef3ba66")
+// BenchmarkEc measures the ec_ opcodes for each group, to set their costs.
+// Pairing checks and multi-scalar multiplications are run with different
+// numbers of points, so the per-point cost can be separated from the base.
+func BenchmarkEc(b *testing.B) {
+	if pairingVersion > LogicVersion {
+		b.Skip()
 	}
-	return data
-}
+	for _, spec := range ecSpecs {
+		g := spec.field
+		gen, double, _, _ := ecTestPoints(g)
+		other, _, _, _ := ecTestPoints(ecOther(g))
 
-func benchmarkBn256(b *testing.B, source string) {
-	data := benchmarkBn256DataGenData(b)
-	ops, err := AssembleStringWithVersion(source, pairingVersion)
-	require.NoError(b, err)
-	for i := 0; i < b.N; i++ {
-		data[i].programs = ops.Program
-	}
+		scalar := make([]byte, scalarSize)
+		_, err := rand.Read(scalar)
+		require.NoError(b, err)
 
-	b.ResetTimer()
-	for i := 0; i < b.N; i++ {
-		var txn transactions.SignedTxn
-		txn.Lsig.Logic = data[i].programs
-		txn.Lsig.Args = [][]byte{data[i].a, data[i].k, data[i].g1, data[i].g2}
-		ep := defaultEvalParams(txn)
-		pass, err := EvalSignature(0, ep)
-		if !pass {
-			b.Log(hex.EncodeToString(data[i].programs))
-			b.Log(ep.Trace.String())
+		fe := make([]byte, len(ecModulus(g)))
+		if g == BN254g2 || g == BLS12_381g2 {
+			fe = make([]byte, 2*len(ecModulus(g)))
 		}
-		if err != nil {
-			require.NoError(b, err)
+		fe[len(fe)-1] = 0x11
+
+		b.Run(fmt.Sprintf("ec_add %s", g), func(b *testing.B) {
+			benchmarkOperation(b, fmt.Sprintf("byte 0x%x", gen), fmt.Sprintf("byte 0x%x; ec_add %s", double, g), "pop; int 1")
+		})
+		b.Run(fmt.Sprintf("ec_scalar_mul %s", g), func(b *testing.B) {
+			benchmarkOperation(b, fmt.Sprintf("byte 0x%x", gen), fmt.Sprintf("byte 0x%x; ec_scalar_mul %s", scalar, g), "pop; int 1")
+		})
+		for _, n := range []int{1, 2, 4} {
+			points := bytes.Repeat(gen, n)
+			others := bytes.Repeat(other, n)
+			b.Run(fmt.Sprintf("ec_pairing_check %s %d", g, n), func(b *testing.B) {
+				benchmarkOperation(b, "", fmt.Sprintf("byte 0x%x; byte 0x%x; ec_pairing_check %s; pop", points, others, g), "int 1")
+			})
 		}
-		if !pass {
-			require.True(b, pass)
+		for _, n := range []int{1, 8, 32} {
+			points := bytes.Repeat(gen, n)
+			scalars := bytes.Repeat(scalar, n)
+			b.Run(fmt.Sprintf("ec_multi_scalar_mul %s %d", g, n), func(b *testing.B) {
+				benchmarkOperation(b, "", fmt.Sprintf("byte 0x%x; byte 0x%x; ec_multi_scalar_mul %s; pop", points, scalars, g), "int 1")
+			})
 		}
+		b.Run(fmt.Sprintf("ec_subgroup_check %s", g), func(b *testing.B) {
+			benchmarkOperation(b, "", fmt.Sprintf("byte 0x%x; ec_subgroup_check %s; pop", gen, g), "int 1")
+		})
+		b.Run(fmt.Sprintf("ec_map_to %s", g), func(b *testing.B) {
+			benchmarkOperation(b, "", fmt.Sprintf("byte 0x%x; ec_map_to %s; pop", fe, g), "int 1")
+		})
 	}
 }
-
-func BenchmarkBn256AddRaw(b *testing.B) {
-	data := benchmarkBn256DataGenData(b)
-	a1 := bytesToBN254G1(data[0].g1)
-	a2 := bytesToBN254G1(data[0].g1)
-
-	b.ResetTimer()
-	for i := 0; i < b.N; i++ {
-		_ = new(bn254.G1Affine).Add(&a1, &a2)
-	}
-}
-
-func BenchmarkBn256AddWithMarshal(b *testing.B) {
-	b.ResetTimer()
-	var v [][]byte
-	v = make([][]byte, b.N)
-	g1, _ := hex.DecodeString("0ebc9fc712b13340c800793386a88385e40912a21bacad2cc7db17d36e54c802238449426931975cced7200f08681ab9a86a2e5c2336cf625451cf2413318e32")
-
-	for i := 0; i < b.N; i++ {
-		a1 := bytesToBN254G1(g1)
-		a2 := bytesToBN254G1(g1)
-		r := new(bn254.G1Affine).Add(&a1, &a2)
-		v[i] = r.Marshal()
-	}
-}
-
-func BenchmarkBn256PairingRaw(b *testing.B) {
-	data := benchmarkBn256DataGenData(b)
-	g1s := bytesToBN254G1s(data[0].g1)
-	g2s := bytesToBN254G2s(data[0].g2)
-
-	b.ResetTimer()
-	for i := 0; i < b.N; i++ {
-		ok, _ := bn254.PairingCheck(g1s, g2s)
-		require.False(b, ok)
-	}
-}
-
-func BenchmarkBn256(b *testing.B) {
-	if pairingVersion > LogicVersion {
-		b.Skip()
-	}
-	b.Run("bn256 add", func(b *testing.B) {
-		benchmarkOperation(b, "byte 0x0ebc9fc712b13340c800793386a88385e40912a21bacad2cc7db17d36e54c802238449426931975cced7200f08681ab9a86a2e5c2336cf625451cf2413318e32", "dup; bn256_add", "pop; int 1")
-	})
-
-	b.Run("bn256 scalar mul", func(b *testing.B) {
-		source := `
-arg 0
-arg 1
-bn256_scalar_mul
-pop
-int 1
-`
-		benchmarkBn256(b, source)
-	})
-
-	b.Run("bn256 pairing", func(b *testing.B) {
-		source := `
-arg 2
-arg 3
-bn256_pairing
-pop
-int 1
-`
-		benchmarkBn256(b, source)
-	})
-}
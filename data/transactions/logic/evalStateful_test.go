@@ -354,6 +354,9 @@ log
 			"byte 0x1234; box_len":                        8,
 			"byte 0x1234; box_get":                        8,
 			"byte 0x1234; byte 0x12; box_put":             8,
+
+			"byte 0x1234; int 12; int 4; byte 0x24; box_splice": 10,
+			"byte 0x1234; int 12; box_resize":                   10,
 		}
 		for source, introduced := range statefulOpcodeCalls {
 			if v < introduced {
@@ -2730,9 +2733,12 @@ func TestReturnTypes(t *testing.T) {
 		"frame_dig":  true, // would need a "proto" subroutine
 		"frame_bury": true, // would need a "proto" subroutine
 
-		"bn256_add":        true,
-		"bn256_scalar_mul": true,
-		"bn256_pairing":    true,
+		"ec_add":              true,
+		"ec_scalar_mul":       true,
+		"ec_pairing_check":    true,
+		"ec_multi_scalar_mul": true,
+		"ec_subgroup_check":   true,
+		"ec_map_to":           true,
 	}
 
 	byName := OpsByName[LogicVersion]
@@ -3284,3 +3290,67 @@ func TestTxnaLimits(t *testing.T) {
 		testApp(t, "txna ApplicationArgs "+strconv.Itoa(len(tx.ApplicationArgs))+";len", ep, "invalid ApplicationArgs index")
 	})
 }
+
+func TestBoxSpliceResizeEdges(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	// Each case starts from a fresh "self" box of the given size, filled
+	// with 0x01 bytes. Ten extra box refs raise the write budget to 1200,
+	// enough to write a box of MaxBoxSize (1000).
+	cases := []struct {
+		size     int
+		program  string
+		problems []string
+	}{
+		// A zero length box can only be spliced with an empty replacement at 0.
+		{0, `byte "self"; int 0; int 0; byte ""; box_splice; byte "self"; box_len; assert; !`, nil},
+		{0, `byte "self"; int 0; int 0; byte "a"; box_splice; int 1`, []string{"splice replacement end 1 beyond original length: 0"}},
+		{0, `byte "self"; int 1; int 0; byte ""; box_splice; int 1`, []string{"splice start 1 beyond length: 0"}},
+		{0, `byte "self"; int 3; box_resize; byte "self"; box_get; assert; byte 0x000000; ==`, nil},
+		{3, `byte "self"; int 0; box_resize; byte "self"; box_get; assert; len; !`, nil},
+
+		// Boxes of the maximum size can be spliced, and resized to and from it.
+		{1000, `byte "self"; int 999; int 1; byte "Z"; box_splice;
+                byte "self"; int 998; int 2; box_extract; byte 0x015a; ==`, nil},
+		{1000, `byte "self"; int 0; int 1; byte "ab"; box_splice;
+                byte "self"; int 0; int 3; box_extract; byte 0x616201; ==`, nil},
+		{1000, `byte "self"; int 1001; box_resize; int 1`, []string{"box size too large"}},
+		{10, `byte "self"; int 1000; box_resize; byte "self"; box_len; assert; int 1000; ==`, nil},
+		{1000, `byte "self"; int 1; box_resize; byte "self"; box_get; assert; byte 0x01; ==`, nil},
+
+		// A replacement longer than the range shifts the tail right, dropping its end.
+		{6, `byte "self"; int 1; int 1; byte "abc"; box_splice;
+             byte "self"; box_get; assert; byte 0x016162630101; ==`, nil},
+		// A replacement shorter than the range shifts the tail left, zero filling the end.
+		{6, `byte "self"; int 1; int 3; byte "a"; box_splice;
+             byte "self"; box_get; assert; byte 0x016101010000; ==`, nil},
+		// An empty replacement deletes the range.
+		{6, `byte "self"; int 0; int 6; byte ""; box_splice;
+             byte "self"; box_get; assert; byte 0x000000000000; ==`, nil},
+		// The replacement must still fit in the box.
+		{6, `byte "self"; int 4; int 1; byte "abc"; box_splice; int 1`, []string{"splice replacement end 7 beyond original length: 6"}},
+
+		// Out of range starts fail, at the end is allowed.
+		{6, `byte "self"; int 6; int 0; byte ""; box_splice; byte "self"; box_len; assert; int 6; ==`, nil},
+		{6, `byte "self"; int 7; int 0; byte ""; box_splice; int 1`, []string{"splice start 7 beyond length: 6"}},
+		{6, `byte "self"; int 0xffffffffffffffff; int 1; byte ""; box_splice; int 1`, []string{"beyond length: 6"}},
+		{6, `byte "self"; int 5; int 2; byte ""; box_splice; int 1`, []string{"splice end 7 beyond original length: 6"}},
+	}
+
+	for i, tc := range cases {
+		tc := tc
+		t.Run(fmt.Sprintf("%d", i), func(t *testing.T) {
+			t.Parallel()
+			ep, txn, ledger := makeSampleEnv()
+			txn.Boxes = append(txn.Boxes, make([]transactions.BoxRef, 10)...)
+			ledger.NewApp(txn.Sender, 888, basics.AppParams{})
+			setup := fmt.Sprintf(`byte "self"; int %d; box_create; assert;`, tc.size)
+			if tc.size > 0 {
+				setup += fmt.Sprintf(`byte "self"; byte 0x%s; box_put;`, strings.Repeat("01", tc.size))
+			}
+			testApp(t, setup+"int 1", ep)
+			testApp(t, tc.program, ep, tc.problems...)
+		})
+	}
+}
@@ -23,7 +23,7 @@ import (
 	"github.com/algorand/go-algorand/protocol"
 )
 
-//go:generate stringer -type=TxnField,GlobalField,AssetParamsField,AppParamsField,AcctParamsField,AssetHoldingField,OnCompletionConstType,EcdsaCurve,Base64Encoding,JSONRefType,VrfStandard,BlockField -output=fields_string.go
+//go:generate stringer -type=TxnField,GlobalField,AssetParamsField,AppParamsField,AcctParamsField,AssetHoldingField,OnCompletionConstType,EcdsaCurve,EC,Base64Encoding,JSONRefType,VrfStandard,BlockField -output=fields_string.go
 
 // FieldSpec unifies the various specs for assembly, disassembly, and doc generation.
 type FieldSpec interface {
@@ -676,6 +676,75 @@ var EcdsaCurves = FieldGroup{
 	ecdsaCurveSpecByName,
 }
 
+// EC is an enum for the `ec_` opcodes
+type EC int
+
+const (
+	// BN254g1 is the G1 group of BN254
+	BN254g1 EC = iota
+	// BN254g2 is the G2 group of BN254
+	BN254g2
+	// BLS12_381g1 is the G1 group of BLS 12-381
+	BLS12_381g1
+	// BLS12_381g2 is the G2 group of BLS 12-381
+	BLS12_381g2
+	invalidEC // compile-time constant for number of fields
+)
+
+var ecGroupNames [invalidEC]string
+
+type ecSpec struct {
+	field   EC
+	version uint64
+	doc     string
+}
+
+func (fs ecSpec) Field() byte {
+	return byte(fs.field)
+}
+func (fs ecSpec) Type() StackType {
+	return StackNone // Will not show, since all are untyped
+}
+func (fs ecSpec) OpVersion() uint64 {
+	return pairingVersion
+}
+func (fs ecSpec) Version() uint64 {
+	return fs.version
+}
+func (fs ecSpec) Note() string {
+	return fs.doc
+}
+
+var ecSpecs = [...]ecSpec{
+	{BN254g1, pairingVersion, "G1 of the BN254 curve. Points encoded as 32 byte X followed by 32 byte Y"},
+	{BN254g2, pairingVersion, "G2 of the BN254 curve. Points encoded as 64 byte X followed by 64 byte Y"},
+	{BLS12_381g1, pairingVersion, "G1 of the BLS 12-381 curve. Points encoded as 48 byte X followed by 48 byte Y"},
+	{BLS12_381g2, pairingVersion, "G2 of the BLS 12-381 curve. Points encoded as 96 byte X followed by 96 byte Y"},
+}
+
+func ecSpecByField(c EC) (ecSpec, bool) {
+	if int(c) >= len(ecSpecs) {
+		return ecSpec{}, false
+	}
+	return ecSpecs[c], true
+}
+
+var ecSpecByName = make(ecNameSpecMap, len(ecGroupNames))
+
+type ecNameSpecMap map[string]ecSpec
+
+func (s ecNameSpecMap) get(name string) (FieldSpec, bool) {
+	fs, ok := s[name]
+	return fs, ok
+}
+
+// EcGroups collects details about the constants used to describe EcGroups
+var EcGroups = FieldGroup{
+	"EC", "Groups",
+	ecGroupNames[:],
+	ecSpecByName,
+}
+
 // Base64Encoding is an enum for the `base64decode` opcode
 type Base64Encoding int
 
@@ -1323,6 +1392,13 @@ func init() {
 		ecdsaCurveSpecByName[s.field.String()] = s
 	}
 
+	equal(len(ecSpecs), len(ecGroupNames))
+	for i, s := range ecSpecs {
+		equal(int(s.field), i)
+		ecGroupNames[s.field] = s.field.String()
+		ecSpecByName[s.field.String()] = s
+	}
+
 	equal(len(base64EncodingSpecs), len(base64EncodingNames))
 	for i, s := range base64EncodingSpecs {
 		equal(int(s.field), i)
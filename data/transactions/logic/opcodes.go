@@ -71,10 +71,11 @@ const sharedResourcesVersion = 9 // apps can access resources from other transac
 // EXPERIMENTAL. These should be revisited whenever a new LogicSigVersion is
 // moved from vFuture to a new consensus version. If they remain unready, bump
 // their version, and fixup TestAssemble() in assembler_test.go.
-const pairingVersion = 10 // bn256 opcodes. will add bls12-381, and unify the available opcodes.
+const pairingVersion = 10 // ec_ opcodes for BN254 and BLS12-381
 
 // Unlimited Global Storage opcodes
-const boxVersion = 8 // box_*
+const boxVersion = 8     // box_*
+const spliceVersion = 10 // box_splice, box_resize
 
 type linearCost struct {
 	baseCost  int
@@ -150,7 +151,7 @@ func (d *OpDetails) docCost(argLen int) string {
 				if !ok {
 					continue
 				}
-				cost += fmt.Sprintf(" %s=%d", name, imm.fieldCosts[fs.Field()])
+				cost += fmt.Sprintf(" %s=%s", name, imm.fieldCosts[fs.Field()].docCost(argLen))
 			}
 		}
 	}
@@ -169,7 +170,8 @@ func (d *OpDetails) Cost(program []byte, pc int, stack []stackValue) int {
 	}
 	for i := range d.Immediates {
 		if d.Immediates[i].fieldCosts != nil {
-			cost += d.Immediates[i].fieldCosts[program[pc+1+i]]
+			lc := d.Immediates[i].fieldCosts[program[pc+1+i]]
+			cost += lc.compute(stack)
 		}
 	}
 	return cost
@@ -290,8 +292,26 @@ func (d OpDetails) field(name string, group *FieldGroup) OpDetails {
 func costByField(immediate string, group *FieldGroup, costs []int) OpDetails {
 	opd := immediates(immediate).costs(0)
 	opd.Immediates[0].Group = group
-	fieldCosts := make([]int, 256)
-	copy(fieldCosts, costs)
+	fieldCosts := make([]linearCost, 256)
+	for i, cost := range costs {
+		fieldCosts[i] = linearCost{baseCost: cost}
+	}
+	opd.Immediates[0].fieldCosts = fieldCosts
+	return opd
+}
+
+// costByFieldAndLength is like costByField, but each field has a linear cost
+// that depends on the length of a stack argument, as in costByLength.
+func costByFieldAndLength(immediate string, group *FieldGroup, costs []linearCost) OpDetails {
+	opd := immediates(immediate).costs(0)
+	opd.Immediates[0].Group = group
+	fieldCosts := make([]linearCost, 256)
+	for i, cost := range costs {
+		if cost.baseCost < 1 || cost.chunkCost <= 0 || cost.chunkSize < 1 || cost.chunkSize > maxStringSize {
+			panic("bad cost configuration")
+		}
+		fieldCosts[i] = cost
+	}
 	opd.Immediates[0].fieldCosts = fieldCosts
 	return opd
 }
@@ -347,7 +367,7 @@ type immediate struct {
 	Group *FieldGroup
 
 	// If non-nil, always 256 long, so cost can be checked before eval
-	fieldCosts []int
+	fieldCosts []linearCost
 }
 
 func imm(name string, kind immKind) immediate {
@@ -607,10 +627,6 @@ var OpSpecs = []OpSpec{
 	{0x98, "sha3_256", opSHA3_256, proto("b:b"), unlimitedStorage, costByLength(58, 4, 8)},},
 	*/
 
-	{0x99, "bn256_add", opBn256Add, proto("bb:b"), pairingVersion, costly(70)},
-	{0x9a, "bn256_scalar_mul", opBn256ScalarMul, proto("bb:b"), pairingVersion, costly(970)},
-	{0x9b, "bn256_pairing", opBn256Pairing, proto("bb:i"), pairingVersion, costly(8700)},
-
 	// Byteslice math.
 	{0xa0, "b+", opBytesPlus, proto("II:b"), 4, costly(10).typed(typeByteMath(maxByteMathSize + 1))},
 	{0xa1, "b-", opBytesMinus, proto("II:I"), 4, costly(10)},
@@ -661,6 +677,42 @@ var OpSpecs = []OpSpec{
 	// randomness support
 	{0xd0, "vrf_verify", opVrfVerify, proto("bbb:bT"), randomnessVersion, field("s", &VrfStandards).costs(5700)},
 	{0xd1, "block", opBlock, proto("i:a"), randomnessVersion, field("f", &BlockFields)},
+
+	// Boxes, continued
+	{0xd2, "box_splice", opBoxSplice, proto("Niib:"), spliceVersion, only(ModeApp)},
+	{0xd3, "box_resize", opBoxResize, proto("Ni:"), spliceVersion, only(ModeApp)},
+
+	// Elliptic curve groups
+	{0xe0, "ec_add", opEcAdd, proto("bb:b"), pairingVersion,
+		costByField("g", &EcGroups, []int{
+			BN254g1: 125, BN254g2: 170,
+			BLS12_381g1: 205, BLS12_381g2: 290})},
+	{0xe1, "ec_scalar_mul", opEcScalarMul, proto("bb:b"), pairingVersion,
+		costByField("g", &EcGroups, []int{
+			BN254g1: 1810, BN254g2: 3430,
+			BLS12_381g1: 2950, BLS12_381g2: 6530})},
+	{0xe2, "ec_pairing_check", opEcPairingCheck, proto("bb:T"), pairingVersion,
+		costByFieldAndLength("g", &EcGroups, []linearCost{
+			BN254g1:     {baseCost: 8_000, chunkCost: 7_400, chunkSize: bn254g2Size},
+			BN254g2:     {baseCost: 8_000, chunkCost: 7_400, chunkSize: bn254g1Size},
+			BLS12_381g1: {baseCost: 13_000, chunkCost: 10_000, chunkSize: bls12381g2Size},
+			BLS12_381g2: {baseCost: 13_000, chunkCost: 10_000, chunkSize: bls12381g1Size},
+		})},
+	{0xe3, "ec_multi_scalar_mul", opEcMultiScalarMul, proto("bb:b"), pairingVersion,
+		costByFieldAndLength("g", &EcGroups, []linearCost{
+			BN254g1:     {baseCost: 3_600, chunkCost: 90, chunkSize: scalarSize},
+			BN254g2:     {baseCost: 7_200, chunkCost: 270, chunkSize: scalarSize},
+			BLS12_381g1: {baseCost: 6_500, chunkCost: 95, chunkSize: scalarSize},
+			BLS12_381g2: {baseCost: 14_850, chunkCost: 485, chunkSize: scalarSize},
+		})},
+	{0xe4, "ec_subgroup_check", opEcSubgroupCheck, proto("b:T"), pairingVersion,
+		costByField("g", &EcGroups, []int{
+			BN254g1: 20, BN254g2: 3_100, // g1 subgroup is nearly a no-op
+			BLS12_381g1: 1_850, BLS12_381g2: 2_340})},
+	{0xe5, "ec_map_to", opEcMapTo, proto("b:b"), pairingVersion,
+		costByField("g", &EcGroups, []int{
+			BN254g1: 630, BN254g2: 3_300,
+			BLS12_381g1: 1_950, BLS12_381g2: 8_150})},
 }
 
 type sortByOpcode []OpSpec
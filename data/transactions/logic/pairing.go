@@ -18,98 +18,785 @@ package logic
 
 import (
 	"errors"
+	"fmt"
 	"math/big"
 
+	"github.com/consensys/gnark-crypto/ecc"
+	bls12381 "github.com/consensys/gnark-crypto/ecc/bls12-381"
+	bls12381fp "github.com/consensys/gnark-crypto/ecc/bls12-381/fp"
+	bls12381fr "github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
 	"github.com/consensys/gnark-crypto/ecc/bn254"
-	"github.com/consensys/gnark-crypto/ecc/bn254/fp"
+	bn254fp "github.com/consensys/gnark-crypto/ecc/bn254/fp"
+	bn254fr "github.com/consensys/gnark-crypto/ecc/bn254/fr"
 )
 
-func bytesToBN254Field(b []byte) (ret fp.Element) {
-	ret.SetBytes(b)
-	return
+// Points are encoded as the concatenation of their big-endian affine
+// coordinates. A G1 point is X followed by Y. A G2 point has coordinates in a
+// quadratic extension, so it is X.A0, X.A1, Y.A0, Y.A1. The point at infinity
+// is encoded as all zero bytes, which is how gnark represents it in affine form.
+const (
+	bn254fpSize = 32
+	bn254g1Size = 2 * bn254fpSize
+	bn254g2Size = 4 * bn254fpSize
+
+	bls12381fpSize = 48
+	bls12381g1Size = 2 * bls12381fpSize
+	bls12381g2Size = 4 * bls12381fpSize
+
+	scalarSize = 32
+)
+
+var bn254Modulus = bn254fp.Modulus()
+var bls12381Modulus = bls12381fp.Modulus()
+
+var bn254Order = bn254fr.Modulus()
+var bls12381Order = bls12381fr.Modulus()
+
+var (
+	errNotOnCurve    = errors.New("point not on curve")
+	errNotInSubgroup = errors.New("point not in subgroup")
+	errNoPoints      = errors.New("no points to multiply")
+)
+
+func bytesToBN254Field(b []byte) (ret bn254fp.Element, err error) {
+	val := new(big.Int).SetBytes(b)
+	if val.Cmp(bn254Modulus) >= 0 {
+		return ret, fmt.Errorf("field element %#x is not less than modulus", b)
+	}
+	ret.SetBigInt(val)
+	return ret, nil
 }
 
-func bytesToBN254G1(b []byte) (ret bn254.G1Affine) {
-	ret.X = bytesToBN254Field(b[:32])
-	ret.Y = bytesToBN254Field(b[32:64])
-	return
+func bytesToBN254G1(b []byte) (ret bn254.G1Affine, err error) {
+	if len(b) != bn254g1Size {
+		return ret, fmt.Errorf("bad length %d, expected %d", len(b), bn254g1Size)
+	}
+	if ret.X, err = bytesToBN254Field(b[:32]); err != nil {
+		return
+	}
+	if ret.Y, err = bytesToBN254Field(b[32:64]); err != nil {
+		return
+	}
+	if !ret.IsOnCurve() {
+		return ret, errNotOnCurve
+	}
+	return ret, nil
 }
 
-func bytesToBN254G1s(b []byte) (ret []bn254.G1Affine) {
-	for i := 0; i < len(b)/64; i++ {
-		ret = append(ret, bytesToBN254G1(b[(i*64):(i*64+64)]))
+func bytesToBN254G1s(b []byte, checkSubgroup bool) (ret []bn254.G1Affine, err error) {
+	if len(b)%bn254g1Size != 0 {
+		return nil, fmt.Errorf("bad length %d, not a multiple of %d", len(b), bn254g1Size)
+	}
+	ret = make([]bn254.G1Affine, len(b)/bn254g1Size)
+	for i := range ret {
+		ret[i], err = bytesToBN254G1(b[i*bn254g1Size : (i+1)*bn254g1Size])
+		if err != nil {
+			return nil, err
+		}
+		if checkSubgroup && !ret[i].IsInSubGroup() {
+			return nil, errNotInSubgroup
+		}
 	}
-	return
+	return ret, nil
 }
 
-func bytesToBN254G2(b []byte) (ret bn254.G2Affine) {
-	ret.X.A0 = bytesToBN254Field(b[:32])
-	ret.X.A1 = bytesToBN254Field(b[32:64])
-	ret.Y.A0 = bytesToBN254Field(b[64:96])
-	ret.Y.A1 = bytesToBN254Field(b[96:128])
-	return
+func bytesToBN254G2(b []byte) (ret bn254.G2Affine, err error) {
+	if len(b) != bn254g2Size {
+		return ret, fmt.Errorf("bad length %d, expected %d", len(b), bn254g2Size)
+	}
+	if ret.X.A0, err = bytesToBN254Field(b[:32]); err != nil {
+		return
+	}
+	if ret.X.A1, err = bytesToBN254Field(b[32:64]); err != nil {
+		return
+	}
+	if ret.Y.A0, err = bytesToBN254Field(b[64:96]); err != nil {
+		return
+	}
+	if ret.Y.A1, err = bytesToBN254Field(b[96:128]); err != nil {
+		return
+	}
+	if !ret.IsOnCurve() {
+		return ret, errNotOnCurve
+	}
+	return ret, nil
 }
 
-func bytesToBN254G2s(b []byte) (ret []bn254.G2Affine) {
-	for i := 0; i < len(b)/128; i++ {
-		ret = append(ret, bytesToBN254G2(b[(i*128):(i*128+128)]))
+func bytesToBN254G2s(b []byte, checkSubgroup bool) (ret []bn254.G2Affine, err error) {
+	if len(b)%bn254g2Size != 0 {
+		return nil, fmt.Errorf("bad length %d, not a multiple of %d", len(b), bn254g2Size)
+	}
+	ret = make([]bn254.G2Affine, len(b)/bn254g2Size)
+	for i := range ret {
+		ret[i], err = bytesToBN254G2(b[i*bn254g2Size : (i+1)*bn254g2Size])
+		if err != nil {
+			return nil, err
+		}
+		if checkSubgroup && !ret[i].IsInSubGroup() {
+			return nil, errNotInSubgroup
+		}
 	}
-	return
+	return ret, nil
+}
+
+func bn254G1ToBytes(g1 *bn254.G1Affine) []byte {
+	ret := make([]byte, 0, bn254g1Size)
+	x := g1.X.Bytes()
+	y := g1.Y.Bytes()
+	ret = append(ret, x[:]...)
+	return append(ret, y[:]...)
 }
 
-func bN254G1ToBytes(g1 *bn254.G1Affine) (ret []byte) {
-	retX := g1.X.Bytes()
-	retY := g1.Y.Bytes()
-	ret = append(retX[:], retY[:]...)
-	return
+func bn254G2ToBytes(g2 *bn254.G2Affine) []byte {
+	ret := make([]byte, 0, bn254g2Size)
+	xa0 := g2.X.A0.Bytes()
+	xa1 := g2.X.A1.Bytes()
+	ya0 := g2.Y.A0.Bytes()
+	ya1 := g2.Y.A1.Bytes()
+	ret = append(ret, xa0[:]...)
+	ret = append(ret, xa1[:]...)
+	ret = append(ret, ya0[:]...)
+	return append(ret, ya1[:]...)
 }
 
-func opBn256Add(cx *EvalContext) error {
+func bytesToBLS12381Field(b []byte) (ret bls12381fp.Element, err error) {
+	val := new(big.Int).SetBytes(b)
+	if val.Cmp(bls12381Modulus) >= 0 {
+		return ret, fmt.Errorf("field element %#x is not less than modulus", b)
+	}
+	ret.SetBigInt(val)
+	return ret, nil
+}
+
+func bytesToBLS12381G1(b []byte) (ret bls12381.G1Affine, err error) {
+	if len(b) != bls12381g1Size {
+		return ret, fmt.Errorf("bad length %d, expected %d", len(b), bls12381g1Size)
+	}
+	if ret.X, err = bytesToBLS12381Field(b[:48]); err != nil {
+		return
+	}
+	if ret.Y, err = bytesToBLS12381Field(b[48:96]); err != nil {
+		return
+	}
+	if !ret.IsOnCurve() {
+		return ret, errNotOnCurve
+	}
+	return ret, nil
+}
+
+func bytesToBLS12381G1s(b []byte, checkSubgroup bool) (ret []bls12381.G1Affine, err error) {
+	if len(b)%bls12381g1Size != 0 {
+		return nil, fmt.Errorf("bad length %d, not a multiple of %d", len(b), bls12381g1Size)
+	}
+	ret = make([]bls12381.G1Affine, len(b)/bls12381g1Size)
+	for i := range ret {
+		ret[i], err = bytesToBLS12381G1(b[i*bls12381g1Size : (i+1)*bls12381g1Size])
+		if err != nil {
+			return nil, err
+		}
+		if checkSubgroup && !ret[i].IsInSubGroup() {
+			return nil, errNotInSubgroup
+		}
+	}
+	return ret, nil
+}
+
+func bytesToBLS12381G2(b []byte) (ret bls12381.G2Affine, err error) {
+	if len(b) != bls12381g2Size {
+		return ret, fmt.Errorf("bad length %d, expected %d", len(b), bls12381g2Size)
+	}
+	if ret.X.A0, err = bytesToBLS12381Field(b[:48]); err != nil {
+		return
+	}
+	if ret.X.A1, err = bytesToBLS12381Field(b[48:96]); err != nil {
+		return
+	}
+	if ret.Y.A0, err = bytesToBLS12381Field(b[96:144]); err != nil {
+		return
+	}
+	if ret.Y.A1, err = bytesToBLS12381Field(b[144:192]); err != nil {
+		return
+	}
+	if !ret.IsOnCurve() {
+		return ret, errNotOnCurve
+	}
+	return ret, nil
+}
+
+func bytesToBLS12381G2s(b []byte, checkSubgroup bool) (ret []bls12381.G2Affine, err error) {
+	if len(b)%bls12381g2Size != 0 {
+		return nil, fmt.Errorf("bad length %d, not a multiple of %d", len(b), bls12381g2Size)
+	}
+	ret = make([]bls12381.G2Affine, len(b)/bls12381g2Size)
+	for i := range ret {
+		ret[i], err = bytesToBLS12381G2(b[i*bls12381g2Size : (i+1)*bls12381g2Size])
+		if err != nil {
+			return nil, err
+		}
+		if checkSubgroup && !ret[i].IsInSubGroup() {
+			return nil, errNotInSubgroup
+		}
+	}
+	return ret, nil
+}
+
+func bls12381G1ToBytes(g1 *bls12381.G1Affine) []byte {
+	ret := make([]byte, 0, bls12381g1Size)
+	x := g1.X.Bytes()
+	y := g1.Y.Bytes()
+	ret = append(ret, x[:]...)
+	return append(ret, y[:]...)
+}
+
+func bls12381G2ToBytes(g2 *bls12381.G2Affine) []byte {
+	ret := make([]byte, 0, bls12381g2Size)
+	xa0 := g2.X.A0.Bytes()
+	xa1 := g2.X.A1.Bytes()
+	ya0 := g2.Y.A0.Bytes()
+	ya1 := g2.Y.A1.Bytes()
+	ret = append(ret, xa0[:]...)
+	ret = append(ret, xa1[:]...)
+	ret = append(ret, ya0[:]...)
+	return append(ret, ya1[:]...)
+}
+
+func ecGroupSpec(cx *EvalContext) (ecSpec, error) {
+	group := EC(cx.program[cx.pc+1])
+	fs, ok := ecSpecByField(group)
+	if !ok || fs.version > cx.version {
+		return ecSpec{}, fmt.Errorf("invalid group %s", group)
+	}
+	return fs, nil
+}
+
+func opEcAdd(cx *EvalContext) error {
+	fs, err := ecGroupSpec(cx)
+	if err != nil {
+		return err
+	}
+
 	last := len(cx.stack) - 1
 	prev := last - 1
-	aBytes := cx.stack[prev].Bytes
-	bBytes := cx.stack[last].Bytes
-	if len(aBytes) != 64 || len(bBytes) != 64 {
-		return errors.New("expect G1 in 64 bytes")
-	}
-	a := bytesToBN254G1(aBytes)
-	b := bytesToBN254G1(bBytes)
-	res := new(bn254.G1Affine).Add(&a, &b)
-	resBytes := bN254G1ToBytes(res)
+	a := cx.stack[prev].Bytes
+	b := cx.stack[last].Bytes
+
+	var res []byte
+	switch fs.field {
+	case BN254g1:
+		res, err = bn254G1Add(a, b)
+	case BN254g2:
+		res, err = bn254G2Add(a, b)
+	case BLS12_381g1:
+		res, err = bls12381G1Add(a, b)
+	case BLS12_381g2:
+		res, err = bls12381G2Add(a, b)
+	default:
+		err = fmt.Errorf("invalid group %s", fs.field)
+	}
+	if err != nil {
+		return err
+	}
+	cx.stack[prev].Bytes = res
 	cx.stack = cx.stack[:last]
-	cx.stack[prev].Bytes = resBytes
 	return nil
 }
 
-func opBn256ScalarMul(cx *EvalContext) error {
+func opEcScalarMul(cx *EvalContext) error {
+	fs, err := ecGroupSpec(cx)
+	if err != nil {
+		return err
+	}
+
 	last := len(cx.stack) - 1
 	prev := last - 1
-	aBytes := cx.stack[prev].Bytes
-	if len(aBytes) != 64 {
-		return errors.New("expect G1 in 64 bytes")
-	}
-	a := bytesToBN254G1(aBytes)
-	kBytes := cx.stack[last].Bytes
-	k := new(big.Int).SetBytes(kBytes[:])
-	res := new(bn254.G1Affine).ScalarMultiplication(&a, k)
-	resBytes := bN254G1ToBytes(res)
+	a := cx.stack[prev].Bytes
+	kbytes := cx.stack[last].Bytes
+	if len(kbytes) > scalarSize {
+		return fmt.Errorf("scalar is too long %d > %d", len(kbytes), scalarSize)
+	}
+	k := new(big.Int).SetBytes(kbytes)
+
+	var res []byte
+	switch fs.field {
+	case BN254g1:
+		res, err = bn254G1ScalarMul(a, k)
+	case BN254g2:
+		res, err = bn254G2ScalarMul(a, k)
+	case BLS12_381g1:
+		res, err = bls12381G1ScalarMul(a, k)
+	case BLS12_381g2:
+		res, err = bls12381G2ScalarMul(a, k)
+	default:
+		err = fmt.Errorf("invalid group %s", fs.field)
+	}
+	if err != nil {
+		return err
+	}
+	cx.stack[prev].Bytes = res
 	cx.stack = cx.stack[:last]
-	cx.stack[prev].Bytes = resBytes
 	return nil
 }
 
-func opBn256Pairing(cx *EvalContext) error {
+func opEcPairingCheck(cx *EvalContext) error {
+	fs, err := ecGroupSpec(cx)
+	if err != nil {
+		return err
+	}
+
 	last := len(cx.stack) - 1
 	prev := last - 1
-	g1Bytes := cx.stack[prev].Bytes
-	g2Bytes := cx.stack[last].Bytes
-	g1 := bytesToBN254G1s(g1Bytes)
-	g2 := bytesToBN254G2s(g2Bytes)
-	ok, err := bn254.PairingCheck(g1, g2)
+	g1 := cx.stack[prev].Bytes
+	g2 := cx.stack[last].Bytes
+
+	var ok bool
+	switch fs.field {
+	case BN254g1:
+		ok, err = bn254PairingCheck(g1, g2)
+	case BN254g2:
+		ok, err = bn254PairingCheck(g2, g1)
+	case BLS12_381g1:
+		ok, err = bls12381PairingCheck(g1, g2)
+	case BLS12_381g2:
+		ok, err = bls12381PairingCheck(g2, g1)
+	default:
+		err = fmt.Errorf("invalid group %s", fs.field)
+	}
 	if err != nil {
-		return errors.New("pairing failed")
+		return err
 	}
-	cx.stack = cx.stack[:last]
 	cx.stack[prev] = boolToSV(ok)
+	cx.stack = cx.stack[:last]
+	return nil
+}
+
+func opEcMultiScalarMul(cx *EvalContext) error {
+	fs, err := ecGroupSpec(cx)
+	if err != nil {
+		return err
+	}
+
+	last := len(cx.stack) - 1
+	prev := last - 1
+	points := cx.stack[prev].Bytes
+	scalars := cx.stack[last].Bytes
+	if len(scalars)%scalarSize != 0 {
+		return fmt.Errorf("scalars are %d bytes, not a multiple of %d", len(scalars), scalarSize)
+	}
+
+	var res []byte
+	switch fs.field {
+	case BN254g1:
+		res, err = bn254G1MultiMul(points, scalars)
+	case BN254g2:
+		res, err = bn254G2MultiMul(points, scalars)
+	case BLS12_381g1:
+		res, err = bls12381G1MultiMul(points, scalars)
+	case BLS12_381g2:
+		res, err = bls12381G2MultiMul(points, scalars)
+	default:
+		err = fmt.Errorf("invalid group %s", fs.field)
+	}
+	if err != nil {
+		return err
+	}
+	cx.stack[prev].Bytes = res
+	cx.stack = cx.stack[:last]
 	return nil
 }
+
+func opEcSubgroupCheck(cx *EvalContext) error {
+	fs, err := ecGroupSpec(cx)
+	if err != nil {
+		return err
+	}
+
+	last := len(cx.stack) - 1
+	pt := cx.stack[last].Bytes
+
+	var ok bool
+	switch fs.field {
+	case BN254g1:
+		var p bn254.G1Affine
+		p, err = bytesToBN254G1(pt)
+		ok = p.IsInSubGroup()
+	case BN254g2:
+		var p bn254.G2Affine
+		p, err = bytesToBN254G2(pt)
+		ok = p.IsInSubGroup()
+	case BLS12_381g1:
+		var p bls12381.G1Affine
+		p, err = bytesToBLS12381G1(pt)
+		ok = p.IsInSubGroup()
+	case BLS12_381g2:
+		var p bls12381.G2Affine
+		p, err = bytesToBLS12381G2(pt)
+		ok = p.IsInSubGroup()
+	default:
+		err = fmt.Errorf("invalid group %s", fs.field)
+	}
+	if err != nil {
+		return err
+	}
+	cx.stack[last] = boolToSV(ok)
+	return nil
+}
+
+func opEcMapTo(cx *EvalContext) error {
+	fs, err := ecGroupSpec(cx)
+	if err != nil {
+		return err
+	}
+
+	last := len(cx.stack) - 1
+	fe := cx.stack[last].Bytes
+
+	var res []byte
+	switch fs.field {
+	case BN254g1:
+		res, err = bn254MapToG1(fe)
+	case BN254g2:
+		res, err = bn254MapToG2(fe)
+	case BLS12_381g1:
+		res, err = bls12381MapToG1(fe)
+	case BLS12_381g2:
+		res, err = bls12381MapToG2(fe)
+	default:
+		err = fmt.Errorf("invalid group %s", fs.field)
+	}
+	if err != nil {
+		return err
+	}
+	cx.stack[last].Bytes = res
+	return nil
+}
+
+// The group operations below all work in Jacobian coordinates, because the
+// affine types do not offer the same methods for G1 and G2, and because
+// Jacobian arithmetic handles the point at infinity without special cases.
+
+func bn254G1Add(aBytes, bBytes []byte) ([]byte, error) {
+	a, err := bytesToBN254G1(aBytes)
+	if err != nil {
+		return nil, err
+	}
+	b, err := bytesToBN254G1(bBytes)
+	if err != nil {
+		return nil, err
+	}
+	var sum bn254.G1Jac
+	sum.FromAffine(&a)
+	sum.AddMixed(&b)
+	var res bn254.G1Affine
+	res.FromJacobian(&sum)
+	return bn254G1ToBytes(&res), nil
+}
+
+func bn254G2Add(aBytes, bBytes []byte) ([]byte, error) {
+	a, err := bytesToBN254G2(aBytes)
+	if err != nil {
+		return nil, err
+	}
+	b, err := bytesToBN254G2(bBytes)
+	if err != nil {
+		return nil, err
+	}
+	var sum bn254.G2Jac
+	sum.FromAffine(&a)
+	sum.AddMixed(&b)
+	var res bn254.G2Affine
+	res.FromJacobian(&sum)
+	return bn254G2ToBytes(&res), nil
+}
+
+func bls12381G1Add(aBytes, bBytes []byte) ([]byte, error) {
+	a, err := bytesToBLS12381G1(aBytes)
+	if err != nil {
+		return nil, err
+	}
+	b, err := bytesToBLS12381G1(bBytes)
+	if err != nil {
+		return nil, err
+	}
+	var sum bls12381.G1Jac
+	sum.FromAffine(&a)
+	sum.AddMixed(&b)
+	var res bls12381.G1Affine
+	res.FromJacobian(&sum)
+	return bls12381G1ToBytes(&res), nil
+}
+
+func bls12381G2Add(aBytes, bBytes []byte) ([]byte, error) {
+	a, err := bytesToBLS12381G2(aBytes)
+	if err != nil {
+		return nil, err
+	}
+	b, err := bytesToBLS12381G2(bBytes)
+	if err != nil {
+		return nil, err
+	}
+	var sum bls12381.G2Jac
+	sum.FromAffine(&a)
+	sum.AddMixed(&b)
+	var res bls12381.G2Affine
+	res.FromJacobian(&sum)
+	return bls12381G2ToBytes(&res), nil
+}
+
+func bn254G1ScalarMul(aBytes []byte, k *big.Int) ([]byte, error) {
+	a, err := bytesToBN254G1(aBytes)
+	if err != nil {
+		return nil, err
+	}
+	var product bn254.G1Jac
+	product.FromAffine(&a)
+	product.ScalarMultiplication(&product, k)
+	var res bn254.G1Affine
+	res.FromJacobian(&product)
+	return bn254G1ToBytes(&res), nil
+}
+
+func bn254G2ScalarMul(aBytes []byte, k *big.Int) ([]byte, error) {
+	a, err := bytesToBN254G2(aBytes)
+	if err != nil {
+		return nil, err
+	}
+	var product bn254.G2Jac
+	product.FromAffine(&a)
+	product.ScalarMultiplication(&product, k)
+	var res bn254.G2Affine
+	res.FromJacobian(&product)
+	return bn254G2ToBytes(&res), nil
+}
+
+func bls12381G1ScalarMul(aBytes []byte, k *big.Int) ([]byte, error) {
+	a, err := bytesToBLS12381G1(aBytes)
+	if err != nil {
+		return nil, err
+	}
+	var product bls12381.G1Jac
+	product.FromAffine(&a)
+	product.ScalarMultiplication(&product, k)
+	var res bls12381.G1Affine
+	res.FromJacobian(&product)
+	return bls12381G1ToBytes(&res), nil
+}
+
+func bls12381G2ScalarMul(aBytes []byte, k *big.Int) ([]byte, error) {
+	a, err := bytesToBLS12381G2(aBytes)
+	if err != nil {
+		return nil, err
+	}
+	var product bls12381.G2Jac
+	product.FromAffine(&a)
+	product.ScalarMultiplication(&product, k)
+	var res bls12381.G2Affine
+	res.FromJacobian(&product)
+	return bls12381G2ToBytes(&res), nil
+}
+
+// Pairings are only meaningful for points in the prime order subgroups, so
+// the pairing checks and multi-scalar multiplications insist on them.
+
+func bn254PairingCheck(g1Bytes, g2Bytes []byte) (bool, error) {
+	g1, err := bytesToBN254G1s(g1Bytes, true)
+	if err != nil {
+		return false, err
+	}
+	g2, err := bytesToBN254G2s(g2Bytes, true)
+	if err != nil {
+		return false, err
+	}
+	if len(g1) != len(g2) {
+		return false, fmt.Errorf("mismatched point counts %d != %d", len(g1), len(g2))
+	}
+	return bn254.PairingCheck(g1, g2)
+}
+
+func bls12381PairingCheck(g1Bytes, g2Bytes []byte) (bool, error) {
+	g1, err := bytesToBLS12381G1s(g1Bytes, true)
+	if err != nil {
+		return false, err
+	}
+	g2, err := bytesToBLS12381G2s(g2Bytes, true)
+	if err != nil {
+		return false, err
+	}
+	if len(g1) != len(g2) {
+		return false, fmt.Errorf("mismatched point counts %d != %d", len(g1), len(g2))
+	}
+	return bls12381.PairingCheck(g1, g2)
+}
+
+// checkScalars ensures there is one canonical scalar, i.e. one that is less
+// than the group order, for each of count points. SetBytes would silently
+// reduce larger scalars.
+func checkScalars(scalarBytes []byte, count int, order *big.Int) error {
+	if count == 0 {
+		return errNoPoints
+	}
+	if len(scalarBytes) != count*scalarSize {
+		return fmt.Errorf("%d scalars for %d points", len(scalarBytes)/scalarSize, count)
+	}
+	for i := 0; i < count; i++ {
+		k := new(big.Int).SetBytes(scalarBytes[i*scalarSize : (i+1)*scalarSize])
+		if k.Cmp(order) >= 0 {
+			return fmt.Errorf("scalar %d is not less than the group order", i)
+		}
+	}
+	return nil
+}
+
+func bn254Scalars(scalarBytes []byte, count int) ([]bn254fr.Element, error) {
+	if err := checkScalars(scalarBytes, count, bn254Order); err != nil {
+		return nil, err
+	}
+	scalars := make([]bn254fr.Element, count)
+	for i := range scalars {
+		scalars[i].SetBytes(scalarBytes[i*scalarSize : (i+1)*scalarSize])
+	}
+	return scalars, nil
+}
+
+func bls12381Scalars(scalarBytes []byte, count int) ([]bls12381fr.Element, error) {
+	if err := checkScalars(scalarBytes, count, bls12381Order); err != nil {
+		return nil, err
+	}
+	scalars := make([]bls12381fr.Element, count)
+	for i := range scalars {
+		scalars[i].SetBytes(scalarBytes[i*scalarSize : (i+1)*scalarSize])
+	}
+	return scalars, nil
+}
+
+// msmConfig is used for all multi-scalar multiplications. Scalars are set with
+// SetBytes, which leaves them in Montgomery form.
+var msmConfig = ecc.MultiExpConfig{ScalarsMont: true}
+
+func bn254G1MultiMul(pointBytes, scalarBytes []byte) ([]byte, error) {
+	points, err := bytesToBN254G1s(pointBytes, true)
+	if err != nil {
+		return nil, err
+	}
+	scalars, err := bn254Scalars(scalarBytes, len(points))
+	if err != nil {
+		return nil, err
+	}
+	var res bn254.G1Affine
+	if _, err = res.MultiExp(points, scalars, msmConfig); err != nil {
+		return nil, err
+	}
+	return bn254G1ToBytes(&res), nil
+}
+
+func bn254G2MultiMul(pointBytes, scalarBytes []byte) ([]byte, error) {
+	points, err := bytesToBN254G2s(pointBytes, true)
+	if err != nil {
+		return nil, err
+	}
+	scalars, err := bn254Scalars(scalarBytes, len(points))
+	if err != nil {
+		return nil, err
+	}
+	var res bn254.G2Affine
+	if _, err = res.MultiExp(points, scalars, msmConfig); err != nil {
+		return nil, err
+	}
+	return bn254G2ToBytes(&res), nil
+}
+
+func bls12381G1MultiMul(pointBytes, scalarBytes []byte) ([]byte, error) {
+	points, err := bytesToBLS12381G1s(pointBytes, true)
+	if err != nil {
+		return nil, err
+	}
+	scalars, err := bls12381Scalars(scalarBytes, len(points))
+	if err != nil {
+		return nil, err
+	}
+	var res bls12381.G1Affine
+	if _, err = res.MultiExp(points, scalars, msmConfig); err != nil {
+		return nil, err
+	}
+	return bls12381G1ToBytes(&res), nil
+}
+
+func bls12381G2MultiMul(pointBytes, scalarBytes []byte) ([]byte, error) {
+	points, err := bytesToBLS12381G2s(pointBytes, true)
+	if err != nil {
+		return nil, err
+	}
+	scalars, err := bls12381Scalars(scalarBytes, len(points))
+	if err != nil {
+		return nil, err
+	}
+	var res bls12381.G2Affine
+	if _, err = res.MultiExp(points, scalars, msmConfig); err != nil {
+		return nil, err
+	}
+	return bls12381G2ToBytes(&res), nil
+}
+
+// The map_to operations use the Shallue-van de Woestijne map, then clear the
+// cofactor so that the result is always in the prime order subgroup. BN254 G1
+// has cofactor 1, so there is nothing to clear.
+
+func bn254MapToG1(fieldBytes []byte) ([]byte, error) {
+	if len(fieldBytes) != bn254fpSize {
+		return nil, fmt.Errorf("bad length %d, expected %d", len(fieldBytes), bn254fpSize)
+	}
+	fe, err := bytesToBN254Field(fieldBytes)
+	if err != nil {
+		return nil, err
+	}
+	point := bn254.MapToCurveG1Svdw(fe)
+	return bn254G1ToBytes(&point), nil
+}
+
+func bn254MapToG2(fieldBytes []byte) ([]byte, error) {
+	if len(fieldBytes) != 2*bn254fpSize {
+		return nil, fmt.Errorf("bad length %d, expected %d", len(fieldBytes), 2*bn254fpSize)
+	}
+	// The extension field type is internal to gnark, so borrow a G2 coordinate.
+	var fe bn254.G2Affine
+	var err error
+	if fe.X.A0, err = bytesToBN254Field(fieldBytes[:32]); err != nil {
+		return nil, err
+	}
+	if fe.X.A1, err = bytesToBN254Field(fieldBytes[32:64]); err != nil {
+		return nil, err
+	}
+	point := bn254.MapToCurveG2Svdw(fe.X)
+	point.ClearCofactor(&point)
+	return bn254G2ToBytes(&point), nil
+}
+
+func bls12381MapToG1(fieldBytes []byte) ([]byte, error) {
+	if len(fieldBytes) != bls12381fpSize {
+		return nil, fmt.Errorf("bad length %d, expected %d", len(fieldBytes), bls12381fpSize)
+	}
+	fe, err := bytesToBLS12381Field(fieldBytes)
+	if err != nil {
+		return nil, err
+	}
+	point := bls12381.MapToCurveG1Svdw(fe)
+	point.ClearCofactor(&point)
+	return bls12381G1ToBytes(&point), nil
+}
+
+func bls12381MapToG2(fieldBytes []byte) ([]byte, error) {
+	if len(fieldBytes) != 2*bls12381fpSize {
+		return nil, fmt.Errorf("bad length %d, expected %d", len(fieldBytes), 2*bls12381fpSize)
+	}
+	// The extension field type is internal to gnark, so borrow a G2 coordinate.
+	var fe bls12381.G2Affine
+	var err error
+	if fe.X.A0, err = bytesToBLS12381Field(fieldBytes[:48]); err != nil {
+		return nil, err
+	}
+	if fe.X.A1, err = bytesToBLS12381Field(fieldBytes[48:96]); err != nil {
+		return nil, err
+	}
+	point := bls12381.MapToCurveG2Svdw(fe.X)
+	point.ClearCofactor(&point)
+	return bls12381G2ToBytes(&point), nil
+}
@@ -16,14 +16,322 @@
 
 package logic
 
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"strings"
+	"testing"
+
+	bls12381 "github.com/consensys/gnark-crypto/ecc/bls12-381"
+	"github.com/consensys/gnark-crypto/ecc/bn254"
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
 const pairingNonsense = `
  pushbytes 0x012345
  dup
- bn256_add
+ ec_add BN254g1
+ dup
+ ec_scalar_mul BLS12_381g1
+ dup
+ ec_multi_scalar_mul BLS12_381g2
+ ec_map_to BN254g2
  dup
- bn256_scalar_mul
+ ec_subgroup_check BLS12_381g1
+ pop
  dup
- bn256_pairing
+ ec_pairing_check BN254g2
 `
 
-const pairingCompiled = "80030123454999499a499b"
+const pairingCompiled = "800301234549e00049e10249e303e50149e4024849e201"
+
+// ecTestPoints returns the generator of group g, its double, the negation of
+// the generator, and the point at infinity, all encoded for use in programs.
+func ecTestPoints(g EC) (gen, double, neg, inf []byte) {
+	switch g {
+	case BN254g1:
+		g1, _, _, _ := bn254.Generators()
+		var d bn254.G1Jac
+		d.Double(&g1)
+		var n bn254.G1Jac
+		n.Neg(&g1)
+		var ga, da, na bn254.G1Affine
+		ga.FromJacobian(&g1)
+		da.FromJacobian(&d)
+		na.FromJacobian(&n)
+		return bn254G1ToBytes(&ga), bn254G1ToBytes(&da), bn254G1ToBytes(&na), make([]byte, bn254g1Size)
+	case BN254g2:
+		_, g2, _, _ := bn254.Generators()
+		var d bn254.G2Jac
+		d.Double(&g2)
+		var n bn254.G2Jac
+		n.Neg(&g2)
+		var ga, da, na bn254.G2Affine
+		ga.FromJacobian(&g2)
+		da.FromJacobian(&d)
+		na.FromJacobian(&n)
+		return bn254G2ToBytes(&ga), bn254G2ToBytes(&da), bn254G2ToBytes(&na), make([]byte, bn254g2Size)
+	case BLS12_381g1:
+		g1, _, _, _ := bls12381.Generators()
+		var d bls12381.G1Jac
+		d.Double(&g1)
+		var n bls12381.G1Jac
+		n.Neg(&g1)
+		var ga, da, na bls12381.G1Affine
+		ga.FromJacobian(&g1)
+		da.FromJacobian(&d)
+		na.FromJacobian(&n)
+		return bls12381G1ToBytes(&ga), bls12381G1ToBytes(&da), bls12381G1ToBytes(&na), make([]byte, bls12381g1Size)
+	case BLS12_381g2:
+		_, g2, _, _ := bls12381.Generators()
+		var d bls12381.G2Jac
+		d.Double(&g2)
+		var n bls12381.G2Jac
+		n.Neg(&g2)
+		var ga, da, na bls12381.G2Affine
+		ga.FromJacobian(&g2)
+		da.FromJacobian(&d)
+		na.FromJacobian(&n)
+		return bls12381G2ToBytes(&ga), bls12381G2ToBytes(&da), bls12381G2ToBytes(&na), make([]byte, bls12381g2Size)
+	}
+	panic(g)
+}
+
+// ecOther returns the group that g is paired with
+func ecOther(g EC) EC {
+	switch g {
+	case BN254g1:
+		return BN254g2
+	case BN254g2:
+		return BN254g1
+	case BLS12_381g1:
+		return BLS12_381g2
+	case BLS12_381g2:
+		return BLS12_381g1
+	}
+	panic(g)
+}
+
+// ecModulus returns the encoding of the modulus of the base field of g, which
+// is the smallest illegal field element.
+func ecModulus(g EC) []byte {
+	switch g {
+	case BN254g1, BN254g2:
+		return bn254Modulus.FillBytes(make([]byte, bn254fpSize))
+	case BLS12_381g1, BLS12_381g2:
+		return bls12381Modulus.FillBytes(make([]byte, bls12381fpSize))
+	}
+	panic(g)
+}
+
+// offCurve returns a copy of pt with its last byte altered, so it is no longer
+// on the curve.
+func offCurve(pt []byte) []byte {
+	off := append([]byte{}, pt...)
+	off[len(off)-1] ^= 0x01
+	return off
+}
+
+// testEc runs a program at pairingVersion with enough budget for the more
+// expensive group operations.
+func testEc(t *testing.T, program string, problems ...string) {
+	t.Helper()
+	ep := defaultEvalParams()
+	clone := *ep.Proto
+	clone.LogicSigMaxCost = 200_000
+	ep.Proto = &clone
+	testLogic(t, program, pairingVersion, ep, problems...)
+}
+
+func TestEcAdd(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	for _, spec := range ecSpecs {
+		g := spec.field
+		t.Run(g.String(), func(t *testing.T) {
+			t.Parallel()
+			gen, double, neg, inf := ecTestPoints(g)
+
+			testEc(t, fmt.Sprintf("byte 0x%x; dup; ec_add %s; byte 0x%x; ==", gen, g, double))
+			testEc(t, fmt.Sprintf("byte 0x%x; byte 0x%x; ec_add %s; byte 0x%x; ==", gen, inf, g, gen))
+			testEc(t, fmt.Sprintf("byte 0x%x; byte 0x%x; ec_add %s; byte 0x%x; ==", inf, gen, g, gen))
+			testEc(t, fmt.Sprintf("byte 0x%x; byte 0x%x; ec_add %s; byte 0x%x; ==", gen, neg, g, inf))
+
+			testEc(t, fmt.Sprintf("byte 0x%x; byte 0x%x; ec_add %s; len", gen, offCurve(gen), g),
+				"point not on curve")
+			testEc(t, fmt.Sprintf("byte 0x%x; byte 0x%x00; ec_add %s; len", gen, gen, g),
+				"bad length")
+			testEc(t, fmt.Sprintf("byte 0x%x; byte 0x%x; ec_add %s; len", gen, gen[1:], g),
+				"bad length")
+			modulus := ecModulus(g)
+			tooBig := append(modulus, gen[len(modulus):]...)
+			testEc(t, fmt.Sprintf("byte 0x%x; byte 0x%x; ec_add %s; len", gen, tooBig, g),
+				"not less than modulus")
+		})
+	}
+}
+
+func TestEcScalarMul(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	for _, spec := range ecSpecs {
+		g := spec.field
+		t.Run(g.String(), func(t *testing.T) {
+			t.Parallel()
+			gen, double, neg, inf := ecTestPoints(g)
+
+			testEc(t, fmt.Sprintf(`byte 0x%x; byte ""; ec_scalar_mul %s; byte 0x%x; ==`, gen, g, inf))
+			testEc(t, fmt.Sprintf("byte 0x%x; byte 0x01; ec_scalar_mul %s; byte 0x%x; ==", gen, g, gen))
+			testEc(t, fmt.Sprintf("byte 0x%x; byte 0x0002; ec_scalar_mul %s; byte 0x%x; ==", gen, g, double))
+			testEc(t, fmt.Sprintf("byte 0x%x; byte 0x1234; ec_scalar_mul %s; byte 0x%x; ==", inf, g, inf))
+			// 3G - G = 2G
+			testEc(t, fmt.Sprintf(`byte 0x%x; byte 0x03; ec_scalar_mul %s
+                                   byte 0x%x; ec_add %s; byte 0x%x; ==`, gen, g, neg, g, double))
+
+			testEc(t, fmt.Sprintf("byte 0x%x; byte 0x%s; ec_scalar_mul %s; len", gen, strings.Repeat("01", 33), g),
+				"scalar is too long")
+			testEc(t, fmt.Sprintf("byte 0x%x; byte 0x01; ec_scalar_mul %s; len", offCurve(gen), g),
+				"point not on curve")
+		})
+	}
+}
+
+func TestEcPairingCheck(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	for _, spec := range ecSpecs {
+		g := spec.field
+		t.Run(g.String(), func(t *testing.T) {
+			t.Parallel()
+			gen, _, neg, _ := ecTestPoints(g)
+			other, _, _, _ := ecTestPoints(ecOther(g))
+
+			// e(G, H) * e(-G, H) is the identity
+			testEc(t, fmt.Sprintf("byte 0x%x%x; byte 0x%x%x; ec_pairing_check %s", gen, neg, other, other, g))
+			testEc(t, fmt.Sprintf("byte 0x%x%x; byte 0x%x%x; ec_pairing_check %s; !", gen, gen, other, other, g))
+			testEc(t, fmt.Sprintf("byte 0x%x; byte 0x%x; ec_pairing_check %s; !", gen, other, g))
+
+			testEc(t, fmt.Sprintf("byte 0x%x%x; byte 0x%x; ec_pairing_check %s", gen, neg, other, g),
+				"mismatched point counts")
+			testEc(t, fmt.Sprintf("byte 0x%x00; byte 0x%x; ec_pairing_check %s", gen, other, g),
+				"bad length")
+			testEc(t, fmt.Sprintf("byte 0x%x; byte 0x%x; ec_pairing_check %s", offCurve(gen), other, g),
+				"point not on curve")
+		})
+	}
+}
+
+func TestEcMultiScalarMul(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	three := make([]byte, scalarSize)
+	three[scalarSize-1] = 3
+	five := make([]byte, scalarSize)
+	five[scalarSize-1] = 5
+
+	for _, spec := range ecSpecs {
+		g := spec.field
+		t.Run(g.String(), func(t *testing.T) {
+			t.Parallel()
+			gen, double, _, inf := ecTestPoints(g)
+
+			// 3G + 5(2G) = 13G
+			testEc(t, fmt.Sprintf(`byte 0x%x%x; byte 0x%x%x; ec_multi_scalar_mul %s
+                                   byte 0x%x; byte 0x0d; ec_scalar_mul %s; ==`,
+				gen, double, three, five, g, gen, g))
+			testEc(t, fmt.Sprintf("byte 0x%x; byte 0x%x; ec_multi_scalar_mul %s; byte 0x%x; ==",
+				inf, five, g, inf))
+
+			testEc(t, fmt.Sprintf("byte 0x%x%x; byte 0x%x; ec_multi_scalar_mul %s; len", gen, double, three, g),
+				"1 scalars for 2 points")
+			testEc(t, fmt.Sprintf("byte 0x%x; byte 0x03; ec_multi_scalar_mul %s; len", gen, g),
+				"not a multiple of 32")
+			testEc(t, fmt.Sprintf(`byte ""; byte ""; ec_multi_scalar_mul %s; len`, g),
+				"no points to multiply")
+
+			// Scalars must be canonical, i.e. less than the group order.
+			order := bn254Order
+			if g == BLS12_381g1 || g == BLS12_381g2 {
+				order = bls12381Order
+			}
+			atOrder := order.FillBytes(make([]byte, scalarSize))
+			belowOrder := new(big.Int).Sub(order, big.NewInt(1)).FillBytes(make([]byte, scalarSize))
+			testEc(t, fmt.Sprintf("byte 0x%x; byte 0x%x; ec_multi_scalar_mul %s; len", gen, belowOrder, g))
+			testEc(t, fmt.Sprintf("byte 0x%x%x; byte 0x%x%x; ec_multi_scalar_mul %s; len", gen, double, three, atOrder, g),
+				"scalar 1 is not less than the group order")
+			testEc(t, fmt.Sprintf("byte 0x%x; byte 0x%x; ec_multi_scalar_mul %s; len", gen, bytes.Repeat([]byte{0xff}, scalarSize), g),
+				"scalar 0 is not less than the group order")
+		})
+	}
+}
+
+func TestEcSubgroupCheck(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	for _, spec := range ecSpecs {
+		g := spec.field
+		t.Run(g.String(), func(t *testing.T) {
+			t.Parallel()
+			gen, double, _, inf := ecTestPoints(g)
+
+			testEc(t, fmt.Sprintf("byte 0x%x; ec_subgroup_check %s", gen, g))
+			testEc(t, fmt.Sprintf("byte 0x%x; ec_subgroup_check %s", double, g))
+			testEc(t, fmt.Sprintf("byte 0x%x; ec_subgroup_check %s", inf, g))
+			testEc(t, fmt.Sprintf("byte 0x%x; ec_subgroup_check %s", offCurve(gen), g),
+				"point not on curve")
+		})
+	}
+}
+
+func TestEcMapTo(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	for _, spec := range ecSpecs {
+		g := spec.field
+		t.Run(g.String(), func(t *testing.T) {
+			t.Parallel()
+			modulus := ecModulus(g)
+			size := len(modulus)
+			if g == BN254g2 || g == BLS12_381g2 {
+				size *= 2
+			}
+			fe := make([]byte, size)
+			fe[size-1] = 7
+
+			testEc(t, fmt.Sprintf("byte 0x%x; ec_map_to %s; ec_subgroup_check %s", fe, g, g))
+			testEc(t, fmt.Sprintf("byte 0x%x; ec_map_to %s; dup; ec_add %s; len", fe, g, g))
+
+			testEc(t, fmt.Sprintf("byte 0x%x; ec_map_to %s; len", fe[1:], g), "bad length")
+			tooBig := append(modulus, fe[len(modulus):]...)
+			testEc(t, fmt.Sprintf("byte 0x%x; ec_map_to %s; len", tooBig, g), "not less than modulus")
+		})
+	}
+}
+
+func TestEcCosts(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	spec := OpsByName[pairingVersion]["ec_pairing_check"]
+	require.Equal(t, "BN254g1=8000 + 7400 per 128 bytes of B BN254g2=8000 + 7400 per 64 bytes of B"+
+		" BLS12_381g1=13000 + 10000 per 192 bytes of B BLS12_381g2=13000 + 10000 per 96 bytes of B",
+		strings.TrimSpace(spec.OpDetails.docCost(2)))
+
+	// Two pairs of BN254 points cost the base plus two chunks
+	program := []byte{0x00, byte(BN254g1)}
+	stack := []stackValue{{Bytes: make([]byte, 2*bn254g1Size)}, {Bytes: make([]byte, 2*bn254g2Size)}}
+	require.Equal(t, 8000+2*7400, spec.OpDetails.Cost(program, 0, stack))
+
+	spec = OpsByName[pairingVersion]["ec_add"]
+	require.Equal(t, "BN254g1=125 BN254g2=170 BLS12_381g1=205 BLS12_381g2=290",
+		strings.TrimSpace(spec.OpDetails.docCost(2)))
+}
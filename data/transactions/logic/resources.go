@@ -25,6 +25,20 @@ import (
 	"github.com/algorand/go-algorand/protocol"
 )
 
+// UnnamedResourcePolicy decides whether a resource that is not available under
+// the normal rules (foreign arrays, group sharing, creation in the group) may be
+// used anyway. Returning true grants access. It must only be used outside of
+// real block evaluation, such as in simulation, where it allows discovering the
+// resources that a transaction group should name.
+type UnnamedResourcePolicy interface {
+	AvailableAccount(addr basics.Address) bool
+	AvailableAsset(aid basics.AssetIndex) bool
+	AvailableApp(aid basics.AppIndex) bool
+	AllowsHolding(addr basics.Address, aid basics.AssetIndex) bool
+	AllowsLocal(addr basics.Address, aid basics.AppIndex) bool
+	AvailableBox(app basics.AppIndex, name string) bool
+}
+
 // resources contains a catalog of available resources. It's used to track the
 // apps, assets, and boxes that are available to a transaction, outside the
 // direct foreign array mechanism.
@@ -182,6 +196,9 @@ func (cx *EvalContext) allowsHolding(addr basics.Address, ai basics.AssetIndex)
 	if cx.txn.Txn.ApplicationID == 0 && cx.getApplicationAddress(cx.appID) == addr {
 		return cx.availableAsset(ai)
 	}
+	if cx.UnnamedResources != nil {
+		return cx.UnnamedResources.AllowsHolding(addr, ai)
+	}
 	return false
 }
 
@@ -208,6 +225,9 @@ func (cx *EvalContext) allowsLocals(addr basics.Address, ai basics.AppIndex) boo
 	if cx.txn.Txn.ApplicationID == 0 && cx.getApplicationAddress(cx.appID) == addr {
 		return cx.availableApp(ai)
 	}
+	if cx.UnnamedResources != nil {
+		return cx.UnnamedResources.AllowsLocal(addr, ai)
+	}
 	return false
 }
 
@@ -17,6 +17,7 @@
 package logic
 
 import (
+	"github.com/algorand/go-algorand/data/basics"
 	"github.com/algorand/go-algorand/data/bookkeeping"
 	"github.com/algorand/go-algorand/data/transactions"
 	"github.com/algorand/go-algorand/ledger/ledgercore"
@@ -198,3 +199,198 @@ func (n NullEvalTracer) AfterOpcode(cx *EvalContext, evalError error) {}
 
 // AfterBlock does nothing
 func (n NullEvalTracer) AfterBlock(hdr *bookkeeping.BlockHeader) {}
+
+// The methods below let an EvalTracer inspect the effects of the opcode at the
+// current PC. They are meant to be called from BeforeOpcode, except for the
+// stack and scratch accessors, which may be used in either hook. An opcode that
+// would fail (because of a stack underflow, for example) may be described
+// incompletely, so tracers should discard the description if AfterOpcode
+// reports an error.
+
+// AppStateEnum identifies the kind of application state changed by an opcode.
+type AppStateEnum uint64
+
+const (
+	// NoState means the opcode does not change application state.
+	NoState AppStateEnum = iota
+	// GlobalState is the global key/value store of an application.
+	GlobalState
+	// LocalState is the key/value store of an account opted into an application.
+	LocalState
+	// BoxState is the box storage of an application.
+	BoxState
+)
+
+// AppStateOpEnum identifies how an opcode changes application state.
+type AppStateOpEnum uint64
+
+const (
+	// NoStateOp means the opcode does not change application state.
+	NoStateOp AppStateOpEnum = iota
+	// AppStateWrite means a value is created or (partially) overwritten.
+	AppStateWrite
+	// AppStateDelete means a value is removed.
+	AppStateDelete
+)
+
+// StateChange describes an application state change about to be made by an opcode.
+type StateChange struct {
+	AppState   AppStateEnum
+	AppStateOp AppStateOpEnum
+	AppID      basics.AppIndex
+	Key        string
+	// Account is the account whose local state changes. It is only set for LocalState.
+	Account basics.Address
+	// NewValue is the value written by app_global_put and app_local_put. Box
+	// opcodes may only change part of a box, so it is left empty for them, and
+	// the box contents should be read from the ledger after the opcode runs.
+	NewValue basics.TealValue
+}
+
+// StackHeight returns the number of values on the stack.
+func (cx *EvalContext) StackHeight() int {
+	return len(cx.stack)
+}
+
+// StackValues returns the values on the stack at and above position `from`,
+// bottom first.
+func (cx *EvalContext) StackValues(from int) []basics.TealValue {
+	if from < 0 {
+		from = 0
+	}
+	if from >= len(cx.stack) {
+		return nil
+	}
+	values := make([]basics.TealValue, len(cx.stack)-from)
+	for i, sv := range cx.stack[from:] {
+		values[i] = sv.toTealValue()
+	}
+	return values
+}
+
+// ScratchValue returns the value in scratch slot `slot`.
+func (cx *EvalContext) ScratchValue(slot int) basics.TealValue {
+	return cx.scratch[slot].toTealValue()
+}
+
+// nextOpSpec returns the spec of the opcode at the current PC, or nil if the
+// program has ended or the opcode is illegal.
+func (cx *EvalContext) nextOpSpec() *OpSpec {
+	if cx.pc >= len(cx.program) {
+		return nil
+	}
+	spec := &opsByOpcode[cx.version][cx.program[cx.pc]]
+	if spec.op == nil {
+		return nil
+	}
+	return spec
+}
+
+// nextImmediate returns the first immediate byte of the opcode at the current PC.
+func (cx *EvalContext) nextImmediate() int {
+	if cx.pc+1 >= len(cx.program) {
+		return 0
+	}
+	return int(cx.program[cx.pc+1])
+}
+
+// NextStackDeletions returns the number of values, counted from the top, that
+// the opcode at the current PC will remove from the stack or overwrite in
+// place. Once it has run, the values above StackHeight() - NextStackDeletions()
+// are the ones it pushed.
+func (cx *EvalContext) NextStackDeletions() int {
+	spec := cx.nextOpSpec()
+	if spec == nil {
+		return 0
+	}
+	deletions := len(spec.Arg.Types)
+	switch spec.Name {
+	case "popn":
+		deletions = cx.nextImmediate()
+	case "bury", "cover", "uncover", "match":
+		deletions = cx.nextImmediate() + 1
+	case "frame_bury":
+		if top := len(cx.callstack) - 1; top >= 0 {
+			deletions = len(cx.stack) - (cx.callstack[top].height + int(int8(cx.nextImmediate())))
+		}
+	case "retsub":
+		if top := len(cx.callstack) - 1; top >= 0 && cx.callstack[top].clear {
+			topFrame := cx.callstack[top]
+			deletions = len(cx.stack) - (topFrame.height - topFrame.args)
+		}
+	}
+	if deletions < 0 {
+		return 0
+	}
+	if deletions > len(cx.stack) {
+		return len(cx.stack)
+	}
+	return deletions
+}
+
+// NextScratchWrite returns the scratch slot written by the opcode at the
+// current PC, if it writes one.
+func (cx *EvalContext) NextScratchWrite() (int, bool) {
+	spec := cx.nextOpSpec()
+	if spec == nil {
+		return 0, false
+	}
+	switch spec.Name {
+	case "store":
+		return cx.nextImmediate(), true
+	case "stores":
+		if len(cx.stack) < 2 {
+			return 0, false
+		}
+		slot := cx.stack[len(cx.stack)-2]
+		if slot.avmType() != avmUint64 || slot.Uint >= uint64(len(cx.scratch)) {
+			return 0, false
+		}
+		return int(slot.Uint), true
+	}
+	return 0, false
+}
+
+// NextStateChange describes the application state change made by the opcode at
+// the current PC, if it makes one.
+func (cx *EvalContext) NextStateChange() (StateChange, bool) {
+	spec := cx.nextOpSpec()
+	if spec == nil || cx.runModeFlags != ModeApp || len(cx.stack) < len(spec.Arg.Types) {
+		return StateChange{}, false
+	}
+	last := len(cx.stack) - 1
+	change := StateChange{AppID: cx.appID}
+	switch spec.Name {
+	case "app_global_put":
+		change.AppState, change.AppStateOp = GlobalState, AppStateWrite
+		change.Key = string(cx.stack[last-1].Bytes)
+		change.NewValue = cx.stack[last].toTealValue()
+	case "app_global_del":
+		change.AppState, change.AppStateOp = GlobalState, AppStateDelete
+		change.Key = string(cx.stack[last].Bytes)
+	case "app_local_put", "app_local_del":
+		change.AppState, change.AppStateOp = LocalState, AppStateDelete
+		account := cx.stack[last-1]
+		change.Key = string(cx.stack[last].Bytes)
+		if spec.Name == "app_local_put" {
+			change.AppStateOp = AppStateWrite
+			account = cx.stack[last-2]
+			change.Key = string(cx.stack[last-1].Bytes)
+			change.NewValue = cx.stack[last].toTealValue()
+		}
+		addr, _, err := cx.resolveAccount(account)
+		if err != nil {
+			return StateChange{}, false
+		}
+		change.Account = addr
+	case "box_create", "box_put", "box_replace", "box_splice", "box_resize":
+		change.AppState, change.AppStateOp = BoxState, AppStateWrite
+		change.Key = string(cx.stack[len(cx.stack)-len(spec.Arg.Types)].Bytes)
+	case "box_del":
+		change.AppState, change.AppStateOp = BoxState, AppStateDelete
+		change.Key = string(cx.stack[last].Bytes)
+	default:
+		return StateChange{}, false
+	}
+	return change, true
+}
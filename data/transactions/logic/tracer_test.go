@@ -231,3 +231,67 @@ func TestEvalWithTracerPanic(t *testing.T) {
 		})
 	}
 }
+
+// stackChangeTracer checks that NextStackDeletions accounts for every stack
+// value changed by an opcode.
+type stackChangeTracer struct {
+	NullEvalTracer
+	t      *testing.T
+	before []basics.TealValue
+	base   int
+}
+
+func (tracer *stackChangeTracer) BeforeOpcode(cx *EvalContext) {
+	tracer.before = cx.StackValues(0)
+	tracer.base = cx.StackHeight() - cx.NextStackDeletions()
+}
+
+func (tracer *stackChangeTracer) AfterOpcode(cx *EvalContext, evalError error) {
+	require.NoError(tracer.t, evalError)
+	require.GreaterOrEqual(tracer.t, tracer.base, 0)
+	require.LessOrEqual(tracer.t, tracer.base, cx.StackHeight())
+	after := cx.StackValues(0)
+	for i := 0; i < tracer.base; i++ {
+		require.Equal(tracer.t, tracer.before[i], after[i], "stack changed at %d below %d", i, tracer.base)
+	}
+}
+
+func TestNextStackDeletions(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	tracer := stackChangeTracer{t: t}
+	ep := DefaultEvalParams()
+	ep.Tracer = &tracer
+	TestLogic(t, `
+int 1
+int 2
+int 3
+cover 2
+uncover 2
+bury 2
+dupn 2
+popn 3
+int 4
+callsub sub
+b cont
+sub:
+proto 2 1
+int 9
+frame_bury -1
+frame_dig -2
+retsub
+cont:
+int 7
+int 8
+int 3
+match m1 m2
+b done
+m1:
+m2:
+err
+done:
+int 3
+==
+`, 8, ep)
+}
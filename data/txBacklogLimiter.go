@@ -0,0 +1,272 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package data
+
+import (
+	"container/list"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/network"
+	"github.com/algorand/go-algorand/util/metrics"
+)
+
+// txSenderLimiter keeps track of the number of transaction groups of each sender that passed signature verification
+// over the last one to two windows, and refuses new groups from senders which already used more than their fair share.
+// Since senders are only charged for verified groups, nobody can use up the share of a sender by forging its address.
+// While the backlog is not congested, the capacity is split evenly between the active senders, so that a single sender
+// may use most of it when nobody else is around. Once the backlog is congested, every sender is limited to the reserved capacity.
+type txSenderLimiter struct {
+	// usage counts the verified groups of each sender during the current window, and prevUsage during the previous one
+	usage     map[basics.Address]int
+	prevUsage map[basics.Address]int
+	// active is the number of senders in usage or prevUsage
+	active int
+
+	windowStart time.Time
+	window      time.Duration
+
+	// capacity is the overall backlog capacity shared by all the senders
+	capacity int
+	// reserved is the number of groups each sender may have verified while the backlog is congested
+	reserved int
+
+	mu deadlock.Mutex
+}
+
+func makeTxSenderLimiter(capacity int, reserved int, window time.Duration) *txSenderLimiter {
+	if reserved < 1 {
+		reserved = 1
+	}
+	return &txSenderLimiter{
+		usage:     make(map[basics.Address]int),
+		prevUsage: make(map[basics.Address]int),
+		window:    window,
+		capacity:  capacity,
+		reserved:  reserved,
+	}
+}
+
+// distinctSenders returns the senders of the given transaction group, without duplicates
+func distinctSenders(txgroup []transactions.SignedTxn) []basics.Address {
+	senders := make([]basics.Address, 0, 1)
+	for i := range txgroup {
+		sender := txgroup[i].Txn.Sender
+		duplicate := false
+		for _, s := range senders {
+			if s == sender {
+				duplicate = true
+				break
+			}
+		}
+		if !duplicate {
+			senders = append(senders, sender)
+		}
+	}
+	return senders
+}
+
+// rotate starts a new window if the current one is over, forgetting the usage of the previous one.
+// locking semantic: lock must be taken
+func (l *txSenderLimiter) rotate(now time.Time) {
+	elapsed := now.Sub(l.windowStart)
+	if elapsed < l.window {
+		return
+	}
+	if elapsed < 2*l.window {
+		l.prevUsage = l.usage
+	} else {
+		l.prevUsage = make(map[basics.Address]int)
+	}
+	l.usage = make(map[basics.Address]int)
+	l.active = len(l.prevUsage)
+	l.windowStart = now
+}
+
+// share returns the number of verified groups a single sender is allowed to have.
+// locking semantic: lock must be taken
+func (l *txSenderLimiter) share(sender basics.Address, congested bool) int {
+	if congested {
+		return l.reserved
+	}
+	active := l.active
+	if l.usage[sender] == 0 && l.prevUsage[sender] == 0 {
+		active++
+	}
+	if share := l.capacity / active; share > l.reserved {
+		return share
+	}
+	return l.reserved
+}
+
+// allow returns true if none of the distinct senders of the given transaction group exceeded its share.
+// It does not charge the senders, since the group has not been verified yet.
+func (l *txSenderLimiter) allow(txgroup []transactions.SignedTxn, congested bool, now time.Time) bool {
+	senders := distinctSenders(txgroup)
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.rotate(now)
+	for _, sender := range senders {
+		if l.usage[sender]+l.prevUsage[sender] >= l.share(sender, congested) {
+			return false
+		}
+	}
+	return true
+}
+
+// charge counts a verified transaction group against the share of each of its distinct senders.
+func (l *txSenderLimiter) charge(txgroup []transactions.SignedTxn, now time.Time) {
+	senders := distinctSenders(txgroup)
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.rotate(now)
+	for _, sender := range senders {
+		if l.usage[sender] == 0 && l.prevUsage[sender] == 0 {
+			l.active++
+		}
+		l.usage[sender]++
+	}
+}
+
+// peerDropCounter counts the transaction messages dropped from the backlog for each peer.
+// Only the peers which dropped messages most recently are tracked, so the number of reported series is bounded
+// without having to learn when peers disconnect.
+type peerDropCounter struct {
+	name        string
+	description string
+
+	// maxPeers is the number of peers tracked before the least recently dropping one is forgotten
+	maxPeers int
+	drops    map[network.Peer]*list.Element
+	// recent lists the peerDrops of the tracked peers, the most recently dropping first
+	recent *list.List
+	mu     deadlock.Mutex
+}
+
+type peerDrops struct {
+	peer  network.Peer
+	count uint64
+}
+
+func makePeerDropCounter(metric metrics.MetricName, maxPeers int) *peerDropCounter {
+	c := &peerDropCounter{
+		name:        metric.Name,
+		description: metric.Description,
+		maxPeers:    maxPeers,
+		drops:       make(map[network.Peer]*list.Element),
+		recent:      list.New(),
+	}
+	metrics.DefaultRegistry().Register(c)
+	return c
+}
+
+// Inc increases the drop count of the given peer by 1
+func (c *peerDropCounter) Inc(peer network.Peer) {
+	if peer == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if e, ok := c.drops[peer]; ok {
+		e.Value.(*peerDrops).count++
+		c.recent.MoveToFront(e)
+		return
+	}
+	c.drops[peer] = c.recent.PushFront(&peerDrops{peer: peer, count: 1})
+	if c.recent.Len() > c.maxPeers {
+		oldest := c.recent.Back()
+		c.recent.Remove(oldest)
+		delete(c.drops, oldest.Value.(*peerDrops).peer)
+	}
+}
+
+// Count returns the drop count of the given peer
+func (c *peerDropCounter) Count(peer network.Peer) uint64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if e, ok := c.drops[peer]; ok {
+		return e.Value.(*peerDrops).count
+	}
+	return 0
+}
+
+// byAddress aggregates the drop counts by the peers addresses
+func (c *peerDropCounter) byAddress() map[string]uint64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	counts := make(map[string]uint64, len(c.drops))
+	for e := c.recent.Front(); e != nil; e = e.Next() {
+		drops := e.Value.(*peerDrops)
+		addr := "unknown"
+		if p, ok := drops.peer.(network.HTTPPeer); ok {
+			addr = p.GetAddress()
+		}
+		counts[addr] += drops.count
+	}
+	return counts
+}
+
+// WriteMetric is part of the Metric interface
+func (c *peerDropCounter) WriteMetric(buf *strings.Builder, parentLabels string) {
+	counts := c.byAddress()
+	if len(counts) == 0 {
+		return
+	}
+	addrs := make([]string, 0, len(counts))
+	for addr := range counts {
+		addrs = append(addrs, addr)
+	}
+	sort.Strings(addrs)
+
+	buf.WriteString("# HELP ")
+	buf.WriteString(c.name)
+	buf.WriteRune(' ')
+	buf.WriteString(c.description)
+	buf.WriteString("\n# TYPE ")
+	buf.WriteString(c.name)
+	buf.WriteString(" counter\n")
+	for _, addr := range addrs {
+		buf.WriteString(c.name)
+		buf.WriteString("{peer=")
+		buf.WriteString(strconv.Quote(addr))
+		if len(parentLabels) > 0 {
+			buf.WriteRune(',')
+			buf.WriteString(parentLabels)
+		}
+		buf.WriteString("} ")
+		buf.WriteString(strconv.FormatUint(counts[addr], 10))
+		buf.WriteRune('\n')
+	}
+}
+
+// AddMetric is part of the Metric interface
+// Only the total is reported, since per-peer values would bloat the telemetry heartbeat.
+func (c *peerDropCounter) AddMetric(values map[string]float64) {
+	var total uint64
+	for _, count := range c.byAddress() {
+		total += count
+	}
+	values[c.name] = float64(total)
+}
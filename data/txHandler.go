@@ -62,6 +62,11 @@ var transactionGroupTxSyncHandled = metrics.MakeCounter(metrics.TransactionGroup
 var transactionGroupTxSyncRemember = metrics.MakeCounter(metrics.TransactionGroupTxSyncRemember)
 var transactionGroupTxSyncAlreadyCommitted = metrics.MakeCounter(metrics.TransactionGroupTxSyncAlreadyCommitted)
 var txBacklogDroppedCongestionManagement = metrics.MakeCounter(metrics.TransactionMessagesTxnDroppedCongestionManagement)
+var txBacklogDroppedSenderLimit = metrics.MakeCounter(metrics.TransactionMessagesTxnDroppedSenderLimit)
+var txBacklogDroppedPerPeer = makePeerDropCounter(metrics.TransactionMessagesBacklogDroppedPerPeer, txBacklogDroppedMaxPeers)
+
+// txBacklogDroppedMaxPeers is the number of peers whose dropped messages are reported individually
+const txBacklogDroppedMaxPeers = 256
 
 // ErrInvalidTxPool is reported when nil is passed for the tx pool
 var ErrInvalidTxPool = errors.New("MakeTxHandler: txPool is nil on initialization")
@@ -128,6 +133,7 @@ type TxHandler struct {
 	streamVerifierChan    chan execpool.InputJob
 	streamVerifierDropped chan *verify.UnverifiedTxnSigJob
 	erl                   *util.ElasticRateLimiter
+	senderLimiter         *txSenderLimiter
 }
 
 // TxHandlerOpts is TxHandler configuration options
@@ -190,6 +196,15 @@ func MakeTxHandler(opts TxHandlerOpts) (*TxHandler, error) {
 		handler.erl = rateLimiter
 	}
 
+	if opts.Config.EnableTxBacklogSenderLimiting {
+		// senders get the same reservation as peers once the backlog is congested
+		handler.senderLimiter = makeTxSenderLimiter(
+			txBacklogSize,
+			opts.Config.TxBacklogReservedCapacityPerPeer,
+			time.Duration(opts.Config.TxBacklogServiceRateWindowSeconds)*time.Second,
+		)
+	}
+
 	// prepare the transaction stream verifier
 	var err error
 	txnElementProcessor, err := verify.MakeSigVerifyJobProcessor(handler.ledger, handler.ledger.VerifiedTransactionCache(),
@@ -297,11 +312,7 @@ func (handler *TxHandler) backlogWorker() {
 				// this is never happening since handler.backlogQueue is never closed
 				return
 			}
-			if wi.capguard != nil {
-				if err := wi.capguard.Release(); err != nil {
-					logging.Base().Warnf("Failed to release capacity to ElasticRateLimiter: %v", err)
-				}
-			}
+			handler.releaseBacklogCapacity(wi)
 			if handler.checkAlreadyCommitted(wi) {
 				transactionMessagesAlreadyCommitted.Inc(nil)
 				if wi.capguard != nil {
@@ -479,6 +490,11 @@ func (handler *TxHandler) postProcessCheckedTxn(wi *txBacklogMsg) {
 	// at this point, we've verified the transaction, so we can safely treat the transaction as a verified transaction.
 	verifiedTxGroup := wi.unverifiedTxGroup
 
+	// the senders are only charged once their signatures are verified, so that nobody could use up their share
+	if handler.senderLimiter != nil {
+		handler.senderLimiter.charge(verifiedTxGroup, time.Now())
+	}
+
 	// save the transaction, if it has high enough fee and not already in the cache
 	err := handler.txPool.Remember(verifiedTxGroup)
 	if err != nil {
@@ -590,6 +606,7 @@ func (handler *TxHandler) processIncomingTxn(rawmsg network.IncomingMessage) net
 			handler.erl.EnableCongestionControl()
 			// if there is no capacity, it is the same as if we failed to put the item onto the backlog, so report such
 			transactionMessagesDroppedFromBacklog.Inc(nil)
+			txBacklogDroppedPerPeer.Inc(rawmsg.Sender)
 			return network.OutgoingMessage{Action: network.Ignore}
 		}
 		// if the backlog Queue has 50% of its buffer back, turn congestion control off
@@ -642,31 +659,52 @@ func (handler *TxHandler) processIncomingTxn(rawmsg network.IncomingMessage) net
 		}
 	}
 
-	select {
-	case handler.backlogQueue <- &txBacklogMsg{
+	wi := &txBacklogMsg{
 		rawmsg:                &rawmsg,
 		unverifiedTxGroup:     unverifiedTxGroup,
 		rawmsgDataHash:        msgKey,
 		unverifiedTxGroupHash: canonicalKey,
 		capguard:              capguard,
-	}:
+	}
+
+	if handler.senderLimiter != nil {
+		// the sender share is tightened to its reservation once half of the backlog is in use,
+		// so that a single spamming sender cannot starve the others regardless of the peers it is using
+		congested := float64(cap(handler.backlogQueue))*0.5 <= float64(len(handler.backlogQueue))
+		if !handler.senderLimiter.allow(unverifiedTxGroup, congested, time.Now()) {
+			txBacklogDroppedSenderLimit.Inc(nil)
+			txBacklogDroppedPerPeer.Inc(rawmsg.Sender)
+			handler.releaseBacklogCapacity(wi)
+			handler.deleteFromCaches(msgKey, canonicalKey)
+			return network.OutgoingMessage{Action: network.Ignore}
+		}
+	}
+
+	select {
+	case handler.backlogQueue <- wi:
 	default:
 		// if we failed here we want to increase the corresponding metric. It might suggest that we
 		// want to increase the queue size.
 		transactionMessagesDroppedFromBacklog.Inc(nil)
+		txBacklogDroppedPerPeer.Inc(rawmsg.Sender)
+		handler.releaseBacklogCapacity(wi)
 
 		// additionally, remove the txn from duplicate caches to ensure it can be re-submitted
-		if handler.txCanonicalCache != nil && canonicalKey != nil {
-			handler.txCanonicalCache.Delete(canonicalKey)
-		}
-		if handler.msgCache != nil && msgKey != nil {
-			handler.msgCache.DeleteByKey(msgKey)
-		}
+		handler.deleteFromCaches(msgKey, canonicalKey)
 	}
 
 	return network.OutgoingMessage{Action: network.Ignore}
 }
 
+// releaseBacklogCapacity returns the capacity taken by the given backlog message to the elastic rate limiter.
+func (handler *TxHandler) releaseBacklogCapacity(wi *txBacklogMsg) {
+	if wi.capguard != nil {
+		if err := wi.capguard.Release(); err != nil {
+			logging.Base().Warnf("Failed to release capacity to ElasticRateLimiter: %v", err)
+		}
+	}
+}
+
 // checkAlreadyCommitted test to see if the given transaction ( in the txBacklogMsg ) was already committed, and
 // whether it would qualify as a candidate for the transaction pool.
 //
@@ -23,6 +23,7 @@ import (
 	"fmt"
 	"io"
 	"math/rand"
+	"net/http"
 	"os"
 	"runtime"
 	"runtime/pprof"
@@ -62,6 +63,15 @@ type mockSender struct{}
 
 func (m mockSender) OnClose(func()) {}
 
+// mockPeer is a distinguishable simulated peer
+type mockPeer struct {
+	addr string
+}
+
+func (p *mockPeer) GetAddress() string { return p.addr }
+
+func (p *mockPeer) GetHTTPClient() *http.Client { return nil }
+
 // txHandlerConfig is a subset of tx handler related options from config.Local
 type txHandlerConfig struct {
 	enableFilteringRawMsg    bool
@@ -1007,6 +1017,196 @@ func TestTxHandlerProcessIncomingCacheBacklogDrop(t *testing.T) {
 	require.Equal(t, initialValue+1, currentValue)
 }
 
+// makeSenderTransaction makes a single encoded transaction from the given sender
+func makeSenderTransaction(sender basics.Address) []byte {
+	var note [32]byte
+	crypto.RandBytes(note[:])
+	stxn := transactions.SignedTxn{
+		Txn: transactions.Transaction{
+			Type: protocol.PaymentTx,
+			Header: transactions.Header{
+				Sender: sender,
+				Fee:    basics.MicroAlgos{Raw: 1000},
+				Note:   note[:],
+			},
+			PaymentTxnFields: transactions.PaymentTxnFields{
+				Receiver: sender,
+			},
+		},
+	}
+	return protocol.Encode(&stxn)
+}
+
+func TestTxHandlerSenderLimiter(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	var spammer, honest, other basics.Address
+	crypto.RandBytes(spammer[:])
+	crypto.RandBytes(honest[:])
+	crypto.RandBytes(other[:])
+	group := func(senders ...basics.Address) []transactions.SignedTxn {
+		txgroup := make([]transactions.SignedTxn, len(senders))
+		for i, sender := range senders {
+			txgroup[i].Txn.Sender = sender
+		}
+		return txgroup
+	}
+
+	const window = time.Minute
+	limiter := makeTxSenderLimiter(10, 2, window)
+	now := time.Now()
+
+	// unverified groups do not count against their senders
+	for i := 0; i < 20; i++ {
+		require.True(t, limiter.allow(group(spammer), false, now))
+	}
+	require.Zero(t, limiter.active)
+
+	// a lone sender may use the entire capacity
+	for i := 0; i < 10; i++ {
+		require.True(t, limiter.allow(group(spammer), false, now))
+		limiter.charge(group(spammer), now)
+	}
+	require.False(t, limiter.allow(group(spammer), false, now))
+
+	// the capacity is split between active senders
+	require.True(t, limiter.allow(group(honest, honest), false, now))
+	limiter.charge(group(honest, honest), now)
+	require.Equal(t, 1, limiter.usage[honest])
+	require.Equal(t, 2, limiter.active)
+
+	// a group is refused as a whole when any of its senders exceeded its share
+	require.False(t, limiter.allow(group(other, spammer), false, now))
+
+	// congestion tightens the share to the reservation
+	limiter.charge(group(honest), now)
+	require.False(t, limiter.allow(group(honest), true, now))
+	require.True(t, limiter.allow(group(honest), false, now))
+
+	// the usage of the previous window still counts
+	now = now.Add(window)
+	require.False(t, limiter.allow(group(spammer), false, now))
+	require.Equal(t, 10, limiter.prevUsage[spammer])
+	require.Empty(t, limiter.usage)
+
+	// and is forgotten afterwards
+	now = now.Add(window)
+	require.True(t, limiter.allow(group(spammer), true, now))
+	require.Empty(t, limiter.prevUsage)
+	require.Zero(t, limiter.active)
+
+	// a pause longer than a window forgets the usage right away
+	limiter.charge(group(spammer), now)
+	limiter.charge(group(spammer), now)
+	require.False(t, limiter.allow(group(spammer), true, now))
+	now = now.Add(2 * window)
+	require.True(t, limiter.allow(group(spammer), true, now))
+}
+
+func TestTxHandlerProcessIncomingSenderFairness(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	const backlogSize = 10
+	const reserved = 2
+	handler := makeTestTxHandlerOrphanedWithContext(context.Background(), backlogSize, 0, txHandlerConfig{true, true}, 0)
+	handler.senderLimiter = makeTxSenderLimiter(backlogSize, reserved, time.Minute)
+	handler.net = &mocks.MockNetwork{}
+
+	var spammer, honest basics.Address
+	crypto.RandBytes(spammer[:])
+	crypto.RandBytes(honest[:])
+	noisyPeer := &mockPeer{addr: "noisy.peer.test:4160"}
+	quietPeer := &mockPeer{addr: "quiet.peer.test:4160"}
+
+	// verify emulates the signature verification of the backlog, which charges the senders
+	verify := func() {
+		for len(handler.backlogQueue) > 0 {
+			wi := <-handler.backlogQueue
+			handler.releaseBacklogCapacity(wi)
+			handler.senderLimiter.charge(wi.unverifiedTxGroup, time.Now())
+		}
+	}
+
+	// groups failing signature verification, such as the ones forging the address of another sender, are not charged
+	for i := 0; i < backlogSize; i++ {
+		wi := &txBacklogMsg{
+			rawmsg:            &network.IncomingMessage{Sender: noisyPeer},
+			unverifiedTxGroup: []transactions.SignedTxn{{Txn: transactions.Transaction{Header: transactions.Header{Sender: honest}}}},
+			verificationErr:   errors.New("invalid signature"),
+		}
+		handler.postProcessCheckedTxn(wi)
+	}
+	require.Zero(t, handler.senderLimiter.active)
+
+	initialSenderDrops := txBacklogDroppedSenderLimit.GetUint64Value()
+
+	// the spammer gets the shared capacity until its verified groups use it up
+	for i := 0; i < backlogSize; i++ {
+		action := handler.processIncomingTxn(network.IncomingMessage{Data: makeSenderTransaction(spammer), Sender: noisyPeer})
+		require.Equal(t, network.OutgoingMessage{Action: network.Ignore}, action)
+	}
+	require.Equal(t, backlogSize, len(handler.backlogQueue))
+	verify()
+	const spam = 5
+	for i := 0; i < spam; i++ {
+		handler.processIncomingTxn(network.IncomingMessage{Data: makeSenderTransaction(spammer), Sender: noisyPeer})
+	}
+	require.Zero(t, len(handler.backlogQueue))
+	require.Equal(t, uint64(spam), txBacklogDroppedPerPeer.Count(noisyPeer))
+	// dropped messages are removed from the duplicate caches so they could be re-submitted
+	require.Equal(t, backlogSize, handler.msgCache.Len())
+	require.Equal(t, backlogSize, handler.txCanonicalCache.Len())
+
+	// the spammer cannot get around the limit by switching peers, while other senders still get in
+	for i := 0; i < reserved; i++ {
+		handler.processIncomingTxn(network.IncomingMessage{Data: makeSenderTransaction(honest), Sender: quietPeer})
+		handler.processIncomingTxn(network.IncomingMessage{Data: makeSenderTransaction(spammer), Sender: quietPeer})
+	}
+	require.Equal(t, reserved, len(handler.backlogQueue))
+	require.Equal(t, uint64(reserved), txBacklogDroppedPerPeer.Count(quietPeer))
+	require.Equal(t, uint64(spam+reserved), txBacklogDroppedSenderLimit.GetUint64Value()-initialSenderDrops)
+
+	var buf strings.Builder
+	txBacklogDroppedPerPeer.WriteMetric(&buf, "")
+	require.Contains(t, buf.String(), fmt.Sprintf(`%s{peer="%s"} %d`, metrics.TransactionMessagesBacklogDroppedPerPeer.Name, noisyPeer.addr, spam))
+	require.Contains(t, buf.String(), fmt.Sprintf(`%s{peer="%s"} %d`, metrics.TransactionMessagesBacklogDroppedPerPeer.Name, quietPeer.addr, reserved))
+
+}
+
+func TestPeerDropCounterMaxPeers(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	counter := makePeerDropCounter(metrics.MetricName{Name: "algod_test_peer_drops", Description: "test"}, 2)
+	defer metrics.DefaultRegistry().Deregister(counter)
+
+	first := &mockPeer{addr: "first.peer.test:4160"}
+	second := &mockPeer{addr: "second.peer.test:4160"}
+	third := &mockPeer{addr: "third.peer.test:4160"}
+	counter.Inc(first)
+	counter.Inc(second)
+	counter.Inc(first)
+	require.Equal(t, uint64(2), counter.Count(first))
+	require.Equal(t, uint64(1), counter.Count(second))
+
+	// the least recently dropping peer is forgotten once too many peers are tracked
+	counter.Inc(third)
+	require.Equal(t, uint64(2), counter.Count(first))
+	require.Zero(t, counter.Count(second))
+	require.Equal(t, uint64(1), counter.Count(third))
+
+	var buf strings.Builder
+	counter.WriteMetric(&buf, "")
+	require.Contains(t, buf.String(), `algod_test_peer_drops{peer="first.peer.test:4160"} 2`)
+	require.NotContains(t, buf.String(), second.addr)
+
+	values := make(map[string]float64)
+	counter.AddMetric(values)
+	require.Equal(t, float64(3), values["algod_test_peer_drops"])
+}
+
 func TestTxHandlerProcessIncomingCacheTxPoolDrop(t *testing.T) {
 	partitiontest.PartitionTest(t)
 
@@ -782,6 +782,18 @@ func (au *accountUpdates) LatestTotals() (basics.Round, ledgercore.AccountTotals
 	return au.latestTotalsImpl()
 }
 
+// Totals returns the totals of all accounts for the given round. The round must be one for which
+// account deltas are still kept in memory.
+func (au *accountUpdates) Totals(rnd basics.Round) (ledgercore.AccountTotals, error) {
+	au.accountsMu.RLock()
+	defer au.accountsMu.RUnlock()
+	offset, err := au.roundOffset(rnd)
+	if err != nil {
+		return ledgercore.AccountTotals{}, err
+	}
+	return au.roundTotals[offset], nil
+}
+
 // ReadCloseSizer interface implements the standard io.Reader and io.Closer as well
 // as supporting the Size() function that let the caller know what the size of the stream would be (in bytes).
 type ReadCloseSizer interface {
@@ -128,6 +128,7 @@ var passThruSource = main(`
 `)
 
 const boxVersion = 36
+const spliceVersion = 39
 
 func boxFee(p config.ConsensusParams, nameAndValueSize uint64) uint64 {
 	return p.BoxFlatMinBalance + p.BoxByteMinBalance*(nameAndValueSize)
@@ -255,6 +256,55 @@ func TestBoxRecreate(t *testing.T) {
 	})
 }
 
+// TestBoxResize tests MBR changes when box_resize grows and shrinks a box
+func TestBoxResize(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	resizeSource := main(`
+		txn ApplicationArgs 0; byte "create"; ==
+		bz resize
+		txn ApplicationArgs 1; int 8; box_create; assert
+		b end
+	 resize:
+		txn ApplicationArgs 1
+		txn ApplicationArgs 2; btoi
+		box_resize
+`)
+
+	genBalances, addrs, _ := ledgertesting.NewTestGenesis()
+	ledgertesting.TestConsensusRange(t, spliceVersion, 0, func(t *testing.T, ver int, cv protocol.ConsensusVersion, cfg config.Local) {
+		dl := NewDoubleLedger(t, genBalances, cv, cfg)
+		defer dl.Close()
+
+		// enough for a size 8 box with a 4 letter name
+		proto := config.Consensus[cv]
+		appID := dl.fundedApp(addrs[0], proto.MinBalance+boxFee(proto, 12), resizeSource)
+
+		call := txntest.Txn{
+			Type:          "appl",
+			Sender:        addrs[0],
+			ApplicationID: appID,
+			Boxes:         []transactions.BoxRef{{Index: 0, Name: []byte("adam")}},
+		}
+
+		dl.txn(call.Args("resize", "adam", "\x08"), "no such box")
+		dl.txn(call.Args("create", "adam"))
+		dl.txn(call.Args("resize", "adam", "\x09"), "below min")
+		dl.txn(call.Args("resize", "adam", "\x04"))
+
+		app := lookup(t, dl.generator, appID.Address())
+		require.Equal(t, uint64(1), app.TotalBoxes)
+		require.Equal(t, uint64(8), app.TotalBoxBytes)
+
+		// The 4 bytes freed by shrinking are available to grow again
+		dl.txn(call.Args("resize", "adam", "\x08").Noted("grow"))
+		app = lookup(t, dl.generator, appID.Address())
+		require.Equal(t, uint64(12), app.TotalBoxBytes)
+		dl.txn(call.Args("resize", "adam", "\x09").Noted("again"), "below min")
+	})
+}
+
 func TestBoxCreateAvailability(t *testing.T) {
 	partitiontest.PartitionTest(t)
 	t.Parallel()
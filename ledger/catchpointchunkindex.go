@@ -0,0 +1,107 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package ledger
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/algorand/go-algorand/ledger/store/trackerdb"
+)
+
+// CatchpointChunkSeeker is implemented by the catchpoint streams returned by GetCatchpointStream when the catchpoint
+// file has a chunk index. Such files hold every chunk ( i.e. tar entry ) in a gzip member of its own, and the index
+// records the offset of each of these members, so the stream can be positioned at the start of any chunk without
+// decompressing the chunks preceding it.
+type CatchpointChunkSeeker interface {
+	// SeekChunk positions the stream at the start of the given chunk, the file header being chunk 0. The stream then
+	// reads as a gzip compressed tar stream beginning with that chunk. Seeking past the last chunk positions the stream
+	// at the end of the tar stream.
+	SeekChunk(chunk uint64) error
+}
+
+// catchpointFileStream is the ReadCloseSizer of a catchpoint file which has a chunk index
+type catchpointFileStream struct {
+	readCloseSizer
+	file *os.File
+	// chunkOffsets holds the offset of the gzip member of each chunk, followed by the offset of the member holding
+	// the end of the tar stream
+	chunkOffsets []int64
+}
+
+// SeekChunk implements the CatchpointChunkSeeker interface
+func (s *catchpointFileStream) SeekChunk(chunk uint64) error {
+	last := uint64(len(s.chunkOffsets) - 1)
+	if chunk > last {
+		chunk = last
+	}
+	_, err := s.file.Seek(s.chunkOffsets[chunk], io.SeekStart)
+	return err
+}
+
+// makeCatchpointStream returns the stream of the given catchpoint file, which implements CatchpointChunkSeeker if the
+// file has a valid chunk index.
+func makeCatchpointStream(file *os.File, size int64) ReadCloseSizer {
+	stream := &readCloseSizer{ReadCloser: file, size: size}
+	chunkOffsets, err := readCatchpointChunkIndex(file.Name() + trackerdb.CatchpointChunkIndexFileSuffix)
+	if err != nil {
+		return stream
+	}
+	return &catchpointFileStream{readCloseSizer: *stream, file: file, chunkOffsets: chunkOffsets}
+}
+
+// countingWriter counts the bytes written to the underlying writer
+type countingWriter struct {
+	io.Writer
+	written int64
+}
+
+func (w *countingWriter) Write(p []byte) (int, error) {
+	n, err := w.Writer.Write(p)
+	w.written += int64(n)
+	return n, err
+}
+
+// writeCatchpointChunkIndex writes the given chunk offsets to the chunk index file at path
+func writeCatchpointChunkIndex(path string, chunkOffsets []int64) error {
+	buf := make([]byte, 8*len(chunkOffsets))
+	for i, offset := range chunkOffsets {
+		binary.BigEndian.PutUint64(buf[8*i:], uint64(offset))
+	}
+	return os.WriteFile(path, buf, 0644)
+}
+
+// readCatchpointChunkIndex reads the chunk offsets from the chunk index file at path
+func readCatchpointChunkIndex(path string) ([]int64, error) {
+	buf, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if len(buf) == 0 || len(buf)%8 != 0 {
+		return nil, fmt.Errorf("catchpoint chunk index '%s' has an invalid size %d", path, len(buf))
+	}
+	chunkOffsets := make([]int64, len(buf)/8)
+	for i := range chunkOffsets {
+		chunkOffsets[i] = int64(binary.BigEndian.Uint64(buf[8*i:]))
+		if i > 0 && chunkOffsets[i] <= chunkOffsets[i-1] {
+			return nil, fmt.Errorf("catchpoint chunk index '%s' is not sorted", path)
+		}
+	}
+	return chunkOffsets, nil
+}
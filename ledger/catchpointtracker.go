@@ -602,7 +602,8 @@ func (ct *catchpointTracker) postCommit(ctx context.Context, dcc *deferredCommit
 	}
 }
 
-func doRepackCatchpoint(ctx context.Context, header CatchpointFileHeader, biggestChunkLen uint64, in *tar.Reader, out *tar.Writer) error {
+// doRepackCatchpoint writes the header and then the chunks read from in to out, calling chunkDone after each of them.
+func doRepackCatchpoint(ctx context.Context, header CatchpointFileHeader, biggestChunkLen uint64, in *tar.Reader, out *tar.Writer, chunkDone func() error) error {
 	bytes := protocol.Encode(&header)
 
 	err := out.WriteHeader(&tar.Header{
@@ -618,6 +619,10 @@ func doRepackCatchpoint(ctx context.Context, header CatchpointFileHeader, bigges
 	if err != nil {
 		return err
 	}
+	err = chunkDone()
+	if err != nil {
+		return err
+	}
 
 	// make buffer for re-use that can fit biggest chunk
 	buf := make([]byte, biggestChunkLen)
@@ -652,6 +657,10 @@ func doRepackCatchpoint(ctx context.Context, header CatchpointFileHeader, bigges
 		if err != nil {
 			return err
 		}
+		err = chunkDone()
+		if err != nil {
+			return err
+		}
 	}
 }
 
@@ -660,6 +669,10 @@ func doRepackCatchpoint(ctx context.Context, header CatchpointFileHeader, bigges
 // dataPath and regurgitates it to look like catchpoints have always looked - a
 // tar file with the header in the first "file" and the catchpoint data in file
 // chunks, all compressed with gzip instead of snappy.
+// Every chunk is compressed in a gzip member of its own, which decompresses
+// just like a single gzip stream, and the offsets of these members are written
+// to a chunk index next to outPath. This lets the chunks be served from any
+// point of the file without decompressing the ones preceding it.
 func repackCatchpoint(ctx context.Context, header CatchpointFileHeader, biggestChunkLen uint64, dataPath string, outPath string) error {
 	// Initialize streams.
 	fin, err := os.OpenFile(dataPath, os.O_RDONLY, 0666)
@@ -682,7 +695,8 @@ func repackCatchpoint(ctx context.Context, header CatchpointFileHeader, biggestC
 	}
 	defer fout.Close()
 
-	gzipOut, err := gzip.NewWriterLevel(fout, gzip.BestSpeed)
+	countingOut := &countingWriter{Writer: fout}
+	gzipOut, err := gzip.NewWriterLevel(countingOut, gzip.BestSpeed)
 	if err != nil {
 		return err
 	}
@@ -691,8 +705,22 @@ func repackCatchpoint(ctx context.Context, header CatchpointFileHeader, biggestC
 	tarOut := tar.NewWriter(gzipOut)
 	defer tarOut.Close()
 
-	// Repack.
-	err = doRepackCatchpoint(ctx, header, biggestChunkLen, tarIn, tarOut)
+	// Repack, starting a new gzip member after every chunk.
+	chunkOffsets := []int64{0}
+	chunkDone := func() error {
+		err := tarOut.Flush()
+		if err != nil {
+			return err
+		}
+		err = gzipOut.Close()
+		if err != nil {
+			return err
+		}
+		chunkOffsets = append(chunkOffsets, countingOut.written)
+		gzipOut.Reset(countingOut)
+		return nil
+	}
+	err = doRepackCatchpoint(ctx, header, biggestChunkLen, tarIn, tarOut, chunkDone)
 	if err != nil {
 		return err
 	}
@@ -723,7 +751,7 @@ func repackCatchpoint(ctx context.Context, header CatchpointFileHeader, biggestC
 		return err
 	}
 
-	return nil
+	return writeCatchpointChunkIndex(outPath+trackerdb.CatchpointChunkIndexFileSuffix, chunkOffsets)
 }
 
 // Create a catchpoint (a label and possibly a file with db record) and remove
@@ -1334,7 +1362,7 @@ func (ct *catchpointTracker) GetCatchpointStream(round basics.Round) (ReadCloseS
 		catchpointPath := filepath.Join(ct.dbDirectory, dbFileName)
 		file, openErr := os.OpenFile(catchpointPath, os.O_RDONLY, 0666)
 		if openErr == nil && file != nil {
-			return &readCloseSizer{ReadCloser: file, size: fileSize}, nil
+			return makeCatchpointStream(file, fileSize), nil
 		}
 		// else, see if this is a file-not-found error
 		if os.IsNotExist(openErr) {
@@ -1376,7 +1404,7 @@ func (ct *catchpointTracker) GetCatchpointStream(round basics.Round) (ReadCloseS
 		if err != nil {
 			ct.log.Warnf("catchpointTracker.GetCatchpointStream() unable to save missing catchpoint entry: %v", err)
 		}
-		return &readCloseSizer{ReadCloser: file, size: fileInfo.Size()}, nil
+		return makeCatchpointStream(file, fileInfo.Size()), nil
 	}
 	return nil, ledgercore.ErrNoEntry{}
 }
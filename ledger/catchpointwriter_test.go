@@ -769,6 +769,64 @@ func TestFullCatchpointWriter(t *testing.T) {
 	}
 }
 
+func TestCatchpointChunkIndex(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	// t.Parallel() NO! config.Consensus is modified
+
+	testProtocolVersion := protocol.ConsensusVersion("test-protocol-TestCatchpointChunkIndex")
+	protoParams := config.Consensus[protocol.ConsensusCurrentVersion]
+	protoParams.CatchpointLookback = 32
+	config.Consensus[testProtocolVersion] = protoParams
+	temporaryDirectory := t.TempDir()
+	defer func() {
+		delete(config.Consensus, testProtocolVersion)
+	}()
+
+	accts := ledgertesting.RandomAccounts(BalancesPerCatchpointFileChunk*3, false)
+	ml := makeMockLedgerForTracker(t, true, 10, testProtocolVersion, []map[basics.Address]basics.AccountData{accts})
+	defer ml.Close()
+
+	conf := config.GetDefaultLocal()
+	conf.CatchpointInterval = 1
+	conf.Archival = true
+	au, _ := newAcctUpdates(t, ml, conf)
+	err := au.loadFromDisk(ml, 0)
+	require.NoError(t, err)
+	au.close()
+
+	catchpointDataFilePath := filepath.Join(temporaryDirectory, "15.data")
+	catchpointFilePath := filepath.Join(temporaryDirectory, "15.catchpoint")
+	testWriteCatchpoint(t, ml.trackerDB(), catchpointDataFilePath, catchpointFilePath, 0)
+
+	// the file still reads as a single gzip stream
+	content := readCatchpointFile(t, catchpointFilePath)
+	require.Len(t, content, 5) // header, state proof verification data and three balances chunks
+
+	file, err := os.Open(catchpointFilePath)
+	require.NoError(t, err)
+	defer file.Close()
+	stream := makeCatchpointStream(file, -1)
+	seeker, ok := stream.(CatchpointChunkSeeker)
+	require.True(t, ok)
+
+	// reading from any chunk, or past the last one, returns the chunks from there on
+	for chunk := 0; chunk <= len(content)+1; chunk++ {
+		require.NoError(t, seeker.SeekChunk(uint64(chunk)))
+		gzipReader, err := gzip.NewReader(stream)
+		require.NoError(t, err)
+		expected := content[:0]
+		if chunk < len(content) {
+			expected = content[chunk:]
+		}
+		require.Equal(t, expected, readCatchpointContent(t, tar.NewReader(gzipReader)), "chunk %d", chunk)
+	}
+
+	// files without a chunk index are only streamed
+	require.NoError(t, os.Remove(catchpointFilePath+trackerdb.CatchpointChunkIndexFileSuffix))
+	_, ok = makeCatchpointStream(file, -1).(CatchpointChunkSeeker)
+	require.False(t, ok)
+}
+
 func TestExactAccountChunk(t *testing.T) {
 	partitiontest.PartitionTest(t)
 	t.Parallel()
@@ -20,6 +20,7 @@ import (
 	"context"
 	"database/sql"
 	"encoding/hex"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"strings"
@@ -55,12 +56,23 @@ type CatchpointCatchupAccessor interface {
 	// SetLabel set the catchpoint catchup label
 	SetLabel(ctx context.Context, label string) (err error)
 
+	// GetLocalFiles returns the catchpoint file and the blocks file the catchpoint catchup is installed from, or empty strings
+	// if the catchup is using the network
+	GetLocalFiles(ctx context.Context) (catchpointFile string, blocksFile string, err error)
+
+	// SetLocalFiles sets the catchpoint file and the blocks file the catchpoint catchup is installed from
+	SetLocalFiles(ctx context.Context, catchpointFile string, blocksFile string) (err error)
+
 	// ResetStagingBalances resets the current staging balances, preparing for a new set of balances to be added
 	ResetStagingBalances(ctx context.Context, newCatchup bool) (err error)
 
 	// ProcessStagingBalances deserialize the given bytes as a temporary staging balances
 	ProcessStagingBalances(ctx context.Context, sectionName string, bytes []byte, progress *CatchpointCatchupAccessorProgress) (err error)
 
+	// RestoreStagingBalancesProgress loads the progress of a previously interrupted catchpoint file download, allowing
+	// the download to be resumed from the first catchpoint file section that wasn't processed yet.
+	RestoreStagingBalancesProgress(ctx context.Context, progress *CatchpointCatchupAccessorProgress) (err error)
+
 	// BuildMerkleTrie inserts the account hashes into the merkle trie
 	BuildMerkleTrie(ctx context.Context, progressUpdates func(uint64, uint64)) (err error)
 
@@ -94,97 +106,79 @@ type CatchpointCatchupAccessor interface {
 	Ledger() (l CatchupAccessorClientLedger)
 }
 
+// stagingWriter writes a catchpoint file chunk into the staging tables. All the writes of a single chunk are done
+// within one transaction, along with the download progress describing it, so that a resumed download never
+// finds the staging tables and the stored progress out of sync.
 type stagingWriter interface {
-	writeBalances(context.Context, []trackerdb.NormalizedAccountBalance) error
-	writeCreatables(context.Context, []trackerdb.NormalizedAccountBalance) error
-	writeHashes(context.Context, []trackerdb.NormalizedAccountBalance) error
-	writeKVs(context.Context, []encoded.KVRecordV6) error
-	isShared() bool
+	transaction(context.Context, func(context.Context, trackerdb.CatchpointReaderWriter) error) error
+	writeBalances(context.Context, trackerdb.CatchpointWriter, []trackerdb.NormalizedAccountBalance) error
+	writeCreatables(context.Context, trackerdb.CatchpointWriter, []trackerdb.NormalizedAccountBalance) error
+	writeHashes(context.Context, trackerdb.CatchpointWriter, []trackerdb.NormalizedAccountBalance) error
+	writeKVs(context.Context, trackerdb.CatchpointWriter, []encoded.KVRecordV6) error
 }
 
 type stagingWriterImpl struct {
 	wdb trackerdb.TrackerStore
 }
 
-func (w *stagingWriterImpl) writeBalances(ctx context.Context, balances []trackerdb.NormalizedAccountBalance) error {
-	return w.wdb.Transaction(func(ctx context.Context, tx trackerdb.TransactionScope) (err error) {
+func (w *stagingWriterImpl) transaction(ctx context.Context, fn func(context.Context, trackerdb.CatchpointReaderWriter) error) error {
+	return w.wdb.TransactionContext(ctx, func(ctx context.Context, tx trackerdb.TransactionScope) (err error) {
 		crw, err := tx.MakeCatchpointReaderWriter()
 		if err != nil {
 			return err
 		}
-		return crw.WriteCatchpointStagingBalances(ctx, balances)
+		return fn(ctx, crw)
 	})
 }
 
-func (w *stagingWriterImpl) writeKVs(ctx context.Context, kvrs []encoded.KVRecordV6) error {
-	return w.wdb.Transaction(func(ctx context.Context, tx trackerdb.TransactionScope) (err error) {
-		crw, err := tx.MakeCatchpointReaderWriter()
-		if err != nil {
-			return err
-		}
-
-		keys := make([][]byte, len(kvrs))
-		values := make([][]byte, len(kvrs))
-		hashes := make([][]byte, len(kvrs))
-		for i := 0; i < len(kvrs); i++ {
-			keys[i] = kvrs[i].Key
-
-			// Since `encoded.KVRecordV6` is `omitempty` and `omitemptyarray`,
-			// when we have an instance of `encoded.KVRecordV6` with nil value,
-			// an empty box is unmarshalled to have `nil` value,
-			// while this might be mistaken to be a box deletion.
-			//
-			// We don't want to mistake this to be a deleted box:
-			// We are (and should be) during Fast Catchup (FC)
-			// writing to DB with empty byte string, rather than writing nil.
-			//
-			// This matters in sqlite3,
-			// for sqlite3 differs on writing nil byte slice to table from writing []byte{}:
-			// - writing nil byte slice is true that `value is NULL`
-			// - writing []byte{} is false on `value is NULL`.
-			//
-			// For the sake of consistency, we convert nil to []byte{}.
-			//
-			// Also, from a round by round catchup perspective,
-			// when we delete a box, in accountsNewRoundImpl method,
-			// the kv pair with value = nil will be deleted from kvstore table.
-			// Thus, it seems more consistent and appropriate to write as []byte{}.
-
-			if kvrs[i].Value == nil {
-				kvrs[i].Value = []byte{}
-			}
-			values[i] = kvrs[i].Value
-			hashes[i] = trackerdb.KvHashBuilderV6(string(keys[i]), values[i])
-		}
-
-		return crw.WriteCatchpointStagingKVs(ctx, keys, values, hashes)
-	})
+func (w *stagingWriterImpl) writeBalances(ctx context.Context, cw trackerdb.CatchpointWriter, balances []trackerdb.NormalizedAccountBalance) error {
+	return cw.WriteCatchpointStagingBalances(ctx, balances)
 }
 
-func (w *stagingWriterImpl) writeCreatables(ctx context.Context, balances []trackerdb.NormalizedAccountBalance) error {
-	return w.wdb.Transaction(func(ctx context.Context, tx trackerdb.TransactionScope) error {
-		crw, err := tx.MakeCatchpointReaderWriter()
-		if err != nil {
-			return err
+func (w *stagingWriterImpl) writeKVs(ctx context.Context, cw trackerdb.CatchpointWriter, kvrs []encoded.KVRecordV6) error {
+	keys := make([][]byte, len(kvrs))
+	values := make([][]byte, len(kvrs))
+	hashes := make([][]byte, len(kvrs))
+	for i := 0; i < len(kvrs); i++ {
+		keys[i] = kvrs[i].Key
+
+		// Since `encoded.KVRecordV6` is `omitempty` and `omitemptyarray`,
+		// when we have an instance of `encoded.KVRecordV6` with nil value,
+		// an empty box is unmarshalled to have `nil` value,
+		// while this might be mistaken to be a box deletion.
+		//
+		// We don't want to mistake this to be a deleted box:
+		// We are (and should be) during Fast Catchup (FC)
+		// writing to DB with empty byte string, rather than writing nil.
+		//
+		// This matters in sqlite3,
+		// for sqlite3 differs on writing nil byte slice to table from writing []byte{}:
+		// - writing nil byte slice is true that `value is NULL`
+		// - writing []byte{} is false on `value is NULL`.
+		//
+		// For the sake of consistency, we convert nil to []byte{}.
+		//
+		// Also, from a round by round catchup perspective,
+		// when we delete a box, in accountsNewRoundImpl method,
+		// the kv pair with value = nil will be deleted from kvstore table.
+		// Thus, it seems more consistent and appropriate to write as []byte{}.
+
+		if kvrs[i].Value == nil {
+			kvrs[i].Value = []byte{}
 		}
+		values[i] = kvrs[i].Value
+		hashes[i] = trackerdb.KvHashBuilderV6(string(keys[i]), values[i])
+	}
 
-		return crw.WriteCatchpointStagingCreatable(ctx, balances)
-	})
+	return cw.WriteCatchpointStagingKVs(ctx, keys, values, hashes)
 }
 
-func (w *stagingWriterImpl) writeHashes(ctx context.Context, balances []trackerdb.NormalizedAccountBalance) error {
-	return w.wdb.Transaction(func(ctx context.Context, tx trackerdb.TransactionScope) error {
-		crw, err := tx.MakeCatchpointReaderWriter()
-		if err != nil {
-			return err
-		}
-
-		return crw.WriteCatchpointStagingHashes(ctx, balances)
-	})
+func (w *stagingWriterImpl) writeCreatables(ctx context.Context, cw trackerdb.CatchpointWriter, balances []trackerdb.NormalizedAccountBalance) error {
+	return cw.WriteCatchpointStagingCreatable(ctx, balances)
 }
 
-func (w *stagingWriterImpl) isShared() bool {
-	return w.wdb.IsSharedCacheConnection()
+func (w *stagingWriterImpl) writeHashes(ctx context.Context, cw trackerdb.CatchpointWriter, balances []trackerdb.NormalizedAccountBalance) error {
+	return cw.WriteCatchpointStagingHashes(ctx, balances)
 }
 
 // catchpointCatchupAccessorImpl is the concrete implementation of the CatchpointCatchupAccessor interface
@@ -297,6 +291,43 @@ func (c *catchpointCatchupAccessorImpl) SetLabel(ctx context.Context, label stri
 	return
 }
 
+// catchpointCatchupLocalFiles is the persisted form of the local files a catchpoint catchup is installed from.
+type catchpointCatchupLocalFiles struct {
+	CatchpointFile string
+	BlocksFile     string
+}
+
+// GetLocalFiles returns the catchpoint file and the blocks file the catchpoint catchup is installed from, or empty strings
+// if the catchup is using the network
+func (c *catchpointCatchupAccessorImpl) GetLocalFiles(ctx context.Context) (catchpointFile string, blocksFile string, err error) {
+	encoded, err := c.catchpointStore.ReadCatchpointStateString(ctx, trackerdb.CatchpointStateCatchupLocalFiles)
+	if err != nil {
+		return "", "", fmt.Errorf("unable to read catchpoint catchup state '%s': %v", trackerdb.CatchpointStateCatchupLocalFiles, err)
+	}
+	if encoded == "" {
+		return "", "", nil
+	}
+	var files catchpointCatchupLocalFiles
+	err = json.Unmarshal([]byte(encoded), &files)
+	if err != nil {
+		return "", "", fmt.Errorf("unable to decode catchpoint catchup state '%s': %v", trackerdb.CatchpointStateCatchupLocalFiles, err)
+	}
+	return files.CatchpointFile, files.BlocksFile, nil
+}
+
+// SetLocalFiles sets the catchpoint file and the blocks file the catchpoint catchup is installed from
+func (c *catchpointCatchupAccessorImpl) SetLocalFiles(ctx context.Context, catchpointFile string, blocksFile string) (err error) {
+	encoded, err := json.Marshal(&catchpointCatchupLocalFiles{CatchpointFile: catchpointFile, BlocksFile: blocksFile})
+	if err != nil {
+		return err
+	}
+	err = c.catchpointStore.WriteCatchpointStateString(ctx, trackerdb.CatchpointStateCatchupLocalFiles, string(encoded))
+	if err != nil {
+		return fmt.Errorf("unable to write catchpoint catchup state '%s': %v", trackerdb.CatchpointStateCatchupLocalFiles, err)
+	}
+	return
+}
+
 // ResetStagingBalances resets the current staging balances, preparing for a new set of balances to be added
 func (c *catchpointCatchupAccessorImpl) ResetStagingBalances(ctx context.Context, newCatchup bool) (err error) {
 	if !newCatchup {
@@ -314,6 +345,10 @@ func (c *catchpointCatchupAccessorImpl) ResetStagingBalances(ctx context.Context
 		if err != nil {
 			return fmt.Errorf("unable to reset catchpoint catchup balances : %v", err)
 		}
+		err = crw.WriteCatchpointStateString(ctx, trackerdb.CatchpointStateCatchupDownloadProgress, "")
+		if err != nil {
+			return fmt.Errorf("unable to reset catchpoint catchup state '%s': %v", trackerdb.CatchpointStateCatchupDownloadProgress, err)
+		}
 		if !newCatchup {
 			err = crw.WriteCatchpointStateUint64(ctx, trackerdb.CatchpointStateCatchupBalancesRound, 0)
 			if err != nil {
@@ -329,6 +364,10 @@ func (c *catchpointCatchupAccessorImpl) ResetStagingBalances(ctx context.Context
 			if err != nil {
 				return err
 			}
+			err = crw.WriteCatchpointStateString(ctx, trackerdb.CatchpointStateCatchupLocalFiles, "")
+			if err != nil {
+				return err
+			}
 			err = crw.WriteCatchpointStateUint64(ctx, trackerdb.CatchpointStateCatchupState, 0)
 			if err != nil {
 				return fmt.Errorf("unable to write catchpoint catchup state '%s': %v", trackerdb.CatchpointStateCatchupState, err)
@@ -337,6 +376,11 @@ func (c *catchpointCatchupAccessorImpl) ResetStagingBalances(ctx context.Context
 		return
 	})
 	ledgerResetstagingbalancesMicros.AddMicrosecondsSince(start, nil)
+	if err == nil {
+		c.acctResCnt = catchpointAccountResourceCounter{}
+		c.expectingSpecificAccount = false
+		c.nextExpectedAccount = basics.Address{}
+	}
 	return
 }
 
@@ -351,6 +395,12 @@ type CatchpointCatchupAccessorProgress struct {
 	SeenHeader         bool
 	Version            uint64
 	TotalAccountHashes uint64
+	// NextChunk is the index of the next catchpoint file chunk ( i.e. tar entry ) to be downloaded. It's set by the caller
+	// of ProcessStagingBalances before each section, and persisted along with the writes of that section, so that an
+	// interrupted download resumes right after the last section that was committed.
+	NextChunk uint64
+	// ProcessedChunks is the number of balances chunks processed so far, out of TotalChunks.
+	ProcessedChunks uint64
 
 	// Having the cachedTrie here would help to accelerate the catchup process since the trie maintain an internal cache of nodes.
 	// While rebuilding the trie, we don't want to force and reload (some) of these nodes into the cache for each catchpoint file chunk.
@@ -364,37 +414,173 @@ type CatchpointCatchupAccessorProgress struct {
 
 // ProcessStagingBalances deserialize the given bytes as a temporary staging balances
 func (c *catchpointCatchupAccessorImpl) ProcessStagingBalances(ctx context.Context, sectionName string, bytes []byte, progress *CatchpointCatchupAccessorProgress) (err error) {
+	// each section is processed against a copy of the progress, which is persisted along with the section's writes
+	// and is only handed back to the caller once these were committed.
+	next := *progress
+
 	// content.msgpack comes first, followed by stateProofVerificationContext.msgpack and then by balances.x.msgpack.
-	if sectionName == CatchpointContentFileName {
-		return c.processStagingContent(ctx, bytes, progress)
+	switch {
+	case sectionName == CatchpointContentFileName:
+		err = c.processStagingContent(ctx, bytes, &next)
+	case sectionName == catchpointSPVerificationFileName:
+		err = c.processStagingStateProofVerificationContext(ctx, bytes, &next)
+	case strings.HasPrefix(sectionName, catchpointBalancesFileNamePrefix) && strings.HasSuffix(sectionName, catchpointBalancesFileNameSuffix):
+		next.ProcessedChunks++
+		err = c.processStagingBalances(ctx, bytes, &next)
+	default:
+		// we want to allow undefined sections to support backward compatibility.
+		c.log.Warnf("CatchpointCatchupAccessorImpl::ProcessStagingBalances encountered unexpected section name '%s' of length %d, which would be ignored", sectionName, len(bytes))
+		err = c.stagingWriter.transaction(ctx, func(ctx context.Context, crw trackerdb.CatchpointReaderWriter) error {
+			return c.storeStagingBalancesProgress(ctx, crw, &next, c.expectingSpecificAccount, c.nextExpectedAccount)
+		})
+	}
+	if err != nil {
+		return err
+	}
+	*progress = next
+	return nil
+}
+
+// catchpointCatchupDownloadProgress is the persisted form of the catchpoint file download progress. It's stored after each
+// processed section so that an interrupted download could be resumed without discarding the staging tables.
+type catchpointCatchupDownloadProgress struct {
+	Label                    string
+	TotalAccounts            uint64
+	ProcessedAccounts        uint64
+	ProcessedBytes           uint64
+	TotalKVs                 uint64
+	ProcessedKVs             uint64
+	TotalChunks              uint64
+	SeenHeader               bool
+	Version                  uint64
+	TotalAccountHashes       uint64
+	NextChunk                uint64
+	ProcessedChunks          uint64
+	ExpectingSpecificAccount bool
+	NextExpectedAccount      basics.Address
+	TotalAppParams           uint64
+	TotalAppLocalStates      uint64
+	TotalAssetParams         uint64
+	TotalAssets              uint64
+}
+
+// storeStagingBalancesProgress persists the given progress along with the given account validation state. It's called
+// from within the transaction writing the section the progress accounts for.
+func (c *catchpointCatchupAccessorImpl) storeStagingBalancesProgress(ctx context.Context, crw trackerdb.CatchpointReaderWriter, progress *CatchpointCatchupAccessorProgress, expectingSpecificAccount bool, nextExpectedAccount basics.Address) (err error) {
+	label, err := crw.ReadCatchpointStateString(ctx, trackerdb.CatchpointStateCatchupLabel)
+	if err != nil {
+		return fmt.Errorf("unable to read catchpoint catchup state '%s': %v", trackerdb.CatchpointStateCatchupLabel, err)
+	}
+	state := catchpointCatchupDownloadProgress{
+		Label:                    label,
+		TotalAccounts:            progress.TotalAccounts,
+		ProcessedAccounts:        progress.ProcessedAccounts,
+		ProcessedBytes:           progress.ProcessedBytes,
+		TotalKVs:                 progress.TotalKVs,
+		ProcessedKVs:             progress.ProcessedKVs,
+		TotalChunks:              progress.TotalChunks,
+		SeenHeader:               progress.SeenHeader,
+		Version:                  progress.Version,
+		TotalAccountHashes:       progress.TotalAccountHashes,
+		NextChunk:                progress.NextChunk,
+		ProcessedChunks:          progress.ProcessedChunks,
+		ExpectingSpecificAccount: expectingSpecificAccount,
+		NextExpectedAccount:      nextExpectedAccount,
+		TotalAppParams:           c.acctResCnt.totalAppParams,
+		TotalAppLocalStates:      c.acctResCnt.totalAppLocalStates,
+		TotalAssetParams:         c.acctResCnt.totalAssetParams,
+		TotalAssets:              c.acctResCnt.totalAssets,
+	}
+	encoded, err := json.Marshal(&state)
+	if err != nil {
+		return err
+	}
+	err = crw.WriteCatchpointStateString(ctx, trackerdb.CatchpointStateCatchupDownloadProgress, string(encoded))
+	if err != nil {
+		return fmt.Errorf("unable to write catchpoint catchup state '%s': %v", trackerdb.CatchpointStateCatchupDownloadProgress, err)
+	}
+	return nil
+}
+
+// RestoreStagingBalancesProgress loads the progress of a previously interrupted catchpoint file download, allowing
+// the download to be resumed from the first catchpoint file section that wasn't processed yet. If there is no stored
+// progress for the current catchpoint label, the progress is left zeroed.
+func (c *catchpointCatchupAccessorImpl) RestoreStagingBalancesProgress(ctx context.Context, progress *CatchpointCatchupAccessorProgress) (err error) {
+	*progress = CatchpointCatchupAccessorProgress{}
+	c.acctResCnt = catchpointAccountResourceCounter{}
+	c.expectingSpecificAccount = false
+	c.nextExpectedAccount = basics.Address{}
+
+	encoded, err := c.catchpointStore.ReadCatchpointStateString(ctx, trackerdb.CatchpointStateCatchupDownloadProgress)
+	if err != nil {
+		return fmt.Errorf("unable to read catchpoint catchup state '%s': %v", trackerdb.CatchpointStateCatchupDownloadProgress, err)
+	}
+	if encoded == "" {
+		return nil
+	}
+	var state catchpointCatchupDownloadProgress
+	err = json.Unmarshal([]byte(encoded), &state)
+	if err != nil {
+		return fmt.Errorf("unable to decode catchpoint catchup state '%s': %v", trackerdb.CatchpointStateCatchupDownloadProgress, err)
+	}
+	label, err := c.catchpointStore.ReadCatchpointStateString(ctx, trackerdb.CatchpointStateCatchupLabel)
+	if err != nil {
+		return fmt.Errorf("unable to read catchpoint catchup state '%s': %v", trackerdb.CatchpointStateCatchupLabel, err)
+	}
+	if state.Label != label {
+		// the stored progress belongs to a different catchpoint; it can't be resumed.
+		return nil
 	}
-	if sectionName == catchpointSPVerificationFileName {
-		return c.processStagingStateProofVerificationContext(bytes)
+
+	progress.TotalAccounts = state.TotalAccounts
+	progress.ProcessedAccounts = state.ProcessedAccounts
+	progress.ProcessedBytes = state.ProcessedBytes
+	progress.TotalKVs = state.TotalKVs
+	progress.ProcessedKVs = state.ProcessedKVs
+	progress.TotalChunks = state.TotalChunks
+	progress.SeenHeader = state.SeenHeader
+	progress.Version = state.Version
+	progress.TotalAccountHashes = state.TotalAccountHashes
+	progress.NextChunk = state.NextChunk
+	progress.ProcessedChunks = state.ProcessedChunks
+	c.expectingSpecificAccount = state.ExpectingSpecificAccount
+	c.nextExpectedAccount = state.NextExpectedAccount
+	c.acctResCnt = catchpointAccountResourceCounter{
+		totalAppParams:      state.TotalAppParams,
+		totalAppLocalStates: state.TotalAppLocalStates,
+		totalAssetParams:    state.TotalAssetParams,
+		totalAssets:         state.TotalAssets,
 	}
-	if strings.HasPrefix(sectionName, catchpointBalancesFileNamePrefix) && strings.HasSuffix(sectionName, catchpointBalancesFileNameSuffix) {
-		return c.processStagingBalances(ctx, bytes, progress)
+
+	// processStagingContent switches to the rebuild synchronous mode until all the accounts are processed; restore that as well.
+	if progress.SeenHeader && progress.ProcessedAccounts < progress.TotalAccounts {
+		c.ledger.setSynchronousMode(ctx, c.ledger.accountsRebuildSynchronousMode)
 	}
-	// we want to allow undefined sections to support backward compatibility.
-	c.log.Warnf("CatchpointCatchupAccessorImpl::ProcessStagingBalances encountered unexpected section name '%s' of length %d, which would be ignored", sectionName, len(bytes))
 	return nil
 }
 
 // processStagingStateProofVerificationContext deserialize the given bytes as a temporary staging state proof verification data
-func (c *catchpointCatchupAccessorImpl) processStagingStateProofVerificationContext(bytes []byte) (err error) {
+func (c *catchpointCatchupAccessorImpl) processStagingStateProofVerificationContext(ctx context.Context, bytes []byte, progress *CatchpointCatchupAccessorProgress) (err error) {
 	var decodedData catchpointStateProofVerificationContext
 	err = protocol.Decode(bytes, &decodedData)
 	if err != nil {
 		return err
 	}
 
-	if len(decodedData.Data) == 0 {
-		return
-	}
-
 	// 6 months of stuck state proofs should lead to about 1.5 MB of data, so we avoid redundant timers
 	// and progress reports.
-	err = c.ledger.trackerDB().Batch(func(ctx context.Context, tx trackerdb.BatchScope) (err error) {
-		return tx.MakeSpVerificationCtxWriter().StoreSPContextsToCatchpointTbl(ctx, decodedData.Data)
+	err = c.ledger.trackerDB().TransactionContext(ctx, func(ctx context.Context, tx trackerdb.TransactionScope) (err error) {
+		if len(decodedData.Data) > 0 {
+			err = tx.MakeSpVerificationCtxReaderWriter().StoreSPContextsToCatchpointTbl(ctx, decodedData.Data)
+			if err != nil {
+				return err
+			}
+		}
+		crw, err := tx.MakeCatchpointReaderWriter()
+		if err != nil {
+			return err
+		}
+		return c.storeStagingBalancesProgress(ctx, crw, progress, c.expectingSpecificAccount, c.nextExpectedAccount)
 	})
 
 	return err
@@ -418,13 +604,20 @@ func (c *catchpointCatchupAccessorImpl) processStagingContent(ctx context.Contex
 		return fmt.Errorf("CatchpointCatchupAccessorImpl::processStagingContent: unable to process catchpoint - version %d is not supported", fileHeader.Version)
 	}
 
+	progress.SeenHeader = true
+	progress.TotalAccounts = fileHeader.TotalAccounts
+	progress.TotalKVs = fileHeader.TotalKVs
+
+	progress.TotalChunks = fileHeader.TotalChunks
+	progress.Version = fileHeader.Version
+
 	// the following fields are now going to be ignored. We could add these to the database and validate these
 	// later on:
 	// TotalAccounts, TotalAccounts, Catchpoint, BlockHeaderDigest, BalancesRound
 	start := time.Now()
 	ledgerProcessstagingcontentCount.Inc(nil)
-	err = c.ledger.trackerDB().Batch(func(ctx context.Context, tx trackerdb.BatchScope) (err error) {
-		cw, err := tx.MakeCatchpointWriter()
+	err = c.ledger.trackerDB().TransactionContext(ctx, func(ctx context.Context, tx trackerdb.TransactionScope) (err error) {
+		cw, err := tx.MakeCatchpointReaderWriter()
 		if err != nil {
 			return err
 		}
@@ -432,7 +625,7 @@ func (c *catchpointCatchupAccessorImpl) processStagingContent(ctx context.Contex
 		if err != nil {
 			return fmt.Errorf("CatchpointCatchupAccessorImpl::processStagingContent: unable to write catchpoint catchup version '%s': %v", trackerdb.CatchpointStateCatchupVersion, err)
 		}
-		aw, err := tx.MakeAccountsWriter()
+		aw, err := tx.MakeAccountsReaderWriter()
 		if err != nil {
 			return err
 		}
@@ -448,16 +641,13 @@ func (c *catchpointCatchupAccessorImpl) processStagingContent(ctx context.Contex
 			}
 		}
 		err = aw.AccountsPutTotals(fileHeader.Totals, true)
-		return
+		if err != nil {
+			return err
+		}
+		return c.storeStagingBalancesProgress(ctx, cw, progress, c.expectingSpecificAccount, c.nextExpectedAccount)
 	})
 	ledgerProcessstagingcontentMicros.AddMicrosecondsSince(start, nil)
 	if err == nil {
-		progress.SeenHeader = true
-		progress.TotalAccounts = fileHeader.TotalAccounts
-		progress.TotalKVs = fileHeader.TotalKVs
-
-		progress.TotalChunks = fileHeader.TotalChunks
-		progress.Version = fileHeader.Version
 		c.ledger.setSynchronousMode(ctx, c.ledger.accountsRebuildSynchronousMode)
 	}
 
@@ -588,93 +778,67 @@ func (c *catchpointCatchupAccessorImpl) processStagingBalances(ctx context.Conte
 		}
 	}
 
-	wg := sync.WaitGroup{}
+	progress.ProcessedBytes += uint64(len(bytes))
+	progress.ProcessedKVs += uint64(len(chunkKVs))
+	for _, acctBal := range normalizedAccountBalances {
+		progress.TotalAccountHashes += uint64(len(acctBal.AccountHashes))
+		if !acctBal.PartialBalance {
+			progress.ProcessedAccounts++
+		}
+	}
+
+	hasCreatables := false
+	for _, accBal := range normalizedAccountBalances {
+		for _, res := range accBal.Resources {
+			if res.IsOwning() {
+				hasCreatables = true
+				break
+			}
+		}
+	}
 
-	var errBalances error
-	var errCreatables error
-	var errHashes error
-	var errKVs error
 	var durBalances time.Duration
 	var durCreatables time.Duration
 	var durHashes time.Duration
 	var durKVs time.Duration
 
-	// start the balances writer
-	wg.Add(1)
-	go func() {
-		defer wg.Done()
+	// write the chunk into the staging tables along with the progress it brings us to, so that an interruption
+	// either keeps both or neither of them.
+	err = c.stagingWriter.transaction(ctx, func(ctx context.Context, crw trackerdb.CatchpointReaderWriter) (err error) {
 		writeBalancesStart := time.Now()
-		errBalances = c.stagingWriter.writeBalances(ctx, normalizedAccountBalances)
+		err = c.stagingWriter.writeBalances(ctx, crw, normalizedAccountBalances)
+		if err != nil {
+			return err
+		}
 		durBalances = time.Since(writeBalancesStart)
-	}()
-
-	// on a in-memory database, wait for the writer to finish before starting the new writer
-	if c.stagingWriter.isShared() {
-		wg.Wait()
-	}
 
-	// starts the creatables writer
-	wg.Add(1)
-	go func() {
-		defer wg.Done()
-		hasCreatables := false
-		for _, accBal := range normalizedAccountBalances {
-			for _, res := range accBal.Resources {
-				if res.IsOwning() {
-					hasCreatables = true
-					break
-				}
-			}
-		}
 		if hasCreatables {
 			writeCreatablesStart := time.Now()
-			errCreatables = c.stagingWriter.writeCreatables(ctx, normalizedAccountBalances)
+			err = c.stagingWriter.writeCreatables(ctx, crw, normalizedAccountBalances)
+			if err != nil {
+				return err
+			}
 			durCreatables = time.Since(writeCreatablesStart)
 		}
-	}()
 
-	// on a in-memory database, wait for the writer to finish before starting the new writer
-	if c.stagingWriter.isShared() {
-		wg.Wait()
-	}
-
-	// start the accounts pending hashes writer
-	wg.Add(1)
-	go func() {
-		defer wg.Done()
 		writeHashesStart := time.Now()
-		errHashes = c.stagingWriter.writeHashes(ctx, normalizedAccountBalances)
+		err = c.stagingWriter.writeHashes(ctx, crw, normalizedAccountBalances)
+		if err != nil {
+			return err
+		}
 		durHashes = time.Since(writeHashesStart)
-	}()
-
-	// on a in-memory database, wait for the writer to finish before starting the new writer
-	if c.stagingWriter.isShared() {
-		wg.Wait()
-	}
-
-	// start the kv store writer
-	wg.Add(1)
-	go func() {
-		defer wg.Done()
 
 		writeKVsStart := time.Now()
-		errKVs = c.stagingWriter.writeKVs(ctx, chunkKVs)
+		err = c.stagingWriter.writeKVs(ctx, crw, chunkKVs)
+		if err != nil {
+			return err
+		}
 		durKVs = time.Since(writeKVsStart)
-	}()
-
-	wg.Wait()
 
-	if errBalances != nil {
-		return errBalances
-	}
-	if errCreatables != nil {
-		return errCreatables
-	}
-	if errHashes != nil {
-		return errHashes
-	}
-	if errKVs != nil {
-		return errKVs
+		return c.storeStagingBalancesProgress(ctx, crw, progress, expectingSpecificAccount, nextExpectedAccount)
+	})
+	if err != nil {
+		return err
 	}
 
 	progress.BalancesWriteDuration += durBalances
@@ -683,14 +847,6 @@ func (c *catchpointCatchupAccessorImpl) processStagingBalances(ctx context.Conte
 	progress.KVWriteDuration += durKVs
 
 	ledgerProcessstagingbalancesMicros.AddMicrosecondsSince(start, nil)
-	progress.ProcessedBytes += uint64(len(bytes))
-	progress.ProcessedKVs += uint64(len(chunkKVs))
-	for _, acctBal := range normalizedAccountBalances {
-		progress.TotalAccountHashes += uint64(len(acctBal.AccountHashes))
-		if !acctBal.PartialBalance {
-			progress.ProcessedAccounts++
-		}
-	}
 
 	// not strictly required, but clean up the pointer when we're done.
 	if progress.ProcessedAccounts == progress.TotalAccounts {
@@ -1218,6 +1374,16 @@ func (c *catchpointCatchupAccessorImpl) finishBalances(ctx context.Context) (err
 			return err
 		}
 
+		err = crw.WriteCatchpointStateString(ctx, trackerdb.CatchpointStateCatchupDownloadProgress, "")
+		if err != nil {
+			return err
+		}
+
+		err = crw.WriteCatchpointStateString(ctx, trackerdb.CatchpointStateCatchupLocalFiles, "")
+		if err != nil {
+			return err
+		}
+
 		if hashRound != 0 {
 			err = crw.WriteCatchpointStateUint64(ctx, trackerdb.CatchpointStateCatchupHashRound, 0)
 			if err != nil {
@@ -19,6 +19,7 @@ package ledger
 import (
 	"context"
 	"encoding/binary"
+	"errors"
 	"fmt"
 	"math/rand"
 	"os"
@@ -277,8 +278,24 @@ func TestCatchupAccessorFoo(t *testing.T) {
 	require.Equal(t, calabel, label)
 	t.Logf("catchpoint label %#v", label)
 
+	catchpointFile, blocksFile, err := catchpointAccessor.GetLocalFiles(context.Background())
+	require.NoError(t, err, "catchpointAccessor.GetLocalFiles")
+	require.Empty(t, catchpointFile)
+	require.Empty(t, blocksFile)
+	err = catchpointAccessor.SetLocalFiles(context.Background(), "/tmp/ledger.catchpoint", "/tmp/ledger.blocks")
+	require.NoError(t, err, "catchpointAccessor.SetLocalFiles")
+	catchpointFile, blocksFile, err = catchpointAccessor.GetLocalFiles(context.Background())
+	require.NoError(t, err, "catchpointAccessor.GetLocalFiles")
+	require.Equal(t, "/tmp/ledger.catchpoint", catchpointFile)
+	require.Equal(t, "/tmp/ledger.blocks", blocksFile)
+
 	err = catchpointAccessor.ResetStagingBalances(context.Background(), false)
 	require.NoError(t, err, "ResetStagingBalances")
+
+	catchpointFile, blocksFile, err = catchpointAccessor.GetLocalFiles(context.Background())
+	require.NoError(t, err, "catchpointAccessor.GetLocalFiles")
+	require.Empty(t, catchpointFile)
+	require.Empty(t, blocksFile)
 }
 
 func TestBuildMerkleTrie(t *testing.T) {
@@ -527,19 +544,19 @@ type testStagingWriter struct {
 	hashes map[[4 + crypto.DigestSize]byte]int
 }
 
-func (w *testStagingWriter) writeBalances(ctx context.Context, balances []trackerdb.NormalizedAccountBalance) error {
+func (w *testStagingWriter) writeBalances(ctx context.Context, cw trackerdb.CatchpointWriter, balances []trackerdb.NormalizedAccountBalance) error {
 	return nil
 }
 
-func (w *testStagingWriter) writeCreatables(ctx context.Context, balances []trackerdb.NormalizedAccountBalance) error {
+func (w *testStagingWriter) writeCreatables(ctx context.Context, cw trackerdb.CatchpointWriter, balances []trackerdb.NormalizedAccountBalance) error {
 	return nil
 }
 
-func (w *testStagingWriter) writeKVs(ctx context.Context, kvrs []encoded.KVRecordV6) error {
+func (w *testStagingWriter) writeKVs(ctx context.Context, cw trackerdb.CatchpointWriter, kvrs []encoded.KVRecordV6) error {
 	return nil
 }
 
-func (w *testStagingWriter) writeHashes(ctx context.Context, balances []trackerdb.NormalizedAccountBalance) error {
+func (w *testStagingWriter) writeHashes(ctx context.Context, cw trackerdb.CatchpointWriter, balances []trackerdb.NormalizedAccountBalance) error {
 	for _, bal := range balances {
 		for _, hash := range bal.AccountHashes {
 			var key [4 + crypto.DigestSize]byte
@@ -551,8 +568,22 @@ func (w *testStagingWriter) writeHashes(ctx context.Context, balances []trackerd
 	return nil
 }
 
-func (w *testStagingWriter) isShared() bool {
-	return false
+// testStagingCatchpointStore stands in for the catchpoint tables of the staging writer transaction; the download
+// progress written along with each chunk is discarded.
+type testStagingCatchpointStore struct {
+	trackerdb.CatchpointReaderWriter
+}
+
+func (testStagingCatchpointStore) ReadCatchpointStateString(ctx context.Context, stateName trackerdb.CatchpointState) (string, error) {
+	return "", nil
+}
+
+func (testStagingCatchpointStore) WriteCatchpointStateString(ctx context.Context, stateName trackerdb.CatchpointState, setValue string) error {
+	return nil
+}
+
+func (w *testStagingWriter) transaction(ctx context.Context, fn func(context.Context, trackerdb.CatchpointReaderWriter) error) error {
+	return fn(ctx, testStagingCatchpointStore{})
 }
 
 // makeTestCatchpointCatchupAccessor creates a CatchpointCatchupAccessor given a ledger
@@ -664,3 +695,101 @@ func TestCatchupAccessorProcessStagingBalances(t *testing.T) {
 		require.Equal(t, 1, count)
 	}
 }
+
+// failingKVsStagingWriter fails the kv writes, after the balances of the same chunk were already written.
+type failingKVsStagingWriter struct {
+	stagingWriter
+}
+
+func (w *failingKVsStagingWriter) writeKVs(ctx context.Context, cw trackerdb.CatchpointWriter, kvrs []encoded.KVRecordV6) error {
+	return errors.New("kv write failure")
+}
+
+// TestCatchupAccessorRestoreStagingBalancesProgress verifies that the catchpoint file download progress is persisted after
+// each processed section, and that a new accessor could pick it up from where the previous one stopped.
+func TestCatchupAccessorRestoreStagingBalancesProgress(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	log := logging.TestingLog(t)
+	dbBaseFileName := t.Name()
+	const inMem = true
+	genesisInitState, _ := ledgertesting.GenerateInitState(t, protocol.ConsensusCurrentVersion, 100)
+	cfg := config.GetDefaultLocal()
+	l, err := OpenLedger(log, dbBaseFileName, inMem, genesisInitState, cfg)
+	require.NoError(t, err, "could not open ledger")
+	defer l.Close()
+
+	ctx := context.Background()
+	const accountsCount = 3 * BalancesPerCatchpointFileChunk
+	catchpointAccessor := MakeCatchpointCatchupAccessor(l, log)
+	err = catchpointAccessor.ResetStagingBalances(ctx, true)
+	require.NoError(t, err)
+	err = catchpointAccessor.SetLabel(ctx, "98#QGMCMMUPV74AXXVKSNPRN73XMJG44ZJTZHU25HDG7JH5OHMM6N3Q")
+	require.NoError(t, err)
+
+	// nothing was stored yet.
+	var restored CatchpointCatchupAccessorProgress
+	err = catchpointAccessor.RestoreStagingBalancesProgress(ctx, &restored)
+	require.NoError(t, err)
+	require.Zero(t, restored.NextChunk)
+
+	fileHeader := CatchpointFileHeader{
+		Version:       CatchpointFileVersionV7,
+		TotalAccounts: accountsCount,
+		TotalChunks:   accountsCount / BalancesPerCatchpointFileChunk,
+	}
+	// the download cursor is advanced by the caller, the way the ledger fetcher does.
+	progress := CatchpointCatchupAccessorProgress{NextChunk: 1}
+	err = catchpointAccessor.ProcessStagingBalances(ctx, CatchpointContentFileName, protocol.Encode(&fileHeader), &progress)
+	require.NoError(t, err)
+
+	encodedAccountChunks, _ := createTestingEncodedChunks(accountsCount)
+	balancesFileName := fmt.Sprintf("%s%d%s", catchpointBalancesFileNamePrefix, 1, catchpointBalancesFileNameSuffix)
+	progress.NextChunk = 2
+	err = catchpointAccessor.ProcessStagingBalances(ctx, balancesFileName, encodedAccountChunks[0], &progress)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), progress.NextChunk)
+	require.Equal(t, uint64(1), progress.ProcessedChunks)
+
+	// a chunk failing halfway through its staging writes leaves both the progress and the stored progress untouched.
+	impl := catchpointAccessor.(*catchpointCatchupAccessorImpl)
+	stagingWriter := impl.stagingWriter
+	impl.stagingWriter = &failingKVsStagingWriter{stagingWriter}
+	balancesFileName = fmt.Sprintf("%s%d%s", catchpointBalancesFileNamePrefix, 2, catchpointBalancesFileNameSuffix)
+	progress.NextChunk = 3
+	beforeFailure := progress
+	err = catchpointAccessor.ProcessStagingBalances(ctx, balancesFileName, encodedAccountChunks[1], &progress)
+	require.Error(t, err)
+	require.Equal(t, beforeFailure, progress)
+	err = catchpointAccessor.RestoreStagingBalancesProgress(ctx, &restored)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), restored.NextChunk)
+	require.Equal(t, progress.ProcessedAccounts, restored.ProcessedAccounts)
+	impl.stagingWriter = stagingWriter
+
+	// a new accessor, as created when algod restarts, resumes from the same position.
+	resumedAccessor := MakeCatchpointCatchupAccessor(l, log)
+	err = resumedAccessor.RestoreStagingBalancesProgress(ctx, &restored)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), restored.NextChunk)
+	require.Equal(t, progress.ProcessedChunks, restored.ProcessedChunks)
+	require.Equal(t, progress.ProcessedAccounts, restored.ProcessedAccounts)
+	require.Equal(t, progress.TotalAccountHashes, restored.TotalAccountHashes)
+	require.Equal(t, progress.TotalChunks, restored.TotalChunks)
+	require.True(t, restored.SeenHeader)
+
+	for i, chunk := range encodedAccountChunks[1:] {
+		balancesFileName = fmt.Sprintf("%s%d%s", catchpointBalancesFileNamePrefix, i+2, catchpointBalancesFileNameSuffix)
+		err = resumedAccessor.ProcessStagingBalances(ctx, balancesFileName, chunk, &restored)
+		require.NoError(t, err)
+	}
+	require.Equal(t, uint64(accountsCount), restored.ProcessedAccounts)
+	require.Equal(t, restored.TotalChunks, restored.ProcessedChunks)
+
+	// resetting the staging balances discards the stored progress.
+	err = resumedAccessor.ResetStagingBalances(ctx, true)
+	require.NoError(t, err)
+	err = resumedAccessor.RestoreStagingBalancesProgress(ctx, &restored)
+	require.NoError(t, err)
+	require.Equal(t, CatchpointCatchupAccessorProgress{}, restored)
+}
@@ -60,7 +60,7 @@ type benchConfig struct {
 	blocks    []bookkeeping.Block
 }
 
-func setupEnv(b *testing.B, numAccts int) (bc *benchConfig) {
+func setupEnv(b *testing.B, numAccts int, engine string) (bc *benchConfig) {
 	dbTempDir := b.TempDir()
 	name := b.Name()
 	dbName := fmt.Sprintf("%s.%d", name, crypto.RandUint64())
@@ -90,6 +90,7 @@ func setupEnv(b *testing.B, numAccts int) (bc *benchConfig) {
 	// open 2 ledgers: 1st for preparing the blocks, 2nd for measuring the time
 	inMem := false
 	cfg := config.GetDefaultLocal()
+	cfg.StorageEngine = engine
 	cfg.Archival = false
 	cfg.MaxAcctLookback = uint64(b.N) // prevent committing blocks into DB since we benchmark validation
 	cfg.Archival = true
@@ -388,7 +389,16 @@ func BenchmarkBlockValidationMix(b *testing.B) {
 }
 
 func benchmarkBlockValidationMix(b *testing.B, newAcctProb, payProb, astProb float64, numAccts int) {
-	bc := setupEnv(b, numAccts)
+	for _, engine := range benchmarkStorageEngines {
+		engine := engine
+		b.Run(engine, func(b *testing.B) {
+			benchmarkBlockValidationMixEngine(b, newAcctProb, payProb, astProb, numAccts, engine)
+		})
+	}
+}
+
+func benchmarkBlockValidationMixEngine(b *testing.B, newAcctProb, payProb, astProb float64, numAccts int, engine string) {
+	bc := setupEnv(b, numAccts, engine)
 
 	numBlocks := uint64(b.N)
 	cert := agreement.Certificate{}
@@ -38,6 +38,8 @@ import (
 	"github.com/algorand/go-algorand/ledger/ledgercore"
 	"github.com/algorand/go-algorand/ledger/store/blockdb"
 	"github.com/algorand/go-algorand/ledger/store/trackerdb"
+	"github.com/algorand/go-algorand/ledger/store/trackerdb/pebbledbdriver"
+	"github.com/algorand/go-algorand/ledger/store/trackerdb/pgdriver"
 	"github.com/algorand/go-algorand/ledger/store/trackerdb/sqlitedriver"
 	"github.com/algorand/go-algorand/logging"
 	"github.com/algorand/go-algorand/protocol"
@@ -144,7 +146,7 @@ func OpenLedger(
 		}
 	}()
 
-	l.trackerDBs, l.blockDBs, err = openLedgerDB(dbPathPrefix, dbMem)
+	l.trackerDBs, l.blockDBs, err = openLedgerDB(dbPathPrefix, dbMem, cfg)
 	if err != nil {
 		err = fmt.Errorf("OpenLedger.openLedgerDB %v", err)
 		return nil, err
@@ -284,7 +286,7 @@ func (l *Ledger) verifyMatchingGenesisHash() (err error) {
 	return
 }
 
-func openLedgerDB(dbPathPrefix string, dbMem bool) (trackerDBs trackerdb.TrackerStore, blockDBs db.Pair, err error) {
+func openLedgerDB(dbPathPrefix string, dbMem bool, cfg config.Local) (trackerDBs trackerdb.TrackerStore, blockDBs db.Pair, err error) {
 	// Backwards compatibility: we used to store both blocks and tracker
 	// state in a single SQLite db file.
 	var trackerDBFilename string
@@ -308,7 +310,20 @@ func openLedgerDB(dbPathPrefix string, dbMem bool) (trackerDBs trackerdb.Tracker
 	outErr := make(chan error, 2)
 	go func() {
 		var lerr error
-		trackerDBs, lerr = sqlitedriver.OpenTrackerSQLStore(trackerDBFilename, dbMem)
+		switch cfg.StorageEngine {
+		case "", "sqlite":
+			trackerDBs, lerr = sqlitedriver.OpenTrackerSQLStore(trackerDBFilename, dbMem)
+		case "postgres":
+			if cfg.TrackerDBPostgresDSN == "" {
+				lerr = fmt.Errorf("storage engine %s requires TrackerDBPostgresDSN to be set", cfg.StorageEngine)
+				break
+			}
+			trackerDBs, lerr = pgdriver.OpenTrackerPGStore(cfg.TrackerDBPostgresDSN)
+		case "pebbledb":
+			trackerDBs, lerr = pebbledbdriver.Open(dbPathPrefix+".tracker.pebble", dbMem)
+		default:
+			lerr = fmt.Errorf("unknown storage engine %s", cfg.StorageEngine)
+		}
 		outErr <- lerr
 	}()
 
@@ -630,6 +645,14 @@ func (l *Ledger) LatestTotals() (basics.Round, ledgercore.AccountTotals, error)
 	return l.accts.LatestTotals()
 }
 
+// Totals returns the totals of all accounts at the end of round rnd.
+// Only rounds at or after the trackers' database round are available.
+func (l *Ledger) Totals(rnd basics.Round) (ledgercore.AccountTotals, error) {
+	l.trackerMu.RLock()
+	defer l.trackerMu.RUnlock()
+	return l.accts.Totals(rnd)
+}
+
 // OnlineCirculation returns the online totals of all accounts at the end of round rnd.
 // It implements agreement's calls for Circulation(rnd)
 func (l *Ledger) OnlineCirculation(rnd basics.Round, voteRnd basics.Round) (basics.MicroAlgos, error) {
@@ -129,7 +129,20 @@ func makeUnsignedPaymentTx(sender basics.Address, round int) transactions.Transa
 	}
 }
 
+// benchmarkStorageEngines lists the tracker storage engines the ledger benchmarks are run against,
+// so that the results of the different backends can be compared side by side.
+var benchmarkStorageEngines = []string{"sqlite", "pebbledb"}
+
 func benchmarkFullBlocks(params testParams, b *testing.B) {
+	for _, engine := range benchmarkStorageEngines {
+		engine := engine
+		b.Run(engine, func(b *testing.B) {
+			benchmarkFullBlocksEngine(params, engine, b)
+		})
+	}
+}
+
+func benchmarkFullBlocksEngine(params testParams, engine string, b *testing.B) {
 	// disable deadlock checking code
 	deadlockDisable := deadlock.Opts.Disable
 	deadlock.Opts.Disable = true
@@ -170,6 +183,7 @@ func benchmarkFullBlocks(params testParams, b *testing.B) {
 	const inMem = false // use persistent storage
 	cfg := config.GetDefaultLocal()
 	cfg.Archival = true
+	cfg.StorageEngine = engine
 	l0, err := OpenLedger(logging.Base(), dbPrefix, inMem, genesisInitState, cfg)
 	require.NoError(b, err)
 
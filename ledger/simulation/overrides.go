@@ -0,0 +1,423 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package simulation
+
+import (
+	"fmt"
+
+	"github.com/algorand/avm-abi/apps"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/data/transactions/logic"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+)
+
+// TealValue is the value of a single key in an app's global or local state override.
+type TealValue struct {
+	Type  basics.TealType `codec:"type"`
+	Bytes []byte          `codec:"bytes,omitempty"`
+	Uint  uint64          `codec:"uint,omitempty"`
+}
+
+// TealKeyValue sets a single key in an app's global or local state.
+type TealKeyValue struct {
+	Key   []byte    `codec:"key"`
+	Value TealValue `codec:"value"`
+}
+
+// AssetHoldingOverride replaces an account's holding of an asset. If the account has not opted
+// into the asset, it is opted in.
+type AssetHoldingOverride struct {
+	AssetID basics.AssetIndex `codec:"asset-id"`
+	Amount  uint64            `codec:"amount"`
+	Frozen  bool              `codec:"is-frozen,omitempty"`
+}
+
+// AppLocalStateOverride sets keys in an account's local state for an app. If the account has not
+// opted into the app, it is opted in. Keys that are not listed keep their current values. The keys
+// and values must respect the AVM length limits, and the resulting state must fit the app's local
+// state schema.
+type AppLocalStateOverride struct {
+	AppID    basics.AppIndex `codec:"app-id"`
+	KeyValue []TealKeyValue  `codec:"key-value,omitempty"`
+}
+
+// AccountOverride replaces parts of an account's state.
+type AccountOverride struct {
+	Address        basics.Address          `codec:"address"`
+	Balance        *uint64                 `codec:"balance,omitempty"`
+	AssetHoldings  []AssetHoldingOverride  `codec:"asset-holdings,omitempty"`
+	AppLocalStates []AppLocalStateOverride `codec:"app-local-states,omitempty"`
+}
+
+// ApplicationOverride replaces the programs of an existing app and sets keys in its global state.
+// Programs that are not provided and keys that are not listed keep their current values. The
+// programs must respect the same length and version limits as at app creation, the keys and values
+// must respect the AVM length limits, and the resulting state must fit the app's global state
+// schema.
+type ApplicationOverride struct {
+	AppID             basics.AppIndex `codec:"app-id"`
+	ApprovalProgram   []byte          `codec:"approval-program,omitempty"`
+	ClearStateProgram []byte          `codec:"clear-state-program,omitempty"`
+	GlobalState       []TealKeyValue  `codec:"global-state,omitempty"`
+}
+
+// BoxOverride sets the contents of a box of an existing app, creating the box if needed. The name
+// and the contents must respect the AVM box limits.
+type BoxOverride struct {
+	AppID basics.AppIndex `codec:"app-id"`
+	Name  []byte          `codec:"name"`
+	Value []byte          `codec:"value"`
+}
+
+// StateOverrides describes changes to the ledger state that are applied on top of the state of
+// the round being simulated against. They only affect the simulation they are part of.
+type StateOverrides struct {
+	Accounts     []AccountOverride     `codec:"accounts,omitempty"`
+	Applications []ApplicationOverride `codec:"applications,omitempty"`
+	Boxes        []BoxOverride         `codec:"boxes,omitempty"`
+}
+
+// Empty returns true if no overrides are requested.
+func (so StateOverrides) Empty() bool {
+	return len(so.Accounts) == 0 && len(so.Applications) == 0 && len(so.Boxes) == 0
+}
+
+// stateOverrideLayer holds the overridden ledger state for a single round. The simulatorLedger
+// consults it before falling back to the underlying ledger. All the account data adjustments
+// implied by the overrides (e.g. opt-in counts, box storage and the account totals) are resolved
+// when the layer is built, so lookups are plain map accesses.
+type stateOverrideLayer struct {
+	accounts map[basics.Address]ledgercore.AccountData
+	assets   map[ledgercore.AccountAsset]ledgercore.AssetResource
+	apps     map[ledgercore.AccountApp]ledgercore.AppResource
+	kvs      map[string][]byte
+	totals   ledgercore.AccountTotals
+}
+
+// overrideBuilder resolves StateOverrides against the state of a round of the underlying ledger.
+type overrideBuilder struct {
+	l     simulatorLedger
+	rnd   basics.Round
+	proto config.ConsensusParams
+	layer *stateOverrideLayer
+}
+
+func (b *overrideBuilder) account(addr basics.Address) (ledgercore.AccountData, error) {
+	if ad, ok := b.layer.accounts[addr]; ok {
+		return ad, nil
+	}
+	ad, _, err := b.l.Ledger.LookupWithoutRewards(b.rnd, addr)
+	return ad, err
+}
+
+func (b *overrideBuilder) asset(addr basics.Address, aidx basics.AssetIndex) (ledgercore.AssetResource, error) {
+	aa := ledgercore.AccountAsset{Address: addr, Asset: aidx}
+	if res, ok := b.layer.assets[aa]; ok {
+		return res, nil
+	}
+	return b.l.Ledger.LookupAsset(b.rnd, addr, aidx)
+}
+
+func (b *overrideBuilder) app(addr basics.Address, aidx basics.AppIndex) (ledgercore.AppResource, error) {
+	aa := ledgercore.AccountApp{Address: addr, App: aidx}
+	if res, ok := b.layer.apps[aa]; ok {
+		return res, nil
+	}
+	return b.l.Ledger.LookupApplication(b.rnd, addr, aidx)
+}
+
+func (b *overrideBuilder) kv(key string) ([]byte, error) {
+	if value, ok := b.layer.kvs[key]; ok {
+		return value, nil
+	}
+	return b.l.Ledger.LookupKv(b.rnd, key)
+}
+
+// appParams returns the creator and the params of an existing app.
+func (b *overrideBuilder) appParams(aidx basics.AppIndex) (basics.Address, basics.AppParams, error) {
+	creator, ok, err := b.l.GetCreatorForRound(b.rnd, basics.CreatableIndex(aidx), basics.AppCreatable)
+	if err != nil {
+		return basics.Address{}, basics.AppParams{}, err
+	}
+	if !ok {
+		return basics.Address{}, basics.AppParams{}, fmt.Errorf("app %d does not exist", aidx)
+	}
+	res, err := b.app(creator, aidx)
+	if err != nil {
+		return basics.Address{}, basics.AppParams{}, err
+	}
+	if res.AppParams == nil {
+		return basics.Address{}, basics.AppParams{}, fmt.Errorf("app %d not found in account %s", aidx, creator)
+	}
+	return creator, *res.AppParams, nil
+}
+
+// applyKeyValues returns a copy of kv with the overrides applied. The overrides must respect the
+// same key and value length limits as the AVM, and the resulting state must fit in schema, so that
+// the simulation never runs against app state which could not exist on chain.
+func (b *overrideBuilder) applyKeyValues(kv basics.TealKeyValue, overrides []TealKeyValue, schema basics.StateSchema) (basics.TealKeyValue, error) {
+	kv = kv.Clone()
+	if kv == nil {
+		kv = make(basics.TealKeyValue, len(overrides))
+	}
+	for _, o := range overrides {
+		if len(o.Key) > b.proto.MaxAppKeyLen {
+			return nil, fmt.Errorf("key too long: length was %d, maximum is %d", len(o.Key), b.proto.MaxAppKeyLen)
+		}
+		switch o.Value.Type {
+		case basics.TealBytesType:
+			if len(o.Value.Bytes) > b.proto.MaxAppBytesValueLen {
+				return nil, fmt.Errorf("value too long for key %#x: length was %d, maximum is %d", o.Key, len(o.Value.Bytes), b.proto.MaxAppBytesValueLen)
+			}
+			if sum := len(o.Key) + len(o.Value.Bytes); sum > b.proto.MaxAppSumKeyValueLens {
+				return nil, fmt.Errorf("key/value total too long for key %#x: sum was %d, maximum is %d", o.Key, sum, b.proto.MaxAppSumKeyValueLens)
+			}
+			kv[string(o.Key)] = basics.TealValue{Type: basics.TealBytesType, Bytes: string(o.Value.Bytes)}
+		case basics.TealUintType:
+			kv[string(o.Key)] = basics.TealValue{Type: basics.TealUintType, Uint: o.Value.Uint}
+		default:
+			return nil, fmt.Errorf("key %#x has invalid value type %d", o.Key, o.Value.Type)
+		}
+	}
+
+	used, err := kv.ToStateSchema()
+	if err != nil {
+		return nil, err
+	}
+	if used.NumUint > schema.NumUint || used.NumByteSlice > schema.NumByteSlice {
+		return nil, fmt.Errorf("state would hold %d uints and %d byte slices, but the schema only allows %d uints and %d byte slices",
+			used.NumUint, used.NumByteSlice, schema.NumUint, schema.NumByteSlice)
+	}
+	return kv, nil
+}
+
+func (b *overrideBuilder) applyAccount(o AccountOverride) error {
+	ad, err := b.account(o.Address)
+	if err != nil {
+		return err
+	}
+
+	if o.Balance != nil {
+		// Move the account's algos in the totals, so that they still add up
+		var ot basics.OverflowTracker
+		totals := b.layer.totals
+		totals.DelAccount(b.proto, ad, &ot)
+		ad.MicroAlgos = basics.MicroAlgos{Raw: *o.Balance}
+		totals.AddAccount(b.proto, ad, &ot)
+		ot.AddA(ot.AddA(totals.Online.Money, totals.Offline.Money), totals.NotParticipating.Money)
+		if ot.Overflowed {
+			return fmt.Errorf("balance %d overflows the total algos of the ledger", *o.Balance)
+		}
+		b.layer.totals = totals
+	}
+
+	for _, h := range o.AssetHoldings {
+		_, ok, err := b.l.GetCreatorForRound(b.rnd, basics.CreatableIndex(h.AssetID), basics.AssetCreatable)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return fmt.Errorf("asset %d does not exist", h.AssetID)
+		}
+		res, err := b.asset(o.Address, h.AssetID)
+		if err != nil {
+			return err
+		}
+		if res.AssetHolding == nil {
+			ad.TotalAssets++
+		}
+		res.AssetHolding = &basics.AssetHolding{Amount: h.Amount, Frozen: h.Frozen}
+		b.layer.assets[ledgercore.AccountAsset{Address: o.Address, Asset: h.AssetID}] = res
+	}
+
+	for _, ls := range o.AppLocalStates {
+		_, params, err := b.appParams(ls.AppID)
+		if err != nil {
+			return err
+		}
+		res, err := b.app(o.Address, ls.AppID)
+		if err != nil {
+			return err
+		}
+		var localState basics.AppLocalState
+		if res.AppLocalState == nil {
+			localState.Schema = params.LocalStateSchema
+			ad.TotalAppLocalStates++
+			ad.TotalAppSchema = ad.TotalAppSchema.AddSchema(params.LocalStateSchema)
+		} else {
+			localState = res.AppLocalState.Clone()
+		}
+		localState.KeyValue, err = b.applyKeyValues(localState.KeyValue, ls.KeyValue, localState.Schema)
+		if err != nil {
+			return fmt.Errorf("local state of app %d: %w", ls.AppID, err)
+		}
+		res.AppLocalState = &localState
+		b.layer.apps[ledgercore.AccountApp{Address: o.Address, App: ls.AppID}] = res
+	}
+
+	b.layer.accounts[o.Address] = ad
+	return nil
+}
+
+// checkPrograms ensures that the programs of an app respect the same length and version limits as
+// programs set by app creation, so that the simulation never runs programs which could not exist
+// on chain.
+func (b *overrideBuilder) checkPrograms(params basics.AppParams) error {
+	lap := len(params.ApprovalProgram)
+	lcs := len(params.ClearStateProgram)
+	pages := int(1 + params.ExtraProgramPages)
+	if lap > pages*b.proto.MaxAppProgramLen {
+		return fmt.Errorf("approval program too long. max len %d bytes", pages*b.proto.MaxAppProgramLen)
+	}
+	if lcs > pages*b.proto.MaxAppProgramLen {
+		return fmt.Errorf("clear state program too long. max len %d bytes", pages*b.proto.MaxAppProgramLen)
+	}
+	if lap+lcs > pages*b.proto.MaxAppTotalProgramLen {
+		return fmt.Errorf("app programs too long, %d. max total len %d bytes", lap+lcs, pages*b.proto.MaxAppTotalProgramLen)
+	}
+
+	err := transactions.CheckContractVersions(params.ApprovalProgram, params.ClearStateProgram, basics.AppParams{}, &b.proto)
+	if err != nil {
+		return err
+	}
+	ep := &logic.EvalParams{Proto: &b.proto}
+	if err := logic.CheckContract(params.ApprovalProgram, ep); err != nil {
+		return fmt.Errorf("check failed on approval program: %w", err)
+	}
+	if err := logic.CheckContract(params.ClearStateProgram, ep); err != nil {
+		return fmt.Errorf("check failed on clear state program: %w", err)
+	}
+	return nil
+}
+
+func (b *overrideBuilder) applyApplication(o ApplicationOverride) error {
+	creator, params, err := b.appParams(o.AppID)
+	if err != nil {
+		return err
+	}
+	res, err := b.app(creator, o.AppID)
+	if err != nil {
+		return err
+	}
+
+	params = params.Clone()
+	if o.ApprovalProgram != nil || o.ClearStateProgram != nil {
+		if o.ApprovalProgram != nil {
+			params.ApprovalProgram = o.ApprovalProgram
+		}
+		if o.ClearStateProgram != nil {
+			params.ClearStateProgram = o.ClearStateProgram
+		}
+		if err := b.checkPrograms(params); err != nil {
+			return fmt.Errorf("programs of app %d: %w", o.AppID, err)
+		}
+	}
+	params.GlobalState, err = b.applyKeyValues(params.GlobalState, o.GlobalState, params.GlobalStateSchema)
+	if err != nil {
+		return fmt.Errorf("global state of app %d: %w", o.AppID, err)
+	}
+	res.AppParams = &params
+	b.layer.apps[ledgercore.AccountApp{Address: creator, App: o.AppID}] = res
+	return nil
+}
+
+func (b *overrideBuilder) applyBox(o BoxOverride) error {
+	if len(o.Name) == 0 {
+		return fmt.Errorf("box names may not be zero length")
+	}
+	if len(o.Name) > b.proto.MaxAppKeyLen {
+		return fmt.Errorf("name too long: length was %d, maximum is %d", len(o.Name), b.proto.MaxAppKeyLen)
+	}
+	if uint64(len(o.Value)) > b.proto.MaxBoxSize {
+		return fmt.Errorf("box size too large: %d, maximum is %d", len(o.Value), b.proto.MaxBoxSize)
+	}
+	if _, _, err := b.appParams(o.AppID); err != nil {
+		return err
+	}
+
+	key := apps.MakeBoxKey(uint64(o.AppID), string(o.Name))
+	old, err := b.kv(key)
+	if err != nil {
+		return err
+	}
+
+	appAddr := o.AppID.Address()
+	ad, err := b.account(appAddr)
+	if err != nil {
+		return err
+	}
+	if old == nil {
+		ad.TotalBoxes = basics.AddSaturate(ad.TotalBoxes, 1)
+		ad.TotalBoxBytes = basics.AddSaturate(ad.TotalBoxBytes, uint64(len(o.Name)))
+	} else {
+		ad.TotalBoxBytes = basics.SubSaturate(ad.TotalBoxBytes, uint64(len(old)))
+	}
+	ad.TotalBoxBytes = basics.AddSaturate(ad.TotalBoxBytes, uint64(len(o.Value)))
+	b.layer.accounts[appAddr] = ad
+
+	// A nil value would read as a missing box, so always store a non-nil slice.
+	value := make([]byte, len(o.Value))
+	copy(value, o.Value)
+	b.layer.kvs[key] = value
+	return nil
+}
+
+// makeStateOverrideLayer resolves the requested overrides against the state of the given round.
+// An InvalidRequestError is returned if the overrides refer to state that does not exist.
+func makeStateOverrideLayer(l simulatorLedger, rnd basics.Round, overrides StateOverrides) (*stateOverrideLayer, error) {
+	hdr, err := l.BlockHdr(rnd)
+	if err != nil {
+		return nil, err
+	}
+	totals, err := l.Totals(rnd)
+	if err != nil {
+		return nil, err
+	}
+	b := overrideBuilder{
+		l:     l,
+		rnd:   rnd,
+		proto: config.Consensus[hdr.CurrentProtocol],
+		layer: &stateOverrideLayer{
+			accounts: make(map[basics.Address]ledgercore.AccountData),
+			assets:   make(map[ledgercore.AccountAsset]ledgercore.AssetResource),
+			apps:     make(map[ledgercore.AccountApp]ledgercore.AppResource),
+			kvs:      make(map[string][]byte),
+			totals:   totals,
+		},
+	}
+
+	for _, o := range overrides.Applications {
+		if err := b.applyApplication(o); err != nil {
+			return nil, InvalidRequestError{SimulatorError{fmt.Errorf("invalid application override: %w", err)}}
+		}
+	}
+	for _, o := range overrides.Accounts {
+		if err := b.applyAccount(o); err != nil {
+			return nil, InvalidRequestError{SimulatorError{fmt.Errorf("invalid override for account %s: %w", o.Address, err)}}
+		}
+	}
+	for _, o := range overrides.Boxes {
+		if err := b.applyBox(o); err != nil {
+			return nil, InvalidRequestError{SimulatorError{fmt.Errorf("invalid box override for app %d: %w", o.AppID, err)}}
+		}
+	}
+
+	return b.layer, nil
+}
@@ -0,0 +1,148 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package simulation
+
+import (
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+)
+
+// BoxRef identifies a box by the application that owns it and its name
+type BoxRef struct {
+	App  basics.AppIndex
+	Name string
+}
+
+// ResourceTracker contains the unnamed resources accessed during simulation: the resources that
+// were not made available through foreign arrays, box references, group sharing or creation
+// earlier in the group, and therefore must be named for the transaction group to succeed.
+type ResourceTracker struct {
+	Accounts map[basics.Address]struct{}
+	Assets   map[basics.AssetIndex]struct{}
+	Apps     map[basics.AppIndex]struct{}
+	Boxes    map[BoxRef]struct{}
+
+	// AssetHoldings and AppLocals are holdings and local states whose account and asset or app
+	// must be named by the same transaction.
+	AssetHoldings map[ledgercore.AccountAsset]struct{}
+	AppLocals     map[ledgercore.AccountApp]struct{}
+}
+
+func makeResourceTracker() ResourceTracker {
+	return ResourceTracker{
+		Accounts:      make(map[basics.Address]struct{}),
+		Assets:        make(map[basics.AssetIndex]struct{}),
+		Apps:          make(map[basics.AppIndex]struct{}),
+		Boxes:         make(map[BoxRef]struct{}),
+		AssetHoldings: make(map[ledgercore.AccountAsset]struct{}),
+		AppLocals:     make(map[ledgercore.AccountApp]struct{}),
+	}
+}
+
+// Empty returns true if no unnamed resources were accessed
+func (r *ResourceTracker) Empty() bool {
+	return len(r.Accounts) == 0 && len(r.Assets) == 0 && len(r.Apps) == 0 && len(r.Boxes) == 0 &&
+		len(r.AssetHoldings) == 0 && len(r.AppLocals) == 0
+}
+
+// unnamedResourcePolicy implements logic.UnnamedResourcePolicy. It grants access to every unnamed
+// resource and records it. Resources used by programs that share resources across their group are
+// recorded for the group, since any of its transactions may name them. Resources used by older
+// programs can only be named by the top-level transaction being evaluated, so they are recorded
+// for that transaction.
+type unnamedResourcePolicy struct {
+	group ResourceTracker
+	txns  []ResourceTracker
+
+	// trackers follows program evaluation, where the top is the tracker of the running program.
+	// It is a stack because apps can call other apps.
+	trackers []*ResourceTracker
+}
+
+func makeUnnamedResourcePolicy(groupSize int) *unnamedResourcePolicy {
+	policy := &unnamedResourcePolicy{
+		group: makeResourceTracker(),
+		txns:  make([]ResourceTracker, groupSize),
+	}
+	for i := range policy.txns {
+		policy.txns[i] = makeResourceTracker()
+	}
+	return policy
+}
+
+func (p *unnamedResourcePolicy) enterProgram(sharesResources bool, topLevelIndex int) {
+	tracker := &p.group
+	if !sharesResources {
+		tracker = &p.txns[topLevelIndex]
+	}
+	p.trackers = append(p.trackers, tracker)
+}
+
+func (p *unnamedResourcePolicy) exitProgram() {
+	p.trackers = p.trackers[:len(p.trackers)-1]
+}
+
+func (p *unnamedResourcePolicy) current() *ResourceTracker {
+	if len(p.trackers) == 0 {
+		return &p.group
+	}
+	return p.trackers[len(p.trackers)-1]
+}
+
+func (p *unnamedResourcePolicy) AvailableAccount(addr basics.Address) bool {
+	p.current().Accounts[addr] = struct{}{}
+	return true
+}
+
+func (p *unnamedResourcePolicy) AvailableAsset(aid basics.AssetIndex) bool {
+	p.current().Assets[aid] = struct{}{}
+	return true
+}
+
+func (p *unnamedResourcePolicy) AvailableApp(aid basics.AppIndex) bool {
+	p.current().Apps[aid] = struct{}{}
+	return true
+}
+
+func (p *unnamedResourcePolicy) AllowsHolding(addr basics.Address, aid basics.AssetIndex) bool {
+	p.current().AssetHoldings[ledgercore.AccountAsset{Address: addr, Asset: aid}] = struct{}{}
+	return true
+}
+
+func (p *unnamedResourcePolicy) AllowsLocal(addr basics.Address, aid basics.AppIndex) bool {
+	p.current().AppLocals[ledgercore.AccountApp{Address: addr, App: aid}] = struct{}{}
+	return true
+}
+
+func (p *unnamedResourcePolicy) AvailableBox(app basics.AppIndex, name string) bool {
+	p.current().Boxes[BoxRef{App: app, Name: name}] = struct{}{}
+	return true
+}
+
+// populate copies the non-empty trackers into a group result
+func (p *unnamedResourcePolicy) populate(groupResult *TxnGroupResult) {
+	for i := range groupResult.Txns {
+		if !p.txns[i].Empty() {
+			tracker := p.txns[i]
+			groupResult.Txns[i].UnnamedResourcesAccessed = &tracker
+		}
+	}
+	if !p.group.Empty() {
+		tracker := p.group
+		groupResult.UnnamedResourcesAccessed = &tracker
+	}
+}
@@ -20,15 +20,21 @@ import (
 	"encoding/binary"
 	"encoding/hex"
 	"fmt"
+	"math"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/algorand/go-algorand/agreement"
+	"github.com/algorand/go-algorand/config"
 	"github.com/algorand/go-algorand/crypto"
 	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
 	"github.com/algorand/go-algorand/data/transactions"
 	"github.com/algorand/go-algorand/data/transactions/logic"
 	"github.com/algorand/go-algorand/data/transactions/logic/mocktracer"
 	"github.com/algorand/go-algorand/data/txntest"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
 	"github.com/algorand/go-algorand/ledger/simulation"
 	simulationtesting "github.com/algorand/go-algorand/ledger/simulation/testing"
 	ledgertesting "github.com/algorand/go-algorand/ledger/testing"
@@ -3012,3 +3018,672 @@ func TestMockTracerScenarios(t *testing.T) {
 		})
 	}
 }
+
+// addBlock evaluates the given transactions in a new block and adds it to the ledger.
+func addBlock(t *testing.T, env *simulationtesting.Environment, stxns ...transactions.SignedTxn) bookkeeping.Block {
+	t.Helper()
+	prevHdr, err := env.Ledger.BlockHdr(env.Ledger.Latest())
+	require.NoError(t, err)
+	nextBlock := bookkeeping.MakeBlock(prevHdr)
+	evaluator, err := env.Ledger.StartEvaluator(nextBlock.BlockHeader, len(stxns), 0, nil)
+	require.NoError(t, err)
+	for _, stxn := range stxns {
+		err = evaluator.Transaction(stxn, transactions.ApplyData{})
+		require.NoError(t, err)
+	}
+	vb, err := evaluator.GenerateBlock()
+	require.NoError(t, err)
+	err = env.Ledger.AddValidatedBlock(*vb, agreement.Certificate{})
+	require.NoError(t, err)
+	return vb.Block()
+}
+
+// TestSimulateAtRound ensures that a simulation can be evaluated against the state of an earlier
+// round, and that requests for rounds which are not available are rejected.
+func TestSimulateAtRound(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	env := simulationtesting.PrepareSimulatorTest(t)
+	defer env.Close()
+
+	sender := env.Accounts[0]
+
+	var seed crypto.Seed
+	crypto.RandBytes(seed[:])
+	newAccountSk := crypto.GenerateSignatureSecrets(seed)
+	newAccount := basics.Address(newAccountSk.SignatureVerifier)
+
+	// Round zero requests the latest round, so the funding round must come after it
+	if env.Ledger.Latest() == 0 {
+		addBlock(t, &env)
+	}
+
+	// Fund a new account in the next block
+	fundRound := env.Ledger.Latest()
+	fundTxn := env.TxnInfo.NewTxn(txntest.Txn{
+		Type:     protocol.PaymentTx,
+		Sender:   sender.Addr,
+		Receiver: newAccount,
+		Amount:   1_000_000,
+	}).Txn().Sign(sender.Sk)
+
+	addBlock(t, &env, fundTxn)
+	require.Equal(t, fundRound+1, env.Ledger.Latest())
+
+	spendTxn := env.TxnInfo.NewTxn(txntest.Txn{
+		Type:     protocol.PaymentTx,
+		Sender:   newAccount,
+		Receiver: sender.Addr,
+		Amount:   100_000,
+	}).Txn().Sign(newAccountSk)
+
+	s := simulation.MakeSimulator(env.Ledger, false)
+
+	// Against the latest round the new account is funded
+	result, err := s.Simulate(simulation.Request{TxnGroups: [][]transactions.SignedTxn{{spendTxn}}})
+	require.NoError(t, err)
+	require.Equal(t, fundRound+1, result.LastRound)
+	require.Empty(t, result.TxnGroups[0].FailureMessage)
+
+	// Before the funding round the new account has no balance
+	result, err = s.Simulate(simulation.Request{
+		Round:     fundRound,
+		TxnGroups: [][]transactions.SignedTxn{{spendTxn}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, fundRound, result.LastRound)
+	require.Contains(t, result.TxnGroups[0].FailureMessage, "overspend")
+	require.Equal(t, simulation.TxnPath{0}, result.TxnGroups[0].FailedAt)
+
+	// Rounds in the future cannot be simulated
+	_, err = s.Simulate(simulation.Request{
+		Round:     fundRound + 2,
+		TxnGroups: [][]transactions.SignedTxn{{spendTxn}},
+	})
+	require.ErrorAs(t, err, &simulation.InvalidRequestError{})
+	require.ErrorContains(t, err, "is ahead of the latest round")
+
+	// Neither can rounds whose state is no longer kept in memory. The trackers only consider
+	// flushing when a block is added, and not more than once every few seconds, so keep adding
+	// blocks until they do.
+	for i := 0; i < 2*int(config.GetDefaultLocal().MaxAcctLookback); i++ {
+		addBlock(t, &env)
+	}
+	deadline := time.Now().Add(30 * time.Second)
+	for env.Ledger.LatestTrackerCommitted() <= fundRound {
+		require.True(t, time.Now().Before(deadline), "round %d was never committed by the trackers", fundRound)
+		time.Sleep(100 * time.Millisecond)
+		addBlock(t, &env)
+	}
+	_, err = s.Simulate(simulation.Request{
+		Round:     fundRound,
+		TxnGroups: [][]transactions.SignedTxn{{spendTxn}},
+	})
+	require.ErrorAs(t, err, &simulation.InvalidRequestError{})
+	require.ErrorContains(t, err, "the oldest whose state is available for simulation")
+}
+
+func TestStateOverrides(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	env := simulationtesting.PrepareSimulatorTest(t)
+	defer env.Close()
+
+	sender := env.Accounts[0]
+
+	var seed crypto.Seed
+	crypto.RandBytes(seed[:])
+	emptyAccountSk := crypto.GenerateSignatureSecrets(seed)
+	emptyAccount := basics.Address(emptyAccountSk.SignatureVerifier)
+
+	// The app only approves calls if global key "k" is 7 and box "b" contains "abc"
+	approvalSource := `#pragma version 8
+txn ApplicationID
+bz end
+byte "k"
+app_global_get
+int 7
+==
+assert
+byte "b"
+box_get
+assert
+byte "abc"
+==
+return
+end:
+int 1`
+
+	assetCreateTxn := env.TxnInfo.NewTxn(txntest.Txn{
+		Type:        protocol.AssetConfigTx,
+		Sender:      sender.Addr,
+		AssetParams: basics.AssetParams{Total: 100},
+	}).Txn().Sign(sender.Sk)
+	appCreateTxn := env.TxnInfo.NewTxn(txntest.Txn{
+		Type:              protocol.ApplicationCallTx,
+		Sender:            sender.Addr,
+		ApprovalProgram:   approvalSource,
+		ClearStateProgram: "#pragma version 8\nint 1",
+		GlobalStateSchema: basics.StateSchema{NumUint: 1},
+	}).Txn().Sign(sender.Sk)
+
+	block := addBlock(t, &env, assetCreateTxn, appCreateTxn)
+	assetID := block.Payset[0].ApplyData.ConfigAsset
+	appID := block.Payset[1].ApplyData.ApplicationID
+	require.NotZero(t, assetID)
+	require.NotZero(t, appID)
+
+	s := simulation.MakeSimulator(env.Ledger, false)
+
+	t.Run("balance and asset holding", func(t *testing.T) {
+		xferTxn := env.TxnInfo.NewTxn(txntest.Txn{
+			Type:          protocol.AssetTransferTx,
+			Sender:        emptyAccount,
+			XferAsset:     assetID,
+			AssetReceiver: sender.Addr,
+			AssetAmount:   5,
+		}).Txn().Sign(emptyAccountSk)
+
+		result, err := s.Simulate(simulation.Request{TxnGroups: [][]transactions.SignedTxn{{xferTxn}}})
+		require.NoError(t, err)
+		require.Contains(t, result.TxnGroups[0].FailureMessage, "overspend")
+
+		balance := uint64(1_000_000)
+		result, err = s.Simulate(simulation.Request{
+			TxnGroups: [][]transactions.SignedTxn{{xferTxn}},
+			StateOverrides: simulation.StateOverrides{
+				Accounts: []simulation.AccountOverride{{
+					Address: emptyAccount,
+					Balance: &balance,
+					AssetHoldings: []simulation.AssetHoldingOverride{
+						{AssetID: assetID, Amount: 10},
+					},
+				}},
+			},
+		})
+		require.NoError(t, err)
+		require.Empty(t, result.TxnGroups[0].FailureMessage)
+	})
+
+	t.Run("app state and programs", func(t *testing.T) {
+		callTxn := env.TxnInfo.NewTxn(txntest.Txn{
+			Type:          protocol.ApplicationCallTx,
+			Sender:        sender.Addr,
+			ApplicationID: appID,
+			Boxes:         []transactions.BoxRef{{Index: 0, Name: []byte("b")}},
+		}).Txn().Sign(sender.Sk)
+
+		result, err := s.Simulate(simulation.Request{TxnGroups: [][]transactions.SignedTxn{{callTxn}}})
+		require.NoError(t, err)
+		require.Contains(t, result.TxnGroups[0].FailureMessage, "assert failed")
+
+		stateOverrides := simulation.StateOverrides{
+			Applications: []simulation.ApplicationOverride{{
+				AppID: appID,
+				GlobalState: []simulation.TealKeyValue{
+					{Key: []byte("k"), Value: simulation.TealValue{Type: basics.TealUintType, Uint: 7}},
+				},
+			}},
+			Boxes: []simulation.BoxOverride{
+				{AppID: appID, Name: []byte("b"), Value: []byte("abc")},
+			},
+		}
+		result, err = s.Simulate(simulation.Request{
+			TxnGroups:      [][]transactions.SignedTxn{{callTxn}},
+			StateOverrides: stateOverrides,
+		})
+		require.NoError(t, err)
+		require.Empty(t, result.TxnGroups[0].FailureMessage)
+
+		ops, err := logic.AssembleString("#pragma version 8\nint 0")
+		require.NoError(t, err)
+		stateOverrides.Applications[0].ApprovalProgram = ops.Program
+		result, err = s.Simulate(simulation.Request{
+			TxnGroups:      [][]transactions.SignedTxn{{callTxn}},
+			StateOverrides: stateOverrides,
+		})
+		require.NoError(t, err)
+		require.Contains(t, result.TxnGroups[0].FailureMessage, "rejected by ApprovalProgram")
+	})
+
+	t.Run("overflowing balance", func(t *testing.T) {
+		payTxn := env.TxnInfo.NewTxn(txntest.Txn{
+			Type:     protocol.PaymentTx,
+			Sender:   sender.Addr,
+			Receiver: sender.Addr,
+		}).Txn().Sign(sender.Sk)
+
+		balance := uint64(math.MaxUint64)
+		_, err := s.Simulate(simulation.Request{
+			TxnGroups: [][]transactions.SignedTxn{{payTxn}},
+			StateOverrides: simulation.StateOverrides{
+				Accounts: []simulation.AccountOverride{{Address: emptyAccount, Balance: &balance}},
+			},
+		})
+		require.ErrorAs(t, err, &simulation.InvalidRequestError{})
+		require.ErrorContains(t, err, "overflows the total algos")
+	})
+
+	t.Run("state outside the app limits", func(t *testing.T) {
+		payTxn := env.TxnInfo.NewTxn(txntest.Txn{
+			Type:     protocol.PaymentTx,
+			Sender:   sender.Addr,
+			Receiver: sender.Addr,
+		}).Txn().Sign(sender.Sk)
+		proto := config.Consensus[protocol.ConsensusCurrentVersion]
+		futureProto := config.Consensus[protocol.ConsensusFuture]
+		uintValue := simulation.TealValue{Type: basics.TealUintType, Uint: 1}
+
+		testCases := []struct {
+			name      string
+			overrides simulation.StateOverrides
+			expected  string
+		}{
+			{
+				name: "global byte slice beyond the schema",
+				overrides: simulation.StateOverrides{Applications: []simulation.ApplicationOverride{{
+					AppID:       appID,
+					GlobalState: []simulation.TealKeyValue{{Key: []byte("k"), Value: simulation.TealValue{Type: basics.TealBytesType, Bytes: []byte("x")}}},
+				}}},
+				expected: "the schema only allows 1 uints and 0 byte slices",
+			},
+			{
+				name: "more global uints than the schema",
+				overrides: simulation.StateOverrides{Applications: []simulation.ApplicationOverride{{
+					AppID:       appID,
+					GlobalState: []simulation.TealKeyValue{{Key: []byte("k"), Value: uintValue}, {Key: []byte("j"), Value: uintValue}},
+				}}},
+				expected: "the schema only allows 1 uints and 0 byte slices",
+			},
+			{
+				name: "local state without a local schema",
+				overrides: simulation.StateOverrides{Accounts: []simulation.AccountOverride{{
+					Address: sender.Addr,
+					AppLocalStates: []simulation.AppLocalStateOverride{{
+						AppID:    appID,
+						KeyValue: []simulation.TealKeyValue{{Key: []byte("l"), Value: uintValue}},
+					}},
+				}}},
+				expected: "the schema only allows 0 uints and 0 byte slices",
+			},
+			{
+				name: "key too long",
+				overrides: simulation.StateOverrides{Applications: []simulation.ApplicationOverride{{
+					AppID:       appID,
+					GlobalState: []simulation.TealKeyValue{{Key: make([]byte, proto.MaxAppKeyLen+1), Value: uintValue}},
+				}}},
+				expected: "key too long",
+			},
+			{
+				name: "value too long",
+				overrides: simulation.StateOverrides{Applications: []simulation.ApplicationOverride{{
+					AppID: appID,
+					GlobalState: []simulation.TealKeyValue{{
+						Key:   []byte("k"),
+						Value: simulation.TealValue{Type: basics.TealBytesType, Bytes: make([]byte, proto.MaxAppBytesValueLen+1)},
+					}},
+				}}},
+				expected: "value too long",
+			},
+			{
+				name: "key and value too long",
+				overrides: simulation.StateOverrides{Applications: []simulation.ApplicationOverride{{
+					AppID: appID,
+					GlobalState: []simulation.TealKeyValue{{
+						Key:   make([]byte, proto.MaxAppKeyLen),
+						Value: simulation.TealValue{Type: basics.TealBytesType, Bytes: make([]byte, proto.MaxAppBytesValueLen)},
+					}},
+				}}},
+				expected: "key/value total too long",
+			},
+			{
+				name: "box too large",
+				overrides: simulation.StateOverrides{Boxes: []simulation.BoxOverride{
+					{AppID: appID, Name: []byte("b"), Value: make([]byte, proto.MaxBoxSize+1)},
+				}},
+				expected: "box size too large",
+			},
+			{
+				name: "approval program too long",
+				overrides: simulation.StateOverrides{Applications: []simulation.ApplicationOverride{{
+					AppID:           appID,
+					ApprovalProgram: append([]byte{8}, make([]byte, proto.MaxAppProgramLen)...),
+				}}},
+				expected: "approval program too long",
+			},
+			{
+				name: "program version not supported",
+				overrides: simulation.StateOverrides{Applications: []simulation.ApplicationOverride{{
+					AppID:             appID,
+					ApprovalProgram:   []byte{byte(futureProto.LogicSigVersion + 1), 0x81, 0x01},
+					ClearStateProgram: []byte{byte(futureProto.LogicSigVersion + 1), 0x81, 0x01},
+				}}},
+				expected: "greater than",
+			},
+			{
+				name: "program versions mismatch",
+				overrides: simulation.StateOverrides{Applications: []simulation.ApplicationOverride{{
+					AppID:             appID,
+					ClearStateProgram: []byte{6, 0x81, 0x01},
+				}}},
+				expected: "program version mismatch",
+			},
+			{
+				name: "empty program",
+				overrides: simulation.StateOverrides{Applications: []simulation.ApplicationOverride{{
+					AppID:           appID,
+					ApprovalProgram: []byte{},
+				}}},
+				expected: "invalid program (empty)",
+			},
+		}
+		for _, tc := range testCases {
+			tc := tc
+			t.Run(tc.name, func(t *testing.T) {
+				_, err := s.Simulate(simulation.Request{
+					TxnGroups:      [][]transactions.SignedTxn{{payTxn}},
+					StateOverrides: tc.overrides,
+				})
+				require.ErrorAs(t, err, &simulation.InvalidRequestError{})
+				require.ErrorContains(t, err, tc.expected)
+			})
+		}
+	})
+
+	t.Run("unknown app", func(t *testing.T) {
+		payTxn := env.TxnInfo.NewTxn(txntest.Txn{
+			Type:     protocol.PaymentTx,
+			Sender:   sender.Addr,
+			Receiver: sender.Addr,
+		}).Txn().Sign(sender.Sk)
+
+		_, err := s.Simulate(simulation.Request{
+			TxnGroups: [][]transactions.SignedTxn{{payTxn}},
+			StateOverrides: simulation.StateOverrides{
+				Boxes: []simulation.BoxOverride{
+					{AppID: appID + 100, Name: []byte("b"), Value: []byte("abc")},
+				},
+			},
+		})
+		require.ErrorAs(t, err, &simulation.InvalidRequestError{})
+		require.ErrorContains(t, err, "does not exist")
+	})
+}
+
+func TestExecTraceStackScratchAndStateChanges(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	env := simulationtesting.PrepareSimulatorTest(t)
+	defer env.Close()
+
+	sender := env.Accounts[0]
+
+	// One op per line, so trace units can be found by source line
+	approvalSource := `#pragma version 8
+txn ApplicationID
+bz end
+int 7
+store 1
+byte "g"
+load 1
+app_global_put
+byte "b"
+int 4
+box_create
+pop
+byte "b"
+int 1
+byte "xy"
+box_replace
+int 1
+int 2
+int 3
+cover 2
+popn 2
+return
+end:
+int 1`
+	ops, err := logic.AssembleString(approvalSource)
+	require.NoError(t, err)
+	pcOfLine := make(map[int]uint64, len(ops.OffsetToLine))
+	for pc, line := range ops.OffsetToLine {
+		pcOfLine[line] = uint64(pc)
+	}
+
+	appCreateTxn := env.TxnInfo.NewTxn(txntest.Txn{
+		Type:              protocol.ApplicationCallTx,
+		Sender:            sender.Addr,
+		ApprovalProgram:   approvalSource,
+		ClearStateProgram: "#pragma version 8\nint 1",
+		GlobalStateSchema: basics.StateSchema{NumUint: 1},
+	}).Txn().Sign(sender.Sk)
+	block := addBlock(t, &env, appCreateTxn)
+	appID := block.Payset[0].ApplyData.ApplicationID
+	require.NotZero(t, appID)
+
+	fundTxn := env.TxnInfo.NewTxn(txntest.Txn{
+		Type:     protocol.PaymentTx,
+		Sender:   sender.Addr,
+		Receiver: appID.Address(),
+		Amount:   1_000_000,
+	}).Txn().Sign(sender.Sk)
+	addBlock(t, &env, fundTxn)
+
+	callTxn := env.TxnInfo.NewTxn(txntest.Txn{
+		Type:          protocol.ApplicationCallTx,
+		Sender:        sender.Addr,
+		ApplicationID: appID,
+		Boxes:         []transactions.BoxRef{{Index: 0, Name: []byte("b")}},
+	}).Txn().Sign(sender.Sk)
+
+	s := simulation.MakeSimulator(env.Ledger, true)
+
+	_, err = s.Simulate(simulation.Request{
+		TxnGroups:   [][]transactions.SignedTxn{{callTxn}},
+		TraceConfig: simulation.ExecTraceConfig{Stack: true},
+	})
+	require.ErrorAs(t, err, &simulation.InvalidRequestError{})
+	require.ErrorContains(t, err, "basic trace must be enabled")
+
+	result, err := s.Simulate(simulation.Request{
+		TxnGroups: [][]transactions.SignedTxn{{callTxn}},
+		TraceConfig: simulation.ExecTraceConfig{
+			Enable:  true,
+			Stack:   true,
+			Scratch: true,
+			State:   true,
+		},
+	})
+	require.NoError(t, err)
+	require.Empty(t, result.TxnGroups[0].FailureMessage)
+
+	units := make(map[uint64]simulation.OpcodeTraceUnit)
+	for _, unit := range result.TxnGroups[0].Txns[0].Trace.ApprovalProgramTrace {
+		units[unit.PC] = unit
+	}
+	unitAt := func(line int) simulation.OpcodeTraceUnit {
+		pc, ok := pcOfLine[line]
+		require.True(t, ok, "no op at line %d", line)
+		unit, ok := units[pc]
+		require.True(t, ok, "no trace for line %d", line)
+		return unit
+	}
+	uintValue := func(u uint64) basics.TealValue {
+		return basics.TealValue{Type: basics.TealUintType, Uint: u}
+	}
+	bytesValue := func(b string) basics.TealValue {
+		return basics.TealValue{Type: basics.TealBytesType, Bytes: b}
+	}
+
+	// int 7
+	unit := unitAt(3)
+	require.Zero(t, unit.StackPopCount)
+	require.Equal(t, []basics.TealValue{uintValue(7)}, unit.StackAdded)
+
+	// store 1
+	unit = unitAt(4)
+	require.Equal(t, uint64(1), unit.StackPopCount)
+	require.Empty(t, unit.StackAdded)
+	require.Equal(t, []simulation.ScratchChange{{Slot: 1, NewValue: uintValue(7)}}, unit.ScratchSlotChanges)
+
+	// app_global_put
+	unit = unitAt(7)
+	require.Equal(t, uint64(2), unit.StackPopCount)
+	require.Equal(t, []simulation.StateOperation{{
+		AppStateOp: logic.AppStateWrite,
+		AppState:   logic.GlobalState,
+		AppID:      appID,
+		Key:        "g",
+		NewValue:   uintValue(7),
+	}}, unit.StateChanges)
+
+	// box_create
+	unit = unitAt(10)
+	require.Equal(t, uint64(2), unit.StackPopCount)
+	require.Equal(t, []basics.TealValue{uintValue(1)}, unit.StackAdded)
+	require.Equal(t, []simulation.StateOperation{{
+		AppStateOp: logic.AppStateWrite,
+		AppState:   logic.BoxState,
+		AppID:      appID,
+		Key:        "b",
+		NewValue:   bytesValue("\x00\x00\x00\x00"),
+	}}, unit.StateChanges)
+
+	// box_replace
+	unit = unitAt(15)
+	require.Equal(t, uint64(3), unit.StackPopCount)
+	require.Empty(t, unit.StackAdded)
+	require.Equal(t, []simulation.StateOperation{{
+		AppStateOp: logic.AppStateWrite,
+		AppState:   logic.BoxState,
+		AppID:      appID,
+		Key:        "b",
+		NewValue:   bytesValue("\x00xy\x00"),
+	}}, unit.StateChanges)
+
+	// cover 2 rewrites the top three values
+	unit = unitAt(19)
+	require.Equal(t, uint64(3), unit.StackPopCount)
+	require.Equal(t, []basics.TealValue{uintValue(3), uintValue(1), uintValue(2)}, unit.StackAdded)
+
+	// popn 2
+	unit = unitAt(20)
+	require.Equal(t, uint64(2), unit.StackPopCount)
+	require.Empty(t, unit.StackAdded)
+	require.Empty(t, unit.StateChanges)
+	require.Empty(t, unit.ScratchSlotChanges)
+}
+
+func TestUnnamedResources(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	env := simulationtesting.PrepareSimulatorTest(t)
+	defer env.Close()
+
+	sender := env.Accounts[0]
+	other := env.Accounts[1].Addr
+
+	assetCreateTxn := env.TxnInfo.NewTxn(txntest.Txn{
+		Type:        protocol.AssetConfigTx,
+		Sender:      sender.Addr,
+		AssetParams: basics.AssetParams{Total: 100},
+	}).Txn().Sign(sender.Sk)
+	block := addBlock(t, &env, assetCreateTxn)
+	assetID := block.Payset[0].ApplyData.ConfigAsset
+	require.NotZero(t, assetID)
+
+	// The program touches an account, an asset, a holding and a box, none of which are named
+	programOfVersion := func(version int) string {
+		return fmt.Sprintf(`#pragma version %d
+txn ApplicationID
+bz end
+addr %s
+balance
+pop
+int %d
+asset_params_get AssetTotal
+assert
+pop
+addr %s
+int %d
+asset_holding_get AssetBalance
+pop
+pop
+byte "b"
+box_len
+pop
+pop
+end:
+int 1`, version, other, assetID, other, assetID)
+	}
+
+	createApp := func(version int) basics.AppIndex {
+		appCreateTxn := env.TxnInfo.NewTxn(txntest.Txn{
+			Type:              protocol.ApplicationCallTx,
+			Sender:            sender.Addr,
+			ApprovalProgram:   programOfVersion(version),
+			ClearStateProgram: fmt.Sprintf("#pragma version %d\nint 1", version),
+		}).Txn().Sign(sender.Sk)
+		block := addBlock(t, &env, appCreateTxn)
+		appID := block.Payset[0].ApplyData.ApplicationID
+		require.NotZero(t, appID)
+		return appID
+	}
+	// Resources are shared across the group since v9
+	sharingAppID := createApp(9)
+	nonSharingAppID := createApp(8)
+
+	sharingCall := env.TxnInfo.NewTxn(txntest.Txn{
+		Type:          protocol.ApplicationCallTx,
+		Sender:        sender.Addr,
+		ApplicationID: sharingAppID,
+	})
+	nonSharingCall := env.TxnInfo.NewTxn(txntest.Txn{
+		Type:          protocol.ApplicationCallTx,
+		Sender:        sender.Addr,
+		ApplicationID: nonSharingAppID,
+	})
+	txntest.Group(&sharingCall, &nonSharingCall)
+	txgroup := []transactions.SignedTxn{sharingCall.Txn().Sign(sender.Sk), nonSharingCall.Txn().Sign(sender.Sk)}
+
+	s := simulation.MakeSimulator(env.Ledger, false)
+
+	result, err := s.Simulate(simulation.Request{TxnGroups: [][]transactions.SignedTxn{txgroup}})
+	require.NoError(t, err)
+	require.Contains(t, result.TxnGroups[0].FailureMessage, "invalid Account reference")
+	require.Nil(t, result.TxnGroups[0].UnnamedResourcesAccessed)
+
+	result, err = s.Simulate(simulation.Request{
+		TxnGroups:             [][]transactions.SignedTxn{txgroup},
+		AllowUnnamedResources: true,
+	})
+	require.NoError(t, err)
+	require.Empty(t, result.TxnGroups[0].FailureMessage)
+	require.True(t, result.EvalOverrides.AllowUnnamedResources)
+
+	groupResources := result.TxnGroups[0].UnnamedResourcesAccessed
+	require.NotNil(t, groupResources)
+	require.Equal(t, map[basics.Address]struct{}{other: {}}, groupResources.Accounts)
+	require.Equal(t, map[basics.AssetIndex]struct{}{assetID: {}}, groupResources.Assets)
+	require.Empty(t, groupResources.Apps)
+	require.Equal(t, map[simulation.BoxRef]struct{}{{App: sharingAppID, Name: "b"}: {}}, groupResources.Boxes)
+	require.Equal(t, map[ledgercore.AccountAsset]struct{}{{Address: other, Asset: assetID}: {}}, groupResources.AssetHoldings)
+	require.Empty(t, groupResources.AppLocals)
+
+	// The v9 app's resources can be named by any transaction of the group
+	require.Nil(t, result.TxnGroups[0].Txns[0].UnnamedResourcesAccessed)
+
+	// The v8 app's resources must be named by its own transaction
+	txnResources := result.TxnGroups[0].Txns[1].UnnamedResourcesAccessed
+	require.NotNil(t, txnResources)
+	require.Equal(t, map[basics.Address]struct{}{other: {}}, txnResources.Accounts)
+	require.Equal(t, map[basics.AssetIndex]struct{}{assetID: {}}, txnResources.Assets)
+	require.Empty(t, txnResources.Apps)
+	require.Equal(t, map[simulation.BoxRef]struct{}{{App: nonSharingAppID, Name: "b"}: {}}, txnResources.Boxes)
+	require.Empty(t, txnResources.AssetHoldings)
+	require.Empty(t, txnResources.AppLocals)
+}
@@ -27,23 +27,33 @@ import (
 	"github.com/algorand/go-algorand/data/transactions"
 	"github.com/algorand/go-algorand/data/transactions/logic"
 	"github.com/algorand/go-algorand/data/transactions/verify"
+	"github.com/algorand/go-algorand/ledger/eval"
 	"github.com/algorand/go-algorand/ledger/ledgercore"
 	"github.com/algorand/go-algorand/protocol"
 )
 
-// simulatorLedger patches the ledger interface to use a constant latest round.
+// simulatorLedger patches the ledger interface to use a constant latest round, and to apply
+// any state overrides requested for the simulation.
 type simulatorLedger struct {
 	*data.Ledger
-	start basics.Round
+	start     basics.Round
+	overrides *stateOverrideLayer
 }
 
 // Request packs simulation related txn-group(s), and configurations that are overlapping the ones in real transactions.
 type Request struct {
-	TxnGroups            [][]transactions.SignedTxn
-	AllowEmptySignatures bool
-	AllowMoreLogging     bool
-	ExtraOpcodeBudget    uint64
-	TraceConfig          ExecTraceConfig
+	// Round is the round whose state the simulation is evaluated against. If zero, the latest
+	// round is used. Only rounds whose state the ledger still keeps in memory, the most recent
+	// MaxAcctLookback ones, can be simulated. Archival nodes keep old blocks but not the account
+	// state as of old rounds, so they reject older rounds too.
+	Round                 basics.Round
+	TxnGroups             [][]transactions.SignedTxn
+	AllowEmptySignatures  bool
+	AllowMoreLogging      bool
+	AllowUnnamedResources bool
+	ExtraOpcodeBudget     uint64
+	TraceConfig           ExecTraceConfig
+	StateOverrides        StateOverrides
 }
 
 // Latest is part of the LedgerForSimulator interface.
@@ -61,6 +71,64 @@ func (l simulatorLedger) LookupLatest(addr basics.Address) (basics.AccountData,
 	return basics.AccountData{}, 0, basics.MicroAlgos{}, err
 }
 
+// LatestTotals is part of the LedgerForEvaluator interface.
+// We override this so that the evaluator sees the account totals as of the simulation round,
+// rather than those of the underlying ledger's latest round, adjusted for any balance overrides.
+func (l simulatorLedger) LatestTotals() (basics.Round, ledgercore.AccountTotals, error) {
+	if l.overrides != nil {
+		return l.start, l.overrides.totals, nil
+	}
+	totals, err := l.Totals(l.start)
+	if err != nil {
+		return 0, ledgercore.AccountTotals{}, err
+	}
+	return l.start, totals, nil
+}
+
+// LookupWithoutRewards is part of the LedgerForEvaluator interface.
+// We override this to apply account state overrides for the simulation round.
+func (l simulatorLedger) LookupWithoutRewards(rnd basics.Round, addr basics.Address) (ledgercore.AccountData, basics.Round, error) {
+	if l.overrides != nil && rnd == l.start {
+		if ad, ok := l.overrides.accounts[addr]; ok {
+			return ad, rnd, nil
+		}
+	}
+	return l.Ledger.LookupWithoutRewards(rnd, addr)
+}
+
+// LookupAsset is part of the LedgerForEvaluator interface.
+// We override this to apply asset holding overrides for the simulation round.
+func (l simulatorLedger) LookupAsset(rnd basics.Round, addr basics.Address, aidx basics.AssetIndex) (ledgercore.AssetResource, error) {
+	if l.overrides != nil && rnd == l.start {
+		if res, ok := l.overrides.assets[ledgercore.AccountAsset{Address: addr, Asset: aidx}]; ok {
+			return res, nil
+		}
+	}
+	return l.Ledger.LookupAsset(rnd, addr, aidx)
+}
+
+// LookupApplication is part of the LedgerForEvaluator interface.
+// We override this to apply app params and local state overrides for the simulation round.
+func (l simulatorLedger) LookupApplication(rnd basics.Round, addr basics.Address, aidx basics.AppIndex) (ledgercore.AppResource, error) {
+	if l.overrides != nil && rnd == l.start {
+		if res, ok := l.overrides.apps[ledgercore.AccountApp{Address: addr, App: aidx}]; ok {
+			return res, nil
+		}
+	}
+	return l.Ledger.LookupApplication(rnd, addr, aidx)
+}
+
+// LookupKv is part of the LedgerForEvaluator interface.
+// We override this to apply box overrides for the simulation round.
+func (l simulatorLedger) LookupKv(rnd basics.Round, key string) ([]byte, error) {
+	if l.overrides != nil && rnd == l.start {
+		if value, ok := l.overrides.kvs[key]; ok {
+			return value, nil
+		}
+	}
+	return l.Ledger.LookupKv(rnd, key)
+}
+
 // SimulatorError is the base error type for all simulator errors.
 type SimulatorError struct {
 	err error
@@ -92,8 +160,15 @@ type Simulator struct {
 
 // MakeSimulator creates a new simulator from a ledger.
 func MakeSimulator(ledger *data.Ledger, developerAPI bool) *Simulator {
+	return MakeSimulatorAtRound(ledger, ledger.Latest(), developerAPI)
+}
+
+// MakeSimulatorAtRound creates a new simulator from a ledger, which treats latest as the latest
+// round of the ledger. Later rounds are ignored, so simulations can neither be evaluated against
+// them nor see their effects. latest must not be ahead of the ledger.
+func MakeSimulatorAtRound(ledger *data.Ledger, latest basics.Round, developerAPI bool) *Simulator {
 	return &Simulator{
-		ledger:       simulatorLedger{ledger, ledger.Latest()},
+		ledger:       simulatorLedger{Ledger: ledger, start: latest},
 		developerAPI: developerAPI,
 	}
 }
@@ -156,22 +231,28 @@ func (s Simulator) check(hdr bookkeeping.BlockHeader, txgroup []transactions.Sig
 }
 
 func (s Simulator) evaluate(hdr bookkeeping.BlockHeader, stxns []transactions.SignedTxn, tracer logic.EvalTracer) (*ledgercore.ValidatedBlock, error) {
-	// s.ledger has 'StartEvaluator' because *data.Ledger is embedded in the simulatorLedger
-	// and data.Ledger embeds *ledger.Ledger
-	eval, err := s.ledger.StartEvaluator(hdr, len(stxns), 0, tracer)
+	// The evaluator is started directly on s.ledger, rather than through the StartEvaluator method
+	// of the embedded *data.Ledger, so that the simulatorLedger overrides are used.
+	evaluator, err := eval.StartEvaluator(s.ledger, hdr,
+		eval.EvaluatorOptions{
+			PaysetHint: len(stxns),
+			Generate:   true,
+			Validate:   true,
+			Tracer:     tracer,
+		})
 	if err != nil {
 		return nil, err
 	}
 
 	group := transactions.WrapSignedTxnsWithAD(stxns)
 
-	err = eval.TransactionGroup(group)
+	err = evaluator.TransactionGroup(group)
 	if err != nil {
 		return nil, EvalFailureError{SimulatorError{err}}
 	}
 
 	// Finally, process any pending end-of-block state changes.
-	vb, err := eval.GenerateBlock()
+	vb, err := evaluator.GenerateBlock()
 	if err != nil {
 		return nil, err
 	}
@@ -208,8 +289,52 @@ func (s Simulator) simulateWithTracer(txgroup []transactions.SignedTxn, tracer l
 	return vb, err
 }
 
+// setRound pins the simulator to the round requested by the caller, if any. The round must not be
+// ahead of the latest round, and its state must still be kept in memory by the ledger. There is no
+// archival account lookup to fall back to, so older rounds are rejected even on archival nodes.
+func (s *Simulator) setRound(rnd basics.Round) error {
+	if rnd == 0 {
+		return nil
+	}
+	if rnd > s.ledger.start {
+		return InvalidRequestError{
+			SimulatorError{
+				err: fmt.Errorf("requested round %d is ahead of the latest round %d", rnd, s.ledger.start),
+			},
+		}
+	}
+	if oldest := s.ledger.LatestTrackerCommitted(); rnd < oldest {
+		return InvalidRequestError{
+			SimulatorError{
+				err: fmt.Errorf("requested round %d is older than round %d, the oldest whose state is available for simulation (only rounds kept in memory can be simulated, even on archival nodes)", rnd, oldest),
+			},
+		}
+	}
+	if _, err := s.ledger.Totals(rnd); err != nil {
+		return InvalidRequestError{
+			SimulatorError{
+				err: fmt.Errorf("state for round %d is not available for simulation: %w", rnd, err),
+			},
+		}
+	}
+	s.ledger.start = rnd
+	return nil
+}
+
 // Simulate simulates a transaction group using the simulator. Will error if the transaction group is not well-formed.
 func (s Simulator) Simulate(simulateRequest Request) (Result, error) {
+	err := s.setRound(simulateRequest.Round)
+	if err != nil {
+		return Result{}, err
+	}
+
+	if !simulateRequest.StateOverrides.Empty() {
+		s.ledger.overrides, err = makeStateOverrideLayer(s.ledger, s.ledger.start, simulateRequest.StateOverrides)
+		if err != nil {
+			return Result{}, err
+		}
+	}
+
 	simulatorTracer, err := makeEvalTracer(s.ledger.start, simulateRequest, s.developerAPI)
 	if err != nil {
 		return Result{}, err
@@ -245,6 +370,10 @@ func (s Simulator) Simulate(simulateRequest Request) (Result, error) {
 
 	simulatorTracer.result.Block = block
 
+	if simulatorTracer.unnamedResourcePolicy != nil {
+		simulatorTracer.unnamedResourcePolicy.populate(&simulatorTracer.result.TxnGroups[0])
+	}
+
 	// Update total cost by aggregating individual txn costs
 	totalCost := uint64(0)
 	for _, txn := range simulatorTracer.result.TxnGroups[0].Txns {
@@ -20,6 +20,7 @@ import (
 	"reflect"
 	"testing"
 
+	"github.com/algorand/go-algorand/config"
 	"github.com/algorand/go-algorand/crypto"
 	"github.com/algorand/go-algorand/data/basics"
 	"github.com/algorand/go-algorand/data/bookkeeping"
@@ -48,13 +49,17 @@ func TestNonOverridenDataLedgerMethodsUseRoundParameter(t *testing.T) {
 	overridenMethods := []string{
 		"Latest",
 		"LookupLatest",
+		"LatestTotals",
+		"LookupWithoutRewards",
+		"LookupAsset",
+		"LookupApplication",
+		"LookupKv",
 	}
 
 	// methods that don't use a round number
 	excludedMethods := []string{
 		"GenesisHash",
 		"GenesisProto",
-		"LatestTotals",
 		"FlushCaches",
 	}
 
@@ -239,3 +244,33 @@ int 1`,
 	}
 	mocktracer.AssertEventsEqual(t, expectedEvents, mockTracer.Events)
 }
+
+// TestBalanceOverrideAdjustsTotals ensures that the account totals seen by the evaluator account
+// for balance overrides.
+func TestBalanceOverrideAdjustsTotals(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	env := simulationtesting.PrepareSimulatorTest(t)
+	defer env.Close()
+	s := MakeSimulator(env.Ledger, false)
+	addr := env.Accounts[0].Addr
+
+	_, before, err := s.ledger.LatestTotals()
+	require.NoError(t, err)
+	hdr, err := s.ledger.BlockHdr(s.ledger.start)
+	require.NoError(t, err)
+	ad, _, err := s.ledger.LookupWithoutRewards(s.ledger.start, addr)
+	require.NoError(t, err)
+	money, _ := ad.Money(config.Consensus[hdr.CurrentProtocol], before.RewardsLevel)
+
+	balance := uint64(0)
+	s.ledger.overrides, err = makeStateOverrideLayer(s.ledger, s.ledger.start, StateOverrides{
+		Accounts: []AccountOverride{{Address: addr, Balance: &balance}},
+	})
+	require.NoError(t, err)
+
+	_, after, err := s.ledger.LatestTotals()
+	require.NoError(t, err)
+	require.Equal(t, before.All().Raw-money.Raw, after.All().Raw)
+}
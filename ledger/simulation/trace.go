@@ -36,6 +36,10 @@ type TxnResult struct {
 	AppBudgetConsumed      uint64
 	LogicSigBudgetConsumed uint64
 	Trace                  *TransactionTrace
+
+	// UnnamedResourcesAccessed is the set of unnamed resources accessed by this transaction that
+	// can only be named by this transaction. Only set if unnamed resources were allowed.
+	UnnamedResourcesAccessed *ResourceTracker
 }
 
 // TxnGroupResult contains the simulation result for a single transaction group
@@ -50,6 +54,10 @@ type TxnGroupResult struct {
 	AppBudgetAdded uint64
 	// AppBudgetConsumed is the total opcode cost used for this group
 	AppBudgetConsumed uint64
+
+	// UnnamedResourcesAccessed is the set of unnamed resources accessed by this group that can be
+	// named by any of its transactions. Only set if unnamed resources were allowed.
+	UnnamedResourcesAccessed *ResourceTracker
 }
 
 func makeTxnGroupResult(txgroup []transactions.SignedTxn) TxnGroupResult {
@@ -67,10 +75,11 @@ const ResultLatestVersion = uint64(2)
 
 // ResultEvalOverrides contains the limits and parameters during a call to Simulator.Simulate
 type ResultEvalOverrides struct {
-	AllowEmptySignatures bool
-	MaxLogCalls          *uint64
-	MaxLogSize           *uint64
-	ExtraOpcodeBudget    uint64
+	AllowEmptySignatures  bool
+	AllowUnnamedResources bool
+	MaxLogCalls           *uint64
+	MaxLogSize            *uint64
+	ExtraOpcodeBudget     uint64
 }
 
 // LogBytesLimit hardcode limit of how much bytes one can log per transaction during simulation (with AllowMoreLogging)
@@ -106,7 +115,10 @@ func (eo ResultEvalOverrides) LogicEvalConstants() logic.EvalConstants {
 
 // ExecTraceConfig gathers all execution trace related configs for simulation result
 type ExecTraceConfig struct {
-	Enable bool `codec:"enable,omitempty"`
+	Enable  bool `codec:"enable,omitempty"`
+	Stack   bool `codec:"stack-change,omitempty"`
+	Scratch bool `codec:"scratch-change,omitempty"`
+	State   bool `codec:"state-change,omitempty"`
 }
 
 // Result contains the result from a call to Simulator.Simulate
@@ -124,9 +136,19 @@ type Result struct {
 // The other invalid options would be eliminated in validateSimulateRequest early.
 func (r Result) ReturnTrace() bool { return r.TraceConfig.Enable }
 
+// ReturnStackChange reads from Result object and decides if simulation returns stack changes.
+func (r Result) ReturnStackChange() bool { return r.TraceConfig.Stack }
+
+// ReturnScratchChange reads from Result object and decides if simulation returns scratch slot changes.
+func (r Result) ReturnScratchChange() bool { return r.TraceConfig.Scratch }
+
+// ReturnStateChange reads from Result object and decides if simulation returns app state changes.
+func (r Result) ReturnStateChange() bool { return r.TraceConfig.State }
+
 // validateSimulateRequest first checks relation between request and config variables, including developerAPI:
 // if `developerAPI` provided is turned off, this method would:
 // - error on asking for exec trace
+// it also errors on asking for stack, scratch or state changes without enabling the basic exec trace.
 func validateSimulateRequest(request Request, developerAPI bool) error {
 	if !developerAPI && request.TraceConfig.Enable {
 		return InvalidRequestError{
@@ -135,6 +157,29 @@ func validateSimulateRequest(request Request, developerAPI bool) error {
 			},
 		}
 	}
+	if !request.TraceConfig.Enable {
+		if request.TraceConfig.Stack {
+			return InvalidRequestError{
+				SimulatorError{
+					err: fmt.Errorf("basic trace must be enabled when enabling stack tracing"),
+				},
+			}
+		}
+		if request.TraceConfig.Scratch {
+			return InvalidRequestError{
+				SimulatorError{
+					err: fmt.Errorf("basic trace must be enabled when enabling scratch slot change tracing"),
+				},
+			}
+		}
+		if request.TraceConfig.State {
+			return InvalidRequestError{
+				SimulatorError{
+					err: fmt.Errorf("basic trace must be enabled when enabling app state change tracing"),
+				},
+			}
+		}
+	}
 	return nil
 }
 
@@ -146,8 +191,9 @@ func makeSimulationResult(lastRound basics.Round, request Request, developerAPI
 	}
 
 	resultEvalConstants := ResultEvalOverrides{
-		AllowEmptySignatures: request.AllowEmptySignatures,
-		ExtraOpcodeBudget:    request.ExtraOpcodeBudget,
+		AllowEmptySignatures:  request.AllowEmptySignatures,
+		AllowUnnamedResources: request.AllowUnnamedResources,
+		ExtraOpcodeBudget:     request.ExtraOpcodeBudget,
 	}.AllowMoreLogging(request.AllowMoreLogging)
 
 	if err := validateSimulateRequest(request, developerAPI); err != nil {
@@ -172,6 +218,46 @@ type OpcodeTraceUnit struct {
 	// if any. These indexes refer to the InnerTraces array of the TransactionTrace object containing
 	// this OpcodeTraceUnit.
 	SpawnedInners []int
+
+	// StackAdded contains the values pushed onto the stack by this opcode, once StackPopCount
+	// values have been removed from the top of the stack. Only set if stack tracing is enabled.
+	StackAdded []basics.TealValue
+
+	// StackPopCount is the number of values removed (or overwritten in place) from the top of the
+	// stack by this opcode. Only set if stack tracing is enabled.
+	StackPopCount uint64
+
+	// ScratchSlotChanges contains the scratch slots written by this opcode. Only set if scratch
+	// tracing is enabled.
+	ScratchSlotChanges []ScratchChange
+
+	// StateChanges contains the application state changes made by this opcode. Only set if state
+	// tracing is enabled.
+	StateChanges []StateOperation
+}
+
+// ScratchChange is a write to a scratch slot
+type ScratchChange struct {
+	// Slot is the index of the scratch slot written
+	Slot uint64
+	// NewValue is the value stored in the slot
+	NewValue basics.TealValue
+}
+
+// StateOperation is a change to application global, local or box state
+type StateOperation struct {
+	// AppStateOp is either a write or a delete
+	AppStateOp logic.AppStateOpEnum
+	// AppState tells whether global, local or box state changes
+	AppState logic.AppStateEnum
+	// AppID is the application whose state changes
+	AppID basics.AppIndex
+	// Key is the state key, or the box name
+	Key string
+	// NewValue is the value after a write. For boxes, it holds the full box contents.
+	NewValue basics.TealValue
+	// Account is the account whose local state changes. Only set for local state.
+	Account basics.Address
 }
 
 // TransactionTrace contains the trace effects of a single transaction evaluation (including its inners)
@@ -88,6 +88,29 @@ type evalTracer struct {
 	// from top level transaction to the current inner txn that contains latest TransactionTrace.
 	// NOTE: execTraceStack is used only for PC/Stack/Storage exposure.
 	execTraceStack []*TransactionTrace
+
+	// pendingOpcodes keeps what BeforeOpcode learns about an opcode until AfterOpcode, which records
+	// the opcode's effects. It is a stack because an opcode can spawn inner transactions, whose
+	// programs run between the two hooks.
+	pendingOpcodes []pendingOpcode
+
+	// unnamedResourcePolicy is set if the request allows unnamed resources. It records the
+	// resources that top-level app calls access without naming them.
+	unnamedResourcePolicy *unnamedResourcePolicy
+}
+
+// pendingOpcode is the part of an opcode's trace that can only be completed once the opcode has run.
+type pendingOpcode struct {
+	// unit is the OpcodeTraceUnit of the opcode
+	unit *OpcodeTraceUnit
+	// stackPopCount is the number of values removed from the stack by the opcode
+	stackPopCount int
+	// stackBase is the stack height once the values removed by the opcode are gone
+	stackBase int
+	// scratchSlot is the scratch slot written by the opcode, or -1
+	scratchSlot int
+	// stateChange is the application state change made by the opcode, if any
+	stateChange *logic.StateChange
 }
 
 func makeEvalTracer(lastRound basics.Round, request Request, developerAPI bool) (*evalTracer, error) {
@@ -157,6 +180,12 @@ func (tracer *evalTracer) BeforeTxnGroup(ep *logic.EvalParams) {
 
 	// Override runtime related constraints against ep, before entering txn group
 	ep.EvalConstants = tracer.result.EvalOverrides.LogicEvalConstants()
+
+	// Relax resource access checks of the top-level group, recording what gets used
+	if ep.GetCaller() == nil && tracer.result.EvalOverrides.AllowUnnamedResources {
+		tracer.unnamedResourcePolicy = makeUnnamedResourcePolicy(len(ep.TxnGroup))
+		ep.UnnamedResources = tracer.unnamedResourcePolicy
+	}
 }
 
 func (tracer *evalTracer) AfterTxnGroup(ep *logic.EvalParams, deltas *ledgercore.StateDelta, evalError error) {
@@ -245,6 +274,57 @@ func (tracer *evalTracer) makeOpcodeTraceUnit(cx *logic.EvalContext) OpcodeTrace
 	return OpcodeTraceUnit{PC: uint64(cx.PC())}
 }
 
+func (tracer *evalTracer) makePendingOpcode(cx *logic.EvalContext, unit *OpcodeTraceUnit) pendingOpcode {
+	pending := pendingOpcode{unit: unit, scratchSlot: -1}
+	if tracer.result.ReturnStackChange() {
+		pending.stackPopCount = cx.NextStackDeletions()
+		pending.stackBase = cx.StackHeight() - pending.stackPopCount
+	}
+	if tracer.result.ReturnScratchChange() {
+		if slot, ok := cx.NextScratchWrite(); ok {
+			pending.scratchSlot = slot
+		}
+	}
+	if tracer.result.ReturnStateChange() {
+		if change, ok := cx.NextStateChange(); ok {
+			pending.stateChange = &change
+		}
+	}
+	return pending
+}
+
+// recordOpcodeEffects fills in the stack, scratch and state changes of a successfully evaluated opcode.
+func (tracer *evalTracer) recordOpcodeEffects(cx *logic.EvalContext, pending pendingOpcode) {
+	if tracer.result.ReturnStackChange() {
+		pending.unit.StackPopCount = uint64(pending.stackPopCount)
+		pending.unit.StackAdded = cx.StackValues(pending.stackBase)
+	}
+	if pending.scratchSlot >= 0 {
+		pending.unit.ScratchSlotChanges = append(pending.unit.ScratchSlotChanges, ScratchChange{
+			Slot:     uint64(pending.scratchSlot),
+			NewValue: cx.ScratchValue(pending.scratchSlot),
+		})
+	}
+	if change := pending.stateChange; change != nil {
+		operation := StateOperation{
+			AppStateOp: change.AppStateOp,
+			AppState:   change.AppState,
+			AppID:      change.AppID,
+			Key:        change.Key,
+			NewValue:   change.NewValue,
+			Account:    change.Account,
+		}
+		if change.AppState == logic.BoxState && change.AppStateOp == logic.AppStateWrite {
+			content, exists, err := cx.Ledger.GetBox(change.AppID, change.Key)
+			if err != nil || !exists {
+				return
+			}
+			operation.NewValue = basics.TealValue{Type: basics.TealBytesType, Bytes: string(content)}
+		}
+		pending.unit.StateChanges = append(pending.unit.StateChanges, operation)
+	}
+}
+
 func (tracer *evalTracer) BeforeOpcode(cx *logic.EvalContext) {
 	groupIndex := cx.GroupIndex()
 
@@ -267,12 +347,26 @@ func (tracer *evalTracer) BeforeOpcode(cx *logic.EvalContext) {
 			txnTrace = tracer.execTraceStack[len(tracer.execTraceStack)-1]
 		}
 		*txnTrace.programTraceRef = append(*txnTrace.programTraceRef, tracer.makeOpcodeTraceUnit(cx))
+
+		// Inner transactions spawned by this opcode only append to their own traces, so the
+		// pointer to this opcode's trace unit remains valid until AfterOpcode.
+		unit := &(*txnTrace.programTraceRef)[len(*txnTrace.programTraceRef)-1]
+		tracer.pendingOpcodes = append(tracer.pendingOpcodes, tracer.makePendingOpcode(cx, unit))
 	}
 }
 
 func (tracer *evalTracer) AfterOpcode(cx *logic.EvalContext, evalError error) {
+	if tracer.result.ReturnTrace() {
+		top := len(tracer.pendingOpcodes) - 1
+		pending := tracer.pendingOpcodes[top]
+		tracer.pendingOpcodes = tracer.pendingOpcodes[:top]
+		if evalError == nil {
+			tracer.recordOpcodeEffects(cx, pending)
+		}
+	}
+
 	if cx.RunMode() != logic.ModeApp {
-		// do nothing for LogicSig ops
+		// do nothing else for LogicSig ops
 		return
 	}
 	tracer.handleError(evalError)
@@ -289,6 +383,11 @@ func (tracer *evalTracer) BeforeProgram(cx *logic.EvalContext) {
 			traceRef := tracer.result.TxnGroups[0].Txns[groupIndex].Trace
 			traceRef.programTraceRef = &traceRef.LogicSigTrace
 		}
+		return
+	}
+
+	if cx.UnnamedResources != nil {
+		tracer.unnamedResourcePolicy.enterProgram(cx.SharesResources(), tracer.relativeCursor[0])
 	}
 }
 
@@ -308,5 +407,9 @@ func (tracer *evalTracer) AfterProgram(cx *logic.EvalContext, evalError error) {
 	// If it is an inner app call, roll up its cost to the top level transaction.
 	tracer.result.TxnGroups[0].Txns[tracer.relativeCursor[0]].AppBudgetConsumed += uint64(cx.Cost())
 
+	if cx.UnnamedResources != nil {
+		tracer.unnamedResourcePolicy.exitProgram()
+	}
+
 	tracer.handleError(evalError)
 }
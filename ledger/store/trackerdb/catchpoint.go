@@ -48,6 +48,10 @@ var TrieCachedNodesCount = 9000
 // CatchpointDirName represents the directory name in which all the catchpoints files are stored
 var CatchpointDirName = "catchpoints"
 
+// CatchpointChunkIndexFileSuffix is appended to the path of a catchpoint file to get the path of its chunk index,
+// which records the offset of the gzip member holding each of the catchpoint file chunks
+const CatchpointChunkIndexFileSuffix = ".index"
+
 // CatchpointState is used to store catchpoint related variables into the catchpointstate table.
 //
 //msgp:ignore CatchpointState
@@ -82,6 +86,12 @@ const (
 	CatchpointStateCatchpointLookback = CatchpointState("catchpointLookback")
 	// CatchpointStateCatchupVersion is the catchpoint version which the currently catchpoint catchup process is trying to catchup to.
 	CatchpointStateCatchupVersion = CatchpointState("catchpointCatchupVersion")
+	// CatchpointStateCatchupDownloadProgress is the progress of the catchpoint file download, stored after each processed catchpoint file chunk so that
+	// an interrupted download could be resumed from the last processed chunk.
+	CatchpointStateCatchupDownloadProgress = CatchpointState("catchpointCatchupDownloadProgress")
+	// CatchpointStateCatchupLocalFiles holds the catchpoint file and the blocks file of a catchpoint catchup installed from the local file system,
+	// so that the catchup could keep using them once resumed.
+	CatchpointStateCatchupLocalFiles = CatchpointState("catchpointCatchupLocalFiles")
 )
 
 // UnfinishedCatchpointRecord represents a stored record of an unfinished catchpoint.
@@ -150,13 +160,15 @@ func MakeCatchpointFilePath(round basics.Round) string {
 // RemoveSingleCatchpointFileFromDisk removes a single catchpoint file from the disk. this function does not leave empty directories
 func RemoveSingleCatchpointFileFromDisk(dbDirectory, fileToDelete string) (err error) {
 	absCatchpointFileName := filepath.Join(dbDirectory, fileToDelete)
-	err = os.Remove(absCatchpointFileName)
-	if err == nil || os.IsNotExist(err) {
-		// it's ok if the file doesn't exist.
-		err = nil
-	} else {
-		// we can't delete the file, abort -
-		return fmt.Errorf("unable to delete old catchpoint file '%s' : %v", absCatchpointFileName, err)
+	for _, fileName := range []string{absCatchpointFileName, absCatchpointFileName + CatchpointChunkIndexFileSuffix} {
+		err = os.Remove(fileName)
+		if err == nil || os.IsNotExist(err) {
+			// it's ok if the file doesn't exist.
+			err = nil
+		} else {
+			// we can't delete the file, abort -
+			return fmt.Errorf("unable to delete old catchpoint file '%s' : %v", fileName, err)
+		}
 	}
 	splitedDirName := strings.Split(fileToDelete, string(os.PathSeparator))
 
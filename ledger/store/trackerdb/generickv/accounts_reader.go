@@ -0,0 +1,892 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package generickv
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/ledger/store/trackerdb"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+// accountRef references an account by its address, which is the key of the account records.
+// The resources of the account are referenced the same way.
+type accountRef struct {
+	addr basics.Address
+}
+
+func (ref accountRef) AccountRefMarker()  {}
+func (ref accountRef) ResourceRefMarker() {}
+
+// onlineAccountRef references an entry of the online accounts history.
+type onlineAccountRef struct {
+	addr     basics.Address
+	updRound uint64
+}
+
+func (ref onlineAccountRef) OnlineAccountRefMarker() {}
+
+// creatableRef references a creatable by its type and index.
+type creatableRef struct {
+	ctype basics.CreatableType
+	cidx  basics.CreatableIndex
+}
+
+func (ref creatableRef) CreatableRefMarker() {}
+
+type accountsReader struct {
+	kvr KvRead
+}
+
+// MakeAccountsReader returns a trackerdb.AccountsReader and trackerdb.AccountsReaderExt reading from kvr.
+func MakeAccountsReader(kvr KvRead) *accountsReader {
+	return &accountsReader{kvr: kvr}
+}
+
+// Testing returns this reader, exposed as an interface with test functions
+func (r *accountsReader) Testing() trackerdb.TestAccountsReaderExt {
+	return r
+}
+
+// Close is a no-op, the reader does not hold any resources.
+func (r *accountsReader) Close() {}
+
+func readUint64(kvr KvRead, key string) (uint64, error) {
+	value, err := kvr.Get([]byte(key))
+	if err != nil {
+		return 0, err
+	}
+	if len(value) != 8 {
+		return 0, fmt.Errorf("unexpected length %d of the value of %s", len(value), key)
+	}
+	return decodeUint64(value), nil
+}
+
+// countKeys returns the number of keys starting with prefix.
+func countKeys(kvr KvRead, prefix string) (total uint64, err error) {
+	low, high := prefixRange([]byte(prefix))
+	iter := kvr.NewIter(low, high, false)
+	defer iter.Close()
+	for iter.Next() {
+		total++
+	}
+	return total, iter.Err()
+}
+
+// splitAccountValue splits a stored account record into its normalized online balance and encoded BaseAccountData.
+func splitAccountValue(value []byte) (normBalance uint64, encodedData []byte, err error) {
+	if len(value) < 8 {
+		return 0, nil, fmt.Errorf("account record is too short: %d bytes", len(value))
+	}
+	return decodeUint64(value), value[8:], nil
+}
+
+func makeAccountValue(normBalance uint64, encodedData []byte) []byte {
+	return append(appendUint64(make([]byte, 0, 8+len(encodedData)), normBalance), encodedData...)
+}
+
+// onlineAccountValue is the decoded value of an online accounts history entry.
+type onlineAccountValue struct {
+	normBalance   uint64
+	voteLastValid uint64
+	encodedData   []byte
+}
+
+func splitOnlineAccountValue(value []byte) (v onlineAccountValue, err error) {
+	if len(value) < 16 {
+		return v, fmt.Errorf("online account record is too short: %d bytes", len(value))
+	}
+	return onlineAccountValue{decodeUint64(value), decodeUint64(value[8:]), value[16:]}, nil
+}
+
+func makeOnlineAccountValue(normBalance uint64, voteLastValid uint64, encodedData []byte) []byte {
+	value := make([]byte, 0, 16+len(encodedData))
+	value = appendUint64(value, normBalance)
+	value = appendUint64(value, voteLastValid)
+	return append(value, encodedData...)
+}
+
+// AccountsRound returns the tracker balances round number
+func (r *accountsReader) AccountsRound() (rnd basics.Round, err error) {
+	v, err := readUint64(r.kvr, keyAccountsRound)
+	return basics.Round(v), err
+}
+
+// dbRound returns the tracker balances round number, to be reported along with the looked up data.
+func (r *accountsReader) dbRound() (basics.Round, error) {
+	rnd, err := r.AccountsRound()
+	if err == ErrNotFound {
+		// this should never happen; it indicates that the database was not initialized.
+		err = fmt.Errorf("unable to read the accounts round : %w", err)
+	}
+	return rnd, err
+}
+
+// AccountsHashRound returns the round of the hash tree
+// if the hash of the tree doesn't exists, it returns zero.
+func (r *accountsReader) AccountsHashRound(ctx context.Context) (hashrnd basics.Round, err error) {
+	v, err := readUint64(r.kvr, keyHashRound)
+	if err == ErrNotFound {
+		return 0, nil
+	}
+	return basics.Round(v), err
+}
+
+// AccountsTotals returns account totals
+func (r *accountsReader) AccountsTotals(ctx context.Context, catchpointStaging bool) (totals ledgercore.AccountTotals, err error) {
+	key := keyTotals
+	if catchpointStaging {
+		key = keyStagingTotals
+	}
+	value, err := r.kvr.Get([]byte(key))
+	if err != nil {
+		return
+	}
+	err = protocol.Decode(value, &totals)
+	return
+}
+
+// ListCreatables returns an array of CreatableLocator which have CreatableIndex smaller or equal to maxIdx and are of the provided CreatableType.
+func (r *accountsReader) ListCreatables(maxIdx basics.CreatableIndex, maxResults uint64, ctype basics.CreatableType) (results []basics.CreatableLocator, dbRound basics.Round, err error) {
+	dbRound, err = r.dbRound()
+	if err != nil {
+		return
+	}
+
+	low := creatableKey(prefixCreatable, ctype, 0)
+	high := keyInclusiveEnd(creatableKey(prefixCreatable, ctype, maxIdx))
+	iter := r.kvr.NewIter(low, high, true)
+	defer iter.Close()
+
+	for uint64(len(results)) < maxResults && iter.Next() {
+		var value []byte
+		value, err = iter.Value()
+		if err != nil {
+			return
+		}
+		cl := basics.CreatableLocator{
+			Type:  ctype,
+			Index: basics.CreatableIndex(decodeUint64(iter.Key()[len(prefixCreatable)+1:])),
+		}
+		copy(cl.Creator[:], value)
+		results = append(results, cl)
+	}
+	err = iter.Err()
+	return
+}
+
+// LookupAccount looks up for a the account data given it's address. It returns the persistedAccountData, which includes the current database round and the matching
+// account data, if such was found. If no matching account data could be found for the given address, an empty account data would
+// be retrieved.
+func (r *accountsReader) LookupAccount(addr basics.Address) (data trackerdb.PersistedAccountData, err error) {
+	// the round is read first: should a commit happen in between, the account would be reported
+	// with an older round than the one it belongs to, and the caller would retry the lookup.
+	data.Round, err = r.dbRound()
+	if err != nil {
+		return
+	}
+	data.Addr = addr
+
+	value, err := r.kvr.Get(accountKey(prefixAccount, addr))
+	if err == ErrNotFound {
+		// we don't have that account, just return the database round.
+		return data, nil
+	}
+	if err != nil {
+		return
+	}
+	_, encodedData, err := splitAccountValue(value)
+	if err != nil {
+		return
+	}
+	data.Ref = accountRef{addr}
+	err = protocol.Decode(encodedData, &data.AccountData)
+	return
+}
+
+// LookupResources returns the requested resource.
+func (r *accountsReader) LookupResources(addr basics.Address, aidx basics.CreatableIndex, ctype basics.CreatableType) (data trackerdb.PersistedResourcesData, err error) {
+	data.Round, err = r.dbRound()
+	if err != nil {
+		return
+	}
+	data.Aidx = aidx
+
+	value, err := r.kvr.Get(resourceKey(prefixResource, addr, aidx))
+	if err == ErrNotFound {
+		data.Data = trackerdb.MakeResourcesData(0)
+		// we don't have that resource, just return the database round.
+		return data, nil
+	}
+	if err != nil {
+		return
+	}
+	data.AcctRef = accountRef{addr}
+	err = protocol.Decode(value, &data.Data)
+	if err != nil {
+		return
+	}
+	if ctype == basics.AssetCreatable && !data.Data.IsAsset() {
+		err = fmt.Errorf("lookupResources asked for an asset but got %v", data.Data)
+		return
+	}
+	if ctype == basics.AppCreatable && !data.Data.IsApp() {
+		err = fmt.Errorf("lookupResources asked for an app but got %v", data.Data)
+		return
+	}
+	return
+}
+
+// LookupAllResources returns all resources associated with the given address.
+func (r *accountsReader) LookupAllResources(addr basics.Address) (data []trackerdb.PersistedResourcesData, rnd basics.Round, err error) {
+	rnd, err = r.dbRound()
+	if err != nil {
+		return
+	}
+
+	prefix := accountKey(prefixResource, addr)
+	low, high := prefixRange(prefix)
+	iter := r.kvr.NewIter(low, high, false)
+	defer iter.Close()
+
+	for iter.Next() {
+		var value []byte
+		value, err = iter.Value()
+		if err != nil {
+			return
+		}
+		var resData trackerdb.ResourcesData
+		err = protocol.Decode(value, &resData)
+		if err != nil {
+			return
+		}
+		data = append(data, trackerdb.PersistedResourcesData{
+			AcctRef: accountRef{addr},
+			Aidx:    basics.CreatableIndex(decodeUint64(iter.Key()[len(prefix):])),
+			Data:    resData,
+			Round:   rnd,
+		})
+	}
+	err = iter.Err()
+	return
+}
+
+// LookupKeyValue returns the application boxed value associated with the key.
+func (r *accountsReader) LookupKeyValue(key string) (pv trackerdb.PersistedKVData, err error) {
+	pv.Round, err = r.dbRound()
+	if err != nil {
+		return
+	}
+
+	value, err := r.kvr.Get(kvKey(prefixKv, key))
+	if err == ErrNotFound {
+		// we don't have that key, just return pv with the database round (pv.value==nil)
+		return pv, nil
+	}
+	if err != nil {
+		return
+	}
+	if value == nil {
+		value = []byte{}
+	}
+	pv.Value = value
+	return
+}
+
+// LookupKeysByPrefix returns a set of application boxed values matching the prefix.
+func (r *accountsReader) LookupKeysByPrefix(prefix string, maxKeyNum uint64, results map[string]bool, resultCount uint64) (round basics.Round, err error) {
+	if prefixEnd([]byte(prefix)) == nil {
+		// Not an expected use case, it's asking for all keys, or all keys
+		// prefixed by some number of 0xFF bytes.
+		return 0, fmt.Errorf("lookup by strange prefix %#v", prefix)
+	}
+	round, err = r.dbRound()
+	if err != nil {
+		return
+	}
+
+	low, high := prefixRange(kvKey(prefixKv, prefix))
+	iter := r.kvr.NewIter(low, high, false)
+	defer iter.Close()
+
+	for iter.Next() {
+		if resultCount == maxKeyNum {
+			return
+		}
+		key := string(iter.Key()[len(prefixKv):])
+		if _, ok := results[key]; ok {
+			continue
+		}
+		results[key] = true
+		resultCount++
+	}
+	err = iter.Err()
+	return
+}
+
+// LookupCreator returns the address and round of the creator.
+func (r *accountsReader) LookupCreator(cidx basics.CreatableIndex, ctype basics.CreatableType) (addr basics.Address, ok bool, dbRound basics.Round, err error) {
+	dbRound, err = r.dbRound()
+	if err != nil {
+		return
+	}
+
+	value, err := r.kvr.Get(creatableKey(prefixCreatable, ctype, cidx))
+	if err == ErrNotFound {
+		return addr, false, dbRound, nil
+	}
+	if err != nil {
+		return
+	}
+	copy(addr[:], value)
+	return addr, true, dbRound, nil
+}
+
+// AccountsOnlineTop returns the top n online accounts starting at position offset
+// (that is, the top offset'th account through the top offset+n-1'th account).
+//
+// The accounts are sorted by their normalized balance and address.  The normalized
+// balance has to do with the reward parts of online account balances.  See the
+// normalization procedure in AccountData.NormalizedOnlineBalance().
+//
+// Note that this does not check if the accounts have a vote key valid for any
+// particular round (past, present, or future).
+func (r *accountsReader) AccountsOnlineTop(rnd basics.Round, offset uint64, n uint64, proto config.ConsensusParams) (map[basics.Address]*ledgercore.OnlineAccount, error) {
+	// The online balances index holds an entry for every online accounts history entry with a non-zero balance.
+	// Scanning it backwards visits the entries by decreasing balance and address; an entry is only counted
+	// when it is the latest entry of its account not fresher than rnd.
+	low, high := prefixRange([]byte(prefixOnlineBalance))
+	iter := r.kvr.NewIter(low, high, true)
+	defer iter.Close()
+
+	res := make(map[basics.Address]*ledgercore.OnlineAccount, n)
+	var position uint64
+	for uint64(len(res)) < n && iter.Next() {
+		key := iter.Key()
+		normBalance := decodeUint64(key[len(prefixOnlineBalance):])
+		addr := addressFromKey(key, len(prefixOnlineBalance)+8)
+		updRound := decodeUint64(key[len(prefixOnlineBalance)+8+len(addr):])
+		if updRound > uint64(rnd) {
+			continue
+		}
+
+		latest, found, err := r.latestOnlineAccount(addr, rnd)
+		if err != nil {
+			return nil, err
+		}
+		if !found || latest.updRound != updRound || latest.value.normBalance != normBalance {
+			// a stale entry, superseded by a more recent update of the account.
+			continue
+		}
+
+		if position < offset {
+			position++
+			continue
+		}
+		position++
+
+		var data trackerdb.BaseOnlineAccountData
+		err = protocol.Decode(latest.value.encodedData, &data)
+		if err != nil {
+			return nil, err
+		}
+		// The original implementation uses current proto to recalculate norm balance
+		// In the same time, in accountsNewRound genesis protocol is used to fill norm balance value
+		// In order to be consistent with the original implementation recalculate the balance with current proto
+		normBalance = basics.NormalizedOnlineAccountBalance(basics.Online, data.RewardsBase, data.MicroAlgos, proto)
+		oa := data.GetOnlineAccount(addr, normBalance)
+		res[addr] = &oa
+	}
+	return res, iter.Err()
+}
+
+type onlineAccountEntry struct {
+	updRound uint64
+	value    onlineAccountValue
+}
+
+// latestOnlineAccount returns the latest online accounts history entry of addr not fresher than rnd.
+func (r *accountsReader) latestOnlineAccount(addr basics.Address, rnd basics.Round) (entry onlineAccountEntry, found bool, err error) {
+	low := onlineAccountKey(addr, 0)
+	high := keyInclusiveEnd(onlineAccountKey(addr, uint64(rnd)))
+	iter := r.kvr.NewIter(low, high, true)
+	defer iter.Close()
+
+	if !iter.Next() {
+		return entry, false, iter.Err()
+	}
+	value, err := iter.Value()
+	if err != nil {
+		return
+	}
+	entry.updRound = decodeUint64(iter.Key()[len(prefixOnlineAccount)+len(addr):])
+	entry.value, err = splitOnlineAccountValue(value)
+	if err != nil {
+		return
+	}
+	// the value is only valid until the iterator moves, keep a copy.
+	entry.value.encodedData = append([]byte{}, entry.value.encodedData...)
+	return entry, true, nil
+}
+
+// forEachOnlineAccount calls fn for every online accounts history entry, ordered by address and update round.
+// The entry is only valid for the duration of the call.
+func (r *accountsReader) forEachOnlineAccount(fn func(addr basics.Address, entry onlineAccountEntry) (bool, error)) error {
+	low, high := prefixRange([]byte(prefixOnlineAccount))
+	iter := r.kvr.NewIter(low, high, false)
+	defer iter.Close()
+
+	for iter.Next() {
+		key := iter.Key()
+		addr := addressFromKey(key, len(prefixOnlineAccount))
+		value, err := iter.Value()
+		if err != nil {
+			return err
+		}
+		entry := onlineAccountEntry{updRound: decodeUint64(key[len(prefixOnlineAccount)+len(addr):])}
+		entry.value, err = splitOnlineAccountValue(value)
+		if err != nil {
+			return err
+		}
+		more, err := fn(addr, entry)
+		if err != nil || !more {
+			return err
+		}
+	}
+	return iter.Err()
+}
+
+// OnlineAccountsAll returns all online accounts
+func (r *accountsReader) OnlineAccountsAll(maxAccounts uint64) ([]trackerdb.PersistedOnlineAccountData, error) {
+	result := make([]trackerdb.PersistedOnlineAccountData, 0, maxAccounts)
+	var numAccounts uint64
+	var seenAddr basics.Address
+	err := r.forEachOnlineAccount(func(addr basics.Address, entry onlineAccountEntry) (bool, error) {
+		if maxAccounts > 0 {
+			if numAccounts == 0 || addr != seenAddr {
+				numAccounts++
+				if numAccounts > maxAccounts {
+					return false, nil
+				}
+				seenAddr = addr
+			}
+		}
+		data := trackerdb.PersistedOnlineAccountData{
+			Addr:     addr,
+			Ref:      onlineAccountRef{addr, entry.updRound},
+			UpdRound: basics.Round(entry.updRound),
+		}
+		err := protocol.Decode(entry.value.encodedData, &data.AccountData)
+		if err != nil {
+			return false, err
+		}
+		result = append(result, data)
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// ExpiredOnlineAccountsForRound returns all online accounts known at `rnd` that will be expired by `voteRnd`.
+func (r *accountsReader) ExpiredOnlineAccountsForRound(rnd, voteRnd basics.Round, proto config.ConsensusParams, rewardsLevel uint64) (map[basics.Address]*ledgercore.OnlineAccountData, error) {
+	ret := make(map[basics.Address]*ledgercore.OnlineAccountData)
+
+	// the entries of an account are visited by increasing update round, so the latest entry
+	// not fresher than rnd is the last one seen before moving to the next account.
+	var latestAddr basics.Address
+	var latest *onlineAccountEntry
+	checkLatest := func() error {
+		if latest == nil {
+			return nil
+		}
+		entry := latest
+		latest = nil
+		if entry.value.voteLastValid == 0 || entry.value.voteLastValid >= uint64(voteRnd) {
+			return nil
+		}
+		var baseData trackerdb.BaseOnlineAccountData
+		err := protocol.Decode(entry.value.encodedData, &baseData)
+		if err != nil {
+			return err
+		}
+		oadata := baseData.GetOnlineAccountData(proto, rewardsLevel)
+		ret[latestAddr] = &oadata
+		return nil
+	}
+
+	err := r.forEachOnlineAccount(func(addr basics.Address, entry onlineAccountEntry) (bool, error) {
+		if latest != nil && addr != latestAddr {
+			err := checkLatest()
+			if err != nil {
+				return false, err
+			}
+		}
+		if entry.updRound <= uint64(rnd) {
+			entry.value.encodedData = append([]byte{}, entry.value.encodedData...)
+			latestAddr = addr
+			latest = &entry
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	err = checkLatest()
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// TotalResources returns the total number of resources
+func (r *accountsReader) TotalResources(ctx context.Context) (total uint64, err error) {
+	return countKeys(r.kvr, prefixResource)
+}
+
+// TotalAccounts returns the total number of accounts
+func (r *accountsReader) TotalAccounts(ctx context.Context) (total uint64, err error) {
+	return countKeys(r.kvr, prefixAccount)
+}
+
+// TotalKVs returns the total number of kv items
+func (r *accountsReader) TotalKVs(ctx context.Context) (total uint64, err error) {
+	return countKeys(r.kvr, prefixKv)
+}
+
+// LoadTxTail returns the tx tails
+func (r *accountsReader) LoadTxTail(ctx context.Context, dbRound basics.Round) (roundData []*trackerdb.TxTailRound, roundHash []crypto.Digest, baseRound basics.Round, err error) {
+	low, high := prefixRange([]byte(prefixTxTail))
+	iter := r.kvr.NewIter(low, high, true)
+	defer iter.Close()
+
+	expectedRound := dbRound
+	for iter.Next() {
+		round := basics.Round(decodeUint64(iter.Key()[len(prefixTxTail):]))
+		if round != expectedRound {
+			return nil, nil, 0, fmt.Errorf("txtail table contain unexpected round %d; round %d was expected", round, expectedRound)
+		}
+		var data []byte
+		data, err = iter.Value()
+		if err != nil {
+			return nil, nil, 0, err
+		}
+		tail := &trackerdb.TxTailRound{}
+		err = protocol.Decode(data, tail)
+		if err != nil {
+			return nil, nil, 0, err
+		}
+		roundData = append(roundData, tail)
+		roundHash = append(roundHash, crypto.Hash(data))
+		expectedRound--
+	}
+	if err = iter.Err(); err != nil {
+		return nil, nil, 0, err
+	}
+	// reverse the array ordering in-place so that it would be incremental order.
+	for i := 0; i < len(roundData)/2; i++ {
+		roundData[i], roundData[len(roundData)-i-1] = roundData[len(roundData)-i-1], roundData[i]
+		roundHash[i], roundHash[len(roundHash)-i-1] = roundHash[len(roundHash)-i-1], roundHash[i]
+	}
+	return roundData, roundHash, expectedRound + 1, nil
+}
+
+// LookupAccountAddressFromAddressID looks up an account based on its reference
+func (r *accountsReader) LookupAccountAddressFromAddressID(ctx context.Context, ref trackerdb.AccountRef) (address basics.Address, err error) {
+	if ref == nil {
+		return address, fmt.Errorf("no matching address could be found for ref = nil: %w", ErrNotFound)
+	}
+	address = ref.(accountRef).addr
+	_, err = r.kvr.Get(accountKey(prefixAccount, address))
+	if err == ErrNotFound {
+		err = fmt.Errorf("no matching address could be found for %v: %w", address, err)
+	}
+	return
+}
+
+// LookupAccountDataByAddress looks up the encoded account data by address.
+func (r *accountsReader) LookupAccountDataByAddress(addr basics.Address) (ref trackerdb.AccountRef, data []byte, err error) {
+	value, err := r.kvr.Get(accountKey(prefixAccount, addr))
+	if err != nil {
+		return
+	}
+	_, data, err = splitAccountValue(value)
+	if err != nil {
+		return
+	}
+	return accountRef{addr}, data, nil
+}
+
+// LookupOnlineAccountDataByAddress looks up online account data by address.
+func (r *accountsReader) LookupOnlineAccountDataByAddress(addr basics.Address) (ref trackerdb.OnlineAccountRef, data []byte, err error) {
+	low, high := prefixRange(accountKey(prefixOnlineAccount, addr))
+	iter := r.kvr.NewIter(low, high, true)
+	defer iter.Close()
+
+	if !iter.Next() {
+		err = iter.Err()
+		if err == nil {
+			err = ErrNotFound
+		}
+		return
+	}
+	value, err := iter.Value()
+	if err != nil {
+		return
+	}
+	v, err := splitOnlineAccountValue(value)
+	if err != nil {
+		return
+	}
+	updRound := decodeUint64(iter.Key()[len(prefixOnlineAccount)+len(addr):])
+	return onlineAccountRef{addr, updRound}, append([]byte{}, v.encodedData...), nil
+}
+
+// LookupAccountRowID looks up the reference of an account based on its address.
+func (r *accountsReader) LookupAccountRowID(addr basics.Address) (ref trackerdb.AccountRef, err error) {
+	_, err = r.kvr.Get(accountKey(prefixAccount, addr))
+	if err != nil {
+		return
+	}
+	return accountRef{addr}, nil
+}
+
+// LookupResourceDataByAddrID looks up the resource data by account reference + resource aidx.
+func (r *accountsReader) LookupResourceDataByAddrID(ref trackerdb.AccountRef, aidx basics.CreatableIndex) (data []byte, err error) {
+	if ref == nil {
+		return data, ErrNotFound
+	}
+	return r.kvr.Get(resourceKey(prefixResource, ref.(accountRef).addr, aidx))
+}
+
+// tablePrefixes maps the sql tables names accepted by LoadAllFullAccounts onto the prefixes of their records.
+var tablePrefixes = map[string]string{
+	"accountbase":         prefixAccount,
+	"resources":           prefixResource,
+	"catchpointbalances":  prefixStagingAccount,
+	"catchpointresources": prefixStagingResource,
+}
+
+// LoadAllFullAccounts loads all accounts from balancesTable and resourcesTable.
+// On every account full load it invokes acctCb callback to report progress and data.
+func (r *accountsReader) LoadAllFullAccounts(
+	ctx context.Context,
+	balancesTable string, resourcesTable string,
+	acctCb func(basics.Address, basics.AccountData),
+) (count int, err error) {
+	balancesPrefix, ok := tablePrefixes[balancesTable]
+	if !ok {
+		return 0, fmt.Errorf("unknown balances table %s", balancesTable)
+	}
+	resourcesPrefix, ok := tablePrefixes[resourcesTable]
+	if !ok {
+		return 0, fmt.Errorf("unknown resources table %s", resourcesTable)
+	}
+
+	low, high := prefixRange([]byte(balancesPrefix))
+	iter := r.kvr.NewIter(low, high, false)
+	defer iter.Close()
+
+	for iter.Next() {
+		addr := addressFromKey(iter.Key(), len(balancesPrefix))
+		var value, encodedData []byte
+		value, err = iter.Value()
+		if err != nil {
+			return
+		}
+		_, encodedData, err = splitAccountValue(value)
+		if err != nil {
+			return
+		}
+		var data trackerdb.BaseAccountData
+		err = protocol.Decode(encodedData, &data)
+		if err != nil {
+			return
+		}
+
+		var ad basics.AccountData
+		ad, err = r.loadFullAccount(resourcesPrefix, addr, data)
+		if err != nil {
+			return
+		}
+
+		acctCb(addr, ad)
+
+		count++
+	}
+	err = iter.Err()
+	return
+}
+
+// loadFullAccount converts BaseAccountData into basics.AccountData and loads all resources as needed
+func (r *accountsReader) loadFullAccount(resourcesPrefix string, addr basics.Address, data trackerdb.BaseAccountData) (ad basics.AccountData, err error) {
+	ad = data.GetAccountData()
+
+	hasResources := false
+	if data.TotalAppParams > 0 {
+		ad.AppParams = make(map[basics.AppIndex]basics.AppParams, data.TotalAppParams)
+		hasResources = true
+	}
+	if data.TotalAppLocalStates > 0 {
+		ad.AppLocalStates = make(map[basics.AppIndex]basics.AppLocalState, data.TotalAppLocalStates)
+		hasResources = true
+	}
+	if data.TotalAssetParams > 0 {
+		ad.AssetParams = make(map[basics.AssetIndex]basics.AssetParams, data.TotalAssetParams)
+		hasResources = true
+	}
+	if data.TotalAssets > 0 {
+		ad.Assets = make(map[basics.AssetIndex]basics.AssetHolding, data.TotalAssets)
+		hasResources = true
+	}
+
+	if !hasResources {
+		return
+	}
+
+	prefix := accountKey(resourcesPrefix, addr)
+	low, high := prefixRange(prefix)
+	iter := r.kvr.NewIter(low, high, false)
+	defer iter.Close()
+
+	for iter.Next() {
+		aidx := decodeUint64(iter.Key()[len(prefix):])
+		var value []byte
+		value, err = iter.Value()
+		if err != nil {
+			return
+		}
+		var resData trackerdb.ResourcesData
+		err = protocol.Decode(value, &resData)
+		if err != nil {
+			return
+		}
+		if resData.ResourceFlags == trackerdb.ResourceFlagsNotHolding {
+			err = fmt.Errorf("addr %s aidx = %d resourceFlagsNotHolding should not be persisted", addr.String(), aidx)
+			return
+		}
+		if resData.IsApp() {
+			if resData.IsOwning() {
+				ad.AppParams[basics.AppIndex(aidx)] = resData.GetAppParams()
+			}
+			if resData.IsHolding() {
+				ad.AppLocalStates[basics.AppIndex(aidx)] = resData.GetAppLocalState()
+			}
+		} else if resData.IsAsset() {
+			if resData.IsOwning() {
+				ad.AssetParams[basics.AssetIndex(aidx)] = resData.GetAssetParams()
+			}
+			if resData.IsHolding() {
+				ad.Assets[basics.AssetIndex(aidx)] = resData.GetAssetHolding()
+			}
+		} else {
+			err = fmt.Errorf("unknown resource data: %v", resData)
+			return
+		}
+	}
+	if err = iter.Err(); err != nil {
+		return
+	}
+
+	if uint64(len(ad.AssetParams)) != data.TotalAssetParams {
+		err = fmt.Errorf("%s assets params mismatch: %d != %d", addr.String(), len(ad.AssetParams), data.TotalAssetParams)
+	}
+	if err == nil && uint64(len(ad.Assets)) != data.TotalAssets {
+		err = fmt.Errorf("%s assets mismatch: %d != %d", addr.String(), len(ad.Assets), data.TotalAssets)
+	}
+	if err == nil && uint64(len(ad.AppParams)) != data.TotalAppParams {
+		err = fmt.Errorf("%s app params mismatch: %d != %d", addr.String(), len(ad.AppParams), data.TotalAppParams)
+	}
+	if err == nil && uint64(len(ad.AppLocalStates)) != data.TotalAppLocalStates {
+		err = fmt.Errorf("%s app local states mismatch: %d != %d", addr.String(), len(ad.AppLocalStates), data.TotalAppLocalStates)
+	}
+
+	return ad, err
+}
+
+// AccountsOnlineRoundParams returns the online round params, along with the round of the last entry.
+func (r *accountsReader) AccountsOnlineRoundParams() (onlineRoundParamsData []ledgercore.OnlineRoundParamsData, endRound basics.Round, err error) {
+	low, high := prefixRange([]byte(prefixOnlineRoundParams))
+	iter := r.kvr.NewIter(low, high, false)
+	defer iter.Close()
+
+	for iter.Next() {
+		endRound = basics.Round(decodeUint64(iter.Key()[len(prefixOnlineRoundParams):]))
+		var value []byte
+		value, err = iter.Value()
+		if err != nil {
+			return nil, 0, err
+		}
+
+		var data ledgercore.OnlineRoundParamsData
+		err = protocol.Decode(value, &data)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		onlineRoundParamsData = append(onlineRoundParamsData, data)
+	}
+	err = iter.Err()
+	return
+}
+
+// AccountsAllTest iterates the accounts and returns a map of the data
+// It is meant only for testing purposes - it is heavy and has no production use case.
+// implements Testing interface
+func (r *accountsReader) AccountsAllTest() (bals map[basics.Address]basics.AccountData, err error) {
+	bals = make(map[basics.Address]basics.AccountData)
+	_, err = r.LoadAllFullAccounts(context.Background(), "accountbase", "resources", func(addr basics.Address, ad basics.AccountData) {
+		bals[addr] = ad
+	})
+	return
+}
+
+// implements Testing interface
+func (r *accountsReader) CheckCreatablesTest(t *testing.T,
+	iteration int,
+	expectedDbImage map[basics.CreatableIndex]ledgercore.ModifiedCreatable) {
+	low, high := prefixRange([]byte(prefixCreatable))
+	iter := r.kvr.NewIter(low, high, false)
+	defer iter.Close()
+
+	counter := 0
+	for iter.Next() {
+		counter++
+		key := iter.Key()
+		mc := ledgercore.ModifiedCreatable{Ctype: basics.CreatableType(key[len(prefixCreatable)])}
+		asset := basics.CreatableIndex(decodeUint64(key[len(prefixCreatable)+1:]))
+		value, err := iter.Value()
+		require.NoError(t, err)
+		copy(mc.Creator[:], value)
+
+		require.NotNil(t, expectedDbImage[asset])
+		require.Equal(t, expectedDbImage[asset].Creator, mc.Creator)
+		require.Equal(t, expectedDbImage[asset].Ctype, mc.Ctype)
+		require.True(t, expectedDbImage[asset].Created)
+	}
+	require.NoError(t, iter.Err())
+	require.Equal(t, len(expectedDbImage), counter)
+}
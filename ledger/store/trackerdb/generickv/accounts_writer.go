@@ -0,0 +1,288 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package generickv
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/ledger/store/trackerdb"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+type accountsWriter struct {
+	kvw KvReadWrite
+}
+
+// MakeAccountsWriter returns a trackerdb.AccountsWriter and trackerdb.AccountsWriterExt writing to kvw.
+func MakeAccountsWriter(kvw KvReadWrite) *accountsWriter {
+	return &accountsWriter{kvw: kvw}
+}
+
+type accountsReaderWriter struct {
+	*accountsReader
+	*accountsWriter
+}
+
+// MakeAccountsReaderWriter returns a trackerdb.AccountsReaderWriter on top of kvrw.
+func MakeAccountsReaderWriter(kvrw KvReadWrite) trackerdb.AccountsReaderWriter {
+	return accountsReaderWriter{MakeAccountsReader(kvrw), MakeAccountsWriter(kvrw)}
+}
+
+// Close is a no-op, the writer does not hold any resources.
+func (w *accountsWriter) Close() {}
+
+// exists reports whether key is present.
+func exists(kvr KvRead, key []byte) (bool, error) {
+	_, err := kvr.Get(key)
+	if err == ErrNotFound {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+// deleteExisting deletes key and reports the number of deleted records, mimicking the rows affected by a sql statement.
+func deleteExisting(kvw KvReadWrite, key []byte) (rowsAffected int64, err error) {
+	found, err := exists(kvw, key)
+	if err != nil || !found {
+		return 0, err
+	}
+	return 1, kvw.Delete(key)
+}
+
+// updateExisting sets the value of key and reports the number of updated records, mimicking the rows affected by a sql statement.
+func updateExisting(kvw KvReadWrite, key []byte, value []byte) (rowsAffected int64, err error) {
+	found, err := exists(kvw, key)
+	if err != nil || !found {
+		return 0, err
+	}
+	return 1, kvw.Set(key, value)
+}
+
+// InsertAccount inserts a new account
+func (w *accountsWriter) InsertAccount(addr basics.Address, normBalance uint64, data trackerdb.BaseAccountData) (ref trackerdb.AccountRef, err error) {
+	err = w.kvw.Set(accountKey(prefixAccount, addr), makeAccountValue(normBalance, protocol.Encode(&data)))
+	if err != nil {
+		return
+	}
+	return accountRef{addr}, nil
+}
+
+// DeleteAccount deletes the referenced account
+func (w *accountsWriter) DeleteAccount(ref trackerdb.AccountRef) (rowsAffected int64, err error) {
+	if ref == nil {
+		return 0, nil
+	}
+	return deleteExisting(w.kvw, accountKey(prefixAccount, ref.(accountRef).addr))
+}
+
+// UpdateAccount updates the referenced account
+func (w *accountsWriter) UpdateAccount(ref trackerdb.AccountRef, normBalance uint64, data trackerdb.BaseAccountData) (rowsAffected int64, err error) {
+	if ref == nil {
+		return 0, fmt.Errorf("no account could be found for ref = nil: %w", ErrNotFound)
+	}
+	return updateExisting(w.kvw, accountKey(prefixAccount, ref.(accountRef).addr), makeAccountValue(normBalance, protocol.Encode(&data)))
+}
+
+// InsertResource inserts a resource of the referenced account.
+// Resources are keyed by the account address and the creatable index, so the returned ref is the one of the owning account.
+func (w *accountsWriter) InsertResource(acctRef trackerdb.AccountRef, aidx basics.CreatableIndex, data trackerdb.ResourcesData) (ref trackerdb.ResourceRef, err error) {
+	if acctRef == nil {
+		return nil, fmt.Errorf("no account could be found for ref = nil: %w", ErrNotFound)
+	}
+	addr := acctRef.(accountRef).addr
+	err = w.kvw.Set(resourceKey(prefixResource, addr, aidx), protocol.Encode(&data))
+	if err != nil {
+		return
+	}
+	return accountRef{addr}, nil
+}
+
+// DeleteResource deletes a resource of the referenced account
+func (w *accountsWriter) DeleteResource(acctRef trackerdb.AccountRef, aidx basics.CreatableIndex) (rowsAffected int64, err error) {
+	if acctRef == nil {
+		return 0, fmt.Errorf("no account could be found for ref = nil: %w", ErrNotFound)
+	}
+	return deleteExisting(w.kvw, resourceKey(prefixResource, acctRef.(accountRef).addr, aidx))
+}
+
+// UpdateResource updates a resource of the referenced account
+func (w *accountsWriter) UpdateResource(acctRef trackerdb.AccountRef, aidx basics.CreatableIndex, data trackerdb.ResourcesData) (rowsAffected int64, err error) {
+	if acctRef == nil {
+		return 0, fmt.Errorf("no account could be found for ref = nil: %w", ErrNotFound)
+	}
+	return updateExisting(w.kvw, resourceKey(prefixResource, acctRef.(accountRef).addr, aidx), protocol.Encode(&data))
+}
+
+// UpsertKvPair updates or inserts an application key/value
+func (w *accountsWriter) UpsertKvPair(key string, value []byte) error {
+	// a nil value has to be stored as well, while the value of an existing empty box is an empty slice.
+	if value == nil {
+		value = []byte{}
+	}
+	return w.kvw.Set(kvKey(prefixKv, key), value)
+}
+
+// DeleteKvPair deletes an application key/value
+func (w *accountsWriter) DeleteKvPair(key string) error {
+	return w.kvw.Delete(kvKey(prefixKv, key))
+}
+
+// InsertCreatable inserts a creatable
+func (w *accountsWriter) InsertCreatable(cidx basics.CreatableIndex, ctype basics.CreatableType, creator []byte) (ref trackerdb.CreatableRef, err error) {
+	err = w.kvw.Set(creatableKey(prefixCreatable, ctype, cidx), creator)
+	if err != nil {
+		return
+	}
+	return creatableRef{ctype, cidx}, nil
+}
+
+// DeleteCreatable deletes a creatable
+func (w *accountsWriter) DeleteCreatable(cidx basics.CreatableIndex, ctype basics.CreatableType) (rowsAffected int64, err error) {
+	return deleteExisting(w.kvw, creatableKey(prefixCreatable, ctype, cidx))
+}
+
+// AccountsReset removes all the tracker data, including the schema version.
+func (w *accountsWriter) AccountsReset(ctx context.Context) error {
+	return w.kvw.DeleteRange([]byte(prefixLiveTracker), []byte(prefixLiveTrackerEnd))
+}
+
+// ResetAccountHashes resets the account hashes generated by the merkle commiter.
+func (w *accountsWriter) ResetAccountHashes(ctx context.Context) (err error) {
+	low, high := prefixRange([]byte(prefixAccountHashes))
+	return w.kvw.DeleteRange(low, high)
+}
+
+// TxtailNewRound stores the tail of the given rounds and forgets the rounds preceding forgetBeforeRound.
+func (w *accountsWriter) TxtailNewRound(ctx context.Context, baseRound basics.Round, roundData [][]byte, forgetBeforeRound basics.Round) error {
+	for i, data := range roundData {
+		err := w.kvw.Set(roundKey(prefixTxTail, uint64(baseRound)+uint64(i)), data)
+		if err != nil {
+			return err
+		}
+	}
+	return w.kvw.DeleteRange([]byte(prefixTxTail), roundKey(prefixTxTail, uint64(forgetBeforeRound)))
+}
+
+// UpdateAccountsRound updates the round number associated with the current account data.
+func (w *accountsWriter) UpdateAccountsRound(rnd basics.Round) (err error) {
+	base, err := readUint64(w.kvw, keyAccountsRound)
+	if err != nil {
+		return err
+	}
+	if basics.Round(base) > rnd {
+		return fmt.Errorf("newRound %d is not after base %d", rnd, base)
+	}
+	if basics.Round(base) == rnd {
+		return nil
+	}
+	return w.kvw.Set([]byte(keyAccountsRound), appendUint64(nil, uint64(rnd)))
+}
+
+// UpdateAccountsHashRound updates the round number associated with the hash of current account data.
+func (w *accountsWriter) UpdateAccountsHashRound(ctx context.Context, hashRound basics.Round) (err error) {
+	return w.kvw.Set([]byte(keyHashRound), appendUint64(nil, uint64(hashRound)))
+}
+
+// AccountsPutTotals updates account totals
+func (w *accountsWriter) AccountsPutTotals(totals ledgercore.AccountTotals, catchpointStaging bool) error {
+	key := keyTotals
+	if catchpointStaging {
+		key = keyStagingTotals
+	}
+	return w.kvw.Set([]byte(key), protocol.Encode(&totals))
+}
+
+// OnlineAccountsDelete cleans up the Online Accounts table to prune expired entires.
+// it will delete entries with an updRound <= expRound
+// EXCEPT, it will not delete the *latest* entry for an account, no matter how old.
+// this is so that accounts whos last update is before expRound still maintain an Online Account Balance
+// After this cleanup runs, accounts in this table will have either one entry (if all entries besides the latest are expired),
+// or will have more than one entry (if multiple entries are not yet expired).
+func (w *accountsWriter) OnlineAccountsDelete(forgetBefore basics.Round) (err error) {
+	type expiredEntry struct {
+		addr     basics.Address
+		updRound uint64
+		value    onlineAccountValue
+	}
+	// collect the entries preceding forgetBefore of the current account; the entries are visited by increasing round.
+	var expired, pending []expiredEntry
+	flush := func() error {
+		if len(pending) == 0 {
+			return nil
+		}
+		// the latest entry is kept unless it holds no voting data.
+		latest := pending[len(pending)-1]
+		var oad trackerdb.BaseOnlineAccountData
+		err := protocol.Decode(latest.value.encodedData, &oad)
+		if err != nil {
+			return err
+		}
+		if !oad.IsVotingEmpty() {
+			pending = pending[:len(pending)-1]
+		}
+		expired = append(expired, pending...)
+		pending = pending[:0]
+		return nil
+	}
+
+	r := accountsReader{kvr: w.kvw}
+	err = r.forEachOnlineAccount(func(addr basics.Address, entry onlineAccountEntry) (bool, error) {
+		if len(pending) > 0 && pending[0].addr != addr {
+			if err := flush(); err != nil {
+				return false, err
+			}
+		}
+		if entry.updRound < uint64(forgetBefore) {
+			entry.value.encodedData = append([]byte{}, entry.value.encodedData...)
+			pending = append(pending, expiredEntry{addr, entry.updRound, entry.value})
+		}
+		return true, nil
+	})
+	if err != nil {
+		return err
+	}
+	if err = flush(); err != nil {
+		return err
+	}
+
+	for _, e := range expired {
+		err = deleteOnlineAccount(w.kvw, e.addr, e.updRound, e.value.normBalance)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AccountsPutOnlineRoundParams stores the online round params, starting at startRound.
+func (w *accountsWriter) AccountsPutOnlineRoundParams(onlineRoundParamsData []ledgercore.OnlineRoundParamsData, startRound basics.Round) error {
+	for i, data := range onlineRoundParamsData {
+		err := w.kvw.Set(roundKey(prefixOnlineRoundParams, uint64(startRound)+uint64(i)), protocol.Encode(&data))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AccountsPruneOnlineRoundParams deletes the online round params preceding deleteBeforeRound.
+func (w *accountsWriter) AccountsPruneOnlineRoundParams(deleteBeforeRound basics.Round) error {
+	return w.kvw.DeleteRange([]byte(prefixOnlineRoundParams), roundKey(prefixOnlineRoundParams, uint64(deleteBeforeRound)))
+}
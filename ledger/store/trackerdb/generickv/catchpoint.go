@@ -0,0 +1,436 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package generickv
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/ledger/store/trackerdb"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+type catchpointReader struct {
+	kvr KvRead
+}
+
+type catchpointWriter struct {
+	kvw KvReadWrite
+}
+
+type catchpointReaderWriter struct {
+	catchpointReader
+	catchpointWriter
+}
+
+// MakeCatchpointReader returns a trackerdb.CatchpointReader reading from kvr.
+func MakeCatchpointReader(kvr KvRead) trackerdb.CatchpointReader {
+	return &catchpointReader{kvr: kvr}
+}
+
+// MakeCatchpointWriter returns a trackerdb.CatchpointWriter writing to kvw.
+func MakeCatchpointWriter(kvw KvReadWrite) trackerdb.CatchpointWriter {
+	return &catchpointReaderWriter{catchpointReader{kvr: kvw}, catchpointWriter{kvw: kvw}}
+}
+
+// MakeCatchpointReaderWriter returns a trackerdb.CatchpointReaderWriter on top of kvrw.
+func MakeCatchpointReaderWriter(kvrw KvReadWrite) trackerdb.CatchpointReaderWriter {
+	return &catchpointReaderWriter{catchpointReader{kvr: kvrw}, catchpointWriter{kvw: kvrw}}
+}
+
+// The stored catchpoints values are made of the file size, the length of the file name,
+// the file name and the catchpoint label.
+func makeStoredCatchpointValue(fileName string, catchpoint string, fileSize int64) []byte {
+	value := make([]byte, 0, 16+len(fileName)+len(catchpoint))
+	value = appendUint64(value, uint64(fileSize))
+	value = appendUint64(value, uint64(len(fileName)))
+	value = append(value, fileName...)
+	return append(value, catchpoint...)
+}
+
+func splitStoredCatchpointValue(value []byte) (fileName string, catchpoint string, fileSize int64, err error) {
+	if len(value) < 16 || uint64(len(value)-16) < decodeUint64(value[8:]) {
+		return "", "", 0, fmt.Errorf("invalid stored catchpoint record of %d bytes", len(value))
+	}
+	fileSize = int64(decodeUint64(value))
+	nameEnd := 16 + int(decodeUint64(value[8:]))
+	return string(value[16:nameEnd]), string(value[nameEnd:]), fileSize, nil
+}
+
+// The catchpoint state values are tagged with their type.
+const (
+	catchpointStateUint64 byte = 'i'
+	catchpointStateString byte = 's'
+)
+
+func catchpointStateKey(stateName trackerdb.CatchpointState) []byte {
+	return append([]byte(prefixCatchpointState), stateName...)
+}
+
+func (cr *catchpointReader) GetCatchpoint(ctx context.Context, round basics.Round) (fileName string, catchpoint string, fileSize int64, err error) {
+	value, err := cr.kvr.Get(roundKey(prefixStoredCatchpoint, uint64(round)))
+	if err != nil {
+		return
+	}
+	return splitStoredCatchpointValue(value)
+}
+
+func (cr *catchpointReader) GetOldestCatchpointFiles(ctx context.Context, fileCount int, filesToKeep int) (fileNames map[basics.Round]string, err error) {
+	low, high := prefixRange([]byte(prefixStoredCatchpoint))
+	iter := cr.kvr.NewIter(low, high, false)
+	defer iter.Close()
+
+	// the stored catchpoints are few, so they are all loaded to find out which are the most recent ones to keep.
+	type storedFile struct {
+		round    basics.Round
+		fileName string
+	}
+	var files []storedFile
+	for iter.Next() {
+		var value []byte
+		value, err = iter.Value()
+		if err != nil {
+			return nil, err
+		}
+		var fileName string
+		fileName, _, _, err = splitStoredCatchpointValue(value)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, storedFile{basics.Round(decodeUint64(iter.Key()[len(prefixStoredCatchpoint):])), fileName})
+	}
+	if err = iter.Err(); err != nil {
+		return nil, err
+	}
+
+	fileNames = make(map[basics.Round]string)
+	if len(files) <= filesToKeep {
+		return fileNames, nil
+	}
+	files = files[:len(files)-filesToKeep]
+	if len(files) > fileCount {
+		files = files[:fileCount]
+	}
+	for _, f := range files {
+		fileNames[f.round] = f.fileName
+	}
+	return fileNames, nil
+}
+
+func (cr *catchpointReader) ReadCatchpointStateUint64(ctx context.Context, stateName trackerdb.CatchpointState) (val uint64, err error) {
+	value, err := cr.kvr.Get(catchpointStateKey(stateName))
+	if err == ErrNotFound {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	if len(value) != 9 || value[0] != catchpointStateUint64 {
+		// the state holds a string value.
+		return 0, nil
+	}
+	return decodeUint64(value[1:]), nil
+}
+
+func (cr *catchpointReader) ReadCatchpointStateString(ctx context.Context, stateName trackerdb.CatchpointState) (val string, err error) {
+	value, err := cr.kvr.Get(catchpointStateKey(stateName))
+	if err == ErrNotFound {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	if len(value) == 0 || value[0] != catchpointStateString {
+		// the state holds an integer value.
+		return "", nil
+	}
+	return string(value[1:]), nil
+}
+
+func (cr *catchpointReader) SelectUnfinishedCatchpoints(ctx context.Context) ([]trackerdb.UnfinishedCatchpointRecord, error) {
+	low, high := prefixRange([]byte(prefixUnfinishedCatchpoint))
+	iter := cr.kvr.NewIter(low, high, false)
+	defer iter.Close()
+
+	var res []trackerdb.UnfinishedCatchpointRecord
+	for iter.Next() {
+		value, err := iter.Value()
+		if err != nil {
+			return nil, err
+		}
+		record := trackerdb.UnfinishedCatchpointRecord{
+			Round: basics.Round(decodeUint64(iter.Key()[len(prefixUnfinishedCatchpoint):])),
+		}
+		copy(record.BlockHash[:], value)
+		res = append(res, record)
+	}
+	if err := iter.Err(); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (cr *catchpointReader) SelectCatchpointFirstStageInfo(ctx context.Context, round basics.Round) (trackerdb.CatchpointFirstStageInfo, bool /*exists*/, error) {
+	data, err := cr.kvr.Get(roundKey(prefixCatchpointFirstStageInfo, uint64(round)))
+	if err == ErrNotFound {
+		return trackerdb.CatchpointFirstStageInfo{}, false, nil
+	}
+	if err != nil {
+		return trackerdb.CatchpointFirstStageInfo{}, false, err
+	}
+
+	var res trackerdb.CatchpointFirstStageInfo
+	err = protocol.Decode(data, &res)
+	if err != nil {
+		return trackerdb.CatchpointFirstStageInfo{}, false, err
+	}
+
+	return res, true, nil
+}
+
+func (cr *catchpointReader) SelectOldCatchpointFirstStageInfoRounds(ctx context.Context, maxRound basics.Round) ([]basics.Round, error) {
+	low := []byte(prefixCatchpointFirstStageInfo)
+	high := keyInclusiveEnd(roundKey(prefixCatchpointFirstStageInfo, uint64(maxRound)))
+	iter := cr.kvr.NewIter(low, high, false)
+	defer iter.Close()
+
+	var res []basics.Round
+	for iter.Next() {
+		res = append(res, basics.Round(decodeUint64(iter.Key()[len(prefixCatchpointFirstStageInfo):])))
+	}
+	if err := iter.Err(); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (cw *catchpointWriter) StoreCatchpoint(ctx context.Context, round basics.Round, fileName string, catchpoint string, fileSize int64) (err error) {
+	key := roundKey(prefixStoredCatchpoint, uint64(round))
+	if fileName == "" && catchpoint == "" && fileSize == 0 {
+		return cw.kvw.Delete(key)
+	}
+	return cw.kvw.Set(key, makeStoredCatchpointValue(fileName, catchpoint, fileSize))
+}
+
+func (cw *catchpointWriter) WriteCatchpointStateUint64(ctx context.Context, stateName trackerdb.CatchpointState, setValue uint64) (err error) {
+	if setValue == 0 {
+		return cw.kvw.Delete(catchpointStateKey(stateName))
+	}
+	return cw.kvw.Set(catchpointStateKey(stateName), appendUint64([]byte{catchpointStateUint64}, setValue))
+}
+
+func (cw *catchpointWriter) WriteCatchpointStateString(ctx context.Context, stateName trackerdb.CatchpointState, setValue string) (err error) {
+	if setValue == "" {
+		return cw.kvw.Delete(catchpointStateKey(stateName))
+	}
+	return cw.kvw.Set(catchpointStateKey(stateName), append([]byte{catchpointStateString}, setValue...))
+}
+
+func (cw *catchpointWriter) InsertUnfinishedCatchpoint(ctx context.Context, round basics.Round, blockHash crypto.Digest) error {
+	return cw.kvw.Set(roundKey(prefixUnfinishedCatchpoint, uint64(round)), blockHash[:])
+}
+
+func (cw *catchpointWriter) DeleteUnfinishedCatchpoint(ctx context.Context, round basics.Round) error {
+	return cw.kvw.Delete(roundKey(prefixUnfinishedCatchpoint, uint64(round)))
+}
+
+func (cw *catchpointWriter) InsertOrReplaceCatchpointFirstStageInfo(ctx context.Context, round basics.Round, info *trackerdb.CatchpointFirstStageInfo) error {
+	return cw.kvw.Set(roundKey(prefixCatchpointFirstStageInfo, uint64(round)), protocol.Encode(info))
+}
+
+func (cw *catchpointWriter) DeleteOldCatchpointFirstStageInfo(ctx context.Context, maxRoundToDelete basics.Round) error {
+	return cw.kvw.DeleteRange([]byte(prefixCatchpointFirstStageInfo), keyInclusiveEnd(roundKey(prefixCatchpointFirstStageInfo, uint64(maxRoundToDelete))))
+}
+
+// WriteCatchpointStagingBalances inserts all the account balances in the provided array into the catchpoint balances staging area.
+func (cw *catchpointWriter) WriteCatchpointStagingBalances(ctx context.Context, bals []trackerdb.NormalizedAccountBalance) error {
+	for _, balance := range bals {
+		key := accountKey(prefixStagingAccount, balance.Address)
+		found, err := exists(cw.kvw, key)
+		if err != nil {
+			return err
+		}
+		// an existing address is an overflowed account record, of which only the resources are new.
+		if !found {
+			err = cw.kvw.Set(key, makeAccountValue(balance.NormalizedBalance, balance.EncodedAccountData))
+			if err != nil {
+				return err
+			}
+		}
+
+		// write resources
+		for aidx := range balance.Resources {
+			err = cw.kvw.Set(resourceKey(prefixStagingResource, balance.Address, aidx), balance.EncodedResources[aidx])
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// WriteCatchpointStagingHashes inserts all the account hashes in the provided array into the catchpoint pending hashes.
+func (cw *catchpointWriter) WriteCatchpointStagingHashes(ctx context.Context, bals []trackerdb.NormalizedAccountBalance) error {
+	for _, balance := range bals {
+		for _, hash := range balance.AccountHashes {
+			err := cw.kvw.Set(append([]byte(prefixStagingPendingHashes), hash...), nil)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// WriteCatchpointStagingCreatable inserts all the creatables in the provided array into the catchpoint creatables staging area.
+func (cw *catchpointWriter) WriteCatchpointStagingCreatable(ctx context.Context, bals []trackerdb.NormalizedAccountBalance) error {
+	for _, balance := range bals {
+		for aidx, resData := range balance.Resources {
+			if !resData.IsOwning() {
+				continue
+			}
+			// determine if it's an asset
+			if resData.IsAsset() {
+				err := cw.kvw.Set(creatableKey(prefixStagingCreatable, basics.AssetCreatable, aidx), balance.Address[:])
+				if err != nil {
+					return err
+				}
+			}
+			// determine if it's an application
+			if resData.IsApp() {
+				err := cw.kvw.Set(creatableKey(prefixStagingCreatable, basics.AppCreatable, aidx), balance.Address[:])
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// WriteCatchpointStagingKVs inserts all the KVs in the provided array into the
+// catchpoint kvstore staging area, and their hashes to the pending hashes.
+func (cw *catchpointWriter) WriteCatchpointStagingKVs(ctx context.Context, keys [][]byte, values [][]byte, hashes [][]byte) error {
+	for i := 0; i < len(keys); i++ {
+		value := values[i]
+		if value == nil {
+			value = []byte{}
+		}
+		err := cw.kvw.Set(append([]byte(prefixStagingKv), keys[i]...), value)
+		if err != nil {
+			return err
+		}
+
+		err = cw.kvw.Set(append([]byte(prefixStagingPendingHashes), hashes[i]...), nil)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ResetCatchpointStagingBalances drops all the catchpoint staging data.
+// There is nothing to create for a new catchup, the staging area being made of plain key ranges.
+func (cw *catchpointWriter) ResetCatchpointStagingBalances(ctx context.Context, newCatchup bool) (err error) {
+	err = cw.kvw.DeleteRange([]byte(prefixStaging), []byte(prefixStagingEnd))
+	if err != nil {
+		return err
+	}
+	return cw.kvw.Delete([]byte(keyStagingTotals))
+}
+
+// ApplyCatchpointStagingBalances switches the staged catchpoint catchup data onto the live
+// data and update the correct balance round. This is the final step in switching onto the new catchpoint round.
+func (cw *catchpointWriter) ApplyCatchpointStagingBalances(ctx context.Context, balancesRound basics.Round, merkleRootRound basics.Round) (err error) {
+	for _, p := range catchpointStagingPrefixes {
+		err = cw.moveRange(p.staging, p.live)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = cw.kvw.Set([]byte(keyAccountsRound), appendUint64(nil, uint64(balancesRound)))
+	if err != nil {
+		return err
+	}
+	return cw.kvw.Set([]byte(keyHashRound), appendUint64(nil, uint64(merkleRootRound)))
+}
+
+// moveRange replaces the keys starting with the live prefix with the keys starting with the staging prefix.
+func (cw *catchpointWriter) moveRange(stagingPrefix string, livePrefix string) error {
+	low, high := prefixRange([]byte(livePrefix))
+	err := cw.kvw.DeleteRange(low, high)
+	if err != nil {
+		return err
+	}
+
+	low, high = prefixRange([]byte(stagingPrefix))
+	iter := cw.kvw.NewIter(low, high, false)
+	defer iter.Close()
+	for iter.Next() {
+		value, err := iter.Value()
+		if err != nil {
+			return err
+		}
+		key := append([]byte(livePrefix), iter.Key()[len(stagingPrefix):]...)
+		err = cw.kvw.Set(key, value)
+		if err != nil {
+			return err
+		}
+	}
+	if err = iter.Err(); err != nil {
+		return err
+	}
+	return cw.kvw.DeleteRange(low, high)
+}
+
+// CreateCatchpointStagingHashesIndex is a no-op: the pending hashes are keyed by the hash and are always sorted.
+func (cw *catchpointWriter) CreateCatchpointStagingHashesIndex(ctx context.Context) (err error) {
+	return nil
+}
+
+// DeleteStoredCatchpoints iterates over the stored catchpoints and deletes all the files stored on disk.
+// once all the files have been deleted, it would go ahead and remove the entries from the database.
+func (crw *catchpointReaderWriter) DeleteStoredCatchpoints(ctx context.Context, dbDirectory string) (err error) {
+	catchpointsFilesChunkSize := 50
+	for {
+		fileNames, err := crw.GetOldestCatchpointFiles(ctx, catchpointsFilesChunkSize, 0)
+		if err != nil {
+			return err
+		}
+		if len(fileNames) == 0 {
+			break
+		}
+
+		for round, fileName := range fileNames {
+			err = trackerdb.RemoveSingleCatchpointFileFromDisk(dbDirectory, fileName)
+			if err != nil {
+				return err
+			}
+			// clear the entry from the database
+			err = crw.StoreCatchpoint(ctx, round, "", "", 0)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
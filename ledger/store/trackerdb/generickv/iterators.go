@@ -0,0 +1,612 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package generickv
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/ledger/encoded"
+	"github.com/algorand/go-algorand/ledger/store/trackerdb"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/msgp/msgp"
+)
+
+// orderedAccountsIter allows us to iterate over the accounts addresses in the order of the account hashes.
+type orderedAccountsIter struct {
+	step               orderedAccountsIterStep
+	accountBaseRows    KvIter
+	hashesRows         KvIter
+	resourcesRows      KvIter
+	kvw                KvReadWrite
+	pendingBaseRow     pendingBaseRow
+	pendingResourceRow pendingResourceRow
+	accountCount       int
+}
+
+// orderedAccountsIterStep is used by orderedAccountsIter to define the current step
+//
+//msgp:ignore orderedAccountsIterStep
+type orderedAccountsIterStep int
+
+const (
+	// startup step: delete any leftover ordering hashes from a previous invocation
+	oaiStepStartup = orderedAccountsIterStep(0)
+	// query the existing accounts
+	oaiStepQueryAccounts = orderedAccountsIterStep(1)
+	// iterate over the existing accounts and insert their hash & address into the ordering hashes
+	oaiStepInsertAccountData = orderedAccountsIterStep(2)
+	// query the ordering hashes
+	oaiStepSelectFromOrderedTable = orderedAccountsIterStep(3)
+	// iterate over the ordering hashes
+	oaiStepIterateOverOrderedTable = orderedAccountsIterStep(4)
+	// cleanup and delete the ordering hashes
+	oaiStepShutdown = orderedAccountsIterStep(5)
+	// do nothing as we're done.
+	oaiStepDone = orderedAccountsIterStep(6)
+)
+
+type pendingBaseRow struct {
+	valid              bool
+	addr               basics.Address
+	accountData        *trackerdb.BaseAccountData
+	encodedAccountData []byte
+}
+
+type pendingResourceRow struct {
+	valid bool
+	addr  basics.Address
+	aidx  basics.CreatableIndex
+	buf   []byte
+}
+
+// MakeOrderedAccountsIter creates an ordered account iterator. Note that due to implementation reasons,
+// only a single iterator can be active at a time.
+func MakeOrderedAccountsIter(kvw KvReadWrite, accountCount int) *orderedAccountsIter {
+	return &orderedAccountsIter{
+		kvw:          kvw,
+		accountCount: accountCount,
+		step:         oaiStepStartup,
+	}
+}
+
+// Next returns an array containing the account address and hash
+// the Next function works in multiple processing stages, where it first processes the current accounts and order them
+// followed by returning the ordered accounts. In the first phase, it would return empty accountAddressHash array
+// and sets the processedRecords to the number of accounts that were processed. On the second phase, the acct
+// would contain valid data ( and optionally the account data as well, if was asked in makeOrderedAccountsIter) and
+// the processedRecords would be zero. If err is ErrNotFound it means that the iterator have completed it's work and no further
+// accounts exists. Otherwise, the caller is expected to keep calling "Next" to retrieve the next set of accounts
+// ( or let the Next function make some progress toward that goal )
+func (iterator *orderedAccountsIter) Next(ctx context.Context) (acct []trackerdb.AccountAddressHash, processedRecords int, err error) {
+	if iterator.step == oaiStepStartup {
+		// although we're going to delete the ordering hashes anyway when completing the iterator execution, we'll try to
+		// clean up any intermediate leftovers.
+		err = iterator.kvw.DeleteRange([]byte(prefixOrderingHashes), []byte(prefixOrderingHashesEnd))
+		if err != nil {
+			return
+		}
+		iterator.step = oaiStepQueryAccounts
+		return
+	}
+	if iterator.step == oaiStepQueryAccounts {
+		// iterate over the existing accounts and resources
+		iterator.accountBaseRows = newPrefixIter(iterator.kvw, prefixAccount)
+		iterator.resourcesRows = newPrefixIter(iterator.kvw, prefixResource)
+		iterator.step = oaiStepInsertAccountData
+		return
+	}
+	if iterator.step == oaiStepInsertAccountData {
+		insertHash := func(hash []byte, addr basics.Address) error {
+			key := make([]byte, 0, len(prefixOrderingHashes)+len(hash)+len(addr))
+			key = append(key, prefixOrderingHashes...)
+			key = append(key, hash...)
+			key = append(key, addr[:]...)
+			return iterator.kvw.Set(key, nil)
+		}
+		baseCb := func(addr basics.Address, accountData *trackerdb.BaseAccountData, encodedAccountData []byte) (err error) {
+			return insertHash(trackerdb.AccountHashBuilderV6(addr, accountData, encodedAccountData), addr)
+		}
+
+		resCb := func(addr basics.Address, cidx basics.CreatableIndex, resData *trackerdb.ResourcesData, encodedResourceData []byte, lastResource bool) error {
+			if resData != nil {
+				hash, err2 := trackerdb.ResourcesHashBuilderV6(resData, addr, cidx, resData.UpdateRound, encodedResourceData)
+				if err2 != nil {
+					return err2
+				}
+				return insertHash(hash, addr)
+			}
+			return nil
+		}
+
+		count := 0
+		count, iterator.pendingBaseRow, iterator.pendingResourceRow, err = processAllBaseAccountRecords(
+			iterator.accountBaseRows, iterator.resourcesRows,
+			baseCb, resCb,
+			iterator.pendingBaseRow, iterator.pendingResourceRow, iterator.accountCount, math.MaxInt,
+		)
+		if err != nil {
+			iterator.Close(ctx)
+			return
+		}
+
+		if count == iterator.accountCount {
+			// we're done with this iteration.
+			processedRecords = count
+			return
+		}
+
+		// make sure the resource iterator has no more entries.
+		if iterator.resourcesRows.Next() {
+			iterator.Close(ctx)
+			err = errors.New("resource table entries exceed the ones specified in the accountbase table")
+			return
+		}
+
+		processedRecords = count
+		iterator.accountBaseRows.Close()
+		iterator.accountBaseRows = nil
+		iterator.resourcesRows.Close()
+		iterator.resourcesRows = nil
+		iterator.step = oaiStepSelectFromOrderedTable
+		return
+	}
+	if iterator.step == oaiStepSelectFromOrderedTable {
+		// the ordering hashes keys are sorted by hash
+		iterator.hashesRows = newPrefixIter(iterator.kvw, prefixOrderingHashes)
+		iterator.step = oaiStepIterateOverOrderedTable
+		return
+	}
+
+	if iterator.step == oaiStepIterateOverOrderedTable {
+		acct = make([]trackerdb.AccountAddressHash, iterator.accountCount)
+		acctIdx := 0
+		for iterator.hashesRows.Next() {
+			key := iterator.hashesRows.Key()
+			hashLen := len(key) - len(prefixOrderingHashes) - len(basics.Address{})
+			if hashLen <= 0 {
+				iterator.Close(ctx)
+				err = fmt.Errorf("invalid ordering hash key of %d bytes", len(key))
+				return
+			}
+			acct[acctIdx].Digest = append([]byte{}, key[len(prefixOrderingHashes):len(prefixOrderingHashes)+hashLen]...)
+			acct[acctIdx].AccountRef = accountRef{addressFromKey(key, len(prefixOrderingHashes)+hashLen)}
+			acctIdx++
+			if acctIdx == iterator.accountCount {
+				// we're done with this iteration.
+				return
+			}
+		}
+		if err = iterator.hashesRows.Err(); err != nil {
+			iterator.Close(ctx)
+			return
+		}
+		acct = acct[:acctIdx]
+		iterator.step = oaiStepShutdown
+		iterator.hashesRows.Close()
+		iterator.hashesRows = nil
+		return
+	}
+	if iterator.step == oaiStepShutdown {
+		err = iterator.Close(ctx)
+		if err != nil {
+			return
+		}
+		iterator.step = oaiStepDone
+		// fallthrough
+	}
+	return nil, 0, ErrNotFound
+}
+
+// Close shuts down the orderedAccountsBuilderIter, releasing the iterators and deleting the ordering hashes.
+func (iterator *orderedAccountsIter) Close(ctx context.Context) (err error) {
+	if iterator.accountBaseRows != nil {
+		iterator.accountBaseRows.Close()
+		iterator.accountBaseRows = nil
+	}
+	if iterator.resourcesRows != nil {
+		iterator.resourcesRows.Close()
+		iterator.resourcesRows = nil
+	}
+	if iterator.hashesRows != nil {
+		iterator.hashesRows.Close()
+		iterator.hashesRows = nil
+	}
+	return iterator.kvw.DeleteRange([]byte(prefixOrderingHashes), []byte(prefixOrderingHashesEnd))
+}
+
+func newPrefixIter(kvr KvRead, prefix string) KvIter {
+	low, high := prefixRange([]byte(prefix))
+	return kvr.NewIter(low, high, false)
+}
+
+// processAllBaseAccountRecords merges the accounts and resources iterators, both ordered by address.
+// The records are copied out of the iterators, as the callbacks might write to the store.
+func processAllBaseAccountRecords(
+	baseRows KvIter,
+	resRows KvIter,
+	baseCb func(addr basics.Address, accountData *trackerdb.BaseAccountData, encodedAccountData []byte) error,
+	resCb func(addr basics.Address, creatableIdx basics.CreatableIndex, resData *trackerdb.ResourcesData, encodedResourceData []byte, lastResource bool) error,
+	pendingBase pendingBaseRow, pendingResource pendingResourceRow, accountCount int, resourceCount int,
+) (int, pendingBaseRow, pendingResourceRow, error) {
+	var addr basics.Address
+	var prevAddr basics.Address
+	var err error
+	count := 0
+
+	var accountData trackerdb.BaseAccountData
+	var buf []byte
+	for {
+		if pendingBase.valid {
+			addr = pendingBase.addr
+			accountData = *pendingBase.accountData
+			buf = pendingBase.encodedAccountData
+			pendingBase = pendingBaseRow{}
+		} else {
+			if !baseRows.Next() {
+				if err = baseRows.Err(); err != nil {
+					return 0, pendingBaseRow{}, pendingResourceRow{}, err
+				}
+				break
+			}
+
+			addr = addressFromKey(baseRows.Key(), len(prefixAccount))
+			var value []byte
+			value, err = baseRows.Value()
+			if err != nil {
+				return 0, pendingBaseRow{}, pendingResourceRow{}, err
+			}
+			_, buf, err = splitAccountValue(value)
+			if err != nil {
+				return 0, pendingBaseRow{}, pendingResourceRow{}, err
+			}
+			buf = append([]byte{}, buf...)
+
+			accountData = trackerdb.BaseAccountData{}
+			err = protocol.Decode(buf, &accountData)
+			if err != nil {
+				return 0, pendingBaseRow{}, pendingResourceRow{}, err
+			}
+		}
+
+		err = baseCb(addr, &accountData, buf)
+		if err != nil {
+			return 0, pendingBaseRow{}, pendingResourceRow{}, err
+		}
+
+		var resourcesProcessed int
+		pendingResource, resourcesProcessed, err = processAllResources(resRows, addr, pendingResource, resourceCount, resCb)
+		if err != nil {
+			err = fmt.Errorf("failed to gather resources for account %v, prev address %v : %w", addr, prevAddr, err)
+			return 0, pendingBaseRow{}, pendingResourceRow{}, err
+		}
+
+		if resourcesProcessed == resourceCount {
+			// we're done with this iteration.
+			pendingBase := pendingBaseRow{
+				valid:              true,
+				addr:               addr,
+				accountData:        &accountData,
+				encodedAccountData: buf,
+			}
+			return count, pendingBase, pendingResource, nil
+		}
+		resourceCount -= resourcesProcessed
+
+		count++
+		if accountCount > 0 && count == accountCount {
+			// we're done with this iteration.
+			return count, pendingBaseRow{}, pendingResource, nil
+		}
+		prevAddr = addr
+	}
+
+	return count, pendingBaseRow{}, pendingResource, nil
+}
+
+func processAllResources(
+	resRows KvIter,
+	addr basics.Address, pr pendingResourceRow, resourceCount int,
+	callback func(addr basics.Address, creatableIdx basics.CreatableIndex, resData *trackerdb.ResourcesData, encodedResourceData []byte, lastResource bool) error,
+) (pendingResourceRow, int, error) {
+	var err error
+	count := 0
+
+	// Declare variabled outside of the loop to prevent allocations per iteration.
+	// At least resData is resolved as "escaped" because of passing it by a pointer to protocol.Decode()
+	var buf []byte
+	var aidx basics.CreatableIndex
+	var resData trackerdb.ResourcesData
+	for {
+		if pr.valid {
+			// some accounts may not have resources, consider the following case:
+			// acct 1 and 3 has resources, account 2 does not
+			// in this case the pending resource belongs to 3 after processing resources from 1, while processing 2
+			// and we need to skip accounts without resources
+			cmp := bytes.Compare(pr.addr[:], addr[:])
+			if cmp > 0 {
+				err = callback(addr, 0, nil, nil, false)
+				return pr, count, err
+			}
+			if cmp < 0 {
+				err = fmt.Errorf("resource entries mismatches account entries : reached address %v while expecting resource for %v", pr.addr, addr)
+				return pendingResourceRow{}, count, err
+			}
+			buf = pr.buf
+			aidx = pr.aidx
+			pr = pendingResourceRow{}
+		} else {
+			if !resRows.Next() {
+				if err = resRows.Err(); err != nil {
+					return pendingResourceRow{}, count, err
+				}
+				err = callback(addr, 0, nil, nil, false)
+				if err != nil {
+					return pendingResourceRow{}, count, err
+				}
+				break
+			}
+			key := resRows.Key()
+			resAddr := addressFromKey(key, len(prefixResource))
+			aidx = basics.CreatableIndex(decodeUint64(key[len(prefixResource)+len(resAddr):]))
+			var value []byte
+			value, err = resRows.Value()
+			if err != nil {
+				return pendingResourceRow{}, count, err
+			}
+			buf = append([]byte{}, value...)
+			cmp := bytes.Compare(resAddr[:], addr[:])
+			if cmp < 0 {
+				err = fmt.Errorf("resource entries mismatches account entries : reached address %v while expecting resource for %v", resAddr, addr)
+				return pendingResourceRow{}, count, err
+			} else if cmp > 0 {
+				err = callback(addr, 0, nil, nil, false)
+				return pendingResourceRow{true, resAddr, aidx, buf}, count, err
+			}
+		}
+		resData = trackerdb.ResourcesData{}
+		err = protocol.Decode(buf, &resData)
+		if err != nil {
+			return pendingResourceRow{}, count, err
+		}
+		count++
+		if resourceCount > 0 && count == resourceCount {
+			// last resource to be included in chunk
+			err = callback(addr, aidx, &resData, buf, true)
+			return pendingResourceRow{}, count, err
+		}
+		err = callback(addr, aidx, &resData, buf, false)
+		if err != nil {
+			return pendingResourceRow{}, count, err
+		}
+	}
+	return pendingResourceRow{}, count, nil
+}
+
+// encodedAccountsBatchIter allows us to iterate over the accounts data stored in the store.
+type encodedAccountsBatchIter struct {
+	kvr             KvRead
+	accountsRows    KvIter
+	resourcesRows   KvIter
+	nextBaseRow     pendingBaseRow
+	nextResourceRow pendingResourceRow
+	acctResCnt      catchpointAccountResourceCounter
+}
+
+// catchpointAccountResourceCounter keeps track of the resources processed for the current account
+type catchpointAccountResourceCounter struct {
+	totalAppParams      uint64
+	totalAppLocalStates uint64
+	totalAssetParams    uint64
+	totalAssets         uint64
+}
+
+// MakeEncodedAccoutsBatchIter creates an empty accounts batch iterator.
+func MakeEncodedAccoutsBatchIter(kvr KvRead) *encodedAccountsBatchIter {
+	return &encodedAccountsBatchIter{kvr: kvr}
+}
+
+// Next returns an array containing the account data, in the same way it appear in the database
+// returning accountCount accounts data at a time.
+func (iterator *encodedAccountsBatchIter) Next(ctx context.Context, accountCount int, resourceCount int) (bals []encoded.BalanceRecordV6, numAccountsProcessed uint64, err error) {
+	if iterator.accountsRows == nil {
+		iterator.accountsRows = newPrefixIter(iterator.kvr, prefixAccount)
+	}
+	if iterator.resourcesRows == nil {
+		iterator.resourcesRows = newPrefixIter(iterator.kvr, prefixResource)
+	}
+
+	// gather up to accountCount encoded accounts.
+	bals = make([]encoded.BalanceRecordV6, 0, accountCount)
+	var encodedRecord encoded.BalanceRecordV6
+	var baseAcct trackerdb.BaseAccountData
+	baseCb := func(addr basics.Address, accountData *trackerdb.BaseAccountData, encodedAccountData []byte) (err error) {
+		encodedRecord = encoded.BalanceRecordV6{Address: addr, AccountData: encodedAccountData}
+		baseAcct = *accountData
+		return nil
+	}
+
+	var totalResources int
+
+	resCb := func(addr basics.Address, cidx basics.CreatableIndex, resData *trackerdb.ResourcesData, encodedResourceData []byte, lastResource bool) error {
+
+		emptyBaseAcct := baseAcct.TotalAppParams == 0 && baseAcct.TotalAppLocalStates == 0 && baseAcct.TotalAssetParams == 0 && baseAcct.TotalAssets == 0
+		if !emptyBaseAcct && resData != nil {
+			if encodedRecord.Resources == nil {
+				encodedRecord.Resources = make(map[uint64]msgp.Raw)
+			}
+			encodedRecord.Resources[uint64(cidx)] = encodedResourceData
+			if resData.IsApp() && resData.IsOwning() {
+				iterator.acctResCnt.totalAppParams++
+			}
+			if resData.IsApp() && resData.IsHolding() {
+				iterator.acctResCnt.totalAppLocalStates++
+			}
+
+			if resData.IsAsset() && resData.IsOwning() {
+				iterator.acctResCnt.totalAssetParams++
+			}
+			if resData.IsAsset() && resData.IsHolding() {
+				iterator.acctResCnt.totalAssets++
+			}
+			totalResources++
+		}
+
+		if baseAcct.TotalAppParams == iterator.acctResCnt.totalAppParams &&
+			baseAcct.TotalAppLocalStates == iterator.acctResCnt.totalAppLocalStates &&
+			baseAcct.TotalAssetParams == iterator.acctResCnt.totalAssetParams &&
+			baseAcct.TotalAssets == iterator.acctResCnt.totalAssets {
+
+			encodedRecord.ExpectingMoreEntries = false
+			bals = append(bals, encodedRecord)
+			numAccountsProcessed++
+
+			iterator.acctResCnt = catchpointAccountResourceCounter{}
+
+			return nil
+		}
+
+		// max resources per chunk reached, stop iterating.
+		if lastResource {
+			encodedRecord.ExpectingMoreEntries = true
+			bals = append(bals, encodedRecord)
+			encodedRecord.Resources = nil
+		}
+
+		return nil
+	}
+
+	_, iterator.nextBaseRow, iterator.nextResourceRow, err = processAllBaseAccountRecords(
+		iterator.accountsRows, iterator.resourcesRows,
+		baseCb, resCb,
+		iterator.nextBaseRow, iterator.nextResourceRow, accountCount, resourceCount,
+	)
+	if err != nil {
+		iterator.Close()
+		return
+	}
+
+	if len(bals) == accountCount || totalResources == resourceCount {
+		// we're done with this iteration.
+		return
+	}
+
+	err = iterator.accountsRows.Err()
+	if err != nil {
+		iterator.Close()
+		return
+	}
+	// Do not Close() the iterator here.  It is the caller's responsibility to
+	// do so, signalled by the return of an empty chunk. If we Close() here, the
+	// next call to Next() will start all over!
+	return
+}
+
+// Close shuts down the encodedAccountsBatchIter, releasing the underlying iterators.
+func (iterator *encodedAccountsBatchIter) Close() {
+	if iterator.accountsRows != nil {
+		iterator.accountsRows.Close()
+		iterator.accountsRows = nil
+	}
+	if iterator.resourcesRows != nil {
+		iterator.resourcesRows.Close()
+		iterator.resourcesRows = nil
+	}
+}
+
+type kvsIter struct {
+	iter KvIter
+}
+
+// MakeKVsIter creates a KV iterator.
+func MakeKVsIter(kvr KvRead) *kvsIter {
+	return &kvsIter{iter: newPrefixIter(kvr, prefixKv)}
+}
+
+func (iter *kvsIter) Next() bool {
+	return iter.iter.Next()
+}
+
+func (iter *kvsIter) KeyValue() (k []byte, v []byte, err error) {
+	v, err = iter.iter.Value()
+	if err != nil {
+		return nil, nil, err
+	}
+	k = append([]byte{}, iter.iter.Key()[len(prefixKv):]...)
+	return k, append([]byte{}, v...), nil
+}
+
+func (iter *kvsIter) Close() {
+	iter.iter.Close()
+}
+
+// catchpointPendingHashesIterator allows us to iterate over the catchpoint pending hashes in their order.
+type catchpointPendingHashesIterator struct {
+	hashCount int
+	kvr       KvRead
+	rows      KvIter
+}
+
+// MakeCatchpointPendingHashesIterator create a pending hashes iterator that retrieves the catchpoint pending hashes.
+func MakeCatchpointPendingHashesIterator(hashCount int, kvr KvRead) *catchpointPendingHashesIterator {
+	return &catchpointPendingHashesIterator{
+		hashCount: hashCount,
+		kvr:       kvr,
+	}
+}
+
+// Next returns an array containing the hashes, returning HashCount hashes at a time.
+func (iterator *catchpointPendingHashesIterator) Next(ctx context.Context) (hashes [][]byte, err error) {
+	if iterator.rows == nil {
+		// the pending hashes are keyed by the hash, so they are already sorted.
+		iterator.rows = newPrefixIter(iterator.kvr, prefixStagingPendingHashes)
+	}
+
+	// gather up to hashCount hashes.
+	hashes = make([][]byte, iterator.hashCount)
+	hashIdx := 0
+	for iterator.rows.Next() {
+		hashes[hashIdx] = append([]byte{}, iterator.rows.Key()[len(prefixStagingPendingHashes):]...)
+
+		hashIdx++
+		if hashIdx == iterator.hashCount {
+			// we're done with this iteration.
+			return
+		}
+	}
+	hashes = hashes[:hashIdx]
+	err = iterator.rows.Err()
+	if err != nil {
+		iterator.Close()
+		return
+	}
+	// we just finished reading the pending hashes.
+	iterator.Close()
+	return
+}
+
+// Close shuts down the catchpointPendingHashesIterator, releasing the underlying iterator.
+func (iterator *catchpointPendingHashesIterator) Close() {
+	if iterator.rows != nil {
+		iterator.rows.Close()
+		iterator.rows = nil
+	}
+}
@@ -0,0 +1,64 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+// Package generickv implements the trackerdb interfaces on top of an ordered key-value store.
+// A driver only needs to provide the KvRead and KvWrite primitives, and the atomic scopes to run them in;
+// everything else, from the keys layout to the schema migrations, is shared between the key-value backends.
+package generickv
+
+import (
+	"database/sql"
+)
+
+// KvRead is the low level interface used to read from the key-value store.
+type KvRead interface {
+	// Get returns a copy of the value stored under key, or ErrNotFound if there is no such key.
+	Get(key []byte) ([]byte, error)
+	// NewIter returns an iterator over the keys in the [low, high) range.
+	// The keys are visited in descending order when reverse is set.
+	NewIter(low, high []byte, reverse bool) KvIter
+}
+
+// KvWrite is the low level interface used to write to the key-value store.
+type KvWrite interface {
+	Set(key, value []byte) error
+	Delete(key []byte) error
+	// DeleteRange deletes all the keys in the [start, end) range.
+	DeleteRange(start, end []byte) error
+}
+
+// KvReadWrite is the union of KvRead and KvWrite.
+type KvReadWrite interface {
+	KvRead
+	KvWrite
+}
+
+// KvIter iterates over a range of keys of the key-value store.
+// It is used the same way as sql.Rows: Next has to be called before reading the first entry.
+type KvIter interface {
+	Next() bool
+	// Key returns the key of the current entry. It is only valid until the next call to Next.
+	Key() []byte
+	// Value returns the value of the current entry. It is only valid until the next call to Next.
+	Value() ([]byte, error)
+	// Err returns the error, if any, that stopped the iteration.
+	Err() error
+	Close()
+}
+
+// ErrNotFound is returned by KvRead.Get when the key does not exist.
+// It is sql.ErrNoRows, since the ledger code expects missing records to be reported the way the sql backends do.
+var ErrNotFound = sql.ErrNoRows
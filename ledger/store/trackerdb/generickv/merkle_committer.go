@@ -0,0 +1,50 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package generickv
+
+//msgp:ignore merkleCommitter
+type merkleCommitter struct {
+	kvw    KvReadWrite
+	prefix string
+}
+
+// MakeMerkleCommitter creates a MerkleCommitter object that implements the merkletrie.Committer interface allowing storing and loading
+// merkletrie pages from a key-value store.
+func MakeMerkleCommitter(kvw KvReadWrite, staging bool) *merkleCommitter {
+	prefix := prefixAccountHashes
+	if staging {
+		prefix = prefixStagingAccountHashes
+	}
+	return &merkleCommitter{kvw: kvw, prefix: prefix}
+}
+
+// StorePage is the merkletrie.Committer interface implementation, stores a single page in the key-value store.
+func (mc *merkleCommitter) StorePage(page uint64, content []byte) error {
+	if len(content) == 0 {
+		return mc.kvw.Delete(roundKey(mc.prefix, page))
+	}
+	return mc.kvw.Set(roundKey(mc.prefix, page), content)
+}
+
+// LoadPage is the merkletrie.Committer interface implementation, load a single page from the key-value store.
+func (mc *merkleCommitter) LoadPage(page uint64) (content []byte, err error) {
+	content, err = mc.kvw.Get(roundKey(mc.prefix, page))
+	if err == ErrNotFound {
+		return nil, nil
+	}
+	return content, err
+}
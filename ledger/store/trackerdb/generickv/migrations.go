@@ -0,0 +1,536 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package generickv
+
+import (
+	"context"
+	"database/sql"
+	"encoding/hex"
+	"fmt"
+	"path/filepath"
+	"time"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/crypto/merklesignature"
+	"github.com/algorand/go-algorand/crypto/merkletrie"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/ledger/store/blockdb"
+	"github.com/algorand/go-algorand/ledger/store/trackerdb"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/util/db"
+)
+
+type trackerDBSchemaInitializer struct {
+	trackerdb.Params
+
+	// schemaVersion contains current db version
+	schemaVersion int32
+	// newDatabase indicates if the db is newly created
+	newDatabase bool
+
+	kv  KvReadWrite
+	log logging.Logger
+}
+
+// RunMigrations initializes the accounts DB if needed and return current account round.
+// as part of the initialization, it tests the current database schema version, and perform upgrade
+// procedures to bring it up to the database schema supported by the binary.
+//
+// A key-value store is created directly at version 6, and then goes through the same upgrades as sqlite.
+// Most of these upgrades only add tables, which have no key-value counterpart; they are kept so that the
+// version numbers and the data migrations match the sql drivers.
+func RunMigrations(ctx context.Context, kv KvReadWrite, params trackerdb.Params, log logging.Logger, targetVersion int32) (mgr trackerdb.InitParams, err error) {
+	// check current database version.
+	dbVersion, err := getSchemaVersion(kv)
+	if err != nil {
+		return trackerdb.InitParams{}, fmt.Errorf("trackerDBInitialize unable to read database schema version : %v", err)
+	}
+
+	tu := trackerDBSchemaInitializer{
+		Params:        params,
+		schemaVersion: dbVersion,
+		kv:            kv,
+		log:           log,
+	}
+
+	// if database version is greater than supported by current binary, write a warning. This would keep the existing
+	// fallback behavior where we could use an older binary iff the schema happen to be backward compatible.
+	if tu.version() > targetVersion {
+		tu.log.Warnf("trackerDBInitialize database schema version is %d, but migration target version is %d", tu.version(), targetVersion)
+	}
+
+	if tu.version() < targetVersion {
+		tu.log.Infof("trackerDBInitialize upgrading database schema from version %d to version %d", tu.version(), targetVersion)
+		// newDatabase is determined during the initialization. If we're filling the database with accounts,
+		// then we set this variable to true, allowing some of the upgrades to be skipped.
+		for tu.version() < targetVersion {
+			tu.log.Infof("trackerDBInitialize performing upgrade from version %d", tu.version())
+			// perform the initialization/upgrade
+			switch tu.version() {
+			case 0:
+				if targetVersion < 6 {
+					return trackerdb.InitParams{}, fmt.Errorf("trackerDBInitialize unable to create a database of schema version %d", targetVersion)
+				}
+				err = tu.upgradeDatabaseSchema0(ctx)
+				if err != nil {
+					tu.log.Warnf("trackerDBInitialize failed to upgrade accounts database (kv) from schema 0 : %v", err)
+					return
+				}
+			case 6:
+				err = tu.upgradeDatabaseSchema6(ctx)
+				if err != nil {
+					tu.log.Warnf("trackerDBInitialize failed to upgrade accounts database (kv) from schema 6 : %v", err)
+					return
+				}
+			case 7:
+				err = tu.upgradeDatabaseSchema7(ctx)
+				if err != nil {
+					tu.log.Warnf("trackerDBInitialize failed to upgrade accounts database (kv) from schema 7 : %v", err)
+					return
+				}
+			case 8:
+				err = tu.upgradeDatabaseSchema8(ctx)
+				if err != nil {
+					tu.log.Warnf("trackerDBInitialize failed to upgrade accounts database (kv) from schema 8 : %v", err)
+					return
+				}
+			case 9:
+				err = tu.upgradeDatabaseSchema9(ctx)
+				if err != nil {
+					tu.log.Warnf("trackerDBInitialize failed to upgrade accounts database (kv) from schema 9 : %v", err)
+					return
+				}
+			default:
+				return trackerdb.InitParams{}, fmt.Errorf("trackerDBInitialize unable to upgrade database from schema version %d", tu.schemaVersion)
+			}
+		}
+		tu.log.Infof("trackerDBInitialize database schema upgrade complete")
+	}
+
+	// the key-value stores compact their data on their own, so there is no need to request a vacuum on startup.
+	return trackerdb.InitParams{SchemaVersion: tu.schemaVersion}, nil
+}
+
+func getSchemaVersion(kvr KvRead) (int32, error) {
+	version, err := readUint64(kvr, keySchemaVersion)
+	if err == ErrNotFound {
+		return 0, nil
+	}
+	return int32(version), err
+}
+
+func (tu *trackerDBSchemaInitializer) setVersion(ctx context.Context, version int32) (err error) {
+	oldVersion := tu.schemaVersion
+	tu.schemaVersion = version
+	err = tu.kv.Set([]byte(keySchemaVersion), appendUint64(nil, uint64(version)))
+	if err != nil {
+		return fmt.Errorf("trackerDBInitialize unable to update database schema version from %d to %d: %v", oldVersion, version, err)
+	}
+	return nil
+}
+
+func (tu trackerDBSchemaInitializer) version() int32 {
+	return tu.schemaVersion
+}
+
+// upgradeDatabaseSchema0 upgrades the database schema from version 0 to version 6
+//
+// In case the database was just created, it would get initialized with the following:
+// The accounts and resources would get initialized with the au.initAccounts
+// The account totals would get initialized to align with the initialization accounts
+// The accounts round would get updated to indicate that the balance matches round 0
+func (tu *trackerDBSchemaInitializer) upgradeDatabaseSchema0(ctx context.Context) (err error) {
+	tu.log.Infof("upgradeDatabaseSchema0 initializing schema")
+	tu.newDatabase, err = accountsInit(ctx, tu.kv, tu.InitAccounts, config.Consensus[tu.InitProto])
+	if err != nil {
+		return fmt.Errorf("upgradeDatabaseSchema0 unable to initialize schema : %v", err)
+	}
+	return tu.setVersion(ctx, 6)
+}
+
+func (tu *trackerDBSchemaInitializer) deleteUnfinishedCatchpoint(ctx context.Context) error {
+	cts := MakeCatchpointReaderWriter(tu.kv)
+	// Delete an unfinished catchpoint if there is one.
+	round, err := cts.ReadCatchpointStateUint64(ctx, trackerdb.CatchpointStateWritingCatchpoint)
+	if err != nil {
+		return err
+	}
+	if round == 0 {
+		return nil
+	}
+
+	relCatchpointFilePath := filepath.Join(
+		trackerdb.CatchpointDirName,
+		trackerdb.MakeCatchpointFilePath(basics.Round(round)))
+	err = trackerdb.RemoveSingleCatchpointFileFromDisk(tu.DbPathPrefix, relCatchpointFilePath)
+	if err != nil {
+		return err
+	}
+
+	return cts.WriteCatchpointStateUint64(ctx, trackerdb.CatchpointStateWritingCatchpoint, 0)
+}
+
+// upgradeDatabaseSchema6 upgrades the database schema from version 6 to version 7,
+// populating the online accounts history, the transactions tail and the online round params.
+func (tu *trackerDBSchemaInitializer) upgradeDatabaseSchema6(ctx context.Context) (err error) {
+	var lastProgressInfoMsg time.Time
+	const progressLoggingInterval = 5 * time.Second
+
+	migrationProcessLog := func(processed, total uint64) {
+		if time.Since(lastProgressInfoMsg) < progressLoggingInterval {
+			return
+		}
+		lastProgressInfoMsg = time.Now()
+		tu.log.Infof("upgradeDatabaseSchema6 upgraded %d out of %d accounts [ %3.1f%% ]", processed, total, float64(processed)*100.0/float64(total))
+	}
+	err = performOnlineAccountsTableMigration(ctx, tu.kv, migrationProcessLog, tu.log)
+	if err != nil {
+		return fmt.Errorf("upgradeDatabaseSchema6 unable to complete online account data migration : %w", err)
+	}
+
+	if !tu.newDatabase {
+		err = performTxTailTableMigration(ctx, tu.kv, tu.BlockDb.Rdb)
+		if err != nil {
+			return fmt.Errorf("upgradeDatabaseSchema6 unable to complete transaction tail data migration : %w", err)
+		}
+	}
+
+	err = performOnlineRoundParamsTailMigration(ctx, tu.kv, tu.BlockDb.Rdb, tu.newDatabase, tu.InitProto)
+	if err != nil {
+		return fmt.Errorf("upgradeDatabaseSchema6 unable to complete online round params data migration : %w", err)
+	}
+
+	err = tu.deleteUnfinishedCatchpoint(ctx)
+	if err != nil {
+		return err
+	}
+
+	// update version
+	return tu.setVersion(ctx, 7)
+}
+
+// upgradeDatabaseSchema7 upgrades the database schema from version 7 to version 8.
+// The boxes have no table to create, so it only updates the version.
+func (tu *trackerDBSchemaInitializer) upgradeDatabaseSchema7(ctx context.Context) (err error) {
+	return tu.setVersion(ctx, 8)
+}
+
+// upgradeDatabaseSchema8 upgrades the database schema from version 8 to version 9,
+// forcing a rebuild of the account hashes on betanet nodes. Otherwise it has no effect.
+func (tu *trackerDBSchemaInitializer) upgradeDatabaseSchema8(ctx context.Context) (err error) {
+	aw := MakeAccountsWriter(tu.kv)
+	betanetGenesisHash, _ := crypto.DigestFromString("TBMBVTC7W24RJNNUZCF7LWZD2NMESGZEQSMPG5XQD7JY4O7JKVWQ")
+	if tu.GenesisHash == betanetGenesisHash && !tu.FromCatchpoint {
+		// reset hash round to 0, forcing catchpointTracker.initializeHashes to rebuild the account hashes
+		err = aw.UpdateAccountsHashRound(ctx, 0)
+		if err != nil {
+			return fmt.Errorf("upgradeDatabaseSchema8 unable to reset the hash round : %v", err)
+		}
+	}
+	return tu.setVersion(ctx, 9)
+}
+
+// upgradeDatabaseSchema9 upgrades the database schema from version 9 to version 10.
+// The key-value store never holds nil box values and has no indexes to create, so it only updates the version.
+func (tu *trackerDBSchemaInitializer) upgradeDatabaseSchema9(ctx context.Context) (err error) {
+	return tu.setVersion(ctx, 10)
+}
+
+func accountsInit(ctx context.Context, kv KvReadWrite, initAccounts map[basics.Address]basics.AccountData, proto config.ConsensusParams) (newDatabase bool, err error) {
+	found, err := exists(kv, []byte(keyAccountsRound))
+	if err != nil || found {
+		return false, err
+	}
+	err = kv.Set([]byte(keyAccountsRound), appendUint64(nil, 0))
+	if err != nil {
+		return false, err
+	}
+
+	aw := MakeAccountsWriter(kv)
+	var ot basics.OverflowTracker
+	var totals ledgercore.AccountTotals
+	for addr, data := range initAccounts {
+		// AccountDataResources consumes the resource maps of the account, so work on a copy
+		// rather than on the genesis data.
+		var accountData basics.AccountData
+		err = protocol.Decode(protocol.Encode(&data), &accountData) //nolint:gosec // Encode does not hold on to reference
+		if err != nil {
+			return true, err
+		}
+
+		var baseAccount trackerdb.BaseAccountData
+		baseAccount.SetAccountData(&accountData)
+
+		var ref trackerdb.AccountRef
+		ref, err = aw.InsertAccount(addr, accountData.NormalizedOnlineBalance(proto), baseAccount)
+		if err != nil {
+			return true, err
+		}
+
+		insertResourceCallback := func(ctx context.Context, _ int64, cidx basics.CreatableIndex, rd *trackerdb.ResourcesData) error {
+			if rd == nil {
+				return nil
+			}
+			_, err0 := aw.InsertResource(ref, cidx, *rd)
+			return err0
+		}
+		err = trackerdb.AccountDataResources(ctx, &accountData, 0, insertResourceCallback)
+		if err != nil {
+			return true, err
+		}
+
+		ad := ledgercore.ToAccountData(data)
+		totals.AddAccount(proto, ad, &ot)
+	}
+
+	if ot.Overflowed {
+		return true, fmt.Errorf("overflow computing totals")
+	}
+
+	err = aw.AccountsPutTotals(totals, false)
+	if err != nil {
+		return true, err
+	}
+	return true, nil
+}
+
+func performTxTailTableMigration(ctx context.Context, kv KvReadWrite, blockDb db.Accessor) (err error) {
+	arw := MakeAccountsReaderWriter(kv)
+	dbRound, err := arw.AccountsRound()
+	if err != nil {
+		return fmt.Errorf("latest block number cannot be retrieved : %w", err)
+	}
+
+	// load the latest MaxTxnLife rounds in the txtail and store these in the txtail.
+	// when migrating there is only MaxTxnLife blocks in the block DB
+	// since the original txTail.commmittedUpTo preserved only (rnd+1)-MaxTxnLife = 1000 blocks back
+	err = blockDb.Atomic(func(ctx context.Context, blockTx *sql.Tx) error {
+		latestBlockRound, blockErr := blockdb.BlockLatest(blockTx)
+		if blockErr != nil {
+			return fmt.Errorf("latest block number cannot be retrieved : %w", blockErr)
+		}
+		latestHdr, hdrErr := blockdb.BlockGetHdr(blockTx, dbRound)
+		if hdrErr != nil {
+			return fmt.Errorf("latest block header %d cannot be retrieved : %w", dbRound, hdrErr)
+		}
+
+		proto := config.Consensus[latestHdr.CurrentProtocol]
+		maxTxnLife := basics.Round(proto.MaxTxnLife)
+		deeperBlockHistory := basics.Round(proto.DeeperBlockHeaderHistory)
+		// firstRound is either maxTxnLife + deeperBlockHistory back from the latest for regular init
+		// or maxTxnLife + deeperBlockHistory + CatchpointLookback back for catchpoint apply.
+		// Try to check the earliest available and start from there.
+		firstRound := (latestBlockRound + 1).SubSaturate(maxTxnLife + deeperBlockHistory + basics.Round(proto.CatchpointLookback))
+		// we don't need to have the txtail for round 0.
+		if firstRound == basics.Round(0) {
+			firstRound++
+		}
+		if _, getErr := blockdb.BlockGet(blockTx, firstRound); getErr != nil {
+			// looks like not catchpoint but a regular migration, start from maxTxnLife + deeperBlockHistory back
+			firstRound = (latestBlockRound + 1).SubSaturate(maxTxnLife + deeperBlockHistory)
+			if firstRound == basics.Round(0) {
+				firstRound++
+			}
+		}
+		tailRounds := make([][]byte, 0, maxTxnLife)
+		for rnd := firstRound; rnd <= dbRound; rnd++ {
+			blk, getErr := blockdb.BlockGet(blockTx, rnd)
+			if getErr != nil {
+				return fmt.Errorf("block for round %d ( %d - %d ) cannot be retrieved : %w", rnd, firstRound, dbRound, getErr)
+			}
+
+			tail, tErr := trackerdb.TxTailRoundFromBlock(blk)
+			if tErr != nil {
+				return tErr
+			}
+
+			encodedTail, _ := tail.Encode()
+			tailRounds = append(tailRounds, encodedTail)
+		}
+
+		return arw.TxtailNewRound(ctx, firstRound, tailRounds, firstRound)
+	})
+
+	return err
+}
+
+func performOnlineRoundParamsTailMigration(ctx context.Context, kv KvReadWrite, blockDb db.Accessor, newDatabase bool, initProto protocol.ConsensusVersion) (err error) {
+	arw := MakeAccountsReaderWriter(kv)
+	totals, err := arw.AccountsTotals(ctx, false)
+	if err != nil {
+		return err
+	}
+	rnd, err := arw.AccountsRound()
+	if err != nil {
+		return err
+	}
+	var currentProto protocol.ConsensusVersion
+	if newDatabase {
+		currentProto = initProto
+	} else {
+		err = blockDb.Atomic(func(ctx context.Context, blockTx *sql.Tx) error {
+			hdr, hdrErr := blockdb.BlockGetHdr(blockTx, rnd)
+			if hdrErr != nil {
+				return hdrErr
+			}
+			currentProto = hdr.CurrentProtocol
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+	onlineRoundParams := []ledgercore.OnlineRoundParamsData{
+		{
+			OnlineSupply:    totals.Online.Money.Raw,
+			RewardsLevel:    totals.RewardsLevel,
+			CurrentProtocol: currentProto,
+		},
+	}
+	return arw.AccountsPutOnlineRoundParams(onlineRoundParams, rnd)
+}
+
+func performOnlineAccountsTableMigration(ctx context.Context, kv KvReadWrite, progress func(processed, total uint64), log logging.Logger) (err error) {
+	r := MakeAccountsReader(kv)
+	totalOnlineBaseAccounts, err := r.TotalAccounts(ctx)
+	if err != nil {
+		return err
+	}
+
+	type acctState struct {
+		old    trackerdb.BaseAccountData
+		oldEnc []byte
+		new    trackerdb.BaseAccountData
+		newEnc []byte
+	}
+	acctRehash := make(map[basics.Address]acctState)
+	type acctUpdate struct {
+		normBal uint64
+		newEnc  []byte
+	}
+	acctUpdates := make(map[basics.Address]acctUpdate)
+
+	var processedAccounts uint64
+	low, high := prefixRange([]byte(prefixAccount))
+	iter := kv.NewIter(low, high, false)
+	defer iter.Close()
+
+	for iter.Next() {
+		addr := addressFromKey(iter.Key(), len(prefixAccount))
+		value, err := iter.Value()
+		if err != nil {
+			return err
+		}
+		normBal, encodedAcctData, err := splitAccountValue(value)
+		if err != nil {
+			return err
+		}
+		encodedAcctData = append([]byte{}, encodedAcctData...)
+		var ba trackerdb.BaseAccountData
+		err = protocol.Decode(encodedAcctData, &ba)
+		if err != nil {
+			return err
+		}
+
+		// insert entries into online accounts
+		if ba.Status == basics.Online {
+			if ba.MicroAlgos.Raw > 0 && normBal == 0 {
+				return fmt.Errorf("non valid norm balance for online account %s", addr.String())
+			}
+			var baseOnlineAD trackerdb.BaseOnlineAccountData
+			baseOnlineAD.BaseVotingData = ba.BaseVotingData
+			baseOnlineAD.MicroAlgos = ba.MicroAlgos
+			baseOnlineAD.RewardsBase = ba.RewardsBase
+			err = insertOnlineAccount(kv, addr, normBal, protocol.Encode(&baseOnlineAD), ba.UpdateRound, uint64(baseOnlineAD.VoteLastValid))
+			if err != nil {
+				return err
+			}
+		}
+
+		// remove stateproofID field for offline accounts
+		if ba.Status != basics.Online && !ba.StateProofID.IsEmpty() {
+			// store old data for account hash update
+			state := acctState{old: ba, oldEnc: encodedAcctData}
+			ba.StateProofID = merklesignature.Commitment{}
+			state.new = ba
+			state.newEnc = protocol.Encode(&ba)
+			acctRehash[addr] = state
+			acctUpdates[addr] = acctUpdate{normBal, state.newEnc}
+		}
+
+		processedAccounts++
+		if progress != nil {
+			progress(processedAccounts, totalOnlineBaseAccounts)
+		}
+	}
+	if err = iter.Err(); err != nil {
+		return err
+	}
+
+	for addr, upd := range acctUpdates {
+		err = kv.Set(accountKey(prefixAccount, addr), makeAccountValue(upd.normBal, upd.newEnc))
+		if err != nil {
+			return err
+		}
+	}
+
+	// update account hashes for the modified accounts
+	if len(acctRehash) > 0 {
+		count, err := countKeys(kv, prefixAccountHashes)
+		if err != nil {
+			return err
+		}
+		if count == 0 {
+			// no account hashes, done
+			return nil
+		}
+
+		mc := MakeMerkleCommitter(kv, false)
+		trie, err := merkletrie.MakeTrie(mc, trackerdb.TrieMemoryConfig)
+		if err != nil {
+			return fmt.Errorf("accountsInitialize was unable to MakeTrie: %v", err)
+		}
+		for addr, state := range acctRehash {
+			deleteHash := trackerdb.AccountHashBuilderV6(addr, &state.old, state.oldEnc)
+			deleted, delErr := trie.Delete(deleteHash)
+			if delErr != nil {
+				return fmt.Errorf("performOnlineAccountsTableMigration failed to delete hash '%s' from merkle trie for account %v: %w", hex.EncodeToString(deleteHash), addr, delErr)
+			}
+			if !deleted && log != nil {
+				log.Warnf("performOnlineAccountsTableMigration failed to delete hash '%s' from merkle trie for account %v", hex.EncodeToString(deleteHash), addr)
+			}
+
+			addHash := trackerdb.AccountHashBuilderV6(addr, &state.new, state.newEnc)
+			added, addErr := trie.Add(addHash)
+			if addErr != nil {
+				return fmt.Errorf("performOnlineAccountsTableMigration attempted to add duplicate hash '%s' to merkle trie for account %v: %w", hex.EncodeToString(addHash), addr, addErr)
+			}
+			if !added && log != nil {
+				log.Warnf("performOnlineAccountsTableMigration attempted to add duplicate hash '%s' to merkle trie for account %v", hex.EncodeToString(addHash), addr)
+			}
+		}
+		_, err = trie.Commit()
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
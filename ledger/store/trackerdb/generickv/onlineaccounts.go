@@ -0,0 +1,174 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package generickv
+
+import (
+	"fmt"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/ledger/store/trackerdb"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+type onlineAccountsReader struct {
+	accountsReader
+}
+
+// MakeOnlineAccountsReader returns a trackerdb.OnlineAccountsReader reading from kvr.
+func MakeOnlineAccountsReader(kvr KvRead) trackerdb.OnlineAccountsReader {
+	return &onlineAccountsReader{accountsReader{kvr: kvr}}
+}
+
+// LookupOnline returns the online account data for the given address.
+func (r *onlineAccountsReader) LookupOnline(addr basics.Address, rnd basics.Round) (data trackerdb.PersistedOnlineAccountData, err error) {
+	data.Round, err = r.AccountsRound()
+	if err == ErrNotFound {
+		// this should never happen; it indicates that the database was not initialized.
+		err = fmt.Errorf("unable to query online account data for address %v : %w", addr, err)
+	}
+	if err != nil {
+		return
+	}
+	data.Addr = addr
+
+	entry, found, err := r.latestOnlineAccount(addr, rnd)
+	if err != nil || !found {
+		// we don't have that account, just return the database round.
+		return
+	}
+	data.Ref = onlineAccountRef{addr, entry.updRound}
+	data.UpdRound = basics.Round(entry.updRound)
+	err = protocol.Decode(entry.value.encodedData, &data.AccountData)
+	return
+}
+
+// LookupOnlineTotalsHistory returns the online stake of the given round.
+func (r *onlineAccountsReader) LookupOnlineTotalsHistory(round basics.Round) (basics.MicroAlgos, error) {
+	value, err := r.kvr.Get(roundKey(prefixOnlineRoundParams, uint64(round)))
+	if err != nil {
+		return basics.MicroAlgos{}, err
+	}
+	data := ledgercore.OnlineRoundParamsData{}
+	err = protocol.Decode(value, &data)
+	if err != nil {
+		return basics.MicroAlgos{}, err
+	}
+	return basics.MicroAlgos{Raw: data.OnlineSupply}, nil
+}
+
+// LookupOnlineHistory returns all the online accounts history entries of the given address, ordered by update round.
+func (r *onlineAccountsReader) LookupOnlineHistory(addr basics.Address) (result []trackerdb.PersistedOnlineAccountData, rnd basics.Round, err error) {
+	rnd, err = r.dbRound()
+	if err != nil {
+		return
+	}
+
+	prefix := accountKey(prefixOnlineAccount, addr)
+	low, high := prefixRange(prefix)
+	iter := r.kvr.NewIter(low, high, false)
+	defer iter.Close()
+
+	for iter.Next() {
+		var value []byte
+		value, err = iter.Value()
+		if err != nil {
+			return
+		}
+		var v onlineAccountValue
+		v, err = splitOnlineAccountValue(value)
+		if err != nil {
+			return
+		}
+		updRound := decodeUint64(iter.Key()[len(prefix):])
+		data := trackerdb.PersistedOnlineAccountData{
+			Addr:     addr,
+			Ref:      onlineAccountRef{addr, updRound},
+			UpdRound: basics.Round(updRound),
+		}
+		err = protocol.Decode(v.encodedData, &data.AccountData)
+		if err != nil {
+			return
+		}
+		result = append(result, data)
+	}
+	err = iter.Err()
+	return
+}
+
+type onlineAccountsWriter struct {
+	kvw KvReadWrite
+}
+
+// MakeOnlineAccountsWriter returns a trackerdb.OnlineAccountsWriter writing to kvw.
+func MakeOnlineAccountsWriter(kvw KvReadWrite) trackerdb.OnlineAccountsWriter {
+	return &onlineAccountsWriter{kvw: kvw}
+}
+
+// InsertOnlineAccount inserts an online accounts history entry, along with its online balances index entry.
+func (w *onlineAccountsWriter) InsertOnlineAccount(addr basics.Address, normBalance uint64, data trackerdb.BaseOnlineAccountData, updRound uint64, voteLastValid uint64) (ref trackerdb.OnlineAccountRef, err error) {
+	err = insertOnlineAccount(w.kvw, addr, normBalance, protocol.Encode(&data), updRound, voteLastValid)
+	if err != nil {
+		return
+	}
+	return onlineAccountRef{addr, updRound}, nil
+}
+
+// Close is a no-op, the writer does not hold any resources.
+func (w *onlineAccountsWriter) Close() {}
+
+// Close is a no-op, the reader does not hold any resources.
+func (r *onlineAccountsReader) Close() {}
+
+func insertOnlineAccount(kvw KvReadWrite, addr basics.Address, normBalance uint64, encodedData []byte, updRound uint64, voteLastValid uint64) error {
+	key := onlineAccountKey(addr, updRound)
+	// an entry being replaced leaves a stale online balances index entry behind.
+	prev, err := kvw.Get(key)
+	if err == nil {
+		var v onlineAccountValue
+		v, err = splitOnlineAccountValue(prev)
+		if err != nil {
+			return err
+		}
+		if v.normBalance > 0 {
+			err = kvw.Delete(onlineBalanceKey(v.normBalance, addr, updRound))
+		}
+	} else if err == ErrNotFound {
+		err = nil
+	}
+	if err != nil {
+		return err
+	}
+
+	err = kvw.Set(key, makeOnlineAccountValue(normBalance, voteLastValid, encodedData))
+	if err != nil {
+		return err
+	}
+	if normBalance == 0 {
+		// accounts with no balance are never part of the online top.
+		return nil
+	}
+	return kvw.Set(onlineBalanceKey(normBalance, addr, updRound), nil)
+}
+
+func deleteOnlineAccount(kvw KvReadWrite, addr basics.Address, updRound uint64, normBalance uint64) error {
+	err := kvw.Delete(onlineAccountKey(addr, updRound))
+	if err != nil || normBalance == 0 {
+		return err
+	}
+	return kvw.Delete(onlineBalanceKey(normBalance, addr, updRound))
+}
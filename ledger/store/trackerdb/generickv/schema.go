@@ -0,0 +1,171 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package generickv
+
+import (
+	"encoding/binary"
+
+	"github.com/algorand/go-algorand/data/basics"
+)
+
+// The keys of the live tracker data all start with "x", and the keys of the catchpoint staging data with "y",
+// so that each of them can be dropped with a single range deletion. The keys starting with "z" are used by
+// the ordered accounts iterator to sort the account hashes.
+//
+// All the integers used in the keys are encoded as big-endian uint64, so that the keys order matches the
+// numerical order.
+const (
+	prefixLiveTracker = "x"
+
+	// accounts: "xa" + address -> normalized online balance (uint64) + encoded BaseAccountData
+	prefixAccount = "xa"
+	// resources: "xr" + address + creatable index -> encoded ResourcesData
+	prefixResource = "xr"
+	// application boxes: "xk" + key -> value
+	prefixKv = "xk"
+	// creatables: "xc" + creatable type (1 byte) + creatable index -> creator address
+	prefixCreatable = "xc"
+	// merkle trie pages: "xh" + page -> page content
+	prefixAccountHashes = "xh"
+	// state proof verification contexts: "xs" + last attested round -> encoded StateProofVerificationContext
+	prefixStateProofVerification = "xs"
+
+	// online accounts history: "xo" + address + update round -> normalized online balance + vote last valid + encoded BaseOnlineAccountData
+	prefixOnlineAccount = "xo"
+	// online balances index: "xb" + normalized online balance + address + update round -> empty
+	prefixOnlineBalance = "xb"
+	// transactions tail: "xt" + round -> encoded TxTailRound
+	prefixTxTail = "xt"
+	// online round params: "xp" + round -> encoded OnlineRoundParamsData
+	prefixOnlineRoundParams = "xp"
+
+	// stored catchpoints: "xf" + round -> encoded storedCatchpoint
+	prefixStoredCatchpoint = "xf"
+	// catchpoint state: "xz" + state name -> tagged value
+	prefixCatchpointState = "xz"
+	// unfinished catchpoints: "xu" + round -> block hash
+	prefixUnfinishedCatchpoint = "xu"
+	// catchpoint first stage info: "xi" + round -> encoded CatchpointFirstStageInfo
+	prefixCatchpointFirstStageInfo = "xi"
+
+	keyAccountsRound     = "xx:round"
+	keyHashRound         = "xx:hashround"
+	keyTotals            = "xx:totals"
+	keyStagingTotals     = "xx:totals:staging"
+	keySchemaVersion     = "xx:schema"
+	prefixLiveTrackerEnd = "y"
+
+	// the staging copies of the tables that are replaced when a catchpoint is applied.
+	prefixStaging                       = "y"
+	prefixStagingAccount                = "ya"
+	prefixStagingResource               = "yr"
+	prefixStagingKv                     = "yk"
+	prefixStagingCreatable              = "yc"
+	prefixStagingAccountHashes          = "yh"
+	prefixStagingStateProofVerification = "ys"
+	// catchpoint pending hashes: "yq" + hash -> empty
+	prefixStagingPendingHashes = "yq"
+	prefixStagingEnd           = "z"
+
+	// ordered accounts iterator hashes: "z" + hash + address -> empty
+	prefixOrderingHashes    = "z"
+	prefixOrderingHashesEnd = "{"
+)
+
+// catchpointStagingPrefixes maps the staging prefixes onto the live prefixes they replace
+// when the catchpoint is applied.
+var catchpointStagingPrefixes = []struct {
+	staging string
+	live    string
+}{
+	{prefixStagingAccount, prefixAccount},
+	{prefixStagingResource, prefixResource},
+	{prefixStagingKv, prefixKv},
+	{prefixStagingCreatable, prefixCreatable},
+	{prefixStagingAccountHashes, prefixAccountHashes},
+	{prefixStagingStateProofVerification, prefixStateProofVerification},
+}
+
+func appendUint64(key []byte, v uint64) []byte {
+	return binary.BigEndian.AppendUint64(key, v)
+}
+
+func decodeUint64(buf []byte) uint64 {
+	return binary.BigEndian.Uint64(buf)
+}
+
+func accountKey(prefix string, addr basics.Address) []byte {
+	key := make([]byte, 0, len(prefix)+len(addr))
+	key = append(key, prefix...)
+	return append(key, addr[:]...)
+}
+
+func resourceKey(prefix string, addr basics.Address, aidx basics.CreatableIndex) []byte {
+	return appendUint64(accountKey(prefix, addr), uint64(aidx))
+}
+
+func kvKey(prefix string, key string) []byte {
+	return append([]byte(prefix), key...)
+}
+
+func creatableKey(prefix string, ctype basics.CreatableType, cidx basics.CreatableIndex) []byte {
+	key := append([]byte(prefix), byte(ctype))
+	return appendUint64(key, uint64(cidx))
+}
+
+func onlineAccountKey(addr basics.Address, updRound uint64) []byte {
+	return appendUint64(accountKey(prefixOnlineAccount, addr), updRound)
+}
+
+func onlineBalanceKey(normBalance uint64, addr basics.Address, updRound uint64) []byte {
+	key := appendUint64([]byte(prefixOnlineBalance), normBalance)
+	key = append(key, addr[:]...)
+	return appendUint64(key, updRound)
+}
+
+func roundKey(prefix string, rnd uint64) []byte {
+	return appendUint64([]byte(prefix), rnd)
+}
+
+// prefixEnd returns the smallest key greater than all the keys starting with prefix,
+// or nil if there is no such key (prefix is empty or made only of 0xFF bytes).
+func prefixEnd(prefix []byte) []byte {
+	end := append([]byte{}, prefix...)
+	for i := len(end) - 1; i >= 0; i-- {
+		if end[i] < 0xFF {
+			end[i]++
+			return end[:i+1]
+		}
+	}
+	return nil
+}
+
+// prefixRange returns the [low, high) range of the keys starting with prefix.
+func prefixRange(prefix []byte) (low, high []byte) {
+	return prefix, prefixEnd(prefix)
+}
+
+// keyInclusiveEnd returns the smallest key greater than key, so that a range ending with it includes key.
+func keyInclusiveEnd(key []byte) []byte {
+	return append(append([]byte{}, key...), 0)
+}
+
+// addressFromKey extracts the address that follows the prefix of the given key.
+func addressFromKey(key []byte, prefixLen int) (addr basics.Address) {
+	copy(addr[:], key[prefixLen:prefixLen+len(addr)])
+	return
+}
@@ -0,0 +1,193 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package generickv
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/ledger/store/trackerdb"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+// TransactionScope implements trackerdb.TransactionScope on top of a read/write view of the store.
+type TransactionScope struct {
+	kv KvReadWrite
+}
+
+// BatchScope implements trackerdb.BatchScope on top of a read/write view of the store.
+// The reads are only used to check for existing records, mimicking the rows affected by sql statements.
+type BatchScope struct {
+	kv KvReadWrite
+}
+
+// SnapshotScope implements trackerdb.SnapshotScope on top of a consistent read-only view of the store.
+type SnapshotScope struct {
+	kv KvRead
+}
+
+// MakeTransactionScope returns a TransactionScope operating on kv.
+func MakeTransactionScope(kv KvReadWrite) TransactionScope {
+	return TransactionScope{kv: kv}
+}
+
+// MakeBatchScope returns a BatchScope operating on kv.
+func MakeBatchScope(kv KvReadWrite) BatchScope {
+	return BatchScope{kv: kv}
+}
+
+// MakeSnapshotScope returns a SnapshotScope operating on kv.
+func MakeSnapshotScope(kv KvRead) SnapshotScope {
+	return SnapshotScope{kv: kv}
+}
+
+// Testing returns this scope, exposed as an interface with test functions
+func (txs TransactionScope) Testing() trackerdb.TestTransactionScope {
+	return txs
+}
+
+func (txs TransactionScope) MakeCatchpointReaderWriter() (trackerdb.CatchpointReaderWriter, error) {
+	return MakeCatchpointReaderWriter(txs.kv), nil
+}
+
+func (txs TransactionScope) MakeAccountsReaderWriter() (trackerdb.AccountsReaderWriter, error) {
+	return MakeAccountsReaderWriter(txs.kv), nil
+}
+
+// implements Testing interface
+func (txs TransactionScope) MakeAccountsOptimizedReader() (trackerdb.AccountsReader, error) {
+	return MakeAccountsReader(txs.kv), nil
+}
+
+func (txs TransactionScope) MakeAccountsOptimizedWriter(hasAccounts, hasResources, hasKvPairs, hasCreatables bool) (trackerdb.AccountsWriter, error) {
+	return MakeAccountsWriter(txs.kv), nil
+}
+
+func (txs TransactionScope) MakeOnlineAccountsOptimizedWriter(hasAccounts bool) (w trackerdb.OnlineAccountsWriter, err error) {
+	return MakeOnlineAccountsWriter(txs.kv), nil
+}
+
+// implements Testing interface
+func (txs TransactionScope) MakeOnlineAccountsOptimizedReader() (r trackerdb.OnlineAccountsReader, err error) {
+	return MakeOnlineAccountsReader(txs.kv), nil
+}
+
+func (txs TransactionScope) MakeMerkleCommitter(staging bool) (trackerdb.MerkleCommitter, error) {
+	return MakeMerkleCommitter(txs.kv, staging), nil
+}
+
+func (txs TransactionScope) MakeOrderedAccountsIter(accountCount int) trackerdb.OrderedAccountsIter {
+	return MakeOrderedAccountsIter(txs.kv, accountCount)
+}
+
+func (txs TransactionScope) MakeKVsIter(ctx context.Context) (trackerdb.KVsIter, error) {
+	return MakeKVsIter(txs.kv), nil
+}
+
+func (txs TransactionScope) MakeEncodedAccoutsBatchIter() trackerdb.EncodedAccountsBatchIter {
+	return MakeEncodedAccoutsBatchIter(txs.kv)
+}
+
+func (txs TransactionScope) MakeSpVerificationCtxReaderWriter() trackerdb.SpVerificationCtxReaderWriter {
+	return MakeStateProofVerificationReaderWriter(txs.kv)
+}
+
+func (txs TransactionScope) RunMigrations(ctx context.Context, params trackerdb.Params, log logging.Logger, targetVersion int32) (mgr trackerdb.InitParams, err error) {
+	return RunMigrations(ctx, txs.kv, params, log, targetVersion)
+}
+
+// ResetTransactionWarnDeadline is a no-op: the key-value transactions are not monitored for their duration.
+func (txs TransactionScope) ResetTransactionWarnDeadline(ctx context.Context, deadline time.Time) (prevDeadline time.Time, err error) {
+	return deadline, nil
+}
+
+// implements Testing interface
+func (txs TransactionScope) AccountsInitTest(tb testing.TB, initAccounts map[basics.Address]basics.AccountData, proto protocol.ConsensusVersion) (newDatabase bool) {
+	return AccountsInitTest(tb, txs.kv, initAccounts, proto)
+}
+
+// implements Testing interface
+func (txs TransactionScope) AccountsInitLightTest(tb testing.TB, initAccounts map[basics.Address]basics.AccountData, proto config.ConsensusParams) (newDatabase bool, err error) {
+	return AccountsInitLightTest(tb, txs.kv, initAccounts, proto)
+}
+
+// Testing returns this scope, exposed as an interface with test functions
+func (bs BatchScope) Testing() trackerdb.TestBatchScope {
+	return bs
+}
+
+func (bs BatchScope) MakeCatchpointWriter() (trackerdb.CatchpointWriter, error) {
+	return MakeCatchpointWriter(bs.kv), nil
+}
+
+func (bs BatchScope) MakeAccountsWriter() (trackerdb.AccountsWriterExt, error) {
+	return MakeAccountsWriter(bs.kv), nil
+}
+
+func (bs BatchScope) MakeAccountsOptimizedWriter(hasAccounts, hasResources, hasKvPairs, hasCreatables bool) (trackerdb.AccountsWriter, error) {
+	return MakeAccountsWriter(bs.kv), nil
+}
+
+// implements Testing interface
+func (bs BatchScope) RunMigrations(ctx context.Context, params trackerdb.Params, log logging.Logger, targetVersion int32) (mgr trackerdb.InitParams, err error) {
+	return RunMigrations(ctx, bs.kv, params, log, targetVersion)
+}
+
+// ResetTransactionWarnDeadline is a no-op: the key-value batches are not monitored for their duration.
+func (bs BatchScope) ResetTransactionWarnDeadline(ctx context.Context, deadline time.Time) (prevDeadline time.Time, err error) {
+	return deadline, nil
+}
+
+// implements Testing interface
+func (bs BatchScope) AccountsInitTest(tb testing.TB, initAccounts map[basics.Address]basics.AccountData, proto protocol.ConsensusVersion) (newDatabase bool) {
+	return AccountsInitTest(tb, bs.kv, initAccounts, proto)
+}
+
+// implements Testing interface
+func (bs BatchScope) ModifyAcctBaseTest() error {
+	return modifyAcctBaseTest(bs.kv)
+}
+
+// AccountsUpdateSchemaTest is a no-op: there are no tables to create to work with a "v6" store.
+// implements Testing interface
+func (bs BatchScope) AccountsUpdateSchemaTest(ctx context.Context) (err error) {
+	return nil
+}
+
+func (bs BatchScope) MakeSpVerificationCtxWriter() trackerdb.SpVerificationCtxWriter {
+	return MakeStateProofVerificationWriter(bs.kv)
+}
+
+func (ss SnapshotScope) MakeAccountsReader() (trackerdb.AccountsReaderExt, error) {
+	return MakeAccountsReader(ss.kv), nil
+}
+
+func (ss SnapshotScope) MakeCatchpointReader() (trackerdb.CatchpointReader, error) {
+	return MakeCatchpointReader(ss.kv), nil
+}
+
+func (ss SnapshotScope) MakeCatchpointPendingHashesIterator(hashCount int) trackerdb.CatchpointPendingHashesIter {
+	return MakeCatchpointPendingHashesIterator(hashCount, ss.kv)
+}
+
+func (ss SnapshotScope) MakeSpVerificationCtxReader() trackerdb.SpVerificationCtxReader {
+	return MakeStateProofVerificationReader(ss.kv)
+}
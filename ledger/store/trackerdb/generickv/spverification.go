@@ -0,0 +1,129 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package generickv
+
+import (
+	"context"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/ledger/store/trackerdb"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+type stateProofVerificationReader struct {
+	kvr KvRead
+}
+
+type stateProofVerificationWriter struct {
+	kvw KvWrite
+}
+
+type stateProofVerificationReaderWriter struct {
+	stateProofVerificationReader
+	stateProofVerificationWriter
+}
+
+// MakeStateProofVerificationReader returns a trackerdb.SpVerificationCtxReader reading from kvr.
+func MakeStateProofVerificationReader(kvr KvRead) trackerdb.SpVerificationCtxReader {
+	return &stateProofVerificationReader{kvr: kvr}
+}
+
+// MakeStateProofVerificationWriter returns a trackerdb.SpVerificationCtxWriter writing to kvw.
+func MakeStateProofVerificationWriter(kvw KvWrite) trackerdb.SpVerificationCtxWriter {
+	return &stateProofVerificationWriter{kvw: kvw}
+}
+
+// MakeStateProofVerificationReaderWriter returns a trackerdb.SpVerificationCtxReaderWriter on top of kvrw.
+func MakeStateProofVerificationReaderWriter(kvrw KvReadWrite) trackerdb.SpVerificationCtxReaderWriter {
+	return &stateProofVerificationReaderWriter{
+		stateProofVerificationReader{kvr: kvrw},
+		stateProofVerificationWriter{kvw: kvrw},
+	}
+}
+
+// LookupSPContext retrieves stateproof verification context from the database.
+func (spa *stateProofVerificationReader) LookupSPContext(stateProofLastAttestedRound basics.Round) (*ledgercore.StateProofVerificationContext, error) {
+	verificationContext := ledgercore.StateProofVerificationContext{}
+	value, err := spa.kvr.Get(roundKey(prefixStateProofVerification, uint64(stateProofLastAttestedRound)))
+	if err != nil {
+		return &verificationContext, err
+	}
+	err = protocol.Decode(value, &verificationContext)
+	return &verificationContext, err
+}
+
+// DeleteOldSPContexts removes the state proof verification data preceding earliestLastAttestedRound.
+func (spa *stateProofVerificationWriter) DeleteOldSPContexts(ctx context.Context, earliestLastAttestedRound basics.Round) error {
+	return spa.kvw.DeleteRange([]byte(prefixStateProofVerification), roundKey(prefixStateProofVerification, uint64(earliestLastAttestedRound)))
+}
+
+// StoreSPContexts stores the state proof verification contexts to the database
+func (spa *stateProofVerificationWriter) StoreSPContexts(ctx context.Context, verificationContext []*ledgercore.StateProofVerificationContext) error {
+	for i := range verificationContext {
+		err := spa.kvw.Set(roundKey(prefixStateProofVerification, uint64(verificationContext[i].LastAttestedRound)), protocol.Encode(verificationContext[i]))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// StoreSPContextsToCatchpointTbl stores state proof verification contexts to the catchpoint staging area
+func (spa *stateProofVerificationWriter) StoreSPContextsToCatchpointTbl(ctx context.Context, verificationContexts []ledgercore.StateProofVerificationContext) error {
+	for i := range verificationContexts {
+		err := spa.kvw.Set(roundKey(prefixStagingStateProofVerification, uint64(verificationContexts[i].LastAttestedRound)), protocol.Encode(&verificationContexts[i]))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetAllSPContexts returns all contexts needed to verify state proofs.
+func (spa *stateProofVerificationReader) GetAllSPContexts(ctx context.Context) ([]ledgercore.StateProofVerificationContext, error) {
+	return spa.getAllSPContextsInternal(prefixStateProofVerification)
+}
+
+// GetAllSPContextsFromCatchpointTbl returns all state proof verification data from the catchpoint staging area.
+func (spa *stateProofVerificationReader) GetAllSPContextsFromCatchpointTbl(ctx context.Context) ([]ledgercore.StateProofVerificationContext, error) {
+	return spa.getAllSPContextsInternal(prefixStagingStateProofVerification)
+}
+
+func (spa *stateProofVerificationReader) getAllSPContextsInternal(prefix string) ([]ledgercore.StateProofVerificationContext, error) {
+	low, high := prefixRange([]byte(prefix))
+	iter := spa.kvr.NewIter(low, high, false)
+	defer iter.Close()
+
+	var result []ledgercore.StateProofVerificationContext
+	for iter.Next() {
+		rawData, err := iter.Value()
+		if err != nil {
+			return nil, err
+		}
+
+		var record ledgercore.StateProofVerificationContext
+		err = protocol.Decode(rawData, &record)
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, record)
+	}
+
+	return result, iter.Err()
+}
@@ -0,0 +1,58 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package generickv
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/util/db"
+)
+
+// AccountsInitLightTest initializes an empty database for testing without the extra methods being called.
+// implements Testing interface, test function only
+func AccountsInitLightTest(tb testing.TB, kv KvReadWrite, initAccounts map[basics.Address]basics.AccountData, proto config.ConsensusParams) (newDatabase bool, err error) {
+	newDB, err := accountsInit(context.Background(), kv, initAccounts, proto)
+	require.NoError(tb, err)
+	return newDB, err
+}
+
+// modifyAcctBaseTest tweaks the database to move backards.
+// implements Testing interface, test function only
+func modifyAcctBaseTest(kv KvReadWrite) error {
+	return kv.Set([]byte(keyAccountsRound), appendUint64(nil, 1))
+}
+
+// AccountsInitTest initializes an empty database for testing.
+// implements Testing interface, test function only
+func AccountsInitTest(tb testing.TB, kv KvReadWrite, initAccounts map[basics.Address]basics.AccountData, proto protocol.ConsensusVersion) (newDatabase bool) {
+	newDB, err := accountsInit(context.Background(), kv, initAccounts, config.Consensus[proto])
+	require.NoError(tb, err)
+
+	err = performOnlineAccountsTableMigration(context.Background(), kv, nil, nil)
+	require.NoError(tb, err)
+
+	err = performOnlineRoundParamsTailMigration(context.Background(), kv, db.Accessor{}, true, proto)
+	require.NoError(tb, err)
+
+	return newDB
+}
@@ -0,0 +1,114 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package pebbledbdriver
+
+import (
+	"io"
+
+	"github.com/cockroachdb/pebble"
+
+	"github.com/algorand/go-algorand/ledger/store/trackerdb/generickv"
+)
+
+// pebbleReader is the subset of the pebble reading API shared by the database, its batches and snapshots.
+type pebbleReader interface {
+	Get(key []byte) ([]byte, io.Closer, error)
+	NewIter(o *pebble.IterOptions) *pebble.Iterator
+}
+
+// kvReader adapts a pebble reader to the generickv.KvRead interface.
+type kvReader struct {
+	r pebbleReader
+}
+
+func (kv kvReader) Get(key []byte) ([]byte, error) {
+	value, closer, err := kv.r.Get(key)
+	if err == pebble.ErrNotFound {
+		return nil, generickv.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer closer.Close()
+	// the value is only valid until the closer is closed; the copy is never nil, so that
+	// empty values are told apart from missing ones.
+	return append([]byte{}, value...), nil
+}
+
+func (kv kvReader) NewIter(low, high []byte, reverse bool) generickv.KvIter {
+	iter := kv.r.NewIter(&pebble.IterOptions{LowerBound: low, UpperBound: high})
+	return &kvIter{iter: iter, reverse: reverse}
+}
+
+// kvReadWriter adapts an indexed pebble batch to the generickv.KvReadWrite interface.
+type kvReadWriter struct {
+	kvReader
+	batch *pebble.Batch
+}
+
+func makeKvReadWriter(batch *pebble.Batch) kvReadWriter {
+	return kvReadWriter{kvReader{batch}, batch}
+}
+
+func (kv kvReadWriter) Set(key, value []byte) error {
+	return kv.batch.Set(key, value, nil)
+}
+
+func (kv kvReadWriter) Delete(key []byte) error {
+	return kv.batch.Delete(key, nil)
+}
+
+func (kv kvReadWriter) DeleteRange(start, end []byte) error {
+	return kv.batch.DeleteRange(start, end, nil)
+}
+
+// kvIter adapts a pebble iterator to the generickv.KvIter interface.
+type kvIter struct {
+	iter    *pebble.Iterator
+	reverse bool
+	started bool
+}
+
+func (it *kvIter) Next() bool {
+	if !it.started {
+		it.started = true
+		if it.reverse {
+			return it.iter.Last()
+		}
+		return it.iter.First()
+	}
+	if it.reverse {
+		return it.iter.Prev()
+	}
+	return it.iter.Next()
+}
+
+func (it *kvIter) Key() []byte {
+	return it.iter.Key()
+}
+
+func (it *kvIter) Value() ([]byte, error) {
+	return it.iter.ValueAndErr()
+}
+
+func (it *kvIter) Err() error {
+	return it.iter.Error()
+}
+
+func (it *kvIter) Close() {
+	it.iter.Close()
+}
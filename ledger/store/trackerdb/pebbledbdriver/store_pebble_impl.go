@@ -0,0 +1,201 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+// Package pebbledbdriver implements the tracker store on top of pebble, an embedded LSM key-value store.
+// The trackerdb interfaces are implemented by the generickv package; this package provides the storage and
+// the atomic scopes.
+package pebbledbdriver
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync/atomic"
+
+	"github.com/algorand/go-deadlock"
+	"github.com/cockroachdb/pebble"
+	"github.com/cockroachdb/pebble/vfs"
+
+	"github.com/algorand/go-algorand/ledger/store/trackerdb"
+	"github.com/algorand/go-algorand/ledger/store/trackerdb/generickv"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/util/db"
+)
+
+type trackerPebbleStore struct {
+	pdb *pebble.DB
+	log logging.Logger
+
+	// writeMu serializes the batches and transactions: each of them reads the committed data along with
+	// its own writes, which is only consistent as long as no other write is committed in the meantime.
+	writeMu deadlock.Mutex
+
+	// noSync is set when the requested synchronous mode allows committing without syncing the WAL.
+	noSync atomic.Bool
+
+	dir      string
+	inMemory bool
+}
+
+// Open opens the tracker store in the dbdir directory, creating it if needed.
+// The store is kept in memory when inMemory is set, in which case dbdir is only used as a name.
+func Open(dbdir string, inMemory bool) (*trackerPebbleStore, error) {
+	opts := &pebble.Options{}
+	if inMemory {
+		opts.FS = vfs.NewMem()
+	}
+	pdb, err := pebble.Open(dbdir, opts)
+	if err != nil {
+		return nil, fmt.Errorf("unable to open the pebble tracker store %s: %w", dbdir, err)
+	}
+	return &trackerPebbleStore{
+		pdb:      pdb,
+		log:      logging.Base(),
+		dir:      dbdir,
+		inMemory: inMemory,
+	}, nil
+}
+
+// SetLogger sets the Logger, mainly for unit test quietness
+func (s *trackerPebbleStore) SetLogger(log logging.Logger) {
+	s.log = log
+}
+
+// SetSynchronousMode maps the sqlite synchronous modes onto pebble's write options.
+// Anything below SynchronousModeFull commits without syncing the WAL: a crash may lose the latest
+// commits, but never corrupts the store.
+func (s *trackerPebbleStore) SetSynchronousMode(ctx context.Context, mode db.SynchronousMode, fullfsync bool) (err error) {
+	if mode < db.SynchronousModeOff || mode > db.SynchronousModeExtra {
+		return fmt.Errorf("invalid value(%d) was provided to mode", mode)
+	}
+	s.noSync.Store(mode < db.SynchronousModeFull)
+	return nil
+}
+
+func (s *trackerPebbleStore) IsSharedCacheConnection() bool {
+	return false
+}
+
+func (s *trackerPebbleStore) writeOptions() *pebble.WriteOptions {
+	if s.noSync.Load() {
+		return pebble.NoSync
+	}
+	return pebble.Sync
+}
+
+func (s *trackerPebbleStore) Batch(fn trackerdb.BatchFn) (err error) {
+	return s.BatchContext(context.Background(), fn)
+}
+
+func (s *trackerPebbleStore) BatchContext(ctx context.Context, fn trackerdb.BatchFn) (err error) {
+	return s.atomic(ctx, func(ctx context.Context, kv generickv.KvReadWrite) error {
+		return fn(ctx, generickv.MakeBatchScope(kv))
+	})
+}
+
+func (s *trackerPebbleStore) Snapshot(fn trackerdb.SnapshotFn) (err error) {
+	return s.SnapshotContext(context.Background(), fn)
+}
+
+func (s *trackerPebbleStore) SnapshotContext(ctx context.Context, fn trackerdb.SnapshotFn) (err error) {
+	snap := s.pdb.NewSnapshot()
+	defer snap.Close()
+	return fn(ctx, generickv.MakeSnapshotScope(kvReader{snap}))
+}
+
+func (s *trackerPebbleStore) Transaction(fn trackerdb.TransactionFn) (err error) {
+	return s.TransactionContext(context.Background(), fn)
+}
+
+func (s *trackerPebbleStore) TransactionContext(ctx context.Context, fn trackerdb.TransactionFn) (err error) {
+	return s.atomic(ctx, func(ctx context.Context, kv generickv.KvReadWrite) error {
+		return fn(ctx, generickv.MakeTransactionScope(kv))
+	})
+}
+
+// atomic executes fn on an indexed batch, which is committed if fn succeeds and discarded otherwise.
+func (s *trackerPebbleStore) atomic(ctx context.Context, fn func(ctx context.Context, kv generickv.KvReadWrite) error) (err error) {
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
+
+	batch := s.pdb.NewIndexedBatch()
+	defer batch.Close()
+
+	err = fn(ctx, makeKvReadWriter(batch))
+	if err != nil {
+		return err
+	}
+	return batch.Commit(s.writeOptions())
+}
+
+// MakeAccountsOptimizedReader returns a reader on the latest committed data.
+// The round is read before the data it labels, so that a concurrent commit could only make the data look older than it is.
+func (s *trackerPebbleStore) MakeAccountsOptimizedReader() (trackerdb.AccountsReader, error) {
+	return generickv.MakeAccountsReader(kvReader{s.pdb}), nil
+}
+
+func (s *trackerPebbleStore) MakeOnlineAccountsOptimizedReader() (trackerdb.OnlineAccountsReader, error) {
+	return generickv.MakeOnlineAccountsReader(kvReader{s.pdb}), nil
+}
+
+func (s *trackerPebbleStore) MakeCatchpointReaderWriter() (trackerdb.CatchpointReaderWriter, error) {
+	return generickv.MakeCatchpointReaderWriter(dbReadWriter{kvReader{s.pdb}, s}), nil
+}
+
+// Vacuum compacts the whole store, dropping the deleted and overwritten entries.
+// Pebble does not report page counts the way sqlite does, so the returned stats are empty.
+func (s *trackerPebbleStore) Vacuum(ctx context.Context) (stats db.VacuumStats, err error) {
+	err = s.pdb.Compact([]byte{0x00}, []byte{0xFF}, true)
+	return
+}
+
+func (s *trackerPebbleStore) CleanupTest(dbName string, inMemory bool) {
+	s.Close()
+	if !s.inMemory {
+		os.RemoveAll(s.dir)
+	}
+}
+
+// ResetToV6Test is not supported: a key-value store is created directly with the upgraded data.
+func (s *trackerPebbleStore) ResetToV6Test(ctx context.Context) error {
+	return fmt.Errorf("the pebble tracker store can not be reset to schema version 6")
+}
+
+func (s *trackerPebbleStore) Close() {
+	err := s.pdb.Close()
+	if err != nil {
+		s.log.Warnf("trackerPebbleStore.Close unable to close the store: %v", err)
+	}
+}
+
+// dbReadWriter writes directly to the database, each write being committed on its own.
+// It is used for the store level catchpoint reader/writer, which is not bound to any scope.
+type dbReadWriter struct {
+	kvReader
+	s *trackerPebbleStore
+}
+
+func (kv dbReadWriter) Set(key, value []byte) error {
+	return kv.s.pdb.Set(key, value, kv.s.writeOptions())
+}
+
+func (kv dbReadWriter) Delete(key []byte) error {
+	return kv.s.pdb.Delete(key, kv.s.writeOptions())
+}
+
+func (kv dbReadWriter) DeleteRange(start, end []byte) error {
+	return kv.s.pdb.DeleteRange(start, end, kv.s.writeOptions())
+}
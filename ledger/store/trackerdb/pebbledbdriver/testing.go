@@ -0,0 +1,50 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package pebbledbdriver
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/ledger/store/trackerdb"
+	"github.com/algorand/go-algorand/logging"
+)
+
+// DbOpenTrackerTest opens a tracker store for testing purposes.
+// A store which is not kept in memory is created in the test temporary directory.
+func DbOpenTrackerTest(t testing.TB, inMemory bool) (trackerdb.TrackerStore, string) {
+	fn := fmt.Sprintf("%s.%d", strings.ReplaceAll(t.Name(), "/", "."), crypto.RandUint64())
+	if !inMemory {
+		fn = filepath.Join(t.TempDir(), fn)
+	}
+
+	dbs, err := Open(fn, inMemory)
+	require.NoErrorf(t, err, "Filename : %s\nInMemory: %v", fn, inMemory)
+
+	return dbs, fn
+}
+
+// SetDbTrackerTestLogging sets a testing logger on a database.
+func SetDbTrackerTestLogging(t testing.TB, dbs trackerdb.TrackerStore) {
+	dblogger := logging.TestingLog(t)
+	dbs.SetLogger(dblogger)
+}
@@ -0,0 +1,505 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package pgdriver
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/ledger/store/trackerdb"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/util/db"
+)
+
+type catchpointReader struct {
+	q db.Queryable
+}
+
+type catchpointWriter struct {
+	e db.Executable
+}
+
+type catchpointReaderWriter struct {
+	catchpointReader
+	catchpointWriter
+}
+
+// NewCatchpointPGReaderWriter creates a Catchpoint postgres reader+writer
+func NewCatchpointPGReaderWriter(e db.Executable) *catchpointReaderWriter {
+	return &catchpointReaderWriter{
+		catchpointReader{q: e},
+		catchpointWriter{e: e},
+	}
+}
+
+func (cr *catchpointReader) GetCatchpoint(ctx context.Context, round basics.Round) (fileName string, catchpoint string, fileSize int64, err error) {
+	err = cr.q.QueryRowContext(ctx, "SELECT filename, catchpoint, filesize FROM storedcatchpoints WHERE round=$1", int64(round)).Scan(&fileName, &catchpoint, &fileSize)
+	return
+}
+
+func (cr *catchpointReader) GetOldestCatchpointFiles(ctx context.Context, fileCount int, filesToKeep int) (fileNames map[basics.Round]string, err error) {
+	query := "SELECT round, filename FROM storedcatchpoints WHERE pinned = 0 and round <= COALESCE((SELECT round FROM storedcatchpoints WHERE pinned = 0 ORDER BY round DESC LIMIT 1 OFFSET $1),0) ORDER BY round ASC LIMIT $2"
+	rows, err := cr.q.QueryContext(ctx, query, filesToKeep, fileCount)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	fileNames = make(map[basics.Round]string)
+	for rows.Next() {
+		var fileName string
+		var round basics.Round
+		err = rows.Scan(&round, &fileName)
+		if err != nil {
+			return nil, err
+		}
+		fileNames[round] = fileName
+	}
+
+	err = rows.Err()
+	if err != nil {
+		fileNames = nil
+	}
+	return
+}
+
+func (cr *catchpointReader) ReadCatchpointStateUint64(ctx context.Context, stateName trackerdb.CatchpointState) (val uint64, err error) {
+	query := "SELECT intval FROM catchpointstate WHERE id=$1"
+	var v sql.NullInt64
+	err = cr.q.QueryRowContext(ctx, query, stateName).Scan(&v)
+	if err == sql.ErrNoRows {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	if v.Valid {
+		val = uint64(v.Int64)
+	}
+	return val, nil
+}
+
+func (cr *catchpointReader) ReadCatchpointStateString(ctx context.Context, stateName trackerdb.CatchpointState) (val string, err error) {
+	query := "SELECT strval FROM catchpointstate WHERE id=$1"
+	var v sql.NullString
+	err = cr.q.QueryRowContext(ctx, query, stateName).Scan(&v)
+	if err == sql.ErrNoRows {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+
+	if v.Valid {
+		val = v.String
+	}
+	return val, nil
+}
+
+func (cr *catchpointReader) SelectUnfinishedCatchpoints(ctx context.Context) ([]trackerdb.UnfinishedCatchpointRecord, error) {
+	query := "SELECT round, blockhash FROM unfinishedcatchpoints ORDER BY round"
+	rows, err := cr.q.QueryContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var res []trackerdb.UnfinishedCatchpointRecord
+	for rows.Next() {
+		var record trackerdb.UnfinishedCatchpointRecord
+		var blockHash []byte
+		err = rows.Scan(&record.Round, &blockHash)
+		if err != nil {
+			return nil, err
+		}
+		copy(record.BlockHash[:], blockHash)
+		res = append(res, record)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (cr *catchpointReader) SelectCatchpointFirstStageInfo(ctx context.Context, round basics.Round) (trackerdb.CatchpointFirstStageInfo, bool /*exists*/, error) {
+	var data []byte
+	query := "SELECT info FROM catchpointfirststageinfo WHERE round=$1"
+	err := cr.q.QueryRowContext(ctx, query, round).Scan(&data)
+	if err == sql.ErrNoRows {
+		return trackerdb.CatchpointFirstStageInfo{}, false, nil
+	}
+	if err != nil {
+		return trackerdb.CatchpointFirstStageInfo{}, false, err
+	}
+
+	var res trackerdb.CatchpointFirstStageInfo
+	err = protocol.Decode(data, &res)
+	if err != nil {
+		return trackerdb.CatchpointFirstStageInfo{}, false, err
+	}
+
+	return res, true, nil
+}
+
+func (cr *catchpointReader) SelectOldCatchpointFirstStageInfoRounds(ctx context.Context, maxRound basics.Round) ([]basics.Round, error) {
+	query := "SELECT round FROM catchpointfirststageinfo WHERE round <= $1"
+	rows, err := cr.q.QueryContext(ctx, query, maxRound)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var res []basics.Round
+	for rows.Next() {
+		var r basics.Round
+		err = rows.Scan(&r)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, r)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (cw *catchpointWriter) StoreCatchpoint(ctx context.Context, round basics.Round, fileName string, catchpoint string, fileSize int64) (err error) {
+	query := "DELETE FROM storedcatchpoints WHERE round=$1"
+	_, err = cw.e.ExecContext(ctx, query, round)
+	if err != nil || (fileName == "" && catchpoint == "" && fileSize == 0) {
+		return err
+	}
+
+	query = "INSERT INTO storedcatchpoints(round, filename, catchpoint, filesize, pinned) VALUES($1, $2, $3, $4, 0)"
+	_, err = cw.e.ExecContext(ctx, query, round, fileName, catchpoint, fileSize)
+	return err
+}
+
+func (cw *catchpointWriter) WriteCatchpointStateUint64(ctx context.Context, stateName trackerdb.CatchpointState, setValue uint64) (err error) {
+	if setValue == 0 {
+		return deleteCatchpointStateImpl(ctx, cw.e, stateName)
+	}
+
+	// we don't know if there is an entry in the table for this state, so we'll upsert it just in case.
+	query := "INSERT INTO catchpointstate(id, intval) VALUES($1, $2) ON CONFLICT (id) DO UPDATE SET intval = excluded.intval, strval = NULL"
+	_, err = cw.e.ExecContext(ctx, query, stateName, setValue)
+	return err
+}
+
+func (cw *catchpointWriter) WriteCatchpointStateString(ctx context.Context, stateName trackerdb.CatchpointState, setValue string) (err error) {
+	if setValue == "" {
+		return deleteCatchpointStateImpl(ctx, cw.e, stateName)
+	}
+
+	// we don't know if there is an entry in the table for this state, so we'll upsert it just in case.
+	query := "INSERT INTO catchpointstate(id, strval) VALUES($1, $2) ON CONFLICT (id) DO UPDATE SET strval = excluded.strval, intval = NULL"
+	_, err = cw.e.ExecContext(ctx, query, stateName, setValue)
+	return err
+}
+
+func (cw *catchpointWriter) InsertUnfinishedCatchpoint(ctx context.Context, round basics.Round, blockHash crypto.Digest) error {
+	query := "INSERT INTO unfinishedcatchpoints(round, blockhash) VALUES($1, $2)"
+	_, err := cw.e.ExecContext(ctx, query, round, blockHash[:])
+	return err
+}
+
+func (cw *catchpointWriter) DeleteUnfinishedCatchpoint(ctx context.Context, round basics.Round) error {
+	query := "DELETE FROM unfinishedcatchpoints WHERE round = $1"
+	_, err := cw.e.ExecContext(ctx, query, round)
+	return err
+}
+
+func deleteCatchpointStateImpl(ctx context.Context, e db.Executable, stateName trackerdb.CatchpointState) error {
+	query := "DELETE FROM catchpointstate WHERE id=$1"
+	_, err := e.ExecContext(ctx, query, stateName)
+	return err
+}
+
+func (cw *catchpointWriter) InsertOrReplaceCatchpointFirstStageInfo(ctx context.Context, round basics.Round, info *trackerdb.CatchpointFirstStageInfo) error {
+	infoSerialized := protocol.Encode(info)
+	query := "INSERT INTO catchpointfirststageinfo(round, info) VALUES($1, $2) ON CONFLICT (round) DO UPDATE SET info = excluded.info"
+	_, err := cw.e.ExecContext(ctx, query, round, infoSerialized)
+	return err
+}
+
+func (cw *catchpointWriter) DeleteOldCatchpointFirstStageInfo(ctx context.Context, maxRoundToDelete basics.Round) error {
+	query := "DELETE FROM catchpointfirststageinfo WHERE round <= $1"
+	_, err := cw.e.ExecContext(ctx, query, maxRoundToDelete)
+	return err
+}
+
+// WriteCatchpointStagingBalances inserts all the account balances in the provided array into the catchpoint balance staging table catchpointbalances.
+func (cw *catchpointWriter) WriteCatchpointStagingBalances(ctx context.Context, bals []trackerdb.NormalizedAccountBalance) error {
+	selectAcctStmt, err := cw.e.PrepareContext(ctx, "SELECT addrid FROM catchpointbalances WHERE address = $1")
+	if err != nil {
+		return err
+	}
+	defer selectAcctStmt.Close()
+
+	// a unique constraint violation would abort the whole transaction, so conflicts are skipped explicitly.
+	insertAcctStmt, err := cw.e.PrepareContext(ctx, "INSERT INTO catchpointbalances(address, normalizedonlinebalance, data) VALUES($1, $2, $3) ON CONFLICT (address) DO NOTHING RETURNING addrid")
+	if err != nil {
+		return err
+	}
+	defer insertAcctStmt.Close()
+
+	insertRscStmt, err := cw.e.PrepareContext(ctx, "INSERT INTO catchpointresources(addrid, aidx, data) VALUES($1, $2, $3)")
+	if err != nil {
+		return err
+	}
+	defer insertRscStmt.Close()
+
+	var rowID int64
+	for _, balance := range bals {
+		err = insertAcctStmt.QueryRowContext(ctx, balance.Address[:], balance.NormalizedBalance, balance.EncodedAccountData).Scan(&rowID)
+		if err == sql.ErrNoRows {
+			// address exists: overflowed account record: find addrid
+			err = selectAcctStmt.QueryRowContext(ctx, balance.Address[:]).Scan(&rowID)
+		}
+		if err != nil {
+			return err
+		}
+
+		// write resources
+		for aidx := range balance.Resources {
+			var result sql.Result
+			result, err = insertRscStmt.ExecContext(ctx, rowID, aidx, balance.EncodedResources[aidx])
+			if err != nil {
+				return err
+			}
+			var aff int64
+			aff, err = result.RowsAffected()
+			if err != nil {
+				return err
+			}
+			if aff != 1 {
+				return fmt.Errorf("number of affected record in insert was expected to be one, but was %d", aff)
+			}
+		}
+	}
+	return nil
+}
+
+// WriteCatchpointStagingHashes inserts all the account hashes in the provided array into the catchpoint pending hashes table catchpointpendinghashes.
+func (cw *catchpointWriter) WriteCatchpointStagingHashes(ctx context.Context, bals []trackerdb.NormalizedAccountBalance) error {
+	insertStmt, err := cw.e.PrepareContext(ctx, "INSERT INTO catchpointpendinghashes(data) VALUES($1)")
+	if err != nil {
+		return err
+	}
+	defer insertStmt.Close()
+
+	for _, balance := range bals {
+		for _, hash := range balance.AccountHashes {
+			result, err := insertStmt.ExecContext(ctx, hash[:])
+			if err != nil {
+				return err
+			}
+
+			aff, err := result.RowsAffected()
+			if err != nil {
+				return err
+			}
+			if aff != 1 {
+				return fmt.Errorf("number of affected record in insert was expected to be one, but was %d", aff)
+			}
+		}
+	}
+	return nil
+}
+
+// WriteCatchpointStagingCreatable inserts all the creatables in the provided array into the catchpoint asset creator staging table catchpointassetcreators.
+// note that we cannot insert the resources here : in order to insert the resources, we need the rowid of the accountbase entry. This is being inserted by
+// writeCatchpointStagingBalances via a separate go-routine.
+func (cw *catchpointWriter) WriteCatchpointStagingCreatable(ctx context.Context, bals []trackerdb.NormalizedAccountBalance) error {
+	var insertCreatorsStmt *sql.Stmt
+	var err error
+	insertCreatorsStmt, err = cw.e.PrepareContext(ctx, "INSERT INTO catchpointassetcreators(asset, creator, ctype) VALUES($1, $2, $3)")
+	if err != nil {
+		return err
+	}
+	defer insertCreatorsStmt.Close()
+
+	for _, balance := range bals {
+		for aidx, resData := range balance.Resources {
+			if resData.IsOwning() {
+				// determine if it's an asset
+				if resData.IsAsset() {
+					_, err := insertCreatorsStmt.ExecContext(ctx, aidx, balance.Address[:], basics.AssetCreatable)
+					if err != nil {
+						return err
+					}
+				}
+				// determine if it's an application
+				if resData.IsApp() {
+					_, err := insertCreatorsStmt.ExecContext(ctx, aidx, balance.Address[:], basics.AppCreatable)
+					if err != nil {
+						return err
+					}
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// WriteCatchpointStagingKVs inserts all the KVs in the provided array into the
+// catchpoint kvstore staging table catchpointkvstore, and their hashes to the pending
+func (cw *catchpointWriter) WriteCatchpointStagingKVs(ctx context.Context, keys [][]byte, values [][]byte, hashes [][]byte) error {
+	insertKV, err := cw.e.PrepareContext(ctx, "INSERT INTO catchpointkvstore(key, value) VALUES($1, $2)")
+	if err != nil {
+		return err
+	}
+	defer insertKV.Close()
+
+	insertHash, err := cw.e.PrepareContext(ctx, "INSERT INTO catchpointpendinghashes(data) VALUES($1)")
+	if err != nil {
+		return err
+	}
+	defer insertHash.Close()
+
+	for i := 0; i < len(keys); i++ {
+		_, err := insertKV.ExecContext(ctx, keys[i], values[i])
+		if err != nil {
+			return err
+		}
+
+		_, err = insertHash.ExecContext(ctx, hashes[i])
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (cw *catchpointWriter) ResetCatchpointStagingBalances(ctx context.Context, newCatchup bool) (err error) {
+	s := []string{
+		"DROP TABLE IF EXISTS catchpointbalances",
+		"DROP TABLE IF EXISTS catchpointassetcreators",
+		"DROP TABLE IF EXISTS catchpointaccounthashes",
+		"DROP TABLE IF EXISTS catchpointpendinghashes",
+		"DROP TABLE IF EXISTS catchpointresources",
+		"DROP TABLE IF EXISTS catchpointkvstore",
+		"DROP TABLE IF EXISTS catchpointstateproofverification",
+		"DELETE FROM accounttotals where id='catchpointStaging'",
+	}
+
+	if newCatchup {
+		// the index names follow the ones listed in catchpointStagingTables, so that
+		// ApplyCatchpointStagingBalances can rename them after the live tables.
+		s = append(s,
+			createAssetCreatorsTable("catchpointassetcreators"),
+			createAccountBaseTable("catchpointbalances"),
+			"CREATE TABLE IF NOT EXISTS catchpointpendinghashes (data BYTEA)",
+			createAccountHashesTable("catchpointaccounthashes"),
+			createResourcesTable("catchpointresources"),
+			createKVStoreTable("catchpointkvstore"),
+			createStateProofVerificationTable("catchpointstateproofverification"),
+
+			createNormalizedOnlineBalanceIndex("catchpointbalances_normbal_idx", "catchpointbalances"),
+			createUniqueAddressBalanceIndex("catchpointbalances_address_idx", "catchpointbalances"),
+		)
+	}
+
+	for _, stmt := range s {
+		_, err = cw.e.ExecContext(ctx, stmt)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ApplyCatchpointStagingBalances switches the staged catchpoint catchup tables onto the actual
+// tables and update the correct balance round. This is the final step in switching onto the new catchpoint round.
+func (cw *catchpointWriter) ApplyCatchpointStagingBalances(ctx context.Context, balancesRound basics.Round, merkleRootRound basics.Round) (err error) {
+	var stmts []string
+	for _, table := range catchpointStagingTables {
+		stmts = append(stmts, "DROP TABLE IF EXISTS "+table.target)
+	}
+	for _, table := range catchpointStagingTables {
+		stmts = append(stmts, fmt.Sprintf("ALTER TABLE %s RENAME TO %s", table.staging, table.target))
+		for _, idx := range table.indexes {
+			stmts = append(stmts, fmt.Sprintf("ALTER INDEX IF EXISTS %s RENAME TO %s", idx[0], idx[1]))
+		}
+		for _, seq := range table.sequences {
+			stmts = append(stmts, fmt.Sprintf("ALTER SEQUENCE IF EXISTS %s RENAME TO %s", seq[0], seq[1]))
+		}
+	}
+
+	for _, stmt := range stmts {
+		_, err = cw.e.ExecContext(ctx, stmt)
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err = cw.e.ExecContext(ctx, "INSERT INTO acctrounds(id, rnd) VALUES('acctbase', $1) ON CONFLICT (id) DO UPDATE SET rnd = excluded.rnd", balancesRound)
+	if err != nil {
+		return err
+	}
+
+	_, err = cw.e.ExecContext(ctx, "INSERT INTO acctrounds(id, rnd) VALUES('hashbase', $1) ON CONFLICT (id) DO UPDATE SET rnd = excluded.rnd", merkleRootRound)
+	if err != nil {
+		return err
+	}
+
+	return
+}
+
+// CreateCatchpointStagingHashesIndex creates an index on catchpointpendinghashes to allow faster scanning according to the hash order
+func (cw *catchpointWriter) CreateCatchpointStagingHashesIndex(ctx context.Context) (err error) {
+	_, err = cw.e.ExecContext(ctx, "CREATE INDEX IF NOT EXISTS catchpointpendinghashesidx ON catchpointpendinghashes(data)")
+	if err != nil {
+		return
+	}
+	return
+}
+
+// DeleteStoredCatchpoints iterates over the storedcatchpoints table and deletes all the files stored on disk.
+// once all the files have been deleted, it would go ahead and remove the entries from the table.
+func (crw *catchpointReaderWriter) DeleteStoredCatchpoints(ctx context.Context, dbDirectory string) (err error) {
+	catchpointsFilesChunkSize := 50
+	for {
+		fileNames, err := crw.GetOldestCatchpointFiles(ctx, catchpointsFilesChunkSize, 0)
+		if err != nil {
+			return err
+		}
+		if len(fileNames) == 0 {
+			break
+		}
+
+		for round, fileName := range fileNames {
+			err = trackerdb.RemoveSingleCatchpointFileFromDisk(dbDirectory, fileName)
+			if err != nil {
+				return err
+			}
+			// clear the entry from the database
+			err = crw.StoreCatchpoint(ctx, round, "", "", 0)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
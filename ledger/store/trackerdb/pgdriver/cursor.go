@@ -0,0 +1,175 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package pgdriver
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sync/atomic"
+)
+
+// cursorPageSize is the number of rows fetched from a server side cursor at a time.
+const cursorPageSize = 1000
+
+// cursorSeq is used to generate unique cursor names.
+var cursorSeq uint64
+
+// cursor iterates over the result of a query through a server side cursor.
+// Unlike *sql.Rows, a cursor does not keep the connection busy between pages,
+// which lets the enclosing transaction execute other statements while iterating.
+// SQLite allows interleaving result sets and statements on the same transaction,
+// and several trackerdb iterators rely on that.
+type cursor struct {
+	ctx       context.Context
+	tx        *sql.Tx
+	name      string
+	page      [][]interface{}
+	pos       int
+	current   []interface{}
+	exhausted bool
+	closed    bool
+	err       error
+}
+
+// openCursor declares a cursor for query within tx. The cursor is released on Close,
+// or implicitly when the transaction ends.
+func openCursor(ctx context.Context, tx *sql.Tx, query string) (*cursor, error) {
+	name := fmt.Sprintf("trackerdb_cursor_%d", atomic.AddUint64(&cursorSeq, 1))
+	_, err := tx.ExecContext(ctx, "DECLARE "+name+" NO SCROLL CURSOR FOR "+query)
+	if err != nil {
+		return nil, err
+	}
+	return &cursor{ctx: ctx, tx: tx, name: name}, nil
+}
+
+// fetch loads the next page of rows into memory.
+func (c *cursor) fetch() error {
+	rows, err := c.tx.QueryContext(c.ctx, fmt.Sprintf("FETCH FORWARD %d FROM %s", cursorPageSize, c.name))
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	columns, err := rows.Columns()
+	if err != nil {
+		return err
+	}
+	c.page = c.page[:0]
+	c.pos = 0
+	for rows.Next() {
+		values := make([]interface{}, len(columns))
+		ptrs := make([]interface{}, len(columns))
+		for i := range values {
+			ptrs[i] = &values[i]
+		}
+		// scanning into *interface{} copies []byte values, so they remain valid after the next fetch.
+		err = rows.Scan(ptrs...)
+		if err != nil {
+			return err
+		}
+		c.page = append(c.page, values)
+	}
+	if err = rows.Err(); err != nil {
+		return err
+	}
+	if len(c.page) < cursorPageSize {
+		c.exhausted = true
+	}
+	return nil
+}
+
+// Next advances the cursor to the next row, fetching a new page if needed.
+func (c *cursor) Next() bool {
+	if c.closed || c.err != nil {
+		return false
+	}
+	if c.pos >= len(c.page) {
+		if c.exhausted {
+			return false
+		}
+		c.err = c.fetch()
+		if c.err != nil || len(c.page) == 0 {
+			return false
+		}
+	}
+	c.current = c.page[c.pos]
+	c.pos++
+	return true
+}
+
+// Scan copies the columns of the current row into dest. The supported destinations
+// are the ones used by this package: sql.Scanner implementations, *[]byte and *int64.
+func (c *cursor) Scan(dest ...interface{}) error {
+	if c.current == nil {
+		return fmt.Errorf("cursor %s: Scan called without calling Next", c.name)
+	}
+	if len(dest) != len(c.current) {
+		return fmt.Errorf("cursor %s: expected %d destination arguments in Scan, not %d", c.name, len(c.current), len(dest))
+	}
+	for i, src := range c.current {
+		err := assignValue(dest[i], src)
+		if err != nil {
+			return fmt.Errorf("cursor %s: column %d: %w", c.name, i, err)
+		}
+	}
+	return nil
+}
+
+// Err returns the error, if any, that was encountered during iteration.
+func (c *cursor) Err() error {
+	return c.err
+}
+
+// Close releases the server side cursor.
+func (c *cursor) Close() error {
+	if c.closed {
+		return nil
+	}
+	c.closed = true
+	c.page = nil
+	c.current = nil
+	_, err := c.tx.ExecContext(c.ctx, "CLOSE "+c.name)
+	return err
+}
+
+func assignValue(dest interface{}, src interface{}) error {
+	switch d := dest.(type) {
+	case sql.Scanner:
+		return d.Scan(src)
+	case *[]byte:
+		if src == nil {
+			*d = nil
+			return nil
+		}
+		b, ok := src.([]byte)
+		if !ok {
+			return fmt.Errorf("unable to scan %T into *[]byte", src)
+		}
+		*d = b
+		return nil
+	case *int64:
+		i, ok := src.(int64)
+		if !ok {
+			return fmt.Errorf("unable to scan %T into *int64", src)
+		}
+		*d = i
+		return nil
+	default:
+		return fmt.Errorf("unsupported scan destination %T", dest)
+	}
+}
@@ -0,0 +1,74 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package pgdriver
+
+import "database/sql"
+
+//msgp:ignore MerkleCommitter
+type merkleCommitter struct {
+	tx         *sql.Tx
+	deleteStmt *sql.Stmt
+	insertStmt *sql.Stmt
+	selectStmt *sql.Stmt
+}
+
+// MakeMerkleCommitter creates a MerkleCommitter object that implements the merkletrie.Committer interface allowing storing and loading
+// merkletrie pages from a postgres database.
+func MakeMerkleCommitter(tx *sql.Tx, staging bool) (mc *merkleCommitter, err error) {
+	mc = &merkleCommitter{tx: tx}
+	accountHashesTable := "accounthashes"
+	if staging {
+		accountHashesTable = "catchpointaccounthashes"
+	}
+	mc.deleteStmt, err = tx.Prepare("DELETE FROM " + accountHashesTable + " WHERE id=$1")
+	if err != nil {
+		return nil, err
+	}
+	mc.insertStmt, err = tx.Prepare("INSERT INTO " + accountHashesTable + "(id, data) VALUES($1, $2) ON CONFLICT (id) DO UPDATE SET data = excluded.data")
+	if err != nil {
+		return nil, err
+	}
+	mc.selectStmt, err = tx.Prepare("SELECT data FROM " + accountHashesTable + " WHERE id = $1")
+	if err != nil {
+		return nil, err
+	}
+	return mc, nil
+}
+
+// StorePage is the merkletrie.Committer interface implementation, stores a single page in a postgres database table.
+// The page numbers are stored as BIGINT, which holds any page number the trie can allocate.
+func (mc *merkleCommitter) StorePage(page uint64, content []byte) error {
+	if len(content) == 0 {
+		_, err := mc.deleteStmt.Exec(int64(page))
+		return err
+	}
+	_, err := mc.insertStmt.Exec(int64(page), content)
+	return err
+}
+
+// LoadPage is the merkletrie.Committer interface implementation, load a single page from a postgres database table.
+func (mc *merkleCommitter) LoadPage(page uint64) (content []byte, err error) {
+	err = mc.selectStmt.QueryRow(int64(page)).Scan(&content)
+	if err == sql.ErrNoRows {
+		content = nil
+		err = nil
+		return
+	} else if err != nil {
+		return nil, err
+	}
+	return content, nil
+}
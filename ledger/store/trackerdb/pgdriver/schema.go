@@ -0,0 +1,690 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package pgdriver
+
+import (
+	"context"
+	"database/sql"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto/merklesignature"
+	"github.com/algorand/go-algorand/crypto/merkletrie"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/ledger/store/blockdb"
+	"github.com/algorand/go-algorand/ledger/store/trackerdb"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/util/db"
+)
+
+// trackerDBVersionID is the key of the schema version row in the trackerdbversion table.
+// Postgres has no equivalent of sqlite's user_version pragma, so the version is kept in a table.
+const trackerDBVersionID = "trackerdb"
+
+const createVersionTable = `
+	CREATE TABLE IF NOT EXISTS trackerdbversion (
+	id TEXT PRIMARY KEY,
+	version INTEGER NOT NULL)`
+
+// accountsSchema is the schema of a version 6 database. Unlike the sqlite driver,
+// a postgres database never existed in the older layouts, so the tables are created
+// directly with the columns added by the sqlite migrations 0 to 5.
+var accountsSchema = []string{
+	`CREATE TABLE IF NOT EXISTS acctrounds (
+		id TEXT PRIMARY KEY,
+		rnd BIGINT)`,
+	`CREATE TABLE IF NOT EXISTS accounttotals (
+		id TEXT PRIMARY KEY,
+		online BIGINT,
+		onlinerewardunits BIGINT,
+		offline BIGINT,
+		offlinerewardunits BIGINT,
+		notparticipating BIGINT,
+		notparticipatingrewardunits BIGINT,
+		rewardslevel BIGINT)`,
+	createAccountBaseTable("accountbase"),
+	createUniqueAddressBalanceIndex("accountbase_address_idx", "accountbase"),
+	createNormalizedOnlineBalanceIndex("accountbase_normbal_idx", "accountbase"),
+	createAssetCreatorsTable("assetcreators"),
+	`CREATE TABLE IF NOT EXISTS storedcatchpoints (
+		round BIGINT PRIMARY KEY,
+		filename TEXT NOT NULL,
+		catchpoint TEXT NOT NULL,
+		filesize BIGINT NOT NULL,
+		pinned INTEGER NOT NULL)`,
+	createAccountHashesTable("accounthashes"),
+	`CREATE TABLE IF NOT EXISTS catchpointstate (
+		id TEXT PRIMARY KEY,
+		intval BIGINT,
+		strval TEXT)`,
+	createResourcesTable("resources"),
+}
+
+// createAccountBaseTable handles accountbase/catchpointbalances tables.
+// The addrid column plays the role of the sqlite rowid.
+func createAccountBaseTable(tablename string) string {
+	return fmt.Sprintf(`CREATE TABLE IF NOT EXISTS %s (
+		addrid BIGSERIAL PRIMARY KEY,
+		address BYTEA NOT NULL,
+		data BYTEA,
+		normalizedonlinebalance BIGINT)`, tablename)
+}
+
+// createAssetCreatorsTable handles assetcreators/catchpointassetcreators tables
+func createAssetCreatorsTable(tablename string) string {
+	return fmt.Sprintf(`CREATE TABLE IF NOT EXISTS %s (
+		asset BIGINT PRIMARY KEY,
+		creator BYTEA,
+		ctype BIGINT DEFAULT 0)`, tablename)
+}
+
+// createAccountHashesTable handles accounthashes/catchpointaccounthashes tables
+func createAccountHashesTable(tablename string) string {
+	return fmt.Sprintf(`CREATE TABLE IF NOT EXISTS %s (
+		id BIGINT PRIMARY KEY,
+		data BYTEA)`, tablename)
+}
+
+// createResourcesTable handles resources/catchpointresources tables
+func createResourcesTable(tablename string) string {
+	return fmt.Sprintf(`CREATE TABLE IF NOT EXISTS %s (
+		addrid BIGINT NOT NULL,
+		aidx BIGINT NOT NULL,
+		data BYTEA NOT NULL,
+		PRIMARY KEY (addrid, aidx))`, tablename)
+}
+
+// createKVStoreTable handles kvstore/catchpointkvstore tables
+func createKVStoreTable(tablename string) string {
+	return fmt.Sprintf(`CREATE TABLE IF NOT EXISTS %s (
+		key BYTEA PRIMARY KEY,
+		value BYTEA)`, tablename)
+}
+
+// createStateProofVerificationTable handles stateproofverification/catchpointstateproofverification tables
+func createStateProofVerificationTable(tablename string) string {
+	return fmt.Sprintf(`CREATE TABLE IF NOT EXISTS %s (
+		lastattestedround BIGINT PRIMARY KEY,
+		verificationcontext BYTEA NOT NULL)`, tablename)
+}
+
+// createNormalizedOnlineBalanceIndexOnline handles onlineaccounts table
+func createNormalizedOnlineBalanceIndexOnline(idxname string, tablename string) string {
+	return fmt.Sprintf(`CREATE INDEX IF NOT EXISTS %s
+		ON %s ( normalizedonlinebalance, address )`, idxname, tablename)
+}
+
+// createUniqueAddressBalanceIndex is sql query to create a uninque index on `address`.
+func createUniqueAddressBalanceIndex(idxname string, tablename string) string {
+	return fmt.Sprintf(`CREATE UNIQUE INDEX IF NOT EXISTS %s ON %s (address)`, idxname, tablename)
+}
+
+// createNormalizedOnlineBalanceIndex handles accountbase/catchpointbalances tables.
+// The data column is left out of the index, as postgres limits the size of the index entries.
+func createNormalizedOnlineBalanceIndex(idxname string, tablename string) string {
+	return fmt.Sprintf(`CREATE INDEX IF NOT EXISTS %s
+		ON %s ( normalizedonlinebalance, address ) WHERE normalizedonlinebalance>0`, idxname, tablename)
+}
+
+var createOnlineAccountsTable = []string{
+	`CREATE TABLE IF NOT EXISTS onlineaccounts (
+		rowid BIGSERIAL PRIMARY KEY,
+		address BYTEA NOT NULL,
+		updround BIGINT NOT NULL,
+		normalizedonlinebalance BIGINT NOT NULL,
+		votelastvalid BIGINT NOT NULL,
+		data BYTEA NOT NULL,
+		UNIQUE (address, updround) )`,
+	createNormalizedOnlineBalanceIndexOnline("onlineaccounts_normbal_idx", "onlineaccounts"),
+}
+
+var createTxTailTable = []string{
+	`CREATE TABLE IF NOT EXISTS txtail (
+		rnd BIGINT PRIMARY KEY NOT NULL,
+		data BYTEA NOT NULL)`,
+}
+
+var createOnlineRoundParamsTable = []string{
+	`CREATE TABLE IF NOT EXISTS onlineroundparamstail(
+		rnd BIGINT NOT NULL PRIMARY KEY,
+		data BYTEA NOT NULL)`, // contains a msgp encoded OnlineRoundParamsData
+}
+
+// Table containing some metadata for a future catchpoint. The `info` column
+// contains a serialized object of type catchpointFirstStageInfo.
+const createCatchpointFirstStageInfoTable = `
+	CREATE TABLE IF NOT EXISTS catchpointfirststageinfo (
+	round BIGINT PRIMARY KEY NOT NULL,
+	info BYTEA NOT NULL)`
+
+const createUnfinishedCatchpointsTable = `
+	CREATE TABLE IF NOT EXISTS unfinishedcatchpoints (
+	round BIGINT PRIMARY KEY NOT NULL,
+	blockhash BYTEA NOT NULL)`
+
+const createVoteLastValidIndex = `
+	CREATE INDEX IF NOT EXISTS onlineaccounts_votelastvalid_idx
+	ON onlineaccounts ( votelastvalid )`
+
+var accountsResetExprs = []string{
+	`DROP TABLE IF EXISTS acctrounds`,
+	`DROP TABLE IF EXISTS accounttotals`,
+	`DROP TABLE IF EXISTS accountbase`,
+	`DROP TABLE IF EXISTS kvstore`,
+	`DROP TABLE IF EXISTS assetcreators`,
+	`DROP TABLE IF EXISTS storedcatchpoints`,
+	`DROP TABLE IF EXISTS catchpointstate`,
+	`DROP TABLE IF EXISTS accounthashes`,
+	`DROP TABLE IF EXISTS resources`,
+	`DROP TABLE IF EXISTS onlineaccounts`,
+	`DROP TABLE IF EXISTS txtail`,
+	`DROP TABLE IF EXISTS onlineroundparamstail`,
+	`DROP TABLE IF EXISTS catchpointfirststageinfo`,
+	`DROP TABLE IF EXISTS unfinishedcatchpoints`,
+	`DROP TABLE IF EXISTS stateproofverification`,
+}
+
+// stagingTable describes a catchpoint staging table which replaces a live table once the
+// catchpoint is applied. Postgres keeps index and sequence names in the schema namespace,
+// so they are renamed along with the table to allow the next catchup to recreate them.
+type stagingTable struct {
+	staging   string
+	target    string
+	indexes   [][2]string
+	sequences [][2]string
+}
+
+var catchpointStagingTables = []stagingTable{
+	{
+		staging: "catchpointbalances",
+		target:  "accountbase",
+		indexes: [][2]string{
+			{"catchpointbalances_pkey", "accountbase_pkey"},
+			{"catchpointbalances_address_idx", "accountbase_address_idx"},
+			{"catchpointbalances_normbal_idx", "accountbase_normbal_idx"},
+		},
+		sequences: [][2]string{
+			{"catchpointbalances_addrid_seq", "accountbase_addrid_seq"},
+		},
+	},
+	{
+		staging: "catchpointassetcreators",
+		target:  "assetcreators",
+		indexes: [][2]string{{"catchpointassetcreators_pkey", "assetcreators_pkey"}},
+	},
+	{
+		staging: "catchpointaccounthashes",
+		target:  "accounthashes",
+		indexes: [][2]string{{"catchpointaccounthashes_pkey", "accounthashes_pkey"}},
+	},
+	{
+		staging: "catchpointresources",
+		target:  "resources",
+		indexes: [][2]string{{"catchpointresources_pkey", "resources_pkey"}},
+	},
+	{
+		staging: "catchpointkvstore",
+		target:  "kvstore",
+		indexes: [][2]string{{"catchpointkvstore_pkey", "kvstore_pkey"}},
+	},
+	{
+		staging: "catchpointstateproofverification",
+		target:  "stateproofverification",
+		indexes: [][2]string{{"catchpointstateproofverification_pkey", "stateproofverification_pkey"}},
+	},
+}
+
+// getSchemaVersion returns the schema version of the tracker database, or 0 if it was not set yet.
+func getSchemaVersion(ctx context.Context, e db.Executable) (version int32, err error) {
+	_, err = e.ExecContext(ctx, createVersionTable)
+	if err != nil {
+		return 0, err
+	}
+	err = e.QueryRowContext(ctx, "SELECT version FROM trackerdbversion WHERE id = $1", trackerDBVersionID).Scan(&version)
+	if err == sql.ErrNoRows {
+		return 0, nil
+	}
+	return
+}
+
+// setSchemaVersion sets the schema version of the tracker database.
+func setSchemaVersion(ctx context.Context, e db.Executable, version int32) (err error) {
+	_, err = e.ExecContext(ctx, createVersionTable)
+	if err != nil {
+		return err
+	}
+	_, err = e.ExecContext(ctx, "INSERT INTO trackerdbversion (id, version) VALUES ($1, $2) ON CONFLICT (id) DO UPDATE SET version = excluded.version", trackerDBVersionID, version)
+	return err
+}
+
+// accountsInit fills the database using tx with initAccounts if the
+// database has not been initialized yet.
+//
+// accountsInit returns nil if either it has initialized the database
+// correctly, or if the database has already been initialized.
+func accountsInit(ctx context.Context, tx *sql.Tx, initAccounts map[basics.Address]basics.AccountData, proto config.ConsensusParams) (newDatabase bool, err error) {
+	for _, tableCreate := range accountsSchema {
+		_, err = tx.ExecContext(ctx, tableCreate)
+		if err != nil {
+			return
+		}
+	}
+
+	// a failing statement would abort the whole transaction, so rather than relying on
+	// a constraint error, check whether the row was inserted.
+	res, err := tx.ExecContext(ctx, "INSERT INTO acctrounds (id, rnd) VALUES ('acctbase', 0) ON CONFLICT (id) DO NOTHING")
+	if err != nil {
+		return
+	}
+	inserted, err := res.RowsAffected()
+	if err != nil || inserted == 0 {
+		return false, err
+	}
+
+	insertAcct, err := tx.PrepareContext(ctx, "INSERT INTO accountbase (address, normalizedonlinebalance, data) VALUES ($1, $2, $3) RETURNING addrid")
+	if err != nil {
+		return true, err
+	}
+	defer insertAcct.Close()
+
+	insertResource, err := tx.PrepareContext(ctx, "INSERT INTO resources (addrid, aidx, data) VALUES ($1, $2, $3)")
+	if err != nil {
+		return true, err
+	}
+	defer insertResource.Close()
+
+	insertResourceCallback := func(ctx context.Context, addrid int64, cidx basics.CreatableIndex, rd *trackerdb.ResourcesData) error {
+		if rd == nil {
+			return nil
+		}
+		_, err0 := insertResource.ExecContext(ctx, addrid, cidx, protocol.Encode(rd))
+		return err0
+	}
+
+	var ot basics.OverflowTracker
+	var totals ledgercore.AccountTotals
+	for addr, data := range initAccounts {
+		// AccountDataResources consumes the resource maps of the account, so work on a copy
+		// rather than on the genesis data.
+		var accountData basics.AccountData
+		err = protocol.Decode(protocol.Encode(&data), &accountData) //nolint:gosec // Encode does not hold on to reference
+		if err != nil {
+			return true, err
+		}
+
+		var baseAccount trackerdb.BaseAccountData
+		baseAccount.SetAccountData(&accountData)
+		var normBalance sql.NullInt64
+		if nb := accountData.NormalizedOnlineBalance(proto); nb > 0 {
+			normBalance = sql.NullInt64{Int64: int64(nb), Valid: true}
+		}
+
+		var addrid int64
+		err = insertAcct.QueryRowContext(ctx, addr[:], normBalance, protocol.Encode(&baseAccount)).Scan(&addrid)
+		if err != nil {
+			return true, err
+		}
+		err = trackerdb.AccountDataResources(ctx, &accountData, addrid, insertResourceCallback)
+		if err != nil {
+			return true, err
+		}
+
+		ad := ledgercore.ToAccountData(data)
+		totals.AddAccount(proto, ad, &ot)
+	}
+
+	if ot.Overflowed {
+		return true, fmt.Errorf("overflow computing totals")
+	}
+
+	arw := NewAccountsPGReaderWriter(tx)
+	err = arw.AccountsPutTotals(totals, false)
+	if err != nil {
+		return true, err
+	}
+	return true, nil
+}
+
+func accountsCreateOnlineAccountsTable(ctx context.Context, tx *sql.Tx) error {
+	for _, stmt := range createOnlineAccountsTable {
+		_, err := tx.ExecContext(ctx, stmt)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// accountsCreateBoxTable creates the KVStore table for box-storage in the database.
+func accountsCreateBoxTable(ctx context.Context, tx *sql.Tx) error {
+	_, err := tx.ExecContext(ctx, createKVStoreTable("kvstore"))
+	return err
+}
+
+// performKVStoreNullBlobConversion scans keys with null blob value, and convert the value to `[]byte{}`.
+func performKVStoreNullBlobConversion(ctx context.Context, tx *sql.Tx) error {
+	_, err := tx.ExecContext(ctx, "UPDATE kvstore SET value = ''::bytea WHERE value IS NULL")
+	return err
+}
+
+func accountsCreateTxTailTable(ctx context.Context, tx *sql.Tx) (err error) {
+	for _, stmt := range createTxTailTable {
+		_, err = tx.ExecContext(ctx, stmt)
+		if err != nil {
+			return
+		}
+	}
+	return nil
+}
+
+func accountsCreateOnlineRoundParamsTable(ctx context.Context, tx *sql.Tx) (err error) {
+	for _, stmt := range createOnlineRoundParamsTable {
+		_, err = tx.ExecContext(ctx, stmt)
+		if err != nil {
+			return
+		}
+	}
+	return nil
+}
+
+func accountsCreateCatchpointFirstStageInfoTable(ctx context.Context, e db.Executable) error {
+	_, err := e.ExecContext(ctx, createCatchpointFirstStageInfoTable)
+	return err
+}
+
+func accountsCreateUnfinishedCatchpointsTable(ctx context.Context, e db.Executable) error {
+	_, err := e.ExecContext(ctx, createUnfinishedCatchpointsTable)
+	return err
+}
+
+func accountsCreateStateProofVerificationTable(ctx context.Context, e db.Executable) error {
+	_, err := e.ExecContext(ctx, createStateProofVerificationTable("stateproofverification"))
+	return err
+}
+
+func performTxTailTableMigration(ctx context.Context, tx *sql.Tx, blockDb db.Accessor) (err error) {
+	if tx == nil {
+		return nil
+	}
+
+	arw := NewAccountsPGReaderWriter(tx)
+	dbRound, err := arw.AccountsRound()
+	if err != nil {
+		return fmt.Errorf("latest block number cannot be retrieved : %w", err)
+	}
+
+	// load the latest MaxTxnLife rounds in the txtail and store these in the txtail.
+	// when migrating there is only MaxTxnLife blocks in the block DB
+	// since the original txTail.commmittedUpTo preserved only (rnd+1)-MaxTxnLife = 1000 blocks back
+	err = blockDb.Atomic(func(ctx context.Context, blockTx *sql.Tx) error {
+		latestBlockRound, blockErr := blockdb.BlockLatest(blockTx)
+		if blockErr != nil {
+			return fmt.Errorf("latest block number cannot be retrieved : %w", blockErr)
+		}
+		latestHdr, hdrErr := blockdb.BlockGetHdr(blockTx, dbRound)
+		if hdrErr != nil {
+			return fmt.Errorf("latest block header %d cannot be retrieved : %w", dbRound, hdrErr)
+		}
+
+		proto := config.Consensus[latestHdr.CurrentProtocol]
+		maxTxnLife := basics.Round(proto.MaxTxnLife)
+		deeperBlockHistory := basics.Round(proto.DeeperBlockHeaderHistory)
+		// firstRound is either maxTxnLife + deeperBlockHistory back from the latest for regular init
+		// or maxTxnLife + deeperBlockHistory + CatchpointLookback back for catchpoint apply.
+		// Try to check the earliest available and start from there.
+		firstRound := (latestBlockRound + 1).SubSaturate(maxTxnLife + deeperBlockHistory + basics.Round(proto.CatchpointLookback))
+		// we don't need to have the txtail for round 0.
+		if firstRound == basics.Round(0) {
+			firstRound++
+		}
+		if _, getErr := blockdb.BlockGet(blockTx, firstRound); getErr != nil {
+			// looks like not catchpoint but a regular migration, start from maxTxnLife + deeperBlockHistory back
+			firstRound = (latestBlockRound + 1).SubSaturate(maxTxnLife + deeperBlockHistory)
+			if firstRound == basics.Round(0) {
+				firstRound++
+			}
+		}
+		tailRounds := make([][]byte, 0, maxTxnLife)
+		for rnd := firstRound; rnd <= dbRound; rnd++ {
+			blk, getErr := blockdb.BlockGet(blockTx, rnd)
+			if getErr != nil {
+				return fmt.Errorf("block for round %d ( %d - %d ) cannot be retrieved : %w", rnd, firstRound, dbRound, getErr)
+			}
+
+			tail, tErr := trackerdb.TxTailRoundFromBlock(blk)
+			if tErr != nil {
+				return tErr
+			}
+
+			encodedTail, _ := tail.Encode()
+			tailRounds = append(tailRounds, encodedTail)
+		}
+
+		return arw.TxtailNewRound(ctx, firstRound, tailRounds, firstRound)
+	})
+
+	return err
+}
+
+func performOnlineRoundParamsTailMigration(ctx context.Context, tx *sql.Tx, blockDb db.Accessor, newDatabase bool, initProto protocol.ConsensusVersion) (err error) {
+	arw := NewAccountsPGReaderWriter(tx)
+	totals, err := arw.AccountsTotals(ctx, false)
+	if err != nil {
+		return err
+	}
+	rnd, err := arw.AccountsRound()
+	if err != nil {
+		return err
+	}
+	var currentProto protocol.ConsensusVersion
+	if newDatabase {
+		currentProto = initProto
+	} else {
+		err = blockDb.Atomic(func(ctx context.Context, blockTx *sql.Tx) error {
+			hdr, hdrErr := blockdb.BlockGetHdr(blockTx, rnd)
+			if hdrErr != nil {
+				return hdrErr
+			}
+			currentProto = hdr.CurrentProtocol
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+	onlineRoundParams := []ledgercore.OnlineRoundParamsData{
+		{
+			OnlineSupply:    totals.Online.Money.Raw,
+			RewardsLevel:    totals.RewardsLevel,
+			CurrentProtocol: currentProto,
+		},
+	}
+	return arw.AccountsPutOnlineRoundParams(onlineRoundParams, rnd)
+}
+
+func performOnlineAccountsTableMigration(ctx context.Context, tx *sql.Tx, progress func(processed, total uint64), log logging.Logger) (err error) {
+	var insertOnlineAcct *sql.Stmt
+	insertOnlineAcct, err = tx.PrepareContext(ctx, "INSERT INTO onlineaccounts(address, data, normalizedonlinebalance, updround, votelastvalid) VALUES($1, $2, $3, $4, $5)")
+	if err != nil {
+		return err
+	}
+	defer insertOnlineAcct.Close()
+
+	var updateAcct *sql.Stmt
+	updateAcct, err = tx.PrepareContext(ctx, "UPDATE accountbase SET data = $1 WHERE addrid = $2")
+	if err != nil {
+		return err
+	}
+	defer updateAcct.Close()
+
+	arw := NewAccountsPGReaderWriter(tx)
+	totalOnlineBaseAccounts, err := arw.TotalAccounts(ctx)
+	if err != nil {
+		return err
+	}
+
+	// the accounts are updated while being scanned, so iterate with a cursor.
+	rows, err := openCursor(ctx, tx, "SELECT addrid, address, data, normalizedonlinebalance FROM accountbase")
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	var processedAccounts uint64
+	checkSQLResult := func(e error, res sql.Result) error {
+		if e != nil {
+			return e
+		}
+		rowsAffected, err := res.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if rowsAffected != 1 {
+			return fmt.Errorf("number of affected rows is not 1 - %d", rowsAffected)
+		}
+		return nil
+	}
+
+	type acctState struct {
+		old    trackerdb.BaseAccountData
+		oldEnc []byte
+		new    trackerdb.BaseAccountData
+		newEnc []byte
+	}
+	acctRehash := make(map[basics.Address]acctState)
+	var addr basics.Address
+
+	for rows.Next() {
+		var addrid sql.NullInt64
+		var addrbuf []byte
+		var encodedAcctData []byte
+		var normBal sql.NullInt64
+		err = rows.Scan(&addrid, &addrbuf, &encodedAcctData, &normBal)
+		if err != nil {
+			return err
+		}
+		if len(addrbuf) != len(addr) {
+			err = fmt.Errorf("account DB address length mismatch: %d != %d", len(addrbuf), len(addr))
+			return err
+		}
+		var ba trackerdb.BaseAccountData
+		err = protocol.Decode(encodedAcctData, &ba)
+		if err != nil {
+			return err
+		}
+
+		// insert entries into online accounts table
+		if ba.Status == basics.Online {
+			if ba.MicroAlgos.Raw > 0 && !normBal.Valid {
+				copy(addr[:], addrbuf)
+				return fmt.Errorf("non valid norm balance for online account %s", addr.String())
+			}
+			var baseOnlineAD trackerdb.BaseOnlineAccountData
+			baseOnlineAD.BaseVotingData = ba.BaseVotingData
+			baseOnlineAD.MicroAlgos = ba.MicroAlgos
+			baseOnlineAD.RewardsBase = ba.RewardsBase
+			encodedOnlineAcctData := protocol.Encode(&baseOnlineAD)
+			insertRes, execErr := insertOnlineAcct.ExecContext(ctx, addrbuf, encodedOnlineAcctData, normBal.Int64, ba.UpdateRound, baseOnlineAD.VoteLastValid)
+			err = checkSQLResult(execErr, insertRes)
+			if err != nil {
+				return err
+			}
+		}
+
+		// remove stateproofID field for offline accounts
+		if ba.Status != basics.Online && !ba.StateProofID.IsEmpty() {
+			// store old data for account hash update
+			state := acctState{old: ba, oldEnc: encodedAcctData}
+			ba.StateProofID = merklesignature.Commitment{}
+			encodedOnlineAcctData := protocol.Encode(&ba)
+			copy(addr[:], addrbuf)
+			state.new = ba
+			state.newEnc = encodedOnlineAcctData
+			acctRehash[addr] = state
+			updateRes, execErr := updateAcct.ExecContext(ctx, encodedOnlineAcctData, addrid.Int64)
+			err = checkSQLResult(execErr, updateRes)
+			if err != nil {
+				return err
+			}
+		}
+
+		processedAccounts++
+		if progress != nil {
+			progress(processedAccounts, totalOnlineBaseAccounts)
+		}
+	}
+	if err = rows.Err(); err != nil {
+		return err
+	}
+
+	// update accounthashes for the modified accounts
+	if len(acctRehash) > 0 {
+		var count uint64
+		err := tx.QueryRowContext(ctx, "SELECT count(1) FROM accounthashes").Scan(&count)
+		if err != nil {
+			return err
+		}
+		if count == 0 {
+			// no account hashes, done
+			return nil
+		}
+
+		mc, err := MakeMerkleCommitter(tx, false)
+		if err != nil {
+			return nil
+		}
+
+		trie, err := merkletrie.MakeTrie(mc, trackerdb.TrieMemoryConfig)
+		if err != nil {
+			return fmt.Errorf("accountsInitialize was unable to MakeTrie: %v", err)
+		}
+		for addr, state := range acctRehash {
+			deleteHash := trackerdb.AccountHashBuilderV6(addr, &state.old, state.oldEnc)
+			deleted, delErr := trie.Delete(deleteHash)
+			if delErr != nil {
+				return fmt.Errorf("performOnlineAccountsTableMigration failed to delete hash '%s' from merkle trie for account %v: %w", hex.EncodeToString(deleteHash), addr, delErr)
+			}
+			if !deleted && log != nil {
+				log.Warnf("performOnlineAccountsTableMigration failed to delete hash '%s' from merkle trie for account %v", hex.EncodeToString(deleteHash), addr)
+			}
+
+			addHash := trackerdb.AccountHashBuilderV6(addr, &state.new, state.newEnc)
+			added, addErr := trie.Add(addHash)
+			if addErr != nil {
+				return fmt.Errorf("performOnlineAccountsTableMigration attempted to add duplicate hash '%s' to merkle trie for account %v: %w", hex.EncodeToString(addHash), addr, addErr)
+			}
+			if !added && log != nil {
+				log.Warnf("performOnlineAccountsTableMigration attempted to add duplicate hash '%s' to merkle trie for account %v", hex.EncodeToString(addHash), addr)
+			}
+		}
+		_, err = trie.Commit()
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func convertOnlineRoundParamsTail(ctx context.Context, tx *sql.Tx) error {
+	// create vote last index
+	_, err := tx.ExecContext(ctx, createVoteLastValidIndex)
+	return err
+}
@@ -0,0 +1,704 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package pgdriver
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/ledger/store/trackerdb"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/util/db"
+)
+
+// accountsDbQueries is used to cache a prepared SQL statement to look up
+// the state of a single account.
+type accountsDbQueries struct {
+	listCreatablesStmt     *sql.Stmt
+	lookupAccountStmt      *sql.Stmt
+	lookupResourcesStmt    *sql.Stmt
+	lookupAllResourcesStmt *sql.Stmt
+	lookupKvPairStmt       *sql.Stmt
+	lookupKeysByRangeStmt  *sql.Stmt
+	lookupCreatorStmt      *sql.Stmt
+}
+
+type onlineAccountsDbQueries struct {
+	lookupOnlineStmt        *sql.Stmt
+	lookupOnlineHistoryStmt *sql.Stmt
+	lookupOnlineTotalsStmt  *sql.Stmt
+}
+
+type accountsPGWriter struct {
+	insertCreatableIdxStmt, deleteCreatableIdxStmt             *sql.Stmt
+	deleteByRowIDStmt, insertStmt, updateStmt                  *sql.Stmt
+	deleteResourceStmt, insertResourceStmt, updateResourceStmt *sql.Stmt
+	deleteKvPairStmt, upsertKvPairStmt                         *sql.Stmt
+}
+
+type onlineAccountsPGWriter struct {
+	insertStmt *sql.Stmt
+}
+
+// pgRowRef references a row by the value of its serial key column:
+// addrid for accountbase, rowid for onlineaccounts.
+type pgRowRef struct {
+	rowid int64
+}
+
+func (ref pgRowRef) AccountRefMarker()       {}
+func (ref pgRowRef) OnlineAccountRefMarker() {}
+func (ref pgRowRef) ResourceRefMarker()      {}
+func (ref pgRowRef) CreatableRefMarker()     {}
+
+// AccountsInitDbQueries constructs an AccountsReader backed by postgres queries.
+func AccountsInitDbQueries(q db.Queryable) (*accountsDbQueries, error) {
+	var err error
+	qs := &accountsDbQueries{}
+
+	qs.listCreatablesStmt, err = q.Prepare("SELECT acctrounds.rnd, assetcreators.asset, assetcreators.creator FROM acctrounds LEFT JOIN assetcreators ON assetcreators.asset <= $1 AND assetcreators.ctype = $2 WHERE acctrounds.id='acctbase' ORDER BY assetcreators.asset DESC NULLS LAST LIMIT $3")
+	if err != nil {
+		return nil, err
+	}
+
+	qs.lookupAccountStmt, err = q.Prepare("SELECT accountbase.addrid, acctrounds.rnd, accountbase.data FROM acctrounds LEFT JOIN accountbase ON accountbase.address = $1 WHERE acctrounds.id='acctbase'")
+	if err != nil {
+		return nil, err
+	}
+
+	qs.lookupResourcesStmt, err = q.Prepare("SELECT accountbase.addrid, acctrounds.rnd, resources.data FROM acctrounds LEFT JOIN accountbase ON accountbase.address = $1 LEFT JOIN resources ON accountbase.addrid = resources.addrid AND resources.aidx = $2 WHERE acctrounds.id='acctbase'")
+	if err != nil {
+		return nil, err
+	}
+
+	qs.lookupAllResourcesStmt, err = q.Prepare("SELECT accountbase.addrid, acctrounds.rnd, resources.aidx, resources.data FROM acctrounds LEFT JOIN accountbase ON accountbase.address = $1 LEFT JOIN resources ON accountbase.addrid = resources.addrid WHERE acctrounds.id='acctbase'")
+	if err != nil {
+		return nil, err
+	}
+
+	qs.lookupKvPairStmt, err = q.Prepare("SELECT acctrounds.rnd, kvstore.key, kvstore.value FROM acctrounds LEFT JOIN kvstore ON kvstore.key = $1 WHERE acctrounds.id='acctbase'")
+	if err != nil {
+		return nil, err
+	}
+
+	qs.lookupKeysByRangeStmt, err = q.Prepare("SELECT acctrounds.rnd, kvstore.key FROM acctrounds LEFT JOIN kvstore ON kvstore.key >= $1 AND kvstore.key < $2 WHERE acctrounds.id='acctbase'")
+	if err != nil {
+		return nil, err
+	}
+
+	qs.lookupCreatorStmt, err = q.Prepare("SELECT acctrounds.rnd, assetcreators.creator FROM acctrounds LEFT JOIN assetcreators ON assetcreators.asset = $1 AND assetcreators.ctype = $2 WHERE acctrounds.id='acctbase'")
+	if err != nil {
+		return nil, err
+	}
+
+	return qs, nil
+}
+
+// OnlineAccountsInitDbQueries constructs an OnlineAccountsReader backed by postgres queries.
+func OnlineAccountsInitDbQueries(r db.Queryable) (*onlineAccountsDbQueries, error) {
+	var err error
+	qs := &onlineAccountsDbQueries{}
+
+	qs.lookupOnlineStmt, err = r.Prepare("SELECT onlineaccounts.rowid, onlineaccounts.updround, acctrounds.rnd, onlineaccounts.data FROM acctrounds LEFT JOIN onlineaccounts ON onlineaccounts.address = $1 AND onlineaccounts.updround <= $2 WHERE acctrounds.id='acctbase' ORDER BY onlineaccounts.updround DESC NULLS LAST LIMIT 1")
+	if err != nil {
+		return nil, err
+	}
+
+	qs.lookupOnlineHistoryStmt, err = r.Prepare("SELECT onlineaccounts.rowid, onlineaccounts.updround, acctrounds.rnd, onlineaccounts.data FROM acctrounds LEFT JOIN onlineaccounts ON onlineaccounts.address = $1 WHERE acctrounds.id='acctbase' ORDER BY onlineaccounts.updround ASC")
+	if err != nil {
+		return nil, err
+	}
+
+	qs.lookupOnlineTotalsStmt, err = r.Prepare("SELECT data FROM onlineroundparamstail WHERE rnd = $1")
+	if err != nil {
+		return nil, err
+	}
+	return qs, nil
+}
+
+// MakeOnlineAccountsPGWriter constructs an OnlineAccountsWriter backed by postgres queries.
+func MakeOnlineAccountsPGWriter(tx *sql.Tx, hasAccounts bool) (w *onlineAccountsPGWriter, err error) {
+	w = new(onlineAccountsPGWriter)
+
+	if hasAccounts {
+		w.insertStmt, err = tx.Prepare("INSERT INTO onlineaccounts (address, normalizedonlinebalance, data, updround, votelastvalid) VALUES ($1, $2, $3, $4, $5) RETURNING rowid")
+		if err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// MakeAccountsPGWriter constructs an AccountsWriter backed by postgres queries.
+func MakeAccountsPGWriter(tx *sql.Tx, hasAccounts, hasResources, hasKvPairs, hasCreatables bool) (w *accountsPGWriter, err error) {
+	w = new(accountsPGWriter)
+
+	if hasAccounts {
+		w.deleteByRowIDStmt, err = tx.Prepare("DELETE FROM accountbase WHERE addrid = $1")
+		if err != nil {
+			return
+		}
+
+		w.insertStmt, err = tx.Prepare("INSERT INTO accountbase (address, normalizedonlinebalance, data) VALUES ($1, $2, $3) RETURNING addrid")
+		if err != nil {
+			return
+		}
+
+		w.updateStmt, err = tx.Prepare("UPDATE accountbase SET normalizedonlinebalance = $1, data = $2 WHERE addrid = $3")
+		if err != nil {
+			return
+		}
+	}
+
+	if hasResources {
+		w.deleteResourceStmt, err = tx.Prepare("DELETE FROM resources WHERE addrid = $1 AND aidx = $2")
+		if err != nil {
+			return
+		}
+
+		w.insertResourceStmt, err = tx.Prepare("INSERT INTO resources (addrid, aidx, data) VALUES ($1, $2, $3)")
+		if err != nil {
+			return
+		}
+
+		w.updateResourceStmt, err = tx.Prepare("UPDATE resources SET data = $1 WHERE addrid = $2 AND aidx = $3")
+		if err != nil {
+			return
+		}
+	}
+
+	if hasKvPairs {
+		w.upsertKvPairStmt, err = tx.Prepare("INSERT INTO kvstore (key, value) VALUES ($1, $2) ON CONFLICT (key) DO UPDATE SET value = excluded.value")
+		if err != nil {
+			return
+		}
+
+		w.deleteKvPairStmt, err = tx.Prepare("DELETE FROM kvstore WHERE key = $1")
+		if err != nil {
+			return
+		}
+	}
+
+	if hasCreatables {
+		w.insertCreatableIdxStmt, err = tx.Prepare("INSERT INTO assetcreators (asset, creator, ctype) VALUES ($1, $2, $3)")
+		if err != nil {
+			return
+		}
+
+		w.deleteCreatableIdxStmt, err = tx.Prepare("DELETE FROM assetcreators WHERE asset = $1 AND ctype = $2")
+		if err != nil {
+			return
+		}
+	}
+	return
+}
+
+// ListCreatables returns an array of CreatableLocator which have CreatableIndex smaller or equal to maxIdx and are of the provided CreatableType.
+func (qs *accountsDbQueries) ListCreatables(maxIdx basics.CreatableIndex, maxResults uint64, ctype basics.CreatableType) (results []basics.CreatableLocator, dbRound basics.Round, err error) {
+	// Query for assets in range
+	rows, err := qs.listCreatablesStmt.Query(maxIdx, ctype, maxResults)
+	if err != nil {
+		return
+	}
+	defer rows.Close()
+
+	// For each row, copy into a new CreatableLocator and append to results
+	var buf []byte
+	var cl basics.CreatableLocator
+	var creatableIndex sql.NullInt64
+	for rows.Next() {
+		err = rows.Scan(&dbRound, &creatableIndex, &buf)
+		if err != nil {
+			return
+		}
+		if !creatableIndex.Valid {
+			// we received an entry without any index. This would happen only on the first entry when there are no creatables of the requested type.
+			break
+		}
+		cl.Index = basics.CreatableIndex(creatableIndex.Int64)
+		copy(cl.Creator[:], buf)
+		cl.Type = ctype
+		results = append(results, cl)
+	}
+	err = rows.Err()
+	return
+}
+
+// LookupKeyValue returns the application boxed value associated with the key.
+func (qs *accountsDbQueries) LookupKeyValue(key string) (pv trackerdb.PersistedKVData, err error) {
+	var rawkey []byte
+	var val []byte
+	// keys are stored as bytea, cast to []byte so that the driver does not treat them as text.
+	err = qs.lookupKvPairStmt.QueryRow([]byte(key)).Scan(&pv.Round, &rawkey, &val)
+	if err != nil {
+		// this should never happen; it indicates that we don't have a current round in the acctrounds table.
+		if err == sql.ErrNoRows {
+			// Return the zero value of data
+			err = fmt.Errorf("unable to query value for key %v : %w", key, err)
+		}
+		return
+	}
+	if rawkey != nil { // We got a non-null key, so it exists
+		if val == nil {
+			val = []byte{}
+		}
+		pv.Value = val
+	}
+	// otherwise we don't have that key, just return pv with the database round (pv.value==nil)
+	return
+}
+
+// LookupKeysByPrefix returns a set of application boxed values matching the prefix.
+func (qs *accountsDbQueries) LookupKeysByPrefix(prefix string, maxKeyNum uint64, results map[string]bool, resultCount uint64) (round basics.Round, err error) {
+	start, end := keyPrefixIntervalPreprocessing([]byte(prefix))
+	if end == nil {
+		// Not an expected use case, it's asking for all keys, or all keys
+		// prefixed by some number of 0xFF bytes.
+		return 0, fmt.Errorf("lookup by strange prefix %#v", prefix)
+	}
+	rows, err := qs.lookupKeysByRangeStmt.Query(start, end)
+	if err != nil {
+		return
+	}
+	defer rows.Close()
+
+	var v []byte
+	for rows.Next() {
+		if resultCount == maxKeyNum {
+			return
+		}
+		err = rows.Scan(&round, &v)
+		if err != nil {
+			return
+		}
+		if v != nil {
+			if _, ok := results[string(v)]; ok {
+				continue
+			}
+			results[string(v)] = true
+			resultCount++
+		}
+	}
+	err = rows.Err()
+	return
+}
+
+// keyPrefixIntervalPreprocessing generates the [prefix, prefixIncr) interval matching all the keys
+// starting with prefix, so that the lookup can be answered by a range scan on the kvstore primary key.
+// bytea values compare byte by byte, the same way sqlite compares blobs.
+// When the prefix is empty or made only of 0xFF bytes there is no upper limit, and nil is returned for prefixIncr.
+func keyPrefixIntervalPreprocessing(prefix []byte) ([]byte, []byte) {
+	if prefix == nil {
+		prefix = []byte{}
+	}
+	prefixIncr := make([]byte, len(prefix))
+	copy(prefixIncr, prefix)
+	for i := len(prefix) - 1; i >= 0; i-- {
+		currentByteIncr := int(prefix[i]) + 1
+		if currentByteIncr > 0xFF {
+			prefixIncr = prefixIncr[:len(prefixIncr)-1]
+			continue
+		}
+		prefixIncr[i] = byte(currentByteIncr)
+		return prefix, prefixIncr
+	}
+	return prefix, nil
+}
+
+// LookupCreator returns the address and round of the creator.
+func (qs *accountsDbQueries) LookupCreator(cidx basics.CreatableIndex, ctype basics.CreatableType) (addr basics.Address, ok bool, dbRound basics.Round, err error) {
+	var buf []byte
+	err = qs.lookupCreatorStmt.QueryRow(cidx, ctype).Scan(&dbRound, &buf)
+
+	// this shouldn't happen unless we can't figure the round number.
+	if err == sql.ErrNoRows {
+		err = fmt.Errorf("lookupCreator was unable to retrieve round number")
+		return
+	}
+
+	// Some other database error
+	if err != nil {
+		return
+	}
+
+	if len(buf) > 0 {
+		ok = true
+		copy(addr[:], buf)
+	}
+	return
+}
+
+// LookupResources returns the requested resource.
+func (qs *accountsDbQueries) LookupResources(addr basics.Address, aidx basics.CreatableIndex, ctype basics.CreatableType) (data trackerdb.PersistedResourcesData, err error) {
+	var buf []byte
+	var rowid sql.NullInt64
+	err = qs.lookupResourcesStmt.QueryRow(addr[:], aidx).Scan(&rowid, &data.Round, &buf)
+	if err == nil {
+		data.Aidx = aidx
+		if len(buf) > 0 && rowid.Valid {
+			data.AcctRef = pgRowRef{rowid.Int64}
+			err = protocol.Decode(buf, &data.Data)
+			if err != nil {
+				return
+			}
+			if ctype == basics.AssetCreatable && !data.Data.IsAsset() {
+				err = fmt.Errorf("lookupResources asked for an asset but got %v", data.Data)
+				return
+			}
+			if ctype == basics.AppCreatable && !data.Data.IsApp() {
+				err = fmt.Errorf("lookupResources asked for an app but got %v", data.Data)
+				return
+			}
+			return
+		}
+		data.Data = trackerdb.MakeResourcesData(0)
+		// we don't have that account, just return the database round.
+		return
+	}
+
+	// this should never happen; it indicates that we don't have a current round in the acctrounds table.
+	if err == sql.ErrNoRows {
+		// Return the zero value of data
+		err = fmt.Errorf("unable to query resource data for address %v aidx %v ctype %v : %w", addr, aidx, ctype, err)
+	}
+	return
+}
+
+// LookupAllResources returns all resources associated with the given address.
+func (qs *accountsDbQueries) LookupAllResources(addr basics.Address) (data []trackerdb.PersistedResourcesData, rnd basics.Round, err error) {
+	// Query for all resources
+	rows, err := qs.lookupAllResourcesStmt.Query(addr[:])
+	if err != nil {
+		return
+	}
+	defer rows.Close()
+
+	var addrid, aidx sql.NullInt64
+	var dbRound basics.Round
+	var buf []byte
+	for rows.Next() {
+		err = rows.Scan(&addrid, &dbRound, &aidx, &buf)
+		if err != nil {
+			return
+		}
+		if !addrid.Valid || !aidx.Valid {
+			// we received an entry without any index. This would happen only on the first entry when there are no resources for this address.
+			// ensure this is the first entry, set the round and return
+			if len(data) != 0 {
+				err = fmt.Errorf("lookupAllResources: unexpected invalid result on non-first resource record: (%v, %v)", addrid.Valid, aidx.Valid)
+				return
+			}
+			rnd = dbRound
+			break
+		}
+		var resData trackerdb.ResourcesData
+		err = protocol.Decode(buf, &resData)
+		if err != nil {
+			return
+		}
+		data = append(data, trackerdb.PersistedResourcesData{
+			AcctRef: pgRowRef{addrid.Int64},
+			Aidx:    basics.CreatableIndex(aidx.Int64),
+			Data:    resData,
+			Round:   dbRound,
+		})
+		rnd = dbRound
+	}
+	err = rows.Err()
+	return
+}
+
+// LookupAccount looks up for a the account data given it's address. It returns the persistedAccountData, which includes the current database round and the matching
+// account data, if such was found. If no matching account data could be found for the given address, an empty account data would
+// be retrieved.
+func (qs *accountsDbQueries) LookupAccount(addr basics.Address) (data trackerdb.PersistedAccountData, err error) {
+	var buf []byte
+	var rowid sql.NullInt64
+	err = qs.lookupAccountStmt.QueryRow(addr[:]).Scan(&rowid, &data.Round, &buf)
+	if err == nil {
+		data.Addr = addr
+		if len(buf) > 0 && rowid.Valid {
+			data.Ref = pgRowRef{rowid.Int64}
+			err = protocol.Decode(buf, &data.AccountData)
+		}
+		// otherwise we don't have that account, just return the database round.
+		return
+	}
+
+	// this should never happen; it indicates that we don't have a current round in the acctrounds table.
+	if err == sql.ErrNoRows {
+		// Return the zero value of data
+		err = fmt.Errorf("unable to query account data for address %v : %w", addr, err)
+	}
+	return
+}
+
+// LookupOnline returns the online account data for the given address.
+func (qs *onlineAccountsDbQueries) LookupOnline(addr basics.Address, rnd basics.Round) (data trackerdb.PersistedOnlineAccountData, err error) {
+	var buf []byte
+	var rowid sql.NullInt64
+	var updround sql.NullInt64
+	err = qs.lookupOnlineStmt.QueryRow(addr[:], rnd).Scan(&rowid, &updround, &data.Round, &buf)
+	if err == nil {
+		data.Addr = addr
+		if len(buf) > 0 && rowid.Valid && updround.Valid {
+			data.Ref = pgRowRef{rowid.Int64}
+			data.UpdRound = basics.Round(updround.Int64)
+			err = protocol.Decode(buf, &data.AccountData)
+		}
+		// otherwise we don't have that account, just return the database round.
+		return
+	}
+
+	// this should never happen; it indicates that we don't have a current round in the acctrounds table.
+	if err == sql.ErrNoRows {
+		// Return the zero value of data
+		err = fmt.Errorf("unable to query online account data for address %v : %w", addr, err)
+	}
+	return
+}
+
+func (qs *onlineAccountsDbQueries) LookupOnlineTotalsHistory(round basics.Round) (basics.MicroAlgos, error) {
+	data := ledgercore.OnlineRoundParamsData{}
+	var buf []byte
+	err := qs.lookupOnlineTotalsStmt.QueryRow(round).Scan(&buf)
+	if err != nil {
+		return basics.MicroAlgos{}, err
+	}
+	err = protocol.Decode(buf, &data)
+	if err != nil {
+		return basics.MicroAlgos{}, err
+	}
+	return basics.MicroAlgos{Raw: data.OnlineSupply}, nil
+}
+
+func (qs *onlineAccountsDbQueries) LookupOnlineHistory(addr basics.Address) (result []trackerdb.PersistedOnlineAccountData, rnd basics.Round, err error) {
+	rows, err := qs.lookupOnlineHistoryStmt.Query(addr[:])
+	if err != nil {
+		return
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var buf []byte
+		var rowid, updround sql.NullInt64
+		err = rows.Scan(&rowid, &updround, &rnd, &buf)
+		if err != nil {
+			return
+		}
+		if !rowid.Valid {
+			// the left join returned the round alone, since the account has no history.
+			continue
+		}
+		data := trackerdb.PersistedOnlineAccountData{
+			Addr:     addr,
+			Ref:      pgRowRef{rowid.Int64},
+			UpdRound: basics.Round(updround.Int64),
+		}
+		err = protocol.Decode(buf, &data.AccountData)
+		if err != nil {
+			return
+		}
+		result = append(result, data)
+	}
+	err = rows.Err()
+	return
+}
+
+func (qs *accountsDbQueries) Close() {
+	preparedQueries := []**sql.Stmt{
+		&qs.listCreatablesStmt,
+		&qs.lookupAccountStmt,
+		&qs.lookupResourcesStmt,
+		&qs.lookupAllResourcesStmt,
+		&qs.lookupKvPairStmt,
+		&qs.lookupKeysByRangeStmt,
+		&qs.lookupCreatorStmt,
+	}
+	for _, preparedQuery := range preparedQueries {
+		if (*preparedQuery) != nil {
+			(*preparedQuery).Close()
+			*preparedQuery = nil
+		}
+	}
+}
+
+func (qs *onlineAccountsDbQueries) Close() {
+	preparedQueries := []**sql.Stmt{
+		&qs.lookupOnlineStmt,
+		&qs.lookupOnlineHistoryStmt,
+		&qs.lookupOnlineTotalsStmt,
+	}
+	for _, preparedQuery := range preparedQueries {
+		if (*preparedQuery) != nil {
+			(*preparedQuery).Close()
+			*preparedQuery = nil
+		}
+	}
+}
+
+func (w *accountsPGWriter) Close() {
+	// Formatted to match the type definition above
+	preparedStmts := []**sql.Stmt{
+		&w.insertCreatableIdxStmt, &w.deleteCreatableIdxStmt,
+		&w.deleteByRowIDStmt, &w.insertStmt, &w.updateStmt,
+		&w.deleteResourceStmt, &w.insertResourceStmt, &w.updateResourceStmt,
+		&w.deleteKvPairStmt, &w.upsertKvPairStmt,
+	}
+
+	for _, stmt := range preparedStmts {
+		if (*stmt) != nil {
+			(*stmt).Close()
+			*stmt = nil
+		}
+	}
+}
+
+func (w *onlineAccountsPGWriter) Close() {
+	if w.insertStmt != nil {
+		w.insertStmt.Close()
+		w.insertStmt = nil
+	}
+}
+
+func (w accountsPGWriter) InsertAccount(addr basics.Address, normBalance uint64, data trackerdb.BaseAccountData) (ref trackerdb.AccountRef, err error) {
+	var rowid int64
+	err = w.insertStmt.QueryRow(addr[:], normBalance, protocol.Encode(&data)).Scan(&rowid)
+	if err != nil {
+		return
+	}
+	return pgRowRef{rowid}, nil
+}
+
+func (w accountsPGWriter) DeleteAccount(ref trackerdb.AccountRef) (rowsAffected int64, err error) {
+	if ref == nil {
+		return 0, nil
+	}
+	rowid := ref.(pgRowRef).rowid
+	result, err := w.deleteByRowIDStmt.Exec(rowid)
+	if err != nil {
+		return
+	}
+	rowsAffected, err = result.RowsAffected()
+	return
+}
+
+func (w accountsPGWriter) UpdateAccount(ref trackerdb.AccountRef, normBalance uint64, data trackerdb.BaseAccountData) (rowsAffected int64, err error) {
+	if ref == nil {
+		err = sql.ErrNoRows
+		return 0, fmt.Errorf("no account could be found for rowid = nil: %w", err)
+	}
+	rowid := ref.(pgRowRef).rowid
+	result, err := w.updateStmt.Exec(normBalance, protocol.Encode(&data), rowid)
+	if err != nil {
+		return
+	}
+	rowsAffected, err = result.RowsAffected()
+	return
+}
+
+// InsertResource inserts a resource of the given account.
+// Resources are keyed by (addrid, aidx) and have no row id of their own, so the returned
+// ref is the one of the owning account.
+func (w accountsPGWriter) InsertResource(accountRef trackerdb.AccountRef, aidx basics.CreatableIndex, data trackerdb.ResourcesData) (ref trackerdb.ResourceRef, err error) {
+	if accountRef == nil {
+		err = sql.ErrNoRows
+		return nil, fmt.Errorf("no account could be found for rowid = nil: %w", err)
+	}
+	addrid := accountRef.(pgRowRef).rowid
+	_, err = w.insertResourceStmt.Exec(addrid, aidx, protocol.Encode(&data))
+	if err != nil {
+		return
+	}
+	return pgRowRef{addrid}, nil
+}
+
+func (w accountsPGWriter) DeleteResource(accountRef trackerdb.AccountRef, aidx basics.CreatableIndex) (rowsAffected int64, err error) {
+	if accountRef == nil {
+		err = sql.ErrNoRows
+		return 0, fmt.Errorf("no account could be found for rowid = nil: %w", err)
+	}
+	addrid := accountRef.(pgRowRef).rowid
+	result, err := w.deleteResourceStmt.Exec(addrid, aidx)
+	if err != nil {
+		return
+	}
+	rowsAffected, err = result.RowsAffected()
+	return
+}
+
+func (w accountsPGWriter) UpdateResource(accountRef trackerdb.AccountRef, aidx basics.CreatableIndex, data trackerdb.ResourcesData) (rowsAffected int64, err error) {
+	if accountRef == nil {
+		err = sql.ErrNoRows
+		return 0, fmt.Errorf("no account could be found for rowid = nil: %w", err)
+	}
+	addrid := accountRef.(pgRowRef).rowid
+	result, err := w.updateResourceStmt.Exec(protocol.Encode(&data), addrid, aidx)
+	if err != nil {
+		return
+	}
+	rowsAffected, err = result.RowsAffected()
+	return
+}
+
+func (w accountsPGWriter) UpsertKvPair(key string, value []byte) error {
+	// The key might contain 0-bytes (e.g. box keys embed the app id), so it is
+	// passed as []byte to be stored as bytea rather than text.
+	// A nil value would be stored as NULL, while the value of an existing empty box is an empty slice.
+	if value == nil {
+		value = []byte{}
+	}
+	_, err := w.upsertKvPairStmt.Exec([]byte(key), value)
+	return err
+}
+
+func (w accountsPGWriter) DeleteKvPair(key string) error {
+	// Cast to []byte to avoid interpretation as character string, see note in UpsertKvPair
+	_, err := w.deleteKvPairStmt.Exec([]byte(key))
+	return err
+}
+
+// InsertCreatable inserts a creatable. The asset column is the key of the
+// assetcreators table, so the creatable index is used as the returned ref.
+func (w accountsPGWriter) InsertCreatable(cidx basics.CreatableIndex, ctype basics.CreatableType, creator []byte) (ref trackerdb.CreatableRef, err error) {
+	_, err = w.insertCreatableIdxStmt.Exec(cidx, creator, ctype)
+	if err != nil {
+		return
+	}
+	return pgRowRef{int64(cidx)}, nil
+}
+
+func (w accountsPGWriter) DeleteCreatable(cidx basics.CreatableIndex, ctype basics.CreatableType) (rowsAffected int64, err error) {
+	result, err := w.deleteCreatableIdxStmt.Exec(cidx, ctype)
+	if err != nil {
+		return
+	}
+	rowsAffected, err = result.RowsAffected()
+	return
+}
+
+func (w onlineAccountsPGWriter) InsertOnlineAccount(addr basics.Address, normBalance uint64, data trackerdb.BaseOnlineAccountData, updRound uint64, voteLastValid uint64) (ref trackerdb.OnlineAccountRef, err error) {
+	var rowid int64
+	err = w.insertStmt.QueryRow(addr[:], normBalance, protocol.Encode(&data), updRound, voteLastValid).Scan(&rowid)
+	if err != nil {
+		return
+	}
+	return pgRowRef{rowid}, nil
+}
@@ -0,0 +1,380 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package pgdriver
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/stdlib"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/ledger/store/trackerdb"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/util/db"
+)
+
+// maxTransactionAttempts is the number of times a transaction is attempted
+// when postgres aborts it because of a serialization failure or a deadlock.
+const maxTransactionAttempts = 5
+
+type trackerPGStore struct {
+	handle *sql.DB
+	log    logging.Logger
+
+	// asyncCommit is set when the requested synchronous mode allows committing
+	// without waiting for the WAL to be flushed.
+	asyncCommit atomic.Bool
+
+	// testSchema is the schema created for this store by OpenTrackerTestStore, if any.
+	testSchema string
+}
+
+type pgBatchScope struct {
+	tx *sql.Tx
+}
+
+type pgSnapshotScope struct {
+	tx *sql.Tx
+}
+
+type pgTransactionScope struct {
+	tx *sql.Tx
+}
+
+// OpenTrackerPGStore opens the tracker store on the PostgreSQL database described by dsn.
+func OpenTrackerPGStore(dsn string) (store *trackerPGStore, err error) {
+	connConfig, err := pgx.ParseConfig(dsn)
+	if err != nil {
+		return nil, fmt.Errorf("OpenTrackerPGStore unable to parse connection string: %w", err)
+	}
+	return openTrackerPGStore(connConfig)
+}
+
+func openTrackerPGStore(connConfig *pgx.ConnConfig) (*trackerPGStore, error) {
+	handle := stdlib.OpenDB(*connConfig)
+	err := handle.Ping()
+	if err != nil {
+		handle.Close()
+		return nil, fmt.Errorf("OpenTrackerPGStore unable to connect to %s:%d: %w", connConfig.Host, connConfig.Port, err)
+	}
+	return &trackerPGStore{handle: handle, log: logging.Base()}, nil
+}
+
+// SetLogger sets the Logger, mainly for unit test quietness
+func (s *trackerPGStore) SetLogger(log logging.Logger) {
+	s.log = log
+}
+
+// SetSynchronousMode maps the sqlite synchronous modes onto postgres' synchronous_commit.
+// Anything below SynchronousModeNormal commits asynchronously: a crash may lose the latest
+// transactions, but never corrupts the database.
+func (s *trackerPGStore) SetSynchronousMode(ctx context.Context, mode db.SynchronousMode, fullfsync bool) (err error) {
+	if mode < db.SynchronousModeOff || mode > db.SynchronousModeExtra {
+		return fmt.Errorf("invalid value(%d) was provided to mode", mode)
+	}
+	s.asyncCommit.Store(mode < db.SynchronousModeNormal)
+	return nil
+}
+
+func (s *trackerPGStore) IsSharedCacheConnection() bool {
+	return false
+}
+
+func (s *trackerPGStore) Batch(fn trackerdb.BatchFn) (err error) {
+	return s.BatchContext(context.Background(), fn)
+}
+
+func (s *trackerPGStore) BatchContext(ctx context.Context, fn trackerdb.BatchFn) (err error) {
+	return s.atomic(ctx, nil, func(ctx context.Context, tx *sql.Tx) error {
+		return fn(ctx, pgBatchScope{tx})
+	})
+}
+
+func (s *trackerPGStore) Snapshot(fn trackerdb.SnapshotFn) (err error) {
+	return s.SnapshotContext(context.Background(), fn)
+}
+
+func (s *trackerPGStore) SnapshotContext(ctx context.Context, fn trackerdb.SnapshotFn) (err error) {
+	opts := &sql.TxOptions{Isolation: sql.LevelRepeatableRead, ReadOnly: true}
+	return s.atomic(ctx, opts, func(ctx context.Context, tx *sql.Tx) error {
+		return fn(ctx, pgSnapshotScope{tx})
+	})
+}
+
+func (s *trackerPGStore) Transaction(fn trackerdb.TransactionFn) (err error) {
+	return s.TransactionContext(context.Background(), fn)
+}
+
+func (s *trackerPGStore) TransactionContext(ctx context.Context, fn trackerdb.TransactionFn) (err error) {
+	return s.atomic(ctx, nil, func(ctx context.Context, tx *sql.Tx) error {
+		return fn(ctx, pgTransactionScope{tx})
+	})
+}
+
+// atomic executes fn within a transaction, retrying it when postgres reports
+// that the transaction could not be serialized.
+func (s *trackerPGStore) atomic(ctx context.Context, opts *sql.TxOptions, fn func(ctx context.Context, tx *sql.Tx) error) (err error) {
+	for attempt := 1; ; attempt++ {
+		err = s.atomicOnce(ctx, opts, fn)
+		if err == nil || !isRetryableError(err) || attempt == maxTransactionAttempts {
+			return err
+		}
+		s.log.Infof("trackerPGStore.atomic: retrying transaction (attempt %d): %v", attempt, err)
+	}
+}
+
+func (s *trackerPGStore) atomicOnce(ctx context.Context, opts *sql.TxOptions, fn func(ctx context.Context, tx *sql.Tx) error) (err error) {
+	tx, err := s.handle.BeginTx(ctx, opts)
+	if err != nil {
+		return err
+	}
+	committed := false
+	defer func() {
+		if !committed {
+			tx.Rollback()
+		}
+	}()
+
+	if (opts == nil || !opts.ReadOnly) && s.asyncCommit.Load() {
+		_, err = tx.ExecContext(ctx, "SET LOCAL synchronous_commit TO OFF")
+		if err != nil {
+			return err
+		}
+	}
+
+	err = fn(ctx, tx)
+	if err != nil {
+		return err
+	}
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
+	committed = true
+	return nil
+}
+
+// isRetryableError returns true for the errors that abort a transaction
+// only because of its interaction with concurrent transactions.
+func isRetryableError(err error) bool {
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) {
+		return false
+	}
+	switch pgErr.Code {
+	case "40001", // serialization_failure
+		"40P01": // deadlock_detected
+		return true
+	}
+	return false
+}
+
+func (s *trackerPGStore) MakeAccountsOptimizedReader() (trackerdb.AccountsReader, error) {
+	return AccountsInitDbQueries(s.handle)
+}
+
+func (s *trackerPGStore) MakeOnlineAccountsOptimizedReader() (trackerdb.OnlineAccountsReader, error) {
+	return OnlineAccountsInitDbQueries(s.handle)
+}
+
+func (s *trackerPGStore) MakeCatchpointReaderWriter() (trackerdb.CatchpointReaderWriter, error) {
+	return NewCatchpointPGReaderWriter(s.handle), nil
+}
+
+// Vacuum reclaims the space used by dead rows and refreshes the planner statistics.
+// Postgres does not report page counts the way sqlite does, so the returned stats are empty.
+func (s *trackerPGStore) Vacuum(ctx context.Context) (stats db.VacuumStats, err error) {
+	_, err = s.handle.ExecContext(ctx, "VACUUM ANALYZE")
+	return
+}
+
+func (s *trackerPGStore) CleanupTest(dbName string, inMemory bool) {
+	if s.testSchema != "" {
+		_, err := s.handle.Exec("DROP SCHEMA IF EXISTS " + s.testSchema + " CASCADE")
+		if err != nil {
+			s.log.Warnf("trackerPGStore.CleanupTest unable to drop schema %s: %v", s.testSchema, err)
+		}
+	}
+	s.handle.Close()
+}
+
+func (s *trackerPGStore) ResetToV6Test(ctx context.Context) error {
+	var resetExprs = []string{
+		`DROP TABLE IF EXISTS onlineaccounts`,
+		`DROP TABLE IF EXISTS txtail`,
+		`DROP TABLE IF EXISTS onlineroundparamstail`,
+		`DROP TABLE IF EXISTS catchpointfirststageinfo`,
+	}
+
+	return s.atomic(ctx, nil, func(ctx context.Context, tx *sql.Tx) error {
+		for _, stmt := range resetExprs {
+			_, err := tx.ExecContext(ctx, stmt)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (s *trackerPGStore) Close() {
+	s.handle.Close()
+}
+
+// Testing returns this scope, exposed as an interface with test functions
+func (txs pgTransactionScope) Testing() trackerdb.TestTransactionScope {
+	return txs
+}
+
+func (txs pgTransactionScope) MakeCatchpointReaderWriter() (trackerdb.CatchpointReaderWriter, error) {
+	return NewCatchpointPGReaderWriter(txs.tx), nil
+}
+
+func (txs pgTransactionScope) MakeAccountsReaderWriter() (trackerdb.AccountsReaderWriter, error) {
+	return NewAccountsPGReaderWriter(txs.tx), nil
+}
+
+// implements Testing interface
+func (txs pgTransactionScope) MakeAccountsOptimizedReader() (trackerdb.AccountsReader, error) {
+	return AccountsInitDbQueries(txs.tx)
+}
+
+func (txs pgTransactionScope) MakeAccountsOptimizedWriter(hasAccounts, hasResources, hasKvPairs, hasCreatables bool) (trackerdb.AccountsWriter, error) {
+	return MakeAccountsPGWriter(txs.tx, hasAccounts, hasResources, hasKvPairs, hasCreatables)
+}
+
+func (txs pgTransactionScope) MakeOnlineAccountsOptimizedWriter(hasAccounts bool) (w trackerdb.OnlineAccountsWriter, err error) {
+	return MakeOnlineAccountsPGWriter(txs.tx, hasAccounts)
+}
+
+// implements Testing interface
+func (txs pgTransactionScope) MakeOnlineAccountsOptimizedReader() (r trackerdb.OnlineAccountsReader, err error) {
+	return OnlineAccountsInitDbQueries(txs.tx)
+}
+
+func (txs pgTransactionScope) MakeMerkleCommitter(staging bool) (trackerdb.MerkleCommitter, error) {
+	return MakeMerkleCommitter(txs.tx, staging)
+}
+
+func (txs pgTransactionScope) MakeOrderedAccountsIter(accountCount int) trackerdb.OrderedAccountsIter {
+	return MakeOrderedAccountsIter(txs.tx, accountCount)
+}
+
+func (txs pgTransactionScope) MakeKVsIter(ctx context.Context) (trackerdb.KVsIter, error) {
+	return MakeKVsIter(ctx, txs.tx)
+}
+
+func (txs pgTransactionScope) MakeEncodedAccoutsBatchIter() trackerdb.EncodedAccountsBatchIter {
+	return MakeEncodedAccoutsBatchIter(txs.tx)
+}
+
+func (txs pgTransactionScope) MakeSpVerificationCtxReaderWriter() trackerdb.SpVerificationCtxReaderWriter {
+	return makeStateProofVerificationReaderWriter(txs.tx, txs.tx)
+}
+
+func (txs pgTransactionScope) RunMigrations(ctx context.Context, params trackerdb.Params, log logging.Logger, targetVersion int32) (mgr trackerdb.InitParams, err error) {
+	return RunMigrations(ctx, txs.tx, params, log, targetVersion)
+}
+
+// ResetTransactionWarnDeadline is a no-op: the postgres store does not monitor
+// the duration of its transactions.
+func (txs pgTransactionScope) ResetTransactionWarnDeadline(ctx context.Context, deadline time.Time) (prevDeadline time.Time, err error) {
+	return time.Time{}, nil
+}
+
+// implements Testing interface
+func (txs pgTransactionScope) AccountsInitTest(tb testing.TB, initAccounts map[basics.Address]basics.AccountData, proto protocol.ConsensusVersion) (newDatabase bool) {
+	return AccountsInitTest(tb, txs.tx, initAccounts, proto)
+}
+
+// implements Testing interface
+func (txs pgTransactionScope) AccountsInitLightTest(tb testing.TB, initAccounts map[basics.Address]basics.AccountData, proto config.ConsensusParams) (newDatabase bool, err error) {
+	return AccountsInitLightTest(tb, txs.tx, initAccounts, proto)
+}
+
+// Testing returns this scope, exposed as an interface with test functions
+func (bs pgBatchScope) Testing() trackerdb.TestBatchScope {
+	return bs
+}
+
+func (bs pgBatchScope) MakeCatchpointWriter() (trackerdb.CatchpointWriter, error) {
+	return NewCatchpointPGReaderWriter(bs.tx), nil
+}
+
+func (bs pgBatchScope) MakeAccountsWriter() (trackerdb.AccountsWriterExt, error) {
+	return NewAccountsPGReaderWriter(bs.tx), nil
+}
+
+func (bs pgBatchScope) MakeAccountsOptimizedWriter(hasAccounts, hasResources, hasKvPairs, hasCreatables bool) (trackerdb.AccountsWriter, error) {
+	return MakeAccountsPGWriter(bs.tx, hasAccounts, hasResources, hasKvPairs, hasCreatables)
+}
+
+// implements Testing interface
+func (bs pgBatchScope) RunMigrations(ctx context.Context, params trackerdb.Params, log logging.Logger, targetVersion int32) (mgr trackerdb.InitParams, err error) {
+	return RunMigrations(ctx, bs.tx, params, log, targetVersion)
+}
+
+// ResetTransactionWarnDeadline is a no-op: the postgres store does not monitor
+// the duration of its transactions.
+func (bs pgBatchScope) ResetTransactionWarnDeadline(ctx context.Context, deadline time.Time) (prevDeadline time.Time, err error) {
+	return time.Time{}, nil
+}
+
+// implements Testing interface
+func (bs pgBatchScope) AccountsInitTest(tb testing.TB, initAccounts map[basics.Address]basics.AccountData, proto protocol.ConsensusVersion) (newDatabase bool) {
+	return AccountsInitTest(tb, bs.tx, initAccounts, proto)
+}
+
+// implements Testing interface
+func (bs pgBatchScope) ModifyAcctBaseTest() error {
+	return modifyAcctBaseTest(bs.tx)
+}
+
+// implements Testing interface
+func (bs pgBatchScope) AccountsUpdateSchemaTest(ctx context.Context) (err error) {
+	return AccountsUpdateSchemaTest(ctx, bs.tx)
+}
+
+func (bs pgBatchScope) MakeSpVerificationCtxWriter() trackerdb.SpVerificationCtxWriter {
+	return makeStateProofVerificationWriter(bs.tx)
+}
+
+func (ss pgSnapshotScope) MakeAccountsReader() (trackerdb.AccountsReaderExt, error) {
+	return NewAccountsPGReaderWriter(ss.tx), nil
+}
+
+func (ss pgSnapshotScope) MakeCatchpointReader() (trackerdb.CatchpointReader, error) {
+	return NewCatchpointPGReaderWriter(ss.tx), nil
+}
+
+func (ss pgSnapshotScope) MakeCatchpointPendingHashesIterator(hashCount int) trackerdb.CatchpointPendingHashesIter {
+	return MakeCatchpointPendingHashesIterator(hashCount, ss.tx)
+}
+
+func (ss pgSnapshotScope) MakeSpVerificationCtxReader() trackerdb.SpVerificationCtxReader {
+	return makeStateProofVerificationReader(ss.tx)
+}
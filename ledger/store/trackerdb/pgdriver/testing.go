@@ -0,0 +1,182 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package pgdriver
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/ledger/store/trackerdb"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/util/db"
+)
+
+// TestDSNEnvVar names the environment variable holding the connection string of the
+// postgres server used by the tests. When it is not set, the tests start a throwaway
+// server with initdb and pg_ctl if those are available, and are skipped otherwise.
+const TestDSNEnvVar = "ALGORAND_TEST_POSTGRES_DSN"
+
+// TestServerDSN returns the connection string of the postgres server to be used by the tests,
+// starting one if TestDSNEnvVar is not set. A started server is stopped when t completes, so
+// tests sharing a server should call it once from their parent test.
+func TestServerDSN(t testing.TB) string {
+	if dsn := os.Getenv(TestDSNEnvVar); dsn != "" {
+		return dsn
+	}
+	return startTestServer(t)
+}
+
+// OpenTrackerTestStore opens a tracker store on the server at dsn for testing purposes.
+// Every store gets its own schema, which is dropped by CleanupTest.
+func OpenTrackerTestStore(t testing.TB, dsn string) (trackerdb.TrackerStore, string) {
+	schema := fmt.Sprintf("trackerdb_test_%d", crypto.RandUint64()>>1)
+
+	connConfig, err := pgx.ParseConfig(dsn)
+	require.NoError(t, err)
+	admin, err := openTrackerPGStore(connConfig)
+	require.NoError(t, err)
+	_, err = admin.handle.Exec("CREATE SCHEMA " + schema)
+	admin.Close()
+	require.NoError(t, err)
+
+	connConfig = connConfig.Copy()
+	connConfig.RuntimeParams["search_path"] = schema
+	store, err := openTrackerPGStore(connConfig)
+	require.NoError(t, err)
+	store.testSchema = schema
+
+	return store, schema
+}
+
+// startTestServer initializes and starts a postgres server listening on a unix socket only,
+// returning its connection string. The server is stopped when the test completes.
+func startTestServer(t testing.TB) string {
+	initdb, err := exec.LookPath("initdb")
+	if err != nil {
+		t.Skipf("%s is not set and initdb is not available: %v", TestDSNEnvVar, err)
+	}
+	pgctl, err := exec.LookPath("pg_ctl")
+	if err != nil {
+		t.Skipf("%s is not set and pg_ctl is not available: %v", TestDSNEnvVar, err)
+	}
+
+	// unix socket paths are limited to about a hundred bytes, which the test temp dir may exceed.
+	dir, err := os.MkdirTemp("", "pgtrackerdb")
+	require.NoError(t, err)
+	t.Cleanup(func() { os.RemoveAll(dir) })
+	dataDir := filepath.Join(dir, "data")
+
+	out, err := exec.Command(initdb, "-D", dataDir, "-U", "postgres", "--auth=trust", "--no-sync").CombinedOutput()
+	require.NoError(t, err, string(out))
+
+	opts := fmt.Sprintf("-k %s -c listen_addresses='' -c fsync=off", dir)
+	out, err = exec.Command(pgctl, "-D", dataDir, "-o", opts, "-l", filepath.Join(dir, "server.log"), "-w", "start").CombinedOutput()
+	require.NoError(t, err, string(out))
+	t.Cleanup(func() {
+		exec.Command(pgctl, "-D", dataDir, "-m", "immediate", "-w", "stop").Run()
+	})
+
+	return fmt.Sprintf("host=%s user=postgres dbname=postgres sslmode=disable", dir)
+}
+
+// SetDbTrackerTestLogging sets a testing logger on a database.
+func SetDbTrackerTestLogging(t testing.TB, dbs trackerdb.TrackerStore) {
+	dblogger := logging.TestingLog(t)
+	dbs.SetLogger(dblogger)
+}
+
+// AccountsInitLightTest initializes an empty database for testing without the extra methods being called.
+// implements Testing interface, test function only
+func AccountsInitLightTest(tb testing.TB, tx *sql.Tx, initAccounts map[basics.Address]basics.AccountData, proto config.ConsensusParams) (newDatabase bool, err error) {
+	newDB, err := accountsInit(context.Background(), tx, initAccounts, proto)
+	require.NoError(tb, err)
+	return newDB, err
+}
+
+// modifyAcctBaseTest tweaks the database to move backards.
+// implements Testing interface, test function only
+func modifyAcctBaseTest(tx *sql.Tx) error {
+	_, err := tx.Exec("UPDATE acctrounds SET rnd = 1 WHERE id = 'acctbase'")
+	return err
+}
+
+// AccountsInitTest initializes an empty database for testing.
+// implements Testing interface, test function only
+func AccountsInitTest(tb testing.TB, tx *sql.Tx, initAccounts map[basics.Address]basics.AccountData, proto protocol.ConsensusVersion) (newDatabase bool) {
+	newDB, err := accountsInit(context.Background(), tx, initAccounts, config.Consensus[proto])
+	require.NoError(tb, err)
+
+	err = accountsCreateOnlineAccountsTable(context.Background(), tx)
+	require.NoError(tb, err)
+
+	err = accountsCreateTxTailTable(context.Background(), tx)
+	require.NoError(tb, err)
+
+	err = performOnlineAccountsTableMigration(context.Background(), tx, nil, nil)
+	require.NoError(tb, err)
+
+	err = accountsCreateOnlineRoundParamsTable(context.Background(), tx)
+	require.NoError(tb, err)
+
+	err = performOnlineRoundParamsTailMigration(context.Background(), tx, db.Accessor{}, true, proto)
+	require.NoError(tb, err)
+
+	err = accountsCreateBoxTable(context.Background(), tx)
+	require.NoError(tb, err)
+
+	err = performKVStoreNullBlobConversion(context.Background(), tx)
+	require.NoError(tb, err)
+
+	return newDB
+}
+
+// AccountsUpdateSchemaTest adds some empty tables for tests to work with a "v6" store.
+func AccountsUpdateSchemaTest(ctx context.Context, tx *sql.Tx) (err error) {
+	if err := accountsCreateOnlineAccountsTable(ctx, tx); err != nil {
+		return err
+	}
+	if err := accountsCreateTxTailTable(ctx, tx); err != nil {
+		return err
+	}
+	if err := accountsCreateOnlineRoundParamsTable(ctx, tx); err != nil {
+		return err
+	}
+	if err := accountsCreateCatchpointFirstStageInfoTable(ctx, tx); err != nil {
+		return err
+	}
+	// this line creates kvstore table, even if it is not required in accountDBVersion 6 -> 7
+	// or in later version where we need kvstore table, some tests will fail
+	if err := accountsCreateBoxTable(ctx, tx); err != nil {
+		return err
+	}
+	if err := accountsCreateStateProofVerificationTable(ctx, tx); err != nil {
+		return err
+	}
+	return nil
+}
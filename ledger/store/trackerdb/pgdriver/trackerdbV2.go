@@ -0,0 +1,282 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package pgdriver
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"path/filepath"
+	"time"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/ledger/store/trackerdb"
+	"github.com/algorand/go-algorand/logging"
+)
+
+type trackerDBSchemaInitializer struct {
+	trackerdb.Params
+
+	// schemaVersion contains current db version
+	schemaVersion int32
+	// newDatabase indicates if the db is newly created
+	newDatabase bool
+
+	log logging.Logger
+}
+
+// RunMigrations initializes the accounts DB if needed and return current account round.
+// as part of the initialization, it tests the current database schema version, and perform upgrade
+// procedures to bring it up to the database schema supported by the binary.
+//
+// The sqlite schema versions 1 to 5 only exist for databases created by old binaries; a postgres
+// database is created directly at version 6 and then goes through the same upgrades as sqlite.
+func RunMigrations(ctx context.Context, tx *sql.Tx, params trackerdb.Params, log logging.Logger, targetVersion int32) (mgr trackerdb.InitParams, err error) {
+	// check current database version.
+	dbVersion, err := getSchemaVersion(ctx, tx)
+	if err != nil {
+		return trackerdb.InitParams{}, fmt.Errorf("trackerDBInitialize unable to read database schema version : %v", err)
+	}
+
+	tu := trackerDBSchemaInitializer{
+		Params:        params,
+		schemaVersion: dbVersion,
+		log:           log,
+	}
+
+	// if database version is greater than supported by current binary, write a warning. This would keep the existing
+	// fallback behavior where we could use an older binary iff the schema happen to be backward compatible.
+	if tu.version() > targetVersion {
+		tu.log.Warnf("trackerDBInitialize database schema version is %d, but migration target version is %d", tu.version(), targetVersion)
+	}
+
+	if tu.version() < targetVersion {
+		tu.log.Infof("trackerDBInitialize upgrading database schema from version %d to version %d", tu.version(), targetVersion)
+		// newDatabase is determined during the tables creations. If we're filling the database with accounts,
+		// then we set this variable to true, allowing some of the upgrades to be skipped.
+		for tu.version() < targetVersion {
+			tu.log.Infof("trackerDBInitialize performing upgrade from version %d", tu.version())
+			// perform the initialization/upgrade
+			switch tu.version() {
+			case 0:
+				if targetVersion < 6 {
+					return trackerdb.InitParams{}, fmt.Errorf("trackerDBInitialize unable to create a database of schema version %d", targetVersion)
+				}
+				err = tu.upgradeDatabaseSchema0(ctx, tx)
+				if err != nil {
+					tu.log.Warnf("trackerDBInitialize failed to upgrade accounts database (postgres) from schema 0 : %v", err)
+					return
+				}
+			case 6:
+				err = tu.upgradeDatabaseSchema6(ctx, tx)
+				if err != nil {
+					tu.log.Warnf("trackerDBInitialize failed to upgrade accounts database (postgres) from schema 6 : %v", err)
+					return
+				}
+			case 7:
+				err = tu.upgradeDatabaseSchema7(ctx, tx)
+				if err != nil {
+					tu.log.Warnf("trackerDBInitialize failed to upgrade accounts database (postgres) from schema 7 : %v", err)
+					return
+				}
+			case 8:
+				err = tu.upgradeDatabaseSchema8(ctx, tx)
+				if err != nil {
+					tu.log.Warnf("trackerDBInitialize failed to upgrade accounts database (postgres) from schema 8 : %v", err)
+					return
+				}
+			case 9:
+				err = tu.upgradeDatabaseSchema9(ctx, tx)
+				if err != nil {
+					tu.log.Warnf("trackerDBInitialize failed to upgrade accounts database (postgres) from schema 9 : %v", err)
+					return
+				}
+			default:
+				return trackerdb.InitParams{}, fmt.Errorf("trackerDBInitialize unable to upgrade database from schema version %d", tu.schemaVersion)
+			}
+		}
+		tu.log.Infof("trackerDBInitialize database schema upgrade complete")
+	}
+
+	// postgres vacuums the tables on its own, so there is no need to request a vacuum on startup.
+	return trackerdb.InitParams{SchemaVersion: tu.schemaVersion}, nil
+}
+
+func (tu *trackerDBSchemaInitializer) setVersion(ctx context.Context, tx *sql.Tx, version int32) (err error) {
+	oldVersion := tu.schemaVersion
+	tu.schemaVersion = version
+	err = setSchemaVersion(ctx, tx, tu.schemaVersion)
+	if err != nil {
+		return fmt.Errorf("trackerDBInitialize unable to update database schema version from %d to %d: %v", oldVersion, version, err)
+	}
+	return nil
+}
+
+func (tu trackerDBSchemaInitializer) version() int32 {
+	return tu.schemaVersion
+}
+
+// upgradeDatabaseSchema0 upgrades the database schema from version 0 to version 6
+//
+// It creates the tables of a version 6 database: acctrounds, accounttotals, accountbase, assetcreators,
+// storedcatchpoints, accounthashes, catchpointstate and resources.
+// In case the database was just created, it would get initialized with the following:
+// The accountbase and resources would get initialized with the au.initAccounts
+// The accounttotals would get initialized to align with the initialization account added to accountbase
+// The acctrounds would get updated to indicate that the balance matches round 0
+func (tu *trackerDBSchemaInitializer) upgradeDatabaseSchema0(ctx context.Context, tx *sql.Tx) (err error) {
+	tu.log.Infof("upgradeDatabaseSchema0 initializing schema")
+	tu.newDatabase, err = accountsInit(ctx, tx, tu.InitAccounts, config.Consensus[tu.InitProto])
+	if err != nil {
+		return fmt.Errorf("upgradeDatabaseSchema0 unable to initialize schema : %v", err)
+	}
+	return tu.setVersion(ctx, tx, 6)
+}
+
+func (tu *trackerDBSchemaInitializer) deleteUnfinishedCatchpoint(ctx context.Context, tx *sql.Tx) error {
+	cts := NewCatchpointPGReaderWriter(tx)
+	// Delete an unfinished catchpoint if there is one.
+	round, err := cts.ReadCatchpointStateUint64(ctx, trackerdb.CatchpointStateWritingCatchpoint)
+	if err != nil {
+		return err
+	}
+	if round == 0 {
+		return nil
+	}
+
+	relCatchpointFilePath := filepath.Join(
+		trackerdb.CatchpointDirName,
+		trackerdb.MakeCatchpointFilePath(basics.Round(round)))
+	err = trackerdb.RemoveSingleCatchpointFileFromDisk(tu.DbPathPrefix, relCatchpointFilePath)
+	if err != nil {
+		return err
+	}
+
+	return cts.WriteCatchpointStateUint64(ctx, trackerdb.CatchpointStateWritingCatchpoint, 0)
+}
+
+// upgradeDatabaseSchema6 upgrades the database schema from version 6 to version 7,
+// adding a new onlineaccounts table
+func (tu *trackerDBSchemaInitializer) upgradeDatabaseSchema6(ctx context.Context, tx *sql.Tx) (err error) {
+	err = accountsCreateOnlineAccountsTable(ctx, tx)
+	if err != nil {
+		return err
+	}
+
+	err = accountsCreateTxTailTable(ctx, tx)
+	if err != nil {
+		return err
+	}
+
+	err = accountsCreateOnlineRoundParamsTable(ctx, tx)
+	if err != nil {
+		return err
+	}
+
+	var lastProgressInfoMsg time.Time
+	const progressLoggingInterval = 5 * time.Second
+
+	migrationProcessLog := func(processed, total uint64) {
+		if time.Since(lastProgressInfoMsg) < progressLoggingInterval {
+			return
+		}
+		lastProgressInfoMsg = time.Now()
+		tu.log.Infof("upgradeDatabaseSchema6 upgraded %d out of %d accounts [ %3.1f%% ]", processed, total, float64(processed)*100.0/float64(total))
+	}
+	err = performOnlineAccountsTableMigration(ctx, tx, migrationProcessLog, tu.log)
+	if err != nil {
+		return fmt.Errorf("upgradeDatabaseSchema6 unable to complete online account data migration : %w", err)
+	}
+
+	if !tu.newDatabase {
+		err = performTxTailTableMigration(ctx, tx, tu.BlockDb.Rdb)
+		if err != nil {
+			return fmt.Errorf("upgradeDatabaseSchema6 unable to complete transaction tail data migration : %w", err)
+		}
+	}
+
+	err = performOnlineRoundParamsTailMigration(ctx, tx, tu.BlockDb.Rdb, tu.newDatabase, tu.InitProto)
+	if err != nil {
+		return fmt.Errorf("upgradeDatabaseSchema6 unable to complete online round params data migration : %w", err)
+	}
+
+	err = tu.deleteUnfinishedCatchpoint(ctx, tx)
+	if err != nil {
+		return err
+	}
+	err = accountsCreateCatchpointFirstStageInfoTable(ctx, tx)
+	if err != nil {
+		return err
+	}
+	err = accountsCreateUnfinishedCatchpointsTable(ctx, tx)
+	if err != nil {
+		return err
+	}
+
+	// update version
+	return tu.setVersion(ctx, tx, 7)
+}
+
+// upgradeDatabaseSchema7 upgrades the database schema from version 7 to version 8.
+// adding the kvstore table for box feature support.
+func (tu *trackerDBSchemaInitializer) upgradeDatabaseSchema7(ctx context.Context, tx *sql.Tx) (err error) {
+	err = accountsCreateBoxTable(ctx, tx)
+	if err != nil {
+		return fmt.Errorf("upgradeDatabaseSchema7 unable to create kvstore through createTables : %v", err)
+	}
+	return tu.setVersion(ctx, tx, 8)
+}
+
+// upgradeDatabaseSchema8 upgrades the database schema from version 8 to version 9,
+// forcing a rebuild of the accounthashes table on betanet nodes. Otherwise it has no effect.
+func (tu *trackerDBSchemaInitializer) upgradeDatabaseSchema8(ctx context.Context, tx *sql.Tx) (err error) {
+	arw := NewAccountsPGReaderWriter(tx)
+	betanetGenesisHash, _ := crypto.DigestFromString("TBMBVTC7W24RJNNUZCF7LWZD2NMESGZEQSMPG5XQD7JY4O7JKVWQ")
+	if tu.GenesisHash == betanetGenesisHash && !tu.FromCatchpoint {
+		// reset hash round to 0, forcing catchpointTracker.initializeHashes to rebuild accounthashes
+		err = arw.UpdateAccountsHashRound(ctx, 0)
+		if err != nil {
+			return fmt.Errorf("upgradeDatabaseSchema8 unable to reset acctrounds table 'hashbase' round : %v", err)
+		}
+	}
+	return tu.setVersion(ctx, tx, 9)
+}
+
+// upgradeDatabaseSchema9 upgrades the database schema from version 9 to version 10,
+// adding a new stateproofverification table,
+// scrubbing out all nil values from kvstore table and replace with empty byte slice.
+func (tu *trackerDBSchemaInitializer) upgradeDatabaseSchema9(ctx context.Context, tx *sql.Tx) (err error) {
+	err = accountsCreateStateProofVerificationTable(ctx, tx)
+	if err != nil {
+		return err
+	}
+
+	err = performKVStoreNullBlobConversion(ctx, tx)
+	if err != nil {
+		return fmt.Errorf("upgradeDatabaseSchema9 unable to replace kvstore nil entries with empty byte slices : %v", err)
+	}
+
+	err = convertOnlineRoundParamsTail(ctx, tx)
+	if err != nil {
+		return fmt.Errorf("upgradeDatabaseSchema9 unable to convert onlineroundparamstail: %v", err)
+	}
+
+	// update version
+	return tu.setVersion(ctx, tx, 10)
+}
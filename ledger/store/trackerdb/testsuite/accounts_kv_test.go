@@ -0,0 +1,361 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package testsuite
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/ledger/store/trackerdb"
+	ledgertesting "github.com/algorand/go-algorand/ledger/testing"
+)
+
+func init() {
+	// register tests that will run on each db implementation
+	registerTest("genesis-accounts", customTestGenesisAccounts)
+	registerTest("accounts-crud", customTestAccountsCrud)
+	registerTest("resources-crud", customTestResourcesCrud)
+	registerTest("creatables-crud", customTestCreatablesCrud)
+	registerTest("kv-crud", customTestKvCrud)
+	registerTest("totals-and-round", customTestTotalsAndRound)
+	registerTest("merkle-committer", customTestMerkleCommitter)
+}
+
+func customTestGenesisAccounts(t *customT) {
+	initAccounts := ledgertesting.RandomAccounts(20, false)
+	t.initDB(initAccounts)
+
+	err := t.db.Snapshot(func(ctx context.Context, tx trackerdb.SnapshotScope) error {
+		ar, err := tx.MakeAccountsReader()
+		require.NoError(t, err)
+
+		total, err := ar.TotalAccounts(ctx)
+		require.NoError(t, err)
+		require.Equal(t, uint64(len(initAccounts)), total)
+
+		bals, err := ar.Testing().AccountsAllTest()
+		require.NoError(t, err)
+		require.Equal(t, initAccounts, bals)
+
+		rnd, err := ar.AccountsRound()
+		require.NoError(t, err)
+		require.Equal(t, basics.Round(0), rnd)
+		return nil
+	})
+	require.NoError(t, err)
+}
+
+func customTestAccountsCrud(t *customT) {
+	t.initDB(nil)
+
+	err := t.db.Transaction(func(ctx context.Context, tx trackerdb.TransactionScope) error {
+		aw, err := tx.MakeAccountsOptimizedWriter(true, true, true, true)
+		require.NoError(t, err)
+		defer aw.Close()
+		ar, err := tx.Testing().MakeAccountsOptimizedReader()
+		require.NoError(t, err)
+		defer ar.Close()
+
+		addr := ledgertesting.RandomAddress()
+		data := trackerdb.BaseAccountData{
+			MicroAlgos:  basics.MicroAlgos{Raw: 100_000_000},
+			UpdateRound: 1,
+		}
+
+		ref, err := aw.InsertAccount(addr, data.NormalizedOnlineBalance(t.proto), data)
+		require.NoError(t, err)
+		require.NotNil(t, ref)
+
+		pad, err := ar.LookupAccount(addr)
+		require.NoError(t, err)
+		require.Equal(t, addr, pad.Addr)
+		require.Equal(t, data, pad.AccountData)
+		require.Equal(t, ref, pad.Ref)
+
+		data.MicroAlgos = basics.MicroAlgos{Raw: 200_000_000}
+		data.UpdateRound = 2
+		rows, err := aw.UpdateAccount(ref, data.NormalizedOnlineBalance(t.proto), data)
+		require.NoError(t, err)
+		require.Equal(t, int64(1), rows)
+
+		pad, err = ar.LookupAccount(addr)
+		require.NoError(t, err)
+		require.Equal(t, data, pad.AccountData)
+
+		rows, err = aw.DeleteAccount(ref)
+		require.NoError(t, err)
+		require.Equal(t, int64(1), rows)
+
+		pad, err = ar.LookupAccount(addr)
+		require.NoError(t, err)
+		require.Nil(t, pad.Ref)
+		require.Empty(t, pad.AccountData)
+		return nil
+	})
+	require.NoError(t, err)
+}
+
+func customTestResourcesCrud(t *customT) {
+	t.initDB(nil)
+
+	err := t.db.Transaction(func(ctx context.Context, tx trackerdb.TransactionScope) error {
+		aw, err := tx.MakeAccountsOptimizedWriter(true, true, true, true)
+		require.NoError(t, err)
+		defer aw.Close()
+		ar, err := tx.Testing().MakeAccountsOptimizedReader()
+		require.NoError(t, err)
+		defer ar.Close()
+
+		addr := ledgertesting.RandomAddress()
+		acctData := trackerdb.BaseAccountData{MicroAlgos: basics.MicroAlgos{Raw: 1_000_000}, TotalAssets: 1}
+		ref, err := aw.InsertAccount(addr, acctData.NormalizedOnlineBalance(t.proto), acctData)
+		require.NoError(t, err)
+
+		aidx := basics.CreatableIndex(10)
+		resData := trackerdb.MakeResourcesData(0)
+		resData.SetAssetHolding(basics.AssetHolding{Amount: 10})
+		_, err = aw.InsertResource(ref, aidx, resData)
+		require.NoError(t, err)
+
+		prd, err := ar.LookupResources(addr, aidx, basics.AssetCreatable)
+		require.NoError(t, err)
+		require.Equal(t, aidx, prd.Aidx)
+		require.Equal(t, resData, prd.Data)
+		require.NotNil(t, prd.AcctRef)
+
+		resData.SetAssetHolding(basics.AssetHolding{Amount: 20, Frozen: true})
+		rows, err := aw.UpdateResource(ref, aidx, resData)
+		require.NoError(t, err)
+		require.Equal(t, int64(1), rows)
+
+		all, _, err := ar.LookupAllResources(addr)
+		require.NoError(t, err)
+		require.Len(t, all, 1)
+		require.Equal(t, resData, all[0].Data)
+
+		rows, err = aw.DeleteResource(ref, aidx)
+		require.NoError(t, err)
+		require.Equal(t, int64(1), rows)
+
+		prd, err = ar.LookupResources(addr, aidx, basics.AssetCreatable)
+		require.NoError(t, err)
+		require.Nil(t, prd.AcctRef)
+		return nil
+	})
+	require.NoError(t, err)
+}
+
+func customTestCreatablesCrud(t *customT) {
+	t.initDB(nil)
+
+	err := t.db.Transaction(func(ctx context.Context, tx trackerdb.TransactionScope) error {
+		aw, err := tx.MakeAccountsOptimizedWriter(true, true, true, true)
+		require.NoError(t, err)
+		defer aw.Close()
+		ar, err := tx.Testing().MakeAccountsOptimizedReader()
+		require.NoError(t, err)
+		defer ar.Close()
+
+		creator := ledgertesting.RandomAddress()
+		for cidx := basics.CreatableIndex(1); cidx <= 5; cidx++ {
+			_, err = aw.InsertCreatable(cidx, basics.AssetCreatable, creator[:])
+			require.NoError(t, err)
+		}
+
+		addr, ok, _, err := ar.LookupCreator(3, basics.AssetCreatable)
+		require.NoError(t, err)
+		require.True(t, ok)
+		require.Equal(t, creator, addr)
+
+		// the same index under another creatable type is not found
+		_, ok, _, err = ar.LookupCreator(3, basics.AppCreatable)
+		require.NoError(t, err)
+		require.False(t, ok)
+
+		// listing is done in descending order, starting at maxIdx
+		results, _, err := ar.ListCreatables(4, 2, basics.AssetCreatable)
+		require.NoError(t, err)
+		require.Len(t, results, 2)
+		require.Equal(t, basics.CreatableIndex(4), results[0].Index)
+		require.Equal(t, basics.CreatableIndex(3), results[1].Index)
+
+		rows, err := aw.DeleteCreatable(3, basics.AssetCreatable)
+		require.NoError(t, err)
+		require.Equal(t, int64(1), rows)
+
+		_, ok, _, err = ar.LookupCreator(3, basics.AssetCreatable)
+		require.NoError(t, err)
+		require.False(t, ok)
+		return nil
+	})
+	require.NoError(t, err)
+}
+
+func customTestKvCrud(t *customT) {
+	t.initDB(nil)
+
+	kvs := map[string][]byte{
+		"bx:app1-a": []byte("value-a"),
+		"bx:app1-b": []byte("value-b"),
+		"bx:app2-a": []byte("value-c"),
+		"bx:empty":  {},
+	}
+
+	err := t.db.Transaction(func(ctx context.Context, tx trackerdb.TransactionScope) error {
+		aw, err := tx.MakeAccountsOptimizedWriter(true, true, true, true)
+		require.NoError(t, err)
+		defer aw.Close()
+		ar, err := tx.Testing().MakeAccountsOptimizedReader()
+		require.NoError(t, err)
+		defer ar.Close()
+
+		for k, v := range kvs {
+			require.NoError(t, aw.UpsertKvPair(k, v))
+		}
+
+		for k, v := range kvs {
+			pv, err := ar.LookupKeyValue(k)
+			require.NoError(t, err)
+			require.Equal(t, v, pv.Value)
+		}
+
+		// a missing key is reported with a nil value
+		pv, err := ar.LookupKeyValue("bx:missing")
+		require.NoError(t, err)
+		require.Nil(t, pv.Value)
+
+		results := make(map[string]bool)
+		_, err = ar.LookupKeysByPrefix("bx:app1", 10, results, 0)
+		require.NoError(t, err)
+		require.Equal(t, map[string]bool{"bx:app1-a": true, "bx:app1-b": true}, results)
+
+		require.NoError(t, aw.UpsertKvPair("bx:app1-a", []byte("updated")))
+		pv, err = ar.LookupKeyValue("bx:app1-a")
+		require.NoError(t, err)
+		require.Equal(t, []byte("updated"), pv.Value)
+		kvs["bx:app1-a"] = []byte("updated")
+
+		require.NoError(t, aw.DeleteKvPair("bx:app2-a"))
+		delete(kvs, "bx:app2-a")
+
+		iter, err := tx.MakeKVsIter(ctx)
+		require.NoError(t, err)
+		defer iter.Close()
+		found := make(map[string][]byte)
+		for iter.Next() {
+			k, v, err := iter.KeyValue()
+			require.NoError(t, err)
+			found[string(k)] = v
+		}
+		require.Len(t, found, len(kvs))
+		for k, v := range kvs {
+			require.Equal(t, len(v), len(found[k]))
+			if len(v) > 0 {
+				require.Equal(t, v, found[k])
+			}
+		}
+		return nil
+	})
+	require.NoError(t, err)
+}
+
+func customTestTotalsAndRound(t *customT) {
+	t.initDB(nil)
+
+	totals := ledgercore.AccountTotals{
+		Online:       ledgercore.AlgoCount{Money: basics.MicroAlgos{Raw: 100}, RewardUnits: 1},
+		Offline:      ledgercore.AlgoCount{Money: basics.MicroAlgos{Raw: 200}, RewardUnits: 2},
+		RewardsLevel: 3,
+	}
+
+	err := t.db.Batch(func(ctx context.Context, tx trackerdb.BatchScope) error {
+		aw, err := tx.MakeAccountsWriter()
+		require.NoError(t, err)
+
+		require.NoError(t, aw.AccountsPutTotals(totals, false))
+		require.NoError(t, aw.UpdateAccountsRound(5))
+		require.NoError(t, aw.UpdateAccountsHashRound(ctx, 5))
+		return nil
+	})
+	require.NoError(t, err)
+
+	err = t.db.Snapshot(func(ctx context.Context, tx trackerdb.SnapshotScope) error {
+		ar, err := tx.MakeAccountsReader()
+		require.NoError(t, err)
+
+		readTotals, err := ar.AccountsTotals(ctx, false)
+		require.NoError(t, err)
+		require.Equal(t, totals, readTotals)
+
+		rnd, err := ar.AccountsRound()
+		require.NoError(t, err)
+		require.Equal(t, basics.Round(5), rnd)
+
+		hashRnd, err := ar.AccountsHashRound(ctx)
+		require.NoError(t, err)
+		require.Equal(t, basics.Round(5), hashRnd)
+		return nil
+	})
+	require.NoError(t, err)
+
+	// the accounts round can only move forward
+	err = t.db.Batch(func(ctx context.Context, tx trackerdb.BatchScope) error {
+		aw, err := tx.MakeAccountsWriter()
+		require.NoError(t, err)
+		return aw.UpdateAccountsRound(3)
+	})
+	require.Error(t, err)
+}
+
+func customTestMerkleCommitter(t *customT) {
+	t.initDB(nil)
+
+	for _, staging := range []bool{false, true} {
+		if staging {
+			err := t.db.Batch(func(ctx context.Context, tx trackerdb.BatchScope) error {
+				cw, err := tx.MakeCatchpointWriter()
+				require.NoError(t, err)
+				return cw.ResetCatchpointStagingBalances(ctx, true)
+			})
+			require.NoError(t, err)
+		}
+
+		err := t.db.Transaction(func(ctx context.Context, tx trackerdb.TransactionScope) error {
+			mc, err := tx.MakeMerkleCommitter(staging)
+			require.NoError(t, err)
+
+			require.NoError(t, mc.StorePage(1, []byte("page-1")))
+			require.NoError(t, mc.StorePage(2, []byte("page-2")))
+			require.NoError(t, mc.StorePage(1, []byte("page-1-updated")))
+
+			content, err := mc.LoadPage(1)
+			require.NoError(t, err)
+			require.Equal(t, []byte("page-1-updated"), content)
+
+			// storing an empty page deletes it
+			require.NoError(t, mc.StorePage(2, nil))
+			content, err = mc.LoadPage(2)
+			require.NoError(t, err)
+			require.Nil(t, content)
+			return nil
+		})
+		require.NoError(t, err)
+	}
+}
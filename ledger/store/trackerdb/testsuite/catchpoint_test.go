@@ -0,0 +1,251 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package testsuite
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/ledger/store/trackerdb"
+	ledgertesting "github.com/algorand/go-algorand/ledger/testing"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+func init() {
+	// register tests that will run on each db implementation
+	registerTest("catchpoint-state", customTestCatchpointState)
+	registerTest("catchpoint-unfinished", customTestUnfinishedCatchpoints)
+	registerTest("catchpoint-staging-apply", customTestCatchpointStagingApply)
+	registerTest("stateproof-verification-ctx", customTestSpVerificationCtx)
+}
+
+func customTestCatchpointState(t *customT) {
+	t.initDB(nil)
+
+	err := t.db.Transaction(func(ctx context.Context, tx trackerdb.TransactionScope) error {
+		crw, err := tx.MakeCatchpointReaderWriter()
+		require.NoError(t, err)
+
+		// reading a state that was never written returns the zero value
+		val, err := crw.ReadCatchpointStateUint64(ctx, trackerdb.CatchpointStateLastCatchpoint)
+		require.NoError(t, err)
+		require.Zero(t, val)
+
+		require.NoError(t, crw.WriteCatchpointStateUint64(ctx, trackerdb.CatchpointStateLastCatchpoint, 42))
+		val, err = crw.ReadCatchpointStateUint64(ctx, trackerdb.CatchpointStateLastCatchpoint)
+		require.NoError(t, err)
+		require.Equal(t, uint64(42), val)
+
+		require.NoError(t, crw.WriteCatchpointStateString(ctx, trackerdb.CatchpointStateCatchupLabel, "label"))
+		str, err := crw.ReadCatchpointStateString(ctx, trackerdb.CatchpointStateCatchupLabel)
+		require.NoError(t, err)
+		require.Equal(t, "label", str)
+
+		// writing the zero value clears the state
+		require.NoError(t, crw.WriteCatchpointStateString(ctx, trackerdb.CatchpointStateCatchupLabel, ""))
+		str, err = crw.ReadCatchpointStateString(ctx, trackerdb.CatchpointStateCatchupLabel)
+		require.NoError(t, err)
+		require.Empty(t, str)
+
+		require.NoError(t, crw.StoreCatchpoint(ctx, 100, "catchpoints/100.catchpoint", "100#label", 1234))
+		fileName, catchpoint, fileSize, err := crw.GetCatchpoint(ctx, 100)
+		require.NoError(t, err)
+		require.Equal(t, "catchpoints/100.catchpoint", fileName)
+		require.Equal(t, "100#label", catchpoint)
+		require.Equal(t, int64(1234), fileSize)
+		return nil
+	})
+	require.NoError(t, err)
+}
+
+func customTestUnfinishedCatchpoints(t *customT) {
+	t.initDB(nil)
+
+	err := t.db.Transaction(func(ctx context.Context, tx trackerdb.TransactionScope) error {
+		crw, err := tx.MakeCatchpointReaderWriter()
+		require.NoError(t, err)
+
+		var d3, d5 crypto.Digest
+		d3[0] = 3
+		d5[0] = 5
+		require.NoError(t, crw.InsertUnfinishedCatchpoint(ctx, 3, d3))
+		require.NoError(t, crw.InsertUnfinishedCatchpoint(ctx, 5, d5))
+
+		ret, err := crw.SelectUnfinishedCatchpoints(ctx)
+		require.NoError(t, err)
+		require.Equal(t, []trackerdb.UnfinishedCatchpointRecord{
+			{Round: 3, BlockHash: d3},
+			{Round: 5, BlockHash: d5},
+		}, ret)
+
+		require.NoError(t, crw.DeleteUnfinishedCatchpoint(ctx, 3))
+		ret, err = crw.SelectUnfinishedCatchpoints(ctx)
+		require.NoError(t, err)
+		require.Equal(t, []trackerdb.UnfinishedCatchpointRecord{{Round: 5, BlockHash: d5}}, ret)
+
+		info := trackerdb.CatchpointFirstStageInfo{TotalAccounts: 7, TotalKVs: 2}
+		require.NoError(t, crw.InsertOrReplaceCatchpointFirstStageInfo(ctx, 5, &info))
+		readInfo, exists, err := crw.SelectCatchpointFirstStageInfo(ctx, 5)
+		require.NoError(t, err)
+		require.True(t, exists)
+		require.Equal(t, info, readInfo)
+
+		_, exists, err = crw.SelectCatchpointFirstStageInfo(ctx, 6)
+		require.NoError(t, err)
+		require.False(t, exists)
+		return nil
+	})
+	require.NoError(t, err)
+}
+
+func customTestCatchpointStagingApply(t *customT) {
+	t.initDB(ledgertesting.RandomAccounts(5, true))
+
+	stagedAddr := ledgertesting.RandomAddress()
+	stagedData := trackerdb.BaseAccountData{MicroAlgos: basics.MicroAlgos{Raw: 5_000_000}, UpdateRound: 1}
+	stagedHash := crypto.Hash(stagedAddr[:]).ToSlice()
+	stagedKVHash := crypto.Hash([]byte("bx:staged")).ToSlice()
+	bals := []trackerdb.NormalizedAccountBalance{{
+		Address:            stagedAddr,
+		AccountData:        stagedData,
+		EncodedAccountData: protocol.Encode(&stagedData),
+		AccountHashes:      [][]byte{stagedHash},
+		NormalizedBalance:  stagedData.NormalizedOnlineBalance(t.proto),
+	}}
+
+	err := t.db.Batch(func(ctx context.Context, tx trackerdb.BatchScope) error {
+		cw, err := tx.MakeCatchpointWriter()
+		require.NoError(t, err)
+
+		require.NoError(t, cw.ResetCatchpointStagingBalances(ctx, true))
+		require.NoError(t, cw.WriteCatchpointStagingBalances(ctx, bals))
+		require.NoError(t, cw.WriteCatchpointStagingHashes(ctx, bals))
+		require.NoError(t, cw.WriteCatchpointStagingKVs(ctx, [][]byte{[]byte("bx:staged")}, [][]byte{[]byte("value")}, [][]byte{stagedKVHash}))
+		return cw.CreateCatchpointStagingHashesIndex(ctx)
+	})
+	require.NoError(t, err)
+
+	err = t.db.Snapshot(func(ctx context.Context, tx trackerdb.SnapshotScope) error {
+		iter := tx.MakeCatchpointPendingHashesIterator(10)
+		defer iter.Close()
+		hashes, err := iter.Next(ctx)
+		require.NoError(t, err)
+		// the kv hashes are staged along with the account hashes
+		require.ElementsMatch(t, [][]byte{stagedHash, stagedKVHash}, hashes)
+		return nil
+	})
+	require.NoError(t, err)
+
+	err = t.db.Batch(func(ctx context.Context, tx trackerdb.BatchScope) error {
+		cw, err := tx.MakeCatchpointWriter()
+		require.NoError(t, err)
+		return cw.ApplyCatchpointStagingBalances(ctx, 100, 90)
+	})
+	require.NoError(t, err)
+
+	// the staged balances replace the current ones
+	err = t.db.Snapshot(func(ctx context.Context, tx trackerdb.SnapshotScope) error {
+		ar, err := tx.MakeAccountsReader()
+		require.NoError(t, err)
+
+		total, err := ar.TotalAccounts(ctx)
+		require.NoError(t, err)
+		require.Equal(t, uint64(1), total)
+
+		_, data, err := ar.LookupAccountDataByAddress(stagedAddr)
+		require.NoError(t, err)
+		require.Equal(t, protocol.Encode(&stagedData), data)
+
+		totalKVs, err := ar.TotalKVs(ctx)
+		require.NoError(t, err)
+		require.Equal(t, uint64(1), totalKVs)
+
+		rnd, err := ar.AccountsRound()
+		require.NoError(t, err)
+		require.Equal(t, basics.Round(100), rnd)
+
+		hashRnd, err := ar.AccountsHashRound(ctx)
+		require.NoError(t, err)
+		require.Equal(t, basics.Round(90), hashRnd)
+		return nil
+	})
+	require.NoError(t, err)
+
+	// new accounts can still be written once the staging tables took over
+	err = t.db.Transaction(func(ctx context.Context, tx trackerdb.TransactionScope) error {
+		aw, err := tx.MakeAccountsOptimizedWriter(true, true, true, true)
+		require.NoError(t, err)
+		defer aw.Close()
+		data := trackerdb.BaseAccountData{MicroAlgos: basics.MicroAlgos{Raw: 1_000_000}}
+		_, err = aw.InsertAccount(ledgertesting.RandomAddress(), data.NormalizedOnlineBalance(t.proto), data)
+		return err
+	})
+	require.NoError(t, err)
+}
+
+func customTestSpVerificationCtx(t *customT) {
+	t.initDB(nil)
+
+	contexts := make([]*ledgercore.StateProofVerificationContext, 0, 4)
+	for rnd := basics.Round(256); rnd <= 1024; rnd += 256 {
+		contexts = append(contexts, &ledgercore.StateProofVerificationContext{
+			LastAttestedRound: rnd,
+			OnlineTotalWeight: basics.MicroAlgos{Raw: uint64(rnd)},
+		})
+	}
+
+	err := t.db.Batch(func(ctx context.Context, tx trackerdb.BatchScope) error {
+		return tx.MakeSpVerificationCtxWriter().StoreSPContexts(ctx, contexts)
+	})
+	require.NoError(t, err)
+
+	err = t.db.Snapshot(func(ctx context.Context, tx trackerdb.SnapshotScope) error {
+		spr := tx.MakeSpVerificationCtxReader()
+
+		vc, err := spr.LookupSPContext(512)
+		require.NoError(t, err)
+		require.Equal(t, *contexts[1], *vc)
+
+		all, err := spr.GetAllSPContexts(ctx)
+		require.NoError(t, err)
+		require.Len(t, all, len(contexts))
+		for i := range all {
+			require.Equal(t, *contexts[i], all[i])
+		}
+		return nil
+	})
+	require.NoError(t, err)
+
+	err = t.db.Transaction(func(ctx context.Context, tx trackerdb.TransactionScope) error {
+		sprw := tx.MakeSpVerificationCtxReaderWriter()
+		require.NoError(t, sprw.DeleteOldSPContexts(ctx, 768))
+
+		all, err := sprw.GetAllSPContexts(ctx)
+		require.NoError(t, err)
+		require.Len(t, all, 2)
+		require.Equal(t, basics.Round(768), all[0].LastAttestedRound)
+
+		_, err = sprw.LookupSPContext(256)
+		require.Error(t, err)
+		return nil
+	})
+	require.NoError(t, err)
+}
@@ -0,0 +1,86 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package testsuite
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/ledger/store/trackerdb"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+// The testsuite package runs the same set of tests against every trackerdb.TrackerStore
+// implementation. Every driver registers a Test function calling runGenericTestsWithDB,
+// and every generic test registers itself with registerTest.
+
+type genericTestEntry struct {
+	name string
+	f    func(*customT)
+}
+
+// list of tests to be run on each db implementation
+var genericTests []genericTestEntry
+
+// registerTest adds a test to the suite. It is meant to be called from an init function.
+func registerTest(name string, f func(*customT)) {
+	genericTests = append(genericTests, genericTestEntry{name, f})
+}
+
+// dbFactory opens an empty tracker store for a test.
+type dbFactory func(t testing.TB) trackerdb.TrackerStore
+
+type customT struct {
+	db    trackerdb.TrackerStore
+	proto config.ConsensusParams
+	*testing.T
+}
+
+func runGenericTestsWithDB(t *testing.T, makeDB dbFactory) {
+	for _, entry := range genericTests {
+		// run each test in a new db
+		entry := entry
+		t.Run(entry.name, func(t *testing.T) {
+			db := makeDB(t)
+			t.Cleanup(func() { db.CleanupTest(t.Name(), true) })
+			db.SetLogger(logging.TestingLog(t))
+			entry.f(&customT{db, config.Consensus[protocol.ConsensusCurrentVersion], t})
+		})
+	}
+}
+
+// initDB brings the store to the latest schema version, with initAccounts as the genesis balances.
+func (t *customT) initDB(initAccounts map[basics.Address]basics.AccountData) {
+	err := t.db.Batch(func(ctx context.Context, tx trackerdb.BatchScope) error {
+		params := trackerdb.Params{
+			InitAccounts: initAccounts,
+			InitProto:    protocol.ConsensusCurrentVersion,
+		}
+		mgr, err := tx.Testing().RunMigrations(ctx, params, logging.TestingLog(t), trackerdb.AccountDBVersion)
+		if err != nil {
+			return err
+		}
+		require.Equal(t, trackerdb.AccountDBVersion, mgr.SchemaVersion)
+		return nil
+	})
+	require.NoError(t, err)
+}
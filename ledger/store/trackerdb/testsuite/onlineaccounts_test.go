@@ -0,0 +1,99 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package testsuite
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/ledger/store/trackerdb"
+	ledgertesting "github.com/algorand/go-algorand/ledger/testing"
+)
+
+func init() {
+	// register tests that will run on each db implementation
+	registerTest("online-accounts-history", customTestOnlineAccountsHistory)
+}
+
+func customTestOnlineAccountsHistory(t *customT) {
+	t.initDB(nil)
+
+	addr := ledgertesting.RandomAddress()
+	history := []trackerdb.BaseOnlineAccountData{
+		{MicroAlgos: basics.MicroAlgos{Raw: 100_000_000}, BaseVotingData: trackerdb.BaseVotingData{VoteLastValid: 1000}},
+		{MicroAlgos: basics.MicroAlgos{Raw: 200_000_000}, BaseVotingData: trackerdb.BaseVotingData{VoteLastValid: 1000}},
+		{MicroAlgos: basics.MicroAlgos{Raw: 300_000_000}, BaseVotingData: trackerdb.BaseVotingData{VoteLastValid: 2000}},
+	}
+
+	err := t.db.Transaction(func(ctx context.Context, tx trackerdb.TransactionScope) error {
+		ow, err := tx.MakeOnlineAccountsOptimizedWriter(true)
+		require.NoError(t, err)
+		defer ow.Close()
+
+		for i, data := range history {
+			updRound := uint64(i+1) * 10
+			normBalance := data.NormalizedOnlineBalance(t.proto)
+			_, err = ow.InsertOnlineAccount(addr, normBalance, data, updRound, uint64(data.VoteLastValid))
+			require.NoError(t, err)
+		}
+
+		aw, err := tx.MakeAccountsReaderWriter()
+		require.NoError(t, err)
+		return aw.UpdateAccountsRound(40)
+	})
+	require.NoError(t, err)
+
+	err = t.db.Transaction(func(ctx context.Context, tx trackerdb.TransactionScope) error {
+		or, err := tx.Testing().MakeOnlineAccountsOptimizedReader()
+		require.NoError(t, err)
+		defer or.Close()
+
+		// the lookup returns the latest entry not after the requested round
+		for _, tc := range []struct {
+			rnd      basics.Round
+			expected int
+		}{{10, 0}, {15, 0}, {20, 1}, {35, 2}} {
+			pad, err := or.LookupOnline(addr, tc.rnd)
+			require.NoError(t, err)
+			require.Equal(t, history[tc.expected], pad.AccountData)
+			require.Equal(t, basics.Round(tc.expected+1)*10, pad.UpdRound)
+		}
+
+		// nothing is known before the first update
+		pad, err := or.LookupOnline(addr, 5)
+		require.NoError(t, err)
+		require.Nil(t, pad.Ref)
+
+		all, rnd, err := or.LookupOnlineHistory(addr)
+		require.NoError(t, err)
+		require.Equal(t, basics.Round(40), rnd)
+		require.Len(t, all, len(history))
+		for i := range all {
+			require.Equal(t, history[i], all[i].AccountData)
+		}
+
+		ar, err := tx.MakeAccountsReaderWriter()
+		require.NoError(t, err)
+		accts, err := ar.OnlineAccountsAll(10)
+		require.NoError(t, err)
+		require.Len(t, accts, len(history))
+		return nil
+	})
+	require.NoError(t, err)
+}
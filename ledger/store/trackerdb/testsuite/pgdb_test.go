@@ -0,0 +1,37 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package testsuite
+
+import (
+	"testing"
+
+	"github.com/algorand/go-algorand/ledger/store/trackerdb"
+	"github.com/algorand/go-algorand/ledger/store/trackerdb/pgdriver"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+// TestPostgresDB runs the suite on the server named by pgdriver.TestDSNEnvVar, or on a
+// server started for the test. It is skipped when neither is available.
+func TestPostgresDB(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	dsn := pgdriver.TestServerDSN(t)
+	runGenericTestsWithDB(t, func(t testing.TB) trackerdb.TrackerStore {
+		db, _ := pgdriver.OpenTrackerTestStore(t, dsn)
+		return db
+	})
+}
@@ -62,6 +62,8 @@ type Client struct {
 	suggestedParamsCache  model.TransactionParametersResponse
 	suggestedParamsExpire time.Time
 	suggestedParamsMaxAge time.Duration
+
+	skipAuthAddrLookup bool
 }
 
 // ClientConfig is data to configure a Client
@@ -467,20 +469,8 @@ func (c *Client) SendPaymentFromWalletWithLease(walletHandle, pw []byte, from, t
 }
 
 func (c *Client) signAndBroadcastTransactionWithWallet(walletHandle, pw []byte, tx transactions.Transaction) (transactions.Transaction, error) {
-	// Sign the transaction
-	kmd, err := c.ensureKmdClient()
-	if err != nil {
-		return transactions.Transaction{}, err
-	}
-	// TODO(rekeying) probably libgoal should allow passing in different public key to sign with
-	resp0, err := kmd.SignTransaction(walletHandle, pw, crypto.PublicKey{}, tx)
-	if err != nil {
-		return transactions.Transaction{}, err
-	}
-
-	// Decode the SignedTxn
-	var stx transactions.SignedTxn
-	err = protocol.Decode(resp0.SignedTransaction, &stx)
+	// Sign the transaction, with the key the sender was rekeyed to if any
+	stx, err := c.SignTransactionWithWallet(walletHandle, pw, tx)
 	if err != nil {
 		return transactions.Transaction{}, err
 	}
@@ -926,6 +916,12 @@ func (c *Client) SetSuggestedParamsCacheAge(maxAge time.Duration) {
 	c.suggestedParamsMaxAge = maxAge
 }
 
+// SetAuthAddrLookup sets whether SignTransactionWithWallet asks algod which address the sender was rekeyed to.
+// It is on by default; turn it off for bulk signing, or to sign offline with the sender key.
+func (c *Client) SetAuthAddrLookup(enabled bool) {
+	c.skipAuthAddrLookup = !enabled
+}
+
 func (c *Client) cachedSuggestedParams() (params model.TransactionParametersResponse, err error) {
 	if c.suggestedParamsMaxAge == 0 || time.Now().After(c.suggestedParamsExpire) {
 		params, err = c.SuggestedParams()
@@ -1055,6 +1051,15 @@ func (c *Client) GetParticipationKeys() (resp model.ParticipationKeysResponse, e
 	return
 }
 
+// GetConsensusState gets a snapshot of the agreement state machine of the node.
+func (c *Client) GetConsensusState() (resp model.ConsensusStateResponse, err error) {
+	algod, err := c.ensureAlgodClient()
+	if err == nil {
+		return algod.GetConsensusState()
+	}
+	return
+}
+
 // GetParticipationKeyByID looks up a specific participation key by its participationID.
 func (c *Client) GetParticipationKeyByID(id string) (resp model.ParticipationKeyResponse, err error) {
 	algod, err := c.ensureAlgodClient()
@@ -1133,6 +1138,20 @@ func (c *Client) Catchup(catchpointLabel string) error {
 	return nil
 }
 
+// CatchupFromFiles start catching up to the give catchpoint label, using a catchpoint file and a blocks file
+// found on the node's file system.
+func (c *Client) CatchupFromFiles(catchpointLabel, catchpointFile, blocksFile string) error {
+	algod, err := c.ensureAlgodClient()
+	if err != nil {
+		return err
+	}
+	_, err = algod.CatchupFromFiles(catchpointLabel, catchpointFile, blocksFile)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
 const defaultAppIdx = 1380011588
 
 // MakeDryrunStateBytes function creates DryrunRequest data structure in serialized form according to the format
@@ -1289,6 +1308,15 @@ func (c *Client) SimulateTransactions(request v2.PreEncodedSimulateRequest) (res
 	return c.SimulateTransactionsRaw(protocol.EncodeReflect(&request))
 }
 
+// StateProofs returns the state proof that covers a given round.
+func (c *Client) StateProofs(round uint64) (resp model.StateProofResponse, err error) {
+	algod, err := c.ensureAlgodClient()
+	if err == nil {
+		return algod.StateProofs(round)
+	}
+	return
+}
+
 // TransactionProof returns a Merkle proof for a transaction in a block.
 func (c *Client) TransactionProof(txid string, round uint64, hashType crypto.HashType) (resp model.TransactionProofResponse, err error) {
 	algod, err := c.ensureAlgodClient()
@@ -19,11 +19,14 @@ package libgoal
 import (
 	"errors"
 	"fmt"
+	"net/http"
 
 	"github.com/algorand/go-algorand/config"
 	"github.com/algorand/go-algorand/crypto"
 	"github.com/algorand/go-algorand/crypto/merklesignature"
+	algodclient "github.com/algorand/go-algorand/daemon/algod/api/client"
 	"github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated/model"
+	"github.com/algorand/go-algorand/daemon/kmd/lib/kmdapi"
 	"github.com/algorand/go-algorand/data/account"
 	"github.com/algorand/go-algorand/data/basics"
 	"github.com/algorand/go-algorand/data/transactions"
@@ -32,15 +35,30 @@ import (
 
 var emptySchema = basics.StateSchema{}
 
-// SignTransactionWithWallet signs the passed transaction with keys from the wallet associated with the passed walletHandle
+// SignTransactionWithWallet signs the passed transaction with keys from the wallet associated with the passed walletHandle.
+// If algod reports that the sender was rekeyed, the transaction is signed with the key of its auth address instead, or
+// with the keys of the wallet that are part of it when the auth address is a multisig. See SetAuthAddrLookup.
 func (c *Client) SignTransactionWithWallet(walletHandle, pw []byte, utx transactions.Transaction) (stx transactions.SignedTxn, err error) {
 	kmd, err := c.ensureKmdClient()
 	if err != nil {
 		return
 	}
 
-	// Sign the transaction
-	resp, err := kmd.SignTransaction(walletHandle, pw, crypto.PublicKey{}, utx)
+	// Look up whether the sender was rekeyed, unless asked not to
+	if !c.skipAuthAddrLookup {
+		var authAddr basics.Address
+		authAddr, err = c.AccountAuthAddr(utx.Sender.String())
+		if err != nil {
+			return stx, fmt.Errorf("could not look up the auth address of %s: %w", utx.Sender, err)
+		}
+		if !authAddr.IsZero() {
+			return c.signTransactionWithAuthAddr(walletHandle, pw, authAddr, utx)
+		}
+	}
+
+	// Sign the transaction with the sender key. Pass it explicitly so that
+	// kmd doesn't look up the auth address again.
+	resp, err := kmd.SignTransaction(walletHandle, pw, crypto.PublicKey(utx.Sender), utx)
 	if err != nil {
 		return
 	}
@@ -50,6 +68,88 @@ func (c *Client) SignTransactionWithWallet(walletHandle, pw []byte, utx transact
 	return
 }
 
+// AccountAuthAddr returns the address the given account was rekeyed to, as reported by algod, or the zero
+// address if the account wasn't rekeyed or algod doesn't know it.
+func (c *Client) AccountAuthAddr(account string) (authAddr basics.Address, err error) {
+	info, err := c.AccountInformation(account, false)
+	if err != nil {
+		var httpErr algodclient.HTTPError
+		if errors.As(err, &httpErr) && httpErr.StatusCode == http.StatusNotFound {
+			return basics.Address{}, nil
+		}
+		return
+	}
+	if info.AuthAddr == nil || *info.AuthAddr == "" {
+		return
+	}
+	return basics.UnmarshalChecksumAddress(*info.AuthAddr)
+}
+
+// signTransactionWithAuthAddr signs utx on behalf of authAddr, the address its sender was rekeyed to. The
+// wallet must either hold the key of authAddr, or know it as a multisig address; in the latter case, the
+// multisig is signed with all of the keys of the wallet that are part of it.
+func (c *Client) signTransactionWithAuthAddr(walletHandle, pw []byte, authAddr basics.Address, utx transactions.Transaction) (stx transactions.SignedTxn, err error) {
+	kmd, err := c.ensureKmdClient()
+	if err != nil {
+		return
+	}
+
+	keys, err := kmd.ListKeys(walletHandle)
+	if err != nil {
+		return
+	}
+	walletKeys := make(map[string]bool, len(keys.Addresses))
+	for _, addr := range keys.Addresses {
+		walletKeys[addr] = true
+	}
+
+	// The wallet holds the key the sender was rekeyed to
+	if walletKeys[authAddr.String()] {
+		var resp kmdapi.APIV1POSTTransactionSignResponse
+		resp, err = kmd.SignTransaction(walletHandle, pw, crypto.PublicKey(authAddr), utx)
+		if err != nil {
+			return
+		}
+		err = protocol.Decode(resp.SignedTransaction, &stx)
+		return
+	}
+
+	// The sender was rekeyed to a multisig address the wallet knows
+	preimage, err := kmd.ExportMultisigAddr(walletHandle, authAddr.String())
+	if err != nil {
+		return stx, fmt.Errorf("account %s was rekeyed to %s, whose key isn't in the wallet", utx.Sender, authAddr)
+	}
+
+	txBytes := protocol.Encode(&utx)
+	msig := crypto.MultisigPreimageFromPKs(preimage.Version, preimage.Threshold, preimage.PKs)
+	signed := false
+	for _, pk := range preimage.PKs {
+		if !walletKeys[basics.Address(pk).String()] {
+			continue
+		}
+		var resp kmdapi.APIV1POSTMultisigTransactionSignResponse
+		resp, err = kmd.MultisigSignTransaction(walletHandle, pw, txBytes, pk, msig, crypto.Digest(authAddr))
+		if err != nil {
+			return
+		}
+		err = protocol.Decode(resp.Multisig, &msig)
+		if err != nil {
+			return
+		}
+		signed = true
+	}
+	if !signed {
+		return stx, fmt.Errorf("account %s was rekeyed to multisig %s, which has no key in the wallet", utx.Sender, authAddr)
+	}
+
+	stx = transactions.SignedTxn{
+		Txn:      utx,
+		Msig:     msig,
+		AuthAddr: authAddr,
+	}
+	return
+}
+
 // SignTransactionWithWalletAndSigner signs the passed transaction under a specific signer (which may differ from the sender's address). This is necessary after an account has been rekeyed.
 // If signerAddr is the empty string, just infer spending key from the sender address.
 func (c *Client) SignTransactionWithWalletAndSigner(walletHandle, pw []byte, signerAddr string, utx transactions.Transaction) (stx transactions.SignedTxn, err error) {
@@ -94,6 +194,65 @@ func (c *Client) SignProgramWithWallet(walletHandle, pw []byte, addr string, pro
 	return
 }
 
+// SignTransactionGroupWithWallet signs the passed transactions, which must share the same group ID,
+// with keys from the wallet associated with the passed walletHandle. signerAddrs is either empty, or holds
+// the address to sign each of the transactions under; an empty address infers the key from the sender.
+func (c *Client) SignTransactionGroupWithWallet(walletHandle, pw []byte, utxs []transactions.Transaction, signerAddrs []string) (stxs []transactions.SignedTxn, err error) {
+	kmd, err := c.ensureKmdClient()
+	if err != nil {
+		return
+	}
+
+	var pks []crypto.PublicKey
+	if len(signerAddrs) != 0 {
+		pks = make([]crypto.PublicKey, len(signerAddrs))
+		for i, signerAddr := range signerAddrs {
+			if signerAddr == "" {
+				continue
+			}
+			var authaddr basics.Address
+			authaddr, err = basics.UnmarshalChecksumAddress(signerAddr)
+			if err != nil {
+				return
+			}
+			pks[i] = crypto.PublicKey(authaddr)
+		}
+	}
+
+	// Sign the transactions
+	resp, err := kmd.SignTransactionGroup(walletHandle, pw, pks, utxs)
+	if err != nil {
+		return
+	}
+
+	// Decode the SignedTxns
+	stxs = make([]transactions.SignedTxn, len(resp.SignedTransactions))
+	for i := range resp.SignedTransactions {
+		err = protocol.Decode(resp.SignedTransactions[i], &stxs[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return
+}
+
+// SignDataWithWallet signs the passed data under the passed domain with the key of addr from the wallet
+// associated with the passed walletHandle
+func (c *Client) SignDataWithWallet(walletHandle, pw []byte, addr string, domain string, data []byte) (signature crypto.Signature, err error) {
+	kmd, err := c.ensureKmdClient()
+	if err != nil {
+		return
+	}
+
+	resp, err := kmd.SignData(walletHandle, pw, addr, domain, data)
+	if err != nil {
+		return
+	}
+
+	copy(signature[:], resp.Signature)
+	return
+}
+
 // MultisigSignTransactionWithWallet creates a multisig (or adds to an existing partial multisig, if one is provided), signing with the key corresponding to the given address and using the specified wallet
 // TODO instead of returning MultisigSigs, accept and return blobs
 func (c *Client) MultisigSignTransactionWithWallet(walletHandle, pw []byte, utx transactions.Transaction, signerAddr string, partial crypto.MultisigSig) (msig crypto.MultisigSig, err error) {
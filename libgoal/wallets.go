@@ -220,3 +220,49 @@ func (c *Client) ExportMasterDerivationKey(wh []byte, pw []byte) (mdk crypto.Mas
 	// Return the mdk from the response
 	return resp.MasterDerivationKey, nil
 }
+
+// BackupWallet returns a backup of the given wallet, encrypted with its password
+func (c *Client) BackupWallet(wh []byte, pw []byte) (backup []byte, err error) {
+	kmd, err := c.ensureKmdClient()
+	if err != nil {
+		return
+	}
+
+	// Export the backup
+	resp, err := kmd.ExportWallet(wh, pw)
+	if err != nil {
+		return
+	}
+
+	return resp.Backup, nil
+}
+
+// UpgradeWalletKDF re-encrypts the given wallet under its password, using the
+// key derivation function currently configured in kmd
+func (c *Client) UpgradeWalletKDF(wh []byte, pw []byte) error {
+	kmd, err := c.ensureKmdClient()
+	if err != nil {
+		return err
+	}
+
+	_, err = kmd.UpgradeWalletKDF(wh, pw)
+	return err
+}
+
+// RestoreWallet creates a new wallet from a backup made with BackupWallet,
+// returning the new wallet's ID. If name is blank, the name of the backed up
+// wallet is used.
+func (c *Client) RestoreWallet(name []byte, password []byte, backup []byte) ([]byte, error) {
+	kmd, err := c.ensureKmdClient()
+	if err != nil {
+		return nil, err
+	}
+
+	// Restore the wallet
+	resp, err := kmd.ImportWallet(name, defaultWalletDriver, password, backup)
+	if err != nil {
+		return nil, err
+	}
+
+	return []byte(resp.Wallet.ID), nil
+}
@@ -0,0 +1,235 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package network
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"strings"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+// HybridP2PNetwork runs the websocket and the p2p gossip networks side by side,
+// so that a relay can serve the peers of both transports and bridge the messages between them.
+type HybridP2PNetwork struct {
+	p2pNetwork *P2PNetwork
+	wsNetwork  *WebsocketNetwork
+}
+
+// NewHybridP2PNetwork constructs a GossipNode that combines a WebsocketNetwork and a P2PNetwork.
+// The phonebook addresses which are multiaddrs are used by the p2p network, the others by the websocket network.
+func NewHybridP2PNetwork(log logging.Logger, cfg config.Local, datadir string, phonebookAddresses []string, genesisID string, networkID protocol.NetworkID, nodeInfo NodeInfo) (*HybridP2PNetwork, error) {
+	var wsAddresses, p2pAddresses []string
+	for _, addr := range phonebookAddresses {
+		if strings.HasPrefix(addr, "/") {
+			p2pAddresses = append(p2pAddresses, addr)
+		} else {
+			wsAddresses = append(wsAddresses, addr)
+		}
+	}
+
+	p2pnet, err := NewP2PNetwork(log, cfg, datadir, p2pAddresses, genesisID, networkID)
+	if err != nil {
+		return nil, err
+	}
+	wsnet, err := NewWebsocketNetwork(log, cfg, wsAddresses, genesisID, networkID, nodeInfo)
+	if err != nil {
+		p2pnet.Stop()
+		return nil, err
+	}
+	// the requests which are not addressed to a p2p peer go through the websocket network transport
+	p2pnet.roundTripper = p2pnet.service.MakeHTTPRoundTripper(wsnet.GetRoundTripper())
+	return &HybridP2PNetwork{
+		p2pNetwork: p2pnet,
+		wsNetwork:  wsnet,
+	}, nil
+}
+
+// SetPrioScheme sets the priority scheme of the websocket network
+func (n *HybridP2PNetwork) SetPrioScheme(scheme NetPrioScheme) {
+	n.wsNetwork.SetPrioScheme(scheme)
+}
+
+// Address returns the address of the websocket network
+func (n *HybridP2PNetwork) Address() (string, bool) {
+	return n.wsNetwork.Address()
+}
+
+// splitExcept returns the excluded peer as seen by each of the networks
+func splitExcept(except Peer) (wsExcept Peer, p2pExcept Peer) {
+	switch except.(type) {
+	case *wsPeer:
+		return except, nil
+	case *p2pPeer:
+		return nil, except
+	}
+	return nil, nil
+}
+
+// Broadcast sends a message through both networks
+func (n *HybridP2PNetwork) Broadcast(ctx context.Context, tag protocol.Tag, data []byte, wait bool, except Peer) error {
+	wsExcept, p2pExcept := splitExcept(except)
+	if err := n.p2pNetwork.Broadcast(ctx, tag, data, wait, p2pExcept); err != nil {
+		return err
+	}
+	return n.wsNetwork.Broadcast(ctx, tag, data, wait, wsExcept)
+}
+
+// BroadcastArray sends an array of messages through both networks
+func (n *HybridP2PNetwork) BroadcastArray(ctx context.Context, tags []protocol.Tag, data [][]byte, wait bool, except Peer) error {
+	wsExcept, p2pExcept := splitExcept(except)
+	if err := n.p2pNetwork.BroadcastArray(ctx, tags, data, wait, p2pExcept); err != nil {
+		return err
+	}
+	return n.wsNetwork.BroadcastArray(ctx, tags, data, wait, wsExcept)
+}
+
+// Relay relays a message through both networks
+func (n *HybridP2PNetwork) Relay(ctx context.Context, tag protocol.Tag, data []byte, wait bool, except Peer) error {
+	wsExcept, p2pExcept := splitExcept(except)
+	if err := n.p2pNetwork.Relay(ctx, tag, data, wait, p2pExcept); err != nil {
+		return err
+	}
+	return n.wsNetwork.Relay(ctx, tag, data, wait, wsExcept)
+}
+
+// RelayArray relays an array of messages through both networks
+func (n *HybridP2PNetwork) RelayArray(ctx context.Context, tags []protocol.Tag, data [][]byte, wait bool, except Peer) error {
+	wsExcept, p2pExcept := splitExcept(except)
+	if err := n.p2pNetwork.RelayArray(ctx, tags, data, wait, p2pExcept); err != nil {
+		return err
+	}
+	return n.wsNetwork.RelayArray(ctx, tags, data, wait, wsExcept)
+}
+
+// Disconnect from the given peer, whichever network it is connected through
+func (n *HybridP2PNetwork) Disconnect(badnode Peer) {
+	switch badnode.(type) {
+	case *wsPeer:
+		n.wsNetwork.Disconnect(badnode)
+	case *p2pPeer:
+		n.p2pNetwork.Disconnect(badnode)
+	}
+}
+
+// DisconnectPeers disconnects from all the peers of both networks
+func (n *HybridP2PNetwork) DisconnectPeers() {
+	n.p2pNetwork.DisconnectPeers()
+	n.wsNetwork.DisconnectPeers()
+}
+
+// Ready returns the readiness channel of the websocket network
+func (n *HybridP2PNetwork) Ready() chan struct{} {
+	return n.wsNetwork.Ready()
+}
+
+// RegisterHTTPHandler registers the handler on both networks, so that it is served to the peers of both transports
+func (n *HybridP2PNetwork) RegisterHTTPHandler(path string, handler http.Handler) {
+	n.p2pNetwork.RegisterHTTPHandler(path, handler)
+	n.wsNetwork.RegisterHTTPHandler(path, handler)
+}
+
+// RequestConnectOutgoing asks both networks to connect to new peers
+func (n *HybridP2PNetwork) RequestConnectOutgoing(replace bool, quit <-chan struct{}) {
+	n.p2pNetwork.RequestConnectOutgoing(replace, quit)
+	n.wsNetwork.RequestConnectOutgoing(replace, quit)
+}
+
+// GetPeers returns the peers of both networks matching the given options
+func (n *HybridP2PNetwork) GetPeers(options ...PeerOption) []Peer {
+	return append(n.wsNetwork.GetPeers(options...), n.p2pNetwork.GetPeers(options...)...)
+}
+
+// Start both networks
+func (n *HybridP2PNetwork) Start() {
+	n.wsNetwork.Start()
+	n.p2pNetwork.Start()
+}
+
+// Stop both networks
+func (n *HybridP2PNetwork) Stop() {
+	n.p2pNetwork.Stop()
+	n.wsNetwork.Stop()
+}
+
+// RegisterHandlers adds the given message handlers to both networks
+func (n *HybridP2PNetwork) RegisterHandlers(dispatch []TaggedMessageHandler) {
+	n.p2pNetwork.RegisterHandlers(dispatch)
+	n.wsNetwork.RegisterHandlers(dispatch)
+}
+
+// ClearHandlers deregisters all the message handlers of both networks
+func (n *HybridP2PNetwork) ClearHandlers() {
+	n.p2pNetwork.ClearHandlers()
+	n.wsNetwork.ClearHandlers()
+}
+
+// GetRoundTripper returns the transport of the p2p network, which sends the requests
+// that are not addressed to a p2p peer through the transport of the websocket network
+func (n *HybridP2PNetwork) GetRoundTripper() http.RoundTripper {
+	return n.p2pNetwork.GetRoundTripper()
+}
+
+// OnNetworkAdvance notifies both networks
+func (n *HybridP2PNetwork) OnNetworkAdvance() {
+	n.p2pNetwork.OnNetworkAdvance()
+	n.wsNetwork.OnNetworkAdvance()
+}
+
+// GetHTTPRequestConnection returns the connection of a request served by either network
+func (n *HybridP2PNetwork) GetHTTPRequestConnection(request *http.Request) (conn net.Conn) {
+	if conn = n.p2pNetwork.GetHTTPRequestConnection(request); conn != nil {
+		return conn
+	}
+	return n.wsNetwork.GetHTTPRequestConnection(request)
+}
+
+// RegisterMessageInterest registers the message interest on both networks
+func (n *HybridP2PNetwork) RegisterMessageInterest(tag protocol.Tag) {
+	n.p2pNetwork.RegisterMessageInterest(tag)
+	n.wsNetwork.RegisterMessageInterest(tag)
+}
+
+// SubstituteGenesisID substitutes the "{genesisID}" with their network-specific genesisID.
+func (n *HybridP2PNetwork) SubstituteGenesisID(rawURL string) string {
+	return n.wsNetwork.SubstituteGenesisID(rawURL)
+}
+
+// GetPeerData returns the peer data associated with a particular key.
+func (n *HybridP2PNetwork) GetPeerData(peer Peer, key string) interface{} {
+	switch peer.(type) {
+	case *wsPeer:
+		return n.wsNetwork.GetPeerData(peer, key)
+	case *p2pPeer:
+		return n.p2pNetwork.GetPeerData(peer, key)
+	}
+	return nil
+}
+
+// SetPeerData sets the peer data associated with a particular key.
+func (n *HybridP2PNetwork) SetPeerData(peer Peer, key string, value interface{}) {
+	switch peer.(type) {
+	case *wsPeer:
+		n.wsNetwork.SetPeerData(peer, key, value)
+	case *p2pPeer:
+		n.p2pNetwork.SetPeerData(peer, key, value)
+	}
+}
@@ -0,0 +1,154 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package p2p
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	dht "github.com/libp2p/go-libp2p-kad-dht"
+	"github.com/libp2p/go-libp2p/core/host"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/libp2p/go-libp2p/core/protocol"
+	drouting "github.com/libp2p/go-libp2p/p2p/discovery/routing"
+	dutil "github.com/libp2p/go-libp2p/p2p/discovery/util"
+
+	"github.com/algorand/go-algorand/logging"
+	algoproto "github.com/algorand/go-algorand/protocol"
+)
+
+// findPeersTimeout bounds a single lookup of the network peers in the DHT
+const findPeersTimeout = 30 * time.Second
+
+// discovery finds the peers of the network through a Kademlia DHT. Every node advertises
+// itself under the network namespace, and looks up the other providers of that namespace.
+// Archival nodes also advertise themselves under the archival namespace, which the nodes
+// catching up look up to find the peers serving the old blocks.
+type discovery struct {
+	log               logging.Logger
+	host              host.Host
+	dht               *dht.IpfsDHT
+	routing           *drouting.RoutingDiscovery
+	namespace         string
+	archivalNamespace string
+	archival          bool
+	bootstrapPeers    []peer.AddrInfo
+
+	wg sync.WaitGroup
+}
+
+func makeDiscovery(ctx context.Context, log logging.Logger, h host.Host, networkID algoproto.NetworkID, serve bool, archival bool, bootstrapPeers []peer.AddrInfo) (*discovery, error) {
+	mode := dht.ModeAuto
+	if serve {
+		mode = dht.ModeServer
+	}
+	kad, err := dht.New(ctx, h,
+		dht.Mode(mode),
+		// keep the DHT of every network separate from the public IPFS one, and from each other
+		dht.ProtocolPrefix(protocol.ID(fmt.Sprintf("/algorand/%s", networkID))),
+		dht.BootstrapPeers(bootstrapPeers...),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &discovery{
+		log:               log,
+		host:              h,
+		dht:               kad,
+		routing:           drouting.NewRoutingDiscovery(kad),
+		namespace:         fmt.Sprintf("algorand/%s", networkID),
+		archivalNamespace: fmt.Sprintf("algorand/%s/archival", networkID),
+		archival:          archival,
+		bootstrapPeers:    bootstrapPeers,
+	}, nil
+}
+
+// start connects to the bootstrap peers, and starts advertising this node in the network namespace,
+// as well as in the archival namespace for archival nodes
+func (d *discovery) start(ctx context.Context) error {
+	connected := 0
+	for _, addrInfo := range d.bootstrapPeers {
+		if addrInfo.ID == d.host.ID() {
+			continue
+		}
+		dialCtx, cancel := context.WithTimeout(ctx, dialTimeout)
+		err := d.host.Connect(dialCtx, addrInfo)
+		cancel()
+		if err != nil {
+			d.log.Warnf("failed to connect to p2p bootstrap peer %s: %v", addrInfo.ID, err)
+			continue
+		}
+		connected++
+	}
+	if len(d.bootstrapPeers) > 0 && connected == 0 {
+		d.log.Warn("could not connect to any of the p2p bootstrap peers")
+	}
+	if err := d.dht.Bootstrap(ctx); err != nil {
+		return err
+	}
+
+	// Advertise keeps re-advertising until the context is done
+	d.wg.Add(1)
+	go func() {
+		defer d.wg.Done()
+		dutil.Advertise(ctx, d.routing, d.namespace)
+	}()
+	if d.archival {
+		d.wg.Add(1)
+		go func() {
+			defer d.wg.Done()
+			dutil.Advertise(ctx, d.routing, d.archivalNamespace)
+		}()
+	}
+	return nil
+}
+
+// findPeers looks up the peers which advertised themselves in the network namespace
+func (d *discovery) findPeers(ctx context.Context) []peer.AddrInfo {
+	return d.findProviders(ctx, d.namespace)
+}
+
+// findArchivers looks up the archival peers, which advertised themselves in the archival namespace
+func (d *discovery) findArchivers(ctx context.Context) []peer.AddrInfo {
+	return d.findProviders(ctx, d.archivalNamespace)
+}
+
+func (d *discovery) findProviders(ctx context.Context, namespace string) []peer.AddrInfo {
+	ctx, cancel := context.WithTimeout(ctx, findPeersTimeout)
+	defer cancel()
+	peersChan, err := d.routing.FindPeers(ctx, namespace)
+	if err != nil {
+		d.log.Debugf("failed to find p2p peers in %s: %v", namespace, err)
+		return nil
+	}
+	var peers []peer.AddrInfo
+	for addrInfo := range peersChan {
+		if addrInfo.ID == d.host.ID() || len(addrInfo.Addrs) == 0 {
+			continue
+		}
+		peers = append(peers, addrInfo)
+	}
+	return peers
+}
+
+func (d *discovery) close() error {
+	err := d.dht.Close()
+	d.wg.Wait()
+	return err
+}
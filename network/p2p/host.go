@@ -0,0 +1,186 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package p2p
+
+import (
+	"errors"
+
+	"github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/libp2p/go-libp2p/core/event"
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/libp2p/go-libp2p/core/protocol"
+	"github.com/libp2p/go-libp2p/core/sec"
+	blankhost "github.com/libp2p/go-libp2p/p2p/host/blank"
+	"github.com/libp2p/go-libp2p/p2p/host/eventbus"
+	"github.com/libp2p/go-libp2p/p2p/host/peerstore/pstoremem"
+	rcmgr "github.com/libp2p/go-libp2p/p2p/host/resource-manager"
+	"github.com/libp2p/go-libp2p/p2p/muxer/yamux"
+	"github.com/libp2p/go-libp2p/p2p/net/swarm"
+	"github.com/libp2p/go-libp2p/p2p/net/upgrader"
+	"github.com/libp2p/go-libp2p/p2p/protocol/identify"
+	"github.com/libp2p/go-libp2p/p2p/security/noise"
+	"github.com/libp2p/go-libp2p/p2p/transport/tcp"
+	"github.com/multiformats/go-multiaddr"
+)
+
+// tcpHost is a libp2p host which only speaks TCP, secured with noise and multiplexed with yamux.
+// It is assembled from its parts, rather than with libp2p.New, because the default host links in the
+// QUIC and WebTransport transports, and quic-go only builds with the Go releases it was written for.
+type tcpHost struct {
+	*blankhost.BlankHost
+	ids identify.IDService
+
+	// bus is the event bus of the swarm. The blank host keeps its own bus, which does not see the
+	// connection events of the swarm, so tcpHost hands out this one instead and emits the protocol
+	// updates of the blank host on it.
+	bus                      event.Bus
+	evtLocalProtocolsUpdated event.Emitter
+}
+
+// makeHost creates a tcpHost with the given identity, listening on listenAddr. The host does not
+// identify its peers until start is called.
+func makeHost(privKey crypto.PrivKey, listenAddr multiaddr.Multiaddr) (*tcpHost, error) {
+	id, err := peer.IDFromPrivateKey(privKey)
+	if err != nil {
+		return nil, err
+	}
+	ps, err := pstoremem.NewPeerstore()
+	if err != nil {
+		return nil, err
+	}
+	if err = ps.AddPrivKey(id, privKey); err != nil {
+		ps.Close()
+		return nil, err
+	}
+	if err = ps.AddPubKey(id, privKey.GetPublic()); err != nil {
+		ps.Close()
+		return nil, err
+	}
+
+	rm, err := rcmgr.NewResourceManager(rcmgr.NewFixedLimiter(rcmgr.DefaultLimits.AutoScale()))
+	if err != nil {
+		ps.Close()
+		return nil, err
+	}
+	bus := eventbus.NewBus()
+	evtLocalProtocolsUpdated, err := bus.Emitter(&event.EvtLocalProtocolsUpdated{})
+	if err != nil {
+		rm.Close()
+		ps.Close()
+		return nil, err
+	}
+	sw, err := swarm.NewSwarm(id, ps, bus, swarm.WithResourceManager(rm))
+	if err != nil {
+		evtLocalProtocolsUpdated.Close()
+		rm.Close()
+		ps.Close()
+		return nil, err
+	}
+	closeAll := func() {
+		sw.Close()
+		evtLocalProtocolsUpdated.Close()
+		rm.Close()
+		ps.Close()
+	}
+
+	muxers := []upgrader.StreamMuxer{{ID: yamux.ID, Muxer: yamux.DefaultTransport}}
+	security, err := noise.New(noise.ID, privKey, muxers)
+	if err != nil {
+		closeAll()
+		return nil, err
+	}
+	upgr, err := upgrader.New([]sec.SecureTransport{security}, muxers, nil, rm, nil)
+	if err != nil {
+		closeAll()
+		return nil, err
+	}
+	transport, err := tcp.NewTCPTransport(upgr, rm)
+	if err != nil {
+		closeAll()
+		return nil, err
+	}
+	if err = sw.AddTransport(transport); err != nil {
+		closeAll()
+		return nil, err
+	}
+	// the host seals our listening addresses into the signed peer record it creates, which the peers
+	// trust over any other address they learn, so it must only be created once we listen
+	if err = sw.Listen(listenAddr); err != nil {
+		closeAll()
+		return nil, err
+	}
+	bh := blankhost.NewBlankHost(sw)
+	if bh == nil {
+		closeAll()
+		return nil, errors.New("failed to create the libp2p host")
+	}
+	return &tcpHost{BlankHost: bh, bus: bus, evtLocalProtocolsUpdated: evtLocalProtocolsUpdated}, nil
+}
+
+// start starts the identify service, which lets the peers learn our listening addresses and the
+// protocols we support. Identify snapshots the protocols when it starts, and only picks up later
+// changes through asynchronous updates, so the protocols the peers must know about from the first
+// connection on, such as the DHT one, have to be registered before start.
+func (h *tcpHost) start() error {
+	ids, err := identify.NewIDService(h, identify.UserAgent(userAgent()))
+	if err != nil {
+		return err
+	}
+	h.ids = ids
+	h.ids.Start()
+	// the connections accepted before identify started were not seen by it
+	for _, conn := range h.Network().Conns() {
+		h.ids.IdentifyWait(conn)
+	}
+	return nil
+}
+
+// EventBus returns the event bus shared with the swarm
+func (h *tcpHost) EventBus() event.Bus {
+	return h.bus
+}
+
+// SetStreamHandler sets the handler of the given protocol, and announces the protocol to the peers
+func (h *tcpHost) SetStreamHandler(pid protocol.ID, handler network.StreamHandler) {
+	h.BlankHost.SetStreamHandler(pid, handler)
+	h.evtLocalProtocolsUpdated.Emit(event.EvtLocalProtocolsUpdated{Added: []protocol.ID{pid}})
+}
+
+// SetStreamHandlerMatch sets the handler of the protocols matching m, and announces pid to the peers
+func (h *tcpHost) SetStreamHandlerMatch(pid protocol.ID, m func(protocol.ID) bool, handler network.StreamHandler) {
+	h.BlankHost.SetStreamHandlerMatch(pid, m, handler)
+	h.evtLocalProtocolsUpdated.Emit(event.EvtLocalProtocolsUpdated{Added: []protocol.ID{pid}})
+}
+
+// RemoveStreamHandler removes the handler of the given protocol, and withdraws the protocol from the peers
+func (h *tcpHost) RemoveStreamHandler(pid protocol.ID) {
+	h.BlankHost.RemoveStreamHandler(pid)
+	h.evtLocalProtocolsUpdated.Emit(event.EvtLocalProtocolsUpdated{Removed: []protocol.ID{pid}})
+}
+
+// Close shuts down the identify service and the network, and releases the peerstore and the resource manager
+func (h *tcpHost) Close() error {
+	if h.ids != nil {
+		h.ids.Close()
+	}
+	err := h.BlankHost.Close()
+	h.evtLocalProtocolsUpdated.Close()
+	h.Peerstore().Close()
+	h.Network().ResourceManager().Close()
+	return err
+}
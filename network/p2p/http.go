@@ -0,0 +1,160 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package p2p
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"sync"
+
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/libp2p/go-libp2p/core/peerstore"
+	"github.com/libp2p/go-libp2p/core/protocol"
+)
+
+// AlgorandHTTPProtocol is the libp2p protocol the HTTP handlers of the node, such as the block and
+// catchpoint services, are served on. Each HTTP connection is a stream of this protocol.
+const AlgorandHTTPProtocol = protocol.ID("/algorand/http/1.0.0")
+
+var errHTTPListenerClosed = errors.New("p2p HTTP listener closed")
+
+// ListenHTTP returns a listener accepting the AlgorandHTTPProtocol streams opened by the peers
+func (s *Service) ListenHTTP() net.Listener {
+	l := &streamListener{
+		service: s,
+		streams: make(chan network.Stream),
+		closing: make(chan struct{}),
+	}
+	s.host.SetStreamHandler(AlgorandHTTPProtocol, func(stream network.Stream) {
+		select {
+		case l.streams <- stream:
+		case <-l.closing:
+			stream.Reset()
+		}
+	})
+	return l
+}
+
+// streamListener is a net.Listener of the AlgorandHTTPProtocol streams
+type streamListener struct {
+	service   *Service
+	streams   chan network.Stream
+	closing   chan struct{}
+	closeOnce sync.Once
+}
+
+// Accept implements net.Listener
+func (l *streamListener) Accept() (net.Conn, error) {
+	select {
+	case stream := <-l.streams:
+		return streamConn{stream}, nil
+	case <-l.closing:
+		return nil, errHTTPListenerClosed
+	}
+}
+
+// Close implements net.Listener
+func (l *streamListener) Close() error {
+	l.closeOnce.Do(func() {
+		l.service.host.RemoveStreamHandler(AlgorandHTTPProtocol)
+		close(l.closing)
+	})
+	return nil
+}
+
+// Addr implements net.Listener
+func (l *streamListener) Addr() net.Addr {
+	return peerAddr(l.service.host.ID())
+}
+
+// streamConn is a net.Conn over a libp2p stream, as the stream already has all the other methods
+type streamConn struct {
+	network.Stream
+}
+
+// LocalAddr implements net.Conn
+func (c streamConn) LocalAddr() net.Addr {
+	return peerAddr(c.Conn().LocalPeer())
+}
+
+// RemoteAddr implements net.Conn
+func (c streamConn) RemoteAddr() net.Addr {
+	return peerAddr(c.Conn().RemotePeer())
+}
+
+// peerAddr is the net.Addr of a peer, which libp2p reaches through any of its multiaddrs
+type peerAddr peer.ID
+
+// Network implements net.Addr
+func (a peerAddr) Network() string {
+	return "libp2p"
+}
+
+// String implements net.Addr
+func (a peerAddr) String() string {
+	return peer.ID(a).String()
+}
+
+// AddPeerAddrs records the addresses of a peer this node is not necessarily connected to,
+// so that HTTP requests could be sent to it
+func (s *Service) AddPeerAddrs(addrInfo peer.AddrInfo) {
+	s.host.Peerstore().AddAddrs(addrInfo.ID, addrInfo.Addrs, peerstore.AddressTTL)
+}
+
+// httpRoundTripper sends the requests whose host is a peer ID over AlgorandHTTPProtocol streams,
+// and every other request through the fallback round tripper.
+type httpRoundTripper struct {
+	p2p      *http.Transport
+	fallback http.RoundTripper
+}
+
+// MakeHTTPRoundTripper returns a round tripper which reaches the peers identified by the host of the
+// request URL, such as http://12D3KooW.../v1/..., over libp2p. Other requests are sent through fallback.
+func (s *Service) MakeHTTPRoundTripper(fallback http.RoundTripper) http.RoundTripper {
+	return &httpRoundTripper{
+		p2p: &http.Transport{
+			DialContext: func(ctx context.Context, _, addr string) (net.Conn, error) {
+				host, _, err := net.SplitHostPort(addr)
+				if err != nil {
+					return nil, err
+				}
+				id, err := peer.Decode(host)
+				if err != nil {
+					return nil, err
+				}
+				stream, err := s.host.NewStream(ctx, id, AlgorandHTTPProtocol)
+				if err != nil {
+					return nil, err
+				}
+				return streamConn{stream}, nil
+			},
+			MaxIdleConnsPerHost: 1,
+		},
+		fallback: fallback,
+	}
+}
+
+// RoundTrip implements http.RoundTripper
+func (rt *httpRoundTripper) RoundTrip(request *http.Request) (*http.Response, error) {
+	if _, err := peer.Decode(request.URL.Hostname()); err == nil {
+		return rt.p2p.RoundTrip(request)
+	}
+	return rt.fallback.RoundTrip(request)
+}
@@ -0,0 +1,234 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package p2p
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/algorand/go-deadlock"
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	"github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/libp2p/go-libp2p/core/host"
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/libp2p/go-libp2p/core/protocol"
+	"github.com/multiformats/go-multiaddr"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/logging"
+	algoproto "github.com/algorand/go-algorand/protocol"
+)
+
+// AlgorandStreamProtocol is the libp2p protocol used for the messages which are not disseminated through gossipsub
+const AlgorandStreamProtocol = protocol.ID("/algorand/gossip/1.0.0")
+
+// dialTimeout bounds the time spent connecting to a single peer
+const dialTimeout = 30 * time.Second
+
+// Service wraps the libp2p host together with the gossipsub router and the peer discovery
+// used by the p2p gossip network.
+type Service struct {
+	log    logging.Logger
+	host   host.Host
+	pubsub *pubsub.PubSub
+	dht    *discovery
+
+	topics   map[string]*pubsub.Topic
+	topicsMu deadlock.Mutex
+}
+
+// MakeService creates a libp2p host listening on listenAddr (host:port), along with its gossipsub router and DHT.
+// bootstrapPeers are used to join the DHT, and networkID scopes both the DHT and the peer discovery to a single network.
+func MakeService(ctx context.Context, log logging.Logger, cfg config.Local, privKey crypto.PrivKey, listenAddr string, networkID algoproto.NetworkID, bootstrapPeers []peer.AddrInfo) (*Service, error) {
+	listenMultiaddr, err := hostPortToMultiaddr(listenAddr)
+	if err != nil {
+		return nil, err
+	}
+	h, err := makeHost(privKey, listenMultiaddr)
+	if err != nil {
+		return nil, err
+	}
+
+	ps, err := makePubSub(ctx, cfg, h)
+	if err != nil {
+		h.Close()
+		return nil, err
+	}
+
+	// nodes listening on a well known address serve the DHT, others only query it
+	serveDHT := cfg.NetAddress != "" || cfg.P2PNetAddress != ""
+	d, err := makeDiscovery(ctx, log, h, networkID, serveDHT, cfg.Archival, bootstrapPeers)
+	if err != nil {
+		h.Close()
+		return nil, err
+	}
+
+	// the gossipsub and DHT protocols are registered by now, so they are announced to every peer
+	if err := h.start(); err != nil {
+		d.close()
+		h.Close()
+		return nil, err
+	}
+
+	return &Service{
+		log:    log,
+		host:   h,
+		pubsub: ps,
+		dht:    d,
+		topics: make(map[string]*pubsub.Topic),
+	}, nil
+}
+
+// hostPortToMultiaddr converts a host:port address into a TCP multiaddr.
+// An empty address listens on an ephemeral port of all the interfaces.
+func hostPortToMultiaddr(addr string) (multiaddr.Multiaddr, error) {
+	if addr == "" {
+		addr = ":0"
+	}
+	host, port, err := net.SplitHostPort(addr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid p2p listen address %s: %w", addr, err)
+	}
+	if host == "" {
+		host = "0.0.0.0"
+	}
+	proto := "ip4"
+	if ip := net.ParseIP(host); ip == nil {
+		proto = "dns"
+	} else if ip.To4() == nil {
+		proto = "ip6"
+	}
+	return multiaddr.NewMultiaddr(fmt.Sprintf("/%s/%s/tcp/%s", proto, host, port))
+}
+
+func userAgent() string {
+	version := config.GetCurrentVersion()
+	return fmt.Sprintf("algod/%d.%d (%s; commit=%s; %d) %s(%s)", version.Major, version.Minor, version.Channel, version.CommitHash, version.BuildNumber, runtime.GOOS, runtime.GOARCH)
+}
+
+// ID returns the peer ID of this node
+func (s *Service) ID() peer.ID {
+	return s.host.ID()
+}
+
+// AddrInfo returns the peer ID and listening addresses of this node
+func (s *Service) AddrInfo() peer.AddrInfo {
+	return peer.AddrInfo{ID: s.host.ID(), Addrs: s.host.Addrs()}
+}
+
+// Addrs returns the full multiaddrs, including the peer ID, this node can be reached at
+func (s *Service) Addrs() []string {
+	addrs := make([]string, 0, len(s.host.Addrs()))
+	for _, addr := range s.host.Addrs() {
+		addrs = append(addrs, fmt.Sprintf("%s/p2p/%s", addr, s.host.ID()))
+	}
+	return addrs
+}
+
+// Start joins the DHT and starts advertising and discovering peers of the network
+func (s *Service) Start(ctx context.Context) error {
+	return s.dht.start(ctx)
+}
+
+// Close shuts down the DHT and the libp2p host.
+// The context given to Start must be done before calling Close.
+func (s *Service) Close() error {
+	if err := s.dht.close(); err != nil {
+		s.log.Warnf("failed to close the p2p DHT: %v", err)
+	}
+	return s.host.Close()
+}
+
+// SetStreamHandler sets the handler of incoming AlgorandStreamProtocol streams
+func (s *Service) SetStreamHandler(handler network.StreamHandler) {
+	s.host.SetStreamHandler(AlgorandStreamProtocol, handler)
+}
+
+// NewStream opens a AlgorandStreamProtocol stream to the given peer
+func (s *Service) NewStream(ctx context.Context, id peer.ID) (network.Stream, error) {
+	return s.host.NewStream(ctx, id, AlgorandStreamProtocol)
+}
+
+// Notify registers a notifiee for the connections events of the host
+func (s *Service) Notify(notifiee network.Notifiee) {
+	s.host.Network().Notify(notifiee)
+}
+
+// DialNode connects to the given peer
+func (s *Service) DialNode(ctx context.Context, addrInfo peer.AddrInfo) error {
+	ctx, cancel := context.WithTimeout(ctx, dialTimeout)
+	defer cancel()
+	return s.host.Connect(ctx, addrInfo)
+}
+
+// DialPeersUntilTargetCount connects to the discovered peers, until there are targetConnCount outgoing connections
+func (s *Service) DialPeersUntilTargetCount(ctx context.Context, targetConnCount int) {
+	outgoing := 0
+	for _, conn := range s.host.Network().Conns() {
+		if conn.Stat().Direction == network.DirOutbound {
+			outgoing++
+		}
+	}
+	if outgoing >= targetConnCount {
+		return
+	}
+	for _, addrInfo := range s.dht.findPeers(ctx) {
+		if outgoing >= targetConnCount {
+			return
+		}
+		if addrInfo.ID == s.host.ID() || s.host.Network().Connectedness(addrInfo.ID) == network.Connected {
+			continue
+		}
+		if err := s.DialNode(ctx, addrInfo); err != nil {
+			s.log.Debugf("failed to dial p2p peer %s: %v", addrInfo.ID, err)
+			continue
+		}
+		outgoing++
+	}
+}
+
+// FindArchivers looks up the archival peers of the network in the DHT
+func (s *Service) FindArchivers(ctx context.Context) []peer.AddrInfo {
+	return s.dht.findArchivers(ctx)
+}
+
+// ClosePeer disconnects from the given peer
+func (s *Service) ClosePeer(id peer.ID) error {
+	return s.host.Network().ClosePeer(id)
+}
+
+// ParseAddrInfos parses the multiaddrs out of the given addresses. Addresses which are not
+// full multiaddrs, such as the host:port addresses of the websocket network, are skipped.
+func ParseAddrInfos(addrs []string) []peer.AddrInfo {
+	infos := make([]peer.AddrInfo, 0, len(addrs))
+	for _, addr := range addrs {
+		if !strings.HasPrefix(addr, "/") {
+			continue
+		}
+		info, err := peer.AddrInfoFromString(addr)
+		if err != nil {
+			continue
+		}
+		infos = append(infos, *info)
+	}
+	return infos
+}
@@ -0,0 +1,169 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package p2p
+
+import (
+	"context"
+	"path"
+	"testing"
+	"time"
+
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/logging"
+	algoproto "github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestGetPrivKey(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	cfg := config.GetDefaultLocal()
+	dataDir := t.TempDir()
+
+	// ephemeral keys are not stored
+	key1, err := GetPrivKey(cfg, dataDir)
+	require.NoError(t, err)
+	key2, err := GetPrivKey(cfg, dataDir)
+	require.NoError(t, err)
+	require.False(t, key1.Equals(key2))
+	require.NoFileExists(t, path.Join(dataDir, DefaultPrivKeyPath))
+
+	// persisted keys are reloaded
+	cfg.P2PPersistPeerID = true
+	key1, err = GetPrivKey(cfg, dataDir)
+	require.NoError(t, err)
+	require.FileExists(t, path.Join(dataDir, DefaultPrivKeyPath))
+	key2, err = GetPrivKey(cfg, dataDir)
+	require.NoError(t, err)
+	require.True(t, key1.Equals(key2))
+
+	// an explicit location must exist
+	cfg.P2PPrivateKeyLocation = path.Join(dataDir, "missing.key")
+	_, err = GetPrivKey(cfg, dataDir)
+	require.Error(t, err)
+	cfg.P2PPrivateKeyLocation = path.Join(dataDir, DefaultPrivKeyPath)
+	key2, err = GetPrivKey(cfg, t.TempDir())
+	require.NoError(t, err)
+	require.True(t, key1.Equals(key2))
+}
+
+func TestHostPortToMultiaddr(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	tests := []struct {
+		addr     string
+		expected string
+	}{
+		{"", "/ip4/0.0.0.0/tcp/0"},
+		{":4190", "/ip4/0.0.0.0/tcp/4190"},
+		{"127.0.0.1:4190", "/ip4/127.0.0.1/tcp/4190"},
+		{"[::1]:4190", "/ip6/::1/tcp/4190"},
+		{"relay.algorand.test:4190", "/dns/relay.algorand.test/tcp/4190"},
+	}
+	for _, test := range tests {
+		maddr, err := hostPortToMultiaddr(test.addr)
+		require.NoError(t, err, test.addr)
+		require.Equal(t, test.expected, maddr.String())
+	}
+
+	_, err := hostPortToMultiaddr("4190")
+	require.Error(t, err)
+}
+
+func TestParseAddrInfos(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	privKey, err := generatePrivKey()
+	require.NoError(t, err)
+	id, err := peer.IDFromPrivateKey(privKey)
+	require.NoError(t, err)
+
+	infos := ParseAddrInfos([]string{
+		"relay.algorand.test:4160",
+		"/ip4/127.0.0.1/tcp/4190/p2p/" + id.String(),
+		"/ip4/127.0.0.1/tcp/4190",
+	})
+	require.Len(t, infos, 1)
+	require.Equal(t, id, infos[0].ID)
+}
+
+func makeTestService(t *testing.T, bootstrapPeers []peer.AddrInfo) *Service {
+	cfg := config.GetDefaultLocal()
+	privKey, err := GetPrivKey(cfg, t.TempDir())
+	require.NoError(t, err)
+	s, err := MakeService(context.Background(), logging.TestingLog(t), cfg, privKey, "127.0.0.1:0", algoproto.NetworkID("p2ptest"), bootstrapPeers)
+	require.NoError(t, err)
+	return s
+}
+
+func TestServicePubSub(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	serviceA := makeTestService(t, nil)
+	serviceB := makeTestService(t, []peer.AddrInfo{serviceA.AddrInfo()})
+	defer func() {
+		cancel()
+		serviceB.Close()
+		serviceA.Close()
+	}()
+	require.NoError(t, serviceA.Start(ctx))
+	require.NoError(t, serviceB.Start(ctx))
+
+	received := make(chan []byte, 1)
+	subA, err := serviceA.Subscribe(TXTopicName, func(ctx context.Context, from peer.ID, msg *pubsub.Message) pubsub.ValidationResult {
+		return pubsub.ValidationAccept
+	})
+	require.NoError(t, err)
+	defer subA.Cancel()
+	subB, err := serviceB.Subscribe(TXTopicName, func(ctx context.Context, from peer.ID, msg *pubsub.Message) pubsub.ValidationResult {
+		if from == serviceA.ID() {
+			select {
+			case received <- msg.Data:
+			default:
+			}
+		}
+		return pubsub.ValidationAccept
+	})
+	require.NoError(t, err)
+	defer subB.Cancel()
+
+	// wait for the subscriptions to propagate
+	require.Eventually(t, func() bool {
+		return len(serviceA.ListPeersForTopic(TXTopicName)) == 1
+	}, 10*time.Second, 50*time.Millisecond)
+
+	// the first messages can be missed until the gossipsub heartbeat has set up the mesh, so keep publishing
+	require.Eventually(t, func() bool {
+		if err := serviceA.Publish(ctx, TXTopicName, []byte("txn")); err != nil {
+			return false
+		}
+		select {
+		case data := <-received:
+			return string(data) == "txn"
+		case <-time.After(100 * time.Millisecond):
+			return false
+		}
+	}, 10*time.Second, 50*time.Millisecond)
+}
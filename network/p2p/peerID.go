@@ -0,0 +1,85 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package p2p
+
+import (
+	"crypto/rand"
+	"fmt"
+	"os"
+	"path"
+
+	"github.com/libp2p/go-libp2p/core/crypto"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/util"
+)
+
+// DefaultPrivKeyPath is the default file name of the persisted libp2p private key, relative to the data directory
+const DefaultPrivKeyPath = "peerIDPrivKey.key"
+
+// GetPrivKey returns the libp2p private key of the node.
+// If the configuration asks for a persistent peer ID, the key is read from P2PPrivateKeyLocation
+// (or from DefaultPrivKeyPath in dataDir), and generated and stored there when missing.
+// Otherwise, a new ephemeral key is generated.
+func GetPrivKey(cfg config.Local, dataDir string) (crypto.PrivKey, error) {
+	if !cfg.P2PPersistPeerID && cfg.P2PPrivateKeyLocation == "" {
+		return generatePrivKey()
+	}
+
+	keyPath := cfg.P2PPrivateKeyLocation
+	if keyPath == "" {
+		keyPath = path.Join(dataDir, DefaultPrivKeyPath)
+	}
+	if util.FileExists(keyPath) {
+		return loadPrivKeyFromFile(keyPath)
+	}
+	if cfg.P2PPrivateKeyLocation != "" {
+		// an explicitly specified key must exist, otherwise the node would silently change its identity
+		return nil, fmt.Errorf("libp2p private key file %s does not exist", keyPath)
+	}
+
+	privKey, err := generatePrivKey()
+	if err != nil {
+		return nil, err
+	}
+	return privKey, writePrivKeyToFile(keyPath, privKey)
+}
+
+func generatePrivKey() (crypto.PrivKey, error) {
+	privKey, _, err := crypto.GenerateEd25519Key(rand.Reader)
+	return privKey, err
+}
+
+func loadPrivKeyFromFile(keyPath string) (crypto.PrivKey, error) {
+	data, err := os.ReadFile(keyPath)
+	if err != nil {
+		return nil, err
+	}
+	privKey, err := crypto.UnmarshalPrivateKey(data)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode libp2p private key %s: %w", keyPath, err)
+	}
+	return privKey, nil
+}
+
+func writePrivKeyToFile(keyPath string, privKey crypto.PrivKey) error {
+	data, err := crypto.MarshalPrivateKey(privKey)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(keyPath, data, 0600)
+}
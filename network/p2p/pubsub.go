@@ -0,0 +1,115 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package p2p
+
+import (
+	"context"
+
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	"github.com/libp2p/go-libp2p/core/host"
+	"github.com/libp2p/go-libp2p/core/peer"
+
+	"github.com/algorand/go-algorand/config"
+	algoproto "github.com/algorand/go-algorand/protocol"
+)
+
+const (
+	// TXTopicName is the gossipsub topic of the transaction messages
+	TXTopicName = "algotx01"
+	// AVTopicName is the gossipsub topic of the agreement vote messages
+	AVTopicName = "algoav01"
+)
+
+// maxPubSubMessageSize matches the largest message accepted by the websocket network
+const maxPubSubMessageSize = 6 * 1024 * 1024
+
+// TopicForTag returns the gossipsub topic used for the messages of the given tag,
+// and false if the tag is not disseminated through gossipsub.
+func TopicForTag(tag algoproto.Tag) (string, bool) {
+	switch tag {
+	case algoproto.TxnTag:
+		return TXTopicName, true
+	case algoproto.AgreementVoteTag:
+		return AVTopicName, true
+	}
+	return "", false
+}
+
+// TagForTopic returns the tag of the messages published on the given gossipsub topic
+func TagForTopic(topic string) (algoproto.Tag, bool) {
+	switch topic {
+	case TXTopicName:
+		return algoproto.TxnTag, true
+	case AVTopicName:
+		return algoproto.AgreementVoteTag, true
+	}
+	return "", false
+}
+
+func makePubSub(ctx context.Context, cfg config.Local, h host.Host) (*pubsub.PubSub, error) {
+	options := []pubsub.Option{
+		// messages are signed by the peer publishing them, so that every relaying node
+		// publishes a message of its own after having validated the payload.
+		pubsub.WithMessageSignaturePolicy(pubsub.StrictSign),
+		pubsub.WithMaxMessageSize(maxPubSubMessageSize),
+		pubsub.WithPeerOutboundQueueSize(cfg.OutgoingMessageFilterBucketSize * cfg.OutgoingMessageFilterBucketCount),
+		pubsub.WithValidateQueueSize(cfg.TxBacklogSize),
+	}
+	return pubsub.NewGossipSub(ctx, h, options...)
+}
+
+// Subscribe joins the given topic and validates the incoming messages with val.
+// The messages which are not accepted by the validator are neither delivered nor forwarded.
+func (s *Service) Subscribe(topic string, val pubsub.ValidatorEx) (*pubsub.Subscription, error) {
+	if err := s.pubsub.RegisterTopicValidator(topic, val); err != nil {
+		return nil, err
+	}
+	t, err := s.getOrJoin(topic)
+	if err != nil {
+		return nil, err
+	}
+	return t.Subscribe()
+}
+
+// Publish publishes data on the given topic
+func (s *Service) Publish(ctx context.Context, topic string, data []byte) error {
+	t, err := s.getOrJoin(topic)
+	if err != nil {
+		return err
+	}
+	return t.Publish(ctx, data)
+}
+
+// ListPeersForTopic returns the connected peers subscribed to the given topic
+func (s *Service) ListPeersForTopic(topic string) []peer.ID {
+	return s.pubsub.ListPeers(topic)
+}
+
+// getOrJoin returns the handle of the given topic, joining it on the first use.
+func (s *Service) getOrJoin(topic string) (*pubsub.Topic, error) {
+	s.topicsMu.Lock()
+	defer s.topicsMu.Unlock()
+	if t, ok := s.topics[topic]; ok {
+		return t, nil
+	}
+	t, err := s.pubsub.Join(topic)
+	if err != nil {
+		return nil, err
+	}
+	s.topics[topic] = t
+	return t, nil
+}
@@ -0,0 +1,557 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package network
+
+import (
+	"context"
+	"encoding/binary"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/algorand/go-deadlock"
+	"github.com/gorilla/mux"
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	libp2pnet "github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/network/p2p"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/util/metrics"
+)
+
+var networkP2PMessagesDropped = metrics.MakeCounter(metrics.MetricName{Name: "algod_network_p2p_messages_dropped_total", Description: "Number of messages dropped because the send queue of a p2p peer was full"})
+var networkP2PPubSubRejected = metrics.MakeCounter(metrics.MetricName{Name: "algod_network_p2p_pubsub_rejected_total", Description: "Number of gossipsub messages rejected by the message handlers"})
+
+// p2pMeshInterval is the interval between two attempts of the p2p network to reach its target number of outgoing connections
+const p2pMeshInterval = time.Minute
+
+// P2PNetwork implements the GossipNode interface on top of libp2p.
+// Transactions and agreement votes are disseminated through gossipsub topics, while every other
+// message is sent over a dedicated stream to each of the connected peers. Peers are discovered
+// through a DHT joined via the bootstrap peers.
+// The registered HTTP handlers are served over libp2p streams as well, and the peers returned
+// by GetPeers are reached over these streams by the HTTP clients of the node.
+type P2PNetwork struct {
+	service *p2p.Service
+	log     logging.Logger
+	config  config.Local
+
+	genesisID string
+	networkID protocol.NetworkID
+
+	handlers Multiplexer
+	// router holds the registered HTTP handlers, served on the p2p.AlgorandHTTPProtocol streams
+	router *mux.Router
+	server http.Server
+	// roundTripper sends the requests addressed to a peer ID over libp2p, see p2p.Service.MakeHTTPRoundTripper
+	roundTripper http.RoundTripper
+
+	// phonebookPeers are the multiaddrs of the phonebook, which are used as the bootstrap peers
+	phonebookPeers []peer.AddrInfo
+	// archivers are the archival peers found in the DHT by the last lookup of the mesh thread
+	archivers     []peer.AddrInfo
+	archiversLock deadlock.RWMutex
+
+	ctx       context.Context
+	ctxCancel context.CancelFunc
+	wg        sync.WaitGroup
+
+	peers     map[peer.ID]*p2pPeer
+	peersLock deadlock.RWMutex
+
+	readyChan chan struct{}
+	readyOnce sync.Once
+
+	meshUpdateRequests chan meshRequest
+}
+
+// NewP2PNetwork returns an instance of GossipNode that uses the p2p.Service.
+// phonebookAddresses which are multiaddrs are used as bootstrap peers of the DHT.
+func NewP2PNetwork(log logging.Logger, cfg config.Local, datadir string, phonebookAddresses []string, genesisID string, networkID protocol.NetworkID) (*P2PNetwork, error) {
+	privKey, err := p2p.GetPrivKey(cfg, datadir)
+	if err != nil {
+		return nil, err
+	}
+
+	listenAddr := cfg.P2PNetAddress
+	if listenAddr == "" && !cfg.EnableP2PHybridMode {
+		// when running alone, the p2p network takes the place of the websocket network
+		listenAddr = cfg.NetAddress
+	}
+
+	n := &P2PNetwork{
+		log:                log,
+		config:             cfg,
+		genesisID:          genesisID,
+		networkID:          networkID,
+		router:             mux.NewRouter(),
+		phonebookPeers:     p2p.ParseAddrInfos(phonebookAddresses),
+		peers:              make(map[peer.ID]*p2pPeer),
+		readyChan:          make(chan struct{}),
+		meshUpdateRequests: make(chan meshRequest, 5),
+	}
+	n.handlers.log = log
+	n.ctx, n.ctxCancel = context.WithCancel(context.Background())
+
+	n.service, err = p2p.MakeService(n.ctx, log, cfg, privKey, listenAddr, networkID, n.phonebookPeers)
+	if err != nil {
+		n.ctxCancel()
+		return nil, err
+	}
+	n.roundTripper = n.service.MakeHTTPRoundTripper(http.DefaultTransport)
+
+	n.server.Handler = n.router
+	n.server.ReadHeaderTimeout = httpServerReadHeaderTimeout
+	n.server.WriteTimeout = httpServerWriteTimeout
+	n.server.IdleTimeout = httpServerIdleTimeout
+	n.server.MaxHeaderBytes = httpServerMaxHeaderBytes
+	n.server.ConnContext = func(ctx context.Context, conn net.Conn) context.Context {
+		return context.WithValue(ctx, p2pHTTPConnKey{}, conn)
+	}
+	return n, nil
+}
+
+// p2pHTTPConnKey is the request context key of the libp2p stream an HTTP request was received on
+type p2pHTTPConnKey struct{}
+
+// Start subscribes to the gossipsub topics, joins the DHT and starts connecting to peers
+func (n *P2PNetwork) Start() {
+	n.service.SetStreamHandler(n.handleStream)
+	n.service.Notify(&libp2pnet.NotifyBundle{
+		ConnectedF:    n.connectedF,
+		DisconnectedF: n.disconnectedF,
+	})
+
+	for _, tag := range []protocol.Tag{protocol.TxnTag, protocol.AgreementVoteTag} {
+		topic, _ := p2p.TopicForTag(tag)
+		sub, err := n.service.Subscribe(topic, n.pubsubValidator(tag))
+		if err != nil {
+			n.log.Errorf("failed to subscribe to p2p topic %s: %v", topic, err)
+			continue
+		}
+		n.wg.Add(1)
+		go n.drainSubscription(sub)
+	}
+
+	n.wg.Add(1)
+	go n.httpThread(n.service.ListenHTTP())
+
+	if err := n.service.Start(n.ctx); err != nil {
+		n.log.Errorf("failed to start the p2p peer discovery: %v", err)
+	}
+	n.wg.Add(1)
+	go n.meshThread()
+}
+
+// Stop closes the connections to the peers, and shuts down the libp2p host
+func (n *P2PNetwork) Stop() {
+	n.ctxCancel()
+	if err := n.server.Close(); err != nil {
+		n.log.Warnf("failed to close the p2p HTTP server: %v", err)
+	}
+	if err := n.service.Close(); err != nil {
+		n.log.Warnf("failed to close the p2p service: %v", err)
+	}
+	for _, p := range n.peerSnapshot() {
+		n.removePeer(p)
+	}
+	n.wg.Wait()
+}
+
+// Address returns the multiaddr of this node, including its peer ID
+func (n *P2PNetwork) Address() (string, bool) {
+	addrs := n.service.Addrs()
+	if len(addrs) == 0 {
+		return "", false
+	}
+	return addrs[0], true
+}
+
+// Broadcast sends a message to the peers. TX and AV messages are published to their gossipsub topic,
+// other messages are queued on the streams of all the peers except the one given.
+// The messages are always queued, so wait has no effect.
+func (n *P2PNetwork) Broadcast(ctx context.Context, tag protocol.Tag, data []byte, wait bool, except Peer) error {
+	if n.config.DisableNetworking {
+		return nil
+	}
+	if topic, ok := p2p.TopicForTag(tag); ok {
+		return n.service.Publish(ctx, topic, data)
+	}
+	for _, p := range n.peerSnapshot() {
+		if Peer(p) == except {
+			continue
+		}
+		p.send(tag, data)
+	}
+	return nil
+}
+
+// BroadcastArray sends an array of messages, see Broadcast
+func (n *P2PNetwork) BroadcastArray(ctx context.Context, tags []protocol.Tag, data [][]byte, wait bool, except Peer) error {
+	if len(tags) != len(data) {
+		return errBcastInvalidArray
+	}
+	for i := range tags {
+		if err := n.Broadcast(ctx, tags[i], data[i], wait, except); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Relay is the same as Broadcast. There are no relays in the p2p network, every node forwards
+// the messages it has validated to its own peers.
+func (n *P2PNetwork) Relay(ctx context.Context, tag protocol.Tag, data []byte, wait bool, except Peer) error {
+	return n.Broadcast(ctx, tag, data, wait, except)
+}
+
+// RelayArray relays an array of messages, see Relay
+func (n *P2PNetwork) RelayArray(ctx context.Context, tags []protocol.Tag, data [][]byte, wait bool, except Peer) error {
+	return n.BroadcastArray(ctx, tags, data, wait, except)
+}
+
+// Disconnect from the given peer
+func (n *P2PNetwork) Disconnect(badnode Peer) {
+	if p, ok := badnode.(*p2pPeer); ok {
+		n.disconnect(p)
+	}
+}
+
+// DisconnectPeers disconnects from all the peers
+func (n *P2PNetwork) DisconnectPeers() {
+	for _, p := range n.peerSnapshot() {
+		n.disconnect(p)
+	}
+}
+
+// Ready returns a channel which is closed once the network is connected to a peer
+func (n *P2PNetwork) Ready() chan struct{} {
+	return n.readyChan
+}
+
+// RegisterHTTPHandler path accepts gorilla/mux path annotations
+func (n *P2PNetwork) RegisterHTTPHandler(path string, handler http.Handler) {
+	n.router.Handle(path, handler)
+}
+
+// RequestConnectOutgoing asks the network to connect to the discovered peers.
+// `replace` drops all the connections first.
+func (n *P2PNetwork) RequestConnectOutgoing(replace bool, quit <-chan struct{}) {
+	if replace {
+		n.DisconnectPeers()
+	}
+	request := meshRequest{}
+	if quit != nil {
+		request.done = make(chan struct{})
+	}
+	select {
+	case n.meshUpdateRequests <- request:
+	case <-quit:
+		return
+	}
+	if request.done != nil {
+		select {
+		case <-request.done:
+		case <-quit:
+		}
+	}
+}
+
+// GetPeers returns the peers matching the given options. The phonebook relays are the multiaddrs
+// of the phonebook, while the phonebook archivers are the archival peers found in the DHT.
+// The phonebook peers are not necessarily connected, they are meant for HTTP requests only.
+func (n *P2PNetwork) GetPeers(options ...PeerOption) []Peer {
+	var peers []Peer
+	for _, option := range options {
+		switch option {
+		case PeersConnectedOut, PeersConnectedIn:
+			for _, p := range n.peerSnapshot() {
+				if p.outgoing == (option == PeersConnectedOut) {
+					peers = append(peers, p)
+				}
+			}
+		case PeersPhonebookRelays:
+			peers = n.appendPhonebookPeers(peers, n.phonebookPeers)
+		case PeersPhonebookArchivers:
+			n.archiversLock.RLock()
+			archivers := n.archivers
+			n.archiversLock.RUnlock()
+			peers = n.appendPhonebookPeers(peers, archivers)
+		}
+	}
+	return peers
+}
+
+// appendPhonebookPeers appends the HTTP peers of the given addresses, skipping this node
+func (n *P2PNetwork) appendPhonebookPeers(peers []Peer, addrInfos []peer.AddrInfo) []Peer {
+	for _, addrInfo := range addrInfos {
+		if addrInfo.ID == n.service.ID() {
+			continue
+		}
+		// let the round tripper dial the peer even when it is not connected
+		n.service.AddPeerAddrs(addrInfo)
+		peers = append(peers, makeP2PHTTPPeer(addrInfo.ID, n.roundTripper))
+	}
+	return peers
+}
+
+// RegisterHandlers adds to the set of given message handlers
+func (n *P2PNetwork) RegisterHandlers(dispatch []TaggedMessageHandler) {
+	n.handlers.RegisterHandlers(dispatch)
+}
+
+// ClearHandlers deregisters all the existing message handlers
+func (n *P2PNetwork) ClearHandlers() {
+	n.handlers.ClearHandlers([]Tag{})
+}
+
+// GetRoundTripper returns the transport sending the requests addressed to a peer ID over libp2p,
+// and the other requests through the default transport
+func (n *P2PNetwork) GetRoundTripper() http.RoundTripper {
+	return n.roundTripper
+}
+
+// OnNetworkAdvance is a no-op: gossipsub maintains its mesh on its own
+func (n *P2PNetwork) OnNetworkAdvance() {}
+
+// GetHTTPRequestConnection returns the libp2p stream the given request was received on
+func (n *P2PNetwork) GetHTTPRequestConnection(request *http.Request) (conn net.Conn) {
+	conn, _ = request.Context().Value(p2pHTTPConnKey{}).(net.Conn)
+	return conn
+}
+
+// RegisterMessageInterest is a no-op: the topics of interest are the gossipsub subscriptions
+func (n *P2PNetwork) RegisterMessageInterest(protocol.Tag) {}
+
+// SubstituteGenesisID substitutes the "{genesisID}" with their network-specific genesisID.
+func (n *P2PNetwork) SubstituteGenesisID(rawURL string) string {
+	return strings.Replace(rawURL, "{genesisID}", n.genesisID, -1)
+}
+
+// GetPeerData returns the peer data associated with a particular key.
+func (n *P2PNetwork) GetPeerData(peer Peer, key string) interface{} {
+	if p, ok := peer.(*p2pPeer); ok {
+		return p.getPeerData(key)
+	}
+	return nil
+}
+
+// SetPeerData sets the peer data associated with a particular key.
+func (n *P2PNetwork) SetPeerData(peer Peer, key string, value interface{}) {
+	if p, ok := peer.(*p2pPeer); ok {
+		p.setPeerData(key, value)
+	}
+}
+
+// meshThread periodically connects to discovered peers, until the target number of outgoing connections is reached,
+// and refreshes the archival peers
+func (n *P2PNetwork) meshThread() {
+	defer n.wg.Done()
+	timer := time.NewTicker(p2pMeshInterval)
+	defer timer.Stop()
+	for {
+		var request meshRequest
+		select {
+		case <-timer.C:
+		case request = <-n.meshUpdateRequests:
+		case <-n.ctx.Done():
+			return
+		}
+		n.service.DialPeersUntilTargetCount(n.ctx, n.config.GossipFanout)
+		if request.done != nil {
+			close(request.done)
+		}
+		archivers := n.service.FindArchivers(n.ctx)
+		n.archiversLock.Lock()
+		n.archivers = archivers
+		n.archiversLock.Unlock()
+	}
+}
+
+// httpThread serves the registered HTTP handlers over libp2p, until the network is stopped
+func (n *P2PNetwork) httpThread(listener net.Listener) {
+	defer n.wg.Done()
+	err := n.server.Serve(listener)
+	if err != nil && err != http.ErrServerClosed {
+		n.log.Warnf("p2p HTTP server stopped: %v", err)
+	}
+}
+
+// connectedF is called by libp2p whenever a new connection is established
+func (n *P2PNetwork) connectedF(_ libp2pnet.Network, conn libp2pnet.Conn) {
+	n.getOrAddPeer(conn)
+}
+
+// disconnectedF is called by libp2p whenever a connection is closed
+func (n *P2PNetwork) disconnectedF(nw libp2pnet.Network, conn libp2pnet.Conn) {
+	id := conn.RemotePeer()
+	if nw.Connectedness(id) == libp2pnet.Connected {
+		// there is another connection to the same peer
+		return
+	}
+	n.peersLock.RLock()
+	p := n.peers[id]
+	n.peersLock.RUnlock()
+	if p != nil {
+		n.removePeer(p)
+	}
+}
+
+// getOrAddPeer returns the peer at the other end of the given connection, adding it when it is new
+func (n *P2PNetwork) getOrAddPeer(conn libp2pnet.Conn) *p2pPeer {
+	id := conn.RemotePeer()
+	n.peersLock.Lock()
+	defer n.peersLock.Unlock()
+	if p, ok := n.peers[id]; ok {
+		return p
+	}
+	if n.ctx.Err() != nil {
+		// do not add peers while stopping, their write loop would not be waited for
+		return makeP2PPeer(n, id, "", false)
+	}
+	addr := conn.RemoteMultiaddr().String() + "/p2p/" + id.String()
+	p := makeP2PPeer(n, id, addr, conn.Stat().Direction == libp2pnet.DirOutbound)
+	n.peers[id] = p
+	n.wg.Add(1)
+	go p.writeLoop(n.ctx)
+	n.readyOnce.Do(func() { close(n.readyChan) })
+	return p
+}
+
+// lookupPeer returns the peer with the given ID, as long as it is connected
+func (n *P2PNetwork) lookupPeer(id peer.ID) *p2pPeer {
+	n.peersLock.RLock()
+	defer n.peersLock.RUnlock()
+	return n.peers[id]
+}
+
+func (n *P2PNetwork) removePeer(p *p2pPeer) {
+	n.peersLock.Lock()
+	if n.peers[p.id] == p {
+		delete(n.peers, p.id)
+	}
+	n.peersLock.Unlock()
+	p.close()
+}
+
+func (n *P2PNetwork) disconnect(p *p2pPeer) {
+	if err := n.service.ClosePeer(p.id); err != nil {
+		n.log.Debugf("failed to close the connection to %v: %v", p, err)
+	}
+	n.removePeer(p)
+}
+
+func (n *P2PNetwork) peerSnapshot() []*p2pPeer {
+	n.peersLock.RLock()
+	defer n.peersLock.RUnlock()
+	peers := make([]*p2pPeer, 0, len(n.peers))
+	for _, p := range n.peers {
+		peers = append(peers, p)
+	}
+	return peers
+}
+
+// handleStream reads the messages of a stream opened by a peer
+func (n *P2PNetwork) handleStream(stream libp2pnet.Stream) {
+	if n.ctx.Err() != nil {
+		stream.Reset()
+		return
+	}
+	p := n.getOrAddPeer(stream.Conn())
+	n.wg.Add(1)
+	go p.readLoop(stream)
+}
+
+// pubsubValidator passes the gossipsub messages of the given tag to the message handlers.
+// The messages are never forwarded by gossipsub itself: as on the websocket network, the handlers
+// relay the messages they have validated, which publishes them again on behalf of this node.
+func (n *P2PNetwork) pubsubValidator(tag protocol.Tag) pubsub.ValidatorEx {
+	return func(ctx context.Context, from peer.ID, msg *pubsub.Message) pubsub.ValidationResult {
+		if from == n.service.ID() {
+			// messages published by this node have been validated already
+			return pubsub.ValidationAccept
+		}
+		p := n.lookupPeer(from)
+		if p == nil {
+			return pubsub.ValidationIgnore
+		}
+		networkReceivedBytesTotal.AddUint64(uint64(len(msg.Data)), nil)
+		outmsg := n.dispatch(IncomingMessage{
+			Sender:   p,
+			Tag:      tag,
+			Data:     msg.Data,
+			Net:      n,
+			Received: time.Now().UnixNano(),
+		})
+		if outmsg.Action == Disconnect {
+			networkP2PPubSubRejected.Inc(nil)
+			return pubsub.ValidationReject
+		}
+		return pubsub.ValidationIgnore
+	}
+}
+
+// drainSubscription discards the messages delivered to a subscription. Only the messages published
+// by this node are accepted by the validator, and those have been handled already.
+func (n *P2PNetwork) drainSubscription(sub *pubsub.Subscription) {
+	defer n.wg.Done()
+	defer sub.Cancel()
+	for {
+		if _, err := sub.Next(n.ctx); err != nil {
+			return
+		}
+	}
+}
+
+// dispatch passes an incoming message to the handlers, and applies the returned forwarding policy
+func (n *P2PNetwork) dispatch(msg IncomingMessage) OutgoingMessage {
+	outmsg := n.handlers.Handle(msg)
+	switch outmsg.Action {
+	case Disconnect:
+		if p, ok := msg.Sender.(*p2pPeer); ok {
+			n.wg.Add(1)
+			go func() {
+				defer n.wg.Done()
+				n.disconnect(p)
+			}()
+		}
+	case Broadcast:
+		if err := n.Broadcast(n.ctx, msg.Tag, msg.Data, false, msg.Sender); err != nil && err != n.ctx.Err() {
+			n.log.Warnf("P2PNetwork.dispatch: P2PNetwork.Broadcast returned unexpected error %v", err)
+		}
+	case Respond:
+		if p, ok := msg.Sender.(*p2pPeer); ok {
+			n.respond(p, msg, outmsg.Topics)
+		}
+	default:
+	}
+	return outmsg
+}
+
+// respond sends the response topics of a request back to the peer which sent it, see wsPeer.Respond
+func (n *P2PNetwork) respond(p *p2pPeer, reqMsg IncomingMessage, responseTopics Topics) {
+	requestHash := hashTopics(reqMsg.Data)
+	requestHashData := make([]byte, binary.MaxVarintLen64)
+	binary.PutUvarint(requestHashData, requestHash)
+	responseTopics = append(responseTopics, Topic{key: requestHashKey, data: requestHashData})
+	p.send(protocol.TopicMsgRespTag, responseTopics.MarshallTopics())
+}
@@ -0,0 +1,285 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package network
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/network/p2p"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+// p2pTestHandler collects the messages it handles, and relays them through relayNet when it is set
+type p2pTestHandler struct {
+	messages chan IncomingMessage
+	relayNet GossipNode
+}
+
+func makeP2PTestHandler() *p2pTestHandler {
+	return &p2pTestHandler{messages: make(chan IncomingMessage, 100)}
+}
+
+func (h *p2pTestHandler) Handle(msg IncomingMessage) OutgoingMessage {
+	h.messages <- msg
+	if h.relayNet != nil {
+		h.relayNet.Relay(context.Background(), msg.Tag, msg.Data, false, msg.Sender)
+	}
+	return OutgoingMessage{Action: Ignore}
+}
+
+func (h *p2pTestHandler) expect(t *testing.T, tag protocol.Tag, data []byte) IncomingMessage {
+	select {
+	case msg := <-h.messages:
+		require.Equal(t, tag, msg.Tag)
+		require.Equal(t, data, msg.Data)
+		return msg
+	case <-time.After(10 * time.Second):
+		require.Fail(t, "message was not received", "tag %s", tag)
+	}
+	return IncomingMessage{}
+}
+
+func makeTestP2PNetwork(t *testing.T, bootstrap ...string) *P2PNetwork {
+	return makeTestP2PNetworkWithConfig(t, config.GetDefaultLocal(), bootstrap...)
+}
+
+func makeTestP2PNetworkWithConfig(t *testing.T, cfg config.Local, bootstrap ...string) *P2PNetwork {
+	cfg.P2PNetAddress = "127.0.0.1:0"
+	n, err := NewP2PNetwork(logging.TestingLog(t), cfg, t.TempDir(), bootstrap, genesisID, config.Devtestnet)
+	require.NoError(t, err)
+	return n
+}
+
+func p2pAddress(t *testing.T, n GossipNode) string {
+	addr, ok := n.Address()
+	require.True(t, ok)
+	return addr
+}
+
+// waitTopicPeers waits until the given network has count peers subscribed to the topic of the given tag
+func waitTopicPeers(t *testing.T, n *P2PNetwork, tag protocol.Tag, count int) {
+	topic, ok := p2p.TopicForTag(tag)
+	require.True(t, ok)
+	require.Eventually(t, func() bool {
+		return len(n.service.ListPeersForTopic(topic)) >= count
+	}, 10*time.Second, 50*time.Millisecond)
+}
+
+func TestP2PNetworkGossip(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	netA := makeTestP2PNetwork(t)
+	netA.Start()
+	defer netA.Stop()
+	netB := makeTestP2PNetwork(t, p2pAddress(t, netA))
+	netB.Start()
+	defer netB.Stop()
+
+	handlerA := makeP2PTestHandler()
+	netA.RegisterHandlers([]TaggedMessageHandler{
+		{Tag: protocol.ProposalPayloadTag, MessageHandler: handlerA},
+	})
+	handlerB := makeP2PTestHandler()
+	netB.RegisterHandlers([]TaggedMessageHandler{
+		{Tag: protocol.TxnTag, MessageHandler: handlerB},
+		{Tag: protocol.AgreementVoteTag, MessageHandler: handlerB},
+		{Tag: protocol.ProposalPayloadTag, MessageHandler: handlerB},
+	})
+
+	<-netA.Ready()
+	<-netB.Ready()
+	require.Len(t, netA.GetPeers(PeersConnectedIn), 1)
+	require.Len(t, netB.GetPeers(PeersConnectedOut), 1)
+
+	// TX and AV go through gossipsub
+	waitTopicPeers(t, netA, protocol.TxnTag, 1)
+	waitTopicPeers(t, netA, protocol.AgreementVoteTag, 1)
+	require.NoError(t, netA.Broadcast(context.Background(), protocol.TxnTag, []byte("txn"), false, nil))
+	msg := handlerB.expect(t, protocol.TxnTag, []byte("txn"))
+	require.Equal(t, netB.GetPeers(PeersConnectedOut)[0], msg.Sender)
+	require.NoError(t, netA.Relay(context.Background(), protocol.AgreementVoteTag, []byte("vote"), false, nil))
+	handlerB.expect(t, protocol.AgreementVoteTag, []byte("vote"))
+
+	// other tags go through the peers streams, in both directions
+	require.NoError(t, netA.Broadcast(context.Background(), protocol.ProposalPayloadTag, []byte("proposal"), false, nil))
+	msg = handlerB.expect(t, protocol.ProposalPayloadTag, []byte("proposal"))
+	require.NoError(t, netB.Broadcast(context.Background(), protocol.ProposalPayloadTag, []byte("proposal"), false, nil))
+	handlerA.expect(t, protocol.ProposalPayloadTag, []byte("proposal"))
+
+	// the sender is not sent its own message back
+	require.NoError(t, netB.Broadcast(context.Background(), protocol.ProposalPayloadTag, []byte("except"), false, msg.Sender))
+	select {
+	case msg := <-handlerA.messages:
+		require.Fail(t, "unexpected message", "%s", msg.Data)
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	// disconnecting removes the peer
+	netB.Disconnect(msg.Sender)
+	require.Eventually(t, func() bool {
+		return len(netA.GetPeers(PeersConnectedIn)) == 0 && len(netB.GetPeers(PeersConnectedOut)) == 0
+	}, 10*time.Second, 50*time.Millisecond)
+}
+
+func TestP2PNetworkDiscovery(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	bootstrap := makeTestP2PNetwork(t)
+	bootstrap.Start()
+	defer bootstrap.Stop()
+	bootstrapAddr := p2pAddress(t, bootstrap)
+
+	cfg := config.GetDefaultLocal()
+	cfg.Archival = true
+	netA := makeTestP2PNetworkWithConfig(t, cfg, bootstrapAddr)
+	netA.Start()
+	defer netA.Stop()
+	netB := makeTestP2PNetwork(t, bootstrapAddr)
+	netB.Start()
+	defer netB.Stop()
+
+	// neither knows about the other one, until they find each other through the DHT
+	require.Eventually(t, func() bool {
+		netB.RequestConnectOutgoing(false, nil)
+		return netB.lookupPeer(netA.service.ID()) != nil
+	}, 30*time.Second, 500*time.Millisecond)
+
+	// only netA advertises itself as an archiver
+	require.Eventually(t, func() bool {
+		netB.RequestConnectOutgoing(false, nil)
+		return len(netB.GetPeers(PeersPhonebookArchivers)) > 0
+	}, 30*time.Second, 500*time.Millisecond)
+	archivers := netB.GetPeers(PeersPhonebookArchivers)
+	require.Len(t, archivers, 1)
+	require.Equal(t, p2pHTTPAddress(netA.service.ID()), archivers[0].(HTTPPeer).GetAddress())
+}
+
+// p2pTestHTTPGet requests the given path from the peer through its HTTP client
+func p2pTestHTTPGet(t *testing.T, p Peer, path string) string {
+	httpPeer, ok := p.(HTTPPeer)
+	require.True(t, ok)
+	response, err := httpPeer.GetHTTPClient().Get(httpPeer.GetAddress() + path)
+	require.NoError(t, err)
+	defer response.Body.Close()
+	require.Equal(t, http.StatusOK, response.StatusCode)
+	body, err := io.ReadAll(response.Body)
+	require.NoError(t, err)
+	return string(body)
+}
+
+func TestP2PNetworkHTTP(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	netA := makeTestP2PNetwork(t)
+	netA.RegisterHTTPHandler("/v1/{genesisID}/test", http.HandlerFunc(func(response http.ResponseWriter, request *http.Request) {
+		if netA.GetHTTPRequestConnection(request) == nil {
+			response.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		response.Write([]byte("served over p2p"))
+	}))
+	netA.Start()
+	defer netA.Stop()
+	netB := makeTestP2PNetwork(t, p2pAddress(t, netA))
+	netB.Start()
+	defer netB.Stop()
+	<-netB.Ready()
+
+	path := netB.SubstituteGenesisID("/v1/{genesisID}/test")
+
+	// through a connected peer
+	peers := netB.GetPeers(PeersConnectedOut)
+	require.Len(t, peers, 1)
+	require.Equal(t, "served over p2p", p2pTestHTTPGet(t, peers[0], path))
+
+	// through the phonebook peer, which is the bootstrap address of netA
+	peers = netB.GetPeers(PeersPhonebookRelays)
+	require.Len(t, peers, 1)
+	require.Equal(t, "served over p2p", p2pTestHTTPGet(t, peers[0], path))
+
+	// through the round tripper of the network, as the catchup services do
+	client := http.Client{Transport: netB.GetRoundTripper()}
+	response, err := client.Get(p2pHTTPAddress(netA.service.ID()) + path)
+	require.NoError(t, err)
+	response.Body.Close()
+	require.Equal(t, http.StatusOK, response.StatusCode)
+	response, err = client.Get(p2pHTTPAddress(netA.service.ID()) + "/unknown")
+	require.NoError(t, err)
+	response.Body.Close()
+	require.Equal(t, http.StatusNotFound, response.StatusCode)
+}
+
+func TestHybridNetworkBridge(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	// the relay serves both transports
+	cfg := config.GetDefaultLocal()
+	cfg.NetAddress = "127.0.0.1:0"
+	cfg.P2PNetAddress = "127.0.0.1:0"
+	cfg.EnableP2PHybridMode = true
+	relay, err := NewHybridP2PNetwork(logging.TestingLog(t), cfg, t.TempDir(), nil, genesisID, config.Devtestnet, nil)
+	require.NoError(t, err)
+	relayHandler := makeP2PTestHandler()
+	relayHandler.relayNet = relay
+	relay.RegisterHandlers([]TaggedMessageHandler{{Tag: protocol.TxnTag, MessageHandler: relayHandler}})
+	relay.Start()
+	defer relay.Stop()
+	wsRelayAddr, ok := relay.wsNetwork.Address()
+	require.True(t, ok)
+
+	// a websocket node and a p2p node, each knowing only the relay address of its own transport
+	wsNode := makeTestWebsocketNode(t)
+	wsNode.config.GossipFanout = 1
+	wsNode.phonebook.ReplacePeerList([]string{wsRelayAddr}, "default", PhoneBookEntryRelayRole)
+	wsHandler := makeP2PTestHandler()
+	wsNode.RegisterHandlers([]TaggedMessageHandler{{Tag: protocol.TxnTag, MessageHandler: wsHandler}})
+	wsNode.Start()
+	defer wsNode.Stop()
+
+	p2pNode := makeTestP2PNetwork(t, p2pAddress(t, relay.p2pNetwork))
+	p2pHandler := makeP2PTestHandler()
+	p2pNode.RegisterHandlers([]TaggedMessageHandler{{Tag: protocol.TxnTag, MessageHandler: p2pHandler}})
+	p2pNode.Start()
+	defer p2pNode.Stop()
+
+	<-wsNode.Ready()
+	<-p2pNode.Ready()
+	require.Eventually(t, func() bool {
+		return len(relay.GetPeers(PeersConnectedIn)) == 2
+	}, 10*time.Second, 50*time.Millisecond)
+	waitTopicPeers(t, p2pNode, protocol.TxnTag, 1)
+	waitTopicPeers(t, relay.p2pNetwork, protocol.TxnTag, 1)
+
+	// websocket -> relay -> p2p
+	require.NoError(t, wsNode.Broadcast(context.Background(), protocol.TxnTag, []byte("from ws"), true, nil))
+	relayHandler.expect(t, protocol.TxnTag, []byte("from ws"))
+	p2pHandler.expect(t, protocol.TxnTag, []byte("from ws"))
+
+	// p2p -> relay -> websocket
+	require.NoError(t, p2pNode.Broadcast(context.Background(), protocol.TxnTag, []byte("from p2p"), false, nil))
+	relayHandler.expect(t, protocol.TxnTag, []byte("from p2p"))
+	wsHandler.expect(t, protocol.TxnTag, []byte("from p2p"))
+}
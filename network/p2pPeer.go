@@ -0,0 +1,280 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package network
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/algorand/go-deadlock"
+	libp2pnet "github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
+
+	"github.com/algorand/go-algorand/protocol"
+)
+
+// p2pMessageHeaderSize is the size of the header of the messages sent over a p2p stream,
+// made of the 2 bytes tag followed by the 4 bytes big endian payload length
+const p2pMessageHeaderSize = 2 + 4
+
+// p2pPeerSendQueueSize is the number of messages buffered for a p2p peer before new messages are dropped
+const p2pPeerSendQueueSize = 1000
+
+var errP2PMessageTooLarge = errors.New("p2p message exceeds the maximum message length")
+
+// p2pPeer is a peer connected through the p2p network. The messages which are not disseminated
+// through gossipsub are written to a stream opened by this node, and read from the stream opened by the peer.
+type p2pPeer struct {
+	net      *P2PNetwork
+	id       peer.ID
+	addr     string
+	outgoing bool
+	client   http.Client
+
+	sendQueue chan []byte
+	closing   chan struct{}
+
+	mu      deadlock.Mutex
+	closed  bool
+	closers []func()
+	data    map[string]interface{}
+}
+
+func makeP2PPeer(net *P2PNetwork, id peer.ID, addr string, outgoing bool) *p2pPeer {
+	return &p2pPeer{
+		net:       net,
+		id:        id,
+		addr:      addr,
+		outgoing:  outgoing,
+		client:    http.Client{Transport: net.roundTripper},
+		sendQueue: make(chan []byte, p2pPeerSendQueueSize),
+		closing:   make(chan struct{}),
+		data:      make(map[string]interface{}),
+	}
+}
+
+// OnClose adds a function to be called when the peer is disconnected
+func (p *p2pPeer) OnClose(f func()) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.closed {
+		go f()
+		return
+	}
+	p.closers = append(p.closers, f)
+}
+
+// RoutingAddr returns the multiaddr the peer is connected from
+func (p *p2pPeer) RoutingAddr() string {
+	return p.addr
+}
+
+// String is used in the logs
+func (p *p2pPeer) String() string {
+	return fmt.Sprintf("p2p peer %s", p.id)
+}
+
+// GetAddress returns the root url of the peer, which is reached over libp2p by GetHTTPClient
+func (p *p2pPeer) GetAddress() string {
+	return p2pHTTPAddress(p.id)
+}
+
+// GetHTTPClient returns a client sending the requests to the peer over libp2p
+func (p *p2pPeer) GetHTTPClient() *http.Client {
+	return &p.client
+}
+
+// p2pHTTPPeer is a phonebook peer of the p2p network, which this node is not necessarily connected to.
+// It is only used to make HTTP requests, see P2PNetwork.GetPeers.
+type p2pHTTPPeer struct {
+	id     peer.ID
+	client http.Client
+}
+
+func makeP2PHTTPPeer(id peer.ID, roundTripper http.RoundTripper) *p2pHTTPPeer {
+	return &p2pHTTPPeer{
+		id:     id,
+		client: http.Client{Transport: roundTripper},
+	}
+}
+
+// GetAddress returns the root url of the peer, see p2pPeer.GetAddress
+func (p *p2pHTTPPeer) GetAddress() string {
+	return p2pHTTPAddress(p.id)
+}
+
+// GetHTTPClient returns a client sending the requests to the peer over libp2p
+func (p *p2pHTTPPeer) GetHTTPClient() *http.Client {
+	return &p.client
+}
+
+// String is used in the logs
+func (p *p2pHTTPPeer) String() string {
+	return fmt.Sprintf("p2p peer %s", p.id)
+}
+
+// p2pHTTPAddress is the root url of a peer, whose host is the peer ID the round tripper dials
+func p2pHTTPAddress(id peer.ID) string {
+	return "http://" + id.String()
+}
+
+func (p *p2pPeer) getPeerData(key string) interface{} {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.data[key]
+}
+
+func (p *p2pPeer) setPeerData(key string, value interface{}) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if value == nil {
+		delete(p.data, key)
+	} else {
+		p.data[key] = value
+	}
+}
+
+// send enqueues a message to the peer. It does not block, and drops the message when the peer is too slow.
+func (p *p2pPeer) send(tag protocol.Tag, data []byte) bool {
+	msg, err := encodeP2PMessage(tag, data)
+	if err != nil {
+		p.net.log.Errorf("trying to send a message longer than we would receive: %d > %d tag=%s", len(data), maxMessageLength, tag)
+		return false
+	}
+	select {
+	case p.sendQueue <- msg:
+		return true
+	case <-p.closing:
+	default:
+		networkP2PMessagesDropped.Inc(nil)
+	}
+	return false
+}
+
+// writeLoop opens a stream to the peer and writes the queued messages to it until the peer is closed
+func (p *p2pPeer) writeLoop(ctx context.Context) {
+	defer p.net.wg.Done()
+	var stream libp2pnet.Stream
+	defer func() {
+		if stream != nil {
+			stream.Close()
+		}
+	}()
+	for {
+		select {
+		case msg := <-p.sendQueue:
+			if stream == nil {
+				var err error
+				stream, err = p.net.service.NewStream(ctx, p.id)
+				if err != nil {
+					p.net.log.Infof("failed to open a stream to %v: %v", p, err)
+					p.net.disconnect(p)
+					return
+				}
+			}
+			if _, err := stream.Write(msg); err != nil {
+				p.net.log.Infof("failed to write to %v: %v", p, err)
+				p.net.disconnect(p)
+				return
+			}
+			networkSentBytesTotal.AddUint64(uint64(len(msg)), nil)
+		case <-p.closing:
+			return
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// readLoop reads the messages the peer writes to the given stream, and dispatches them to the network handlers
+func (p *p2pPeer) readLoop(stream libp2pnet.Stream) {
+	defer p.net.wg.Done()
+	defer stream.Close()
+	reader := bufio.NewReader(stream)
+	for {
+		tag, data, err := readP2PMessage(reader)
+		if err != nil {
+			if err != io.EOF {
+				p.net.log.Infof("failed to read from %v: %v", p, err)
+				p.net.disconnect(p)
+			}
+			return
+		}
+		networkReceivedBytesTotal.AddUint64(uint64(p2pMessageHeaderSize+len(data)), nil)
+		p.net.dispatch(IncomingMessage{
+			Sender:   p,
+			Tag:      tag,
+			Data:     data,
+			Net:      p.net,
+			Received: time.Now().UnixNano(),
+		})
+	}
+}
+
+// close runs the closers of the peer and stops its write loop. Only the first call has an effect.
+func (p *p2pPeer) close() {
+	p.mu.Lock()
+	if p.closed {
+		p.mu.Unlock()
+		return
+	}
+	p.closed = true
+	closers := p.closers
+	p.closers = nil
+	p.mu.Unlock()
+
+	close(p.closing)
+	for _, f := range closers {
+		f()
+	}
+}
+
+func encodeP2PMessage(tag protocol.Tag, data []byte) ([]byte, error) {
+	if len(data) > maxMessageLength || len(tag) != 2 {
+		return nil, errP2PMessageTooLarge
+	}
+	msg := make([]byte, p2pMessageHeaderSize+len(data))
+	copy(msg, tag)
+	binary.BigEndian.PutUint32(msg[2:], uint32(len(data)))
+	copy(msg[p2pMessageHeaderSize:], data)
+	return msg, nil
+}
+
+func readP2PMessage(reader io.Reader) (protocol.Tag, []byte, error) {
+	var header [p2pMessageHeaderSize]byte
+	if _, err := io.ReadFull(reader, header[:]); err != nil {
+		return "", nil, err
+	}
+	length := binary.BigEndian.Uint32(header[2:])
+	if length > maxMessageLength {
+		return "", nil, errP2PMessageTooLarge
+	}
+	data := make([]byte, length)
+	if _, err := io.ReadFull(reader, data); err != nil {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return "", nil, err
+	}
+	return protocol.Tag(header[:2]), data, nil
+}
@@ -240,9 +240,15 @@ func (node *AlgorandFollowerNode) BroadcastInternalSignedTxGroup(_ []transaction
 
 // Simulate speculatively runs a transaction group against the current
 // blockchain state and returns the effects and/or errors that would result.
-func (node *AlgorandFollowerNode) Simulate(_ simulation.Request) (result simulation.Result, err error) {
-	err = fmt.Errorf("cannot simulate in data mode")
-	return
+// The ledger may be ahead of the sync round by up to MaxAcctLookback rounds,
+// so if a sync round is set, simulation treats it as the latest round.
+func (node *AlgorandFollowerNode) Simulate(request simulation.Request) (result simulation.Result, err error) {
+	latest := node.ledger.Latest()
+	if syncRound := basics.Round(node.GetSyncRound()); syncRound != 0 && syncRound < latest {
+		latest = syncRound
+	}
+	simulator := simulation.MakeSimulatorAtRound(node.ledger, latest, node.config.EnableDeveloperAPI)
+	return simulator.Simulate(request)
 }
 
 // GetPendingTransaction no-ops in follower mode
@@ -332,6 +338,17 @@ func (node *AlgorandFollowerNode) OnNewBlock(block bookkeeping.Block, _ ledgerco
 // StartCatchup starts the catchpoint mode and attempt to get to the provided catchpoint
 // this function is intended to be called externally via the REST api interface.
 func (node *AlgorandFollowerNode) StartCatchup(catchpoint string) error {
+	return node.startCatchup(catchpoint, "", "")
+}
+
+// StartCatchupFromFiles starts the catchpoint mode and attempt to get to the provided catchpoint, using the
+// given local catchpoint file and blocks file instead of downloading them from the network.
+// this function is intended to be called externally via the REST api interface.
+func (node *AlgorandFollowerNode) StartCatchupFromFiles(catchpoint, catchpointFile, blocksFile string) error {
+	return node.startCatchup(catchpoint, catchpointFile, blocksFile)
+}
+
+func (node *AlgorandFollowerNode) startCatchup(catchpoint, catchpointFile, blocksFile string) error {
 	node.mu.Lock()
 	defer node.mu.Unlock()
 	if node.catchpointCatchupService != nil {
@@ -344,13 +361,21 @@ func (node *AlgorandFollowerNode) StartCatchup(catchpoint string) error {
 	}
 	var err error
 	accessor := ledger.MakeCatchpointCatchupAccessor(node.ledger.Ledger, node.log)
-	node.catchpointCatchupService, err = catchup.MakeNewCatchpointCatchupService(catchpoint, node, node.log, node.net, accessor, node.config)
+	if catchpointFile != "" || blocksFile != "" {
+		node.catchpointCatchupService, err = catchup.MakeNewCatchpointCatchupServiceFromFiles(catchpoint, catchpointFile, blocksFile, node, node.log, node.net, accessor, node.config)
+	} else {
+		node.catchpointCatchupService, err = catchup.MakeNewCatchpointCatchupService(catchpoint, node, node.log, node.net, accessor, node.config)
+	}
 	if err != nil {
 		node.log.Warnf("unable to create catchpoint catchup service : %v", err)
 		return err
 	}
 	node.catchpointCatchupService.Start(node.ctx)
-	node.log.Infof("starting catching up toward catchpoint %s", catchpoint)
+	if catchpointFile != "" {
+		node.log.Infof("starting catching up toward catchpoint %s from %s", catchpoint, catchpointFile)
+	} else {
+		node.log.Infof("starting catching up toward catchpoint %s", catchpoint)
+	}
 	return nil
 }
 
@@ -460,3 +485,9 @@ func (node *AlgorandFollowerNode) SetBlockTimeStampOffset(offset int64) error {
 func (node *AlgorandFollowerNode) GetBlockTimeStampOffset() (*int64, error) {
 	return nil, fmt.Errorf("cannot get block timestamp offset in follower mode")
 }
+
+// AgreementState returns a snapshot of the live agreement state machine.
+// Follower nodes do not run agreement.
+func (node *AlgorandFollowerNode) AgreementState(ctx context.Context) (agreement.StateSnapshot, error) {
+	return agreement.StateSnapshot{}, fmt.Errorf("agreement does not run in follower mode")
+}
@@ -27,6 +27,7 @@ import (
 
 	"github.com/algorand/go-algorand/agreement"
 	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
 	"github.com/algorand/go-algorand/data/account"
 	"github.com/algorand/go-algorand/data/basics"
 	"github.com/algorand/go-algorand/data/bookkeeping"
@@ -115,9 +116,7 @@ func TestErrors(t *testing.T) {
 	node := setupFollowNode(t)
 	require.Error(t, node.BroadcastSignedTxGroup([]transactions.SignedTxn{}))
 	require.Error(t, node.BroadcastInternalSignedTxGroup([]transactions.SignedTxn{}))
-	_, err := node.Simulate(simulation.Request{})
-	require.Error(t, err)
-	_, err = node.GetParticipationKey(account.ParticipationID{})
+	_, err := node.GetParticipationKey(account.ParticipationID{})
 	require.Error(t, err)
 	require.Error(t, node.RemoveParticipationKey(account.ParticipationID{}))
 	require.Error(t, node.AppendParticipationKeys(account.ParticipationID{}, account.StateProofKeys{}))
@@ -125,6 +124,75 @@ func TestErrors(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestSimulate(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	var sender basics.Address
+	crypto.RandBytes(sender[:])
+
+	cfg := config.GetDefaultLocal()
+	cfg.EnableFollowMode = true
+	cfg.DisableNetworking = true
+	genesis := followNodeDefaultGenesis()
+	genesis.Allocation = append(genesis.Allocation, bookkeeping.GenesisAllocation{
+		Address: sender.String(),
+		State: basics.AccountData{
+			MicroAlgos: basics.MicroAlgos{Raw: 1000000},
+		},
+	})
+	node, err := MakeFollower(logging.Base(), t.TempDir(), cfg, []string{}, genesis)
+	require.NoError(t, err)
+
+	for i := 0; i < 3; i++ {
+		prev, err := node.Ledger().BlockHdr(node.Ledger().Latest())
+		require.NoError(t, err)
+		err = node.Ledger().AddBlock(bookkeeping.MakeBlock(prev), agreement.Certificate{})
+		require.NoError(t, err)
+	}
+	latest := node.Ledger().Latest()
+
+	proto := config.Consensus[protocol.ConsensusCurrentVersion]
+	payTxn := transactions.SignedTxn{
+		Txn: transactions.Transaction{
+			Type: protocol.PaymentTx,
+			Header: transactions.Header{
+				Sender:      sender,
+				Fee:         basics.MicroAlgos{Raw: proto.MinTxnFee},
+				FirstValid:  1,
+				LastValid:   100,
+				GenesisHash: node.GenesisHash(),
+			},
+			PaymentTxnFields: transactions.PaymentTxnFields{
+				Receiver: sinkAddr,
+				Amount:   basics.MicroAlgos{Raw: 1000},
+			},
+		},
+	}
+	request := simulation.Request{
+		TxnGroups:            [][]transactions.SignedTxn{{payTxn}},
+		AllowEmptySignatures: true,
+	}
+
+	// Without a sync round, the latest round is used
+	node.UnsetSyncRound()
+	result, err := node.Simulate(request)
+	require.NoError(t, err)
+	require.Equal(t, latest, result.LastRound)
+	require.Empty(t, result.TxnGroups[0].FailureMessage)
+
+	// The ledger may be ahead of the sync round, but simulation must not be
+	require.NoError(t, node.SetSyncRound(uint64(latest-1)))
+	result, err = node.Simulate(request)
+	require.NoError(t, err)
+	require.Equal(t, latest-1, result.LastRound)
+	require.Empty(t, result.TxnGroups[0].FailureMessage)
+
+	request.Round = latest
+	_, err = node.Simulate(request)
+	require.ErrorAs(t, err, &simulation.InvalidRequestError{})
+}
+
 func TestDevModeWarning(t *testing.T) {
 	partitiontest.PartitionTest(t)
 	t.Parallel()
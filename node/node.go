@@ -188,20 +188,37 @@ func MakeFull(log logging.Logger, rootDir string, cfg config.Local, phonebookAdd
 	node.config = cfg
 
 	// tie network, block fetcher, and agreement services together
-	p2pNode, err := network.NewWebsocketNetwork(node.log, node.config, phonebookAddresses, genesis.ID(), genesis.Network, node)
-	if err != nil {
-		log.Errorf("could not create websocket node: %v", err)
-		return nil, err
+	if cfg.EnableP2PHybridMode {
+		hybridNode, err := network.NewHybridP2PNetwork(node.log, node.config, rootDir, phonebookAddresses, genesis.ID(), genesis.Network, node)
+		if err != nil {
+			log.Errorf("could not create hybrid p2p node: %v", err)
+			return nil, err
+		}
+		hybridNode.SetPrioScheme(node)
+		node.net = hybridNode
+	} else if cfg.EnableP2P {
+		p2pNode, err := network.NewP2PNetwork(node.log, node.config, rootDir, phonebookAddresses, genesis.ID(), genesis.Network)
+		if err != nil {
+			log.Errorf("could not create p2p node: %v", err)
+			return nil, err
+		}
+		node.net = p2pNode
+	} else {
+		wsNode, err := network.NewWebsocketNetwork(node.log, node.config, phonebookAddresses, genesis.ID(), genesis.Network, node)
+		if err != nil {
+			log.Errorf("could not create websocket node: %v", err)
+			return nil, err
+		}
+		wsNode.SetPrioScheme(node)
+		node.net = wsNode
 	}
-	p2pNode.SetPrioScheme(node)
-	node.net = p2pNode
 
 	// load stored data
 	genesisDir := filepath.Join(rootDir, genesis.ID())
 	ledgerPathnamePrefix := filepath.Join(genesisDir, config.LedgerFilenamePrefix)
 
 	// create initial ledger, if it doesn't exist
-	err = os.Mkdir(genesisDir, 0700)
+	err := os.Mkdir(genesisDir, 0700)
 	if err != nil && !os.IsExist(err) {
 		log.Errorf("Unable to create genesis directory: %v", err)
 		return nil, err
@@ -253,9 +270,9 @@ func MakeFull(log logging.Logger, rootDir string, cfg config.Local, phonebookAdd
 		}
 	}
 
-	node.blockService = rpcs.MakeBlockService(node.log, cfg, node.ledger, p2pNode, node.genesisID)
-	node.ledgerService = rpcs.MakeLedgerService(cfg, node.ledger, p2pNode, node.genesisID)
-	rpcs.RegisterTxService(node.transactionPool, p2pNode, node.genesisID, cfg.TxPoolSize, cfg.TxSyncServeResponseSize)
+	node.blockService = rpcs.MakeBlockService(node.log, cfg, node.ledger, node.net, node.genesisID)
+	node.ledgerService = rpcs.MakeLedgerService(cfg, node.ledger, node.net, node.genesisID)
+	rpcs.RegisterTxService(node.transactionPool, node.net, node.genesisID, cfg.TxPoolSize, cfg.TxSyncServeResponseSize)
 
 	crashPathname := filepath.Join(genesisDir, config.CrashFilename)
 	crashAccess, err := db.MakeAccessor(crashPathname, false, false)
@@ -292,7 +309,7 @@ func MakeFull(log logging.Logger, rootDir string, cfg config.Local, phonebookAdd
 	}
 
 	node.catchupBlockAuth = blockAuthenticatorImpl{Ledger: node.ledger, AsyncVoteVerifier: agreement.MakeAsyncVoteVerifier(node.lowPriorityCryptoVerificationPool)}
-	node.catchupService = catchup.MakeService(node.log, node.config, p2pNode, node.ledger, node.catchupBlockAuth, agreementLedger.UnmatchedPendingCertificates, node.lowPriorityCryptoVerificationPool)
+	node.catchupService = catchup.MakeService(node.log, node.config, node.net, node.ledger, node.catchupBlockAuth, agreementLedger.UnmatchedPendingCertificates, node.lowPriorityCryptoVerificationPool)
 	node.txPoolSyncerService = rpcs.MakeTxSyncer(node.transactionPool, node.net, node.txHandler.SolicitedTxHandler(), time.Duration(cfg.TxSyncIntervalSeconds)*time.Second, time.Duration(cfg.TxSyncTimeoutSeconds)*time.Second, cfg.TxSyncServeResponseSize)
 
 	registry, err := ensureParticipationDB(genesisDir, node.log)
@@ -1162,6 +1179,17 @@ func (node *AlgorandFullNode) GetTransactionByID(txid transactions.Txid, rnd bas
 // StartCatchup starts the catchpoint mode and attempt to get to the provided catchpoint
 // this function is intended to be called externally via the REST api interface.
 func (node *AlgorandFullNode) StartCatchup(catchpoint string) error {
+	return node.startCatchup(catchpoint, "", "")
+}
+
+// StartCatchupFromFiles starts the catchpoint mode and attempt to get to the provided catchpoint, using the
+// given local catchpoint file and blocks file instead of downloading them from the network.
+// this function is intended to be called externally via the REST api interface.
+func (node *AlgorandFullNode) StartCatchupFromFiles(catchpoint, catchpointFile, blocksFile string) error {
+	return node.startCatchup(catchpoint, catchpointFile, blocksFile)
+}
+
+func (node *AlgorandFullNode) startCatchup(catchpoint, catchpointFile, blocksFile string) error {
 	node.mu.Lock()
 	defer node.mu.Unlock()
 	if node.indexer != nil {
@@ -1177,13 +1205,21 @@ func (node *AlgorandFullNode) StartCatchup(catchpoint string) error {
 	}
 	var err error
 	accessor := ledger.MakeCatchpointCatchupAccessor(node.ledger.Ledger, node.log)
-	node.catchpointCatchupService, err = catchup.MakeNewCatchpointCatchupService(catchpoint, node, node.log, node.net, accessor, node.config)
+	if catchpointFile != "" || blocksFile != "" {
+		node.catchpointCatchupService, err = catchup.MakeNewCatchpointCatchupServiceFromFiles(catchpoint, catchpointFile, blocksFile, node, node.log, node.net, accessor, node.config)
+	} else {
+		node.catchpointCatchupService, err = catchup.MakeNewCatchpointCatchupService(catchpoint, node, node.log, node.net, accessor, node.config)
+	}
 	if err != nil {
 		node.log.Warnf("unable to create catchpoint catchup service : %v", err)
 		return err
 	}
 	node.catchpointCatchupService.Start(node.ctx)
-	node.log.Infof("starting catching up toward catchpoint %s", catchpoint)
+	if catchpointFile != "" {
+		node.log.Infof("starting catching up toward catchpoint %s from %s", catchpoint, catchpointFile)
+	} else {
+		node.log.Infof("starting catching up toward catchpoint %s", catchpoint)
+	}
 	return nil
 }
 
@@ -1469,3 +1505,14 @@ func (node *AlgorandFullNode) GetBlockTimeStampOffset() (*int64, error) {
 	}
 	return nil, fmt.Errorf("cannot get block timestamp offset when not in dev mode")
 }
+
+// AgreementState returns a snapshot of the live agreement state machine.
+func (node *AlgorandFullNode) AgreementState(ctx context.Context) (agreement.StateSnapshot, error) {
+	node.mu.Lock()
+	catchingUp := node.catchpointCatchupService != nil
+	node.mu.Unlock()
+	if catchingUp {
+		return agreement.StateSnapshot{}, fmt.Errorf("agreement is not running during catchpoint catchup")
+	}
+	return node.agreementService.StateSnapshot(ctx)
+}
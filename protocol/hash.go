@@ -44,6 +44,7 @@ const (
 	BalanceRecord                    HashID = "BR"
 	Credential                       HashID = "CR"
 	Genesis                          HashID = "GE"
+	KMDSignedData                    HashID = "KD"
 	KeysInMSS                        HashID = "KP"
 	MerkleArrayNode                  HashID = "MA"
 	MerkleVectorCommitmentBottomLeaf HashID = "MB"
@@ -17,9 +17,11 @@
 package rpcs
 
 import (
+	"archive/tar"
 	"compress/gzip"
 	"fmt"
 	"io"
+	"math"
 	"net/http"
 	"strconv"
 	"strings"
@@ -32,6 +34,7 @@ import (
 	"github.com/algorand/go-algorand/config"
 	"github.com/algorand/go-algorand/data"
 	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/ledger"
 	"github.com/algorand/go-algorand/ledger/ledgercore"
 	"github.com/algorand/go-algorand/logging"
 	"github.com/algorand/go-algorand/network"
@@ -52,6 +55,19 @@ const (
 
 	// expectedWorstUploadSpeedBytesPerSecond defines the worst-case scenario upload speed we expect to get while uploading a catchpoint file
 	expectedWorstUploadSpeedBytesPerSecond = 20 * 1024
+
+	// LedgerServiceFirstChunkParam is the query argument selecting the first catchpoint file chunk ( i.e. tar entry ) to be returned.
+	// When it's provided, the response is an uncompressed tar stream containing only the requested range of chunks.
+	LedgerServiceFirstChunkParam = "first"
+
+	// LedgerServiceChunkCountParam is the query argument limiting the number of catchpoint file chunks to be returned.
+	// When it's omitted, all the chunks starting at LedgerServiceFirstChunkParam are returned. When it's provided for a
+	// catchpoint file without a chunk index, the request is rejected with http.StatusRequestedRangeNotSatisfiable.
+	LedgerServiceChunkCountParam = "count"
+
+	// LedgerResponseFirstChunkHeader is set on the responses of chunk range requests, and holds the index of the first
+	// returned chunk. Clients use it to tell apart servers that support chunk ranges from servers that always return the entire file.
+	LedgerResponseFirstChunkHeader = "X-Algorand-Ledger-First-Chunk"
 )
 
 // LedgerService represents the Ledger RPC API
@@ -172,6 +188,13 @@ func (ls *LedgerService) ServeHTTP(response http.ResponseWriter, request *http.R
 		response.Write([]byte(fmt.Sprintf("specified round number could not be parsed using base 36 : %v", err)))
 		return
 	}
+	firstChunk, chunkCount, isRangeRequest, err := parseChunkRange(request)
+	if err != nil {
+		logging.Base().Debugf("http ledger chunk range parse fail : %v", err)
+		response.WriteHeader(http.StatusBadRequest)
+		response.Write([]byte(err.Error()))
+		return
+	}
 	cs, err := ls.ledger.GetCatchpointStream(basics.Round(round))
 	if err != nil {
 		switch err.(type) {
@@ -205,6 +228,10 @@ func (ls *LedgerService) ServeHTTP(response http.ResponseWriter, request *http.R
 
 	response.Header().Set("Content-Type", LedgerResponseContentType)
 	requestedCompressedResponse := strings.Contains(request.Header.Get("Accept-Encoding"), "gzip")
+	if isRangeRequest {
+		ls.serveChunkRange(response, cs, basics.Round(round), firstChunk, chunkCount, requestedCompressedResponse)
+		return
+	}
 	if requestedCompressedResponse {
 		response.Header().Set("Content-Encoding", "gzip")
 		written, err := io.Copy(response, cs)
@@ -226,3 +253,106 @@ func (ls *LedgerService) ServeHTTP(response http.ResponseWriter, request *http.R
 		logging.Base().Infof("LedgerService.ServeHTTP : unable to write decompressed catchpoint file for round %d, written bytes %d : %v", round, written, err)
 	}
 }
+
+// parseChunkRange parses the optional chunk range query arguments of a ledger request. Requests without the
+// LedgerServiceFirstChunkParam argument are not range requests, and would get the entire catchpoint file.
+func parseChunkRange(request *http.Request) (first uint64, count uint64, isRangeRequest bool, err error) {
+	query := request.URL.Query()
+	firstStr := query.Get(LedgerServiceFirstChunkParam)
+	if firstStr == "" {
+		return 0, 0, false, nil
+	}
+	first, err = strconv.ParseUint(firstStr, 10, 64)
+	if err != nil {
+		return 0, 0, false, fmt.Errorf("invalid first chunk specified '%s' : %v", firstStr, err)
+	}
+	count = math.MaxUint64
+	if countStr := query.Get(LedgerServiceChunkCountParam); countStr != "" {
+		count, err = strconv.ParseUint(countStr, 10, 64)
+		if err != nil || count == 0 {
+			return 0, 0, false, fmt.Errorf("invalid chunk count specified '%s'", countStr)
+		}
+	}
+	return first, count, true, nil
+}
+
+// serveChunkRange writes the requested range of catchpoint file chunks to the response as a tar stream. Chunks past the
+// end of the catchpoint file are omitted, so a client could detect the end of the file by receiving less chunks than it asked for.
+// Catchpoint files without a chunk index only serve open ended ranges, which resume a stream of the entire file.
+func (ls *LedgerService) serveChunkRange(response http.ResponseWriter, cs io.Reader, round basics.Round, first, count uint64, compress bool) {
+	seeker, indexed := cs.(ledger.CatchpointChunkSeeker)
+	if !indexed && count != math.MaxUint64 {
+		// every range would have to be decompressed from the beginning of the file, making a download by ranges
+		// quadratic in the file size. Reject it, so that the client streams the file instead.
+		response.WriteHeader(http.StatusRequestedRangeNotSatisfiable)
+		response.Write([]byte(fmt.Sprintf("catchpoint file for round %d has no chunk index, and can only be streamed", round)))
+		return
+	}
+	skip := first
+	if indexed {
+		// the catchpoint file has a chunk index, so we can start decompressing right at the first requested chunk.
+		if err := seeker.SeekChunk(first); err != nil {
+			logging.Base().Warnf("LedgerService.ServeHTTP : failed to seek catchpoint %d chunk %d %v", round, first, err)
+			response.WriteHeader(http.StatusInternalServerError)
+			response.Write([]byte(fmt.Sprintf("catchpoint file for round %d could not be read due to internal error : %v", round, err)))
+			return
+		}
+		skip = 0
+	}
+	decompressedGzip, err := gzip.NewReader(cs)
+	if err != nil {
+		logging.Base().Warnf("LedgerService.ServeHTTP : failed to decompress catchpoint %d %v", round, err)
+		response.WriteHeader(http.StatusInternalServerError)
+		response.Write([]byte(fmt.Sprintf("catchpoint file for round %d could not be decompressed due to internal error : %v", round, err)))
+		return
+	}
+	defer decompressedGzip.Close()
+	tarReader := tar.NewReader(decompressedGzip)
+
+	// skip the chunks preceding the requested range; without a chunk index, we have no way to seek into the compressed file.
+	for i := uint64(0); i < skip; i++ {
+		_, err = tarReader.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			logging.Base().Warnf("LedgerService.ServeHTTP : failed to read catchpoint %d %v", round, err)
+			response.WriteHeader(http.StatusInternalServerError)
+			response.Write([]byte(fmt.Sprintf("catchpoint file for round %d could not be read due to internal error : %v", round, err)))
+			return
+		}
+	}
+
+	response.Header().Set(LedgerResponseFirstChunkHeader, strconv.FormatUint(first, 10))
+	var out io.Writer = response
+	if compress {
+		response.Header().Set("Content-Encoding", "gzip")
+		compressor := gzip.NewWriter(response)
+		defer compressor.Close()
+		out = compressor
+	}
+	tarWriter := tar.NewWriter(out)
+	defer tarWriter.Close()
+	if err == io.EOF {
+		// the requested range starts past the end of the file; reply with an empty tar stream.
+		return
+	}
+	for i := uint64(0); i < count; i++ {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			return
+		}
+		if err != nil {
+			logging.Base().Infof("LedgerService.ServeHTTP : unable to read catchpoint file for round %d chunk %d : %v", round, first+i, err)
+			return
+		}
+		err = tarWriter.WriteHeader(header)
+		if err == nil {
+			_, err = io.Copy(tarWriter, tarReader)
+		}
+		if err != nil {
+			logging.Base().Infof("LedgerService.ServeHTTP : unable to write catchpoint file for round %d chunk %d : %v", round, first+i, err)
+			return
+		}
+	}
+}
@@ -11,9 +11,9 @@
 # Our build task-runner `mule` will refer to this script and will automatically
 # build a new image whenever the version number has been changed.
 
-BUILD=1.17.13
- MIN=1.17
- GO_MOD_SUPPORT=1.17
+BUILD=1.20.5
+ MIN=1.20
+ GO_MOD_SUPPORT=1.20
 
 if [ "$1" = all ]
 then
@@ -0,0 +1,197 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package lightclient
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/crypto/stateproof"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/data/stateproofmsg"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/util/codecs"
+)
+
+// ErrRoundNotAttested is returned when a round is not covered by any of the
+// state proofs verified by the checkpoint.
+var ErrRoundNotAttested = errors.New("round is not attested by a verified state proof")
+
+var errInvalidCheckpoint = errors.New("invalid checkpoint")
+
+// MaxIntervals is the number of verified intervals a checkpoint keeps. Older intervals
+// are dropped as the checkpoint advances, so only their rounds can no longer be proven.
+const MaxIntervals = 1024
+
+// Interval is the part of a verified state proof message that is needed in order to
+// prove the light block headers of the rounds it attests to.
+type Interval struct {
+	FirstAttestedRound     uint64
+	LastAttestedRound      uint64
+	BlockHeadersCommitment crypto.GenericDigest
+}
+
+// Checkpoint is the trusted state of a light client. VotersCommitment and LnProvenWeight
+// are used to verify the state proof that attests to the rounds following Round.
+type Checkpoint struct {
+	// Round is the last round attested to by a verified state proof. Before any state
+	// proof is verified, it is the round of the block that holds the trusted voters commitment.
+	Round            uint64
+	VotersCommitment crypto.GenericDigest
+	LnProvenWeight   uint64
+	StrengthTarget   uint64
+
+	// Intervals holds the latest verified state proof messages, oldest first.
+	// At most MaxIntervals are kept.
+	Intervals []Interval
+}
+
+// NewCheckpoint creates a checkpoint that trusts the voters commitment found in the
+// block of the given round. provenWeight is the weight the next state proof has to prove,
+// i.e. the online total weight of that block scaled by StateProofWeightThreshold.
+func NewCheckpoint(round uint64, votersCommitment crypto.GenericDigest, provenWeight uint64, strengthTarget uint64) (Checkpoint, error) {
+	lnProvenWeight, err := stateproof.LnIntApproximation(provenWeight)
+	if err != nil {
+		return Checkpoint{}, err
+	}
+	cp := Checkpoint{
+		Round:            round,
+		VotersCommitment: votersCommitment,
+		LnProvenWeight:   lnProvenWeight,
+		StrengthTarget:   strengthTarget,
+	}
+	return cp, cp.validate()
+}
+
+// CheckpointFromMessage creates a checkpoint that trusts a state proof message which was
+// verified by other means. The message itself is kept so its rounds can be proven.
+func CheckpointFromMessage(msg stateproofmsg.Message, strengthTarget uint64) (Checkpoint, error) {
+	cp := Checkpoint{StrengthTarget: strengthTarget}.advance(msg)
+	return cp, cp.validate()
+}
+
+// CheckpointFromHeader creates a checkpoint that trusts the voters commitment of hdr, which
+// has to be a state proof interval boundary. proto has to be the consensus protocol of hdr.
+func CheckpointFromHeader(hdr bookkeeping.BlockHeader, proto config.ConsensusParams) (Checkpoint, error) {
+	if proto.StateProofInterval == 0 {
+		return Checkpoint{}, fmt.Errorf("state proofs are not enabled in protocol %s", hdr.CurrentProtocol)
+	}
+	tracking := hdr.StateProofTracking[protocol.StateProofBasic]
+	if len(tracking.StateProofVotersCommitment) == 0 {
+		return Checkpoint{}, fmt.Errorf("block %d does not have a voters commitment", hdr.Round)
+	}
+
+	provenWeight, overflowed := basics.Muldiv(tracking.StateProofOnlineTotalWeight.ToUint64(), uint64(proto.StateProofWeightThreshold), 1<<32)
+	if overflowed {
+		return Checkpoint{}, fmt.Errorf("overflow computing the proven weight of block %d", hdr.Round)
+	}
+	return NewCheckpoint(uint64(hdr.Round), tracking.StateProofVotersCommitment, provenWeight, proto.StateProofStrengthTarget)
+}
+
+// LoadCheckpoint reads a checkpoint from a JSON file.
+func LoadCheckpoint(filename string) (Checkpoint, error) {
+	var cp Checkpoint
+	err := codecs.LoadObjectFromFile(filename, &cp)
+	if err != nil {
+		return Checkpoint{}, err
+	}
+	if err := cp.validate(); err != nil {
+		return Checkpoint{}, fmt.Errorf("%s: %w", filename, err)
+	}
+	return cp, nil
+}
+
+// Save writes the checkpoint to a JSON file. The file is replaced atomically so a
+// crash never leaves a partially written checkpoint behind.
+func (cp Checkpoint) Save(filename string) error {
+	tmp := filename + ".tmp"
+	err := codecs.SaveObjectToFile(tmp, cp, true)
+	if err != nil {
+		os.Remove(tmp)
+		return err
+	}
+	return os.Rename(tmp, filename)
+}
+
+// Interval returns the verified interval that attests to the given round.
+func (cp Checkpoint) Interval(round uint64) (Interval, error) {
+	i := sort.Search(len(cp.Intervals), func(i int) bool {
+		return cp.Intervals[i].LastAttestedRound >= round
+	})
+	if i == len(cp.Intervals) || cp.Intervals[i].FirstAttestedRound > round {
+		return Interval{}, fmt.Errorf("%w: %d", ErrRoundNotAttested, round)
+	}
+	return cp.Intervals[i], nil
+}
+
+// advance returns the checkpoint that follows from a verified state proof message.
+func (cp Checkpoint) advance(msg stateproofmsg.Message) Checkpoint {
+	kept := cp.Intervals
+	if len(kept) >= MaxIntervals {
+		kept = kept[len(kept)-MaxIntervals+1:]
+	}
+	intervals := make([]Interval, len(kept), len(kept)+1)
+	copy(intervals, kept)
+	intervals = append(intervals, Interval{
+		FirstAttestedRound:     msg.FirstAttestedRound,
+		LastAttestedRound:      msg.LastAttestedRound,
+		BlockHeadersCommitment: msg.BlockHeadersCommitment,
+	})
+
+	return Checkpoint{
+		Round:            msg.LastAttestedRound,
+		VotersCommitment: msg.VotersCommitment,
+		LnProvenWeight:   msg.LnProvenWeight,
+		StrengthTarget:   cp.StrengthTarget,
+		Intervals:        intervals,
+	}
+}
+
+func (cp Checkpoint) validate() error {
+	if len(cp.VotersCommitment) == 0 {
+		return fmt.Errorf("%w: missing voters commitment", errInvalidCheckpoint)
+	}
+	if cp.StrengthTarget == 0 {
+		return fmt.Errorf("%w: missing strength target", errInvalidCheckpoint)
+	}
+	if len(cp.Intervals) > MaxIntervals {
+		return fmt.Errorf("%w: %d intervals exceed the maximum of %d", errInvalidCheckpoint, len(cp.Intervals), MaxIntervals)
+	}
+
+	next := uint64(0)
+	for i, iv := range cp.Intervals {
+		if iv.FirstAttestedRound > iv.LastAttestedRound {
+			return fmt.Errorf("%w: interval %d attests to rounds %d-%d", errInvalidCheckpoint, i, iv.FirstAttestedRound, iv.LastAttestedRound)
+		}
+		if i > 0 && iv.FirstAttestedRound != next {
+			return fmt.Errorf("%w: interval %d starts at round %d instead of %d", errInvalidCheckpoint, i, iv.FirstAttestedRound, next)
+		}
+		if len(iv.BlockHeadersCommitment) == 0 {
+			return fmt.Errorf("%w: interval %d is missing its block headers commitment", errInvalidCheckpoint, i)
+		}
+		next = iv.LastAttestedRound + 1
+	}
+	if len(cp.Intervals) > 0 && cp.Intervals[len(cp.Intervals)-1].LastAttestedRound != cp.Round {
+		return fmt.Errorf("%w: last interval ends at round %d instead of %d", errInvalidCheckpoint, next-1, cp.Round)
+	}
+	return nil
+}
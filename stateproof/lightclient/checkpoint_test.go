@@ -0,0 +1,197 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package lightclient
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/data/stateproofmsg"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func testMessage(first, last uint64) stateproofmsg.Message {
+	msg := stateproofmsg.Message{
+		BlockHeadersCommitment: make([]byte, crypto.Sha256Size),
+		VotersCommitment:       make([]byte, crypto.SumhashDigestSize),
+		LnProvenWeight:         last,
+		FirstAttestedRound:     first,
+		LastAttestedRound:      last,
+	}
+	crypto.RandBytes(msg.BlockHeadersCommitment)
+	crypto.RandBytes(msg.VotersCommitment)
+	return msg
+}
+
+func TestCheckpointInterval(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+	a := require.New(t)
+
+	cp, err := CheckpointFromMessage(testMessage(257, 512), 256)
+	a.NoError(err)
+	cp = cp.advance(testMessage(513, 768))
+	cp = cp.advance(testMessage(769, 1024))
+	a.NoError(cp.validate())
+	a.Equal(uint64(1024), cp.Round)
+
+	for _, rnd := range []uint64{0, 1, 256, 1025} {
+		_, err := cp.Interval(rnd)
+		a.ErrorIs(err, ErrRoundNotAttested, "round %d", rnd)
+	}
+	for rnd := uint64(257); rnd <= 1024; rnd += 17 {
+		iv, err := cp.Interval(rnd)
+		a.NoError(err)
+		a.LessOrEqual(iv.FirstAttestedRound, rnd)
+		a.GreaterOrEqual(iv.LastAttestedRound, rnd)
+	}
+	iv, err := cp.Interval(768)
+	a.NoError(err)
+	a.Equal(cp.Intervals[1], iv)
+}
+
+func TestCheckpointAdvanceDoesNotAlias(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+	a := require.New(t)
+
+	cp, err := CheckpointFromMessage(testMessage(1, 16), 256)
+	a.NoError(err)
+	cp.Intervals = append(make([]Interval, 0, 10), cp.Intervals...)
+
+	next1 := cp.advance(testMessage(17, 32))
+	next2 := cp.advance(testMessage(17, 32))
+	a.NotEqual(next1.Intervals[1], next2.Intervals[1])
+	a.Len(cp.Intervals, 1)
+}
+
+func TestCheckpointValidate(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+	a := require.New(t)
+
+	valid, err := CheckpointFromMessage(testMessage(1, 16), 256)
+	a.NoError(err)
+	valid = valid.advance(testMessage(17, 32))
+	a.NoError(valid.validate())
+
+	testcases := []struct {
+		name   string
+		modify func(*Checkpoint)
+	}{
+		{"voters", func(cp *Checkpoint) { cp.VotersCommitment = nil }},
+		{"strength", func(cp *Checkpoint) { cp.StrengthTarget = 0 }},
+		{"round", func(cp *Checkpoint) { cp.Round++ }},
+		{"gap", func(cp *Checkpoint) { cp.Intervals[1].FirstAttestedRound++ }},
+		{"reversed", func(cp *Checkpoint) { cp.Intervals[0].FirstAttestedRound = 20 }},
+		{"commitment", func(cp *Checkpoint) { cp.Intervals[0].BlockHeadersCommitment = nil }},
+	}
+	for _, tc := range testcases {
+		cp := valid
+		cp.Intervals = append([]Interval{}, valid.Intervals...)
+		tc.modify(&cp)
+		a.ErrorIs(cp.validate(), errInvalidCheckpoint, tc.name)
+	}
+}
+
+func TestCheckpointSaveLoad(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+	a := require.New(t)
+
+	cp, err := NewCheckpoint(256, make([]byte, crypto.SumhashDigestSize), 1000000, 256)
+	a.NoError(err)
+	a.Empty(cp.Intervals)
+
+	filename := filepath.Join(t.TempDir(), "checkpoint.json")
+	a.NoError(cp.Save(filename))
+	loaded, err := LoadCheckpoint(filename)
+	a.NoError(err)
+	a.Equal(cp.Round, loaded.Round)
+	a.Equal(cp.VotersCommitment, loaded.VotersCommitment)
+	a.Equal(cp.LnProvenWeight, loaded.LnProvenWeight)
+
+	cp = cp.advance(testMessage(257, 512))
+	a.NoError(cp.Save(filename))
+	loaded, err = LoadCheckpoint(filename)
+	a.NoError(err)
+	a.Equal(cp, loaded)
+	a.NoFileExists(filename + ".tmp")
+
+	a.NoError(os.WriteFile(filename, []byte(`{"Round": 5}`), 0600))
+	_, err = LoadCheckpoint(filename)
+	a.ErrorIs(err, errInvalidCheckpoint)
+}
+
+func TestCheckpointFromHeader(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+	a := require.New(t)
+
+	proto := config.Consensus[protocol.ConsensusCurrentVersion]
+	hdr := bookkeeping.BlockHeader{Round: 512}
+	_, err := CheckpointFromHeader(hdr, proto)
+	a.Error(err)
+
+	hdr.StateProofTracking = map[protocol.StateProofType]bookkeeping.StateProofTrackingData{
+		protocol.StateProofBasic: {
+			StateProofVotersCommitment:  make([]byte, crypto.SumhashDigestSize),
+			StateProofOnlineTotalWeight: basics.MicroAlgos{Raw: 1 << 20},
+		},
+	}
+	cp, err := CheckpointFromHeader(hdr, proto)
+	a.NoError(err)
+	a.Equal(uint64(512), cp.Round)
+	a.Equal(proto.StateProofStrengthTarget, cp.StrengthTarget)
+
+	expected, err := NewCheckpoint(512, hdr.StateProofTracking[protocol.StateProofBasic].StateProofVotersCommitment,
+		(1<<20)*uint64(proto.StateProofWeightThreshold)>>32, proto.StateProofStrengthTarget)
+	a.NoError(err)
+	a.Equal(expected, cp)
+
+	proto.StateProofInterval = 0
+	_, err = CheckpointFromHeader(hdr, proto)
+	a.Error(err)
+}
+
+func TestCheckpointHistoryIsCapped(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+	a := require.New(t)
+
+	cp, err := CheckpointFromMessage(testMessage(1, 16), 256)
+	a.NoError(err)
+	for i := uint64(1); i < MaxIntervals+10; i++ {
+		cp = cp.advance(testMessage(i*16+1, (i+1)*16))
+	}
+	a.NoError(cp.validate())
+	a.Len(cp.Intervals, MaxIntervals)
+	a.Equal(uint64((MaxIntervals+10)*16), cp.Round)
+
+	_, err = cp.Interval(16)
+	a.ErrorIs(err, ErrRoundNotAttested)
+	_, err = cp.Interval(cp.Intervals[0].FirstAttestedRound)
+	a.NoError(err)
+}
@@ -0,0 +1,271 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+// Package lightclient follows the state proof chain of an Algorand network from a
+// trusted checkpoint and uses it to prove the inclusion of transactions, relying on
+// nothing but the answers of an untrusted algod node.
+package lightclient
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/crypto/merklearray"
+	"github.com/algorand/go-algorand/crypto/stateproof"
+	"github.com/algorand/go-algorand/daemon/algod/api/client"
+	"github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated/model"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/data/stateproofmsg"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+// ErrInvalidProof is returned when the data served by the node does not verify
+// against the checkpoint.
+var ErrInvalidProof = errors.New("proof verification failed")
+
+// Node is the subset of the algod REST API the light client relies on. libgoal.Client
+// implements it; a client.RestClient only needs BookkeepingBlock on top, which decodes
+// RawBlock. Nothing returned by a Node is trusted before it is verified.
+type Node interface {
+	StateProofs(round uint64) (model.StateProofResponse, error)
+	LightBlockHeaderProof(round uint64) (model.LightBlockHeaderProofResponse, error)
+	TransactionProof(txid string, round uint64, hashType crypto.HashType) (model.TransactionProofResponse, error)
+	BookkeepingBlock(round uint64) (bookkeeping.Block, error)
+}
+
+// Client is a state proof light client.
+type Client struct {
+	node       Node
+	checkpoint Checkpoint
+	filename   string
+}
+
+// MakeClient creates a light client that starts from the given checkpoint. If
+// filename is not empty, the checkpoint is saved to it at the end of every Advance
+// that verified a state proof.
+func MakeClient(node Node, checkpoint Checkpoint, filename string) (*Client, error) {
+	if err := checkpoint.validate(); err != nil {
+		return nil, err
+	}
+	return &Client{
+		node:       node,
+		checkpoint: checkpoint,
+		filename:   filename,
+	}, nil
+}
+
+// OpenClient creates a light client that starts from the checkpoint saved in filename
+// and keeps it up to date.
+func OpenClient(node Node, filename string) (*Client, error) {
+	cp, err := LoadCheckpoint(filename)
+	if err != nil {
+		return nil, err
+	}
+	return MakeClient(node, cp, filename)
+}
+
+// Checkpoint returns the current trusted state of the client.
+func (c *Client) Checkpoint() Checkpoint {
+	return c.checkpoint
+}
+
+// Advance verifies the state proofs that follow the checkpoint, one after the other,
+// until the node does not have the next one. It returns the number of state proofs
+// that were verified; the checkpoint reflects all of them even if an error is returned.
+func (c *Client) Advance() (n int, err error) {
+	for {
+		var ok bool
+		ok, err = c.advanceOnce()
+		if err != nil || !ok {
+			break
+		}
+		n++
+	}
+
+	if n > 0 && c.filename != "" {
+		saveErr := c.checkpoint.Save(c.filename)
+		if saveErr != nil && err == nil {
+			err = fmt.Errorf("saving checkpoint: %w", saveErr)
+		}
+	}
+	return n, err
+}
+
+// advanceOnce verifies the state proof for the rounds that follow the checkpoint.
+// It returns false if the node does not have that state proof yet.
+func (c *Client) advanceOnce() (bool, error) {
+	next := c.checkpoint.Round + 1
+	res, err := c.node.StateProofs(next)
+	if err != nil {
+		var httpErr client.HTTPError
+		if errors.As(err, &httpErr) && httpErr.StatusCode == http.StatusNotFound {
+			return false, nil
+		}
+		return false, fmt.Errorf("fetching state proof for round %d: %w", next, err)
+	}
+
+	msg := stateproofmsg.Message{
+		BlockHeadersCommitment: res.Message.BlockHeadersCommitment,
+		VotersCommitment:       res.Message.VotersCommitment,
+		LnProvenWeight:         res.Message.LnProvenWeight,
+		FirstAttestedRound:     res.Message.FirstAttestedRound,
+		LastAttestedRound:      res.Message.LastAttestedRound,
+	}
+	if msg.FirstAttestedRound != next || msg.LastAttestedRound < msg.FirstAttestedRound {
+		return false, fmt.Errorf("%w: state proof for round %d attests to rounds %d-%d", ErrInvalidProof, next, msg.FirstAttestedRound, msg.LastAttestedRound)
+	}
+
+	var sp stateproof.StateProof
+	err = protocol.Decode(res.StateProof, &sp)
+	if err != nil {
+		return false, fmt.Errorf("%w: decoding state proof for rounds %d-%d: %v", ErrInvalidProof, msg.FirstAttestedRound, msg.LastAttestedRound, err)
+	}
+
+	verifier := stateproof.MkVerifierWithLnProvenWeight(c.checkpoint.VotersCommitment, c.checkpoint.LnProvenWeight, c.checkpoint.StrengthTarget)
+	err = verifier.Verify(msg.LastAttestedRound, msg.Hash(), &sp)
+	if err != nil {
+		return false, fmt.Errorf("%w: state proof for rounds %d-%d: %v", ErrInvalidProof, msg.FirstAttestedRound, msg.LastAttestedRound, err)
+	}
+
+	c.checkpoint = c.checkpoint.advance(msg)
+	return true, nil
+}
+
+// ProveBlockHeader returns the block of the given round after checking that its light
+// block header is committed to by a verified state proof. Only the fields of the
+// light block header are authenticated; the rest of the block is as served by the node.
+func (c *Client) ProveBlockHeader(round uint64) (bookkeeping.Block, bookkeeping.LightBlockHeader, error) {
+	iv, err := c.checkpoint.Interval(round)
+	if err != nil {
+		return bookkeeping.Block{}, bookkeeping.LightBlockHeader{}, err
+	}
+
+	blk, err := c.node.BookkeepingBlock(round)
+	if err != nil {
+		return bookkeeping.Block{}, bookkeeping.LightBlockHeader{}, fmt.Errorf("fetching block %d: %w", round, err)
+	}
+	if blk.Round() != basics.Round(round) {
+		return bookkeeping.Block{}, bookkeeping.LightBlockHeader{}, fmt.Errorf("%w: node returned block %d instead of %d", ErrInvalidProof, blk.Round(), round)
+	}
+	lightHeader := blk.ToLightBlockHeader()
+
+	res, err := c.node.LightBlockHeaderProof(round)
+	if err != nil {
+		return bookkeeping.Block{}, bookkeeping.LightBlockHeader{}, fmt.Errorf("fetching light block header proof for round %d: %w", round, err)
+	}
+	if res.Index != round-iv.FirstAttestedRound {
+		return bookkeeping.Block{}, bookkeeping.LightBlockHeader{}, fmt.Errorf("%w: light block header of round %d proven at index %d", ErrInvalidProof, round, res.Index)
+	}
+	proof, err := merklearray.ProofDataToSingleLeafProof(crypto.Sha256.String(), res.Treedepth, res.Proof)
+	if err != nil {
+		return bookkeeping.Block{}, bookkeeping.LightBlockHeader{}, fmt.Errorf("%w: light block header proof for round %d: %v", ErrInvalidProof, round, err)
+	}
+
+	elems := map[uint64]crypto.Hashable{res.Index: &lightHeader}
+	err = merklearray.VerifyVectorCommitment(iv.BlockHeadersCommitment, elems, proof.ToProof())
+	if err != nil {
+		return bookkeeping.Block{}, bookkeeping.LightBlockHeader{}, fmt.Errorf("%w: light block header of round %d: %v", ErrInvalidProof, round, err)
+	}
+	return blk, lightHeader, nil
+}
+
+// TransactionInclusion describes a transaction proven to be included in a block.
+type TransactionInclusion struct {
+	Round       uint64
+	Index       uint64
+	Transaction transactions.SignedTxnWithAD
+	Header      bookkeeping.LightBlockHeader
+}
+
+// ProveTransaction checks that the transaction with the given ID is included in the
+// block of the given round, which has to be attested to by a verified state proof.
+func (c *Client) ProveTransaction(txid string, round uint64) (TransactionInclusion, error) {
+	var id transactions.Txid
+	err := id.UnmarshalText([]byte(txid))
+	if err != nil {
+		return TransactionInclusion{}, fmt.Errorf("invalid transaction ID %s: %w", txid, err)
+	}
+
+	blk, lightHeader, err := c.ProveBlockHeader(round)
+	if err != nil {
+		return TransactionInclusion{}, err
+	}
+	if len(lightHeader.Sha256TxnCommitment) == 0 {
+		return TransactionInclusion{}, fmt.Errorf("block %d does not have a SHA256 transaction commitment", round)
+	}
+
+	res, err := c.node.TransactionProof(txid, round, crypto.Sha256)
+	if err != nil {
+		return TransactionInclusion{}, fmt.Errorf("fetching proof for transaction %s in round %d: %w", txid, round, err)
+	}
+	if res.Hashtype != model.TransactionProofResponseHashtypeSha256 {
+		return TransactionInclusion{}, fmt.Errorf("%w: transaction proof uses hash type %s", ErrInvalidProof, res.Hashtype)
+	}
+	if res.Idx >= uint64(len(blk.Payset)) {
+		return TransactionInclusion{}, fmt.Errorf("%w: transaction index %d is out of range of block %d", ErrInvalidProof, res.Idx, round)
+	}
+
+	// The leaf is computed from the served block rather than taken from the proof, so
+	// verifying it against the authenticated commitment also authenticates the transaction.
+	stib := blk.Payset[res.Idx]
+	stxn, ad, err := blk.DecodeSignedTxn(stib)
+	if err != nil {
+		return TransactionInclusion{}, fmt.Errorf("%w: decoding transaction %d of block %d: %v", ErrInvalidProof, res.Idx, round, err)
+	}
+	if stxn.ID() != id {
+		return TransactionInclusion{}, fmt.Errorf("%w: transaction %d of block %d is %s", ErrInvalidProof, res.Idx, round, stxn.ID())
+	}
+	stibHash := stib.HashSHA256()
+	if !bytes.Equal(stibHash[:], res.Stibhash) {
+		return TransactionInclusion{}, fmt.Errorf("%w: transaction %s has a mismatching stib hash", ErrInvalidProof, txid)
+	}
+
+	proof, err := merklearray.ProofDataToSingleLeafProof(string(res.Hashtype), res.Treedepth, res.Proof)
+	if err != nil {
+		return TransactionInclusion{}, fmt.Errorf("%w: proof for transaction %s: %v", ErrInvalidProof, txid, err)
+	}
+	elems := map[uint64]crypto.Hashable{res.Idx: &txnLeaf{txid: stxn.Txn.IDSha256(), stib: stibHash}}
+	err = merklearray.VerifyVectorCommitment(lightHeader.Sha256TxnCommitment, elems, proof.ToProof())
+	if err != nil {
+		return TransactionInclusion{}, fmt.Errorf("%w: transaction %s in round %d: %v", ErrInvalidProof, txid, round, err)
+	}
+
+	return TransactionInclusion{
+		Round:       round,
+		Index:       res.Idx,
+		Transaction: transactions.SignedTxnWithAD{SignedTxn: stxn, ApplyData: ad},
+		Header:      lightHeader,
+	}, nil
+}
+
+// txnLeaf is a leaf of the SHA256 vector commitment to the transactions of a block.
+type txnLeaf struct {
+	txid crypto.Digest
+	stib crypto.Digest
+}
+
+// ToBeHashed implements the crypto.Hashable interface.
+func (l *txnLeaf) ToBeHashed() (protocol.HashID, []byte) {
+	buf := make([]byte, 2*crypto.DigestSize)
+	copy(buf, l.txid[:])
+	copy(buf[crypto.DigestSize:], l.stib[:])
+	return protocol.TxnMerkleLeaf, buf
+}
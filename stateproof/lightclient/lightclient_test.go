@@ -0,0 +1,423 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package lightclient
+
+import (
+	"errors"
+	"net/http"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/crypto/merklearray"
+	"github.com/algorand/go-algorand/crypto/merklesignature"
+	"github.com/algorand/go-algorand/crypto/stateproof"
+	"github.com/algorand/go-algorand/daemon/algod/api/client"
+	"github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated/model"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/data/stateproofmsg"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+const testInterval = 16
+const testStrengthTarget = 64
+
+type lightHeaders []bookkeeping.LightBlockHeader
+
+func (h lightHeaders) Length() uint64 {
+	return uint64(len(h))
+}
+
+func (h lightHeaders) Marshal(pos uint64) (crypto.Hashable, error) {
+	return &h[pos], nil
+}
+
+// testChain is an in-memory network whose blocks and state proofs are served through
+// the Node interface. The tamper hooks let tests simulate a malicious node.
+type testChain struct {
+	t      *testing.T
+	proto  config.ConsensusParams
+	blocks map[uint64]bookkeeping.Block
+	proofs []model.StateProofResponse
+
+	tamperBlock func(*bookkeeping.Block)
+	tamperProof func(*model.StateProofResponse)
+}
+
+// makeTestChain creates a chain whose first state proof interval boundary holds the voters
+// commitment, followed by the given number of intervals attested to by state proofs.
+func makeTestChain(t *testing.T, intervals int) *testChain {
+	a := require.New(t)
+
+	proto := config.Consensus[protocol.ConsensusCurrentVersion]
+	proto.StateProofInterval = testInterval
+	proto.StateProofStrengthTarget = testStrengthTarget
+	proto.StateProofWeightThreshold = 1 << 31
+
+	lastRound := uint64(intervals+1) * testInterval
+	key, err := merklesignature.New(0, lastRound, testInterval)
+	a.NoError(err)
+
+	var parts basics.ParticipantsArray
+	for i := 0; i < 8; i++ {
+		parts = append(parts, basics.Participant{PK: *key.GetVerifier(), Weight: 1000000})
+	}
+	partTree, err := merklearray.BuildVectorCommitmentTree(parts, crypto.HashFactory{HashType: stateproof.HashType})
+	a.NoError(err)
+	totalWeight := uint64(len(parts)) * 1000000
+	provenWeight := totalWeight / 2
+	lnProvenWeight, err := stateproof.LnIntApproximation(provenWeight)
+	a.NoError(err)
+
+	c := &testChain{
+		t:      t,
+		proto:  proto,
+		blocks: make(map[uint64]bookkeeping.Block),
+	}
+
+	var genesisHash crypto.Digest
+	crypto.RandBytes(genesisHash[:])
+	var headers lightHeaders
+	for rnd := uint64(1); rnd <= lastRound; rnd++ {
+		blk := bookkeeping.Block{
+			BlockHeader: bookkeeping.BlockHeader{
+				Round:       basics.Round(rnd),
+				GenesisID:   "lightclient-test",
+				GenesisHash: genesisHash,
+				UpgradeState: bookkeeping.UpgradeState{
+					CurrentProtocol: protocol.ConsensusCurrentVersion,
+				},
+			},
+		}
+		crypto.RandBytes(blk.BlockHeader.Seed[:])
+		for i := 0; i < 3; i++ {
+			var stxn transactions.SignedTxn
+			stxn.Txn.Type = protocol.PaymentTx
+			crypto.RandBytes(stxn.Txn.Sender[:])
+			crypto.RandBytes(stxn.Txn.Receiver[:])
+			stxn.Txn.Amount = basics.MicroAlgos{Raw: rnd*10 + uint64(i)}
+			stxn.Txn.FirstValid = basics.Round(rnd)
+			stxn.Txn.LastValid = basics.Round(rnd + 10)
+			stxn.Txn.GenesisID = blk.BlockHeader.GenesisID
+			stxn.Txn.GenesisHash = genesisHash
+
+			stib, err := blk.EncodeSignedTxn(stxn, transactions.ApplyData{})
+			a.NoError(err)
+			blk.Payset = append(blk.Payset, stib)
+		}
+		blk.TxnCommitments, err = blk.PaysetCommit()
+		a.NoError(err)
+
+		if rnd%testInterval == 0 {
+			blk.StateProofTracking = map[protocol.StateProofType]bookkeeping.StateProofTrackingData{
+				protocol.StateProofBasic: {
+					StateProofVotersCommitment:  partTree.Root(),
+					StateProofOnlineTotalWeight: basics.MicroAlgos{Raw: totalWeight},
+				},
+			}
+		}
+		c.blocks[rnd] = blk
+		headers = append(headers, blk.ToLightBlockHeader())
+
+		if rnd%testInterval != 0 || rnd == testInterval {
+			continue
+		}
+
+		tree, err := merklearray.BuildVectorCommitmentTree(headers[rnd-testInterval:rnd], crypto.HashFactory{HashType: crypto.Sha256})
+		a.NoError(err)
+		msg := stateproofmsg.Message{
+			BlockHeadersCommitment: tree.Root(),
+			VotersCommitment:       partTree.Root(),
+			LnProvenWeight:         lnProvenWeight,
+			FirstAttestedRound:     rnd - testInterval + 1,
+			LastAttestedRound:      rnd,
+		}
+		hash := msg.Hash()
+		sig, err := key.GetSigner(rnd).SignBytes(hash[:])
+		a.NoError(err)
+
+		prover, err := stateproof.MakeProver(hash, rnd, provenWeight, parts, partTree, testStrengthTarget)
+		a.NoError(err)
+		for i := range parts {
+			a.NoError(prover.Add(uint64(i), sig))
+		}
+		sp, err := prover.CreateProof()
+		a.NoError(err)
+
+		var res model.StateProofResponse
+		res.StateProof = protocol.Encode(sp)
+		res.Message.BlockHeadersCommitment = msg.BlockHeadersCommitment
+		res.Message.VotersCommitment = msg.VotersCommitment
+		res.Message.LnProvenWeight = msg.LnProvenWeight
+		res.Message.FirstAttestedRound = msg.FirstAttestedRound
+		res.Message.LastAttestedRound = msg.LastAttestedRound
+		c.proofs = append(c.proofs, res)
+	}
+	return c
+}
+
+func (c *testChain) checkpoint() Checkpoint {
+	blk := c.blocks[testInterval]
+	cp, err := CheckpointFromHeader(blk.BlockHeader, c.proto)
+	require.NoError(c.t, err)
+	return cp
+}
+
+func (c *testChain) proofFor(round uint64) (model.StateProofResponse, bool) {
+	for _, res := range c.proofs {
+		if res.Message.FirstAttestedRound <= round && round <= res.Message.LastAttestedRound {
+			return res, true
+		}
+	}
+	return model.StateProofResponse{}, false
+}
+
+func (c *testChain) StateProofs(round uint64) (model.StateProofResponse, error) {
+	res, ok := c.proofFor(round)
+	if !ok {
+		return model.StateProofResponse{}, client.HTTPError{StatusCode: http.StatusNotFound, Status: "404 Not Found"}
+	}
+	if c.tamperProof != nil {
+		c.tamperProof(&res)
+	}
+	return res, nil
+}
+
+func (c *testChain) LightBlockHeaderProof(round uint64) (model.LightBlockHeaderProofResponse, error) {
+	res, ok := c.proofFor(round)
+	if !ok {
+		return model.LightBlockHeaderProofResponse{}, client.HTTPError{StatusCode: http.StatusNotFound, Status: "404 Not Found"}
+	}
+
+	var headers lightHeaders
+	for rnd := res.Message.FirstAttestedRound; rnd <= res.Message.LastAttestedRound; rnd++ {
+		blk := c.blocks[rnd]
+		headers = append(headers, blk.ToLightBlockHeader())
+	}
+	tree, err := merklearray.BuildVectorCommitmentTree(headers, crypto.HashFactory{HashType: crypto.Sha256})
+	require.NoError(c.t, err)
+	index := round - res.Message.FirstAttestedRound
+	proof, err := tree.ProveSingleLeaf(index)
+	require.NoError(c.t, err)
+
+	return model.LightBlockHeaderProofResponse{
+		Index:     index,
+		Proof:     proof.GetConcatenatedProof(),
+		Treedepth: uint64(proof.TreeDepth),
+	}, nil
+}
+
+func (c *testChain) TransactionProof(txid string, round uint64, hashType crypto.HashType) (model.TransactionProofResponse, error) {
+	require.Equal(c.t, crypto.Sha256, hashType)
+	blk := c.blocks[round]
+	for idx, stib := range blk.Payset {
+		stxn, _, err := blk.DecodeSignedTxn(stib)
+		require.NoError(c.t, err)
+		if stxn.ID().String() != txid {
+			continue
+		}
+
+		tree, err := blk.TxnMerkleTreeSHA256()
+		require.NoError(c.t, err)
+		proof, err := tree.ProveSingleLeaf(uint64(idx))
+		require.NoError(c.t, err)
+		stibHash := stib.HashSHA256()
+
+		return model.TransactionProofResponse{
+			Proof:     proof.GetConcatenatedProof(),
+			Stibhash:  stibHash[:],
+			Idx:       uint64(idx),
+			Treedepth: uint64(proof.TreeDepth),
+			Hashtype:  model.TransactionProofResponseHashtypeSha256,
+		}, nil
+	}
+	return model.TransactionProofResponse{}, client.HTTPError{StatusCode: http.StatusNotFound, Status: "404 Not Found"}
+}
+
+func (c *testChain) BookkeepingBlock(round uint64) (bookkeeping.Block, error) {
+	blk, ok := c.blocks[round]
+	if !ok {
+		return bookkeeping.Block{}, errors.New("no such block")
+	}
+	if c.tamperBlock != nil {
+		payset := make(transactions.Payset, len(blk.Payset))
+		copy(payset, blk.Payset)
+		blk.Payset = payset
+		c.tamperBlock(&blk)
+	}
+	return blk, nil
+}
+
+func (c *testChain) txid(round uint64, idx int) string {
+	blk := c.blocks[round]
+	stxn, _, err := blk.DecodeSignedTxn(blk.Payset[idx])
+	require.NoError(c.t, err)
+	return stxn.ID().String()
+}
+
+func TestAdvanceAndProveTransaction(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+	a := require.New(t)
+
+	chain := makeTestChain(t, 3)
+	filename := filepath.Join(t.TempDir(), "checkpoint.json")
+	lc, err := MakeClient(chain, chain.checkpoint(), filename)
+	a.NoError(err)
+
+	_, err = lc.ProveTransaction(chain.txid(testInterval+1, 0), testInterval+1)
+	a.ErrorIs(err, ErrRoundNotAttested)
+
+	n, err := lc.Advance()
+	a.NoError(err)
+	a.Equal(3, n)
+	a.Equal(uint64(4*testInterval), lc.Checkpoint().Round)
+	a.Len(lc.Checkpoint().Intervals, 3)
+
+	// Nothing new to verify.
+	n, err = lc.Advance()
+	a.NoError(err)
+	a.Zero(n)
+
+	for _, rnd := range []uint64{testInterval + 1, 2*testInterval + 5, 4 * testInterval} {
+		for idx := range chain.blocks[rnd].Payset {
+			txid := chain.txid(rnd, idx)
+			inc, err := lc.ProveTransaction(txid, rnd)
+			a.NoError(err)
+			a.Equal(rnd, inc.Round)
+			a.Equal(uint64(idx), inc.Index)
+			a.Equal(txid, inc.Transaction.ID().String())
+			a.Equal(basics.Round(rnd), inc.Header.Round)
+		}
+	}
+
+	_, err = lc.ProveTransaction(chain.txid(testInterval, 0), testInterval)
+	a.ErrorIs(err, ErrRoundNotAttested)
+	_, err = lc.ProveTransaction(chain.txid(testInterval+1, 0), testInterval+2)
+	a.Error(err)
+
+	// The checkpoint was persisted and a reopened client resumes from it.
+	reopened, err := OpenClient(chain, filename)
+	a.NoError(err)
+	a.Equal(lc.Checkpoint(), reopened.Checkpoint())
+	_, err = reopened.ProveTransaction(chain.txid(3*testInterval, 1), 3*testInterval)
+	a.NoError(err)
+}
+
+func TestAdvanceRejectsInvalidStateProof(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	chain := makeTestChain(t, 2)
+
+	testcases := []struct {
+		name   string
+		tamper func(*model.StateProofResponse)
+	}{
+		{"commitment", func(res *model.StateProofResponse) {
+			res.Message.BlockHeadersCommitment = append([]byte{}, res.Message.BlockHeadersCommitment...)
+			res.Message.BlockHeadersCommitment[0]++
+		}},
+		{"weight", func(res *model.StateProofResponse) {
+			res.Message.LnProvenWeight++
+		}},
+		{"rounds", func(res *model.StateProofResponse) {
+			res.Message.FirstAttestedRound++
+		}},
+		{"encoding", func(res *model.StateProofResponse) {
+			res.StateProof = res.StateProof[:len(res.StateProof)/2]
+		}},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			a := require.New(t)
+			// The first state proof is served intact, only the second one is tampered with.
+			calls := 0
+			node := *chain
+			node.t = t
+			node.tamperProof = func(res *model.StateProofResponse) {
+				calls++
+				if calls > 1 {
+					tc.tamper(res)
+				}
+			}
+
+			lc, err := MakeClient(&node, node.checkpoint(), "")
+			a.NoError(err)
+			n, err := lc.Advance()
+			a.ErrorIs(err, ErrInvalidProof)
+			a.Equal(1, n)
+			a.Equal(uint64(2*testInterval), lc.Checkpoint().Round)
+		})
+	}
+}
+
+func TestProveTransactionRejectsForgedBlock(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	chain := makeTestChain(t, 1)
+	rnd := uint64(testInterval + 3)
+	txid := chain.txid(rnd, 1)
+
+	testcases := []struct {
+		name   string
+		tamper func(*bookkeeping.Block)
+	}{
+		{"seed", func(blk *bookkeeping.Block) {
+			blk.BlockHeader.Seed[0]++
+		}},
+		{"commitment", func(blk *bookkeeping.Block) {
+			blk.TxnCommitments.Sha256Commitment[0]++
+		}},
+		{"round", func(blk *bookkeeping.Block) {
+			blk.BlockHeader.Round++
+		}},
+		{"transaction", func(blk *bookkeeping.Block) {
+			// Swap in a transaction with the same ID but different apply data.
+			blk.Payset[1].ApplyData.ClosingAmount = basics.MicroAlgos{Raw: 1}
+		}},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			a := require.New(t)
+			node := *chain
+			node.t = t
+			lc, err := MakeClient(&node, node.checkpoint(), "")
+			a.NoError(err)
+			_, err = lc.Advance()
+			a.NoError(err)
+
+			_, err = lc.ProveTransaction(txid, rnd)
+			a.NoError(err)
+
+			node.tamperBlock = tc.tamper
+			_, err = lc.ProveTransaction(txid, rnd)
+			a.ErrorIs(err, ErrInvalidProof)
+		})
+	}
+}
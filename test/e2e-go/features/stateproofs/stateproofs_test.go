@@ -43,6 +43,7 @@ import (
 	"github.com/algorand/go-algorand/libgoal"
 	"github.com/algorand/go-algorand/nodecontrol"
 	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/stateproof/lightclient"
 	"github.com/algorand/go-algorand/test/framework/fixtures"
 	"github.com/algorand/go-algorand/test/partitiontest"
 )
@@ -372,6 +373,90 @@ func TestStateProofMessageCommitmentVerification(t *testing.T) {
 	}
 }
 
+func TestStateProofLightClient(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	defer fixtures.ShutdownSynchronizedTest(t)
+
+	r := require.New(fixtures.SynchronizedTest(t))
+
+	configurableConsensus := make(config.ConsensusProtocols)
+	consensusVersion := protocol.ConsensusVersion("test-fast-stateproofs")
+	consensusParams := getDefaultStateProofConsensusParams()
+	configurableConsensus[consensusVersion] = consensusParams
+
+	var fixture fixtures.RestClientFixture
+	fixture.SetConsensus(configurableConsensus)
+	fixture.Setup(t, filepath.Join("nettemplates", "StateProofSmall.json"))
+	defer fixture.Shutdown()
+
+	libgoalClient := fixture.LibGoalClient
+
+	// The light client trusts the voters commitment of the first interval boundary, so the
+	// transaction to prove has to be confirmed after it.
+	votersRound := consensusParams.StateProofInterval
+	err := fixture.WaitForRound(votersRound+1, timeoutUntilNextRound)
+	r.NoError(err)
+
+	votersBlock, err := libgoalClient.BookkeepingBlock(votersRound)
+	r.NoError(err)
+	checkpoint, err := lightclient.CheckpointFromHeader(votersBlock.BlockHeader, consensusParams)
+	r.NoError(err)
+
+	account0 := accountFetcher{nodeName: "Node0", accountNumber: 0}.getAccount(r, &fixture)
+	account1 := accountFetcher{nodeName: "Node1", accountNumber: 0}.getAccount(r, &fixture)
+	minTxnFee, _, err := fixture.CurrentMinFeeAndBalance()
+	r.NoError(err)
+	status, err := libgoalClient.Status()
+	r.NoError(err)
+	node0Client := fixture.GetLibGoalClientForNamedNode("Node0")
+	tx, err := node0Client.SendPaymentFromUnencryptedWallet(account0, account1, minTxnFee, 1, nil)
+	r.NoError(err)
+	txid := tx.ID().String()
+	confirmedTx, err := fixture.WaitForConfirmedTxn(status.LastRound+10, account0, txid)
+	r.NoError(err)
+	r.NotNil(confirmedTx.ConfirmedRound)
+	confirmedRound := *confirmedTx.ConfirmedRound
+	t.Logf("transaction %s confirmed in round %d", txid, confirmedRound)
+
+	// Wait until a state proof attests to the round of the transaction.
+	lastAttestedRound := ((confirmedRound-1)/consensusParams.StateProofInterval + 1) * consensusParams.StateProofInterval
+	for rnd := status.LastRound + 1; ; rnd++ {
+		err = fixture.WaitForRound(rnd, timeoutUntilNextRound)
+		r.NoError(err)
+
+		blk, err := libgoalClient.BookkeepingBlock(rnd)
+		r.NoError(err)
+		if uint64(blk.StateProofTracking[protocol.StateProofBasic].StateProofNextRound) > lastAttestedRound {
+			break
+		}
+		r.Less(rnd, lastAttestedRound+consensusParams.StateProofInterval*(consensusParams.StateProofMaxRecoveryIntervals+1), "state proof for round %d was not created", lastAttestedRound)
+	}
+
+	checkpointFile := filepath.Join(t.TempDir(), "checkpoint.json")
+	lc, err := lightclient.MakeClient(&libgoalClient, checkpoint, checkpointFile)
+	r.NoError(err)
+	n, err := lc.Advance()
+	r.NoError(err)
+	r.Greater(n, 0)
+	r.GreaterOrEqual(lc.Checkpoint().Round, lastAttestedRound)
+
+	inclusion, err := lc.ProveTransaction(txid, confirmedRound)
+	r.NoError(err)
+	r.Equal(confirmedRound, inclusion.Round)
+	r.Equal(txid, inclusion.Transaction.ID().String())
+	r.Equal(votersBlock.GenesisHash, inclusion.Header.GenesisHash)
+
+	_, err = lc.ProveTransaction(txid, confirmedRound+1)
+	r.Error(err)
+
+	// A client reopened from the persisted checkpoint can prove the transaction without advancing.
+	reopened, err := lightclient.OpenClient(&libgoalClient, checkpointFile)
+	r.NoError(err)
+	r.Equal(lc.Checkpoint().Round, reopened.Checkpoint().Round)
+	_, err = reopened.ProveTransaction(txid, confirmedRound)
+	r.NoError(err)
+}
+
 func getDefaultStateProofConsensusParams() config.ConsensusParams {
 	consensusParams := config.Consensus[protocol.ConsensusFuture]
 
@@ -0,0 +1,162 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package transactions
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/framework/fixtures"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+// TestRekeyAwareSigning rekeys an account to a key, to a multisig and back,
+// signing every transaction without naming the signer, and checks that the
+// auth address algod reports is used to sign them.
+func TestRekeyAwareSigning(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	defer fixtures.ShutdownSynchronizedTest(t)
+
+	t.Parallel()
+	a := require.New(fixtures.SynchronizedTest(t))
+
+	var fixture fixtures.RestClientFixture
+	fixture.Setup(t, filepath.Join("nettemplates", "TwoNodes50EachFuture.json"))
+	defer fixture.Shutdown()
+	client := fixture.LibGoalClient
+
+	accountList, err := fixture.GetWalletsSortedByBalance()
+	a.NoError(err)
+	baseAcct := accountList[0].Address
+
+	wh, err := client.GetUnencryptedWalletHandle()
+	a.NoError(err)
+
+	acctA, err := client.GenerateAddress(wh)
+	a.NoError(err)
+	addrA, err := basics.UnmarshalChecksumAddress(acctA)
+	a.NoError(err)
+	acctB, err := client.GenerateAddress(wh)
+	a.NoError(err)
+	addrB, err := basics.UnmarshalChecksumAddress(acctB)
+	a.NoError(err)
+	acctC, err := client.GenerateAddress(wh)
+	a.NoError(err)
+	acctMsig, err := client.CreateMultisigAccount(wh, 1, []string{acctB, acctC})
+	a.NoError(err)
+	addrMsig, err := basics.UnmarshalChecksumAddress(acctMsig)
+	a.NoError(err)
+
+	status, err := client.Status()
+	a.NoError(err)
+	tx, err := client.SendPaymentFromUnencryptedWallet(baseAcct, acctA, 1000, 10000000, nil)
+	a.NoError(err)
+	_, err = fixture.WaitForConfirmedTxn(status.LastRound+10, baseAcct, tx.ID().String())
+	a.NoError(err)
+
+	// pay signs a payment from acctA with the wallet, optionally rekeying it,
+	// checks who authorized it and waits for it to be confirmed
+	pay := func(rekeyTo basics.Address, authAddr basics.Address, msig bool) {
+		utx, err := client.ConstructPayment(acctA, baseAcct, 1000, 1000, nil, "", [32]byte{}, 0, 0)
+		a.NoError(err)
+		utx.RekeyTo = rekeyTo
+
+		stx, err := client.SignTransactionWithWallet(wh, nil, utx)
+		a.NoError(err)
+		a.Equal(authAddr, stx.AuthAddr)
+		a.Equal(msig, !stx.Msig.Blank())
+
+		status, err := client.Status()
+		a.NoError(err)
+		txid, err := client.BroadcastTransaction(stx)
+		a.NoError(err)
+		_, err = fixture.WaitForConfirmedTxn(status.LastRound+10, acctA, txid)
+		a.NoError(err)
+	}
+	authAddrOfA := func() basics.Address {
+		authAddr, err := client.AccountAuthAddr(acctA)
+		a.NoError(err)
+		return authAddr
+	}
+
+	// Rekey A to B, signed by A
+	pay(addrB, basics.Address{}, false)
+	a.Equal(addrB, authAddrOfA())
+
+	// Send from A, signed by B
+	pay(basics.Address{}, addrB, false)
+
+	// kmd looks up the auth address too when it isn't told which key to use
+	kmd, err := fixture.NC.KMDClient()
+	a.NoError(err)
+	utx, err := client.ConstructPayment(acctA, baseAcct, 1000, 1000, nil, "", [32]byte{}, 0, 0)
+	a.NoError(err)
+	resp, err := kmd.SignTransaction(wh, nil, crypto.PublicKey{}, utx)
+	a.NoError(err)
+	var stx transactions.SignedTxn
+	a.NoError(protocol.Decode(resp.SignedTransaction, &stx))
+	a.Equal(addrB, stx.AuthAddr)
+
+	// and for each transaction of a group, signing the others with the key
+	// of their sender
+	utx2, err := client.ConstructPayment(baseAcct, acctA, 1000, 1000, nil, "", [32]byte{}, 0, 0)
+	a.NoError(err)
+	txgroup := []transactions.Transaction{utx, utx2}
+	gid, err := client.GroupID(txgroup)
+	a.NoError(err)
+	for i := range txgroup {
+		txgroup[i].Group = gid
+	}
+	groupResp, err := kmd.SignTransactionGroup(wh, nil, nil, txgroup)
+	a.NoError(err)
+	a.Len(groupResp.SignedTransactions, len(txgroup))
+	stxgroup := make([]transactions.SignedTxn, len(txgroup))
+	for i := range stxgroup {
+		a.NoError(protocol.Decode(groupResp.SignedTransactions[i], &stxgroup[i]))
+	}
+	a.Equal(addrB, stxgroup[0].AuthAddr)
+	a.True(stxgroup[1].AuthAddr.IsZero())
+	status, err = client.Status()
+	a.NoError(err)
+	a.NoError(client.BroadcastTransactionGroup(stxgroup))
+	_, err = fixture.WaitForConfirmedTxn(status.LastRound+10, acctA, stxgroup[0].ID().String())
+	a.NoError(err)
+
+	// With the lookup turned off, the sender key signs
+	client.SetAuthAddrLookup(false)
+	stx, err = client.SignTransactionWithWallet(wh, nil, utx)
+	a.NoError(err)
+	a.True(stx.AuthAddr.IsZero())
+	client.SetAuthAddrLookup(true)
+
+	// Rekey A to a multisig of B and C, signed by B, then send from A on
+	// behalf of the multisig
+	pay(addrMsig, addrB, false)
+	a.Equal(addrMsig, authAddrOfA())
+	pay(basics.Address{}, addrMsig, true)
+
+	// Rekey A back to itself, signed by the multisig, then send from A
+	pay(addrA, addrMsig, true)
+	a.True(authAddrOfA().IsZero())
+	pay(basics.Address{}, basics.Address{}, false)
+}
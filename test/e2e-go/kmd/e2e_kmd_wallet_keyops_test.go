@@ -24,6 +24,7 @@ import (
 	"github.com/algorand/go-algorand/config"
 	"github.com/algorand/go-algorand/crypto"
 	"github.com/algorand/go-algorand/daemon/kmd/lib/kmdapi"
+	"github.com/algorand/go-algorand/daemon/kmd/wallet"
 	"github.com/algorand/go-algorand/data/basics"
 	"github.com/algorand/go-algorand/data/transactions"
 	"github.com/algorand/go-algorand/data/transactions/logic"
@@ -383,6 +384,132 @@ func TestSignProgram(t *testing.T) {
 	a.True(secrets.SignatureVerifier.Verify(ph, sig))
 }
 
+func TestSignTransactionGroup(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	defer fixtures.ShutdownSynchronizedTest(t)
+
+	a := require.New(fixtures.SynchronizedTest(t))
+	t.Parallel()
+	var f fixtures.KMDFixture
+	walletHandleToken := f.SetupWithWallet(t)
+	defer f.Shutdown()
+
+	// Generate two keys outside of kmd, and import them
+	var pks []crypto.PublicKey
+	for i := 0; i < 2; i++ {
+		seed := crypto.Seed{}
+		crypto.RandBytes(seed[:])
+		secrets := crypto.GenerateSignatureSecrets(seed)
+		pks = append(pks, secrets.SignatureVerifier)
+
+		req0 := kmdapi.APIV1POSTKeyImportRequest{
+			WalletHandleToken: walletHandleToken,
+			PrivateKey:        crypto.PrivateKey(secrets.SK),
+		}
+		resp0 := kmdapi.APIV1POSTKeyImportResponse{}
+		err := f.Client.DoV1Request(req0, &resp0)
+		a.NoError(err)
+	}
+
+	// Make a transaction group
+	var group crypto.Digest
+	crypto.RandBytes(group[:])
+	var txs [][]byte
+	for _, pk := range pks {
+		tx := transactions.Transaction{
+			Type: protocol.PaymentTx,
+			Header: transactions.Header{
+				Sender:     basics.Address(pk),
+				Fee:        basics.MicroAlgos{Raw: config.Consensus[protocol.ConsensusCurrentVersion].MinTxnFee},
+				FirstValid: basics.Round(1),
+				LastValid:  basics.Round(1),
+				Group:      group,
+			},
+		}
+		txs = append(txs, protocol.Encode(&tx))
+	}
+
+	// Request the signatures
+	req1 := kmdapi.APIV1POSTTransactionGroupSignRequest{
+		WalletHandleToken: walletHandleToken,
+		Transactions:      txs,
+		WalletPassword:    f.WalletPassword,
+	}
+	resp1 := kmdapi.APIV1POSTTransactionGroupSignResponse{}
+	err := f.Client.DoV1Request(req1, &resp1)
+	a.NoError(err)
+	a.Len(resp1.SignedTransactions, len(pks))
+
+	for i, encoded := range resp1.SignedTransactions {
+		var stx transactions.SignedTxn
+		err = protocol.Decode(encoded, &stx)
+		a.NoError(err)
+		a.True(pks[i].Verify(stx.Txn, stx.Sig))
+	}
+
+	// Transactions that don't share the same group ID can't be signed together
+	var tx transactions.Transaction
+	a.NoError(protocol.Decode(txs[1], &tx))
+	tx.Group = crypto.Digest{}
+	req1.Transactions[1] = protocol.Encode(&tx)
+	err = f.Client.DoV1Request(req1, &resp1)
+	a.Error(err)
+}
+
+func TestSignData(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	defer fixtures.ShutdownSynchronizedTest(t)
+
+	a := require.New(fixtures.SynchronizedTest(t))
+	t.Parallel()
+	var f fixtures.KMDFixture
+	walletHandleToken := f.SetupWithWallet(t)
+	defer f.Shutdown()
+
+	// Generate a key outside of kmd
+	seed := crypto.Seed{}
+	crypto.RandBytes(seed[:])
+	secrets := crypto.GenerateSignatureSecrets(seed)
+	pk := crypto.Digest(secrets.SignatureVerifier)
+
+	// Import the key
+	req0 := kmdapi.APIV1POSTKeyImportRequest{
+		WalletHandleToken: walletHandleToken,
+		PrivateKey:        crypto.PrivateKey(secrets.SK),
+	}
+	resp0 := kmdapi.APIV1POSTKeyImportResponse{}
+	err := f.Client.DoV1Request(req0, &resp0)
+	a.NoError(err)
+
+	data := []byte("some bytes to sign")
+	addr := basics.Address(pk)
+
+	// Request a signature
+	req1 := kmdapi.APIV1POSTDataSignRequest{
+		WalletHandleToken: walletHandleToken,
+		Address:           addr.String(),
+		Domain:            "example.com/login",
+		Data:              data,
+		WalletPassword:    f.WalletPassword,
+	}
+	resp1 := kmdapi.APIV1POSTDataSignResponse{}
+	err = f.Client.DoV1Request(req1, &resp1)
+	a.NoError(err)
+
+	var sig crypto.Signature
+	copy(sig[:], resp1.Signature)
+	a.True(secrets.SignatureVerifier.Verify(wallet.DomainData{Domain: req1.Domain, Data: data}, sig))
+
+	// The signature is bound to its domain, and can't pass for a program signature
+	a.False(secrets.SignatureVerifier.Verify(wallet.DomainData{Domain: "example.com", Data: data}, sig))
+	a.False(secrets.SignatureVerifier.Verify(logic.Program(data), sig))
+
+	// A domain is mandatory
+	req1.Domain = ""
+	err = f.Client.DoV1Request(req1, &resp1)
+	a.Error(err)
+}
+
 func BenchmarkSignTransaction(b *testing.B) {
 	a := require.New(fixtures.SynchronizedTest(b))
 	var f fixtures.KMDFixture
@@ -22,6 +22,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/algorand/go-algorand/crypto"
 	"github.com/algorand/go-algorand/daemon/kmd/lib/kmdapi"
 	"github.com/algorand/go-algorand/test/framework/fixtures"
 	"github.com/algorand/go-algorand/test/partitiontest"
@@ -197,6 +198,178 @@ func TestWalletRename(t *testing.T) {
 	a.Equal(resp1.Wallets[0].ID, resp4.Wallets[0].ID)
 }
 
+func TestWalletBackupRestore(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	defer fixtures.ShutdownSynchronizedTest(t)
+
+	a := require.New(fixtures.SynchronizedTest(t))
+	t.Parallel()
+	var f fixtures.KMDFixture
+	walletHandleToken := f.SetupWithWallet(t)
+	defer f.Shutdown()
+
+	// Generate a key from the master derivation key
+	req0 := kmdapi.APIV1POSTKeyRequest{
+		WalletHandleToken: walletHandleToken,
+	}
+	resp0 := kmdapi.APIV1POSTKeyResponse{}
+	err := f.Client.DoV1Request(req0, &resp0)
+	a.NoError(err)
+
+	// Import a key that can't be recovered from the master derivation key
+	seed := crypto.Seed{}
+	crypto.RandBytes(seed[:])
+	secrets := crypto.GenerateSignatureSecrets(seed)
+	req1 := kmdapi.APIV1POSTKeyImportRequest{
+		WalletHandleToken: walletHandleToken,
+		PrivateKey:        crypto.PrivateKey(secrets.SK),
+	}
+	resp1 := kmdapi.APIV1POSTKeyImportResponse{}
+	err = f.Client.DoV1Request(req1, &resp1)
+	a.NoError(err)
+
+	// Import a multisig address
+	req2 := kmdapi.APIV1POSTMultisigImportRequest{
+		WalletHandleToken: walletHandleToken,
+		Version:           1,
+		Threshold:         1,
+		PKs:               []crypto.PublicKey{secrets.SignatureVerifier},
+	}
+	resp2 := kmdapi.APIV1POSTMultisigImportResponse{}
+	err = f.Client.DoV1Request(req2, &resp2)
+	a.NoError(err)
+
+	// Export the backup
+	req3 := kmdapi.APIV1POSTWalletExportRequest{
+		WalletHandleToken: walletHandleToken,
+		WalletPassword:    f.WalletPassword,
+	}
+	resp3 := kmdapi.APIV1POSTWalletExportResponse{}
+	err = f.Client.DoV1Request(req3, &resp3)
+	a.NoError(err)
+	a.NotEmpty(resp3.Backup)
+
+	// The backup can't be restored with another password
+	req4 := kmdapi.APIV1POSTWalletImportRequest{
+		WalletName:       "restored",
+		WalletDriverName: "sqlite",
+		WalletPassword:   "wrong",
+		Backup:           resp3.Backup,
+	}
+	resp4 := kmdapi.APIV1POSTWalletImportResponse{}
+	err = f.Client.DoV1Request(req4, &resp4)
+	a.Error(err)
+
+	// Restore the backup
+	req4.WalletPassword = f.WalletPassword
+	err = f.Client.DoV1Request(req4, &resp4)
+	a.NoError(err)
+	a.Equal("restored", resp4.Wallet.Name)
+
+	// Get a handle to the restored wallet
+	req5 := kmdapi.APIV1POSTWalletInitRequest{
+		WalletID:       resp4.Wallet.ID,
+		WalletPassword: f.WalletPassword,
+	}
+	resp5 := kmdapi.APIV1POSTWalletInitResponse{}
+	err = f.Client.DoV1Request(req5, &resp5)
+	a.NoError(err)
+
+	// The restored wallet has the same keys and multisig addresses
+	for _, handle := range []string{walletHandleToken, resp5.WalletHandleToken} {
+		req6 := kmdapi.APIV1POSTKeyListRequest{
+			WalletHandleToken: handle,
+		}
+		resp6 := kmdapi.APIV1POSTKeyListResponse{}
+		err = f.Client.DoV1Request(req6, &resp6)
+		a.NoError(err)
+		a.ElementsMatch([]string{resp0.Address, resp1.Address}, resp6.Addresses)
+
+		req7 := kmdapi.APIV1POSTMultisigListRequest{
+			WalletHandleToken: handle,
+		}
+		resp7 := kmdapi.APIV1POSTMultisigListResponse{}
+		err = f.Client.DoV1Request(req7, &resp7)
+		a.NoError(err)
+		a.Equal([]string{resp2.Address}, resp7.Addresses)
+	}
+
+	// The next generated key isn't one of the restored keys
+	req8 := kmdapi.APIV1POSTKeyRequest{
+		WalletHandleToken: resp5.WalletHandleToken,
+	}
+	resp8 := kmdapi.APIV1POSTKeyResponse{}
+	err = f.Client.DoV1Request(req8, &resp8)
+	a.NoError(err)
+	a.NotEqual(resp0.Address, resp8.Address)
+}
+
+func TestWalletUpgradeKDF(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	defer fixtures.ShutdownSynchronizedTest(t)
+
+	a := require.New(fixtures.SynchronizedTest(t))
+	t.Parallel()
+	var f fixtures.KMDFixture
+	// Write a config encrypting new wallets with cheap argon2id
+	cfg := `{"drivers":{"sqlite":{"scrypt":{"scrypt_n":2},"allow_unsafe_scrypt":true,"kdf":"argon2id","argon2id":{"argon2_time":1,"argon2_memory_kib":1024,"argon2_threads":1}}}}`
+	f.SetupWithConfig(t, cfg)
+	walletHandleToken, err := f.MakeWalletAndHandleToken()
+	defer f.Shutdown()
+	a.NoError(err)
+
+	// Generate a key
+	req0 := kmdapi.APIV1POSTKeyRequest{
+		WalletHandleToken: walletHandleToken,
+	}
+	resp0 := kmdapi.APIV1POSTKeyResponse{}
+	err = f.Client.DoV1Request(req0, &resp0)
+	a.NoError(err)
+
+	// Re-encrypting requires the wallet password
+	req1 := kmdapi.APIV1POSTWalletUpgradeKDFRequest{
+		WalletHandleToken: walletHandleToken,
+		WalletPassword:    "wrong",
+	}
+	resp1 := kmdapi.APIV1POSTWalletUpgradeKDFResponse{}
+	err = f.Client.DoV1Request(req1, &resp1)
+	a.Error(err)
+
+	req1.WalletPassword = f.WalletPassword
+	err = f.Client.DoV1Request(req1, &resp1)
+	a.NoError(err)
+
+	// Look up the wallet ID
+	req2 := kmdapi.APIV1POSTWalletInfoRequest{
+		WalletHandleToken: walletHandleToken,
+	}
+	resp2 := kmdapi.APIV1POSTWalletInfoResponse{}
+	err = f.Client.DoV1Request(req2, &resp2)
+	a.NoError(err)
+
+	// The re-encrypted wallet only opens with the same password
+	req3 := kmdapi.APIV1POSTWalletInitRequest{
+		WalletID:       resp2.WalletHandle.Wallet.ID,
+		WalletPassword: "wrong",
+	}
+	resp3 := kmdapi.APIV1POSTWalletInitResponse{}
+	err = f.Client.DoV1Request(req3, &resp3)
+	a.Error(err)
+
+	req3.WalletPassword = f.WalletPassword
+	err = f.Client.DoV1Request(req3, &resp3)
+	a.NoError(err)
+
+	// The key is still there
+	req4 := kmdapi.APIV1POSTKeyListRequest{
+		WalletHandleToken: resp3.WalletHandleToken,
+	}
+	resp4 := kmdapi.APIV1POSTKeyListResponse{}
+	err = f.Client.DoV1Request(req4, &resp4)
+	a.NoError(err)
+	a.Equal([]string{resp0.Address}, resp4.Addresses)
+}
+
 func TestWalletSessionRelease(t *testing.T) {
 	partitiontest.PartitionTest(t)
 	defer fixtures.ShutdownSynchronizedTest(t)
@@ -0,0 +1,76 @@
+#!/bin/bash
+
+filename=$(basename "$0")
+scriptname="${filename%.*}"
+date "+${scriptname} start %Y%m%d_%H%M%S"
+
+set -exo pipefail
+export SHELLOPTS
+
+WALLET=$1
+
+gcmd="goal -w ${WALLET}"
+
+ACCOUNT=$(${gcmd} account list|awk '{ print $3 }')
+ACCOUNTA=$(${gcmd} account new|awk '{ print $6 }')
+ACCOUNTB=$(${gcmd} account new|awk '{ print $6 }')
+ACCOUNTC=$(${gcmd} account new|awk '{ print $6 }')
+ACCOUNTM=$(${gcmd} account multisig new -T 1 "${ACCOUNTB}" "${ACCOUNTC}"|awk '{ print $6 }')
+
+${gcmd} clerk send -a 10000000 -f "${ACCOUNT}" -t "${ACCOUNTA}"
+
+# auth_addr prints the address the account is authorized by, if it was rekeyed
+auth_addr() {
+    ${gcmd} account dump -a "$1" | jq -r '.spend // empty'
+}
+
+# Rekeying to an address the wallet can't sign for needs --force
+algokey generate > "${TEMPDIR}/outside"
+OUTSIDE=$(grep 'Public key:' < "${TEMPDIR}/outside" | sed 's/Public key: //')
+if ${gcmd} account rekey -a "${ACCOUNTA}" --to "${OUTSIDE}"; then
+    date "+${scriptname} FAIL rekey to a key outside the wallet should need --force %Y%m%d_%H%M%S"
+    false
+fi
+
+# Rekey A to B, then send from A without naming the signer
+${gcmd} account rekey -a "${ACCOUNTA}" --to "${ACCOUNTB}"
+if [ "$(auth_addr "${ACCOUNTA}")" != "${ACCOUNTB}" ]; then
+    date "+${scriptname} FAIL ${ACCOUNTA} should be authorized by ${ACCOUNTB} %Y%m%d_%H%M%S"
+    false
+fi
+${gcmd} clerk send -a 1000 -f "${ACCOUNTA}" -t "${ACCOUNT}"
+
+# Signing offline without the lookup uses the sender key, which no longer
+# authorizes A
+${gcmd} clerk send -a 1000 -f "${ACCOUNTA}" -t "${ACCOUNT}" -o "${TEMPDIR}/rekeyed.tx"
+${gcmd} clerk sign --no-auth-lookup -i "${TEMPDIR}/rekeyed.tx" -o "${TEMPDIR}/rekeyed.stx"
+if ${gcmd} clerk rawsend -f "${TEMPDIR}/rekeyed.stx"; then
+    date "+${scriptname} FAIL the sender key should no longer authorize ${ACCOUNTA} %Y%m%d_%H%M%S"
+    false
+fi
+${gcmd} clerk sign -i "${TEMPDIR}/rekeyed.tx" -o "${TEMPDIR}/rekeyed.stx"
+${gcmd} clerk rawsend -f "${TEMPDIR}/rekeyed.stx"
+
+# Rekey A from B to the multisig of B and C, then send from A
+${gcmd} account rekey -a "${ACCOUNTA}" --to "${ACCOUNTM}"
+if [ "$(auth_addr "${ACCOUNTA}")" != "${ACCOUNTM}" ]; then
+    date "+${scriptname} FAIL ${ACCOUNTA} should be authorized by ${ACCOUNTM} %Y%m%d_%H%M%S"
+    false
+fi
+${gcmd} clerk send -a 1000 -f "${ACCOUNTA}" -t "${ACCOUNT}"
+
+# Rekey A back to its own key, then send from A
+${gcmd} account rekey -a "${ACCOUNTA}" --back
+if [ -n "$(auth_addr "${ACCOUNTA}")" ]; then
+    date "+${scriptname} FAIL ${ACCOUNTA} should be authorized by its own key %Y%m%d_%H%M%S"
+    false
+fi
+${gcmd} clerk send -a 1000 -f "${ACCOUNTA}" -t "${ACCOUNT}"
+
+# Rekeying back an account that isn't rekeyed is refused
+if ${gcmd} account rekey -a "${ACCOUNTA}" --back; then
+    date "+${scriptname} FAIL rekeying back an account authorized by its own key should fail %Y%m%d_%H%M%S"
+    false
+fi
+
+date "+${scriptname} OK %Y%m%d_%H%M%S"
@@ -34,6 +34,7 @@ var numRegex = regexp.MustCompile(`^\d+$`)
 var filename = flag.String("file", "", "Name of the input cadaver file (otherwise, use stdin)")
 var versionCheck = flag.Bool("version", false, "Display current coroner build version and exit")
 var printmsgpack = flag.Bool("msgpack", false, "If provided, emit msgpack instead of a string")
+var replay = flag.Bool("replay", false, "If provided, replay the trace and report the first point where the emitted actions diverge from the recorded ones")
 
 var skipHead = flag.String("skip-head", "", "The first round to trim before")
 var skipTail = flag.String("skip-tail", "", "The last round to trim after")
@@ -90,6 +91,16 @@ func main() {
 	}
 	defer autopsy.Close()
 
+	if *replay {
+		res := autopsy.Replay()
+		if res.Divergence != nil {
+			autopsy.Close()
+			log.Fatalf("coroner: replay diverged after %d events in %d sequences: %v", res.Events, res.Sequences, *res.Divergence)
+		}
+		log.Printf("coroner: replayed %d events in %d sequences without divergence\n", res.Events, res.Sequences)
+		return
+	}
+
 	var filter agreement.AutopsyFilter
 	if *skipHead != "" {
 		filter.Enabled = true
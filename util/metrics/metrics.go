@@ -99,6 +99,10 @@ var (
 	TransactionMessagesTxGroupInvalidFee = MetricName{Name: "algod_transaction_messages_txgroup_invalid_fee", Description: "Number of transaction messages with invalid txgroup fee"}
 	// TransactionMessagesTxnDroppedCongestionManagement "Number of transaction messages dropped because the tx backlog is under congestion management"
 	TransactionMessagesTxnDroppedCongestionManagement = MetricName{Name: "algod_transaction_messages_txn_dropped_congestion_ctrl", Description: "Number of transaction messages dropped because the tx backlog is under congestion management"}
+	// TransactionMessagesTxnDroppedSenderLimit "Number of transaction messages dropped because a sender exceeded its share of the tx backlog"
+	TransactionMessagesTxnDroppedSenderLimit = MetricName{Name: "algod_transaction_messages_txn_dropped_sender_limit", Description: "Number of transaction messages dropped because a sender exceeded its share of the tx backlog"}
+	// TransactionMessagesBacklogDroppedPerPeer "Number of transaction messages dropped from the tx backlog, per connected peer"
+	TransactionMessagesBacklogDroppedPerPeer = MetricName{Name: "algod_transaction_messages_backlog_dropped_peer", Description: "Number of transaction messages dropped from the tx backlog, per peer, for the peers which dropped messages most recently"}
 	// TransactionMessagesTxnNotWellFormed "Number of transaction messages not well formed"
 	TransactionMessagesTxnNotWellFormed = MetricName{Name: "algod_transaction_messages_txn_notwell_formed", Description: "Number of transaction messages not well formed"}
 	// TransactionMessagesTxnSigNotWellFormed "Number of transaction messages with bad formed signature"
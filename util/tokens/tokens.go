@@ -33,6 +33,9 @@ const (
 	AlgodTokenFilename      = "algod.token"
 	AlgodAdminTokenFilename = "algod.admin.token"
 	KmdTokenFilename        = "kmd.token"
+
+	// AlgodScopedTokensFilename holds the algod API tokens restricted to some routes.
+	AlgodScopedTokensFilename = "algod.scoped_tokens.json"
 )
 
 func tokenFilepath(dataDir, tokenFilename string) string {